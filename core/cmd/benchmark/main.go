@@ -1,6 +1,7 @@
 package main
 
 import (
+	"context"
 	"flag"
 	"fmt"
 	"runtime"
@@ -64,7 +65,8 @@ func (b *Bench) RunWorkers() {
 }
 
 func (b *Bench) Worker() {
-	run, err := b.wandb.NewRun()
+	ctx := context.Background()
+	run, err := b.wandb.NewRun(ctx)
 	if err != nil {
 		panic(err)
 	}
@@ -75,9 +77,9 @@ func (b *Bench) Worker() {
 	}
 
 	for i := 0; i < *b.opts.numHistory; i++ {
-		run.Log(data)
+		_ = run.Log(ctx, data)
 	}
-	run.Finish()
+	_ = run.Finish(ctx)
 }
 
 func (b *Bench) Close() {
@@ -1,6 +1,7 @@
 package main
 
 import (
+	"context"
 	_ "embed"
 
 	"github.com/wandb/wandb/core/pkg/gowandb"
@@ -22,10 +23,15 @@ func main() {
 	}
 	defer wandb.Close()
 
-	run, err := wandb.NewRun()
+	ctx := context.Background()
+	run, err := wandb.NewRun(ctx)
 	if err != nil {
 		panic(err)
 	}
-	run.Log(gowandb.History{"acc": 1.0})
-	run.Finish()
+	if err := run.Log(ctx, gowandb.History{"acc": 1.0}); err != nil {
+		panic(err)
+	}
+	if err := run.Finish(ctx); err != nil {
+		panic(err)
+	}
 }
@@ -22,10 +22,16 @@ import (
 // duration is >= max.
 // The jitter is at most 25% of the calculated duration.
 func ExponentialBackoffWithJitter(min, max time.Duration, attemptNum int, resp *http.Response) time.Duration {
+	return backoffWithJitter(DefaultRetryJitter, min, max, attemptNum, resp)
+}
+
+// backoffWithJitter returns min * 2^attemptNum, or the Retry-After duration
+// of a 429 response, plus a random jitter of at most the jitter fraction of
+// it. The result is at most max.
+func backoffWithJitter(jitter float64, min, max time.Duration, attemptNum int, resp *http.Response) time.Duration {
 	// based on go-retryablehttp's DefaultBackoff
 	addJitter := func(duration time.Duration) time.Duration {
-		jitter := SecondsToDuration(rand.Float64() * 0.25 * DurationToSeconds(duration))
-		return duration + jitter
+		return duration + SecondsToDuration(rand.Float64()*jitter*DurationToSeconds(duration))
 	}
 
 	if resp != nil {
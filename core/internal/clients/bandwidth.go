@@ -0,0 +1,96 @@
+package clients
+
+import (
+	"context"
+	"io"
+	"net/http"
+
+	"github.com/hashicorp/go-retryablehttp"
+	"golang.org/x/time/rate"
+)
+
+// maxBandwidthBurst is the largest number of bytes sent at once by a
+// bandwidth limited request
+const maxBandwidthBurst = 64 << 10
+
+// BandwidthLimiter caps the number of bytes per second sent by the requests
+// of the clients that share it.
+type BandwidthLimiter struct {
+	limiter *rate.Limiter
+}
+
+// NewBandwidthLimiter returns a limiter of bytesPerSecond, or nil if the
+// bandwidth is not limited.
+func NewBandwidthLimiter(bytesPerSecond int64) *BandwidthLimiter {
+	if bytesPerSecond <= 0 {
+		return nil
+	}
+	return &BandwidthLimiter{
+		limiter: rate.NewLimiter(rate.Limit(bytesPerSecond), int(min(bytesPerSecond, maxBandwidthBurst))),
+	}
+}
+
+// Limit returns the number of bytes per second.
+func (bl *BandwidthLimiter) Limit() int64 {
+	return int64(bl.limiter.Limit())
+}
+
+// throttledReader reads a request body no faster than the limiter allows
+type throttledReader struct {
+	ctx     context.Context
+	limiter *rate.Limiter
+	wrapped io.ReadCloser
+}
+
+func (r *throttledReader) Read(p []byte) (int, error) {
+	if burst := r.limiter.Burst(); len(p) > burst {
+		p = p[:burst]
+	}
+	n, err := r.wrapped.Read(p)
+	if n > 0 {
+		if waitErr := r.limiter.WaitN(r.ctx, n); waitErr != nil {
+			return n, waitErr
+		}
+	}
+	return n, err
+}
+
+func (r *throttledReader) Close() error {
+	return r.wrapped.Close()
+}
+
+// bandwidthTransport throttles the body of each request
+type bandwidthTransport struct {
+	limiter *BandwidthLimiter
+	wrapped http.RoundTripper
+}
+
+func (t *bandwidthTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.Body != nil && req.Body != http.NoBody {
+		req = req.Clone(req.Context())
+		req.Body = &throttledReader{
+			ctx:     req.Context(),
+			limiter: t.limiter.limiter,
+			wrapped: req.Body,
+		}
+	}
+	return t.wrapped.RoundTrip(req)
+}
+
+// WithRetryClientBandwidthLimiter caps the upload bandwidth of the client,
+// including the retries, with the limiter. A nil limiter is ignored.
+func WithRetryClientBandwidthLimiter(limiter *BandwidthLimiter) RetryClientOption {
+	return func(rc *retryablehttp.Client) {
+		if limiter == nil {
+			return
+		}
+		wrapped := rc.HTTPClient.Transport
+		if wrapped == nil {
+			wrapped = http.DefaultTransport
+		}
+		rc.HTTPClient.Transport = &bandwidthTransport{
+			limiter: limiter,
+			wrapped: wrapped,
+		}
+	}
+}
@@ -0,0 +1,44 @@
+package clients_test
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/wandb/wandb/core/internal/clients"
+)
+
+func TestNewBandwidthLimiterDisabled(t *testing.T) {
+	assert.Nil(t, clients.NewBandwidthLimiter(0))
+	assert.Nil(t, clients.NewBandwidthLimiter(-1))
+	assert.Equal(t, int64(1000), clients.NewBandwidthLimiter(1000).Limit())
+}
+
+func TestWithRetryClientBandwidthLimiter(t *testing.T) {
+	var received int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		received = len(body)
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	client := clients.NewRetryClient(
+		clients.WithRetryClientRetryMax(0),
+		clients.WithRetryClientBandwidthLimiter(clients.NewBandwidthLimiter(256<<10)),
+	)
+
+	// the first 64KiB are sent right away, the other 128KiB take half a second
+	body := bytes.Repeat([]byte("x"), 192<<10)
+	start := time.Now()
+	resp, err := client.Post(server.URL, "application/octet-stream", body)
+	assert.NoError(t, err)
+	resp.Body.Close()
+	assert.Equal(t, len(body), received)
+	assert.GreaterOrEqual(t, time.Since(start), 400*time.Millisecond)
+}
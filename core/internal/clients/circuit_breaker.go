@@ -0,0 +1,218 @@
+package clients
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/go-retryablehttp"
+)
+
+// CircuitBreaker stops the requests to a server that is having an outage.
+//
+// After maxFailures consecutive failed attempts, where an attempt fails if
+// it gets no response or a 5xx response, the breaker opens and the requests
+// wait for the cool-down window to end. A single request then probes the
+// server: the breaker closes if it succeeds, and opens again for another
+// window otherwise.
+//
+// The requests wait before each attempt rather than in the transport, so
+// that the wait does not count towards the timeout of the attempt.
+type CircuitBreaker struct {
+	mu sync.Mutex
+
+	// maxFailures is the number of consecutive failures that open the
+	// breaker
+	maxFailures int
+
+	// cooldown is how long the breaker stays open
+	cooldown time.Duration
+
+	// failures is the number of consecutive failures
+	failures int
+
+	// openUntil is when the open breaker lets a probe through, it is zero
+	// if the breaker is closed
+	openUntil time.Time
+
+	// probeUntil is when the probe in flight is given up on, so that
+	// another request probes the server if it never got an answer
+	probeUntil time.Time
+
+	// changed is closed and replaced when the state changes, to wake up the
+	// waiting requests
+	changed chan struct{}
+
+	// onOpen is called when the closed breaker opens
+	onOpen func(failures int, err error)
+
+	// onClose is called when the open breaker closes
+	onClose func()
+}
+
+// NewCircuitBreaker returns a breaker that opens after maxFailures
+// consecutive failures for cooldown. onOpen is called when it opens after
+// being closed, with the number of failures and the last error, and onClose
+// when it closes again. Either can be nil.
+func NewCircuitBreaker(
+	maxFailures int,
+	cooldown time.Duration,
+	onOpen func(failures int, err error),
+	onClose func(),
+) *CircuitBreaker {
+	return &CircuitBreaker{
+		maxFailures: maxFailures,
+		cooldown:    cooldown,
+		changed:     make(chan struct{}),
+		onOpen:      onOpen,
+		onClose:     onClose,
+	}
+}
+
+// IsOpen returns whether the breaker stops the requests, a nil breaker is
+// always closed.
+func (cb *CircuitBreaker) IsOpen() bool {
+	if cb == nil {
+		return false
+	}
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
+	return !cb.openUntil.IsZero()
+}
+
+// Wait blocks until a request can be made, or the context is done.
+func (cb *CircuitBreaker) Wait(ctx context.Context) error {
+	for {
+		cb.mu.Lock()
+		if cb.openUntil.IsZero() {
+			cb.mu.Unlock()
+			return nil
+		}
+		now := time.Now()
+		wait := max(cb.openUntil.Sub(now), cb.probeUntil.Sub(now))
+		if wait <= 0 {
+			cb.probeUntil = now.Add(cb.cooldown)
+			cb.mu.Unlock()
+			return nil
+		}
+		changed := cb.changed
+		cb.mu.Unlock()
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-timer.C:
+		case <-changed:
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		}
+		timer.Stop()
+	}
+}
+
+// record accounts for the outcome of an attempt
+func (cb *CircuitBreaker) record(failed bool, err error) {
+	cb.mu.Lock()
+	wasOpen := !cb.openUntil.IsZero()
+	if !failed {
+		cb.failures = 0
+		cb.openUntil = time.Time{}
+		cb.probeUntil = time.Time{}
+		cb.notify()
+		cb.mu.Unlock()
+		if wasOpen && cb.onClose != nil {
+			cb.onClose()
+		}
+		return
+	}
+
+	cb.failures++
+	switch {
+	case wasOpen && !cb.probeUntil.IsZero():
+		// the probe failed, wait for another window
+		cb.probeUntil = time.Time{}
+		cb.openUntil = time.Now().Add(cb.cooldown)
+		cb.notify()
+		cb.mu.Unlock()
+	case !wasOpen && cb.failures >= cb.maxFailures:
+		cb.openUntil = time.Now().Add(cb.cooldown)
+		cb.notify()
+		failures := cb.failures
+		cb.mu.Unlock()
+		if cb.onOpen != nil {
+			cb.onOpen(failures, err)
+		}
+	default:
+		cb.mu.Unlock()
+	}
+}
+
+// cancelProbe lets another request probe the server, as the probe was
+// cancelled before it got an answer
+func (cb *CircuitBreaker) cancelProbe() {
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
+	if !cb.probeUntil.IsZero() {
+		cb.probeUntil = time.Time{}
+		cb.notify()
+	}
+}
+
+// notify wakes up the waiting requests, cb.mu must be held
+func (cb *CircuitBreaker) notify() {
+	close(cb.changed)
+	cb.changed = make(chan struct{})
+}
+
+// circuitBreakerTransport reports the outcome of each attempt to a circuit
+// breaker
+type circuitBreakerTransport struct {
+	breaker *CircuitBreaker
+	wrapped http.RoundTripper
+}
+
+func (t *circuitBreakerTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	resp, err := t.wrapped.RoundTrip(req)
+	switch {
+	case err != nil && req.Context().Err() != nil:
+		// a cancelled request says nothing about the server
+		t.breaker.cancelProbe()
+	case err != nil:
+		t.breaker.record(true, err)
+	case resp.StatusCode >= 500 && resp.StatusCode != http.StatusNotImplemented:
+		t.breaker.record(true, fmt.Errorf("the server responded with an error. (Error %d: %s)",
+			resp.StatusCode, http.StatusText(resp.StatusCode)))
+	default:
+		t.breaker.record(false, nil)
+	}
+	return resp, err
+}
+
+// WithRetryClientCircuitBreaker makes each attempt of the client wait for
+// the circuit breaker, and reports its outcome to it. A nil breaker is
+// ignored.
+func WithRetryClientCircuitBreaker(breaker *CircuitBreaker) RetryClientOption {
+	return func(rc *retryablehttp.Client) {
+		if breaker == nil {
+			return
+		}
+		hook := rc.RequestLogHook
+		rc.RequestLogHook = func(logger retryablehttp.Logger, req *http.Request, attempt int) {
+			if hook != nil {
+				hook(logger, req, attempt)
+			}
+			// a cancelled request fails right away when it is attempted
+			_ = breaker.Wait(req.Context())
+		}
+		wrapped := rc.HTTPClient.Transport
+		if wrapped == nil {
+			wrapped = http.DefaultTransport
+		}
+		rc.HTTPClient.Transport = &circuitBreakerTransport{
+			breaker: breaker,
+			wrapped: wrapped,
+		}
+	}
+}
@@ -0,0 +1,108 @@
+package clients_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/wandb/wandb/core/internal/clients"
+)
+
+func TestCircuitBreakerClosed(t *testing.T) {
+	breaker := clients.NewCircuitBreaker(3, time.Hour, nil, nil)
+	assert.False(t, breaker.IsOpen())
+	assert.NoError(t, breaker.Wait(context.Background()))
+
+	// a nil breaker never stops the requests
+	var nilBreaker *clients.CircuitBreaker
+	assert.False(t, nilBreaker.IsOpen())
+}
+
+func TestWithRetryClientCircuitBreaker(t *testing.T) {
+	var healthy atomic.Bool
+	var requests atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		if healthy.Load() {
+			w.WriteHeader(http.StatusOK)
+		} else {
+			w.WriteHeader(http.StatusBadGateway)
+		}
+	}))
+	defer server.Close()
+
+	var opened, closed atomic.Int32
+	var openFailures atomic.Int32
+	cooldown := 100 * time.Millisecond
+	breaker := clients.NewCircuitBreaker(2, cooldown,
+		func(failures int, err error) {
+			opened.Add(1)
+			openFailures.Store(int32(failures))
+			assert.Error(t, err)
+		},
+		func() { closed.Add(1) },
+	)
+	client := clients.NewRetryClient(
+		clients.WithRetryClientRetryMax(0),
+		clients.WithRetryClientCircuitBreaker(breaker),
+	)
+
+	// the breaker opens after the consecutive failures
+	for i := 0; i < 2; i++ {
+		resp, err := client.Get(server.URL)
+		if err == nil {
+			resp.Body.Close()
+		}
+	}
+	assert.True(t, breaker.IsOpen())
+	assert.Equal(t, int32(1), opened.Load())
+	assert.Equal(t, int32(2), openFailures.Load())
+
+	// the requests wait for the cool-down window while it is open
+	ctx, cancel := context.WithTimeout(context.Background(), cooldown/4)
+	defer cancel()
+	assert.True(t, errors.Is(breaker.Wait(ctx), context.DeadlineExceeded))
+	assert.Equal(t, int32(2), requests.Load())
+
+	// a successful probe closes it
+	healthy.Store(true)
+	start := time.Now()
+	resp, err := client.Get(server.URL)
+	assert.NoError(t, err)
+	resp.Body.Close()
+	assert.GreaterOrEqual(t, time.Since(start), cooldown/2)
+	assert.False(t, breaker.IsOpen())
+	assert.Equal(t, int32(1), opened.Load())
+	assert.Equal(t, int32(1), closed.Load())
+}
+
+func TestCircuitBreakerProbeFails(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	var opened atomic.Int32
+	breaker := clients.NewCircuitBreaker(1, 50*time.Millisecond,
+		func(int, error) { opened.Add(1) }, nil)
+	client := clients.NewRetryClient(
+		clients.WithRetryClientRetryMax(0),
+		clients.WithRetryClientCircuitBreaker(breaker),
+	)
+
+	for i := 0; i < 3; i++ {
+		resp, err := client.Get(server.URL)
+		if err == nil {
+			resp.Body.Close()
+		}
+	}
+	// the failed probes keep it open and the user is only warned once
+	assert.True(t, breaker.IsOpen())
+	assert.Equal(t, int32(1), opened.Load())
+}
@@ -65,6 +65,7 @@ func (t *authedTransport) RoundTrip(req *http.Request) (*http.Response, error) {
 
 func NewRetryClient(opts ...RetryClientOption) *retryablehttp.Client {
 	retryClient := retryablehttp.NewClient()
+	retryClient.ErrorHandler = requestErrorHandler
 
 	for _, opt := range opts {
 		opt(retryClient)
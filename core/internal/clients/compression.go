@@ -0,0 +1,158 @@
+package clients
+
+import (
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync/atomic"
+
+	"github.com/hashicorp/go-retryablehttp"
+)
+
+// minCompressedSize is the size of the smallest request body that is
+// compressed, smaller ones barely shrink
+const minCompressedSize = 1 << 10
+
+// CompressionMode is when the request bodies are compressed
+type CompressionMode int
+
+const (
+	// CompressionAuto compresses the request bodies once the server
+	// advertises support for gzip in the Accept-Encoding header of its
+	// responses
+	CompressionAuto CompressionMode = iota
+
+	// CompressionGzip always compresses the request bodies
+	CompressionGzip
+
+	// CompressionNone never compresses the request bodies
+	CompressionNone
+)
+
+// ParseCompressionMode parses the mode of the _request_compression setting:
+// "auto" or empty, "gzip", or "none".
+func ParseCompressionMode(mode string) (CompressionMode, error) {
+	switch strings.ToLower(strings.TrimSpace(mode)) {
+	case "", "auto":
+		return CompressionAuto, nil
+	case "gzip":
+		return CompressionGzip, nil
+	case "none":
+		return CompressionNone, nil
+	default:
+		return CompressionAuto, fmt.Errorf("clients: unsupported request compression %q", mode)
+	}
+}
+
+// Compressor negotiates the compression of the request bodies with the
+// server, it is shared by the clients that talk to the same server.
+type Compressor struct {
+	mode CompressionMode
+
+	// accepted is set once the server has advertised gzip support, and
+	// cleared if it rejects a compressed body
+	accepted atomic.Bool
+}
+
+// NewCompressor returns a compressor in the given mode.
+func NewCompressor(mode CompressionMode) *Compressor {
+	return &Compressor{mode: mode}
+}
+
+// Enabled returns whether the request bodies are compressed.
+func (c *Compressor) Enabled() bool {
+	switch c.mode {
+	case CompressionGzip:
+		return true
+	case CompressionAuto:
+		return c.accepted.Load()
+	default:
+		return false
+	}
+}
+
+// observe updates the negotiation from a response of the server
+func (c *Compressor) observe(resp *http.Response, compressed bool) {
+	if c.mode != CompressionAuto {
+		return
+	}
+	switch {
+	case compressed && resp.StatusCode == http.StatusUnsupportedMediaType:
+		c.accepted.Store(false)
+	case acceptsGzip(resp.Header.Get("Accept-Encoding")):
+		c.accepted.Store(true)
+	}
+}
+
+// acceptsGzip returns whether an Accept-Encoding header lists gzip
+func acceptsGzip(header string) bool {
+	for _, encoding := range strings.Split(header, ",") {
+		name, _, _ := strings.Cut(encoding, ";")
+		if strings.EqualFold(strings.TrimSpace(name), "gzip") {
+			return true
+		}
+	}
+	return false
+}
+
+// compressionTransport compresses the request bodies once the compressor is
+// enabled
+type compressionTransport struct {
+	compressor *Compressor
+	wrapped    http.RoundTripper
+}
+
+func (t *compressionTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	compressed := false
+	if t.compressor.Enabled() && req.Body != nil && req.Body != http.NoBody &&
+		req.Header.Get("Content-Encoding") == "" {
+		body, err := io.ReadAll(req.Body)
+		req.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		req = req.Clone(req.Context())
+		if len(body) < minCompressedSize {
+			req.Body = io.NopCloser(bytes.NewReader(body))
+		} else {
+			var buffer bytes.Buffer
+			writer := gzip.NewWriter(&buffer)
+			if _, err := writer.Write(body); err != nil {
+				return nil, err
+			}
+			if err := writer.Close(); err != nil {
+				return nil, err
+			}
+			req.Body = io.NopCloser(&buffer)
+			req.ContentLength = int64(buffer.Len())
+			req.Header.Set("Content-Encoding", "gzip")
+			compressed = true
+		}
+	}
+	resp, err := t.wrapped.RoundTrip(req)
+	if err == nil {
+		t.compressor.observe(resp, compressed)
+	}
+	return resp, err
+}
+
+// WithRetryClientCompression compresses the request bodies of the client as
+// negotiated by the compressor. A nil compressor is ignored.
+func WithRetryClientCompression(compressor *Compressor) RetryClientOption {
+	return func(rc *retryablehttp.Client) {
+		if compressor == nil {
+			return
+		}
+		wrapped := rc.HTTPClient.Transport
+		if wrapped == nil {
+			wrapped = http.DefaultTransport
+		}
+		rc.HTTPClient.Transport = &compressionTransport{
+			compressor: compressor,
+			wrapped:    wrapped,
+		}
+	}
+}
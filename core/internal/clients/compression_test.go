@@ -0,0 +1,118 @@
+package clients_test
+
+import (
+	"bytes"
+	"compress/gzip"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/wandb/wandb/core/internal/clients"
+)
+
+func TestParseCompressionMode(t *testing.T) {
+	for value, mode := range map[string]clients.CompressionMode{
+		"":     clients.CompressionAuto,
+		"auto": clients.CompressionAuto,
+		"GZIP": clients.CompressionGzip,
+		"none": clients.CompressionNone,
+	} {
+		parsed, err := clients.ParseCompressionMode(value)
+		assert.NoError(t, err)
+		assert.Equal(t, mode, parsed, value)
+	}
+	_, err := clients.ParseCompressionMode("zstd")
+	assert.Error(t, err)
+}
+
+// compressionServer returns a server that records the bodies it receives
+// and the encoding they were sent with
+func compressionServer(t *testing.T, status int, acceptEncoding string) (*httptest.Server, *[]string) {
+	var encodings []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		encoding := r.Header.Get("Content-Encoding")
+		encodings = append(encodings, encoding)
+		body := io.Reader(r.Body)
+		if encoding == "gzip" {
+			reader, err := gzip.NewReader(r.Body)
+			assert.NoError(t, err)
+			body = reader
+		}
+		data, err := io.ReadAll(body)
+		assert.NoError(t, err)
+		assert.True(t, strings.HasPrefix(string(data), "{"))
+		if acceptEncoding != "" {
+			w.Header().Set("Accept-Encoding", acceptEncoding)
+		}
+		w.WriteHeader(status)
+	}))
+	return server, &encodings
+}
+
+func postBody(t *testing.T, client http.RoundTripper, url string, size int) {
+	body := "{" + strings.Repeat("x", size) + "}"
+	req, err := http.NewRequest(http.MethodPost, url, bytes.NewBufferString(body))
+	assert.NoError(t, err)
+	resp, err := (&http.Client{Transport: client}).Do(req)
+	assert.NoError(t, err)
+	resp.Body.Close()
+}
+
+func TestWithRetryClientCompressionAuto(t *testing.T) {
+	server, encodings := compressionServer(t, http.StatusOK, "zstd, gzip;q=0.8")
+	defer server.Close()
+
+	client := clients.NewRetryClient(
+		clients.WithRetryClientRetryMax(0),
+		clients.WithRetryClientCompression(clients.NewCompressor(clients.CompressionAuto)),
+	)
+
+	// the bodies are compressed once the server advertises gzip, unless
+	// they are small
+	postBody(t, client.HTTPClient.Transport, server.URL, 4<<10)
+	postBody(t, client.HTTPClient.Transport, server.URL, 4<<10)
+	postBody(t, client.HTTPClient.Transport, server.URL, 10)
+	assert.Equal(t, []string{"", "gzip", ""}, *encodings)
+}
+
+func TestWithRetryClientCompressionRejected(t *testing.T) {
+	server, encodings := compressionServer(t, http.StatusUnsupportedMediaType, "")
+	defer server.Close()
+
+	compressor := clients.NewCompressor(clients.CompressionAuto)
+	client := clients.NewRetryClient(
+		clients.WithRetryClientRetryMax(0),
+		clients.WithRetryClientCompression(compressor),
+	)
+	accepting := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Accept-Encoding", "gzip")
+	}))
+	defer accepting.Close()
+	postBody(t, client.HTTPClient.Transport, accepting.URL, 10)
+	assert.True(t, compressor.Enabled())
+
+	// a server that rejects a compressed body stops the compression
+	postBody(t, client.HTTPClient.Transport, server.URL, 4<<10)
+	assert.False(t, compressor.Enabled())
+	postBody(t, client.HTTPClient.Transport, server.URL, 4<<10)
+	assert.Equal(t, []string{"gzip", ""}, *encodings)
+}
+
+func TestWithRetryClientCompressionModes(t *testing.T) {
+	server, encodings := compressionServer(t, http.StatusOK, "gzip")
+	defer server.Close()
+
+	for _, mode := range []clients.CompressionMode{clients.CompressionGzip, clients.CompressionNone} {
+		client := clients.NewRetryClient(
+			clients.WithRetryClientRetryMax(0),
+			clients.WithRetryClientCompression(clients.NewCompressor(mode)),
+		)
+		postBody(t, client.HTTPClient.Transport, server.URL, 4<<10)
+		postBody(t, client.HTTPClient.Transport, server.URL, 4<<10)
+	}
+	assert.Equal(t, []string{"gzip", "gzip", "", ""}, *encodings)
+}
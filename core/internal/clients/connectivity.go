@@ -0,0 +1,42 @@
+package clients
+
+import (
+	"net/http"
+
+	"github.com/hashicorp/go-retryablehttp"
+)
+
+// connectivityTransport reports whether the requests reach the server: a
+// request that fails without a response means the server is unreachable,
+// and any response means it is reachable again
+type connectivityTransport struct {
+	report  func(connected bool)
+	wrapped http.RoundTripper
+}
+
+func (t *connectivityTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	resp, err := t.wrapped.RoundTrip(req)
+	switch {
+	case err == nil:
+		t.report(true)
+	case req.Context().Err() == nil:
+		// requests that are cancelled say nothing about the network
+		t.report(false)
+	}
+	return resp, err
+}
+
+// WithRetryClientConnectivity reports to the report function whether each
+// attempt of the client reached the server.
+func WithRetryClientConnectivity(report func(connected bool)) RetryClientOption {
+	return func(rc *retryablehttp.Client) {
+		wrapped := rc.HTTPClient.Transport
+		if wrapped == nil {
+			wrapped = http.DefaultTransport
+		}
+		rc.HTTPClient.Transport = &connectivityTransport{
+			report:  report,
+			wrapped: wrapped,
+		}
+	}
+}
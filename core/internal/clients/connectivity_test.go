@@ -0,0 +1,35 @@
+package clients_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/wandb/wandb/core/internal/clients"
+)
+
+func TestWithRetryClientConnectivity(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+
+	var reports []bool
+	client := clients.NewRetryClient(
+		clients.WithRetryClientRetryMax(0),
+		clients.WithRetryClientConnectivity(func(connected bool) {
+			reports = append(reports, connected)
+		}),
+	)
+
+	// a response means the server is reachable
+	resp, err := client.Get(server.URL)
+	assert.NoError(t, err)
+	resp.Body.Close()
+
+	server.Close()
+	_, err = client.Get(server.URL)
+	assert.Error(t, err)
+	assert.Equal(t, []bool{true, false}, reports)
+}
@@ -0,0 +1,64 @@
+package clients
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/hashicorp/go-retryablehttp"
+)
+
+// RequestError is returned by the retry clients when a request still fails
+// after its last attempt.
+type RequestError struct {
+	// Method and URL are those of the request, if it got a response
+	Method string
+	URL    string
+
+	// Attempts is the number of attempts made
+	Attempts int
+
+	// StatusCode is the status code of the last response, zero if the last
+	// attempt got no response
+	StatusCode int
+
+	// Err is the error of the last attempt, if any
+	Err error
+}
+
+func (e *RequestError) Error() string {
+	message := fmt.Sprintf("giving up after %d attempt(s)", e.Attempts)
+	if e.Method != "" {
+		message = fmt.Sprintf("%s %s %s", e.Method, e.URL, message)
+	}
+	switch {
+	case e.Err != nil:
+		return fmt.Sprintf("%s: %v", message, e.Err)
+	case e.StatusCode != 0:
+		return fmt.Sprintf("%s: %d %s", message, e.StatusCode, http.StatusText(e.StatusCode))
+	default:
+		return message
+	}
+}
+
+func (e *RequestError) Unwrap() error {
+	return e.Err
+}
+
+// requestErrorHandler is the error handler of the retry clients, it keeps
+// the status code of the last response in the returned error
+func requestErrorHandler(resp *http.Response, err error, attempts int) (*http.Response, error) {
+	requestErr := &RequestError{Attempts: attempts, Err: err}
+	if resp != nil {
+		requestErr.StatusCode = resp.StatusCode
+		if resp.Request != nil {
+			requestErr.Method = resp.Request.Method
+			requestErr.URL = resp.Request.URL.String()
+		}
+		_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 4096))
+		resp.Body.Close()
+	}
+	return nil, requestErr
+}
+
+var _ retryablehttp.ErrorHandler = requestErrorHandler
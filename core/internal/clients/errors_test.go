@@ -0,0 +1,49 @@
+package clients_test
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/wandb/wandb/core/internal/clients"
+)
+
+func TestRetryClientRequestError(t *testing.T) {
+	var attempts int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	client := clients.NewRetryClient(
+		clients.WithRetryClientRetryMax(1),
+		clients.WithRetryClientRetryWaitMin(0),
+		clients.WithRetryClientRetryWaitMax(0),
+	)
+	_, err := client.StandardClient().Get(server.URL)
+
+	var requestErr *clients.RequestError
+	assert.True(t, errors.As(err, &requestErr))
+	assert.Equal(t, http.StatusServiceUnavailable, requestErr.StatusCode)
+	assert.Equal(t, 2, requestErr.Attempts)
+	assert.Equal(t, 2, attempts)
+	assert.ErrorContains(t, err, "GET "+server.URL+" giving up after 2 attempt(s)")
+}
+
+func TestRetryClientRequestErrorNoResponse(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	url := server.URL
+	server.Close()
+
+	client := clients.NewRetryClient(clients.WithRetryClientRetryMax(0))
+	_, err := client.StandardClient().Get(url)
+
+	var requestErr *clients.RequestError
+	assert.True(t, errors.As(err, &requestErr))
+	assert.Zero(t, requestErr.StatusCode)
+	assert.Error(t, requestErr.Err)
+}
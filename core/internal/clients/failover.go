@@ -0,0 +1,164 @@
+package clients
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/go-retryablehttp"
+)
+
+// Failover sends the requests to the endpoints of a server in priority
+// order.
+//
+// The requests are made to the first endpoint, the primary. Once an
+// endpoint is unreachable, that is an attempt gets no response, the requests
+// go to the next endpoint that is not down. A down endpoint is tried again
+// once recoverAfter passed, so that the requests return to the primary when
+// it recovers.
+type Failover struct {
+	mu sync.Mutex
+
+	// endpoints are the base URLs of the server, in priority order
+	endpoints []string
+
+	// downSince is when each endpoint was found unreachable, zero if it is
+	// reachable
+	downSince []time.Time
+
+	// recoverAfter is how long a down endpoint is skipped
+	recoverAfter time.Duration
+
+	// active is the index of the endpoint of the last attempt
+	active int
+
+	// onSwitch is called when the requests go to another endpoint
+	onSwitch func(from, to string)
+}
+
+// NewFailover returns a failover among the base URLs, in priority order. A
+// down endpoint is tried again after recoverAfter. onSwitch is called when
+// the requests go to another endpoint, it can be nil.
+func NewFailover(baseURLs []string, recoverAfter time.Duration, onSwitch func(from, to string)) (*Failover, error) {
+	if len(baseURLs) == 0 {
+		return nil, fmt.Errorf("clients: failover without endpoints")
+	}
+	endpoints := make([]string, len(baseURLs))
+	for i, baseURL := range baseURLs {
+		parsed, err := url.Parse(baseURL)
+		if err != nil || parsed.Scheme == "" || parsed.Host == "" {
+			return nil, fmt.Errorf("clients: invalid failover endpoint %q", baseURL)
+		}
+		endpoints[i] = strings.TrimSuffix(baseURL, "/")
+	}
+	return &Failover{
+		endpoints:    endpoints,
+		downSince:    make([]time.Time, len(endpoints)),
+		recoverAfter: recoverAfter,
+		onSwitch:     onSwitch,
+	}, nil
+}
+
+// Endpoint returns the base URL the requests are sent to
+func (f *Failover) Endpoint() string {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.endpoints[f.active]
+}
+
+// next returns the index of the endpoint of the next attempt: the first
+// endpoint that is up or due to be tried again, else the one down for the
+// longest time
+func (f *Failover) next() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	now := time.Now()
+	index := -1
+	for i, since := range f.downSince {
+		if since.IsZero() || now.Sub(since) >= f.recoverAfter {
+			index = i
+			break
+		}
+		if index < 0 || since.Before(f.downSince[index]) {
+			index = i
+		}
+	}
+	if index != f.active {
+		from := f.endpoints[f.active]
+		f.active = index
+		if f.onSwitch != nil {
+			f.onSwitch(from, f.endpoints[index])
+		}
+	}
+	return index
+}
+
+// report records whether an endpoint is reachable
+func (f *Failover) report(index int, reachable bool) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	switch {
+	case reachable:
+		f.downSince[index] = time.Time{}
+	case f.downSince[index].IsZero() || time.Since(f.downSince[index]) >= f.recoverAfter:
+		// a failed retry of a down endpoint skips it for another period
+		f.downSince[index] = time.Now()
+	}
+}
+
+// failoverTransport sends the requests to the primary endpoint to the
+// active one. An attempt that gets no response marks its endpoint as down,
+// so that the retry goes to the next one.
+type failoverTransport struct {
+	failover *Failover
+	wrapped  http.RoundTripper
+}
+
+func (t *failoverTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	primary := t.failover.endpoints[0]
+	raw := req.URL.String()
+	if raw != primary && !strings.HasPrefix(raw, primary+"/") && !strings.HasPrefix(raw, primary+"?") {
+		return t.wrapped.RoundTrip(req)
+	}
+
+	index := t.failover.next()
+	if index != 0 {
+		rewritten, err := url.Parse(t.failover.endpoints[index] + strings.TrimPrefix(raw, primary))
+		if err != nil {
+			return nil, err
+		}
+		req = req.Clone(req.Context())
+		req.URL = rewritten
+		req.Host = ""
+	}
+	resp, err := t.wrapped.RoundTrip(req)
+	switch {
+	case err == nil:
+		t.failover.report(index, true)
+	case req.Context().Err() == nil:
+		// requests that are cancelled say nothing about the endpoint
+		t.failover.report(index, false)
+	}
+	return resp, err
+}
+
+// WithRetryClientFailover sends the requests to the primary endpoint of the
+// failover to its active endpoint. A nil failover is ignored.
+func WithRetryClientFailover(failover *Failover) RetryClientOption {
+	return func(rc *retryablehttp.Client) {
+		if failover == nil {
+			return
+		}
+		wrapped := rc.HTTPClient.Transport
+		if wrapped == nil {
+			wrapped = http.DefaultTransport
+		}
+		rc.HTTPClient.Transport = &failoverTransport{
+			failover: failover,
+			wrapped:  wrapped,
+		}
+	}
+}
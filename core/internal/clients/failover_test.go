@@ -0,0 +1,95 @@
+package clients_test
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-retryablehttp"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/wandb/wandb/core/internal/clients"
+)
+
+// downTransport fails the requests to a host while it is down
+type downTransport struct {
+	host string
+	down atomic.Bool
+}
+
+func (t *downTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if t.down.Load() && req.URL.Host == t.host {
+		return nil, errors.New("connection refused")
+	}
+	return http.DefaultTransport.RoundTrip(req)
+}
+
+// echoServer answers with its name and the path and body of the requests
+func echoServer(name string) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		_, _ = io.WriteString(w, name+" "+r.URL.Path+" "+string(body))
+	}))
+}
+
+func TestWithRetryClientFailover(t *testing.T) {
+	primary := echoServer("primary")
+	defer primary.Close()
+	secondary := echoServer("secondary")
+	defer secondary.Close()
+	other := echoServer("other")
+	defer other.Close()
+
+	var switches []string
+	failover, err := clients.NewFailover([]string{primary.URL, secondary.URL + "/"}, 50*time.Millisecond,
+		func(from, to string) { switches = append(switches, to) })
+	assert.NoError(t, err)
+
+	transport := &downTransport{host: strings.TrimPrefix(primary.URL, "http://")}
+	client := clients.NewRetryClient(
+		clients.WithRetryClientRetryMax(1),
+		clients.WithRetryClientRetryWaitMin(0),
+		clients.WithRetryClientRetryWaitMax(0),
+		clients.WithRetryClientHttpTransport(transport),
+		clients.WithRetryClientFailover(failover),
+	)
+	post := func(url string) string {
+		req, err := retryablehttp.NewRequest(http.MethodPost, url, []byte("body"))
+		assert.NoError(t, err)
+		resp, err := client.Do(req)
+		assert.NoError(t, err)
+		defer resp.Body.Close()
+		body, _ := io.ReadAll(resp.Body)
+		return string(body)
+	}
+
+	assert.Equal(t, "primary /graphql body", post(primary.URL+"/graphql"))
+
+	// the retry of a request to the unreachable primary goes to the
+	// secondary, and so do the next requests
+	transport.down.Store(true)
+	assert.Equal(t, "secondary /graphql body", post(primary.URL+"/graphql"))
+	assert.Equal(t, "secondary /files/run body", post(primary.URL+"/files/run"))
+	assert.Equal(t, secondary.URL, failover.Endpoint())
+
+	// the requests to other servers are not rewritten
+	assert.Equal(t, "other /upload body", post(other.URL+"/upload"))
+
+	// the requests return to the primary once it recovered
+	transport.down.Store(false)
+	time.Sleep(50 * time.Millisecond)
+	assert.Equal(t, "primary /graphql body", post(primary.URL+"/graphql"))
+	assert.Equal(t, []string{secondary.URL, primary.URL}, switches)
+}
+
+func TestNewFailoverInvalid(t *testing.T) {
+	_, err := clients.NewFailover(nil, time.Second, nil)
+	assert.Error(t, err)
+	_, err = clients.NewFailover([]string{"https://api.wandb.ai", "not a url"}, time.Second, nil)
+	assert.Error(t, err)
+}
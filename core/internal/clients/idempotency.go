@@ -0,0 +1,57 @@
+package clients
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/hashicorp/go-retryablehttp"
+
+	"github.com/wandb/wandb/core/internal/shared"
+)
+
+// IdempotencyKeyHeader is the header of the idempotency key of a request,
+// the server applies a mutation once for all the requests with the same key
+const IdempotencyKeyHeader = "Idempotency-Key"
+
+// CtxIdempotencyKey is the context key of the idempotency key of a request
+const CtxIdempotencyKey ContextKey = "idempotencyKey"
+
+// idempotencyKeyLength is the length of the generated idempotency keys
+const idempotencyKeyLength = 32
+
+// WithIdempotencyKey returns a context whose requests carry a new
+// idempotency key. The retries of a request keep its key, so that a mutation
+// retried after a timeout is not applied twice.
+//
+// A context must be made for each mutation, as the server returns the
+// result of the first request with a key to the following ones.
+func WithIdempotencyKey(ctx context.Context) context.Context {
+	return context.WithValue(ctx, CtxIdempotencyKey, shared.ShortID(idempotencyKeyLength))
+}
+
+// idempotencyTransport sets the idempotency key of the context of the
+// requests as their Idempotency-Key header
+type idempotencyTransport struct {
+	wrapped http.RoundTripper
+}
+
+func (t *idempotencyTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if key, ok := req.Context().Value(CtxIdempotencyKey).(string); ok && key != "" &&
+		req.Header.Get(IdempotencyKeyHeader) == "" {
+		req = req.Clone(req.Context())
+		req.Header.Set(IdempotencyKeyHeader, key)
+	}
+	return t.wrapped.RoundTrip(req)
+}
+
+// WithRetryClientIdempotencyKeys sends the idempotency keys of the contexts
+// of the requests, see WithIdempotencyKey.
+func WithRetryClientIdempotencyKeys() RetryClientOption {
+	return func(rc *retryablehttp.Client) {
+		wrapped := rc.HTTPClient.Transport
+		if wrapped == nil {
+			wrapped = http.DefaultTransport
+		}
+		rc.HTTPClient.Transport = &idempotencyTransport{wrapped: wrapped}
+	}
+}
@@ -0,0 +1,50 @@
+package clients_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/go-retryablehttp"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/wandb/wandb/core/internal/clients"
+)
+
+func TestWithRetryClientIdempotencyKeys(t *testing.T) {
+	var keys []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		keys = append(keys, r.Header.Get(clients.IdempotencyKeyHeader))
+		if len(keys) == 2 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}
+	}))
+	defer server.Close()
+
+	client := clients.NewRetryClient(
+		clients.WithRetryClientRetryMax(1),
+		clients.WithRetryClientRetryWaitMin(0),
+		clients.WithRetryClientRetryWaitMax(0),
+		clients.WithRetryClientIdempotencyKeys(),
+	)
+	post := func(ctx context.Context) {
+		req, err := retryablehttp.NewRequestWithContext(ctx, http.MethodPost, server.URL, []byte("{}"))
+		assert.NoError(t, err)
+		resp, err := client.Do(req)
+		assert.NoError(t, err)
+		resp.Body.Close()
+	}
+
+	// a request without a key is sent as is, the retries of one with a key
+	// keep it
+	post(context.Background())
+	post(clients.WithIdempotencyKey(context.Background()))
+	post(clients.WithIdempotencyKey(context.Background()))
+
+	assert.Len(t, keys, 4)
+	assert.Empty(t, keys[0])
+	assert.Len(t, keys[1], 32)
+	assert.Equal(t, keys[1], keys[2])
+	assert.NotEqual(t, keys[2], keys[3])
+}
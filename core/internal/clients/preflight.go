@@ -0,0 +1,226 @@
+package clients
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/segmentio/encoding/json"
+)
+
+// defaultPreflightTimeout is how long each stage of the preflight can take
+const defaultPreflightTimeout = 10 * time.Second
+
+// PreflightStage is a layer of the connection to the backend, the stages are
+// checked in order
+type PreflightStage string
+
+const (
+	PreflightDNS  PreflightStage = "dns"
+	PreflightTCP  PreflightStage = "tcp"
+	PreflightTLS  PreflightStage = "tls"
+	PreflightAuth PreflightStage = "auth"
+)
+
+// preflightHints tell what to look at when a stage fails
+var preflightHints = map[PreflightStage]string{
+	PreflightDNS:  "check the base_url setting, and that its host resolves on this network",
+	PreflightTCP:  "check that a firewall allows the connection, and the HTTPS_PROXY, HTTP_PROXY and NO_PROXY settings",
+	PreflightTLS:  "check the certificate of the server, and the _ca_bundle_path setting or the REQUESTS_CA_BUNDLE variable",
+	PreflightAuth: "check the API key, with wandb login or the WANDB_API_KEY variable",
+}
+
+// PreflightCheck is the outcome of a stage of the preflight.
+type PreflightCheck struct {
+	Stage PreflightStage
+
+	// Address is what the stage checked, the proxy if the requests go
+	// through one
+	Address string
+
+	Duration time.Duration
+
+	// Err is why the stage failed, nil if it passed
+	Err error
+}
+
+// Hint returns what to look at if the stage failed, empty if it passed
+func (c PreflightCheck) Hint() string {
+	if c.Err == nil {
+		return ""
+	}
+	return preflightHints[c.Stage]
+}
+
+// PreflightConfig is the connection to the backend to check.
+type PreflightConfig struct {
+	BaseURL string
+	APIKey  string
+
+	// Transport is the transport of the clients, with their proxies and
+	// certificates
+	Transport *http.Transport
+
+	// Timeout is how long each stage can take
+	Timeout time.Duration
+}
+
+// Preflight checks the layers of the connection to the backend in order: the
+// name resolution, the TCP connection, the TLS handshake and the API key. It
+// stops at the first stage that fails, and returns the checks made.
+func Preflight(ctx context.Context, config PreflightConfig) []PreflightCheck {
+	target, err := url.Parse(config.BaseURL)
+	if err != nil || target.Host == "" {
+		return []PreflightCheck{{
+			Stage:   PreflightDNS,
+			Address: config.BaseURL,
+			Err:     fmt.Errorf("clients: invalid base URL %q", config.BaseURL),
+		}}
+	}
+	transport := config.Transport
+	if transport == nil {
+		transport = http.DefaultTransport.(*http.Transport)
+	}
+	timeout := config.Timeout
+	if timeout <= 0 {
+		timeout = defaultPreflightTimeout
+	}
+
+	// the connection is made to the proxy of the requests, if any
+	address := hostPort(target)
+	proxied := false
+	if transport.Proxy != nil {
+		proxy, err := transport.Proxy(&http.Request{URL: target})
+		if err == nil && proxy != nil {
+			address = hostPort(proxy)
+			proxied = true
+		}
+	}
+	host, _, _ := net.SplitHostPort(address)
+
+	var checks []PreflightCheck
+	check := func(stage PreflightStage, address string, f func(ctx context.Context) error) bool {
+		ctx, cancel := context.WithTimeout(ctx, timeout)
+		defer cancel()
+		start := time.Now()
+		err := f(ctx)
+		checks = append(checks, PreflightCheck{
+			Stage:    stage,
+			Address:  address,
+			Duration: time.Since(start),
+			Err:      err,
+		})
+		return err == nil
+	}
+
+	if !check(PreflightDNS, host, func(ctx context.Context) error {
+		_, err := net.DefaultResolver.LookupHost(ctx, host)
+		return err
+	}) {
+		return checks
+	}
+
+	var conn net.Conn
+	if !check(PreflightTCP, address, func(ctx context.Context) error {
+		var err error
+		conn, err = (&net.Dialer{}).DialContext(ctx, "tcp", address)
+		return err
+	}) {
+		return checks
+	}
+	defer conn.Close()
+
+	if target.Scheme == "https" {
+		if !check(PreflightTLS, hostPort(target), func(ctx context.Context) error {
+			// the handshake of the proxied requests is made through the
+			// proxy, any response means it succeeded
+			if proxied {
+				return checkResponse(ctx, transport, config.BaseURL)
+			}
+			tlsConfig := &tls.Config{}
+			if transport.TLSClientConfig != nil {
+				tlsConfig = transport.TLSClientConfig.Clone()
+			}
+			tlsConfig.ServerName = target.Hostname()
+			return tls.Client(conn, tlsConfig).HandshakeContext(ctx)
+		}) {
+			return checks
+		}
+	}
+
+	check(PreflightAuth, config.BaseURL, func(ctx context.Context) error {
+		return checkAuth(ctx, transport, config)
+	})
+	return checks
+}
+
+// hostPort returns the address of the host of a URL, with the default port
+// of its scheme
+func hostPort(u *url.URL) string {
+	port := u.Port()
+	if port == "" {
+		port = "80"
+		if u.Scheme == "https" {
+			port = "443"
+		}
+	}
+	return net.JoinHostPort(u.Hostname(), port)
+}
+
+// checkResponse returns whether the server answers a request
+func checkResponse(ctx context.Context, transport http.RoundTripper, baseURL string) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodHead, baseURL, nil)
+	if err != nil {
+		return err
+	}
+	resp, err := transport.RoundTrip(req)
+	if err != nil {
+		return err
+	}
+	return resp.Body.Close()
+}
+
+// checkAuth asks the backend for the user of the API key
+func checkAuth(ctx context.Context, transport http.RoundTripper, config PreflightConfig) error {
+	if config.APIKey == "" {
+		return fmt.Errorf("clients: no API key")
+	}
+	body := strings.NewReader(`{"operationName":"Viewer","query":"query Viewer { viewer { id } }"}`)
+	url := strings.TrimSuffix(config.BaseURL, "/") + "/graphql"
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, body)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := (&authedTransport{key: config.APIKey, wrapped: transport}).RoundTrip(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	switch {
+	case resp.StatusCode == http.StatusUnauthorized || resp.StatusCode == http.StatusForbidden:
+		return fmt.Errorf("clients: the API key was rejected: %s", resp.Status)
+	case resp.StatusCode >= 300:
+		return fmt.Errorf("clients: unexpected response of the server: %s", resp.Status)
+	}
+	var data struct {
+		Data struct {
+			Viewer *struct {
+				ID string `json:"id"`
+			} `json:"viewer"`
+		} `json:"data"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&data); err != nil {
+		return fmt.Errorf("clients: invalid response of the server: %v", err)
+	}
+	if data.Data.Viewer == nil {
+		return fmt.Errorf("clients: the API key is not valid")
+	}
+	return nil
+}
@@ -0,0 +1,93 @@
+package clients_test
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/wandb/wandb/core/internal/clients"
+)
+
+// viewerHandler answers the GraphQL requests with a user if the API key is
+// the right one
+func viewerHandler(key string) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if _, password, _ := r.BasicAuth(); password != key {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		_, _ = io.WriteString(w, `{"data":{"viewer":{"id":"user"}}}`)
+	})
+}
+
+// preflightStages returns the stages of the checks, and the error of the last
+func preflightStages(checks []clients.PreflightCheck) ([]clients.PreflightStage, error) {
+	var stages []clients.PreflightStage
+	for _, check := range checks {
+		stages = append(stages, check.Stage)
+	}
+	if len(checks) == 0 {
+		return stages, nil
+	}
+	return stages, checks[len(checks)-1].Err
+}
+
+func TestPreflight(t *testing.T) {
+	server := httptest.NewTLSServer(viewerHandler("key"))
+	defer server.Close()
+	pool := x509.NewCertPool()
+	pool.AddCert(server.Certificate())
+	transport := &http.Transport{TLSClientConfig: &tls.Config{RootCAs: pool}}
+
+	checks := clients.Preflight(context.Background(), clients.PreflightConfig{
+		BaseURL:   server.URL,
+		APIKey:    "key",
+		Transport: transport,
+	})
+	stages, err := preflightStages(checks)
+	assert.Equal(t, []clients.PreflightStage{
+		clients.PreflightDNS, clients.PreflightTCP, clients.PreflightTLS, clients.PreflightAuth,
+	}, stages)
+	assert.Nil(t, err)
+	assert.Empty(t, checks[3].Hint())
+}
+
+func TestPreflightFailures(t *testing.T) {
+	untrusted := httptest.NewTLSServer(viewerHandler("key"))
+	defer untrusted.Close()
+	plain := httptest.NewServer(viewerHandler("key"))
+	defer plain.Close()
+	closed := httptest.NewServer(viewerHandler("key"))
+	closed.Close()
+
+	testCases := []struct {
+		name    string
+		baseURL string
+		stage   clients.PreflightStage
+		hint    string
+	}{
+		{"invalid URL", "not a url", clients.PreflightDNS, "base_url"},
+		{"refused", closed.URL, clients.PreflightTCP, "firewall"},
+		{"untrusted certificate", untrusted.URL, clients.PreflightTLS, "certificate"},
+		{"rejected key", plain.URL, clients.PreflightAuth, "API key"},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			checks := clients.Preflight(context.Background(), clients.PreflightConfig{
+				BaseURL:   tc.baseURL,
+				APIKey:    "wrong",
+				Transport: &http.Transport{},
+			})
+			stages, err := preflightStages(checks)
+			assert.Equal(t, tc.stage, stages[len(stages)-1])
+			assert.NotNil(t, err)
+			assert.Contains(t, checks[len(checks)-1].Hint(), tc.hint)
+		})
+	}
+}
@@ -0,0 +1,207 @@
+package clients
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/go-retryablehttp"
+	"golang.org/x/time/rate"
+)
+
+const (
+	// throttledRate is the rate of requests after the first 429 response that
+	// does not say how long to wait
+	throttledRate = rate.Limit(1)
+
+	// minRate is the lowest rate the limiter slows down to
+	minRate = rate.Limit(0.05)
+
+	// maxRate is the rate above which the requests are no longer limited
+	maxRate = rate.Limit(10)
+
+	// rateIncrease is how much the rate increases after each successful
+	// response while it is limited
+	rateIncrease = rate.Limit(0.1)
+
+	// epochThreshold is the value above which a reset header is a unix time
+	// rather than a number of seconds
+	epochThreshold = 1_000_000_000
+)
+
+// RateLimiter adapts the rate of requests to the rate limits of the server.
+//
+// It pauses the requests for the duration of the Retry-After header of a 429
+// or 503 response, and paces them to the quota described by the RateLimit
+// headers (RateLimit-Remaining and RateLimit-Reset, or their X- variants).
+// Without these headers, it halves the rate on every 429 response and slowly
+// increases it back on successful responses, until the requests are no
+// longer limited.
+type RateLimiter struct {
+	mu sync.Mutex
+
+	limiter *rate.Limiter
+
+	// pausedUntil is when the requests can be made again after the server
+	// asked to wait
+	pausedUntil time.Time
+}
+
+// NewRateLimiter returns a rate limiter that does not limit the requests
+// until the server asks it to.
+func NewRateLimiter() *RateLimiter {
+	return &RateLimiter{
+		limiter: rate.NewLimiter(rate.Inf, 1),
+	}
+}
+
+// Limit returns the current rate of requests per second.
+func (rl *RateLimiter) Limit() rate.Limit {
+	return rl.limiter.Limit()
+}
+
+// PausedUntil returns until when the server asked to wait, it is in the past
+// if the requests are not paused.
+func (rl *RateLimiter) PausedUntil() time.Time {
+	rl.mu.Lock()
+	defer rl.mu.Unlock()
+	return rl.pausedUntil
+}
+
+// Wait blocks until a request can be made, or the context is done.
+func (rl *RateLimiter) Wait(ctx context.Context) error {
+	if wait := time.Until(rl.PausedUntil()); wait > 0 {
+		timer := time.NewTimer(wait)
+		defer timer.Stop()
+		select {
+		case <-timer.C:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+	return rl.limiter.Wait(ctx)
+}
+
+// Observe adapts the rate to a response of the server.
+func (rl *RateLimiter) Observe(resp *http.Response) {
+	rl.mu.Lock()
+	defer rl.mu.Unlock()
+
+	now := time.Now()
+	throttled := resp.StatusCode == http.StatusTooManyRequests
+	if throttled || resp.StatusCode == http.StatusServiceUnavailable {
+		if wait, ok := parseRetryAfter(resp.Header.Get("Retry-After"), now); ok {
+			rl.pause(now.Add(wait))
+		}
+	}
+
+	remaining, okRemaining := rateLimitHeader(resp.Header, "RateLimit-Remaining")
+	reset, okReset := rateLimitHeader(resp.Header, "RateLimit-Reset")
+	switch {
+	case okRemaining && okReset:
+		if reset > epochThreshold {
+			reset = max(0, float64(time.Unix(int64(reset), 0).Sub(now))/float64(time.Second))
+		}
+		if remaining < 1 {
+			rl.pause(now.Add(SecondsToDuration(reset)))
+		} else if reset > 0 {
+			rl.setLimit(rate.Limit(remaining / reset))
+		}
+	case throttled:
+		if limit := rl.limiter.Limit(); limit == rate.Inf {
+			rl.setLimit(throttledRate)
+		} else {
+			rl.setLimit(limit / 2)
+		}
+	case resp.StatusCode < 400:
+		if limit := rl.limiter.Limit(); limit != rate.Inf {
+			rl.setLimit(limit + rateIncrease)
+		}
+	}
+}
+
+// pause pauses the requests until the given time, rl.mu must be held
+func (rl *RateLimiter) pause(until time.Time) {
+	if until.After(rl.pausedUntil) {
+		rl.pausedUntil = until
+	}
+}
+
+// setLimit sets the rate within the bounds, the rate is no longer limited
+// above maxRate
+func (rl *RateLimiter) setLimit(limit rate.Limit) {
+	switch {
+	case limit > maxRate:
+		limit = rate.Inf
+	case limit < minRate:
+		limit = minRate
+	}
+	rl.limiter.SetLimit(limit)
+}
+
+// parseRetryAfter parses a Retry-After header, which is either a number of
+// seconds or an HTTP date
+func parseRetryAfter(value string, now time.Time) (time.Duration, bool) {
+	if value == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.ParseFloat(value, 64); err == nil {
+		return SecondsToDuration(max(0, seconds)), true
+	}
+	if date, err := http.ParseTime(value); err == nil {
+		return max(0, date.Sub(now)), true
+	}
+	return 0, false
+}
+
+// rateLimitHeader returns the value of a rate limit header, or of its X-
+// variant
+func rateLimitHeader(header http.Header, name string) (float64, bool) {
+	value := header.Get(name)
+	if value == "" {
+		value = header.Get("X-" + name)
+	}
+	if value == "" {
+		return 0, false
+	}
+	number, err := strconv.ParseFloat(value, 64)
+	if err != nil || number < 0 {
+		return 0, false
+	}
+	return number, true
+}
+
+// rateLimitedTransport waits for the rate limiter before each request, and
+// adapts it to each response
+type rateLimitedTransport struct {
+	limiter *RateLimiter
+	wrapped http.RoundTripper
+}
+
+func (t *rateLimitedTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if err := t.limiter.Wait(req.Context()); err != nil {
+		return nil, err
+	}
+	resp, err := t.wrapped.RoundTrip(req)
+	if err == nil {
+		t.limiter.Observe(resp)
+	}
+	return resp, err
+}
+
+// WithRetryClientRateLimiter limits the rate of the requests of the client,
+// including the retries, with the rate limiter.
+func WithRetryClientRateLimiter(limiter *RateLimiter) RetryClientOption {
+	return func(rc *retryablehttp.Client) {
+		wrapped := rc.HTTPClient.Transport
+		if wrapped == nil {
+			wrapped = http.DefaultTransport
+		}
+		rc.HTTPClient.Transport = &rateLimitedTransport{
+			limiter: limiter,
+			wrapped: wrapped,
+		}
+	}
+}
@@ -0,0 +1,113 @@
+package clients_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/time/rate"
+
+	"github.com/wandb/wandb/core/internal/clients"
+)
+
+func response(statusCode int, header ...string) *http.Response {
+	resp := &http.Response{StatusCode: statusCode, Header: make(http.Header)}
+	for i := 0; i+1 < len(header); i += 2 {
+		resp.Header.Set(header[i], header[i+1])
+	}
+	return resp
+}
+
+func TestRateLimiterRetryAfter(t *testing.T) {
+	limiter := clients.NewRateLimiter()
+	limiter.Observe(response(http.StatusTooManyRequests, "Retry-After", "30"))
+	assert.WithinDuration(t, time.Now().Add(30*time.Second), limiter.PausedUntil(), time.Second)
+
+	limiter = clients.NewRateLimiter()
+	date := time.Now().Add(time.Minute).UTC().Format(http.TimeFormat)
+	limiter.Observe(response(http.StatusServiceUnavailable, "Retry-After", date))
+	assert.WithinDuration(t, time.Now().Add(time.Minute), limiter.PausedUntil(), 2*time.Second)
+
+	// the header is only honored on throttled responses
+	limiter = clients.NewRateLimiter()
+	limiter.Observe(response(http.StatusOK, "Retry-After", "30"))
+	assert.True(t, limiter.PausedUntil().IsZero())
+}
+
+func TestRateLimiterHeaders(t *testing.T) {
+	limiter := clients.NewRateLimiter()
+	limiter.Observe(response(http.StatusOK, "RateLimit-Remaining", "10", "RateLimit-Reset", "20"))
+	assert.Equal(t, rate.Limit(0.5), limiter.Limit())
+
+	reset := time.Now().Add(10 * time.Second).Unix()
+	// the reset is a unix time rather than a number of seconds
+	limiter.Observe(response(http.StatusOK,
+		"X-RateLimit-Remaining", "0", "X-RateLimit-Reset", strconv.FormatInt(reset, 10)))
+	assert.WithinDuration(t, time.Unix(reset, 0), limiter.PausedUntil(), time.Second)
+
+	// a quota that is far from exhausted is not limited
+	limiter.Observe(response(http.StatusOK, "RateLimit-Remaining", "1000", "RateLimit-Reset", "1"))
+	assert.Equal(t, rate.Inf, limiter.Limit())
+}
+
+func TestRateLimiterAdaptive(t *testing.T) {
+	limiter := clients.NewRateLimiter()
+	assert.Equal(t, rate.Inf, limiter.Limit())
+
+	limiter.Observe(response(http.StatusTooManyRequests))
+	assert.Equal(t, rate.Limit(1), limiter.Limit())
+	limiter.Observe(response(http.StatusTooManyRequests))
+	assert.Equal(t, rate.Limit(0.5), limiter.Limit())
+
+	// successful responses slowly lift the limit
+	limiter.Observe(response(http.StatusOK))
+	assert.InDelta(t, 0.6, float64(limiter.Limit()), 1e-9)
+	for i := 0; i < 100; i++ {
+		limiter.Observe(response(http.StatusOK))
+	}
+	assert.Equal(t, rate.Inf, limiter.Limit())
+}
+
+func TestRateLimiterWait(t *testing.T) {
+	limiter := clients.NewRateLimiter()
+	limiter.Observe(response(http.StatusTooManyRequests, "Retry-After", "60"))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	assert.ErrorIs(t, limiter.Wait(ctx), context.DeadlineExceeded)
+}
+
+func TestWithRetryClientRateLimiter(t *testing.T) {
+	var attempts atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if attempts.Add(1) == 1 {
+			w.Header().Set("Retry-After", "0.2")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	limiter := clients.NewRateLimiter()
+	client := clients.NewRetryClient(
+		clients.WithRetryClientRateLimiter(limiter),
+		clients.WithRetryClientRetryMax(1),
+		// retry right away, so that only the limiter waits
+		clients.WithRetryClientBackoff(func(time.Duration, time.Duration, int, *http.Response) time.Duration {
+			return 0
+		}),
+	)
+	start := time.Now()
+	resp, err := client.Get(server.URL)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	resp.Body.Close()
+	assert.Equal(t, int32(2), attempts.Load())
+	assert.GreaterOrEqual(t, time.Since(start), 200*time.Millisecond)
+}
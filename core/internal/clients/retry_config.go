@@ -0,0 +1,88 @@
+package clients
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-retryablehttp"
+)
+
+// DefaultRetryJitter is the maximum fraction of the backoff added as jitter
+// when it is not configured
+const DefaultRetryJitter = 0.25
+
+// RetryConfig configures how a retry client retries the failed requests.
+type RetryConfig struct {
+	// MaxRetries is the maximum number of retries after the first attempt
+	MaxRetries int
+
+	// WaitMin is the base of the exponential backoff
+	WaitMin time.Duration
+
+	// WaitMax is the longest backoff between two attempts
+	WaitMax time.Duration
+
+	// Jitter is the maximum fraction of the backoff added to it at random
+	Jitter float64
+
+	// Timeout is the timeout of each attempt, zero means no timeout
+	Timeout time.Duration
+
+	// RetryableStatusCodes are the only status codes retried if not empty,
+	// otherwise DefaultRetryPolicy decides which responses are retried
+	RetryableStatusCodes []int
+}
+
+// ParseRetryStatusCodes parses a list of HTTP status codes.
+func ParseRetryStatusCodes(values []string) ([]int, error) {
+	codes := make([]int, 0, len(values))
+	for _, value := range values {
+		code, err := strconv.Atoi(strings.TrimSpace(value))
+		if err != nil || code < 100 || code > 599 {
+			return nil, fmt.Errorf("invalid retry status code %q", value)
+		}
+		codes = append(codes, code)
+	}
+	return codes, nil
+}
+
+// CheckRetry decides whether a request is retried. A retry policy set on the
+// request context takes precedence over the retryable status codes.
+func (c RetryConfig) CheckRetry(ctx context.Context, resp *http.Response, err error) (bool, error) {
+	if err != nil || ctx.Err() != nil {
+		return retryablehttp.DefaultRetryPolicy(ctx, resp, err)
+	}
+	if _, ok := ctx.Value(CtxRetryPolicyKey).(func(context.Context, *http.Response, error) (bool, error)); ok ||
+		len(c.RetryableStatusCodes) == 0 {
+		return CheckRetry(ctx, resp, err)
+	}
+	if slices.Contains(c.RetryableStatusCodes, resp.StatusCode) {
+		return true, fmt.Errorf("the server responded with an error, retrying. (Error %d: %s)",
+			resp.StatusCode, http.StatusText(resp.StatusCode))
+	}
+	// the default policy describes the error of the responses not retried
+	_, err = DefaultRetryPolicy(ctx, resp, nil)
+	return false, err
+}
+
+// Backoff returns the exponential backoff of the configured jitter.
+func (c RetryConfig) Backoff(min, max time.Duration, attemptNum int, resp *http.Response) time.Duration {
+	return backoffWithJitter(c.Jitter, min, max, attemptNum, resp)
+}
+
+// WithRetryClientConfig configures the retries of the client.
+func WithRetryClientConfig(config RetryConfig) RetryClientOption {
+	return func(rc *retryablehttp.Client) {
+		rc.RetryMax = config.MaxRetries
+		rc.RetryWaitMin = config.WaitMin
+		rc.RetryWaitMax = config.WaitMax
+		rc.HTTPClient.Timeout = config.Timeout
+		rc.CheckRetry = config.CheckRetry
+		rc.Backoff = config.Backoff
+	}
+}
@@ -0,0 +1,105 @@
+package clients_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/wandb/wandb/core/internal/clients"
+)
+
+func TestParseRetryStatusCodes(t *testing.T) {
+	codes, err := clients.ParseRetryStatusCodes([]string{"429", " 503"})
+	assert.NoError(t, err)
+	assert.Equal(t, []int{429, 503}, codes)
+
+	_, err = clients.ParseRetryStatusCodes([]string{"5xx"})
+	assert.ErrorContains(t, err, "invalid retry status code")
+	_, err = clients.ParseRetryStatusCodes([]string{"42"})
+	assert.Error(t, err)
+}
+
+func TestRetryConfigCheckRetry(t *testing.T) {
+	config := clients.RetryConfig{RetryableStatusCodes: []int{http.StatusTooManyRequests}}
+	testCases := []struct {
+		name        string
+		statusCode  int
+		shouldRetry bool
+	}{
+		{"Retryable", http.StatusTooManyRequests, true},
+		{"NotRetryable", http.StatusBadGateway, false},
+		{"NotRetryable4xx", http.StatusTeapot, false},
+		{"OK", http.StatusOK, false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			resp := httptest.NewRecorder().Result()
+			resp.StatusCode = tc.statusCode
+
+			retry, _ := config.CheckRetry(context.Background(), resp, nil)
+			assert.Equal(t, tc.shouldRetry, retry)
+		})
+	}
+}
+
+func TestRetryConfigCheckRetryContextPolicy(t *testing.T) {
+	config := clients.RetryConfig{RetryableStatusCodes: []int{http.StatusServiceUnavailable}}
+	ctx := context.WithValue(context.Background(), clients.CtxRetryPolicyKey, clients.UpsertBucketRetryPolicy)
+	resp := httptest.NewRecorder().Result()
+	resp.StatusCode = http.StatusConflict
+
+	// the policy of the request takes precedence over the status codes
+	retry, _ := config.CheckRetry(ctx, resp, nil)
+	assert.True(t, retry)
+}
+
+func TestRetryConfigBackoff(t *testing.T) {
+	config := clients.RetryConfig{}
+	assert.Equal(t, 8*time.Second, config.Backoff(time.Second, time.Minute, 3, nil))
+	assert.Equal(t, 10*time.Second, config.Backoff(time.Second, 10*time.Second, 5, nil))
+
+	config.Jitter = 0.5
+	for i := 0; i < 10; i++ {
+		backoff := config.Backoff(time.Second, time.Minute, 2, nil)
+		assert.GreaterOrEqual(t, backoff, 4*time.Second)
+		assert.LessOrEqual(t, backoff, 6*time.Second)
+	}
+}
+
+func TestWithRetryClientConfig(t *testing.T) {
+	var attempts atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if attempts.Add(1) < 3 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	client := clients.NewRetryClient(clients.WithRetryClientConfig(clients.RetryConfig{
+		MaxRetries:           3,
+		WaitMin:              time.Millisecond,
+		WaitMax:              time.Millisecond,
+		RetryableStatusCodes: []int{http.StatusServiceUnavailable},
+	}))
+	resp, err := client.Get(server.URL)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, int32(3), attempts.Load())
+	resp.Body.Close()
+
+	// status codes that are not configured are not retried
+	attempts.Store(0)
+	client.CheckRetry = clients.RetryConfig{RetryableStatusCodes: []int{http.StatusTooManyRequests}}.CheckRetry
+	resp, err = client.Get(server.URL)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusServiceUnavailable, resp.StatusCode)
+	assert.Equal(t, int32(1), attempts.Load())
+	resp.Body.Close()
+}
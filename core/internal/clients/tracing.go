@@ -0,0 +1,68 @@
+package clients
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/hashicorp/go-retryablehttp"
+
+	"github.com/wandb/wandb/core/pkg/observability"
+)
+
+// tracingTransport calls the hooks of a tracer around each attempt
+type tracingTransport struct {
+	tracer  observability.RequestTracer
+	wrapped http.RoundTripper
+}
+
+func (t *tracingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	req = req.Clone(req.Context())
+	info := &observability.RequestInfo{
+		Method: req.Method,
+		URL:    req.URL.String(),
+		Header: req.Header,
+		Size:   req.ContentLength,
+	}
+	if req.Body != nil && req.Body != http.NoBody && req.ContentLength == 0 {
+		info.Size = -1
+	}
+	ctx := t.tracer.OnRequestStart(req.Context(), info)
+	if ctx != nil {
+		req = req.WithContext(ctx)
+	}
+
+	start := time.Now()
+	resp, err := t.wrapped.RoundTrip(req)
+	result := &observability.RequestResult{
+		Size:     -1,
+		Duration: time.Since(start),
+		Err:      err,
+	}
+	if resp != nil {
+		result.StatusCode = resp.StatusCode
+		result.Size = resp.ContentLength
+	}
+	t.tracer.OnRequestEnd(req.Context(), info, result)
+	return resp, err
+}
+
+// WithRetryClientTracer calls the hooks of the tracer around each attempt of
+// the requests of the client. A nil tracer is ignored.
+//
+// The attempts are traced as they are sent, so the option should be applied
+// right after the HTTP transport, before the options that wrap it.
+func WithRetryClientTracer(tracer observability.RequestTracer) RetryClientOption {
+	return func(rc *retryablehttp.Client) {
+		if tracer == nil {
+			return
+		}
+		wrapped := rc.HTTPClient.Transport
+		if wrapped == nil {
+			wrapped = http.DefaultTransport
+		}
+		rc.HTTPClient.Transport = &tracingTransport{
+			tracer:  tracer,
+			wrapped: wrapped,
+		}
+	}
+}
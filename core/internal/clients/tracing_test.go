@@ -0,0 +1,102 @@
+package clients_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/go-retryablehttp"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/wandb/wandb/core/internal/clients"
+	"github.com/wandb/wandb/core/pkg/observability"
+)
+
+// traceHeader is the header the tracer adds to the requests
+const traceHeader = "Traceparent"
+
+type testTracer struct {
+	requests []observability.RequestInfo
+	results  []observability.RequestResult
+}
+
+type attemptKey struct{}
+
+func (t *testTracer) OnRequestStart(ctx context.Context, request *observability.RequestInfo) context.Context {
+	request.Header.Set(traceHeader, "trace")
+	return context.WithValue(ctx, attemptKey{}, len(t.requests))
+}
+
+func (t *testTracer) OnRequestEnd(
+	ctx context.Context,
+	request *observability.RequestInfo,
+	result *observability.RequestResult,
+) {
+	if ctx.Value(attemptKey{}) != len(t.requests) {
+		panic("the context of the attempt was not passed")
+	}
+	t.requests = append(t.requests, *request)
+	t.results = append(t.results, *result)
+}
+
+func TestWithRetryClientTracer(t *testing.T) {
+	var headers []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		headers = append(headers, r.Header.Get(traceHeader))
+		if len(headers) == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		_, _ = w.Write([]byte("ok"))
+	}))
+	defer server.Close()
+
+	tracer := &testTracer{}
+	client := clients.NewRetryClient(
+		clients.WithRetryClientRetryMax(1),
+		clients.WithRetryClientRetryWaitMin(0),
+		clients.WithRetryClientRetryWaitMax(0),
+		clients.WithRetryClientTracer(tracer),
+	)
+	req, err := retryablehttp.NewRequest(http.MethodPost, server.URL, []byte("{}"))
+	assert.NoError(t, err)
+	resp, err := client.Do(req)
+	assert.NoError(t, err)
+	resp.Body.Close()
+
+	// each attempt is traced, with the header set by the tracer
+	assert.Equal(t, []string{"trace", "trace"}, headers)
+	assert.Len(t, tracer.requests, 2)
+	for _, request := range tracer.requests {
+		assert.Equal(t, http.MethodPost, request.Method)
+		assert.Equal(t, server.URL, request.URL)
+		assert.Equal(t, int64(2), request.Size)
+	}
+	assert.Equal(t, http.StatusServiceUnavailable, tracer.results[0].StatusCode)
+	assert.Equal(t, http.StatusOK, tracer.results[1].StatusCode)
+	assert.Equal(t, int64(2), tracer.results[1].Size)
+	assert.Positive(t, tracer.results[1].Duration)
+	assert.NoError(t, tracer.results[1].Err)
+}
+
+func TestWithRetryClientTracerError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	url := server.URL
+	server.Close()
+
+	tracer := &testTracer{}
+	client := clients.NewRetryClient(
+		clients.WithRetryClientRetryMax(0),
+		clients.WithRetryClientTracer(tracer),
+	)
+	req, err := retryablehttp.NewRequest(http.MethodGet, url, nil)
+	assert.NoError(t, err)
+	_, err = client.Do(req)
+	assert.Error(t, err)
+
+	assert.Len(t, tracer.results, 1)
+	assert.Zero(t, tracer.results[0].StatusCode)
+	assert.Equal(t, int64(-1), tracer.results[0].Size)
+	assert.Error(t, tracer.results[0].Err)
+}
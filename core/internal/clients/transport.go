@@ -0,0 +1,89 @@
+package clients
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+
+	"github.com/hashicorp/go-cleanhttp"
+	"golang.org/x/net/http/httpproxy"
+)
+
+// caBundleEnvVar is the environment variable of the CA bundle used by the
+// Python requests library, it is honored so that all the traffic of a run
+// trusts the same certificates
+const caBundleEnvVar = "REQUESTS_CA_BUNDLE"
+
+// TransportConfig is the network configuration of the HTTP clients.
+//
+// The unset fields fall back to the environment: HTTP_PROXY, HTTPS_PROXY and
+// NO_PROXY for the proxies, and REQUESTS_CA_BUNDLE for the CA bundle.
+type TransportConfig struct {
+	// Proxies maps the URL schemes "http" and "https" to the URL of their
+	// proxy. The proxy credentials, if any, are the user info of the URL.
+	Proxies map[string]string
+
+	// NoProxy is the comma separated list of the hosts that are not proxied
+	NoProxy string
+
+	// CABundlePath is a PEM file of the certificates to trust in addition to
+	// the system ones
+	CABundlePath string
+}
+
+// proxyFunc returns the proxy of each request
+func (c TransportConfig) proxyFunc() func(*http.Request) (*url.URL, error) {
+	config := httpproxy.FromEnvironment()
+	if proxy, ok := c.Proxies["http"]; ok {
+		config.HTTPProxy = proxy
+	}
+	if proxy, ok := c.Proxies["https"]; ok {
+		config.HTTPSProxy = proxy
+	}
+	if c.NoProxy != "" {
+		config.NoProxy = c.NoProxy
+	}
+	proxy := config.ProxyFunc()
+	return func(req *http.Request) (*url.URL, error) {
+		return proxy(req.URL)
+	}
+}
+
+// rootCAs returns the certificates to trust, or nil for the system ones
+func (c TransportConfig) rootCAs() (*x509.CertPool, error) {
+	path := c.CABundlePath
+	if path == "" {
+		path = os.Getenv(caBundleEnvVar)
+	}
+	if path == "" {
+		return nil, nil
+	}
+	pem, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("clients: error reading CA bundle: %v", err)
+	}
+	pool, err := x509.SystemCertPool()
+	if err != nil {
+		pool = x509.NewCertPool()
+	}
+	if !pool.AppendCertsFromPEM(pem) {
+		return nil, fmt.Errorf("clients: no certificates in CA bundle %s", path)
+	}
+	return pool, nil
+}
+
+// NewTransport returns the base transport of the HTTP clients with the
+// configured proxies and certificates. If the CA bundle can't be loaded, the
+// transport trusts the system certificates and the error is returned with it.
+func NewTransport(config TransportConfig) (*http.Transport, error) {
+	transport := cleanhttp.DefaultPooledTransport()
+	transport.Proxy = config.proxyFunc()
+	pool, err := config.rootCAs()
+	if pool != nil {
+		transport.TLSClientConfig = &tls.Config{RootCAs: pool}
+	}
+	return transport, err
+}
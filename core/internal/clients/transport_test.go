@@ -0,0 +1,83 @@
+package clients_test
+
+import (
+	"encoding/base64"
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/wandb/wandb/core/internal/clients"
+)
+
+func TestNewTransportProxy(t *testing.T) {
+	var proxied []string
+	var proxyAuth string
+	proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		proxied = append(proxied, r.URL.String())
+		proxyAuth = r.Header.Get("Proxy-Authorization")
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer proxy.Close()
+
+	transport, err := clients.NewTransport(clients.TransportConfig{
+		Proxies: map[string]string{"http": "http://user:pass@" + proxy.Listener.Addr().String()},
+		NoProxy: "direct.example.com",
+	})
+	assert.NoError(t, err)
+	client := clients.NewRetryClient(
+		clients.WithRetryClientRetryMax(0),
+		clients.WithRetryClientHttpTransport(transport),
+	)
+
+	resp, err := client.Get("http://api.example.com/graphql")
+	assert.NoError(t, err)
+	resp.Body.Close()
+	assert.Equal(t, []string{"http://api.example.com/graphql"}, proxied)
+	assert.Equal(t, "Basic "+base64.StdEncoding.EncodeToString([]byte("user:pass")), proxyAuth)
+
+	// the hosts in NoProxy are not proxied
+	req, err := http.NewRequest(http.MethodGet, "http://direct.example.com", nil)
+	assert.NoError(t, err)
+	proxyURL, err := transport.Proxy(req)
+	assert.NoError(t, err)
+	assert.Nil(t, proxyURL)
+}
+
+func TestNewTransportCABundle(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	t.Setenv("REQUESTS_CA_BUNDLE", "")
+
+	// the certificate of the server is not trusted by default
+	transport, err := clients.NewTransport(clients.TransportConfig{})
+	assert.NoError(t, err)
+	_, err = (&http.Client{Transport: transport}).Get(server.URL)
+	assert.Error(t, err)
+
+	caBundle := filepath.Join(t.TempDir(), "ca.pem")
+	certPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw})
+	assert.NoError(t, os.WriteFile(caBundle, certPEM, 0o600))
+	transport, err = clients.NewTransport(clients.TransportConfig{CABundlePath: caBundle})
+	assert.NoError(t, err)
+	resp, err := (&http.Client{Transport: transport}).Get(server.URL)
+	assert.NoError(t, err)
+	resp.Body.Close()
+}
+
+func TestNewTransportCABundleError(t *testing.T) {
+	// the transport falls back to the system certificates
+	transport, err := clients.NewTransport(clients.TransportConfig{
+		CABundlePath: filepath.Join(t.TempDir(), "missing.pem"),
+	})
+	assert.Error(t, err)
+	assert.NotNil(t, transport)
+	assert.Nil(t, transport.TLSClientConfig)
+}
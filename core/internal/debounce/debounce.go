@@ -1,6 +1,9 @@
 package debounce
 
 import (
+	"math"
+	"time"
+
 	"github.com/wandb/wandb/core/pkg/observability"
 
 	"golang.org/x/time/rate"
@@ -12,6 +15,43 @@ type Debouncer struct {
 	limiter       *rate.Limiter
 	needsDebounce bool
 	logger        *observability.CoreLogger
+
+	// clock tells the time to the limiter and makes the channel of Ready
+	clock Clock
+}
+
+// Clock is the time of a debouncer, see SetClock
+type Clock interface {
+	Now() time.Time
+
+	// After returns a channel that receives once the delay passed, the
+	// channel of an earlier call is no longer used
+	After(delay time.Duration) <-chan time.Time
+}
+
+// realClock is the clock of the system, it reuses its timer for every call
+// of After
+type realClock struct {
+	timer *time.Timer
+}
+
+func (c *realClock) Now() time.Time {
+	return time.Now()
+}
+
+func (c *realClock) After(delay time.Duration) <-chan time.Time {
+	if c.timer == nil {
+		c.timer = time.NewTimer(delay)
+		return c.timer.C
+	}
+	if !c.timer.Stop() {
+		select {
+		case <-c.timer.C:
+		default:
+		}
+	}
+	c.timer.Reset(delay)
+	return c.timer.C
 }
 
 // NewDebouncer creates a new debouncer
@@ -23,9 +63,16 @@ func NewDebouncer(
 	return &Debouncer{
 		limiter: rate.NewLimiter(eventRate, burstSize),
 		logger:  logger,
+		clock:   &realClock{},
 	}
 }
 
+// SetClock replaces the clock of the system, so that tests control when the
+// pending call is due
+func (d *Debouncer) SetClock(clock Clock) {
+	d.clock = clock
+}
+
 func (d *Debouncer) SetNeedsDebounce() {
 	if d == nil {
 		return
@@ -45,12 +92,27 @@ func (d *Debouncer) Debounce(f func()) {
 	if d == nil {
 		return
 	}
-	if !d.needsDebounce || !d.limiter.Allow() {
+	if !d.needsDebounce || !d.limiter.AllowN(d.clock.Now(), 1) {
 		return
 	}
 	d.Flush(f)
 }
 
+// Ready returns a channel that receives once the rate limiter allows the
+// pending call, so that it is made by Debounce even if no more events come.
+// It returns nil if no call is pending.
+func (d *Debouncer) Ready() <-chan time.Time {
+	if d == nil || !d.needsDebounce {
+		return nil
+	}
+	var delay time.Duration
+	tokens, limit := d.limiter.TokensAt(d.clock.Now()), d.limiter.Limit()
+	if tokens < 1 && limit > 0 {
+		delay = time.Duration(math.Ceil((1 - tokens) / float64(limit) * float64(time.Second)))
+	}
+	return d.clock.After(delay)
+}
+
 // Flush will call the function f if it needs to be called.
 func (d *Debouncer) Flush(f func()) {
 	if d == nil {
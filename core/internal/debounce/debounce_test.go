@@ -6,6 +6,7 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/wandb/wandb/core/internal/debounce"
+	"github.com/wandb/wandb/core/internal/debounce/debouncetest"
 	"github.com/wandb/wandb/core/pkg/observability"
 	"golang.org/x/time/rate"
 )
@@ -33,3 +34,32 @@ func TestDebouncer(t *testing.T) {
 	time.Sleep(time.Millisecond * 150)
 	assert.Equal(t, 1, count)
 }
+
+func TestDebouncerReady(t *testing.T) {
+	logger := observability.NewNoOpLogger()
+	debouncer := debounce.NewDebouncer(rate.Every(time.Millisecond*50), 1, logger)
+	clock := debouncetest.NewFakeClock()
+	debouncer.SetClock(clock)
+	assert.Nil(t, debouncer.Ready())
+
+	count := 0
+	debouncer.SetNeedsDebounce()
+	debouncer.Debounce(func() { count++ })
+	debouncer.SetNeedsDebounce()
+	debouncer.Debounce(func() { count++ })
+	assert.Equal(t, 1, count)
+
+	// the pending call is allowed once the limiter has a token again
+	ready := debouncer.Ready()
+	clock.Advance(time.Millisecond * 40)
+	select {
+	case <-ready:
+		t.Fatal("ready before the limiter has a token")
+	default:
+	}
+	clock.Advance(time.Millisecond * 10)
+	<-ready
+	debouncer.Debounce(func() { count++ })
+	assert.Equal(t, 2, count)
+	assert.Nil(t, debouncer.Ready())
+}
@@ -0,0 +1,54 @@
+// Package debouncetest has the fake clock to test the debounced calls
+package debouncetest
+
+import (
+	"sync"
+	"time"
+)
+
+// FakeClock is a debounce.Clock whose time only moves with Advance
+type FakeClock struct {
+	mu  sync.Mutex
+	now time.Time
+
+	// due is when ready receives, ready is nil if no call of After is
+	// pending
+	due   time.Time
+	ready chan time.Time
+}
+
+func NewFakeClock() *FakeClock {
+	return &FakeClock{now: time.Now()}
+}
+
+func (c *FakeClock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.now
+}
+
+func (c *FakeClock) After(delay time.Duration) <-chan time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.ready = make(chan time.Time, 1)
+	c.due = c.now.Add(delay)
+	c.fire()
+	return c.ready
+}
+
+// Advance moves the time forward, the channel of the last call of After
+// receives if it is due
+func (c *FakeClock) Advance(d time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.now = c.now.Add(d)
+	c.fire()
+}
+
+func (c *FakeClock) fire() {
+	if c.ready == nil || c.now.Before(c.due) {
+		return
+	}
+	c.ready <- c.now
+	c.ready = nil
+}
@@ -0,0 +1,415 @@
+// Package expr evaluates arithmetic expressions over named values, like the
+// derived metrics of a run computed from the keys of its history rows.
+//
+// An expression has numbers, names, the operators + - * / % ^, parentheses
+// and the functions abs, sqrt, log, exp, min and max. A name is made of
+// letters, digits, underscores and dots; any other name is quoted with
+// backquotes, e.g. `train/tokens` / step_time.
+package expr
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// ErrMissing is returned by Eval when a name of the expression has no value
+var ErrMissing = errors.New("expr: missing value")
+
+// Expr is a parsed expression.
+type Expr struct {
+	source string
+	root   node
+	names  []string
+}
+
+// Parse parses an expression
+func Parse(source string) (*Expr, error) {
+	p := &parser{source: source}
+	if err := p.next(); err != nil {
+		return nil, err
+	}
+	root, err := p.parseSum()
+	if err != nil {
+		return nil, err
+	}
+	if p.token.kind != tokenEnd {
+		return nil, p.errorf("unexpected %q", p.token.text)
+	}
+	e := &Expr{source: source, root: root}
+	seen := make(map[string]bool)
+	root.walk(func(n node) {
+		if name, ok := n.(nameNode); ok && !seen[string(name)] {
+			seen[string(name)] = true
+			e.names = append(e.names, string(name))
+		}
+	})
+	return e, nil
+}
+
+// String returns the source of the expression
+func (e *Expr) String() string {
+	return e.source
+}
+
+// Names returns the names of the expression, in the order they appear
+func (e *Expr) Names() []string {
+	return e.names
+}
+
+// Eval evaluates the expression with the values of its names. It fails with
+// ErrMissing if a name has no value, and if the result is not a finite
+// number, for example after a division by zero.
+func (e *Expr) Eval(values map[string]float64) (float64, error) {
+	value, err := e.root.eval(values)
+	if err != nil {
+		return 0, err
+	}
+	if math.IsNaN(value) || math.IsInf(value, 0) {
+		return 0, fmt.Errorf("expr: %s is not a finite number", e.source)
+	}
+	return value, nil
+}
+
+type node interface {
+	eval(values map[string]float64) (float64, error)
+	walk(f func(node))
+}
+
+type numberNode float64
+
+func (n numberNode) eval(map[string]float64) (float64, error) { return float64(n), nil }
+func (n numberNode) walk(f func(node))                        { f(n) }
+
+type nameNode string
+
+func (n nameNode) eval(values map[string]float64) (float64, error) {
+	value, ok := values[string(n)]
+	if !ok {
+		return 0, fmt.Errorf("%w of %s", ErrMissing, string(n))
+	}
+	return value, nil
+}
+
+func (n nameNode) walk(f func(node)) { f(n) }
+
+type unaryNode struct {
+	operand node
+}
+
+func (n unaryNode) eval(values map[string]float64) (float64, error) {
+	value, err := n.operand.eval(values)
+	return -value, err
+}
+
+func (n unaryNode) walk(f func(node)) {
+	f(n)
+	n.operand.walk(f)
+}
+
+type binaryNode struct {
+	op          rune
+	left, right node
+}
+
+func (n binaryNode) eval(values map[string]float64) (float64, error) {
+	left, err := n.left.eval(values)
+	if err != nil {
+		return 0, err
+	}
+	right, err := n.right.eval(values)
+	if err != nil {
+		return 0, err
+	}
+	switch n.op {
+	case '+':
+		return left + right, nil
+	case '-':
+		return left - right, nil
+	case '*':
+		return left * right, nil
+	case '/':
+		return left / right, nil
+	case '%':
+		return math.Mod(left, right), nil
+	default:
+		return math.Pow(left, right), nil
+	}
+}
+
+func (n binaryNode) walk(f func(node)) {
+	f(n)
+	n.left.walk(f)
+	n.right.walk(f)
+}
+
+// functions are the functions of the expressions, by name and number of
+// arguments
+var functions = map[string]struct {
+	args int
+	f    func(args []float64) float64
+}{
+	"abs":  {1, func(args []float64) float64 { return math.Abs(args[0]) }},
+	"sqrt": {1, func(args []float64) float64 { return math.Sqrt(args[0]) }},
+	"log":  {1, func(args []float64) float64 { return math.Log(args[0]) }},
+	"exp":  {1, func(args []float64) float64 { return math.Exp(args[0]) }},
+	"min":  {2, func(args []float64) float64 { return math.Min(args[0], args[1]) }},
+	"max":  {2, func(args []float64) float64 { return math.Max(args[0], args[1]) }},
+}
+
+type callNode struct {
+	name string
+	args []node
+}
+
+func (n callNode) eval(values map[string]float64) (float64, error) {
+	args := make([]float64, len(n.args))
+	for i, arg := range n.args {
+		value, err := arg.eval(values)
+		if err != nil {
+			return 0, err
+		}
+		args[i] = value
+	}
+	return functions[n.name].f(args), nil
+}
+
+func (n callNode) walk(f func(node)) {
+	f(n)
+	for _, arg := range n.args {
+		arg.walk(f)
+	}
+}
+
+type tokenKind int
+
+const (
+	tokenEnd tokenKind = iota
+	tokenNumber
+	tokenName
+	tokenOperator
+)
+
+type token struct {
+	kind tokenKind
+	text string
+	pos  int
+}
+
+// parser is a recursive descent parser of the expressions. From the lowest
+// precedence:
+//
+//	sum     = product { ("+" | "-") product }
+//	product = unary { ("*" | "/" | "%") unary }
+//	unary   = "-" unary | power
+//	power   = primary [ "^" unary ]
+//	primary = number | name | name "(" sum { "," sum } ")" | "(" sum ")"
+type parser struct {
+	source string
+	pos    int
+	token  token
+}
+
+func (p *parser) errorf(format string, args ...interface{}) error {
+	return fmt.Errorf("expr: %s at %d of %q", fmt.Sprintf(format, args...), p.token.pos, p.source)
+}
+
+// next reads the next token
+func (p *parser) next() error {
+	for p.pos < len(p.source) && unicode.IsSpace(rune(p.source[p.pos])) {
+		p.pos++
+	}
+	start := p.pos
+	if p.pos == len(p.source) {
+		p.token = token{kind: tokenEnd, pos: start}
+		return nil
+	}
+	c := p.source[p.pos]
+	switch {
+	case c >= '0' && c <= '9' || c == '.':
+		for p.pos < len(p.source) && isNumberByte(p.source, p.pos) {
+			p.pos++
+		}
+		p.token = token{kind: tokenNumber, text: p.source[start:p.pos], pos: start}
+	case c == '_' || unicode.IsLetter(rune(c)):
+		for p.pos < len(p.source) && isNameByte(p.source[p.pos]) {
+			p.pos++
+		}
+		p.token = token{kind: tokenName, text: p.source[start:p.pos], pos: start}
+	case c == '`':
+		end := strings.IndexByte(p.source[start+1:], '`')
+		if end < 0 {
+			p.token = token{pos: start}
+			return p.errorf("unterminated name")
+		}
+		p.pos = start + end + 2
+		p.token = token{kind: tokenName, text: p.source[start+1 : start+1+end], pos: start}
+	case strings.IndexByte("+-*/%^(),", c) >= 0:
+		p.pos++
+		p.token = token{kind: tokenOperator, text: string(c), pos: start}
+	default:
+		p.token = token{pos: start}
+		return p.errorf("unexpected %q", c)
+	}
+	return nil
+}
+
+func isNameByte(c byte) bool {
+	return c == '_' || c == '.' || c >= '0' && c <= '9' || unicode.IsLetter(rune(c))
+}
+
+// isNumberByte reports whether the byte at i continues a number, with the
+// sign of an exponent
+func isNumberByte(s string, i int) bool {
+	c := s[i]
+	switch {
+	case c >= '0' && c <= '9' || c == '.' || c == 'e' || c == 'E':
+		return true
+	case c == '+' || c == '-':
+		return i > 0 && (s[i-1] == 'e' || s[i-1] == 'E')
+	}
+	return false
+}
+
+// isOperator reports whether the current token is one of the operators
+func (p *parser) isOperator(ops string) bool {
+	return p.token.kind == tokenOperator && strings.Contains(ops, p.token.text)
+}
+
+func (p *parser) parseSum() (node, error) {
+	left, err := p.parseProduct()
+	if err != nil {
+		return nil, err
+	}
+	for p.isOperator("+-") {
+		op := rune(p.token.text[0])
+		if err := p.next(); err != nil {
+			return nil, err
+		}
+		right, err := p.parseProduct()
+		if err != nil {
+			return nil, err
+		}
+		left = binaryNode{op: op, left: left, right: right}
+	}
+	return left, nil
+}
+
+func (p *parser) parseProduct() (node, error) {
+	left, err := p.parseUnary()
+	if err != nil {
+		return nil, err
+	}
+	for p.isOperator("*/%") {
+		op := rune(p.token.text[0])
+		if err := p.next(); err != nil {
+			return nil, err
+		}
+		right, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		left = binaryNode{op: op, left: left, right: right}
+	}
+	return left, nil
+}
+
+func (p *parser) parseUnary() (node, error) {
+	if p.isOperator("-") {
+		if err := p.next(); err != nil {
+			return nil, err
+		}
+		operand, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		return unaryNode{operand: operand}, nil
+	}
+	return p.parsePower()
+}
+
+func (p *parser) parsePower() (node, error) {
+	base, err := p.parsePrimary()
+	if err != nil {
+		return nil, err
+	}
+	if !p.isOperator("^") {
+		return base, nil
+	}
+	if err := p.next(); err != nil {
+		return nil, err
+	}
+	exponent, err := p.parseUnary()
+	if err != nil {
+		return nil, err
+	}
+	return binaryNode{op: '^', left: base, right: exponent}, nil
+}
+
+func (p *parser) parsePrimary() (node, error) {
+	current := p.token
+	switch {
+	case current.kind == tokenNumber:
+		value, err := strconv.ParseFloat(current.text, 64)
+		if err != nil {
+			return nil, p.errorf("invalid number %q", current.text)
+		}
+		return numberNode(value), p.next()
+	case current.kind == tokenName:
+		if err := p.next(); err != nil {
+			return nil, err
+		}
+		if !p.isOperator("(") {
+			return nameNode(current.text), nil
+		}
+		return p.parseCall(current.text)
+	case p.isOperator("("):
+		if err := p.next(); err != nil {
+			return nil, err
+		}
+		inner, err := p.parseSum()
+		if err != nil {
+			return nil, err
+		}
+		if !p.isOperator(")") {
+			return nil, p.errorf("missing )")
+		}
+		return inner, p.next()
+	case current.kind == tokenEnd:
+		return nil, p.errorf("unexpected end")
+	default:
+		return nil, p.errorf("unexpected %q", current.text)
+	}
+}
+
+// parseCall parses the arguments of a call, after the name of the function
+func (p *parser) parseCall(name string) (node, error) {
+	function, ok := functions[name]
+	if !ok {
+		return nil, p.errorf("unknown function %s", name)
+	}
+	var args []node
+	for {
+		if err := p.next(); err != nil {
+			return nil, err
+		}
+		arg, err := p.parseSum()
+		if err != nil {
+			return nil, err
+		}
+		args = append(args, arg)
+		if !p.isOperator(",") {
+			break
+		}
+	}
+	if !p.isOperator(")") {
+		return nil, p.errorf("missing )")
+	}
+	if len(args) != function.args {
+		return nil, p.errorf("%s takes %d arguments, got %d", name, function.args, len(args))
+	}
+	return callNode{name: name, args: args}, p.next()
+}
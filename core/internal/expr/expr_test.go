@@ -0,0 +1,64 @@
+package expr_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/wandb/wandb/core/internal/expr"
+)
+
+func TestEval(t *testing.T) {
+	values := map[string]float64{"tokens": 512, "step_time": 0.5, "train/loss": 2, "gpu.0.mem": 3}
+	testCases := []struct {
+		source   string
+		expected float64
+	}{
+		{"tokens / step_time", 1024},
+		{"1 + 2 * 3", 7},
+		{"(1 + 2) * 3", 9},
+		{"-2 ^ 2", -4},
+		{"2 ^ 3 ^ 2", 512},
+		{"10 % 4 - 1", 1},
+		{"`train/loss` * gpu.0.mem", 6},
+		{"max(tokens, 1e3) + min(1, abs(-2))", 1001},
+		{"sqrt(16) + log(exp(1))", 5},
+		{"1.5e-1 * 10", 1.5},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.source, func(t *testing.T) {
+			e, err := expr.Parse(tc.source)
+			if assert.NoError(t, err) {
+				value, err := e.Eval(values)
+				assert.NoError(t, err)
+				assert.InDelta(t, tc.expected, value, 1e-9)
+			}
+		})
+	}
+}
+
+func TestNames(t *testing.T) {
+	e, err := expr.Parse("a / (b + a) * `c/d`")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"a", "b", "c/d"}, e.Names())
+}
+
+func TestEvalErrors(t *testing.T) {
+	e, err := expr.Parse("tokens / step_time")
+	assert.NoError(t, err)
+
+	_, err = e.Eval(map[string]float64{"tokens": 1})
+	assert.True(t, errors.Is(err, expr.ErrMissing))
+
+	_, err = e.Eval(map[string]float64{"tokens": 1, "step_time": 0})
+	assert.Error(t, err)
+	assert.False(t, errors.Is(err, expr.ErrMissing))
+}
+
+func TestParseErrors(t *testing.T) {
+	for _, source := range []string{"", "1 +", "(1", "a b", "median(a)", "max(a)", "`a", "a $ b"} {
+		_, err := expr.Parse(source)
+		assert.Error(t, err, source)
+	}
+}
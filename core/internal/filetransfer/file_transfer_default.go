@@ -1,6 +1,7 @@
 package filetransfer
 
 import (
+	"context"
 	"fmt"
 	"io"
 	"math"
@@ -15,6 +16,9 @@ import (
 
 // DefaultFileTransfer uploads or downloads files to/from the server
 type DefaultFileTransfer struct {
+	// ctx is the context of the transfers, they are aborted when it is done
+	ctx context.Context
+
 	// client is the HTTP client for the file transfer
 	client *retryablehttp.Client
 
@@ -23,8 +27,13 @@ type DefaultFileTransfer struct {
 }
 
 // NewDefaultFileTransfer creates a new fileTransfer
-func NewDefaultFileTransfer(logger *observability.CoreLogger, client *retryablehttp.Client) *DefaultFileTransfer {
+func NewDefaultFileTransfer(
+	ctx context.Context,
+	logger *observability.CoreLogger,
+	client *retryablehttp.Client,
+) *DefaultFileTransfer {
 	fileTransfer := &DefaultFileTransfer{
+		ctx:    ctx,
 		logger: logger,
 		client: client,
 	}
@@ -58,7 +67,7 @@ func (ft *DefaultFileTransfer) Upload(task *Task) error {
 	if err != nil {
 		return err
 	}
-	req, err := retryablehttp.NewRequest(http.MethodPut, task.Url, progressReader)
+	req, err := retryablehttp.NewRequestWithContext(ft.ctx, http.MethodPut, task.Url, progressReader)
 	if err != nil {
 		return err
 	}
@@ -103,7 +112,11 @@ func (ft *DefaultFileTransfer) Download(task *Task) error {
 		}
 	}(file)
 
-	resp, err := ft.client.Get(task.Url)
+	req, err := retryablehttp.NewRequestWithContext(ft.ctx, http.MethodGet, task.Url, nil)
+	if err != nil {
+		return err
+	}
+	resp, err := ft.client.Do(req)
 	if err != nil {
 		return err
 	}
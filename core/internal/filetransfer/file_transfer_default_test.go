@@ -1,6 +1,7 @@
 package filetransfer
 
 import (
+	"context"
 	"os"
 	"testing"
 
@@ -52,6 +53,7 @@ func TestDefaultFileTransfer_Download(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			ft := &DefaultFileTransfer{
+				ctx:    context.Background(),
 				client: tt.fields.client,
 				logger: tt.fields.logger,
 			}
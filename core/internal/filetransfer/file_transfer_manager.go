@@ -95,9 +95,10 @@ func (fm *FileTransferManager) Start() {
 	if fm.active {
 		return
 	}
+	// set before the loop starts, so that a Close right after Start closes it
+	fm.active = true
 	fm.wg.Add(1)
 	go func() {
-		fm.active = true
 		for task := range fm.inChan {
 			// add a task to the wait group
 			fm.wg.Add(1)
@@ -0,0 +1,444 @@
+package gql
+
+// The operations in this file are written by hand, in the form genqlient
+// generates them, as gql_gen.go can only be regenerated with the schema that
+// is not checked in. Their queries are in api/graphql/manual, out of the
+// operations genqlient generates: move them to api/graphql and delete them
+// from here when gql_gen.go is regenerated with them.
+
+import (
+	"context"
+
+	"github.com/Khan/genqlient/graphql"
+)
+
+// RunStoppedStatusProject includes the requested fields of the GraphQL type Project.
+type RunStoppedStatusProject struct {
+	Run *RunStoppedStatusProjectRun `json:"run"`
+}
+
+// GetRun returns RunStoppedStatusProject.Run, and is useful for accessing the field via an interface.
+func (v *RunStoppedStatusProject) GetRun() *RunStoppedStatusProjectRun { return v.Run }
+
+// RunStoppedStatusProjectRun includes the requested fields of the GraphQL type Run.
+type RunStoppedStatusProjectRun struct {
+	Stopped *bool `json:"stopped"`
+}
+
+// GetStopped returns RunStoppedStatusProjectRun.Stopped, and is useful for accessing the field via an interface.
+func (v *RunStoppedStatusProjectRun) GetStopped() *bool { return v.Stopped }
+
+// RunStoppedStatusResponse is returned by RunStoppedStatus on success.
+type RunStoppedStatusResponse struct {
+	Project *RunStoppedStatusProject `json:"project"`
+}
+
+// GetProject returns RunStoppedStatusResponse.Project, and is useful for accessing the field via an interface.
+func (v *RunStoppedStatusResponse) GetProject() *RunStoppedStatusProject { return v.Project }
+
+// __RunStoppedStatusInput is used internally by genqlient
+type __RunStoppedStatusInput struct {
+	ProjectName *string `json:"projectName"`
+	EntityName  *string `json:"entityName"`
+	RunId       string  `json:"runId"`
+}
+
+// GetProjectName returns __RunStoppedStatusInput.ProjectName, and is useful for accessing the field via an interface.
+func (v *__RunStoppedStatusInput) GetProjectName() *string { return v.ProjectName }
+
+// GetEntityName returns __RunStoppedStatusInput.EntityName, and is useful for accessing the field via an interface.
+func (v *__RunStoppedStatusInput) GetEntityName() *string { return v.EntityName }
+
+// GetRunId returns __RunStoppedStatusInput.RunId, and is useful for accessing the field via an interface.
+func (v *__RunStoppedStatusInput) GetRunId() string { return v.RunId }
+
+// The query or mutation executed by RunStoppedStatus.
+const RunStoppedStatus_Operation = `
+query RunStoppedStatus ($projectName: String, $entityName: String, $runId: String!) {
+	project(name: $projectName, entityName: $entityName) {
+		run(name: $runId) {
+			stopped
+		}
+	}
+}
+`
+
+func RunStoppedStatus(
+	ctx context.Context,
+	client graphql.Client,
+	projectName *string,
+	entityName *string,
+	runId string,
+) (*RunStoppedStatusResponse, error) {
+	req := &graphql.Request{
+		OpName: "RunStoppedStatus",
+		Query:  RunStoppedStatus_Operation,
+		Variables: &__RunStoppedStatusInput{
+			ProjectName: projectName,
+			EntityName:  entityName,
+			RunId:       runId,
+		},
+	}
+	var err error
+
+	var data RunStoppedStatusResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+// AgentHeartbeatAgentHeartbeatAgentHeartbeatPayload includes the requested fields of the GraphQL type AgentHeartbeatPayload.
+type AgentHeartbeatAgentHeartbeatAgentHeartbeatPayload struct {
+	Agent    *AgentHeartbeatAgentHeartbeatAgentHeartbeatPayloadAgent `json:"agent"`
+	Commands *string                                                 `json:"commands"`
+}
+
+// GetAgent returns AgentHeartbeatAgentHeartbeatAgentHeartbeatPayload.Agent, and is useful for accessing the field via an interface.
+func (v *AgentHeartbeatAgentHeartbeatAgentHeartbeatPayload) GetAgent() *AgentHeartbeatAgentHeartbeatAgentHeartbeatPayloadAgent {
+	return v.Agent
+}
+
+// GetCommands returns AgentHeartbeatAgentHeartbeatAgentHeartbeatPayload.Commands, and is useful for accessing the field via an interface.
+func (v *AgentHeartbeatAgentHeartbeatAgentHeartbeatPayload) GetCommands() *string {
+	return v.Commands
+}
+
+// AgentHeartbeatAgentHeartbeatAgentHeartbeatPayloadAgent includes the requested fields of the GraphQL type Agent.
+type AgentHeartbeatAgentHeartbeatAgentHeartbeatPayloadAgent struct {
+	Id string `json:"id"`
+}
+
+// GetId returns AgentHeartbeatAgentHeartbeatAgentHeartbeatPayloadAgent.Id, and is useful for accessing the field via an interface.
+func (v *AgentHeartbeatAgentHeartbeatAgentHeartbeatPayloadAgent) GetId() string { return v.Id }
+
+// AgentHeartbeatResponse is returned by AgentHeartbeat on success.
+type AgentHeartbeatResponse struct {
+	AgentHeartbeat *AgentHeartbeatAgentHeartbeatAgentHeartbeatPayload `json:"agentHeartbeat"`
+}
+
+// GetAgentHeartbeat returns AgentHeartbeatResponse.AgentHeartbeat, and is useful for accessing the field via an interface.
+func (v *AgentHeartbeatResponse) GetAgentHeartbeat() *AgentHeartbeatAgentHeartbeatAgentHeartbeatPayload {
+	return v.AgentHeartbeat
+}
+
+// CreateAgentCreateAgentCreateAgentPayload includes the requested fields of the GraphQL type CreateAgentPayload.
+type CreateAgentCreateAgentCreateAgentPayload struct {
+	Agent *CreateAgentCreateAgentCreateAgentPayloadAgent `json:"agent"`
+}
+
+// GetAgent returns CreateAgentCreateAgentCreateAgentPayload.Agent, and is useful for accessing the field via an interface.
+func (v *CreateAgentCreateAgentCreateAgentPayload) GetAgent() *CreateAgentCreateAgentCreateAgentPayloadAgent {
+	return v.Agent
+}
+
+// CreateAgentCreateAgentCreateAgentPayloadAgent includes the requested fields of the GraphQL type Agent.
+type CreateAgentCreateAgentCreateAgentPayloadAgent struct {
+	Id string `json:"id"`
+}
+
+// GetId returns CreateAgentCreateAgentCreateAgentPayloadAgent.Id, and is useful for accessing the field via an interface.
+func (v *CreateAgentCreateAgentCreateAgentPayloadAgent) GetId() string { return v.Id }
+
+// CreateAgentResponse is returned by CreateAgent on success.
+type CreateAgentResponse struct {
+	CreateAgent *CreateAgentCreateAgentCreateAgentPayload `json:"createAgent"`
+}
+
+// GetCreateAgent returns CreateAgentResponse.CreateAgent, and is useful for accessing the field via an interface.
+func (v *CreateAgentResponse) GetCreateAgent() *CreateAgentCreateAgentCreateAgentPayload {
+	return v.CreateAgent
+}
+
+// SweepConfigProject includes the requested fields of the GraphQL type Project.
+type SweepConfigProject struct {
+	Sweep *SweepConfigProjectSweep `json:"sweep"`
+}
+
+// GetSweep returns SweepConfigProject.Sweep, and is useful for accessing the field via an interface.
+func (v *SweepConfigProject) GetSweep() *SweepConfigProjectSweep { return v.Sweep }
+
+// SweepConfigProjectSweep includes the requested fields of the GraphQL type Sweep.
+type SweepConfigProjectSweep struct {
+	Config string `json:"config"`
+}
+
+// GetConfig returns SweepConfigProjectSweep.Config, and is useful for accessing the field via an interface.
+func (v *SweepConfigProjectSweep) GetConfig() string { return v.Config }
+
+// SweepConfigResponse is returned by SweepConfig on success.
+type SweepConfigResponse struct {
+	Project *SweepConfigProject `json:"project"`
+}
+
+// GetProject returns SweepConfigResponse.Project, and is useful for accessing the field via an interface.
+func (v *SweepConfigResponse) GetProject() *SweepConfigProject { return v.Project }
+
+// __AgentHeartbeatInput is used internally by genqlient
+type __AgentHeartbeatInput struct {
+	Id       string  `json:"id"`
+	Metrics  *string `json:"metrics"`
+	RunState *string `json:"runState"`
+}
+
+// GetId returns __AgentHeartbeatInput.Id, and is useful for accessing the field via an interface.
+func (v *__AgentHeartbeatInput) GetId() string { return v.Id }
+
+// GetMetrics returns __AgentHeartbeatInput.Metrics, and is useful for accessing the field via an interface.
+func (v *__AgentHeartbeatInput) GetMetrics() *string { return v.Metrics }
+
+// GetRunState returns __AgentHeartbeatInput.RunState, and is useful for accessing the field via an interface.
+func (v *__AgentHeartbeatInput) GetRunState() *string { return v.RunState }
+
+// __CreateAgentInput is used internally by genqlient
+type __CreateAgentInput struct {
+	Host        string  `json:"host"`
+	ProjectName *string `json:"projectName"`
+	EntityName  *string `json:"entityName"`
+	Sweep       string  `json:"sweep"`
+}
+
+// GetHost returns __CreateAgentInput.Host, and is useful for accessing the field via an interface.
+func (v *__CreateAgentInput) GetHost() string { return v.Host }
+
+// GetProjectName returns __CreateAgentInput.ProjectName, and is useful for accessing the field via an interface.
+func (v *__CreateAgentInput) GetProjectName() *string { return v.ProjectName }
+
+// GetEntityName returns __CreateAgentInput.EntityName, and is useful for accessing the field via an interface.
+func (v *__CreateAgentInput) GetEntityName() *string { return v.EntityName }
+
+// GetSweep returns __CreateAgentInput.Sweep, and is useful for accessing the field via an interface.
+func (v *__CreateAgentInput) GetSweep() string { return v.Sweep }
+
+// __SweepConfigInput is used internally by genqlient
+type __SweepConfigInput struct {
+	EntityName  *string `json:"entityName"`
+	ProjectName *string `json:"projectName"`
+	Sweep       string  `json:"sweep"`
+}
+
+// GetEntityName returns __SweepConfigInput.EntityName, and is useful for accessing the field via an interface.
+func (v *__SweepConfigInput) GetEntityName() *string { return v.EntityName }
+
+// GetProjectName returns __SweepConfigInput.ProjectName, and is useful for accessing the field via an interface.
+func (v *__SweepConfigInput) GetProjectName() *string { return v.ProjectName }
+
+// GetSweep returns __SweepConfigInput.Sweep, and is useful for accessing the field via an interface.
+func (v *__SweepConfigInput) GetSweep() string { return v.Sweep }
+
+// The query or mutation executed by AgentHeartbeat.
+const AgentHeartbeat_Operation = `
+mutation AgentHeartbeat ($id: ID!, $metrics: JSONString, $runState: JSONString) {
+	agentHeartbeat(input: {id:$id,metrics:$metrics,runState:$runState}) {
+		agent {
+			id
+		}
+		commands
+	}
+}
+`
+
+func AgentHeartbeat(
+	ctx context.Context,
+	client graphql.Client,
+	id string,
+	metrics *string,
+	runState *string,
+) (*AgentHeartbeatResponse, error) {
+	req := &graphql.Request{
+		OpName: "AgentHeartbeat",
+		Query:  AgentHeartbeat_Operation,
+		Variables: &__AgentHeartbeatInput{
+			Id:       id,
+			Metrics:  metrics,
+			RunState: runState,
+		},
+	}
+	var err error
+
+	var data AgentHeartbeatResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+// The query or mutation executed by CreateAgent.
+const CreateAgent_Operation = `
+mutation CreateAgent ($host: String!, $projectName: String, $entityName: String, $sweep: String!) {
+	createAgent(input: {host:$host,projectName:$projectName,entityName:$entityName,sweep:$sweep}) {
+		agent {
+			id
+		}
+	}
+}
+`
+
+func CreateAgent(
+	ctx context.Context,
+	client graphql.Client,
+	host string,
+	projectName *string,
+	entityName *string,
+	sweep string,
+) (*CreateAgentResponse, error) {
+	req := &graphql.Request{
+		OpName: "CreateAgent",
+		Query:  CreateAgent_Operation,
+		Variables: &__CreateAgentInput{
+			Host:        host,
+			ProjectName: projectName,
+			EntityName:  entityName,
+			Sweep:       sweep,
+		},
+	}
+	var err error
+
+	var data CreateAgentResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+// The query or mutation executed by SweepConfig.
+const SweepConfig_Operation = `
+query SweepConfig ($entityName: String, $projectName: String, $sweep: String!) {
+	project(name: $projectName, entityName: $entityName) {
+		sweep(sweepName: $sweep) {
+			config
+		}
+	}
+}
+`
+
+func SweepConfig(
+	ctx context.Context,
+	client graphql.Client,
+	entityName *string,
+	projectName *string,
+	sweep string,
+) (*SweepConfigResponse, error) {
+	req := &graphql.Request{
+		OpName: "SweepConfig",
+		Query:  SweepConfig_Operation,
+		Variables: &__SweepConfigInput{
+			EntityName:  entityName,
+			ProjectName: projectName,
+			Sweep:       sweep,
+		},
+	}
+	var err error
+
+	var data SweepConfigResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+// ArtifactByNameProject includes the requested fields of the GraphQL type Project.
+type ArtifactByNameProject struct {
+	Artifact *ArtifactByNameProjectArtifact `json:"artifact"`
+}
+
+// GetArtifact returns ArtifactByNameProject.Artifact, and is useful for accessing the field via an interface.
+func (v *ArtifactByNameProject) GetArtifact() *ArtifactByNameProjectArtifact { return v.Artifact }
+
+// ArtifactByNameProjectArtifact includes the requested fields of the GraphQL type Artifact.
+type ArtifactByNameProjectArtifact struct {
+	Id     string `json:"id"`
+	Digest string `json:"digest"`
+}
+
+// GetId returns ArtifactByNameProjectArtifact.Id, and is useful for accessing the field via an interface.
+func (v *ArtifactByNameProjectArtifact) GetId() string { return v.Id }
+
+// GetDigest returns ArtifactByNameProjectArtifact.Digest, and is useful for accessing the field via an interface.
+func (v *ArtifactByNameProjectArtifact) GetDigest() string { return v.Digest }
+
+// ArtifactByNameResponse is returned by ArtifactByName on success.
+type ArtifactByNameResponse struct {
+	Project *ArtifactByNameProject `json:"project"`
+}
+
+// GetProject returns ArtifactByNameResponse.Project, and is useful for accessing the field via an interface.
+func (v *ArtifactByNameResponse) GetProject() *ArtifactByNameProject { return v.Project }
+
+// __ArtifactByNameInput is used internally by genqlient
+type __ArtifactByNameInput struct {
+	EntityName  *string `json:"entityName"`
+	ProjectName *string `json:"projectName"`
+	Name        string  `json:"name"`
+}
+
+// GetEntityName returns __ArtifactByNameInput.EntityName, and is useful for accessing the field via an interface.
+func (v *__ArtifactByNameInput) GetEntityName() *string { return v.EntityName }
+
+// GetProjectName returns __ArtifactByNameInput.ProjectName, and is useful for accessing the field via an interface.
+func (v *__ArtifactByNameInput) GetProjectName() *string { return v.ProjectName }
+
+// GetName returns __ArtifactByNameInput.Name, and is useful for accessing the field via an interface.
+func (v *__ArtifactByNameInput) GetName() string { return v.Name }
+
+// The query or mutation executed by ArtifactByName.
+const ArtifactByName_Operation = `
+query ArtifactByName ($entityName: String, $projectName: String, $name: String!) {
+	project(name: $projectName, entityName: $entityName) {
+		artifact(name: $name) {
+			id
+			digest
+		}
+	}
+}
+`
+
+func ArtifactByName(
+	ctx context.Context,
+	client graphql.Client,
+	entityName *string,
+	projectName *string,
+	name string,
+) (*ArtifactByNameResponse, error) {
+	req := &graphql.Request{
+		OpName: "ArtifactByName",
+		Query:  ArtifactByName_Operation,
+		Variables: &__ArtifactByNameInput{
+			EntityName:  entityName,
+			ProjectName: projectName,
+			Name:        name,
+		},
+	}
+	var err error
+
+	var data ArtifactByNameResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
@@ -10,6 +10,7 @@ typedef enum {
 import "C"
 
 import (
+	"context"
 	"unsafe"
 
 	"github.com/wandb/wandb/core/internal/gowandb/internal_runopts"
@@ -76,7 +77,7 @@ func wandbcoreInit(configDataNum int, name *C.cchar_t, runID *C.cchar_t, project
 	telemetry := getTelemetry(library)
 	options = append(options, internal_runopts.WithTelemetry(telemetry))
 
-	run, err := wandbSession.NewRun(options...)
+	run, err := wandbSession.NewRun(context.Background(), options...)
 	if err != nil {
 		panic(err)
 	}
@@ -139,14 +140,14 @@ func wandbcoreDataAddStrings(num int, cLength C.int, cKeys **C.cchar_t, cStrings
 func wandbcoreLogData(runNum int, dataNum int) {
 	run := wandbRuns.Get(runNum)
 	data := wandbData.Get(dataNum)
-	run.Log(data)
+	_ = run.Log(context.Background(), data)
 	wandbData.Remove(dataNum)
 }
 
 //export wandbcoreFinish
 func wandbcoreFinish(num int) {
 	run := wandbRuns.Get(num)
-	run.Finish()
+	_ = run.Finish(context.Background())
 	wandbRuns.Remove(num)
 }
 
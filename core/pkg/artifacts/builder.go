@@ -91,6 +91,19 @@ func (b *ArtifactBuilder) AddFile(path string, name string) error {
 	return nil
 }
 
+// AddEntry adds a file whose digest is known, the base64 encoded MD5 of its
+// content, without reading it
+func (b *ArtifactBuilder) AddEntry(name string, path string, digest string, size int64) {
+	b.artifactRecord.Manifest.Contents = append(b.artifactRecord.Manifest.Contents,
+		&service.ArtifactManifestEntry{
+			Path:      name,
+			Digest:    digest,
+			LocalPath: path,
+			Size:      size,
+		})
+	b.isDigestUpToDate = false
+}
+
 func (b *ArtifactBuilder) updateManifestDigest() {
 	if b.isDigestUpToDate {
 		return
@@ -0,0 +1,106 @@
+package artifacts
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/wandb/wandb/core/pkg/utils"
+)
+
+// FileCache is a content addressed cache of the files of artifacts, shared
+// with the Python SDK. A file is stored at obj/md5/<hex[:2]>/<hex[2:]>, where
+// hex is the MD5 of its content.
+type FileCache struct {
+	root string
+}
+
+// NewFileCache creates a cache of files stored under root
+func NewFileCache(root string) *FileCache {
+	return &FileCache{root: root}
+}
+
+// DefaultCacheDir is the directory of the artifacts cache, under
+// WANDB_CACHE_DIR if it is set and the user cache directory otherwise
+func DefaultCacheDir() string {
+	cacheDir := os.Getenv("WANDB_CACHE_DIR")
+	if cacheDir == "" {
+		userCacheDir, err := os.UserCacheDir()
+		if err != nil {
+			userCacheDir = os.TempDir()
+		}
+		cacheDir = filepath.Join(userCacheDir, "wandb")
+	}
+	return filepath.Join(cacheDir, "artifacts")
+}
+
+// path is where the file with the base64 MD5 digest is stored
+func (c *FileCache) path(digest string) (string, error) {
+	hexDigest, err := utils.B64ToHex(digest)
+	if err != nil || len(hexDigest) < 3 {
+		return "", fmt.Errorf("artifacts: invalid MD5 digest %q", digest)
+	}
+	return filepath.Join(c.root, "obj", "md5", hexDigest[:2], hexDigest[2:]), nil
+}
+
+// Restore copies the file with the digest from the cache to dst, it returns
+// false if the file is not cached
+func (c *FileCache) Restore(digest, dst string) (bool, error) {
+	path, err := c.path(digest)
+	if err != nil {
+		return false, err
+	}
+	exists, err := utils.FileExists(path)
+	if err != nil || !exists {
+		return false, err
+	}
+	// a cached file that got corrupted is downloaded again
+	cachedDigest, err := utils.ComputeFileB64MD5(path)
+	if err != nil || cachedDigest != digest {
+		return false, err
+	}
+	if err := copyFile(path, dst); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// Add copies the file at src with the digest to the cache
+func (c *FileCache) Add(digest, src string) error {
+	path, err := c.path(digest)
+	if err != nil {
+		return err
+	}
+	return copyFile(src, path)
+}
+
+// copyFile copies src to dst through a temporary file, so that dst is either
+// missing or complete
+func copyFile(src, dst string) error {
+	if err := os.MkdirAll(filepath.Dir(dst), 0755); err != nil {
+		return err
+	}
+	in, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+	out, err := os.CreateTemp(filepath.Dir(dst), ".tmp-"+filepath.Base(dst))
+	if err != nil {
+		return err
+	}
+	defer os.Remove(out.Name())
+	if _, err := io.Copy(out, in); err != nil {
+		out.Close()
+		return err
+	}
+	if err := out.Chmod(0644); err != nil {
+		out.Close()
+		return err
+	}
+	if err := out.Close(); err != nil {
+		return err
+	}
+	return os.Rename(out.Name(), dst)
+}
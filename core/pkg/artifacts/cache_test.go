@@ -0,0 +1,46 @@
+package artifacts
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/wandb/wandb/core/pkg/utils"
+)
+
+func TestFileCache(t *testing.T) {
+	dir := t.TempDir()
+	cache := NewFileCache(filepath.Join(dir, "cache"))
+	src := filepath.Join(dir, "model.pt")
+	assert.NoError(t, os.WriteFile(src, []byte("weights"), 0644))
+	digest, err := utils.ComputeFileB64MD5(src)
+	assert.NoError(t, err)
+
+	dst := filepath.Join(dir, "download", "model.pt")
+	restored, err := cache.Restore(digest, dst)
+	assert.NoError(t, err)
+	assert.False(t, restored)
+
+	assert.NoError(t, cache.Add(digest, src))
+	hexDigest, _ := utils.B64ToHex(digest)
+	assert.FileExists(t, filepath.Join(dir, "cache", "obj", "md5", hexDigest[:2], hexDigest[2:]))
+
+	restored, err = cache.Restore(digest, dst)
+	assert.NoError(t, err)
+	assert.True(t, restored)
+	data, err := os.ReadFile(dst)
+	assert.NoError(t, err)
+	assert.Equal(t, "weights", string(data))
+
+	// a corrupted file is not restored
+	path, _ := cache.path(digest)
+	assert.NoError(t, os.WriteFile(path, []byte("corrupted"), 0644))
+	restored, err = cache.Restore(digest, filepath.Join(dir, "other.pt"))
+	assert.NoError(t, err)
+	assert.False(t, restored)
+
+	_, err = cache.Restore("not base64!", dst)
+	assert.Error(t, err)
+}
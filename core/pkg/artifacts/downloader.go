@@ -3,6 +3,7 @@ package artifacts
 import (
 	"context"
 	"fmt"
+	"os"
 	"path/filepath"
 	"time"
 
@@ -24,6 +25,8 @@ type ArtifactDownloader struct {
 	ArtifactID             string
 	DownloadRoot           string
 	AllowMissingReferences *bool
+	// Cache has the files already downloaded, nil to download them all
+	Cache *FileCache
 }
 
 func NewArtifactDownloader(
@@ -33,6 +36,7 @@ func NewArtifactDownloader(
 	artifactID string,
 	downloadRoot string,
 	allowMissingReferences *bool,
+	cache *FileCache,
 ) *ArtifactDownloader {
 	return &ArtifactDownloader{
 		Ctx:                    ctx,
@@ -41,6 +45,7 @@ func NewArtifactDownloader(
 		ArtifactID:             artifactID,
 		DownloadRoot:           downloadRoot,
 		AllowMissingReferences: allowMissingReferences,
+		Cache:                  cache,
 	}
 }
 
@@ -78,6 +83,8 @@ func (ad *ArtifactDownloader) downloadFiles(artifactID string, manifest Manifest
 	type TaskResult struct {
 		Task *filetransfer.Task
 		Name string
+		// Err is the error of the verification of the downloaded file
+		Err error
 	}
 
 	// Fetch URLs and download files in batches
@@ -133,6 +140,8 @@ func (ad *ArtifactDownloader) downloadFiles(artifactID string, manifest Manifest
 			// Schedule downloads
 			if len(manifestEntriesBatch) > 0 {
 				for _, entry := range manifestEntriesBatch {
+					// the completion callback runs after the loop moved on
+					entry := entry
 					// Add function that returns download path?
 					downloadLocalPath := filepath.Join(ad.DownloadRoot, *entry.LocalPath)
 					// Skip downloading the file if it already exists and has the same digest.
@@ -150,6 +159,14 @@ func (ad *ArtifactDownloader) downloadFiles(artifactID string, manifest Manifest
 							continue
 						}
 					}
+					// the cache is best effort, the files it fails to restore
+					// are downloaded
+					if ad.Cache != nil {
+						if restored, err := ad.Cache.Restore(entry.Digest, downloadLocalPath); err == nil && restored {
+							numDone++
+							continue
+						}
+					}
 					task := &filetransfer.Task{
 						Type: filetransfer.DownloadTask,
 						Path: downloadLocalPath,
@@ -157,7 +174,11 @@ func (ad *ArtifactDownloader) downloadFiles(artifactID string, manifest Manifest
 					}
 					task.AddCompletionCallback(
 						func(task *filetransfer.Task) {
-							taskResultsChan <- TaskResult{task, *entry.LocalPath}
+							var err error
+							if task.Err == nil {
+								err = ad.verify(entry, task.Path)
+							}
+							taskResultsChan <- TaskResult{task, *entry.LocalPath, err}
 						},
 					)
 					numInProgress++
@@ -177,6 +198,9 @@ func (ad *ArtifactDownloader) downloadFiles(artifactID string, manifest Manifest
 					delete(nameToScheduledTime, result.Name) // retry
 					continue
 				}
+				if result.Err != nil {
+					return result.Err
+				}
 				numDone++
 			}
 		}
@@ -184,6 +208,23 @@ func (ad *ArtifactDownloader) downloadFiles(artifactID string, manifest Manifest
 	return nil
 }
 
+// verify checks that a downloaded file has the digest of its entry, and adds
+// it to the cache if it can
+func (ad *ArtifactDownloader) verify(entry ManifestEntry, path string) error {
+	digest, err := utils.ComputeFileB64MD5(path)
+	if err != nil {
+		return err
+	}
+	if digest != entry.Digest {
+		_ = os.Remove(path)
+		return fmt.Errorf("artifacts: checksum mismatch for %s: got %s, want %s", *entry.LocalPath, digest, entry.Digest)
+	}
+	if ad.Cache != nil {
+		_ = ad.Cache.Add(entry.Digest, path)
+	}
+	return nil
+}
+
 func (ad *ArtifactDownloader) Download() (rerr error) {
 	artifactManifest, err := ad.getArtifactManifest(ad.ArtifactID)
 	if err != nil {
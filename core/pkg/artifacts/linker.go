@@ -6,6 +6,7 @@ import (
 
 	"github.com/Khan/genqlient/graphql"
 
+	"github.com/wandb/wandb/core/internal/clients"
 	"github.com/wandb/wandb/core/internal/gql"
 	"github.com/wandb/wandb/core/pkg/observability"
 	"github.com/wandb/wandb/core/pkg/service"
@@ -39,7 +40,7 @@ func (al *ArtifactLinker) Link() error {
 	switch {
 	case server_id != "":
 		response, err = gql.LinkArtifact(
-			al.Ctx,
+			clients.WithIdempotencyKey(al.Ctx),
 			al.GraphqlClient,
 			portfolio_name,
 			portfolio_entity,
@@ -50,7 +51,7 @@ func (al *ArtifactLinker) Link() error {
 		)
 	case client_id != "":
 		response, err = gql.LinkArtifact(
-			al.Ctx,
+			clients.WithIdempotencyKey(al.Ctx),
 			al.GraphqlClient,
 			portfolio_name,
 			portfolio_entity,
@@ -10,6 +10,7 @@ import (
 
 	"github.com/Khan/genqlient/graphql"
 
+	"github.com/wandb/wandb/core/internal/clients"
 	"github.com/wandb/wandb/core/internal/filetransfer"
 	"github.com/wandb/wandb/core/internal/gql"
 	"github.com/wandb/wandb/core/pkg/service"
@@ -59,7 +60,7 @@ func (as *ArtifactSaver) createArtifact() (
 		)
 	}
 	response, err := gql.CreateArtifact(
-		as.Ctx,
+		clients.WithIdempotencyKey(as.Ctx),
 		as.GraphqlClient,
 		as.Artifact.Entity,
 		as.Artifact.Project,
@@ -96,7 +97,7 @@ func (as *ArtifactSaver) createManifest(
 	}
 
 	response, err := gql.CreateArtifactManifest(
-		as.Ctx,
+		clients.WithIdempotencyKey(as.Ctx),
 		as.GraphqlClient,
 		artifactId,
 		baseArtifactId,
@@ -160,7 +161,7 @@ func (as *ArtifactSaver) uploadFiles(artifactID string, manifest *Manifest, mani
 		if len(fileSpecsBatch) > 0 {
 			// Fetch upload URLs.
 			response, err := gql.CreateArtifactFiles(
-				as.Ctx,
+				clients.WithIdempotencyKey(as.Ctx),
 				as.GraphqlClient,
 				fileSpecsBatch,
 				gql.ArtifactStorageLayoutV2,
@@ -181,6 +182,8 @@ func (as *ArtifactSaver) uploadFiles(artifactID string, manifest *Manifest, mani
 				entry := manifest.Contents[name]
 				entry.BirthArtifactID = &edge.Node.Artifact.Id
 				manifest.Contents[name] = entry
+				// the backend has no upload URL for content it already has
+				// with the MD5 of the entry, it is not uploaded again
 				if edge.Node.UploadUrl == nil {
 					numDone++
 					continue
@@ -311,7 +314,7 @@ func (as *ArtifactSaver) uploadManifest(manifestFile string, uploadUrl *string,
 
 func (as *ArtifactSaver) commitArtifact(artifactID string) error {
 	_, err := gql.CommitArtifact(
-		as.Ctx,
+		clients.WithIdempotencyKey(as.Ctx),
 		as.GraphqlClient,
 		artifactID,
 	)
@@ -319,6 +322,11 @@ func (as *ArtifactSaver) commitArtifact(artifactID string) error {
 }
 
 func (as *ArtifactSaver) deleteStagingFiles(manifest *Manifest) {
+	// the artifacts logged while offline are saved without a staging dir,
+	// their files are not removed
+	if as.StagingDir == "" {
+		return
+	}
 	for _, entry := range manifest.Contents {
 		if entry.LocalPath != nil && strings.HasPrefix(*entry.LocalPath, as.StagingDir) {
 			// We intentionally ignore errors below.
@@ -350,7 +358,7 @@ func (as *ArtifactSaver) Save(ch chan<- *service.Record) (artifactID string, rer
 	if artifactAttrs.State == gql.ArtifactStateCommitted {
 		if as.Artifact.UseAfterCommit {
 			_, err := gql.UseArtifact(
-				as.Ctx,
+				clients.WithIdempotencyKey(as.Ctx),
 				as.GraphqlClient,
 				as.Artifact.Entity,
 				as.Artifact.Project,
@@ -407,7 +415,7 @@ func (as *ArtifactSaver) Save(ch chan<- *service.Record) (artifactID string, rer
 
 		if as.Artifact.UseAfterCommit {
 			_, err = gql.UseArtifact(
-				as.Ctx,
+				clients.WithIdempotencyKey(as.Ctx),
 				as.GraphqlClient,
 				as.Artifact.Entity,
 				as.Artifact.Project,
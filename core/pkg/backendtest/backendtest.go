@@ -0,0 +1,393 @@
+// Package backendtest implements a fake W&B backend for tests.
+//
+// The fake serves the endpoints the sender talks to:
+//
+//	POST /graphql                                    - GraphQL operations
+//	POST /files/{entity}/{project}/{run}/file_stream - file stream updates
+//	PUT  /upload/{path}                              - file uploads
+//	GET  /upload/{path}                              - downloads of the uploaded files
+//
+// It keeps the requests it receives so that tests can check what was sent,
+// and it can be scripted to fail some of them to exercise the retries.
+package backendtest
+
+import (
+	"compress/gzip"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+
+	"github.com/segmentio/encoding/json"
+
+	"github.com/wandb/wandb/core/pkg/filestream"
+)
+
+// GraphQLHandler returns the data of the response to a GraphQL operation
+// from its variables, an error is returned to the client as a GraphQL error
+type GraphQLHandler func(variables map[string]interface{}) (interface{}, error)
+
+// Fault makes the fake fail the requests that match it.
+type Fault struct {
+	// Path is the prefix of the paths of the requests to fail, for example
+	// "/graphql" or "/files/", an empty path matches all the requests
+	Path string
+
+	// Operation is the GraphQL operation to fail, an empty operation matches
+	// all the GraphQL requests
+	Operation string
+
+	// Status is the status code of the responses, zero closes the
+	// connection without a response
+	Status int
+
+	// Header is added to the responses, for example a Retry-After header
+	Header http.Header
+
+	// Times is the number of requests to fail
+	Times int
+}
+
+// matches returns whether the fault applies to a request
+func (f *Fault) matches(path string, operation string) bool {
+	if f.Times <= 0 || !strings.HasPrefix(path, f.Path) {
+		return false
+	}
+	return f.Operation == "" || f.Operation == operation
+}
+
+// Request is a request received by the fake.
+type Request struct {
+	Method string
+	Path   string
+	Header http.Header
+
+	// Operation is the name of the GraphQL operation, if any
+	Operation string
+
+	// Variables are the variables of the GraphQL operation, if any
+	Variables map[string]interface{}
+
+	// Failed is set if the request was failed by a fault
+	Failed bool
+}
+
+// Server is a fake W&B backend.
+type Server struct {
+	server *httptest.Server
+
+	mu sync.Mutex
+
+	// graphql are the handlers of the GraphQL operations by name
+	graphql map[string]GraphQLHandler
+
+	// faults are the failures left to inject
+	faults []*Fault
+
+	// requests are all the requests received, in order
+	requests []Request
+
+	// fileStream are the file stream updates received, in order
+	fileStream []filestream.FsTransmitData
+
+	// uploads are the contents of the uploaded files by path
+	uploads map[string][]byte
+}
+
+// NewServer starts a fake backend, it must be closed with Close.
+//
+// The fake answers UpsertBucket, CreateRunFiles, RunResumeStatus, ServerInfo
+// and Viewer with the responses of a server on which the runs are new, the
+// other operations fail unless they are handled with HandleGraphQL.
+func NewServer() *Server {
+	s := &Server{
+		graphql: make(map[string]GraphQLHandler),
+		uploads: make(map[string][]byte),
+	}
+	s.graphql["UpsertBucket"] = upsertBucket
+	s.graphql["CreateRunFiles"] = s.createRunFiles
+	s.graphql["RunResumeStatus"] = func(map[string]interface{}) (interface{}, error) {
+		return map[string]interface{}{"model": nil}, nil
+	}
+	s.graphql["ServerInfo"] = func(map[string]interface{}) (interface{}, error) {
+		return map[string]interface{}{"serverInfo": map[string]interface{}{}}, nil
+	}
+	s.graphql["Viewer"] = func(map[string]interface{}) (interface{}, error) {
+		return map[string]interface{}{"viewer": map[string]interface{}{"id": "mock", "entity": "mock"}}, nil
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/graphql", s.serveGraphQL)
+	mux.HandleFunc("/files/", s.serveFileStream)
+	mux.HandleFunc("/upload/", s.serveUpload)
+	s.server = httptest.NewServer(mux)
+	return s
+}
+
+// URL returns the base URL of the fake, to use as the base_url setting.
+func (s *Server) URL() string {
+	return s.server.URL
+}
+
+// Close shuts down the fake.
+func (s *Server) Close() {
+	s.server.Close()
+}
+
+// HandleGraphQL sets the handler of a GraphQL operation.
+func (s *Server) HandleGraphQL(operation string, handler GraphQLHandler) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.graphql[operation] = handler
+}
+
+// Inject makes the fake fail the next requests that match the fault, the
+// faults are applied in the order they are injected.
+func (s *Server) Inject(fault Fault) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.faults = append(s.faults, &fault)
+}
+
+// Requests returns the requests received so far.
+func (s *Server) Requests() []Request {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]Request(nil), s.requests...)
+}
+
+// GraphQLRequests returns the requests received so far for a GraphQL
+// operation, including the failed ones.
+func (s *Server) GraphQLRequests(operation string) []Request {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var requests []Request
+	for _, request := range s.requests {
+		if request.Operation == operation {
+			requests = append(requests, request)
+		}
+	}
+	return requests
+}
+
+// FileStreamRequests returns the file stream updates received so far,
+// excluding the failed ones.
+func (s *Server) FileStreamRequests() []filestream.FsTransmitData {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]filestream.FsTransmitData(nil), s.fileStream...)
+}
+
+// Upload returns the content of an uploaded file, and whether it was
+// uploaded.
+func (s *Server) Upload(path string) ([]byte, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	content, ok := s.uploads[path]
+	return content, ok
+}
+
+// UploadURL returns the URL to upload a file to.
+func (s *Server) UploadURL(path string) string {
+	return fmt.Sprintf("%s/upload/%s", s.server.URL, path)
+}
+
+// record records a request, and fails it if a fault matches it. It returns
+// whether the request was failed.
+func (s *Server) record(w http.ResponseWriter, request Request) bool {
+	s.mu.Lock()
+	var fault *Fault
+	for _, f := range s.faults {
+		if f.matches(request.Path, request.Operation) {
+			f.Times--
+			fault = f
+			break
+		}
+	}
+	request.Failed = fault != nil
+	s.requests = append(s.requests, request)
+	s.mu.Unlock()
+
+	if fault == nil {
+		return false
+	}
+	status := fault.Status
+	if status == 0 {
+		if conn, _, err := http.NewResponseController(w).Hijack(); err == nil {
+			_ = conn.Close()
+			return true
+		}
+		status = http.StatusServiceUnavailable
+	}
+	for key, values := range fault.Header {
+		for _, value := range values {
+			w.Header().Add(key, value)
+		}
+	}
+	http.Error(w, http.StatusText(status), status)
+	return true
+}
+
+// graphQLError is an error of a GraphQL response
+type graphQLError struct {
+	Message string `json:"message"`
+}
+
+// graphQLResponse is the body of a GraphQL response
+type graphQLResponse struct {
+	Data   interface{}    `json:"data,omitempty"`
+	Errors []graphQLError `json:"errors,omitempty"`
+}
+
+func (s *Server) serveGraphQL(w http.ResponseWriter, r *http.Request) {
+	var body struct {
+		OperationName string                 `json:"operationName"`
+		Variables     map[string]interface{} `json:"variables"`
+	}
+	if err := decodeBody(r, &body); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	if s.record(w, Request{
+		Method:    r.Method,
+		Path:      r.URL.Path,
+		Header:    r.Header.Clone(),
+		Operation: body.OperationName,
+		Variables: body.Variables,
+	}) {
+		return
+	}
+
+	s.mu.Lock()
+	handler, ok := s.graphql[body.OperationName]
+	s.mu.Unlock()
+
+	var response graphQLResponse
+	if !ok {
+		response.Errors = []graphQLError{{Message: "backendtest: no handler for " + body.OperationName}}
+	} else if data, err := handler(body.Variables); err != nil {
+		response.Errors = []graphQLError{{Message: err.Error()}}
+	} else {
+		response.Data = data
+	}
+	writeJSON(w, response)
+}
+
+func (s *Server) serveFileStream(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost || !strings.HasSuffix(r.URL.Path, "/file_stream") {
+		http.NotFound(w, r)
+		return
+	}
+	var data filestream.FsTransmitData
+	if err := decodeBody(r, &data); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	if s.record(w, Request{Method: r.Method, Path: r.URL.Path, Header: r.Header.Clone()}) {
+		return
+	}
+	s.mu.Lock()
+	s.fileStream = append(s.fileStream, data)
+	s.mu.Unlock()
+	writeJSON(w, map[string]interface{}{"exitcode": nil, "limits": map[string]interface{}{}})
+}
+
+func (s *Server) serveUpload(w http.ResponseWriter, r *http.Request) {
+	if s.record(w, Request{Method: r.Method, Path: r.URL.Path, Header: r.Header.Clone()}) {
+		return
+	}
+	path := strings.TrimPrefix(r.URL.Path, "/upload/")
+	switch r.Method {
+	case http.MethodPut:
+		content, err := io.ReadAll(r.Body)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		s.mu.Lock()
+		s.uploads[path] = content
+		s.mu.Unlock()
+		w.WriteHeader(http.StatusOK)
+	case http.MethodGet:
+		content, ok := s.Upload(path)
+		if !ok {
+			http.NotFound(w, r)
+			return
+		}
+		_, _ = w.Write(content)
+	default:
+		http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+	}
+}
+
+// upsertBucket answers UpsertBucket as if the run was created
+func upsertBucket(variables map[string]interface{}) (interface{}, error) {
+	name, _ := variables["name"].(string)
+	project, _ := variables["project"].(string)
+	if project == "" {
+		project = "uncategorized"
+	}
+	entity, _ := variables["entity"].(string)
+	if entity == "" {
+		entity = "mock"
+	}
+	return map[string]interface{}{
+		"upsertBucket": map[string]interface{}{
+			"bucket": map[string]interface{}{
+				"id":          name,
+				"name":        name,
+				"displayName": name,
+				"project": map[string]interface{}{
+					"id":     project,
+					"name":   project,
+					"entity": map[string]interface{}{"id": entity, "name": entity},
+				},
+			},
+			"inserted": true,
+		},
+	}, nil
+}
+
+// createRunFiles answers CreateRunFiles with upload URLs of the fake
+func (s *Server) createRunFiles(variables map[string]interface{}) (interface{}, error) {
+	run, _ := variables["run"].(string)
+	names, _ := variables["files"].([]interface{})
+	files := make([]interface{}, 0, len(names))
+	for _, name := range names {
+		name, _ := name.(string)
+		files = append(files, map[string]interface{}{
+			"name":      name,
+			"uploadUrl": s.UploadURL(run + "/" + name),
+		})
+	}
+	return map[string]interface{}{
+		"createRunFiles": map[string]interface{}{
+			"runID":         run,
+			"uploadHeaders": []string{},
+			"files":         files,
+		},
+	}, nil
+}
+
+// decodeBody decodes the JSON body of a request, which may be compressed
+func decodeBody(r *http.Request, value interface{}) error {
+	body := io.Reader(r.Body)
+	if r.Header.Get("Content-Encoding") == "gzip" {
+		reader, err := gzip.NewReader(r.Body)
+		if err != nil {
+			return err
+		}
+		defer reader.Close()
+		body = reader
+	}
+	return json.NewDecoder(body).Decode(value)
+}
+
+func writeJSON(w http.ResponseWriter, value interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(value); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+	}
+}
@@ -0,0 +1,91 @@
+package backendtest_test
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/wandb/wandb/core/pkg/backendtest"
+)
+
+func postGraphQL(t *testing.T, backend *backendtest.Server, operation string) (*http.Response, string) {
+	body := `{"operationName":"` + operation + `","variables":{"name":"run1"}}`
+	resp, err := http.Post(backend.URL()+"/graphql", "application/json", strings.NewReader(body))
+	assert.NoError(t, err)
+	defer resp.Body.Close()
+	data, err := io.ReadAll(resp.Body)
+	assert.NoError(t, err)
+	return resp, string(data)
+}
+
+func TestServerFaults(t *testing.T) {
+	backend := backendtest.NewServer()
+	defer backend.Close()
+	backend.Inject(backendtest.Fault{
+		Operation: "UpsertBucket",
+		Status:    http.StatusTooManyRequests,
+		Header:    http.Header{"Retry-After": []string{"1"}},
+		Times:     1,
+	})
+
+	resp, _ := postGraphQL(t, backend, "UpsertBucket")
+	assert.Equal(t, http.StatusTooManyRequests, resp.StatusCode)
+	assert.Equal(t, "1", resp.Header.Get("Retry-After"))
+
+	resp, body := postGraphQL(t, backend, "UpsertBucket")
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Contains(t, body, `"name":"run1"`)
+
+	requests := backend.GraphQLRequests("UpsertBucket")
+	assert.Len(t, requests, 2)
+	assert.True(t, requests[0].Failed)
+	assert.Equal(t, "run1", requests[1].Variables["name"])
+}
+
+func TestServerUnhandledOperation(t *testing.T) {
+	backend := backendtest.NewServer()
+	defer backend.Close()
+
+	resp, body := postGraphQL(t, backend, "LinkArtifact")
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Contains(t, body, "no handler for LinkArtifact")
+
+	backend.HandleGraphQL("LinkArtifact", func(map[string]interface{}) (interface{}, error) {
+		return map[string]interface{}{"linkArtifact": nil}, nil
+	})
+	_, body = postGraphQL(t, backend, "LinkArtifact")
+	assert.Contains(t, body, `"linkArtifact":null`)
+}
+
+func TestServerUpload(t *testing.T) {
+	backend := backendtest.NewServer()
+	defer backend.Close()
+	// the connection is closed without a response
+	backend.Inject(backendtest.Fault{Path: "/upload/", Times: 1})
+
+	put := func() (*http.Response, error) {
+		req, err := http.NewRequest(http.MethodPut, backend.UploadURL("run1/model.pt"), bytes.NewBufferString("weights"))
+		assert.NoError(t, err)
+		return http.DefaultClient.Do(req)
+	}
+	_, err := put()
+	assert.Error(t, err)
+	resp, err := put()
+	assert.NoError(t, err)
+	resp.Body.Close()
+
+	content, ok := backend.Upload("run1/model.pt")
+	assert.True(t, ok)
+	assert.Equal(t, "weights", string(content))
+
+	resp, err = http.Get(backend.UploadURL("run1/model.pt"))
+	assert.NoError(t, err)
+	defer resp.Body.Close()
+	data, err := io.ReadAll(resp.Body)
+	assert.NoError(t, err)
+	assert.Equal(t, "weights", string(data))
+}
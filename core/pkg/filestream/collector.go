@@ -21,23 +21,39 @@ type chunkCollector struct {
 	fileChunks        chunkMap
 	maxItemsPerPush   int
 	itemsCollected    int
+	maxBytesPerPush   int
+	bytesCollected    int
 	isOverflow        bool
 	isTransmitReady   bool
 	isDirty           bool
 	transmitData      *FsTransmitData
 	finalTransmitData *FsTransmitData
+
+	// pending is the chunk that did not fit in the last push, it starts the
+	// next one
+	pending *processedChunk
+
+	// movePath is the path the stream moves to before the push
+	movePath string
 }
 
 func (cr *chunkCollector) reset() {
 	cr.fileChunks = make(chunkMap)
 	cr.itemsCollected = 0
+	cr.bytesCollected = 0
 	cr.transmitData = &FsTransmitData{}
 	cr.isTransmitReady = false
 	cr.isDirty = false
+	cr.movePath = ""
 }
 
 func (cr *chunkCollector) read() bool {
 	cr.reset()
+	if cr.pending != nil {
+		cr.addFileChunk(*cr.pending)
+		cr.pending = nil
+		return true
+	}
 	select {
 	case chunk, ok := <-cr.input:
 		if !ok {
@@ -71,8 +87,15 @@ func (cr *chunkCollector) readMore() {
 				cr.isDone = true
 				return
 			}
+			// the chunks before a move are pushed to the current path
+			if chunk.movePath != "" || cr.isFull(chunk) {
+				cr.pending = &chunk
+				cr.isOverflow = true
+				return
+			}
 			cr.addFileChunk(chunk)
-			if cr.itemsCollected >= cr.maxItemsPerPush {
+			if cr.itemsCollected >= cr.maxItemsPerPush ||
+				(cr.maxBytesPerPush > 0 && cr.bytesCollected >= cr.maxBytesPerPush) {
 				cr.isOverflow = true
 				return
 			}
@@ -82,6 +105,15 @@ func (cr *chunkCollector) readMore() {
 	}
 }
 
+// isFull returns whether the chunk would make the push larger than the
+// maximum payload size, a push always has at least one chunk
+func (cr *chunkCollector) isFull(chunk processedChunk) bool {
+	if cr.maxBytesPerPush <= 0 || cr.itemsCollected == 0 {
+		return false
+	}
+	return cr.bytesCollected+len(chunk.fileLine) > cr.maxBytesPerPush
+}
+
 func (cr *chunkCollector) update(chunk processedChunk) {
 	// Complete and Exitcode are saved to finalTransmitData because
 	// they need to be sent last
@@ -101,8 +133,11 @@ func (cr *chunkCollector) update(chunk processedChunk) {
 		cr.transmitData.Preempting = chunk.Preempting
 		cr.isDirty = true
 
+	case chunk.movePath != "":
+		cr.movePath = chunk.movePath
+
 	case chunk.Uploaded != nil:
-		cr.transmitData.Uploaded = chunk.Uploaded
+		cr.transmitData.Uploaded = append(cr.transmitData.Uploaded, chunk.Uploaded...)
 		cr.isDirty = true
 	}
 }
@@ -115,6 +150,7 @@ func (cr *chunkCollector) addFileChunk(chunk processedChunk) {
 		cr.update(chunk)
 	}
 	cr.itemsCollected += 1
+	cr.bytesCollected += len(chunk.fileLine)
 }
 
 func (cr *chunkCollector) dumpFinalTransmit() {
@@ -156,3 +156,94 @@ func TestIsDirtyResetAfterDump(t *testing.T) {
 	collector.dump(offset)
 	assert.False(t, collector.isDirty)
 }
+
+func TestCollectMaxBytes(t *testing.T) {
+	input := make(chan processedChunk, 32)
+	for _, line := range []string{"line1", "line2", "line3"} {
+		input <- processedChunk{
+			fileType: HistoryChunk,
+			fileLine: line,
+		}
+	}
+	collector := chunkCollector{
+		input:           input,
+		heartbeatTime:   60 * time.Second,
+		delayProcess:    30 * time.Second,
+		maxItemsPerPush: 100,
+		maxBytesPerPush: 12,
+	}
+	offset := FileStreamOffsetMap{}
+
+	// the line that does not fit starts the next push
+	assert.True(t, collector.read())
+	collector.readMore()
+	assert.Equal(t,
+		map[string]fsTransmitFileData{
+			"wandb-history.jsonl": {
+				Offset:  0,
+				Content: []string{"line1", "line2"},
+			},
+		},
+		collector.dump(offset).Files,
+	)
+
+	close(input)
+	assert.True(t, collector.read())
+	collector.readMore()
+	assert.Equal(t,
+		map[string]fsTransmitFileData{
+			"wandb-history.jsonl": {
+				Offset:  2,
+				Content: []string{"line3"},
+			},
+		},
+		collector.dump(offset).Files,
+	)
+	assert.Nil(t, collector.pending)
+}
+
+func TestCollectMaxBytesLargeLine(t *testing.T) {
+	input := make(chan processedChunk, 32)
+	input <- processedChunk{
+		fileType: HistoryChunk,
+		fileLine: "a line longer than the limit",
+	}
+	input <- processedChunk{
+		fileType: HistoryChunk,
+		fileLine: "line",
+	}
+	collector := chunkCollector{
+		input:           input,
+		heartbeatTime:   60 * time.Second,
+		delayProcess:    30 * time.Second,
+		maxItemsPerPush: 100,
+		maxBytesPerPush: 8,
+	}
+
+	// a line larger than the limit is sent on its own
+	assert.True(t, collector.read())
+	collector.readMore()
+	assert.Equal(t,
+		[]string{"a line longer than the limit"},
+		collector.dump(FileStreamOffsetMap{}).Files["wandb-history.jsonl"].Content,
+	)
+	assert.Equal(t, "line", collector.pending.fileLine)
+}
+
+func TestCollectUploaded(t *testing.T) {
+	input := make(chan processedChunk, 32)
+	input <- processedChunk{Uploaded: []string{"model.pt"}}
+	input <- processedChunk{Uploaded: []string{"config.yaml"}}
+	collector := chunkCollector{
+		input:           input,
+		heartbeatTime:   60 * time.Second,
+		delayProcess:    100 * time.Millisecond,
+		maxItemsPerPush: 100,
+	}
+	assert.True(t, collector.read())
+	collector.readMore()
+	assert.Equal(t,
+		[]string{"model.pt", "config.yaml"},
+		collector.dump(FileStreamOffsetMap{}).Uploaded,
+	)
+}
@@ -32,7 +32,10 @@
 package filestream
 
 import (
+	"context"
+	"fmt"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/hashicorp/go-retryablehttp"
@@ -49,8 +52,10 @@ const (
 	SummaryFileName        = "wandb-summary.json"
 	OutputFileName         = "output.log"
 	defaultMaxItemsPerPush = 5_000
+	defaultMaxBytesPerPush = 10 << 20
 	defaultDelayProcess    = 20 * time.Millisecond
 	defaultHeartbeatTime   = 2 * time.Second
+	defaultKeepaliveTime   = 30 * time.Second
 )
 
 type ChunkTypeEnum int8
@@ -89,10 +94,22 @@ type FileStream struct {
 	httpClient *retryablehttp.Client
 
 	maxItemsPerPush int
+	maxBytesPerPush int
 	delayProcess    time.Duration
 	heartbeatTime   time.Duration
 
+	// keepaliveTime is how long the stream can stay idle before an empty
+	// update is sent, so that the run is not shown as crashed
+	keepaliveTime time.Duration
+
 	clientId string
+
+	// ctx is the context of the requests, the data left to send is dropped
+	// once it is done
+	ctx context.Context
+
+	// unsentRequests is the number of requests dropped because ctx is done
+	unsentRequests atomic.Int32
 }
 
 type FileStreamOption func(fs *FileStream)
@@ -121,12 +138,28 @@ func WithHttpClient(client *retryablehttp.Client) FileStreamOption {
 	}
 }
 
+// WithContext sets the context of the requests, the requests are aborted
+// and the data left is dropped once it is done
+func WithContext(ctx context.Context) FileStreamOption {
+	return func(fs *FileStream) {
+		fs.ctx = ctx
+	}
+}
+
 func WithMaxItemsPerPush(maxItemsPerPush int) FileStreamOption {
 	return func(fs *FileStream) {
 		fs.maxItemsPerPush = maxItemsPerPush
 	}
 }
 
+// WithMaxBytesPerPush limits the size of the lines sent in a single request,
+// zero or less means no limit
+func WithMaxBytesPerPush(maxBytesPerPush int) FileStreamOption {
+	return func(fs *FileStream) {
+		fs.maxBytesPerPush = maxBytesPerPush
+	}
+}
+
 func WithClientId(clientId string) FileStreamOption {
 	// TODO: this should be the default behavior in the future
 	return func(fs *FileStream) {
@@ -148,6 +181,14 @@ func WithHeartbeatTime(heartbeatTime time.Duration) FileStreamOption {
 	}
 }
 
+// WithKeepaliveTime sets how long the stream can stay idle before an empty
+// update is sent to keep the run alive, zero or less disables the updates
+func WithKeepaliveTime(keepaliveTime time.Duration) FileStreamOption {
+	return func(fs *FileStream) {
+		fs.keepaliveTime = keepaliveTime
+	}
+}
+
 func WithOffsets(offsetMap FileStreamOffsetMap) FileStreamOption {
 	return func(fs *FileStream) {
 		for k, v := range offsetMap {
@@ -163,6 +204,11 @@ func WithOffsets(offsetMap FileStreamOffsetMap) FileStreamOption {
 //	}
 // }
 
+// Path returns the path of the file stream of a run
+func Path(baseURL, entity, project, runID string) string {
+	return fmt.Sprintf("%s/files/%s/%s/%s/file_stream", baseURL, entity, project, runID)
+}
+
 func NewFileStream(opts ...FileStreamOption) *FileStream {
 	fs := &FileStream{
 		processWait:     &sync.WaitGroup{},
@@ -173,8 +219,11 @@ func NewFileStream(opts ...FileStreamOption) *FileStream {
 		feedbackChan:    make(chan map[string]interface{}, BufferSize),
 		offsetMap:       make(FileStreamOffsetMap),
 		maxItemsPerPush: defaultMaxItemsPerPush,
+		maxBytesPerPush: defaultMaxBytesPerPush,
 		delayProcess:    defaultDelayProcess,
 		heartbeatTime:   defaultHeartbeatTime,
+		keepaliveTime:   defaultKeepaliveTime,
+		ctx:             context.Background(),
 	}
 	for _, opt := range opts {
 		opt(fs)
@@ -214,6 +263,25 @@ func (fs *FileStream) StreamRecord(rec *service.Record) {
 	fs.addProcess(rec)
 }
 
+// UnsentRequests returns the number of requests dropped because the context
+// of the stream is done
+func (fs *FileStream) UnsentRequests() int32 {
+	if fs == nil {
+		return 0
+	}
+	return fs.unsentRequests.Load()
+}
+
+// Load returns how full the queues of the stream are, from 0 to 1. It stays
+// near 1 while the records are streamed faster than they are sent.
+func (fs *FileStream) Load() float64 {
+	if fs == nil {
+		return 0
+	}
+	queued := len(fs.processChan) + len(fs.transmitChan)
+	return float64(queued) / float64(cap(fs.processChan)+cap(fs.transmitChan))
+}
+
 func (fs *FileStream) GetInputChan() chan protoreflect.ProtoMessage {
 	return fs.processChan
 }
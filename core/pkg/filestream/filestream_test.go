@@ -20,6 +20,7 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/wandb/wandb/core/pkg/service"
+	"google.golang.org/protobuf/types/known/wrapperspb"
 )
 
 type captureState struct {
@@ -200,3 +201,66 @@ func BenchmarkHistory(b *testing.B) {
 		})
 	assert.Equal(b, num, tst.capture.m["total"].(int))
 }
+
+func TestLoad(t *testing.T) {
+	// the records queue up while the stream is not started
+	fs := filestream.NewFileStream(filestream.WithLogger(observability.NewNoOpLogger()))
+	assert.Zero(t, fs.Load())
+	for i := 0; i < filestream.BufferSize; i++ {
+		fs.StreamRecord(NewHistoryRecord())
+	}
+	assert.Equal(t, 0.5, fs.Load())
+}
+
+func TestMove(t *testing.T) {
+	var lock sync.Mutex
+	received := make(map[string][]filestream.FsTransmitData)
+	hserver := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var data filestream.FsTransmitData
+		assert.Nil(t, json.NewDecoder(r.Body).Decode(&data))
+		lock.Lock()
+		received[r.URL.Path] = append(received[r.URL.Path], data)
+		lock.Unlock()
+		_, _ = w.Write([]byte("{}"))
+	}))
+	defer hserver.Close()
+
+	settings := &service.Settings{BaseUrl: &wrapperspb.StringValue{Value: hserver.URL}}
+	logger := observability.NewNoOpLogger()
+	fs := filestream.NewFileStream(
+		filestream.WithPath(filestream.Path(hserver.URL, "entity", "project", "run")),
+		filestream.WithOffsets(filestream.FileStreamOffsetMap{filestream.HistoryChunk: 5}),
+		filestream.WithSettings(settings),
+		filestream.WithLogger(logger),
+		filestream.WithHttpClient(clients.NewRetryClient(clients.WithRetryClientLogger(logger))),
+	)
+	fs.Start()
+	fs.StreamRecord(NewHistoryRecord())
+	fs.StreamRecord(NewHistoryRecord())
+	fs.StreamRecord(&service.Record{
+		RecordType: &service.Record_Request{Request: &service.Request{
+			RequestType: &service.Request_RunMove{RunMove: &service.RunMoveRequest{
+				Entity: "entity", Project: "moved", RunId: "run",
+			}},
+		}},
+	})
+	fs.StreamRecord(NewHistoryRecord())
+	fs.Close()
+
+	// the old run gets the history before the move, and is finished
+	old := received["/files/entity/project/run/file_stream"]
+	if assert.Len(t, old, 2) {
+		history := old[0].Files[filestream.HistoryFileName]
+		assert.Equal(t, 5, history.Offset)
+		assert.Len(t, history.Content, 2)
+		assert.True(t, *old[1].Complete)
+	}
+
+	// the new run gets the history after the move from the start
+	moved := received["/files/entity/moved/run/file_stream"]
+	if assert.Len(t, moved, 1) {
+		history := moved[0].Files[filestream.HistoryFileName]
+		assert.Equal(t, 0, history.Offset)
+		assert.Len(t, history.Content, 1)
+	}
+}
@@ -19,6 +19,9 @@ type processedChunk struct {
 	Exitcode   *int32
 	Preempting bool
 	Uploaded   []string
+
+	// movePath is the path the stream continues at, after this chunk
+	movePath string
 }
 
 func (fs *FileStream) addProcess(rec *service.Record) {
@@ -39,6 +42,8 @@ func (fs *FileStream) processRecord(record *service.Record) {
 		fs.streamFinish(x.Exit)
 	case *service.Record_Preempting:
 		fs.streamPreempting(x.Preempting)
+	case *service.Record_Request:
+		fs.streamRequest(x.Request)
 	case nil:
 		err := fmt.Errorf("filestream: field not set")
 		fs.logger.CaptureFatalAndPanic("filestream error:", err)
@@ -147,6 +152,24 @@ func (fs *FileStream) streamPreempting(exitRecord *service.RunPreemptingRecord)
 	})
 }
 
+func (fs *FileStream) streamRequest(msg *service.Request) {
+	switch x := msg.RequestType.(type) {
+	case *service.Request_RunMove:
+		fs.streamMove(x.RunMove)
+	default:
+		err := fmt.Errorf("filestream: Unknown request type %T", x)
+		fs.logger.CaptureFatalAndPanic("filestream error:", err)
+	}
+}
+
+// streamMove continues the stream at the run of the request, the data
+// streamed before it is sent to the current run
+func (fs *FileStream) streamMove(msg *service.RunMoveRequest) {
+	fs.addTransmit(processedChunk{
+		movePath: Path(fs.settings.GetBaseUrl().GetValue(), msg.Entity, msg.Project, msg.RunId),
+	})
+}
+
 func (fs *FileStream) streamFilesUploaded(msg *service.FilesUploaded) {
 	fs.addTransmit(processedChunk{
 		Uploaded: msg.Files,
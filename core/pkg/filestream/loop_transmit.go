@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"io"
 	"net/http"
+	"time"
 
 	"github.com/segmentio/encoding/json"
 
@@ -36,18 +37,38 @@ func (fs *FileStream) loopTransmit(inChan <-chan processedChunk) {
 		heartbeatTime:   fs.heartbeatTime,
 		delayProcess:    fs.delayProcess,
 		maxItemsPerPush: fs.maxItemsPerPush,
+		maxBytesPerPush: fs.maxBytesPerPush,
 	}
+	lastSent := time.Now()
 	for !collector.isDone {
 		if readMore := collector.read(); readMore {
 			collector.readMore()
 		}
+		if collector.movePath != "" {
+			fs.move(collector.movePath)
+		}
 		data := collector.dump(fs.offsetMap)
+		if data == nil && fs.keepaliveTime > 0 && time.Since(lastSent) >= fs.keepaliveTime {
+			// the backend marks the runs it has not heard from as crashed
+			data = &FsTransmitData{}
+		}
 		if data != nil {
 			fs.send(data)
+			lastSent = time.Now()
 		}
 	}
 }
 
+// move finishes the stream of the current run, and continues the stream at
+// another path from the start of the files
+func (fs *FileStream) move(path string) {
+	fs.logger.Info("filestream: moving the stream", "from", fs.path, "to", path)
+	exitCode := int32(0)
+	fs.send(&FsTransmitData{Complete: &boolTrue, Exitcode: &exitCode})
+	fs.path = path
+	fs.offsetMap = make(FileStreamOffsetMap)
+}
+
 func (fs *FileStream) send(data interface{}) {
 	jsonData, err := json.Marshal(data)
 	if err != nil {
@@ -56,12 +77,17 @@ func (fs *FileStream) send(data interface{}) {
 	fs.logger.Debug("filestream: post request", "request", string(jsonData))
 
 	buffer := bytes.NewBuffer(jsonData)
-	req, err := retryablehttp.NewRequest(http.MethodPost, fs.path, buffer)
+	req, err := retryablehttp.NewRequestWithContext(fs.ctx, http.MethodPost, fs.path, buffer)
 	if err != nil {
 		fs.logger.CaptureFatalAndPanic("filestream: error creating HTTP request", err)
 	}
 	req.Header.Set("Content-Type", "application/json")
 	resp, err := fs.httpClient.Do(req)
+	if err != nil && fs.ctx.Err() != nil {
+		fs.unsentRequests.Add(1)
+		fs.logger.Warn("filestream: dropping request, the stream is cancelled", "error", err)
+		return
+	}
 	if err != nil {
 		fs.logger.CaptureFatalAndPanic("filestream: error making HTTP request", err)
 	}
@@ -5,6 +5,7 @@ import (
 	"net/http"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/segmentio/encoding/json"
 
@@ -85,3 +86,35 @@ func TestSendChunks(t *testing.T) {
 	}
 	testSendAndReceive(t, []processedChunk{send}, expect)
 }
+
+func TestSendKeepalive(t *testing.T) {
+	fsTest := newFsTest(t)
+
+	keepalives := make(chan string, 16)
+	fsTest.m.EXPECT().
+		RoundTrip(gomock.Any()).
+		DoAndReturn(func(req *http.Request) (*http.Response, error) {
+			body, err := io.ReadAll(req.Body)
+			assert.Nil(t, err)
+			keepalives <- string(body)
+			return &http.Response{StatusCode: 200, Body: io.NopCloser(strings.NewReader("{}"))}, nil
+		}).
+		AnyTimes()
+
+	fs := NewFileStream(
+		WithLogger(fsTest.logger),
+		WithHttpClient(fsTest.client),
+		WithHeartbeatTime(time.Millisecond),
+		WithKeepaliveTime(10*time.Millisecond),
+	)
+	fs.Start()
+
+	// an idle stream sends empty updates
+	select {
+	case body := <-keepalives:
+		assert.Equal(t, "{}", body)
+	case <-time.After(5 * time.Second):
+		t.Fatal("no keepalive was sent")
+	}
+	fs.Close()
+}
@@ -0,0 +1,193 @@
+package gowandb
+
+import (
+	"fmt"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"runtime"
+	"strings"
+
+	"github.com/segmentio/encoding/json"
+
+	"github.com/wandb/wandb/core/pkg/artifacts"
+	"github.com/wandb/wandb/core/pkg/service"
+	"github.com/wandb/wandb/core/pkg/utils"
+)
+
+// artifactName matches the valid names of artifacts
+var artifactName = regexp.MustCompile(`^[a-zA-Z0-9_\-.]+$`)
+
+// Artifact is a versioned set of local files, logged by a run as its
+// output or its input. The files are uploaded from where they are, they must
+// not change until the artifact is logged.
+type Artifact struct {
+	Name        string
+	Type        string
+	Description string
+	Metadata    map[string]interface{}
+
+	// Aliases are the aliases of the version logged, latest if there are none
+	Aliases []string
+
+	entries []*service.ArtifactManifestEntry
+}
+
+// NewArtifact creates an artifact without files
+func NewArtifact(name, artifactType string) *Artifact {
+	return &Artifact{Name: name, Type: artifactType}
+}
+
+// AddFile adds the file at a local path to the artifact, as name, or as its
+// base name if name is empty. The digest of the file is computed when it is
+// added.
+func (a *Artifact) AddFile(localPath, name string) error {
+	if name == "" {
+		name = filepath.Base(localPath)
+	}
+	name = filepath.ToSlash(name)
+	if !fs.ValidPath(name) || name == "." {
+		return fmt.Errorf("gowandb: invalid artifact file name %q", name)
+	}
+	for _, entry := range a.entries {
+		if entry.GetPath() == name {
+			return fmt.Errorf("gowandb: the artifact has a file %s already", name)
+		}
+	}
+
+	localPath, err := filepath.Abs(localPath)
+	if err != nil {
+		return err
+	}
+	info, err := os.Stat(localPath)
+	if err != nil {
+		return err
+	}
+	if !info.Mode().IsRegular() {
+		return fmt.Errorf("gowandb: %s is not a file", localPath)
+	}
+	digest, err := utils.ComputeFileB64MD5(localPath)
+	if err != nil {
+		return err
+	}
+	a.entries = append(a.entries, &service.ArtifactManifestEntry{
+		Path:      name,
+		Digest:    digest,
+		LocalPath: localPath,
+		Size:      info.Size(),
+	})
+	return nil
+}
+
+// AddDir adds the files under a local directory to the artifact, named by
+// their path relative to it under prefix
+func (a *Artifact) AddDir(localPath, prefix string) error {
+	return filepath.WalkDir(localPath, func(p string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() {
+			return err
+		}
+		rel, err := filepath.Rel(localPath, p)
+		if err != nil {
+			return err
+		}
+		return a.AddFile(p, path.Join(filepath.ToSlash(prefix), filepath.ToSlash(rel)))
+	})
+}
+
+// record returns the record of the artifact logged by a run
+func (a *Artifact) record(run *service.RunRecord, use bool) (*service.ArtifactRecord, error) {
+	switch {
+	case !artifactName.MatchString(a.Name):
+		return nil, &Error{
+			Code:    service.ErrorInfo_USAGE,
+			Message: fmt.Sprintf("gowandb: invalid artifact name %q", a.Name),
+		}
+	case a.Type == "":
+		return nil, &Error{
+			Code:    service.ErrorInfo_USAGE,
+			Message: fmt.Sprintf("gowandb: the artifact %s needs a type", a.Name),
+		}
+	}
+	var metadata string
+	if len(a.Metadata) > 0 {
+		encoded, err := json.Marshal(a.Metadata)
+		if err != nil {
+			return nil, err
+		}
+		metadata = string(encoded)
+	}
+	aliases := a.Aliases
+	if len(aliases) == 0 {
+		aliases = []string{"latest"}
+	}
+
+	builder := artifacts.NewArtifactBuilder(&service.ArtifactRecord{
+		Entity:           run.GetEntity(),
+		Project:          run.GetProject(),
+		RunId:            run.GetRunId(),
+		Name:             a.Name,
+		Type:             a.Type,
+		Description:      a.Description,
+		Metadata:         metadata,
+		Aliases:          aliases,
+		UserCreated:      true,
+		UseAfterCommit:   use,
+		Finalize:         true,
+		ClientId:         utils.GenerateAlphanumericSequence(128),
+		SequenceClientId: utils.GenerateAlphanumericSequence(128),
+	})
+	for _, entry := range a.entries {
+		builder.AddEntry(entry.GetPath(), entry.GetLocalPath(), entry.GetDigest(), entry.GetSize())
+	}
+	return builder.GetArtifact(), nil
+}
+
+// artifactPath is the path of a version of an artifact
+type artifactPath struct {
+	entity  string
+	project string
+	name    string
+	alias   string
+}
+
+// parseArtifactPath parses [[entity/]project/]name[:alias], the entity and
+// the project are those given if they are missing and the alias is latest
+func parseArtifactPath(path, entity, project string) (artifactPath, error) {
+	parsed := artifactPath{entity: entity, project: project, alias: "latest"}
+	parts := strings.Split(path, "/")
+	switch len(parts) {
+	case 1:
+	case 2:
+		parsed.project = parts[0]
+	case 3:
+		parsed.entity, parsed.project = parts[0], parts[1]
+	default:
+		return artifactPath{}, &Error{
+			Code:    service.ErrorInfo_USAGE,
+			Message: fmt.Sprintf("gowandb: invalid artifact path %q", path),
+		}
+	}
+	name := parts[len(parts)-1]
+	if i := strings.LastIndex(name, ":"); i >= 0 {
+		name, parsed.alias = name[:i], name[i+1:]
+	}
+	if !artifactName.MatchString(name) || parsed.alias == "" {
+		return artifactPath{}, &Error{
+			Code:    service.ErrorInfo_USAGE,
+			Message: fmt.Sprintf("gowandb: invalid artifact path %q", path),
+		}
+	}
+	parsed.name = name
+	return parsed, nil
+}
+
+// directory is the directory the version is downloaded to by default, the
+// colon is not allowed in the paths of Windows
+func (p artifactPath) directory() string {
+	if runtime.GOOS == "windows" {
+		return p.name + "-" + p.alias
+	}
+	return p.name + ":" + p.alias
+}
@@ -0,0 +1,127 @@
+package gowandb
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/wandb/wandb/core/pkg/service"
+)
+
+func TestArtifactRecord(t *testing.T) {
+	dir := t.TempDir()
+	assert.NoError(t, os.MkdirAll(filepath.Join(dir, "data", "sub"), 0755))
+	assert.NoError(t, os.WriteFile(filepath.Join(dir, "model.pt"), []byte("weights"), 0644))
+	assert.NoError(t, os.WriteFile(filepath.Join(dir, "data", "a.csv"), []byte("a,b\n1,2\n"), 0644))
+	assert.NoError(t, os.WriteFile(filepath.Join(dir, "data", "sub", "b.csv"), []byte("c\n3\n"), 0644))
+
+	artifact := NewArtifact("model", "model")
+	artifact.Metadata = map[string]interface{}{"epochs": 3}
+	assert.NoError(t, artifact.AddFile(filepath.Join(dir, "model.pt"), ""))
+	assert.NoError(t, artifact.AddDir(filepath.Join(dir, "data"), "dataset"))
+	assert.Error(t, artifact.AddFile(filepath.Join(dir, "model.pt"), "model.pt"))
+	assert.Error(t, artifact.AddFile(filepath.Join(dir, "data"), "data"))
+	assert.Error(t, artifact.AddFile(filepath.Join(dir, "missing"), ""))
+
+	record, err := artifact.record(&service.RunRecord{Entity: "e", Project: "p", RunId: "run1"}, true)
+	assert.NoError(t, err)
+	assert.Equal(t, "run1", record.GetRunId())
+	assert.Equal(t, []string{"latest"}, record.GetAliases())
+	assert.True(t, record.GetUseAfterCommit())
+	assert.True(t, record.GetFinalize())
+	assert.JSONEq(t, `{"epochs": 3}`, record.GetMetadata())
+	assert.NotEmpty(t, record.GetDigest())
+
+	var paths []string
+	for _, entry := range record.GetManifest().GetContents() {
+		paths = append(paths, entry.GetPath())
+		assert.NotEmpty(t, entry.GetDigest())
+		assert.True(t, filepath.IsAbs(entry.GetLocalPath()))
+	}
+	assert.ElementsMatch(t, []string{"model.pt", "dataset/a.csv", "dataset/sub/b.csv"}, paths)
+
+	_, err = NewArtifact("bad/name", "model").record(&service.RunRecord{}, false)
+	assert.ErrorIs(t, err, ErrUsage)
+}
+
+func TestParseArtifactPath(t *testing.T) {
+	testCases := []struct {
+		path    string
+		want    artifactPath
+		wantErr bool
+	}{
+		{"model", artifactPath{"ent", "proj", "model", "latest"}, false},
+		{"model:v3", artifactPath{"ent", "proj", "model", "v3"}, false},
+		{"other/model:best", artifactPath{"ent", "other", "model", "best"}, false},
+		{"team/other/model", artifactPath{"team", "other", "model", "latest"}, false},
+		{"a/b/c/model", artifactPath{}, true},
+		{"model:", artifactPath{}, true},
+		{"bad name", artifactPath{}, true},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.path, func(t *testing.T) {
+			got, err := parseArtifactPath(tc.path, "ent", "proj")
+			if tc.wantErr {
+				assert.ErrorIs(t, err, ErrUsage)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
+
+func TestDownloadArtifact(t *testing.T) {
+	var query string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		query = string(body)
+		_, _ = w.Write([]byte(`{"data": {"project": {"artifact": {"id": "artifact1", "digest": "d"}}}}`))
+	}))
+	defer server.Close()
+
+	run, requests, respond := pipeRun(t)
+	run.graphqlClient = graphql.NewClient(server.URL, server.Client())
+	run.run = &service.RunRecord{Entity: "ent", Project: "proj"}
+	run.conn.Start()
+
+	root := t.TempDir()
+	done := make(chan error, 1)
+	go func() {
+		_, err := run.DownloadArtifact(context.Background(), "model:v1", root)
+		done <- err
+	}()
+	record := (<-requests).GetRecordCommunicate()
+	request := record.GetRequest().GetDownloadArtifact()
+	assert.Equal(t, "artifact1", request.GetArtifactId())
+	assert.Equal(t, root, request.GetDownloadRoot())
+	assert.Contains(t, query, `"name":"model:v1"`)
+	assert.Contains(t, query, `"projectName":"proj"`)
+
+	respond(record, &service.Result{ResultType: &service.Result_Response{Response: &service.Response{
+		ResponseType: &service.Response_DownloadArtifactResponse{DownloadArtifactResponse: &service.DownloadArtifactResponse{
+			ErrorMessage: "checksum mismatch",
+		}},
+	}}})
+	assert.ErrorContains(t, <-done, "checksum mismatch")
+}
+
+func TestDownloadArtifactClientOnce(t *testing.T) {
+	run, _, _ := pipeRun(t)
+
+	// the downloads made at once share the client made by the first
+	clients := make(chan graphql.Client, 2)
+	for i := 0; i < 2; i++ {
+		go func() { clients <- run.graphql() }()
+	}
+	client := <-clients
+	assert.NotNil(t, client)
+	assert.Equal(t, client, <-clients)
+}
@@ -0,0 +1,172 @@
+package gowandb
+
+import (
+	"fmt"
+	"reflect"
+	"sync"
+
+	"github.com/segmentio/encoding/json"
+
+	"github.com/wandb/wandb/core/pkg/service"
+)
+
+// Config is the config of a run. Its values are kept as they are encoded to
+// JSON, the nested maps and structs are merged key by key. Once the run
+// started, the keys changed by an update are sent to it as they change.
+type Config struct {
+	mu sync.Mutex
+
+	values map[string]interface{}
+
+	// locked are the keys whose value can't be changed, e.g. the parameters
+	// of a sweep
+	locked map[string]bool
+
+	// send sends the keys changed by an update to the run, nil until the run
+	// started
+	send func(*service.ConfigRecord)
+}
+
+// newConfig creates the config of a run with its initial values
+func newConfig(values map[string]interface{}) *Config {
+	c := &Config{values: make(map[string]interface{}), locked: make(map[string]bool)}
+	if len(values) > 0 {
+		_ = c.Update(values)
+	}
+	return c
+}
+
+// Get returns the value of a key of the config, as decoded from JSON
+func (c *Config) Get(key string) (interface{}, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	value, ok := c.values[key]
+	return value, ok
+}
+
+// Set sets the value of a key of the config, a map or a struct is merged
+// with the value the key has
+func (c *Config) Set(key string, value interface{}) error {
+	return c.Update(map[string]interface{}{key: value})
+}
+
+// Update merges a map or a struct with the config, the values of its nested
+// maps and structs are merged with the values of the same nested keys. The
+// locked keys keep their value.
+func (c *Config) Update(values interface{}) error {
+	update, err := configValues(values)
+	if err != nil {
+		return err
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	var items []*service.ConfigItem
+	for key, value := range update {
+		if c.locked[key] {
+			continue
+		}
+		items = c.merge(c.values, []string{key}, value, items)
+	}
+	if c.send != nil && len(items) > 0 {
+		c.send(&service.ConfigRecord{Update: items})
+	}
+	return nil
+}
+
+// Lock locks keys of the config, the updates to them are ignored from then
+// on
+func (c *Config) Lock(keys ...string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for _, key := range keys {
+		c.locked[key] = true
+	}
+}
+
+// merge merges a value at a path of the config, and returns the items with
+// the nested keys it changed
+func (c *Config) merge(
+	target map[string]interface{},
+	path []string,
+	value interface{},
+	items []*service.ConfigItem,
+) []*service.ConfigItem {
+	key := path[len(path)-1]
+	nested, isMap := value.(map[string]interface{})
+	current, hasMap := target[key].(map[string]interface{})
+	switch {
+	case isMap && hasMap:
+		for nestedKey, nestedValue := range nested {
+			items = c.merge(current, append(path[:len(path):len(path)], nestedKey), nestedValue, items)
+		}
+		return items
+	case reflect.DeepEqual(target[key], value):
+		return items
+	}
+
+	target[key] = value
+	data, err := json.Marshal(value)
+	if err != nil {
+		return items
+	}
+	item := &service.ConfigItem{ValueJson: string(data)}
+	if len(path) == 1 {
+		item.Key = key
+	} else {
+		item.NestedKey = path
+	}
+	return append(items, item)
+}
+
+// restore sets the config of a resumed run to the config it was logged with,
+// before the run started so that it is not sent back
+func (c *Config) restore(record *service.ConfigRecord) {
+	values := make(map[string]interface{})
+	for _, item := range record.GetUpdate() {
+		var value interface{}
+		if err := json.Unmarshal([]byte(item.GetValueJson()), &value); err != nil {
+			continue
+		}
+		values[item.GetKey()] = value
+	}
+	_ = c.Update(values)
+}
+
+// start sends the updates made from then on to the run
+func (c *Config) start(send func(*service.ConfigRecord)) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.send = send
+}
+
+// record returns the record of all the values of the config
+func (c *Config) record() *service.ConfigRecord {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	config := &service.ConfigRecord{}
+	for key, value := range c.values {
+		data, err := json.Marshal(value)
+		if err != nil {
+			continue
+		}
+		config.Update = append(config.Update, &service.ConfigItem{Key: key, ValueJson: string(data)})
+	}
+	return config
+}
+
+// configValues returns the values of a map or a struct as decoded from JSON
+func configValues(values interface{}) (map[string]interface{}, error) {
+	data, err := json.Marshal(values)
+	if err != nil {
+		return nil, &Error{Code: service.ErrorInfo_USAGE, Message: fmt.Sprintf("gowandb: invalid config: %v", err)}
+	}
+	var decoded map[string]interface{}
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		return nil, &Error{
+			Code:    service.ErrorInfo_USAGE,
+			Message: fmt.Sprintf("gowandb: the config must be a map or a struct, not %T", values),
+		}
+	}
+	return decoded, nil
+}
@@ -0,0 +1,48 @@
+package gowandb
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/wandb/wandb/core/pkg/service"
+)
+
+func TestConfigUpdate(t *testing.T) {
+	type optimizer struct {
+		Name string  `json:"name"`
+		LR   float64 `json:"lr"`
+	}
+	config := newConfig(map[string]interface{}{"epochs": 10, "seed": 1})
+	var sent []*service.ConfigRecord
+	config.start(func(record *service.ConfigRecord) { sent = append(sent, record) })
+
+	assert.NoError(t, config.Set("optimizer", optimizer{Name: "adam", LR: 0.1}))
+	assert.Len(t, sent, 1)
+	assert.Equal(t, "optimizer", sent[0].GetUpdate()[0].GetKey())
+	assert.JSONEq(t, `{"name": "adam", "lr": 0.1}`, sent[0].GetUpdate()[0].GetValueJson())
+
+	// only the nested keys that changed are sent
+	assert.NoError(t, config.Update(map[string]interface{}{
+		"epochs":    10,
+		"optimizer": map[string]interface{}{"name": "adam", "lr": 0.01},
+	}))
+	assert.Len(t, sent, 2)
+	assert.Len(t, sent[1].GetUpdate(), 1)
+	assert.Equal(t, []string{"optimizer", "lr"}, sent[1].GetUpdate()[0].GetNestedKey())
+	assert.Equal(t, "0.01", sent[1].GetUpdate()[0].GetValueJson())
+	value, ok := config.Get("optimizer")
+	assert.True(t, ok)
+	assert.Equal(t, map[string]interface{}{"name": "adam", "lr": 0.01}, value)
+
+	// the locked keys keep their value
+	config.Lock("seed")
+	assert.NoError(t, config.Update(map[string]interface{}{"seed": 2, "epochs": 20}))
+	assert.Len(t, sent, 3)
+	assert.Equal(t, "epochs", sent[2].GetUpdate()[0].GetKey())
+	seed, _ := config.Get("seed")
+	assert.Equal(t, float64(1), seed)
+
+	assert.ErrorIs(t, config.Update([]int{1}), ErrUsage)
+	assert.Len(t, config.record().GetUpdate(), 3)
+}
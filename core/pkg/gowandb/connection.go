@@ -5,6 +5,9 @@ import (
 	"context"
 	"encoding/binary"
 	"fmt"
+	"io"
+	"log/slog"
+	"sync"
 
 	"github.com/wandb/wandb/core/pkg/server"
 	"github.com/wandb/wandb/core/pkg/service"
@@ -22,11 +25,21 @@ type Connection struct {
 	// Conn is the connection to the server
 	net.Conn
 	Mbox *Mailbox
+
+	// sendMu serializes the messages sent by the runs sharing the connection
+	sendMu sync.Mutex
+
+	// startOnce makes sure the connection is only received from once
+	startOnce sync.Once
+
+	// wg waits for the receiving goroutine
+	wg sync.WaitGroup
 }
 
 // NewConnection creates a new connection to the server.
 func NewConnection(ctx context.Context, addr string) (*Connection, error) {
-	conn, err := net.Dial("tcp", addr)
+	var dialer net.Dialer
+	conn, err := dialer.DialContext(ctx, "tcp", addr)
 	if err != nil {
 		err = fmt.Errorf("error connecting to server: %w", err)
 		return nil, err
@@ -40,28 +53,46 @@ func NewConnection(ctx context.Context, addr string) (*Connection, error) {
 	return connection, nil
 }
 
-// Send sends a message to the server.
+// Send sends a message to the server, the errors of writing to the server
+// wrap ErrNetwork. It is safe to call from several goroutines.
 func (c *Connection) Send(msg proto.Message) error {
 	data, err := proto.Marshal(msg)
 	if err != nil {
 		return fmt.Errorf("error marshaling message: %w", err)
 	}
+	c.sendMu.Lock()
+	defer c.sendMu.Unlock()
 	writer := bufio.NewWriterSize(c, 16384)
 
 	header := server.Header{Magic: byte('W'), DataLength: uint32(len(data))}
 	err = binary.Write(writer, binary.LittleEndian, &header)
 	if err != nil {
-		return fmt.Errorf("error writing header: %w", err)
+		return fmt.Errorf("%w: error writing header: %w", ErrNetwork, err)
 	}
 	if _, err = writer.Write(data); err != nil {
-		return fmt.Errorf("error writing message: %w", err)
+		return fmt.Errorf("%w: error writing message: %w", ErrNetwork, err)
 	}
 	if err = writer.Flush(); err != nil {
-		return fmt.Errorf("error flushing writer: %w", err)
+		return fmt.Errorf("%w: error flushing writer: %w", ErrNetwork, err)
 	}
 	return nil
 }
 
+// Start starts receiving responses from the server in the background, it is
+// a no-op if the connection was already started.
+func (c *Connection) Start() {
+	c.startOnce.Do(func() {
+		c.wg.Add(1)
+		go func() {
+			c.Recv()
+			c.wg.Done()
+		}()
+	})
+}
+
+// Recv receives the responses from the server until the connection is
+// closed or a response can't be unmarshaled, the requests still waiting for
+// their response then fail with an error that wraps ErrNetwork.
 func (c *Connection) Recv() {
 	scanner := bufio.NewScanner(c.Conn)
 	tokenizer := &server.Tokenizer{}
@@ -70,7 +101,9 @@ func (c *Connection) Recv() {
 		msg := &service.ServerResponse{}
 		err := proto.Unmarshal(scanner.Bytes(), msg)
 		if err != nil {
-			panic(err)
+			slog.Error("error unmarshaling server response", "err", err)
+			c.Mbox.Fail(fmt.Errorf("%w: error unmarshaling server response: %w", ErrNetwork, err))
+			return
 		}
 		switch x := msg.ServerResponseType.(type) {
 		case *service.ServerResponse_ResultCommunicate:
@@ -78,12 +111,17 @@ func (c *Connection) Recv() {
 		default:
 		}
 	}
+	err := scanner.Err()
+	if err == nil {
+		err = io.EOF
+	}
+	c.Mbox.Fail(fmt.Errorf("%w: connection to the server closed: %w", ErrNetwork, err))
 }
 
-// Close closes the connection.
-func (c *Connection) Close() {
+// Close closes the connection and waits for the receiving goroutine, if
+// the connection was started. It returns the error of closing the socket.
+func (c *Connection) Close() error {
 	err := c.Conn.Close()
-	if err != nil {
-		return
-	}
+	c.wg.Wait()
+	return err
 }
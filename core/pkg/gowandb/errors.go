@@ -0,0 +1,64 @@
+package gowandb
+
+import (
+	"errors"
+
+	"github.com/wandb/wandb/core/pkg/service"
+)
+
+// The kinds of errors reported by the server, use errors.Is to check the
+// kind of an Error.
+var (
+	ErrAuthentication  = errors.New("gowandb: authentication failed")
+	ErrQuotaExceeded   = errors.New("gowandb: quota exceeded")
+	ErrProjectNotFound = errors.New("gowandb: project not found")
+	ErrNetwork         = errors.New("gowandb: network error")
+	ErrServer          = errors.New("gowandb: server error")
+	ErrUsage           = errors.New("gowandb: invalid usage")
+	ErrUnsupported     = errors.New("gowandb: unsupported")
+)
+
+// ErrClosed is returned by a manager, and the session of the manager, once it
+// is closed.
+//
+// The errors that wrap ErrNetwork are recoverable, the server could not be
+// reached and the call can be retried. ErrClosed and the errors of the
+// server that wrap ErrAuthentication, ErrUsage or ErrUnsupported are fatal,
+// retrying the call fails the same way.
+var ErrClosed = errors.New("gowandb: manager is closed")
+
+// codeErrors are the kinds of the error codes
+var codeErrors = map[service.ErrorInfo_ErrorCode]error{
+	service.ErrorInfo_AUTHENTICATION:    ErrAuthentication,
+	service.ErrorInfo_QUOTA_EXCEEDED:    ErrQuotaExceeded,
+	service.ErrorInfo_PROJECT_NOT_FOUND: ErrProjectNotFound,
+	service.ErrorInfo_COMMUNICATION:     ErrNetwork,
+	service.ErrorInfo_SERVER:            ErrServer,
+	service.ErrorInfo_USAGE:             ErrUsage,
+	service.ErrorInfo_UNSUPPORTED:       ErrUnsupported,
+}
+
+// Error is an error reported by the server, for example when a run could not
+// be created.
+type Error struct {
+	Code    service.ErrorInfo_ErrorCode
+	Message string
+}
+
+func (e *Error) Error() string {
+	return e.Message
+}
+
+// Unwrap returns the kind of the error, nil if it is unknown.
+func (e *Error) Unwrap() error {
+	return codeErrors[e.Code]
+}
+
+// errorFromInfo returns the error of an error reported by the server, nil if
+// there is none
+func errorFromInfo(info *service.ErrorInfo) error {
+	if info == nil {
+		return nil
+	}
+	return &Error{Code: info.GetCode(), Message: info.GetMessage()}
+}
@@ -0,0 +1,29 @@
+package gowandb_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/wandb/wandb/core/pkg/gowandb"
+	"github.com/wandb/wandb/core/pkg/service"
+)
+
+func TestErrorKinds(t *testing.T) {
+	err := fmt.Errorf("creating run: %w", &gowandb.Error{
+		Code:    service.ErrorInfo_AUTHENTICATION,
+		Message: "failed to upsert bucket: 401 Unauthorized",
+	})
+	assert.ErrorIs(t, err, gowandb.ErrAuthentication)
+	assert.False(t, errors.Is(err, gowandb.ErrNetwork))
+	assert.ErrorContains(t, err, "401 Unauthorized")
+
+	var wandbErr *gowandb.Error
+	assert.True(t, errors.As(err, &wandbErr))
+	assert.Equal(t, service.ErrorInfo_AUTHENTICATION, wandbErr.Code)
+
+	unknown := &gowandb.Error{Code: service.ErrorInfo_UNKNOWN, Message: "unknown"}
+	assert.Nil(t, unknown.Unwrap())
+}
@@ -1,10 +1,30 @@
 // package gowandb implements the go Weights & Biases SDK
+//
+// The stable API of the package is Session, Run, Config, Artifact and the
+// media types, with the options of the opts packages and the settings
+// package. It follows semantic versioning with Version: a minor version adds
+// to it and only a major version breaks it. The other exported names, like
+// Manager and Connection, are used by wandb-core itself and may change in
+// any version.
+//
+// The package is part of the module of wandb-core, since it shares the
+// internal packages of wandb-core. It is imported as
+// github.com/wandb/wandb/core/pkg/gowandb.
 package gowandb
 
 import (
+	"fmt"
+
+	"github.com/Khan/genqlient/graphql"
+
+	"github.com/wandb/wandb/core/internal/clients"
 	"github.com/wandb/wandb/core/pkg/gowandb/opts/sessionopts"
+	"github.com/wandb/wandb/core/pkg/service"
 )
 
+// Version is the version of the stable API of the package
+const Version = "0.1.0"
+
 type History map[string]interface{}
 
 func NewSession(opts ...sessionopts.SessionOption) (*Session, error) {
@@ -12,6 +32,21 @@ func NewSession(opts ...sessionopts.SessionOption) (*Session, error) {
 	for _, opt := range opts {
 		opt(&session.SessionParams)
 	}
-	session.start()
+	if err := session.start(); err != nil {
+		return nil, err
+	}
 	return session, nil
 }
+
+// newGraphqlClient creates a client of the GraphQL API of the server of the
+// settings, for the requests the client makes without a run
+func newGraphqlClient(settings *service.Settings) graphql.Client {
+	retryClient := clients.NewRetryClient(
+		clients.WithRetryClientHttpAuthTransport(
+			settings.GetApiKey().GetValue(),
+			settings.GetXExtraHttpHeaders().GetValue(),
+		),
+	)
+	url := fmt.Sprintf("%s/graphql", settings.GetBaseUrl().GetValue())
+	return graphql.NewClient(url, retryClient.StandardClient())
+}
@@ -1,7 +1,9 @@
 package gowandb
 
 import (
+	"context"
 	"strings"
+	"sync"
 
 	"github.com/wandb/wandb/core/internal/shared"
 	"github.com/wandb/wandb/core/pkg/service"
@@ -9,33 +11,83 @@ import (
 
 type MailboxHandle struct {
 	responseChan chan *service.Result
+
+	// mailbox and slot are where the handle waits, it is removed from the
+	// mailbox once it stops waiting
+	mailbox *Mailbox
+	slot    string
 }
 
 type Mailbox struct {
+	// mu guards the handles, they are delivered by the run and responded to
+	// by the goroutine receiving from the connection
+	mu      sync.Mutex
 	handles map[string]*MailboxHandle
+
+	// failed is closed by Fail once no more responses come, err is why
+	failed chan struct{}
+	err    error
 }
 
 func NewMailbox() *Mailbox {
-	mailbox := &Mailbox{}
+	mailbox := &Mailbox{failed: make(chan struct{})}
 	mailbox.handles = make(map[string]*MailboxHandle)
 	return mailbox
 }
 
 func NewMailboxHandle() *MailboxHandle {
-	mbh := &MailboxHandle{responseChan: make(chan *service.Result)}
+	// the response is buffered so that it is not blocked if it comes after
+	// the handle stopped waiting for it
+	mbh := &MailboxHandle{responseChan: make(chan *service.Result, 1)}
 	return mbh
 }
 
-func (mbh *MailboxHandle) wait() *service.Result {
-	got := <-mbh.responseChan
-	return got
+// wait waits for the response until ctx is done, it returns the error of ctx
+// if it is done first, and the error of the mailbox if it fails first
+func (mbh *MailboxHandle) wait(ctx context.Context) (*service.Result, error) {
+	var failed <-chan struct{}
+	if mbh.mailbox != nil {
+		failed = mbh.mailbox.failed
+	}
+	select {
+	case got := <-mbh.responseChan:
+		return got, nil
+	case <-failed:
+		// the response may have come just before the failure
+		select {
+		case got := <-mbh.responseChan:
+			return got, nil
+		default:
+		}
+		return nil, mbh.mailbox.err
+	case <-ctx.Done():
+		mbh.release()
+		return nil, ctx.Err()
+	}
+}
+
+// release removes the handle from its mailbox, for the handles that no
+// longer wait for their response
+func (mbh *MailboxHandle) release() {
+	if mbh.mailbox == nil {
+		return
+	}
+	mbh.mailbox.mu.Lock()
+	defer mbh.mailbox.mu.Unlock()
+	delete(mbh.mailbox.handles, mbh.slot)
 }
 
 func (mb *Mailbox) Deliver(rec *service.Record) *MailboxHandle {
 	uuid := "core:" + shared.ShortID(12)
 	rec.Control = &service.Control{MailboxSlot: uuid}
 	handle := NewMailboxHandle()
-	mb.handles[uuid] = handle
+	handle.mailbox = mb
+	handle.slot = uuid
+	mb.mu.Lock()
+	defer mb.mu.Unlock()
+	if mb.err == nil {
+		mb.handles[uuid] = handle
+	}
 	return handle
 }
 
@@ -44,11 +96,26 @@ func (mb *Mailbox) Respond(result *service.Result) bool {
 	if !strings.HasPrefix(slot, "core:") {
 		return false
 	}
+	mb.mu.Lock()
 	handle, ok := mb.handles[slot]
+	delete(mb.handles, slot)
+	mb.mu.Unlock()
 	if ok {
 		handle.responseChan <- result
-		// clean up after thyself?
-		delete(mb.handles, slot)
 	}
 	return ok
 }
+
+// Fail fails the handles waiting for their response, and the handles
+// delivered later, with err. It is called once no more responses come, it
+// is a no-op if the mailbox already failed.
+func (mb *Mailbox) Fail(err error) {
+	mb.mu.Lock()
+	defer mb.mu.Unlock()
+	if mb.err != nil {
+		return
+	}
+	mb.err = err
+	mb.handles = make(map[string]*MailboxHandle)
+	close(mb.failed)
+}
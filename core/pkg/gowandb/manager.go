@@ -2,13 +2,57 @@ package gowandb
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
 
 	"github.com/wandb/wandb/core/internal/shared"
 	"github.com/wandb/wandb/core/pkg/gowandb/opts/runopts"
 	"github.com/wandb/wandb/core/pkg/gowandb/settings"
 	"github.com/wandb/wandb/core/pkg/service"
+	"google.golang.org/protobuf/types/known/wrapperspb"
 )
 
+const (
+	// defaultConnectAttempts is the default number of attempts to connect
+	defaultConnectAttempts = 5
+	// defaultConnectBackoff is the default wait before the first retry,
+	// it doubles after every failed attempt
+	defaultConnectBackoff = 100 * time.Millisecond
+)
+
+// ManagerOption is an option for the manager
+type ManagerOption func(*Manager)
+
+// WithConnectRetry sets how many times the manager attempts to connect to
+// the server, and how long it waits before the first retry. The wait doubles
+// after every failed attempt.
+func WithConnectRetry(attempts int, backoff time.Duration) ManagerOption {
+	return func(m *Manager) {
+		m.connectAttempts = attempts
+		m.connectBackoff = backoff
+	}
+}
+
+// WithConnectionSharing makes the runs share the connections to the server
+// once there are as many as WithMaxConnections allows, instead of making
+// more. The records of the runs sharing a connection are told apart by the
+// server from the id of their run.
+func WithConnectionSharing() ManagerOption {
+	return func(m *Manager) {
+		m.shareConnections = true
+	}
+}
+
+// WithMaxConnections sets the number of connections to the server the
+// manager keeps for reuse by runs.
+func WithMaxConnections(maxConnections int) ManagerOption {
+	return func(m *Manager) {
+		m.maxConnections = maxConnections
+	}
+}
+
 // Manager is a collection of components that work together to handle incoming
 type Manager struct {
 	// ctx is the context for the run
@@ -19,48 +63,180 @@ type Manager struct {
 
 	// settings for all runs
 	settings *settings.SettingsWrap
+
+	// connectAttempts is the number of attempts to connect to the server
+	connectAttempts int
+
+	// connectBackoff is the wait before the first connection retry
+	connectBackoff time.Duration
+
+	// maxConnections is the size of the connection pool
+	maxConnections int
+
+	// shareConnections is whether the runs share the connections of the
+	// pool once it is exhausted
+	shareConnections bool
+
+	// pool is the pool of connections handed out to runs
+	pool *connectionPool
+
+	// mu guards the control connection and the closed state
+	mu sync.Mutex
+
+	// controlConn is the connection used for requests that are not tied to
+	// a run, like the teardown request
+	controlConn *Connection
+
+	// closed is whether the manager was closed
+	closed bool
 }
 
 // NewManager creates a new manager with the given settings and responders.
-func NewManager(ctx context.Context, baseSettings *settings.SettingsWrap, addr string) *Manager {
+func NewManager(ctx context.Context, baseSettings *settings.SettingsWrap, addr string, opts ...ManagerOption) *Manager {
 	manager := &Manager{
-		ctx:      ctx,
-		settings: baseSettings,
-		addr:     addr,
+		ctx:             ctx,
+		settings:        baseSettings,
+		addr:            addr,
+		connectAttempts: defaultConnectAttempts,
+		connectBackoff:  defaultConnectBackoff,
+		maxConnections:  defaultMaxConnections,
+	}
+	for _, opt := range opts {
+		opt(manager)
 	}
+	manager.pool = newConnectionPool(manager.maxConnections, defaultPoolWait, manager.Connect)
+	manager.pool.share = manager.shareConnections
 	return manager
 }
 
-func (m *Manager) NewRun(runParams *runopts.RunParams) *Run {
-	conn := m.Connect(m.ctx)
+// NewRun creates a run with the parameters, over a connection of the pool
+// that it waits for until ctx is done
+func (m *Manager) NewRun(ctx context.Context, runParams *runopts.RunParams) (*Run, error) {
+	if err := runParams.Validate(); err != nil {
+		return nil, err
+	}
 	// make a copy of the base manager settings
 	runSettings := m.settings.Copy()
+	if runParams.Offline != nil {
+		runSettings.SetOffline(*runParams.Offline)
+	}
 	if runParams.RunID != nil {
 		runSettings.SetRunID(*runParams.RunID)
 	} else if runSettings.RunId == nil {
 		runSettings.SetRunID(shared.ShortID(8))
+	} else if runParams.Offline != nil {
+		// the sync dir of the run is named after its mode
+		runSettings.SetRunID(runSettings.GetRunId().GetValue())
+	}
+	if runParams.SweepID != nil {
+		runSettings.SweepId = &wrapperspb.StringValue{Value: *runParams.SweepID}
+	}
+	if runParams.Resume != nil {
+		runSettings.Resume = &wrapperspb.StringValue{Value: *runParams.Resume}
+	}
+	if err := runSettings.Validate(); err != nil {
+		return nil, err
+	}
+	if err := runopts.ValidateRunID(runSettings.GetRunId().GetValue()); err != nil {
+		return nil, err
+	}
+
+	// establish the control connection while the server is known to be up,
+	// so that it can be used to tear the server down later
+	if err := m.ensureControlConnection(ctx); err != nil {
+		return nil, err
+	}
+	conn, err := m.pool.get(ctx)
+	if err != nil {
+		return nil, err
 	}
 	run := NewRun(m.ctx, runSettings.Settings, conn, runParams)
-	return run
+	run.release = m.pool.put
+	return run, nil
 }
 
-func (m *Manager) Connect(ctx context.Context) *Connection {
-	conn, err := NewConnection(ctx, m.addr)
-	// slog.Info("Connecting to server", "conn", conn.Conn.RemoteAddr().String())
+// Connect connects to the server, retrying with exponential backoff if the
+// server is not reachable yet. The error once the attempts are exhausted
+// wraps ErrNetwork, and the error of ctx if it is done first.
+func (m *Manager) Connect(ctx context.Context) (*Connection, error) {
+	backoff := m.connectBackoff
+	var err error
+	for attempt := 1; ; attempt++ {
+		var conn *Connection
+		conn, err = NewConnection(ctx, m.addr)
+		if err == nil {
+			return conn, nil
+		}
+		if ctx.Err() != nil {
+			return nil, fmt.Errorf("gowandb: connect cancelled: %w", ctx.Err())
+		}
+		if attempt >= m.connectAttempts {
+			break
+		}
+		select {
+		case <-ctx.Done():
+			return nil, fmt.Errorf("gowandb: connect cancelled: %w", ctx.Err())
+		case <-time.After(backoff):
+		}
+		backoff *= 2
+	}
+	return nil, fmt.Errorf("%w: failed to connect after %d attempts: %w", ErrNetwork, m.connectAttempts, err)
+}
+
+// ensureControlConnection connects the control connection if needed
+func (m *Manager) ensureControlConnection(ctx context.Context) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.closed {
+		return ErrClosed
+	}
+	if m.controlConn != nil {
+		return nil
+	}
+	conn, err := m.Connect(ctx)
 	if err != nil {
-		panic(err)
+		return err
 	}
-	return conn
+	m.controlConn = conn
+	return nil
 }
 
-func (m *Manager) Close() {
-	conn := m.Connect(m.ctx)
+// Close closes the idle pooled connections and tears down the server over
+// the control connection. If the control connection is missing or broken, a
+// new connection is made to send the teardown request, and the errors of
+// both are returned if that fails too. Calling Close more than once is a
+// no-op.
+func (m *Manager) Close() error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.closed {
+		return nil
+	}
+	m.closed = true
+	m.pool.close()
+
 	serverRecord := service.ServerRequest{
 		ServerRequestType: &service.ServerRequest_InformTeardown{InformTeardown: &service.ServerInformTeardownRequest{}},
 	}
-	err := conn.Send(&serverRecord)
+	var controlErr error
+	if m.controlConn != nil {
+		controlErr = m.controlConn.Send(&serverRecord)
+		// the server closes the connection once it is torn down
+		_ = m.controlConn.Close()
+		m.controlConn = nil
+		if controlErr == nil {
+			return nil
+		}
+		controlErr = fmt.Errorf("gowandb: failed to send teardown over the control connection: %w", controlErr)
+	}
+
+	conn, err := m.Connect(m.ctx)
 	if err != nil {
-		return
+		return errors.Join(controlErr, err)
+	}
+	defer conn.Close()
+	if err := conn.Send(&serverRecord); err != nil {
+		return errors.Join(controlErr, fmt.Errorf("%w: failed to send teardown: %w", ErrNetwork, err))
 	}
-	conn.Close()
+	return nil
 }
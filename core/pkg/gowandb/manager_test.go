@@ -0,0 +1,262 @@
+package gowandb_test
+
+import (
+	"bufio"
+	"context"
+	"net"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	"github.com/wandb/wandb/core/pkg/gowandb"
+	"github.com/wandb/wandb/core/pkg/gowandb/opts/runopts"
+	"github.com/wandb/wandb/core/pkg/gowandb/opts/sessionopts"
+	"github.com/wandb/wandb/core/pkg/gowandb/settings"
+	"github.com/wandb/wandb/core/pkg/server"
+	"github.com/wandb/wandb/core/pkg/service"
+)
+
+// unusedAddr returns an address nothing is listening on
+func unusedAddr(t *testing.T) string {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	assert.NoError(t, err)
+	addr := listener.Addr().String()
+	listener.Close()
+	return addr
+}
+
+func TestConnectFailsAfterRetries(t *testing.T) {
+	manager := gowandb.NewManager(context.Background(), settings.NewSettings(), unusedAddr(t),
+		gowandb.WithConnectRetry(3, time.Millisecond),
+	)
+	conn, err := manager.Connect(context.Background())
+	assert.Nil(t, conn)
+	assert.ErrorContains(t, err, "failed to connect after 3 attempts")
+	// the server not being up is recoverable
+	assert.ErrorIs(t, err, gowandb.ErrNetwork)
+}
+
+func TestSessionConnectRetry(t *testing.T) {
+	session, err := gowandb.NewSession(
+		sessionopts.WithCoreAddress(unusedAddr(t)),
+		sessionopts.WithConnectRetry(2, time.Millisecond),
+	)
+	assert.NoError(t, err)
+	run, err := session.NewRun(context.Background())
+	assert.Nil(t, run)
+	assert.ErrorContains(t, err, "failed to connect after 2 attempts")
+}
+
+func TestConnectRetriesUntilServerIsUp(t *testing.T) {
+	addr := unusedAddr(t)
+	go func() {
+		time.Sleep(20 * time.Millisecond)
+		listener, err := net.Listen("tcp", addr)
+		if err != nil {
+			return
+		}
+		defer listener.Close()
+		conn, err := listener.Accept()
+		if err == nil {
+			conn.Close()
+		}
+	}()
+
+	manager := gowandb.NewManager(context.Background(), settings.NewSettings(), addr,
+		gowandb.WithConnectRetry(10, 10*time.Millisecond),
+	)
+	conn, err := manager.Connect(context.Background())
+	assert.NoError(t, err)
+	assert.NotNil(t, conn)
+	conn.Close()
+}
+
+func TestConnectCancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	manager := gowandb.NewManager(ctx, settings.NewSettings(), unusedAddr(t),
+		gowandb.WithConnectRetry(3, time.Hour),
+	)
+	_, err := manager.Connect(ctx)
+	assert.ErrorIs(t, err, context.Canceled)
+}
+
+// fakeServer accepts connections and records the server requests it receives
+type fakeServer struct {
+	listener net.Listener
+	mu       sync.Mutex
+	conns    int
+	requests []*service.ServerRequest
+	wg       sync.WaitGroup
+}
+
+func newFakeServer(t *testing.T) *fakeServer {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	assert.NoError(t, err)
+	s := &fakeServer{listener: listener}
+	s.wg.Add(1)
+	go func() {
+		defer s.wg.Done()
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			s.mu.Lock()
+			s.conns++
+			s.mu.Unlock()
+			s.wg.Add(1)
+			go func() {
+				defer s.wg.Done()
+				s.serve(conn)
+			}()
+		}
+	}()
+	return s
+}
+
+func (s *fakeServer) serve(conn net.Conn) {
+	defer conn.Close()
+	scanner := bufio.NewScanner(conn)
+	tokenizer := &server.Tokenizer{}
+	scanner.Split(tokenizer.Split)
+	for scanner.Scan() {
+		msg := &service.ServerRequest{}
+		if err := proto.Unmarshal(scanner.Bytes(), msg); err != nil {
+			return
+		}
+		s.mu.Lock()
+		s.requests = append(s.requests, msg)
+		s.mu.Unlock()
+		if msg.GetInformTeardown() != nil {
+			return
+		}
+	}
+}
+
+func (s *fakeServer) Addr() string {
+	return s.listener.Addr().String()
+}
+
+func (s *fakeServer) Conns() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.conns
+}
+
+func (s *fakeServer) Teardowns() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	n := 0
+	for _, request := range s.requests {
+		if request.GetInformTeardown() != nil {
+			n++
+		}
+	}
+	return n
+}
+
+func (s *fakeServer) Close() {
+	s.listener.Close()
+	s.wg.Wait()
+}
+
+func TestCloseReusesControlConnection(t *testing.T) {
+	fake := newFakeServer(t)
+	manager := gowandb.NewManager(context.Background(), settings.NewSettings(), fake.Addr())
+
+	run, err := manager.NewRun(context.Background(), &runopts.RunParams{})
+	assert.NoError(t, err)
+	assert.NotNil(t, run)
+	// the control connection and the run connection
+	assert.Eventually(t, func() bool { return fake.Conns() == 2 }, time.Second, time.Millisecond)
+
+	assert.NoError(t, manager.Close())
+	assert.Eventually(t, func() bool { return fake.Teardowns() == 1 }, time.Second, time.Millisecond)
+	assert.Equal(t, 2, fake.Conns())
+
+	// closing again does not send another teardown
+	assert.NoError(t, manager.Close())
+	fake.listener.Close()
+	assert.Equal(t, 1, fake.Teardowns())
+}
+
+func TestCloseWithoutRuns(t *testing.T) {
+	fake := newFakeServer(t)
+	manager := gowandb.NewManager(context.Background(), settings.NewSettings(), fake.Addr())
+
+	assert.NoError(t, manager.Close())
+	assert.Eventually(t, func() bool { return fake.Teardowns() == 1 }, time.Second, time.Millisecond)
+	fake.Close()
+}
+
+func TestCloseWithoutServer(t *testing.T) {
+	manager := gowandb.NewManager(context.Background(), settings.NewSettings(), unusedAddr(t),
+		gowandb.WithConnectRetry(1, time.Millisecond),
+	)
+	// the teardown could not be sent
+	assert.ErrorIs(t, manager.Close(), gowandb.ErrNetwork)
+
+	// a closed manager makes no more runs
+	run, err := manager.NewRun(context.Background(), &runopts.RunParams{})
+	assert.Nil(t, run)
+	assert.ErrorIs(t, err, gowandb.ErrClosed)
+}
+
+func TestNewRunValidation(t *testing.T) {
+	badRunID := "a/b"
+	offlineSync := settings.NewSettings()
+	offlineSync.XOffline = wrapperspb.Bool(true)
+	offlineSync.XSync = wrapperspb.Bool(true)
+	emptyEntity := settings.NewSettings()
+	emptyEntity.Entity = wrapperspb.String("")
+
+	testCases := []struct {
+		name     string
+		settings *settings.SettingsWrap
+		params   *runopts.RunParams
+		wantErr  string
+	}{
+		{"bad run id", settings.NewSettings(), &runopts.RunParams{RunID: &badRunID}, "invalid RunID"},
+		{"offline and sync", offlineSync, &runopts.RunParams{}, "XOffline and XSync"},
+		{"empty entity", emptyEntity, &runopts.RunParams{}, "Entity"},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// validation fails before connecting to the server
+			manager := gowandb.NewManager(context.Background(), tc.settings, unusedAddr(t),
+				gowandb.WithConnectRetry(1, time.Millisecond),
+			)
+			run, err := manager.NewRun(context.Background(), tc.params)
+			assert.Nil(t, run)
+			assert.ErrorContains(t, err, tc.wantErr)
+		})
+	}
+}
+
+func TestNewRunOffline(t *testing.T) {
+	fake := newFakeServer(t)
+	defer fake.listener.Close()
+	manager := gowandb.NewManager(context.Background(), settings.NewSettings(), fake.Addr())
+	defer manager.Close()
+
+	offline := true
+	run, err := manager.NewRun(context.Background(), &runopts.RunParams{Offline: &offline})
+	assert.NoError(t, err)
+	assert.True(t, run.Offline())
+	assert.Contains(t, run.SyncFile(), "offline-run-")
+
+	// offline runs can not reach the server
+	_, err = run.DownloadArtifact(context.Background(), "dataset", t.TempDir())
+	assert.ErrorIs(t, err, gowandb.ErrUsage)
+
+	offline = false
+	run, err = manager.NewRun(context.Background(), &runopts.RunParams{Offline: &offline})
+	assert.NoError(t, err)
+	assert.False(t, run.Offline())
+	assert.NotContains(t, run.SyncFile(), "offline-run-")
+}
@@ -0,0 +1,235 @@
+package gowandb
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/binary"
+	"fmt"
+	"image"
+	_ "image/gif"
+	_ "image/jpeg"
+	"image/png"
+	"math"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/segmentio/encoding/json"
+
+	"github.com/wandb/wandb/core/pkg/utils"
+)
+
+// defaultHistogramBins is the number of bins of a histogram if none is given,
+// as in the Python client
+const defaultHistogramBins = 64
+
+// audioFormats are the formats of the audio files that can be logged
+var audioFormats = map[string]bool{"wav": true, "mp3": true, "ogg": true, "flac": true}
+
+// mediaFile is a value of the history written to a file of the run
+type mediaFile interface {
+	// writeFile writes the media to the files dir of the run, and returns the
+	// history value that refers to it and the path of the file relative to
+	// the files dir
+	writeFile(filesDir, key string) (interface{}, string, error)
+}
+
+// mediaFileValue is the history value of a media file
+type mediaFileValue struct {
+	Type    string `json:"_type"`
+	Path    string `json:"path"`
+	Sha256  string `json:"sha256"`
+	Size    int    `json:"size"`
+	Format  string `json:"format,omitempty"`
+	Width   int    `json:"width,omitempty"`
+	Height  int    `json:"height,omitempty"`
+	Caption string `json:"caption,omitempty"`
+}
+
+// writeMediaFile writes the content of a media file to a subdir of the
+// media of the run, named by its key and its hash. It returns the path of
+// the file relative to the files dir and the hash of its content.
+func writeMediaFile(filesDir, subdir, key, ext string, data []byte) (string, string, error) {
+	sum := sha256.Sum256(data)
+	sha256Hex := utils.EncodeBytesAsHex(sum[:])
+	rel := path.Join("media", subdir, fmt.Sprintf("%s_%s.%s", filepath.Base(key), sha256Hex[:20], ext))
+	name := filepath.Join(filesDir, filepath.FromSlash(rel))
+	if err := os.MkdirAll(filepath.Dir(name), os.ModePerm); err != nil {
+		return "", "", err
+	}
+	if err := os.WriteFile(name, data, 0644); err != nil {
+		return "", "", err
+	}
+	return rel, sha256Hex, nil
+}
+
+// Image is an image logged to the history, uploaded to the media of the run
+type Image struct {
+	Caption string
+
+	format string
+	width  int
+	height int
+	data   []byte
+}
+
+// NewImage creates the image logged from an image, encoded as PNG
+func NewImage(img image.Image) (*Image, error) {
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, img); err != nil {
+		return nil, err
+	}
+	bounds := img.Bounds()
+	return &Image{format: "png", width: bounds.Dx(), height: bounds.Dy(), data: buf.Bytes()}, nil
+}
+
+// NewImageFromFile creates the image logged from a PNG, JPEG or GIF file
+func NewImageFromFile(fileName string) (*Image, error) {
+	data, err := os.ReadFile(fileName)
+	if err != nil {
+		return nil, err
+	}
+	config, format, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("gowandb: %s is not an image: %w", fileName, err)
+	}
+	return &Image{format: format, width: config.Width, height: config.Height, data: data}, nil
+}
+
+func (i *Image) writeFile(filesDir, key string) (interface{}, string, error) {
+	rel, sha256Hex, err := writeMediaFile(filesDir, "images", key, i.format, i.data)
+	if err != nil {
+		return nil, "", err
+	}
+	return mediaFileValue{
+		Type:    "image-file",
+		Path:    rel,
+		Sha256:  sha256Hex,
+		Size:    len(i.data),
+		Format:  i.format,
+		Width:   i.width,
+		Height:  i.height,
+		Caption: i.Caption,
+	}, rel, nil
+}
+
+// Audio is a sound logged to the history, uploaded to the media of the run
+type Audio struct {
+	Caption string
+
+	format string
+	data   []byte
+}
+
+// NewAudio creates the sound logged from mono samples between -1 and 1, at
+// a sample rate in Hz, encoded as 16-bit WAV
+func NewAudio(samples []float64, sampleRate int) (*Audio, error) {
+	if sampleRate <= 0 {
+		return nil, fmt.Errorf("gowandb: invalid sample rate %d", sampleRate)
+	}
+	size := 2 * len(samples)
+	var buf bytes.Buffer
+	buf.WriteString("RIFF")
+	_ = binary.Write(&buf, binary.LittleEndian, uint32(36+size))
+	buf.WriteString("WAVEfmt ")
+	_ = binary.Write(&buf, binary.LittleEndian, struct {
+		Size          uint32
+		Format        uint16
+		Channels      uint16
+		SampleRate    uint32
+		ByteRate      uint32
+		BlockAlign    uint16
+		BitsPerSample uint16
+	}{16, 1, 1, uint32(sampleRate), uint32(2 * sampleRate), 2, 16})
+	buf.WriteString("data")
+	_ = binary.Write(&buf, binary.LittleEndian, uint32(size))
+	for _, sample := range samples {
+		if math.IsNaN(sample) {
+			sample = 0
+		}
+		sample = math.Max(-1, math.Min(1, sample))
+		_ = binary.Write(&buf, binary.LittleEndian, int16(math.Round(sample*math.MaxInt16)))
+	}
+	return &Audio{format: "wav", data: buf.Bytes()}, nil
+}
+
+// NewAudioFromFile creates the sound logged from a WAV, MP3, OGG or FLAC file
+func NewAudioFromFile(fileName string) (*Audio, error) {
+	format := strings.ToLower(strings.TrimPrefix(filepath.Ext(fileName), "."))
+	if !audioFormats[format] {
+		return nil, fmt.Errorf("gowandb: unsupported audio format of %s", fileName)
+	}
+	data, err := os.ReadFile(fileName)
+	if err != nil {
+		return nil, err
+	}
+	return &Audio{format: format, data: data}, nil
+}
+
+func (a *Audio) writeFile(filesDir, key string) (interface{}, string, error) {
+	rel, sha256Hex, err := writeMediaFile(filesDir, "audio", key, a.format, a.data)
+	if err != nil {
+		return nil, "", err
+	}
+	return mediaFileValue{
+		Type:    "audio-file",
+		Path:    rel,
+		Sha256:  sha256Hex,
+		Size:    len(a.data),
+		Caption: a.Caption,
+	}, rel, nil
+}
+
+// Histogram is a distribution logged to the history, Values are the counts
+// of its bins and Bins the edges of the bins, one more than the counts
+type Histogram struct {
+	Values []float64
+	Bins   []float64
+}
+
+// NewHistogram creates the histogram of data over bins of the same width
+// between its minimum and its maximum, 64 if numBins is not positive. The
+// values that are not finite are left out.
+func NewHistogram(data []float64, numBins int) *Histogram {
+	if numBins <= 0 {
+		numBins = defaultHistogramBins
+	}
+	low, high := math.Inf(1), math.Inf(-1)
+	for _, value := range data {
+		if !math.IsNaN(value) && !math.IsInf(value, 0) {
+			low, high = math.Min(low, value), math.Max(high, value)
+		}
+	}
+	switch {
+	case low > high:
+		low, high = 0, 1
+	case low == high:
+		low, high = low-0.5, high+0.5
+	}
+
+	h := &Histogram{Values: make([]float64, numBins), Bins: make([]float64, numBins+1)}
+	width := (high - low) / float64(numBins)
+	for i := range h.Bins {
+		h.Bins[i] = low + float64(i)*width
+	}
+	h.Bins[numBins] = high
+	for _, value := range data {
+		if math.IsNaN(value) || math.IsInf(value, 0) {
+			continue
+		}
+		// the last bin includes its right edge
+		bin := min(int((value-low)/width), numBins-1)
+		h.Values[bin]++
+	}
+	return h
+}
+
+// MarshalJSON encodes the histogram as a history value
+func (h *Histogram) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		Type   string    `json:"_type"`
+		Values []float64 `json:"values"`
+		Bins   []float64 `json:"bins"`
+	}{"histogram", h.Values, h.Bins})
+}
@@ -0,0 +1,67 @@
+package gowandb
+
+import (
+	"image"
+	"image/color"
+	"math"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/segmentio/encoding/json"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMediaFiles(t *testing.T) {
+	filesDir := t.TempDir()
+
+	img := image.NewRGBA(image.Rect(0, 0, 4, 3))
+	img.Set(1, 1, color.White)
+	logged, err := NewImage(img)
+	assert.NoError(t, err)
+	logged.Caption = "sample"
+	value, path, err := logged.writeFile(filesDir, "examples")
+	assert.NoError(t, err)
+	imageValue := value.(mediaFileValue)
+	assert.Equal(t, "image-file", imageValue.Type)
+	assert.Equal(t, path, imageValue.Path)
+	assert.Regexp(t, `^media/images/examples_[0-9a-f]{20}\.png$`, path)
+	assert.Equal(t, 4, imageValue.Width)
+	assert.Equal(t, 3, imageValue.Height)
+	assert.Equal(t, "sample", imageValue.Caption)
+
+	fromFile, err := NewImageFromFile(filepath.Join(filesDir, path))
+	assert.NoError(t, err)
+	assert.Equal(t, "png", fromFile.format)
+	_, err = NewImageFromFile(os.Args[0])
+	assert.Error(t, err)
+
+	audio, err := NewAudio([]float64{0, 0.5, -1, 2, math.NaN()}, 16000)
+	assert.NoError(t, err)
+	value, path, err = audio.writeFile(filesDir, "speech")
+	assert.NoError(t, err)
+	assert.Regexp(t, `^media/audio/speech_[0-9a-f]{20}\.wav$`, path)
+	data, err := os.ReadFile(filepath.Join(filesDir, path))
+	assert.NoError(t, err)
+	assert.Len(t, data, 44+2*5)
+	assert.Equal(t, len(data), value.(mediaFileValue).Size)
+	_, err = NewAudio(nil, 0)
+	assert.Error(t, err)
+	_, err = NewAudioFromFile("speech.txt")
+	assert.Error(t, err)
+}
+
+func TestHistogram(t *testing.T) {
+	h := NewHistogram([]float64{0, 1, 1, 2, 3, 4, math.Inf(1)}, 4)
+	assert.Equal(t, []float64{1, 2, 1, 2}, h.Values)
+	assert.Equal(t, []float64{0, 1, 2, 3, 4}, h.Bins)
+
+	encoded, err := json.Marshal(h)
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"_type": "histogram", "values": [1, 2, 1, 2], "bins": [0, 1, 2, 3, 4]}`, string(encoded))
+
+	constant := NewHistogram([]float64{5, 5}, 0)
+	assert.Len(t, constant.Values, defaultHistogramBins)
+	assert.Equal(t, 4.5, constant.Bins[0])
+	assert.Equal(t, 5.5, constant.Bins[defaultHistogramBins])
+}
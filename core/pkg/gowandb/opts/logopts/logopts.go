@@ -0,0 +1,26 @@
+// sub-package for gowandb log options
+package logopts
+
+type LogParams struct {
+	Step   *int64
+	Commit *bool
+}
+
+type LogOption func(*LogParams)
+
+// WithStep logs the data at a step of the history, the rows of the steps
+// before it are committed, the steps after it are not logged
+func WithStep(step int64) LogOption {
+	return func(p *LogParams) {
+		p.Step = &step
+	}
+}
+
+// WithCommit sets whether the row of the step is committed once the data is
+// logged, by default it is unless a step is given. A row that is not
+// committed accumulates the data logged until it is.
+func WithCommit(commit bool) LogOption {
+	return func(p *LogParams) {
+		p.Commit = &commit
+	}
+}
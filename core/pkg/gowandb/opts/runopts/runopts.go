@@ -2,6 +2,9 @@
 package runopts
 
 import (
+	"fmt"
+	"strings"
+
 	"github.com/wandb/wandb/core/pkg/gowandb/runconfig"
 	"github.com/wandb/wandb/core/pkg/service"
 )
@@ -12,6 +15,13 @@ type RunParams struct {
 	RunID     *string
 	Project   *string
 	Telemetry *service.TelemetryRecord
+	SweepID   *string
+	Resume    *string
+	Tags      []string
+	Notes     *string
+	Group     *string
+	JobType   *string
+	Offline   *bool
 }
 
 type RunOption func(*RunParams)
@@ -39,3 +49,130 @@ func WithProject(project string) RunOption {
 		p.Project = &project
 	}
 }
+
+// WithSweepID makes the run a run of a sweep
+func WithSweepID(sweepID string) RunOption {
+	return func(p *RunParams) {
+		p.SweepID = &sweepID
+	}
+}
+
+// WithResume resumes the run with the run ID if it exists, "allow" starts a
+// new run otherwise, "must" fails and "never" fails if the run exists
+func WithResume(resume string) RunOption {
+	return func(p *RunParams) {
+		p.Resume = &resume
+	}
+}
+
+// WithTags sets the tags of the run
+func WithTags(tags ...string) RunOption {
+	return func(p *RunParams) {
+		p.Tags = tags
+	}
+}
+
+// WithNotes sets the notes of the run, a description shown with it
+func WithNotes(notes string) RunOption {
+	return func(p *RunParams) {
+		p.Notes = &notes
+	}
+}
+
+// WithGroup sets the group the run is part of, e.g. the runs of a
+// distributed training
+func WithGroup(group string) RunOption {
+	return func(p *RunParams) {
+		p.Group = &group
+	}
+}
+
+// WithJobType sets the type of the job of the run, e.g. train or eval, to
+// tell apart the runs of a group
+func WithJobType(jobType string) RunOption {
+	return func(p *RunParams) {
+		p.JobType = &jobType
+	}
+}
+
+// WithOffline sets whether the run is offline, by default it is offline if
+// the settings are. An offline run makes no requests to the server, its
+// records are stored in its sync file for Session.Sync to upload them later.
+func WithOffline(offline bool) RunOption {
+	return func(p *RunParams) {
+		p.Offline = &offline
+	}
+}
+
+// invalidProjectChars are the characters that are not allowed in a project
+const invalidProjectChars = `/\#?%:`
+
+// maxProjectLength is the maximum length of a project name
+const maxProjectLength = 128
+
+// Validate checks that the run parameters can be used to create a run
+func (p *RunParams) Validate() error {
+	if p.RunID != nil {
+		if err := ValidateRunID(*p.RunID); err != nil {
+			return err
+		}
+	}
+	if p.Project != nil {
+		if err := ValidateProject(*p.Project); err != nil {
+			return err
+		}
+	}
+	if p.Resume != nil {
+		switch *p.Resume {
+		case "allow", "never":
+		case "must":
+			if p.RunID == nil {
+				return fmt.Errorf("invalid Resume: %q needs a RunID", *p.Resume)
+			}
+		default:
+			return fmt.Errorf("invalid Resume: %q is not one of allow, must or never", *p.Resume)
+		}
+	}
+	return nil
+}
+
+// ValidateRunID checks that the run ID is not empty and only contains ASCII
+// letters, digits, dashes and underscores. The run ID names the directory and
+// files of the run, so it must be a valid file name on every platform, and not
+// "." or "..".
+func ValidateRunID(runID string) error {
+	if runID == "" {
+		return fmt.Errorf("invalid RunID: must not be empty")
+	}
+	for _, c := range runID {
+		if !isRunIDChar(c) {
+			return fmt.Errorf(
+				"invalid RunID %q: must only contain letters, digits, dashes and underscores, found %q",
+				runID, c,
+			)
+		}
+	}
+	return nil
+}
+
+// isRunIDChar returns whether the character is allowed in a run ID
+func isRunIDChar(c rune) bool {
+	return c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9' || c == '-' || c == '_'
+}
+
+// ValidateProject checks that the project name is valid
+func ValidateProject(project string) error {
+	if project == "" {
+		return fmt.Errorf("invalid Project: must not be empty")
+	}
+	if len(project) > maxProjectLength {
+		return fmt.Errorf("invalid Project %q: must not be longer than %d characters", project, maxProjectLength)
+	}
+	if i := strings.IndexAny(project, invalidProjectChars); i >= 0 {
+		return fmt.Errorf(
+			"invalid Project %q: must not contain any of %q, found %q",
+			project, invalidProjectChars, project[i],
+		)
+	}
+	return nil
+}
@@ -0,0 +1,52 @@
+package runopts_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/wandb/wandb/core/pkg/gowandb/opts/runopts"
+)
+
+func TestValidate(t *testing.T) {
+	testCases := []struct {
+		name    string
+		opts    []runopts.RunOption
+		wantErr string
+	}{
+		{"no options", nil, ""},
+		{"valid", []runopts.RunOption{runopts.WithRunID("abc-123_x"), runopts.WithProject("my-project")}, ""},
+		{"empty run id", []runopts.RunOption{runopts.WithRunID("")}, "invalid RunID"},
+		{"path in run id", []runopts.RunOption{runopts.WithRunID("../abc")}, "invalid RunID"},
+		{"blank run id", []runopts.RunOption{runopts.WithRunID("  ")}, "invalid RunID"},
+		{"control character in run id", []runopts.RunOption{runopts.WithRunID("a\nb")}, "invalid RunID"},
+		{"punctuation in run id", []runopts.RunOption{runopts.WithRunID("run.1 (copy)")}, "invalid RunID"},
+		{"dot run id", []runopts.RunOption{runopts.WithRunID(".")}, "invalid RunID"},
+		{"dot dot run id", []runopts.RunOption{runopts.WithRunID("..")}, "invalid RunID"},
+		{"backslash in run id", []runopts.RunOption{runopts.WithRunID(`a\b`)}, "invalid RunID"},
+		{"windows reserved characters in run id", []runopts.RunOption{runopts.WithRunID(`a:b*c?"<>|`)}, "invalid RunID"},
+		{"unicode letter in run id", []runopts.RunOption{runopts.WithRunID("runé")}, "invalid RunID"},
+		{"upper case run id", []runopts.RunOption{runopts.WithRunID("Run-ABC_9")}, ""},
+		{"empty project", []runopts.RunOption{runopts.WithProject("")}, "invalid Project"},
+		{"slash in project", []runopts.RunOption{runopts.WithProject("a/b")}, "invalid Project"},
+		{"long project", []runopts.RunOption{runopts.WithProject(strings.Repeat("p", 129))}, "invalid Project"},
+		{"resume", []runopts.RunOption{runopts.WithRunID("abc"), runopts.WithResume("must")}, ""},
+		{"resume without run id", []runopts.RunOption{runopts.WithResume("must")}, "invalid Resume"},
+		{"unknown resume", []runopts.RunOption{runopts.WithResume("always")}, "invalid Resume"},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			params := &runopts.RunParams{}
+			for _, opt := range tc.opts {
+				opt(params)
+			}
+			err := params.Validate()
+			if tc.wantErr == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorContains(t, err, tc.wantErr)
+			}
+		})
+	}
+}
@@ -2,6 +2,8 @@
 package sessionopts
 
 import (
+	"time"
+
 	"github.com/wandb/wandb/core/pkg/gowandb/settings"
 )
 
@@ -9,6 +11,13 @@ type SessionParams struct {
 	CoreBinary []byte
 	Address    string
 	Settings   *settings.SettingsWrap
+
+	// the options of the manager of the session, the defaults of the
+	// manager are used when they are zero
+	ConnectAttempts  int
+	ConnectBackoff   time.Duration
+	MaxConnections   int
+	ShareConnections bool
 }
 
 type SessionOption func(*SessionParams)
@@ -30,3 +39,28 @@ func WithSettings(baseSettings *settings.SettingsWrap) SessionOption {
 		s.Settings = baseSettings
 	}
 }
+
+// WithConnectRetry sets how many times the session attempts to connect to
+// wandb-core, and how long it waits before the first retry
+func WithConnectRetry(attempts int, backoff time.Duration) SessionOption {
+	return func(s *SessionParams) {
+		s.ConnectAttempts = attempts
+		s.ConnectBackoff = backoff
+	}
+}
+
+// WithMaxConnections sets the number of connections to wandb-core the
+// session keeps for reuse by runs
+func WithMaxConnections(maxConnections int) SessionOption {
+	return func(s *SessionParams) {
+		s.MaxConnections = maxConnections
+	}
+}
+
+// WithConnectionSharing makes the runs of the session share the connections
+// to wandb-core once there are as many as WithMaxConnections allows
+func WithConnectionSharing() SessionOption {
+	return func(s *SessionParams) {
+		s.ShareConnections = true
+	}
+}
@@ -0,0 +1,171 @@
+package gowandb
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+)
+
+const (
+	// defaultMaxConnections is the default size of the connection pool
+	defaultMaxConnections = 4
+	// defaultPoolWait is how long to wait for an idle connection when the
+	// pool is exhausted before making a new connection
+	defaultPoolWait = 100 * time.Millisecond
+)
+
+// connectionPool is a pool of connections to the server that are handed out
+// to runs and returned to the pool when the runs finish.
+type connectionPool struct {
+	// connect makes a new connection
+	connect func(ctx context.Context) (*Connection, error)
+
+	// idle are the connections that are not used by any run
+	idle chan *Connection
+
+	// wait is how long to wait for an idle connection when the pool is
+	// exhausted
+	wait time.Duration
+
+	// share is whether the runs share the connections in use once the pool
+	// is exhausted, instead of making more connections
+	share bool
+
+	// mu guards the fields below
+	mu sync.Mutex
+
+	// open is the number of open connections made by the pool
+	open int
+
+	// users are the number of runs using each connection in use
+	users map[*Connection]int
+
+	// closed is whether the pool was closed
+	closed bool
+}
+
+func newConnectionPool(
+	maxSize int,
+	wait time.Duration,
+	connect func(ctx context.Context) (*Connection, error),
+) *connectionPool {
+	return &connectionPool{
+		connect: connect,
+		idle:    make(chan *Connection, maxSize),
+		wait:    wait,
+		users:   make(map[*Connection]int),
+	}
+}
+
+// get returns an idle connection, or makes a new one if the pool is not full.
+// If the pool is exhausted it waits briefly for a connection to be returned
+// before sharing the least used connection, or making a new connection
+// anyway if the connections are not shared.
+func (p *connectionPool) get(ctx context.Context) (*Connection, error) {
+	select {
+	case conn := <-p.idle:
+		return p.use(conn), nil
+	default:
+	}
+
+	p.mu.Lock()
+	if p.closed {
+		p.mu.Unlock()
+		return nil, fmt.Errorf("gowandb: connection pool is closed")
+	}
+	full := p.open >= cap(p.idle)
+	p.mu.Unlock()
+
+	if full {
+		select {
+		case conn := <-p.idle:
+			return p.use(conn), nil
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(p.wait):
+		}
+		if p.share {
+			if conn := p.leastUsed(); conn != nil {
+				return conn, nil
+			}
+		}
+	}
+	return p.dial(ctx)
+}
+
+// use counts a run using the connection
+func (p *connectionPool) use(conn *Connection) *Connection {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.users[conn]++
+	return conn
+}
+
+// leastUsed returns the connection in use by the fewest runs, and counts a
+// run using it. It returns nil if no connection is in use.
+func (p *connectionPool) leastUsed() *Connection {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	var least *Connection
+	for conn, users := range p.users {
+		if least == nil || users < p.users[least] {
+			least = conn
+		}
+	}
+	if least != nil {
+		p.users[least]++
+	}
+	return least
+}
+
+func (p *connectionPool) dial(ctx context.Context) (*Connection, error) {
+	conn, err := p.connect(ctx)
+	if err != nil {
+		return nil, err
+	}
+	p.mu.Lock()
+	p.open++
+	p.users[conn] = 1
+	p.mu.Unlock()
+	conn.Start()
+	return conn, nil
+}
+
+// put returns a connection to the pool once no run uses it, closing it if
+// the pool is full or closed.
+func (p *connectionPool) put(conn *Connection) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.users[conn] > 1 {
+		p.users[conn]--
+		return
+	}
+	delete(p.users, conn)
+	if !p.closed {
+		select {
+		case p.idle <- conn:
+			return
+		default:
+		}
+	}
+	p.open--
+	_ = conn.Close()
+}
+
+// close closes all the idle connections, connections still in use are
+// closed when they are returned.
+func (p *connectionPool) close() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.closed = true
+	for {
+		select {
+		case conn := <-p.idle:
+			p.open--
+			_ = conn.Close()
+		default:
+			return
+		}
+	}
+}
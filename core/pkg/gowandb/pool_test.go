@@ -0,0 +1,165 @@
+package gowandb
+
+import (
+	"context"
+	"net"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// pipeDialer makes connections over in-memory pipes and counts them
+type pipeDialer struct {
+	mu    sync.Mutex
+	dials int
+	peers []net.Conn
+}
+
+func (d *pipeDialer) connect(ctx context.Context) (*Connection, error) {
+	client, peer := net.Pipe()
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.dials++
+	d.peers = append(d.peers, peer)
+	return &Connection{ctx: ctx, Conn: client, Mbox: NewMailbox()}, nil
+}
+
+func (d *pipeDialer) Dials() int {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return d.dials
+}
+
+func (d *pipeDialer) Close() {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	for _, peer := range d.peers {
+		peer.Close()
+	}
+}
+
+func TestPoolReusesConnections(t *testing.T) {
+	dialer := &pipeDialer{}
+	defer dialer.Close()
+	pool := newConnectionPool(2, time.Millisecond, dialer.connect)
+
+	for i := 0; i < 5; i++ {
+		conn, err := pool.get(context.Background())
+		assert.NoError(t, err)
+		pool.put(conn)
+	}
+	assert.Equal(t, 1, dialer.Dials())
+	pool.close()
+}
+
+func TestPoolExhausted(t *testing.T) {
+	dialer := &pipeDialer{}
+	defer dialer.Close()
+	pool := newConnectionPool(2, time.Millisecond, dialer.connect)
+
+	var conns []*Connection
+	for i := 0; i < 3; i++ {
+		conn, err := pool.get(context.Background())
+		assert.NoError(t, err)
+		conns = append(conns, conn)
+	}
+	// the pool is exhausted, so the third get makes a new connection
+	assert.Equal(t, 3, dialer.Dials())
+
+	// only as many connections as the pool holds are kept
+	for _, conn := range conns {
+		pool.put(conn)
+	}
+	assert.Len(t, pool.idle, 2)
+	assert.Equal(t, 2, pool.open)
+
+	pool.close()
+	assert.Len(t, pool.idle, 0)
+	assert.Equal(t, 0, pool.open)
+}
+
+func TestPoolWaitsForReturnedConnection(t *testing.T) {
+	dialer := &pipeDialer{}
+	defer dialer.Close()
+	pool := newConnectionPool(1, time.Second, dialer.connect)
+
+	conn, err := pool.get(context.Background())
+	assert.NoError(t, err)
+	go func() {
+		time.Sleep(10 * time.Millisecond)
+		pool.put(conn)
+	}()
+	conn2, err := pool.get(context.Background())
+	assert.NoError(t, err)
+	assert.Same(t, conn, conn2)
+	assert.Equal(t, 1, dialer.Dials())
+	pool.put(conn2)
+	pool.close()
+}
+
+func TestPoolConcurrentGets(t *testing.T) {
+	dialer := &pipeDialer{}
+	defer dialer.Close()
+	pool := newConnectionPool(4, time.Millisecond, dialer.connect)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			conn, err := pool.get(context.Background())
+			if assert.NoError(t, err) {
+				pool.put(conn)
+			}
+		}()
+	}
+	wg.Wait()
+	pool.close()
+	assert.Equal(t, 0, pool.open)
+}
+
+func TestPoolClosed(t *testing.T) {
+	dialer := &pipeDialer{}
+	defer dialer.Close()
+	pool := newConnectionPool(1, time.Millisecond, dialer.connect)
+
+	conn, err := pool.get(context.Background())
+	assert.NoError(t, err)
+	pool.close()
+
+	// connections returned after closing are closed
+	pool.put(conn)
+	assert.Equal(t, 0, pool.open)
+	_, err = pool.get(context.Background())
+	assert.Error(t, err)
+}
+
+func TestPoolSharesConnections(t *testing.T) {
+	dialer := &pipeDialer{}
+	defer dialer.Close()
+	pool := newConnectionPool(2, time.Millisecond, dialer.connect)
+	pool.share = true
+
+	var conns []*Connection
+	for i := 0; i < 3; i++ {
+		conn, err := pool.get(context.Background())
+		assert.NoError(t, err)
+		conns = append(conns, conn)
+	}
+	// the pool is exhausted, so the third get shares a connection
+	assert.Equal(t, 2, dialer.Dials())
+	assert.Contains(t, conns[:2], conns[2])
+
+	// a shared connection is returned once no run uses it
+	pool.put(conns[2])
+	assert.Len(t, pool.idle, 0)
+	pool.put(conns[0])
+	pool.put(conns[1])
+	assert.Len(t, pool.idle, 2)
+	assert.Equal(t, 2, pool.open)
+
+	pool.close()
+	assert.Equal(t, 0, pool.open)
+}
@@ -2,30 +2,62 @@ package gowandb
 
 import (
 	"context"
+	"fmt"
 	"log/slog"
 	"os"
+	"path/filepath"
+	"slices"
 	"sync"
 
+	"github.com/Khan/genqlient/graphql"
 	"github.com/segmentio/encoding/json"
 
+	"github.com/wandb/wandb/core/internal/gql"
 	"github.com/wandb/wandb/core/internal/shared"
+	"github.com/wandb/wandb/core/pkg/gowandb/opts/logopts"
 	"github.com/wandb/wandb/core/pkg/gowandb/opts/runopts"
-	"github.com/wandb/wandb/core/pkg/gowandb/runconfig"
 	"github.com/wandb/wandb/core/pkg/service"
+	"github.com/wandb/wandb/core/pkg/utils"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 type Settings map[string]interface{}
 
+// Run is a run logging to a stream of the server, it is safe to use from
+// several goroutines
 type Run struct {
 	// ctx is the context for the run
-	ctx            context.Context
-	settings       *service.Settings
-	config         *runconfig.Config
-	conn           *Connection
-	wg             sync.WaitGroup
-	run            *service.RunRecord
-	params         *runopts.RunParams
+	ctx      context.Context
+	settings *service.Settings
+	conn     *Connection
+	params   *runopts.RunParams
+
+	// mu guards the run, which is replaced when it changes
+	mu  sync.Mutex
+	run *service.RunRecord
+
+	// historyMu guards the partial history, and keeps the rows of the
+	// history in the order they are logged
+	historyMu      sync.Mutex
 	partialHistory History
+
+	// Config is the config of the run, it can be updated while the run logs
+	Config *Config
+
+	// credits are the records the run can send before it asks the handler
+	// for more, if flow control is on
+	creditMu sync.Mutex
+	credits  int32
+
+	// release returns the connection when the run is finished, if not set
+	// the connection is closed
+	release func(*Connection)
+
+	// graphqlClient resolves the names of the artifacts to download, made
+	// from the settings by graphql when it is first needed
+	graphqlClient graphql.Client
+	graphqlOnce   sync.Once
 }
 
 // NewRun creates a new run with the given settings and responders.
@@ -34,10 +66,13 @@ func NewRun(ctx context.Context, settings *service.Settings, conn *Connection, r
 		ctx:      ctx,
 		settings: settings,
 		conn:     conn,
-		wg:       sync.WaitGroup{},
-		config:   runParams.Config,
 		params:   runParams,
 	}
+	var config map[string]interface{}
+	if runParams.Config != nil {
+		config = *runParams.Config
+	}
+	run.Config = newConfig(config)
 	run.resetPartialHistory()
 	return run
 }
@@ -51,14 +86,12 @@ func (r *Run) setup() {
 	if err != nil {
 		slog.Error("error creating files dir", "err", err)
 	}
-	r.wg.Add(1)
-	go func() {
-		r.conn.Recv()
-		r.wg.Done()
-	}()
+	r.conn.Start()
 }
 
-func (r *Run) init() {
+// init creates the run, it returns the error reported by the server if the
+// run could not be created
+func (r *Run) init(ctx context.Context) error {
 	serverRecord := service.ServerRequest{
 		ServerRequestType: &service.ServerRequest_InformInit{InformInit: &service.ServerInformInitRequest{
 			Settings: r.settings,
@@ -67,23 +100,10 @@ func (r *Run) init() {
 	}
 	err := r.conn.Send(&serverRecord)
 	if err != nil {
-		return
+		return err
 	}
 
-	config := &service.ConfigRecord{}
-	if r.config == nil {
-		r.config = &runconfig.Config{}
-	}
-	for key, value := range *r.config {
-		data, err := json.Marshal(value)
-		if err != nil {
-			panic(err)
-		}
-		config.Update = append(config.Update, &service.ConfigItem{
-			Key:       key,
-			ValueJson: string(data),
-		})
-	}
+	config := r.Config.record()
 	var DisplayName string
 	if r.params.Name != nil {
 		DisplayName = *r.params.Name
@@ -93,30 +113,44 @@ func (r *Run) init() {
 		DisplayName: DisplayName,
 		Config:      config,
 		Telemetry:   r.params.Telemetry,
+		StartTime:   timestamppb.Now(),
 		XInfo:       &service.XRecordInfo{StreamId: r.settings.GetRunId().GetValue()},
 	}}
 	if r.params.Project != nil {
 		runRecord.Run.Project = *r.params.Project
 	}
+	runRecord.Run.Tags = r.params.Tags
+	if r.params.Notes != nil {
+		runRecord.Run.Notes = *r.params.Notes
+	}
+	if r.params.Group != nil {
+		runRecord.Run.RunGroup = *r.params.Group
+	}
+	if r.params.JobType != nil {
+		runRecord.Run.JobType = *r.params.JobType
+	}
 	record := service.Record{
 		RecordType: &runRecord,
 		XInfo:      &service.XRecordInfo{StreamId: r.settings.GetRunId().GetValue()},
 	}
-	serverRecord = service.ServerRequest{
-		ServerRequestType: &service.ServerRequest_RecordCommunicate{RecordCommunicate: &record},
-	}
-
-	handle := r.conn.Mbox.Deliver(&record)
-	err = r.conn.Send(&serverRecord)
+	result, err := r.communicate(ctx, &record)
 	if err != nil {
-		return
+		return err
 	}
-	result := handle.wait()
-	r.run = result.GetRunResult().GetRun()
-	shared.PrintHeadFoot(r.run, r.settings, false)
+	if err := errorFromInfo(result.GetRunResult().GetError()); err != nil {
+		return err
+	}
+	run := result.GetRunResult().GetRun()
+	r.setRunRecord(run)
+	if run.GetResumed() {
+		r.Config.restore(run.GetConfig())
+	}
+	r.Config.start(r.sendConfig)
+	shared.PrintHeadFoot(run, r.settings, false)
+	return nil
 }
 
-func (r *Run) start() {
+func (r *Run) start(ctx context.Context) error {
 	serverRecord := service.ServerRequest{
 		ServerRequestType: &service.ServerRequest_InformStart{InformStart: &service.ServerInformStartRequest{
 			Settings: r.settings,
@@ -125,44 +159,219 @@ func (r *Run) start() {
 	}
 	err := r.conn.Send(&serverRecord)
 	if err != nil {
-		return
+		return err
 	}
 
+	// the run created by the server has the step and the runtime a resumed
+	// run continues from
+	run := r.runRecord()
+	if run == nil {
+		run = &service.RunRecord{RunId: r.settings.GetRunId().GetValue()}
+	}
 	request := service.Request{RequestType: &service.Request_RunStart{
-		RunStart: &service.RunStartRequest{Run: &service.RunRecord{
-			RunId: r.settings.GetRunId().GetValue(),
-		}}}}
+		RunStart: &service.RunStartRequest{Run: run}}}
 	record := service.Record{
 		RecordType: &service.Record_Request{Request: &request},
 		Control:    &service.Control{Local: true},
 		XInfo:      &service.XRecordInfo{StreamId: r.settings.GetRunId().GetValue()},
 	}
+	_, err = r.communicate(ctx, &record)
+	return err
+}
+
+// Offline is whether the run is offline, its records are stored in its sync
+// file and uploaded once it is synced
+func (r *Run) Offline() bool {
+	return r.settings.GetXOffline().GetValue()
+}
+
+// SyncFile is the path of the file the records of the run are stored in
+func (r *Run) SyncFile() string {
+	return r.settings.GetSyncFile().GetValue()
+}
+
+// Resumed is whether the run continues a run that existed, its config is the
+// config it was logged with and its history continues at StartingStep
+func (r *Run) Resumed() bool {
+	return r.runRecord().GetResumed()
+}
+
+// StartingStep is the step the history of the run continues at, 0 unless it
+// was resumed
+func (r *Run) StartingStep() int64 {
+	return r.runRecord().GetStartingStep()
+}
+
+// RestoredSummary returns the summary of the run when it was resumed, nil
+// unless it was resumed
+func (r *Run) RestoredSummary() map[string]interface{} {
+	run := r.runRecord()
+	if !run.GetResumed() {
+		return nil
+	}
+	summary := make(map[string]interface{})
+	for _, item := range run.GetSummary().GetUpdate() {
+		var value interface{}
+		if err := json.Unmarshal([]byte(item.GetValueJson()), &value); err != nil {
+			continue
+		}
+		summary[item.GetKey()] = value
+	}
+	return summary
+}
+
+// SetTags replaces the tags of the run
+func (r *Run) SetTags(ctx context.Context, tags ...string) error {
+	return r.update(ctx, func(run *service.RunRecord) {
+		run.Tags = tags
+	})
+}
+
+// AddTags adds tags to the run, the tags it already has are kept
+func (r *Run) AddTags(ctx context.Context, tags ...string) error {
+	return r.update(ctx, func(run *service.RunRecord) {
+		for _, tag := range tags {
+			if !slices.Contains(run.Tags, tag) {
+				run.Tags = append(run.Tags, tag)
+			}
+		}
+	})
+}
 
-	serverRecord = service.ServerRequest{
-		ServerRequestType: &service.ServerRequest_RecordCommunicate{RecordCommunicate: &record},
+// SetNotes sets the notes of the run
+func (r *Run) SetNotes(ctx context.Context, notes string) error {
+	return r.update(ctx, func(run *service.RunRecord) {
+		run.Notes = notes
+	})
+}
+
+// SetGroup sets the group the run is part of
+func (r *Run) SetGroup(ctx context.Context, group string) error {
+	return r.update(ctx, func(run *service.RunRecord) {
+		run.RunGroup = group
+	})
+}
+
+// SetJobType sets the type of the job of the run
+func (r *Run) SetJobType(ctx context.Context, jobType string) error {
+	return r.update(ctx, func(run *service.RunRecord) {
+		run.JobType = jobType
+	})
+}
+
+// update changes the run and sends it as an update of the run, without its
+// config and summary which are updated on their own
+func (r *Run) update(ctx context.Context, change func(run *service.RunRecord)) error {
+	// the updates are sent in the order they change the run
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.run == nil {
+		return &Error{Code: service.ErrorInfo_USAGE, Message: "gowandb: the run is not started"}
+	}
+	changed, ok := proto.Clone(r.run).(*service.RunRecord)
+	if !ok {
+		return fmt.Errorf("gowandb: failed to clone the run")
+	}
+	change(changed)
+	r.run = changed
+	run, ok := proto.Clone(changed).(*service.RunRecord)
+	if !ok {
+		return fmt.Errorf("gowandb: failed to clone the run")
+	}
+	run.Config = nil
+	run.Summary = nil
+	run.XInfo = &service.XRecordInfo{StreamId: r.settings.GetRunId().GetValue()}
+	record := service.Record{
+		RecordType: &service.Record_Run{Run: run},
+		XInfo:      &service.XRecordInfo{StreamId: r.settings.GetRunId().GetValue()},
 	}
+	return r.publish(ctx, &record)
+}
+
+// runRecord returns the run as the server created it, nil until it is
+// created
+func (r *Run) runRecord() *service.RunRecord {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.run
+}
+
+func (r *Run) setRunRecord(run *service.RunRecord) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.run = run
+}
+
+// publish sends a record to the stream of the run without waiting for it,
+// unless ctx is done
+func (r *Run) publish(ctx context.Context, record *service.Record) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	return r.conn.Send(&service.ServerRequest{
+		ServerRequestType: &service.ServerRequest_RecordPublish{RecordPublish: record},
+	})
+}
 
-	handle := r.conn.Mbox.Deliver(&record)
-	err = r.conn.Send(&serverRecord)
+// communicate sends a record to the stream of the run, and waits for its
+// result until ctx is done
+func (r *Run) communicate(ctx context.Context, record *service.Record) (*service.Result, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	handle := r.conn.Mbox.Deliver(record)
+	err := r.conn.Send(&service.ServerRequest{
+		ServerRequestType: &service.ServerRequest_RecordCommunicate{RecordCommunicate: record},
+	})
 	if err != nil {
-		return
+		handle.release()
+		return nil, err
 	}
-	handle.wait()
+	return handle.wait(ctx)
 }
 
-func (r *Run) logCommit(data map[string]interface{}) {
+// logCommit sends the data logged to the history, at the step and with the
+// commit of the params if there are any
+func (r *Run) logCommit(ctx context.Context, data map[string]interface{}, params *logopts.LogParams) error {
 	history := service.PartialHistoryRequest{}
+	if params != nil {
+		if params.Step != nil {
+			history.Step = &service.HistoryStep{Num: *params.Step}
+		}
+		commit := params.Step == nil
+		if params.Commit != nil {
+			commit = *params.Commit
+		}
+		history.Action = &service.HistoryAction{Flush: commit}
+	}
+	var files []*service.FilesItem
 	for key, value := range data {
-		// strValue := strconv.FormatFloat(value, 'f', -1, 64)
+		if media, ok := value.(mediaFile); ok {
+			mediaValue, path, err := media.writeFile(r.settings.GetFilesDir().GetValue(), key)
+			if err != nil {
+				return fmt.Errorf("gowandb: failed to write the media of %s: %w", key, err)
+			}
+			value = mediaValue
+			files = append(files, &service.FilesItem{Path: path, Type: service.FilesItem_MEDIA})
+		}
 		data, err := json.Marshal(value)
 		if err != nil {
-			panic(err)
+			return &Error{
+				Code:    service.ErrorInfo_USAGE,
+				Message: fmt.Sprintf("gowandb: invalid value of %s: %v", key, err),
+			}
 		}
 		history.Item = append(history.Item, &service.HistoryItem{
 			Key:       key,
 			ValueJson: string(data),
 		})
 	}
+	if len(files) > 0 {
+		if err := r.sendFiles(ctx, files); err != nil {
+			return err
+		}
+	}
+
 	request := service.Request{
 		RequestType: &service.Request_PartialHistory{PartialHistory: &history},
 	}
@@ -171,58 +380,417 @@ func (r *Run) logCommit(data map[string]interface{}) {
 		Control:    &service.Control{Local: true},
 		XInfo:      &service.XRecordInfo{StreamId: r.settings.GetRunId().GetValue()},
 	}
-
-	serverRecord := service.ServerRequest{
-		ServerRequestType: &service.ServerRequest_RecordPublish{RecordPublish: &record},
+	if err := r.acquireCredit(ctx); err != nil {
+		return err
 	}
+	return r.publish(ctx, &record)
+}
 
-	err := r.conn.Send(&serverRecord)
+// sendConfig sends the keys of the config changed once the run started
+func (r *Run) sendConfig(config *service.ConfigRecord) {
+	record := service.Record{
+		RecordType: &service.Record_Config{Config: config},
+		XInfo:      &service.XRecordInfo{StreamId: r.settings.GetRunId().GetValue()},
+	}
+	err := r.acquireCredit(r.ctx)
+	if err == nil {
+		err = r.publish(r.ctx, &record)
+	}
 	if err != nil {
-		return
+		slog.Error("error sending config", "err", err)
+	}
+}
+
+// sendFiles uploads the media files written to the files dir of the run
+func (r *Run) sendFiles(ctx context.Context, files []*service.FilesItem) error {
+	record := service.Record{
+		RecordType: &service.Record_Files{Files: &service.FilesRecord{Files: files}},
+		XInfo:      &service.XRecordInfo{StreamId: r.settings.GetRunId().GetValue()},
+	}
+	if err := r.acquireCredit(ctx); err != nil {
+		return err
+	}
+	return r.publish(ctx, &record)
+}
+
+// acquireCredit takes a credit to send a record. Once there are none left, it
+// asks the handler for more and waits for them, so that the run slows down
+// while the queues of the stream are full. Flow control is off unless
+// _flow_control_credits is set.
+func (r *Run) acquireCredit(ctx context.Context) error {
+	want := r.settings.GetXFlowControlCredits().GetValue()
+	if want <= 0 {
+		return nil
+	}
+	r.creditMu.Lock()
+	defer r.creditMu.Unlock()
+	if r.credits == 0 {
+		record := service.Record{
+			RecordType: &service.Record_Request{Request: &service.Request{
+				RequestType: &service.Request_FlowCredit{FlowCredit: &service.FlowCreditRequest{
+					Want:  want,
+					XInfo: &service.XRequestInfo{StreamId: r.settings.GetRunId().GetValue()},
+				}},
+			}},
+			XInfo: &service.XRecordInfo{StreamId: r.settings.GetRunId().GetValue()},
+		}
+		result, err := r.communicate(ctx, &record)
+		if err != nil {
+			return err
+		}
+		r.credits = result.GetResponse().GetFlowCreditResponse().GetCredits()
 	}
+	if r.credits > 0 {
+		r.credits--
+	}
+	return nil
 }
 
 func (r *Run) resetPartialHistory() {
 	r.partialHistory = make(map[string]interface{})
 }
 
-func (r *Run) LogPartial(data map[string]interface{}, commit bool) {
+// commitPartialHistory logs the partial history at the step and with the
+// commit of the params, and resets it. historyMu must be held.
+func (r *Run) commitPartialHistory(ctx context.Context, params *logopts.LogParams) error {
+	defer r.resetPartialHistory()
+	return r.logCommit(ctx, r.partialHistory, params)
+}
+
+func (r *Run) LogPartial(ctx context.Context, data map[string]interface{}, commit bool) error {
+	r.historyMu.Lock()
+	defer r.historyMu.Unlock()
 	for k, v := range data {
 		r.partialHistory[k] = v
 	}
 	if commit {
-		r.LogPartialCommit()
+		return r.commitPartialHistory(ctx, nil)
+	}
+	return nil
+}
+
+func (r *Run) LogPartialCommit(ctx context.Context) error {
+	r.historyMu.Lock()
+	defer r.historyMu.Unlock()
+	return r.commitPartialHistory(ctx, nil)
+}
+
+// Log logs data to the history of the run, and commits the row of the step,
+// e.g. Log(ctx, data, logopts.WithStep(10), logopts.WithCommit(false)) adds
+// data to the row of step 10 without committing it. The data logged with
+// LogPartial is logged with it.
+func (r *Run) Log(ctx context.Context, data map[string]interface{}, opts ...logopts.LogOption) error {
+	var params *logopts.LogParams
+	if len(opts) > 0 {
+		params = &logopts.LogParams{}
+		for _, opt := range opts {
+			opt(params)
+		}
+	}
+	r.historyMu.Lock()
+	defer r.historyMu.Unlock()
+	for k, v := range data {
+		r.partialHistory[k] = v
+	}
+	return r.commitPartialHistory(ctx, params)
+}
+
+// UpdateSummary sets keys of the summary of the run, they keep their value
+// until they are logged again. It returns once the run applied the update.
+func (r *Run) UpdateSummary(ctx context.Context, values map[string]interface{}) error {
+	summary := &service.SummaryRecord{}
+	for key, value := range values {
+		data, err := json.Marshal(value)
+		if err != nil {
+			return &Error{
+				Code:    service.ErrorInfo_USAGE,
+				Message: fmt.Sprintf("gowandb: invalid value of %s: %v", key, err),
+			}
+		}
+		summary.Update = append(summary.Update, &service.SummaryItem{Key: key, ValueJson: string(data)})
+	}
+	record := service.Record{
+		RecordType: &service.Record_Summary{Summary: summary},
+		XInfo:      &service.XRecordInfo{StreamId: r.settings.GetRunId().GetValue()},
+	}
+	if err := r.acquireCredit(ctx); err != nil {
+		return err
+	}
+	if err := r.publish(ctx, &record); err != nil {
+		return err
 	}
+	// the run handles its records in order, the summary it returns has the
+	// update
+	_, err := r.Summary(ctx)
+	return err
 }
 
-func (r *Run) LogPartialCommit() {
-	r.logCommit(r.partialHistory)
-	r.resetPartialHistory()
+// Summary returns the summary of the run as the run has it, the last value
+// logged of each key of the history unless it was set by UpdateSummary
+func (r *Run) Summary(ctx context.Context) (map[string]interface{}, error) {
+	record := service.Record{
+		RecordType: &service.Record_Request{Request: &service.Request{
+			RequestType: &service.Request_GetSummary{GetSummary: &service.GetSummaryRequest{
+				XInfo: &service.XRequestInfo{StreamId: r.settings.GetRunId().GetValue()},
+			}},
+		}},
+		XInfo: &service.XRecordInfo{StreamId: r.settings.GetRunId().GetValue()},
+	}
+	result, err := r.communicate(ctx, &record)
+	if err != nil {
+		return nil, err
+	}
+	summary := make(map[string]interface{})
+	for _, item := range result.GetResponse().GetGetSummaryResponse().GetItem() {
+		// the private keys of the run are not part of its summary
+		if item.GetKey() == "_wandb" {
+			continue
+		}
+		var value interface{}
+		if err := json.Unmarshal([]byte(item.GetValueJson()), &value); err != nil {
+			return nil, fmt.Errorf("gowandb: invalid summary value of %s: %w", item.GetKey(), err)
+		}
+		summary[item.GetKey()] = value
+	}
+	return summary, nil
+}
+
+// Move moves the run to another project while it is logging, and to another
+// entity if it is not empty. The logging continues at the new location, the
+// run at the old location is kept and finished.
+func (r *Run) Move(ctx context.Context, entity, project string) error {
+	return r.move(ctx, &service.RunMoveRequest{Entity: entity, Project: project})
+}
+
+// Fork continues the logging in a new run of another project, and of another
+// entity if it is not empty. The new run gets the config and the summary of
+// the run, which is kept and finished.
+func (r *Run) Fork(ctx context.Context, entity, project string) error {
+	return r.move(ctx, &service.RunMoveRequest{Entity: entity, Project: project, Fork: true})
+}
+
+// move sends the move of the run, and waits for the run at its new location
+func (r *Run) move(ctx context.Context, request *service.RunMoveRequest) error {
+	request.XInfo = &service.XRequestInfo{StreamId: r.settings.GetRunId().GetValue()}
+	record := service.Record{
+		RecordType: &service.Record_Request{Request: &service.Request{
+			RequestType: &service.Request_RunMove{RunMove: request},
+		}},
+		XInfo: &service.XRecordInfo{StreamId: r.settings.GetRunId().GetValue()},
+	}
+	result, err := r.communicate(ctx, &record)
+	if err != nil {
+		return err
+	}
+	response := result.GetResponse().GetRunMoveResponse()
+	if err := errorFromInfo(response.GetError()); err != nil {
+		return err
+	}
+	r.setRunRecord(response.GetRun())
+	return nil
+}
+
+// DeriveMetric declares a metric computed from the keys of each row of the
+// history, e.g. DeriveMetric(ctx, "tokens_per_sec", "tokens / step_time").
+// The metric is logged in the rows that have the keys of its expression.
+func (r *Run) DeriveMetric(ctx context.Context, name, expression string) error {
+	record := service.Record{
+		RecordType: &service.Record_Request{Request: &service.Request{
+			RequestType: &service.Request_DerivedMetric{DerivedMetric: &service.DerivedMetricRequest{
+				Name:       name,
+				Expression: expression,
+				XInfo:      &service.XRequestInfo{StreamId: r.settings.GetRunId().GetValue()},
+			}},
+		}},
+		XInfo: &service.XRecordInfo{StreamId: r.settings.GetRunId().GetValue()},
+	}
+	result, err := r.communicate(ctx, &record)
+	if err != nil {
+		return err
+	}
+	return errorFromInfo(result.GetResponse().GetDerivedMetricResponse().GetError())
+}
+
+// AddStopCondition registers a condition over a metric of the history that
+// stops the run once it holds, e.g. loss > 10 for 50 steps. The run learns
+// it was stopped, and why, from its stop status.
+func (r *Run) AddStopCondition(ctx context.Context, condition *service.StopConditionRequest) error {
+	condition.XInfo = &service.XRequestInfo{StreamId: r.settings.GetRunId().GetValue()}
+	record := service.Record{
+		RecordType: &service.Record_Request{Request: &service.Request{
+			RequestType: &service.Request_StopCondition{StopCondition: condition},
+		}},
+		XInfo: &service.XRecordInfo{StreamId: r.settings.GetRunId().GetValue()},
+	}
+	result, err := r.communicate(ctx, &record)
+	if err != nil {
+		return err
+	}
+	return errorFromInfo(result.GetResponse().GetStopConditionResponse().GetError())
+}
+
+// LogArtifact logs an artifact as an output of the run, it returns once the
+// files of the artifact are uploaded and the version is committed, with the
+// id of the version. Offline, the artifact is saved when the run is synced
+// and the id is empty.
+func (r *Run) LogArtifact(ctx context.Context, artifact *Artifact) (string, error) {
+	return r.logArtifact(ctx, artifact, false)
+}
+
+// UseArtifact logs an artifact like LogArtifact, and declares it as an input
+// of the run once it is committed.
+func (r *Run) UseArtifact(ctx context.Context, artifact *Artifact) (string, error) {
+	return r.logArtifact(ctx, artifact, true)
+}
+
+func (r *Run) logArtifact(ctx context.Context, artifact *Artifact, use bool) (string, error) {
+	run := &service.RunRecord{
+		Entity:  r.settings.GetEntity().GetValue(),
+		Project: r.settings.GetProject().GetValue(),
+		RunId:   r.settings.GetRunId().GetValue(),
+	}
+	if created := r.runRecord(); created != nil {
+		run = created
+	}
+	artifactRecord, err := artifact.record(run, use)
+	if err != nil {
+		return "", err
+	}
+	record := service.Record{
+		RecordType: &service.Record_Request{Request: &service.Request{
+			RequestType: &service.Request_LogArtifact{LogArtifact: &service.LogArtifactRequest{
+				Artifact: artifactRecord,
+				XInfo:    &service.XRequestInfo{StreamId: r.settings.GetRunId().GetValue()},
+			}},
+		}},
+		XInfo: &service.XRecordInfo{StreamId: r.settings.GetRunId().GetValue()},
+	}
+	result, err := r.communicate(ctx, &record)
+	if err != nil {
+		return "", err
+	}
+	response := result.GetResponse().GetLogArtifactResponse()
+	if message := response.GetErrorMessage(); message != "" {
+		return "", &Error{Code: service.ErrorInfo_UNKNOWN, Message: message}
+	}
+	return response.GetArtifactId(), nil
 }
 
-func (r *Run) Log(data map[string]interface{}) {
-	r.LogPartial(data, true)
+// graphql returns the client of the GraphQL API of the server, it is made
+// once, by the first of the calls that need it
+func (r *Run) graphql() graphql.Client {
+	r.graphqlOnce.Do(func() {
+		if r.graphqlClient == nil {
+			r.graphqlClient = newGraphqlClient(r.settings)
+		}
+	})
+	return r.graphqlClient
 }
 
-func (r *Run) sendExit() {
+// DownloadArtifact downloads the files of a version of an artifact to root,
+// it returns the directory the files are in. The name of the artifact is
+// [[entity/]project/]name[:alias], the project and the entity of the run and
+// the latest alias by default, and root is artifacts/name:alias by default.
+// The files are checked against their digest, and those downloaded before
+// are copied from the artifacts cache. Offline runs can not download
+// artifacts.
+func (r *Run) DownloadArtifact(ctx context.Context, name, root string) (string, error) {
+	if r.Offline() {
+		return "", &Error{Code: service.ErrorInfo_USAGE, Message: "gowandb: artifacts can not be downloaded by offline runs"}
+	}
+	entity, project := r.settings.GetEntity().GetValue(), r.settings.GetProject().GetValue()
+	if run := r.runRecord(); run != nil {
+		entity, project = run.GetEntity(), run.GetProject()
+	}
+	path, err := parseArtifactPath(name, entity, project)
+	if err != nil {
+		return "", err
+	}
+	if root == "" {
+		root = filepath.Join("artifacts", path.directory())
+	}
+	root, err = filepath.Abs(root)
+	if err != nil {
+		return "", err
+	}
+
+	data, err := gql.ArtifactByName(ctx, r.graphql(),
+		utils.NilIfZero(path.entity), utils.NilIfZero(path.project), path.name+":"+path.alias)
+	if err != nil {
+		return "", fmt.Errorf("gowandb: failed to get artifact %s: %w", name, err)
+	}
+	if data.GetProject() == nil || data.GetProject().GetArtifact() == nil {
+		return "", &Error{Code: service.ErrorInfo_USAGE, Message: fmt.Sprintf("gowandb: artifact %s not found", name)}
+	}
+
+	record := service.Record{
+		RecordType: &service.Record_Request{Request: &service.Request{
+			RequestType: &service.Request_DownloadArtifact{DownloadArtifact: &service.DownloadArtifactRequest{
+				ArtifactId:   data.GetProject().GetArtifact().GetId(),
+				DownloadRoot: root,
+				XInfo:        &service.XRequestInfo{StreamId: r.settings.GetRunId().GetValue()},
+			}},
+		}},
+		XInfo: &service.XRecordInfo{StreamId: r.settings.GetRunId().GetValue()},
+	}
+	result, err := r.communicate(ctx, &record)
+	if err != nil {
+		return "", err
+	}
+	if message := result.GetResponse().GetDownloadArtifactResponse().GetErrorMessage(); message != "" {
+		return "", &Error{Code: service.ErrorInfo_UNKNOWN, Message: message}
+	}
+	return root, nil
+}
+
+// sendExit sends the exit of the run, and waits for the run to be synced
+// until ctx is done
+func (r *Run) sendExit(ctx context.Context, exitCode int32) error {
 	record := service.Record{
 		RecordType: &service.Record_Exit{
 			Exit: &service.RunExitRecord{
-				ExitCode: 0, XInfo: &service.XRecordInfo{StreamId: r.settings.GetRunId().GetValue()}}},
+				ExitCode: exitCode, XInfo: &service.XRecordInfo{StreamId: r.settings.GetRunId().GetValue()}}},
 		XInfo: &service.XRecordInfo{StreamId: r.settings.GetRunId().GetValue()},
 	}
-	serverRecord := service.ServerRequest{
-		ServerRequestType: &service.ServerRequest_RecordCommunicate{RecordCommunicate: &record},
+	_, err := r.communicate(ctx, &record)
+	return err
+}
+
+// sendError logs the error the run failed with to its console output
+func (r *Run) sendError(ctx context.Context, runErr error) error {
+	record := service.Record{
+		RecordType: &service.Record_OutputRaw{OutputRaw: &service.OutputRawRecord{
+			OutputType: service.OutputRawRecord_STDERR,
+			Timestamp:  timestamppb.Now(),
+			Line:       fmt.Sprintf("Error: %v\n", runErr),
+			XInfo:      &service.XRecordInfo{StreamId: r.settings.GetRunId().GetValue()},
+		}},
+		XInfo: &service.XRecordInfo{StreamId: r.settings.GetRunId().GetValue()},
 	}
-	handle := r.conn.Mbox.Deliver(&record)
-	err := r.conn.Send(&serverRecord)
-	if err != nil {
-		return
+	return r.publish(ctx, &record)
+}
+
+// pollExit fills the summary with what the run uploaded
+func (r *Run) pollExit(ctx context.Context, summary *FinishSummary) error {
+	record := service.Record{
+		RecordType: &service.Record_Request{Request: &service.Request{
+			RequestType: &service.Request_PollExit{PollExit: &service.PollExitRequest{}},
+		}},
+		XInfo: &service.XRecordInfo{StreamId: r.settings.GetRunId().GetValue()},
 	}
-	handle.wait()
+	result, err := r.communicate(ctx, &record)
+	if err != nil {
+		return err
+	}
+	response := result.GetResponse().GetPollExitResponse()
+	summary.UploadedBytes = response.GetPusherStats().GetUploadedBytes()
+	summary.TotalBytes = response.GetPusherStats().GetTotalBytes()
+	summary.DedupedBytes = response.GetPusherStats().GetDedupedBytes()
+	summary.FileCounts = response.GetFileCounts()
+	return nil
 }
 
-func (r *Run) sendShutdown() {
+func (r *Run) sendShutdown(ctx context.Context) error {
 	record := &service.Record{
 		RecordType: &service.Record_Request{
 			Request: &service.Request{
@@ -231,16 +799,10 @@ func (r *Run) sendShutdown() {
 				},
 			}},
 		Control: &service.Control{AlwaysSend: true, ReqResp: true},
+		XInfo:   &service.XRecordInfo{StreamId: r.settings.GetRunId().GetValue()},
 	}
-	serverRecord := service.ServerRequest{
-		ServerRequestType: &service.ServerRequest_RecordCommunicate{RecordCommunicate: record},
-	}
-	handle := r.conn.Mbox.Deliver(record)
-	err := r.conn.Send(&serverRecord)
-	if err != nil {
-		return
-	}
-	handle.wait()
+	_, err := r.communicate(ctx, record)
+	return err
 }
 
 func (r *Run) sendInformFinish() {
@@ -255,12 +817,59 @@ func (r *Run) sendInformFinish() {
 	}
 }
 
-func (r *Run) Finish() {
-	r.sendExit()
-	r.sendShutdown()
+// FinishSummary is what a run uploaded until it finished
+type FinishSummary struct {
+	ExitCode      int32
+	UploadedBytes int64
+	TotalBytes    int64
+	DedupedBytes  int64
+	FileCounts    *service.FileCounts
+}
+
+// Finish finishes the run with exit code 0, once it is synced
+func (r *Run) Finish(ctx context.Context) error {
+	_, err := r.FinishWithExitCode(ctx, 0, nil)
+	return err
+}
+
+// FinishWithExitCode finishes the run with an exit code, and the error it
+// failed with if runErr is not nil, which is logged to its console output
+// and makes the exit code 1 if it is 0. It waits for the run to be synced
+// and returns what the run uploaded. If ctx is done first, it returns the
+// error of ctx and what is left to sync is dropped.
+func (r *Run) FinishWithExitCode(ctx context.Context, exitCode int32, runErr error) (*FinishSummary, error) {
+	// the run is removed from the server even if it could not finish
+	defer r.close()
+
+	if runErr != nil {
+		if exitCode == 0 {
+			exitCode = 1
+		}
+		if err := r.sendError(ctx, runErr); err != nil {
+			slog.Error("error sending the error of the run", "err", err)
+		}
+	}
+	summary := &FinishSummary{ExitCode: exitCode}
+	if err := r.sendExit(ctx, exitCode); err != nil {
+		return summary, err
+	}
+	if err := r.pollExit(ctx, summary); err != nil {
+		return summary, err
+	}
+	if err := r.sendShutdown(ctx); err != nil {
+		return summary, err
+	}
+	shared.PrintHeadFoot(r.runRecord(), r.settings, true)
+	return summary, nil
+}
+
+// close removes the run from the server and gives back its connection
+func (r *Run) close() {
 	r.sendInformFinish()
 
-	r.conn.Close()
-	r.wg.Wait()
-	shared.PrintHeadFoot(r.run, r.settings, true)
+	if r.release != nil {
+		r.release(r.conn)
+	} else {
+		_ = r.conn.Close()
+	}
 }
@@ -0,0 +1,407 @@
+package gowandb
+
+import (
+	"bufio"
+	"context"
+	"encoding/binary"
+	"errors"
+	"net"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	"github.com/wandb/wandb/core/pkg/gowandb/opts/logopts"
+	"github.com/wandb/wandb/core/pkg/gowandb/opts/runopts"
+	"github.com/wandb/wandb/core/pkg/server"
+	"github.com/wandb/wandb/core/pkg/service"
+)
+
+// pipeRun makes a run connected over an in-memory pipe, the requests it
+// sends are received on the channel and the results are sent back to it by
+// respond
+func pipeRun(t *testing.T) (*Run, <-chan *service.ServerRequest, func(*service.Record, *service.Result)) {
+	client, peer := net.Pipe()
+	requests := make(chan *service.ServerRequest, 16)
+	// the reader stops on the first request it can't unmarshal, its error is
+	// reported by the test goroutine once the test ends
+	errs := make(chan error, 1)
+	stop := make(chan struct{})
+	go func() {
+		defer close(errs)
+		defer close(requests)
+		scanner := bufio.NewScanner(peer)
+		tokenizer := &server.Tokenizer{}
+		scanner.Split(tokenizer.Split)
+		for scanner.Scan() {
+			msg := &service.ServerRequest{}
+			if err := proto.Unmarshal(scanner.Bytes(), msg); err != nil {
+				errs <- err
+				return
+			}
+			select {
+			case requests <- msg:
+			case <-stop:
+				return
+			}
+		}
+	}()
+	t.Cleanup(func() {
+		close(stop)
+		client.Close()
+		peer.Close()
+		if err := <-errs; err != nil {
+			t.Error(err)
+		}
+	})
+	run := &Run{
+		ctx:            context.Background(),
+		settings:       &service.Settings{},
+		conn:           &Connection{ctx: context.Background(), Conn: client, Mbox: NewMailbox()},
+		partialHistory: make(History),
+	}
+	respond := func(record *service.Record, result *service.Result) {
+		result.Control = record.GetControl()
+		data, err := proto.Marshal(&service.ServerResponse{
+			ServerResponseType: &service.ServerResponse_ResultCommunicate{ResultCommunicate: result},
+		})
+		assert.NoError(t, err)
+		header := server.Header{Magic: byte('W'), DataLength: uint32(len(data))}
+		assert.NoError(t, binary.Write(peer, binary.LittleEndian, &header))
+		_, err = peer.Write(data)
+		assert.NoError(t, err)
+	}
+	return run, requests, respond
+}
+
+func TestLogStep(t *testing.T) {
+	run, requests, _ := pipeRun(t)
+	ctx := context.Background()
+	history := func() *service.PartialHistoryRequest {
+		return (<-requests).GetRecordPublish().GetRequest().GetPartialHistory()
+	}
+
+	run.Log(ctx, History{"loss": 1})
+	request := history()
+	assert.Nil(t, request.GetStep())
+	assert.Nil(t, request.GetAction())
+
+	run.Log(ctx, History{"loss": 2}, logopts.WithStep(5))
+	request = history()
+	assert.Equal(t, int64(5), request.GetStep().GetNum())
+	assert.False(t, request.GetAction().GetFlush())
+
+	run.LogPartial(ctx, History{"lr": 0.1}, false)
+	run.Log(ctx, History{"acc": 0.5}, logopts.WithStep(5), logopts.WithCommit(true))
+	request = history()
+	assert.Equal(t, int64(5), request.GetStep().GetNum())
+	assert.True(t, request.GetAction().GetFlush())
+	assert.Len(t, request.GetItem(), 2)
+
+	run.Log(ctx, History{"loss": 3}, logopts.WithCommit(false))
+	request = history()
+	assert.Nil(t, request.GetStep())
+	assert.False(t, request.GetAction().GetFlush())
+	assert.Empty(t, run.partialHistory)
+}
+
+func TestFinishWithExitCode(t *testing.T) {
+	run, requests, respond := pipeRun(t)
+	run.conn.Start()
+
+	type finished struct {
+		summary *FinishSummary
+		err     error
+	}
+	done := make(chan finished, 1)
+	go func() {
+		summary, err := run.FinishWithExitCode(context.Background(), 0, errors.New("out of memory"))
+		done <- finished{summary, err}
+	}()
+
+	output := (<-requests).GetRecordPublish().GetOutputRaw()
+	assert.Equal(t, service.OutputRawRecord_STDERR, output.GetOutputType())
+	assert.Contains(t, output.GetLine(), "out of memory")
+
+	exit := (<-requests).GetRecordCommunicate()
+	assert.Equal(t, int32(1), exit.GetExit().GetExitCode())
+	respond(exit, &service.Result{ResultType: &service.Result_ExitResult{ExitResult: &service.RunExitResult{}}})
+
+	poll := (<-requests).GetRecordCommunicate()
+	assert.NotNil(t, poll.GetRequest().GetPollExit())
+	respond(poll, &service.Result{ResultType: &service.Result_Response{Response: &service.Response{
+		ResponseType: &service.Response_PollExitResponse{PollExitResponse: &service.PollExitResponse{
+			Done:        true,
+			PusherStats: &service.FilePusherStats{UploadedBytes: 10, TotalBytes: 12},
+			FileCounts:  &service.FileCounts{MediaCount: 2},
+		}},
+	}}})
+
+	shutdown := (<-requests).GetRecordCommunicate()
+	assert.NotNil(t, shutdown.GetRequest().GetShutdown())
+	respond(shutdown, &service.Result{})
+	assert.NotNil(t, (<-requests).GetInformFinish())
+
+	result := <-done
+	assert.NoError(t, result.err)
+	assert.Equal(t, int32(1), result.summary.ExitCode)
+	assert.Equal(t, int64(10), result.summary.UploadedBytes)
+	assert.Equal(t, int64(12), result.summary.TotalBytes)
+	assert.Equal(t, int32(2), result.summary.FileCounts.GetMediaCount())
+}
+
+func TestFinishTimeout(t *testing.T) {
+	run, requests, _ := pipeRun(t)
+	run.conn.Start()
+
+	done := make(chan error, 1)
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancel()
+		_, err := run.FinishWithExitCode(ctx, 3, nil)
+		done <- err
+	}()
+	assert.Equal(t, int32(3), (<-requests).GetRecordCommunicate().GetExit().GetExitCode())
+	assert.NotNil(t, (<-requests).GetInformFinish())
+	assert.ErrorIs(t, <-done, context.DeadlineExceeded)
+}
+
+func TestCancelledContext(t *testing.T) {
+	run, requests, _ := pipeRun(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	assert.ErrorIs(t, run.Log(ctx, History{"loss": 1}), context.Canceled)
+	assert.ErrorIs(t, run.DeriveMetric(ctx, "rate", "a / b"), context.Canceled)
+	assert.Empty(t, requests)
+
+	// a communicate stops waiting once its context is cancelled
+	ctx, cancel = context.WithCancel(context.Background())
+	done := make(chan error, 1)
+	go func() { done <- run.DeriveMetric(ctx, "rate", "a / b") }()
+	assert.NotNil(t, (<-requests).GetRecordCommunicate().GetRequest().GetDerivedMetric())
+	cancel()
+	assert.ErrorIs(t, <-done, context.Canceled)
+	// and no longer waits in the mailbox
+	assert.Empty(t, run.conn.Mbox.handles)
+}
+
+func TestConnectionClosed(t *testing.T) {
+	run, requests, _ := pipeRun(t)
+	run.conn.Start()
+
+	// the pending communicate fails once no more responses come
+	done := make(chan error, 1)
+	go func() {
+		_, err := run.Summary(context.Background())
+		done <- err
+	}()
+	assert.NotNil(t, (<-requests).GetRecordCommunicate().GetRequest().GetGetSummary())
+	assert.NoError(t, run.conn.Conn.Close())
+	assert.ErrorIs(t, <-done, ErrNetwork)
+
+	// and so do the later ones
+	_, err := run.Summary(context.Background())
+	assert.ErrorIs(t, err, ErrNetwork)
+	assert.Empty(t, run.conn.Mbox.handles)
+}
+
+func TestResume(t *testing.T) {
+	run, requests, respond := pipeRun(t)
+	run.params = &runopts.RunParams{}
+	run.Config = newConfig(map[string]interface{}{"epochs": 20})
+	run.conn.Start()
+	ctx := context.Background()
+
+	done := make(chan error, 1)
+	go func() { done <- run.init(ctx) }()
+	assert.NotNil(t, (<-requests).GetInformInit())
+	record := (<-requests).GetRecordCommunicate()
+	respond(record, &service.Result{ResultType: &service.Result_RunResult{RunResult: &service.RunUpdateResult{
+		Run: &service.RunRecord{
+			RunId:        "run1",
+			Resumed:      true,
+			StartingStep: 5,
+			Config: &service.ConfigRecord{Update: []*service.ConfigItem{
+				{Key: "lr", ValueJson: "0.1"},
+				{Key: "epochs", ValueJson: "20"},
+			}},
+			Summary: &service.SummaryRecord{Update: []*service.SummaryItem{
+				{Key: "loss", ValueJson: "0.5"},
+			}},
+		},
+	}}})
+	assert.NoError(t, <-done)
+
+	assert.True(t, run.Resumed())
+	assert.Equal(t, int64(5), run.StartingStep())
+	assert.Equal(t, map[string]interface{}{"loss": 0.5}, run.RestoredSummary())
+	lr, ok := run.Config.Get("lr")
+	assert.True(t, ok)
+	assert.Equal(t, 0.1, lr)
+
+	// the handler continues the history at the starting step of the run
+	go func() { done <- run.start(ctx) }()
+	assert.NotNil(t, (<-requests).GetInformStart())
+	record = (<-requests).GetRecordCommunicate()
+	assert.Equal(t, int64(5), record.GetRequest().GetRunStart().GetRun().GetStartingStep())
+	respond(record, &service.Result{})
+	assert.NoError(t, <-done)
+}
+
+func TestRunUpdates(t *testing.T) {
+	run, requests, _ := pipeRun(t)
+	ctx := context.Background()
+	update := func() *service.RunRecord {
+		return (<-requests).GetRecordPublish().GetRun()
+	}
+
+	assert.ErrorIs(t, run.SetNotes(ctx, "notes"), ErrUsage)
+
+	run.run = &service.RunRecord{
+		RunId:       "run1",
+		DisplayName: "name",
+		Tags:        []string{"a"},
+		Config:      &service.ConfigRecord{Update: []*service.ConfigItem{{Key: "lr", ValueJson: "0.1"}}},
+	}
+	assert.NoError(t, run.AddTags(ctx, "a", "b"))
+	record := update()
+	assert.Equal(t, []string{"a", "b"}, record.GetTags())
+	assert.Equal(t, "name", record.GetDisplayName())
+	// the config is not sent again
+	assert.Nil(t, record.GetConfig())
+
+	assert.NoError(t, run.SetTags(ctx, "c"))
+	assert.Equal(t, []string{"c"}, update().GetTags())
+
+	assert.NoError(t, run.SetNotes(ctx, "notes"))
+	assert.NoError(t, run.SetGroup(ctx, "group"))
+	assert.NoError(t, run.SetJobType(ctx, "train"))
+	update()
+	update()
+	record = update()
+	assert.Equal(t, "notes", record.GetNotes())
+	assert.Equal(t, "group", record.GetRunGroup())
+	assert.Equal(t, "train", record.GetJobType())
+	assert.Equal(t, []string{"c"}, record.GetTags())
+}
+
+func TestUpdateSummary(t *testing.T) {
+	run, requests, respond := pipeRun(t)
+	run.conn.Start()
+
+	done := make(chan error, 1)
+	go func() {
+		done <- run.UpdateSummary(context.Background(), History{"best_loss": 0.1})
+	}()
+	update := (<-requests).GetRecordPublish().GetSummary().GetUpdate()
+	assert.Len(t, update, 1)
+	assert.Equal(t, "best_loss", update[0].GetKey())
+	assert.Equal(t, "0.1", update[0].GetValueJson())
+
+	// the update returns once the summary of the run has it
+	record := (<-requests).GetRecordCommunicate()
+	assert.NotNil(t, record.GetRequest().GetGetSummary())
+	respond(record, &service.Result{ResultType: &service.Result_Response{Response: &service.Response{
+		ResponseType: &service.Response_GetSummaryResponse{GetSummaryResponse: &service.GetSummaryResponse{
+			Item: []*service.SummaryItem{
+				{Key: "best_loss", ValueJson: "0.1"},
+				{Key: "_wandb", ValueJson: `{"runtime": 3}`},
+			},
+		}},
+	}}})
+	assert.NoError(t, <-done)
+
+	go func() {
+		summary, err := run.Summary(context.Background())
+		assert.NoError(t, err)
+		assert.Equal(t, map[string]interface{}{"best_loss": 0.1, "acc": 0.9}, summary)
+		done <- err
+	}()
+	record = (<-requests).GetRecordCommunicate()
+	respond(record, &service.Result{ResultType: &service.Result_Response{Response: &service.Response{
+		ResponseType: &service.Response_GetSummaryResponse{GetSummaryResponse: &service.GetSummaryResponse{
+			Item: []*service.SummaryItem{
+				{Key: "best_loss", ValueJson: "0.1"},
+				{Key: "acc", ValueJson: "0.9"},
+			},
+		}},
+	}}})
+	assert.NoError(t, <-done)
+}
+
+func TestConcurrentLog(t *testing.T) {
+	run, requests, _ := pipeRun(t)
+	ctx := context.Background()
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		i := i
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for step := 0; step < 10; step++ {
+				assert.NoError(t, run.Log(ctx, History{"worker": i, "step": step}))
+			}
+		}()
+	}
+	// the rows of every goroutine are sent whole
+	for n := 0; n < 8*10; n++ {
+		request := <-requests
+		assert.Len(t, request.GetRecordPublish().GetRequest().GetPartialHistory().GetItem(), 2)
+	}
+	wg.Wait()
+	assert.Empty(t, run.partialHistory)
+}
+
+func TestRunsSharingConnection(t *testing.T) {
+	run1, requests, respond := pipeRun(t)
+	run1.settings = &service.Settings{RunId: &wrapperspb.StringValue{Value: "run1"}}
+	run2 := &Run{
+		ctx:            context.Background(),
+		settings:       &service.Settings{RunId: &wrapperspb.StringValue{Value: "run2"}},
+		conn:           run1.conn,
+		partialHistory: make(History),
+	}
+	run1.conn.Start()
+
+	// the results of each run are delivered to it, whatever the order they
+	// are responded in
+	var mu sync.Mutex
+	go func() {
+		for request := range requests {
+			record := request.GetRecordCommunicate()
+			if record == nil {
+				continue
+			}
+			name := record.GetXInfo().GetStreamId()
+			go func() {
+				mu.Lock()
+				defer mu.Unlock()
+				respond(record, &service.Result{ResultType: &service.Result_Response{Response: &service.Response{
+					ResponseType: &service.Response_GetSummaryResponse{GetSummaryResponse: &service.GetSummaryResponse{
+						Item: []*service.SummaryItem{{Key: "run", ValueJson: `"` + name + `"`}},
+					}},
+				}}})
+			}()
+		}
+	}()
+
+	var wg sync.WaitGroup
+	for _, run := range []*Run{run1, run2} {
+		run := run
+		for i := 0; i < 10; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				summary, err := run.Summary(context.Background())
+				assert.NoError(t, err)
+				assert.Equal(t, run.settings.GetRunId().GetValue(), summary["run"])
+			}()
+		}
+	}
+	wg.Wait()
+}
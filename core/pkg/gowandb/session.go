@@ -19,7 +19,7 @@ type Session struct {
 	sessionopts.SessionParams
 }
 
-func (s *Session) start() {
+func (s *Session) start() error {
 	var execCmd *execbin.ForkExecCmd
 	var err error
 
@@ -37,36 +37,70 @@ func (s *Session) start() {
 			execCmd, err = launch.LaunchCommand("wandb-core")
 		}
 		if err != nil {
-			panic("error launching")
+			return fmt.Errorf("gowandb: failed to launch wandb-core: %w", err)
 		}
 		s.execCmd = execCmd
 
 		port, err := launch.Getport()
 		if err != nil {
-			panic("error getting port")
+			return fmt.Errorf("gowandb: failed to get the port of wandb-core: %w", err)
 		}
 		s.Address = fmt.Sprintf("127.0.0.1:%d", port)
 	}
 
-	s.manager = NewManager(ctx, sessionSettings, s.Address)
+	s.manager = NewManager(ctx, sessionSettings, s.Address, s.managerOptions()...)
+	return nil
 }
 
-func (s *Session) Close() {
-	s.manager.Close()
-	if s.execCmd != nil {
+// managerOptions returns the options of the manager set by the session
+// options
+func (s *Session) managerOptions() []ManagerOption {
+	var opts []ManagerOption
+	if s.ConnectAttempts > 0 {
+		backoff := s.ConnectBackoff
+		if backoff <= 0 {
+			backoff = defaultConnectBackoff
+		}
+		opts = append(opts, WithConnectRetry(s.ConnectAttempts, backoff))
+	}
+	if s.MaxConnections > 0 {
+		opts = append(opts, WithMaxConnections(s.MaxConnections))
+	}
+	if s.ShareConnections {
+		opts = append(opts, WithConnectionSharing())
+	}
+	return opts
+}
+
+func (s *Session) Close() error {
+	err := s.manager.Close()
+	// the core process only exits once it received the teardown request
+	if err == nil && s.execCmd != nil {
 		_ = s.execCmd.Wait()
 		// TODO(beta): check exit code
 	}
+	return err
 }
 
-func (s *Session) NewRun(opts ...runopts.RunOption) (*Run, error) {
+// NewRun creates and starts a run, it returns the error of ctx if ctx is
+// done before the run is started
+func (s *Session) NewRun(ctx context.Context, opts ...runopts.RunOption) (*Run, error) {
 	runParams := &runopts.RunParams{}
 	for _, opt := range opts {
 		opt(runParams)
 	}
-	run := s.manager.NewRun(runParams)
+	run, err := s.manager.NewRun(ctx, runParams)
+	if err != nil {
+		return nil, err
+	}
 	run.setup()
-	run.init()
-	run.start()
+	if err := run.init(ctx); err != nil {
+		run.close()
+		return nil, err
+	}
+	if err := run.start(ctx); err != nil {
+		run.close()
+		return nil, err
+	}
 	return run, nil
 }
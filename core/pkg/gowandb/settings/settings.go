@@ -1,6 +1,7 @@
 package settings
 
 import (
+	"fmt"
 	"os"
 	"path/filepath"
 	"time"
@@ -98,6 +99,18 @@ func NewSettings(args ...any) *SettingsWrap {
 	return &SettingsWrap{settings}
 }
 
+// SetOffline sets whether the runs are offline, their records are only
+// stored in their sync file to be synced later. It is called before SetRunID,
+// as the sync dir of a run is named after its mode.
+func (s *SettingsWrap) SetOffline(offline bool) {
+	runMode := "run"
+	if offline {
+		runMode = "offline-run"
+	}
+	s.Settings.XOffline = &wrapperspb.BoolValue{Value: offline}
+	s.Settings.RunMode = &wrapperspb.StringValue{Value: runMode}
+}
+
 func (s *SettingsWrap) SetRunID(runID string) {
 	wandbDir := s.Settings.WandbDir.Value
 	timeStamp := s.Settings.Timespec.Value
@@ -128,3 +141,20 @@ func (s *SettingsWrap) SetRunID(runID string) {
 		Value: filepath.Join(tmpDir, "code"),
 	}
 }
+
+// Validate checks that the settings can be used to create a run
+func (s *SettingsWrap) Validate() error {
+	if s.Entity != nil && s.Entity.GetValue() == "" {
+		return fmt.Errorf("invalid settings: Entity must not be empty when set")
+	}
+	if s.Project != nil && s.Project.GetValue() == "" {
+		return fmt.Errorf("invalid settings: Project must not be empty when set")
+	}
+	if s.RunId != nil && s.RunId.GetValue() == "" {
+		return fmt.Errorf("invalid settings: RunId must not be empty when set")
+	}
+	if s.GetXOffline().GetValue() && s.GetXSync().GetValue() {
+		return fmt.Errorf("invalid settings: XOffline and XSync can not both be set")
+	}
+	return nil
+}
@@ -0,0 +1,274 @@
+package gowandb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+	"slices"
+	"time"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/segmentio/encoding/json"
+
+	"github.com/wandb/wandb/core/internal/gql"
+	"github.com/wandb/wandb/core/pkg/gowandb/opts/runopts"
+	"github.com/wandb/wandb/core/pkg/gowandb/runconfig"
+	"github.com/wandb/wandb/core/pkg/service"
+	"github.com/wandb/wandb/core/pkg/utils"
+)
+
+// defaultSweepHeartbeat is how often a sweep agent asks the controller of
+// the sweep for commands, as the Python agent does
+const defaultSweepHeartbeat = 5 * time.Second
+
+// SweepTrial runs a trial of a sweep, with a run whose config has the
+// parameters suggested by the controller of the sweep. The metrics the trial
+// logs to the run are its results. ctx is cancelled if the sweep stops the
+// run early.
+type SweepTrial func(ctx context.Context, run *Run) error
+
+// SweepOption is an option for a sweep agent
+type SweepOption func(*SweepAgent)
+
+// WithSweepProject sets the entity and the project of the sweep, by default
+// they are those of the session settings
+func WithSweepProject(entity, project string) SweepOption {
+	return func(a *SweepAgent) {
+		a.entity = entity
+		a.project = project
+	}
+}
+
+// WithSweepCount sets the number of trials the agent runs, all those the
+// controller suggests if it is not positive
+func WithSweepCount(count int) SweepOption {
+	return func(a *SweepAgent) {
+		a.count = count
+	}
+}
+
+// WithSweepHeartbeat sets how often the agent asks the controller of the
+// sweep for commands
+func WithSweepHeartbeat(interval time.Duration) SweepOption {
+	return func(a *SweepAgent) {
+		a.heartbeat = interval
+	}
+}
+
+// SweepAgent runs the trials of a sweep suggested by its controller, one at
+// a time and in the order they are suggested.
+type SweepAgent struct {
+	sweepID string
+	entity  string
+	project string
+
+	// count is the number of trials to run, all of them if it is 0
+	count int
+
+	// heartbeat is how often the agent asks for commands
+	heartbeat time.Duration
+
+	client graphql.Client
+
+	// newRun starts the run of a trial
+	newRun func(ctx context.Context, opts ...runopts.RunOption) (*Run, error)
+
+	// agentID is the id of the agent registered with the sweep
+	agentID string
+}
+
+// sweepCommand is a command of the controller of a sweep to its agents
+type sweepCommand struct {
+	Type   string              `json:"type"`
+	RunID  string              `json:"run_id"`
+	RunIDs []string            `json:"run_ids"`
+	Args   map[string]sweepArg `json:"args"`
+}
+
+// sweepArg is a parameter of a trial
+type sweepArg struct {
+	Value interface{} `json:"value"`
+}
+
+// sweepTrialRun is a trial being run
+type sweepTrialRun struct {
+	id     string
+	cancel context.CancelFunc
+	done   chan error
+}
+
+// NewSweepAgent creates an agent for the sweep with the id, the runs of its
+// trials are runs of the session
+func (s *Session) NewSweepAgent(sweepID string, opts ...SweepOption) *SweepAgent {
+	settings := s.manager.settings.Settings
+	return newSweepAgent(sweepID, settings, newGraphqlClient(settings), s.NewRun, opts...)
+}
+
+func newSweepAgent(
+	sweepID string,
+	settings *service.Settings,
+	client graphql.Client,
+	newRun func(ctx context.Context, opts ...runopts.RunOption) (*Run, error),
+	opts ...SweepOption,
+) *SweepAgent {
+	agent := &SweepAgent{
+		sweepID:   sweepID,
+		entity:    settings.GetEntity().GetValue(),
+		project:   settings.GetProject().GetValue(),
+		heartbeat: defaultSweepHeartbeat,
+		client:    client,
+		newRun:    newRun,
+	}
+	for _, opt := range opts {
+		opt(agent)
+	}
+	return agent
+}
+
+// Config returns the config of the sweep, in YAML
+func (a *SweepAgent) Config(ctx context.Context) (string, error) {
+	data, err := gql.SweepConfig(ctx, a.client, utils.NilIfZero(a.entity), utils.NilIfZero(a.project), a.sweepID)
+	if err != nil {
+		return "", fmt.Errorf("gowandb: failed to get the config of the sweep: %w", err)
+	}
+	if data.GetProject() == nil || data.GetProject().GetSweep() == nil {
+		return "", &Error{Code: service.ErrorInfo_USAGE, Message: fmt.Sprintf("gowandb: sweep %s not found", a.sweepID)}
+	}
+	return data.GetProject().GetSweep().GetConfig(), nil
+}
+
+// Run registers the agent with the sweep, and runs the trials suggested by
+// its controller until it has no more, the count of trials is reached or ctx
+// is done. The trials that fail are logged, and do not stop the agent.
+func (a *SweepAgent) Run(ctx context.Context, trial SweepTrial) error {
+	host, _ := os.Hostname()
+	data, err := gql.CreateAgent(ctx, a.client, host, utils.NilIfZero(a.project), utils.NilIfZero(a.entity), a.sweepID)
+	if err != nil {
+		return fmt.Errorf("gowandb: failed to create the sweep agent: %w", err)
+	}
+	if data.GetCreateAgent() == nil || data.GetCreateAgent().GetAgent() == nil {
+		return fmt.Errorf("gowandb: failed to create the sweep agent for sweep %s", a.sweepID)
+	}
+	a.agentID = data.GetCreateAgent().GetAgent().GetId()
+
+	ticker := time.NewTicker(a.heartbeat)
+	defer ticker.Stop()
+
+	var current *sweepTrialRun
+	var queued []sweepCommand
+	started := 0
+	exiting := false
+	for {
+		runState := map[string]bool{}
+		if current != nil {
+			runState[current.id] = true
+		}
+		commands, err := a.sendHeartbeat(ctx, runState)
+		if err != nil {
+			slog.Error("error sending the sweep agent heartbeat", "err", err)
+		}
+		for _, command := range commands {
+			switch command.Type {
+			case "run", "resume":
+				queued = append(queued, command)
+			case "stop":
+				queued = slices.DeleteFunc(queued, func(c sweepCommand) bool {
+					return slices.Contains(command.RunIDs, c.RunID)
+				})
+				if current != nil && slices.Contains(command.RunIDs, current.id) {
+					current.cancel()
+				}
+			case "exit":
+				exiting = true
+			}
+		}
+
+		if current == nil && len(queued) > 0 && !exiting && (a.count <= 0 || started < a.count) {
+			current = a.startTrial(ctx, queued[0], trial)
+			queued = queued[1:]
+			started++
+		}
+		if current == nil && (exiting || (a.count > 0 && started >= a.count)) {
+			return nil
+		}
+
+		var done <-chan error
+		if current != nil {
+			done = current.done
+		}
+		select {
+		case <-ctx.Done():
+			if current != nil {
+				current.cancel()
+				<-current.done
+			}
+			return ctx.Err()
+		case err := <-done:
+			if err != nil {
+				slog.Error("sweep trial failed", "run", current.id, "err", err)
+			}
+			current = nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// sendHeartbeat reports the state of the trials to the controller of the
+// sweep, and returns its commands
+func (a *SweepAgent) sendHeartbeat(ctx context.Context, runState map[string]bool) ([]sweepCommand, error) {
+	state, err := json.Marshal(runState)
+	if err != nil {
+		return nil, err
+	}
+	metrics, runStateJSON := "{}", string(state)
+	data, err := gql.AgentHeartbeat(ctx, a.client, a.agentID, &metrics, &runStateJSON)
+	if err != nil {
+		return nil, err
+	}
+	if data.GetAgentHeartbeat() == nil || data.GetAgentHeartbeat().GetCommands() == nil {
+		return nil, nil
+	}
+	var commands []sweepCommand
+	if err := json.Unmarshal([]byte(*data.GetAgentHeartbeat().GetCommands()), &commands); err != nil {
+		return nil, err
+	}
+	return commands, nil
+}
+
+// startTrial runs a trial in the background with the parameters of a command
+func (a *SweepAgent) startTrial(ctx context.Context, command sweepCommand, trial SweepTrial) *sweepTrialRun {
+	config := runconfig.Config{}
+	for name, arg := range command.Args {
+		config[name] = arg.Value
+	}
+	trialCtx, cancel := context.WithCancel(ctx)
+	current := &sweepTrialRun{id: command.RunID, cancel: cancel, done: make(chan error, 1)}
+
+	go func() {
+		defer cancel()
+		opts := []runopts.RunOption{
+			runopts.WithRunID(command.RunID),
+			runopts.WithConfig(config),
+			runopts.WithSweepID(a.sweepID),
+		}
+		if a.project != "" {
+			opts = append(opts, runopts.WithProject(a.project))
+		}
+		run, err := a.newRun(trialCtx, opts...)
+		if err != nil {
+			current.done <- err
+			return
+		}
+		err = trial(trialCtx, run)
+		// the trials stopped early by the sweep did not fail
+		if trialCtx.Err() != nil && errors.Is(err, context.Canceled) {
+			err = nil
+		}
+		// the run of a stopped trial is still synced
+		_, finishErr := run.FinishWithExitCode(context.WithoutCancel(ctx), 0, err)
+		current.done <- errors.Join(err, finishErr)
+	}()
+	return current
+}
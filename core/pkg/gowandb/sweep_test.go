@@ -0,0 +1,125 @@
+package gowandb
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/segmentio/encoding/json"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/wandb/wandb/core/pkg/gowandb/opts/runopts"
+	"github.com/wandb/wandb/core/pkg/service"
+)
+
+// sweepController serves the sweep agent mutations, the heartbeats get the
+// commands in order and then none
+type sweepController struct {
+	mu        sync.Mutex
+	commands  []string
+	runStates []string
+}
+
+func (c *sweepController) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	body, _ := io.ReadAll(r.Body)
+	var request struct {
+		OperationName string            `json:"operationName"`
+		Variables     map[string]string `json:"variables"`
+	}
+	_ = json.Unmarshal(body, &request)
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	switch request.OperationName {
+	case "CreateAgent":
+		_, _ = w.Write([]byte(`{"data": {"createAgent": {"agent": {"id": "agent1"}}}}`))
+	case "AgentHeartbeat":
+		c.runStates = append(c.runStates, request.Variables["runState"])
+		commands := "[]"
+		if len(c.commands) > 0 {
+			commands, c.commands = c.commands[0], c.commands[1:]
+		}
+		encoded, _ := json.Marshal(commands)
+		_, _ = w.Write([]byte(`{"data": {"agentHeartbeat": {"agent": {"id": "agent1"}, "commands": ` + string(encoded) + `}}}`))
+	}
+}
+
+// sweepRuns starts the runs of the trials over pipes that respond to every
+// request
+func sweepRuns(t *testing.T, params chan<- *runopts.RunParams) func(ctx context.Context, opts ...runopts.RunOption) (*Run, error) {
+	return func(ctx context.Context, opts ...runopts.RunOption) (*Run, error) {
+		runParams := &runopts.RunParams{}
+		for _, opt := range opts {
+			opt(runParams)
+		}
+		params <- runParams
+		run, requests, respond := pipeRun(t)
+		run.Config = newConfig(*runParams.Config)
+		run.conn.Start()
+		go func() {
+			for request := range requests {
+				if record := request.GetRecordCommunicate(); record != nil {
+					respond(record, &service.Result{})
+				}
+			}
+		}()
+		return run, nil
+	}
+}
+
+func TestSweepAgent(t *testing.T) {
+	controller := &sweepController{commands: []string{
+		`[{"type": "run", "run_id": "trial1", "args": {"lr": {"value": 0.1}}},
+		  {"type": "run", "run_id": "trial2", "args": {"lr": {"value": 0.2}}}]`,
+		`[{"type": "stop", "run_ids": ["trial2"]}]`,
+		`[{"type": "exit"}]`,
+	}}
+	server := httptest.NewServer(controller)
+	defer server.Close()
+
+	params := make(chan *runopts.RunParams, 2)
+	agent := newSweepAgent("sweep1", &service.Settings{}, graphql.NewClient(server.URL, server.Client()),
+		sweepRuns(t, params), WithSweepHeartbeat(time.Millisecond))
+
+	var lrs []interface{}
+	err := agent.Run(context.Background(), func(ctx context.Context, run *Run) error {
+		lr, _ := run.Config.Get("lr")
+		lrs = append(lrs, lr)
+		// the trial runs until the next heartbeats
+		time.Sleep(20 * time.Millisecond)
+		return nil
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []interface{}{0.1}, lrs)
+	runParams := <-params
+	assert.Equal(t, "trial1", *runParams.RunID)
+	assert.Equal(t, "sweep1", *runParams.SweepID)
+	assert.Contains(t, controller.runStates, `{"trial1":true}`)
+}
+
+func TestSweepAgentStop(t *testing.T) {
+	controller := &sweepController{commands: []string{
+		`[{"type": "run", "run_id": "trial1", "args": {}}]`,
+		`[{"type": "stop", "run_ids": ["trial1"]}]`,
+	}}
+	server := httptest.NewServer(controller)
+	defer server.Close()
+
+	params := make(chan *runopts.RunParams, 1)
+	agent := newSweepAgent("sweep1", &service.Settings{}, graphql.NewClient(server.URL, server.Client()),
+		sweepRuns(t, params), WithSweepHeartbeat(time.Millisecond), WithSweepCount(1))
+
+	stopped := false
+	err := agent.Run(context.Background(), func(ctx context.Context, run *Run) error {
+		<-ctx.Done()
+		stopped = true
+		return ctx.Err()
+	})
+	assert.NoError(t, err)
+	assert.True(t, stopped)
+}
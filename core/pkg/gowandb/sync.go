@@ -0,0 +1,139 @@
+package gowandb
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	"github.com/wandb/wandb/core/pkg/service"
+)
+
+// Sync uploads the run recorded in a sync file, e.g. by an offline run, and
+// returns the URL of the run. path is the sync file, or the sync dir of the
+// run it is in. The records are uploaded with the settings of the session,
+// to the run id of the file.
+func (s *Session) Sync(ctx context.Context, path string) (string, error) {
+	return s.manager.Sync(ctx, path)
+}
+
+// Sync uploads the run recorded in a sync file, over a connection of the pool
+// that it waits for until ctx is done
+func (m *Manager) Sync(ctx context.Context, path string) (string, error) {
+	syncFile, err := findSyncFile(path)
+	if err != nil {
+		return "", err
+	}
+	syncSettings := m.settings.Copy()
+	setSyncFile(syncSettings.Settings, syncFile)
+
+	if err := m.ensureControlConnection(ctx); err != nil {
+		return "", err
+	}
+	conn, err := m.pool.get(ctx)
+	if err != nil {
+		return "", err
+	}
+	defer m.pool.put(conn)
+	return syncStream(ctx, conn, syncSettings.Settings)
+}
+
+// findSyncFile returns the sync file at path, the run-<id>.wandb file in it
+// if it is a sync dir
+func findSyncFile(path string) (string, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return "", &Error{Code: service.ErrorInfo_USAGE, Message: fmt.Sprintf("gowandb: sync file not found: %v", err)}
+	}
+	if info.IsDir() {
+		matches, _ := filepath.Glob(filepath.Join(path, "run-*.wandb"))
+		if len(matches) != 1 {
+			return "", &Error{Code: service.ErrorInfo_USAGE, Message: fmt.Sprintf("gowandb: no single sync file in %s", path)}
+		}
+		path = matches[0]
+	}
+	if syncRunID(path) == "" {
+		return "", &Error{Code: service.ErrorInfo_USAGE, Message: fmt.Sprintf("gowandb: %s is not a run-<id>.wandb sync file", path)}
+	}
+	return filepath.Abs(path)
+}
+
+// syncRunID is the id of the run of the sync file, its name is
+// run-<id>.wandb
+func syncRunID(syncFile string) string {
+	name := filepath.Base(syncFile)
+	if !strings.HasPrefix(name, "run-") || !strings.HasSuffix(name, ".wandb") {
+		return ""
+	}
+	return strings.TrimSuffix(strings.TrimPrefix(name, "run-"), ".wandb")
+}
+
+// setSyncFile makes the settings those of a stream syncing the sync file,
+// whose files are in the sync dir of the file
+func setSyncFile(settings *service.Settings, syncFile string) {
+	syncDir := filepath.Dir(syncFile)
+	settings.RunId = &wrapperspb.StringValue{Value: syncRunID(syncFile)}
+	settings.SyncFile = &wrapperspb.StringValue{Value: syncFile}
+	settings.SyncDir = &wrapperspb.StringValue{Value: syncDir}
+	settings.FilesDir = &wrapperspb.StringValue{Value: filepath.Join(syncDir, "files")}
+	settings.XOffline = &wrapperspb.BoolValue{Value: false}
+	settings.XSync = &wrapperspb.BoolValue{Value: true}
+}
+
+// syncStream starts a stream with the sync settings, and waits until it
+// uploaded the records of the sync file or ctx is done
+func syncStream(ctx context.Context, conn *Connection, settings *service.Settings) (string, error) {
+	streamID := settings.GetRunId().GetValue()
+	err := conn.Send(&service.ServerRequest{
+		ServerRequestType: &service.ServerRequest_InformInit{InformInit: &service.ServerInformInitRequest{
+			Settings: settings,
+			XInfo:    &service.XRecordInfo{StreamId: streamID},
+		}},
+	})
+	if err != nil {
+		return "", err
+	}
+	// the stream is closed once the records are uploaded, or the sync is
+	// given up
+	defer func() {
+		_ = conn.Send(&service.ServerRequest{
+			ServerRequestType: &service.ServerRequest_InformFinish{InformFinish: &service.ServerInformFinishRequest{
+				XInfo: &service.XRecordInfo{StreamId: streamID},
+			}},
+		})
+	}()
+
+	record := &service.Record{
+		RecordType: &service.Record_Request{
+			Request: &service.Request{
+				RequestType: &service.Request_Sync{
+					Sync: &service.SyncRequest{},
+				},
+			},
+		},
+		XInfo: &service.XRecordInfo{StreamId: streamID},
+	}
+	if err := ctx.Err(); err != nil {
+		return "", err
+	}
+	handle := conn.Mbox.Deliver(record)
+	err = conn.Send(&service.ServerRequest{
+		ServerRequestType: &service.ServerRequest_RecordCommunicate{RecordCommunicate: record},
+	})
+	if err != nil {
+		handle.release()
+		return "", err
+	}
+	result, err := handle.wait(ctx)
+	if err != nil {
+		return "", err
+	}
+	response := result.GetResponse().GetSyncResponse()
+	if err := errorFromInfo(response.GetError()); err != nil {
+		return "", err
+	}
+	return response.GetUrl(), nil
+}
@@ -0,0 +1,88 @@
+package gowandb
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/wandb/wandb/core/pkg/service"
+)
+
+func TestFindSyncFile(t *testing.T) {
+	syncDir := filepath.Join(t.TempDir(), "offline-run-20240101_000000-abc123")
+	assert.NoError(t, os.MkdirAll(syncDir, 0755))
+	syncFile := filepath.Join(syncDir, "run-abc123.wandb")
+	assert.NoError(t, os.WriteFile(syncFile, nil, 0644))
+
+	found, err := findSyncFile(syncFile)
+	assert.NoError(t, err)
+	assert.Equal(t, syncFile, found)
+
+	// the sync file of a sync dir is found in it
+	found, err = findSyncFile(syncDir)
+	assert.NoError(t, err)
+	assert.Equal(t, syncFile, found)
+	assert.Equal(t, "abc123", syncRunID(found))
+
+	_, err = findSyncFile(filepath.Join(syncDir, "files"))
+	assert.ErrorIs(t, err, ErrUsage)
+	_, err = findSyncFile(t.TempDir())
+	assert.ErrorIs(t, err, ErrUsage)
+	other := filepath.Join(syncDir, "debug.log")
+	assert.NoError(t, os.WriteFile(other, nil, 0644))
+	_, err = findSyncFile(other)
+	assert.ErrorIs(t, err, ErrUsage)
+}
+
+func TestSyncStream(t *testing.T) {
+	run, requests, respond := pipeRun(t)
+	run.conn.Start()
+	settings := &service.Settings{}
+	setSyncFile(settings, "/tmp/offline-run-1/run-abc123.wandb")
+
+	type synced struct {
+		url string
+		err error
+	}
+	done := make(chan synced, 1)
+	go func() {
+		url, err := syncStream(context.Background(), run.conn, settings)
+		done <- synced{url, err}
+	}()
+
+	init := (<-requests).GetInformInit()
+	assert.Equal(t, "abc123", init.GetXInfo().GetStreamId())
+	assert.True(t, init.GetSettings().GetXSync().GetValue())
+	assert.False(t, init.GetSettings().GetXOffline().GetValue())
+	assert.Equal(t, "/tmp/offline-run-1/files", init.GetSettings().GetFilesDir().GetValue())
+
+	record := (<-requests).GetRecordCommunicate()
+	assert.NotNil(t, record.GetRequest().GetSync())
+	respond(record, &service.Result{ResultType: &service.Result_Response{Response: &service.Response{
+		ResponseType: &service.Response_SyncResponse{SyncResponse: &service.SyncResponse{
+			Url: "https://wandb.ai/entity/project/runs/abc123",
+		}},
+	}}})
+	// the stream is closed once the run is synced
+	assert.NotNil(t, (<-requests).GetInformFinish())
+	result := <-done
+	assert.NoError(t, result.err)
+	assert.Equal(t, "https://wandb.ai/entity/project/runs/abc123", result.url)
+
+	go func() {
+		_, err := syncStream(context.Background(), run.conn, settings)
+		done <- synced{err: err}
+	}()
+	<-requests
+	record = (<-requests).GetRecordCommunicate()
+	respond(record, &service.Result{ResultType: &service.Result_Response{Response: &service.Response{
+		ResponseType: &service.Response_SyncResponse{SyncResponse: &service.SyncResponse{
+			Error: &service.ErrorInfo{Code: service.ErrorInfo_AUTHENTICATION, Message: "invalid api key"},
+		}},
+	}}})
+	<-requests
+	assert.ErrorIs(t, (<-done).err, ErrAuthentication)
+}
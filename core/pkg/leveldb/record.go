@@ -89,6 +89,7 @@ package leveldb
 import (
 	"encoding/binary"
 	"errors"
+	"fmt"
 	"io"
 )
 
@@ -112,6 +113,12 @@ var (
 
 	// ErrNoLastRecord is returned if LastRecordOffset is called and there is no previous record.
 	ErrNoLastRecord = errors.New("leveldb/record: no last record exists")
+
+	// ErrInvalidChunk is returned if a chunk header is zeroed or its length overflows the block.
+	ErrInvalidChunk = errors.New("leveldb/record: invalid chunk")
+
+	// ErrChecksumMismatch is returned if the checksum of a chunk does not match its payload.
+	ErrChecksumMismatch = errors.New("leveldb/record: invalid chunk (checksum mismatch)")
 )
 
 type flusher interface {
@@ -180,7 +187,7 @@ func (r *Reader) nextChunk(wantFirst bool) error {
 					r.Recover()
 					continue
 				}
-				return errors.New("leveldb/record: invalid chunk")
+				return ErrInvalidChunk
 			}
 
 			r.i = r.j + headerSize
@@ -190,14 +197,14 @@ func (r *Reader) nextChunk(wantFirst bool) error {
 					r.Recover()
 					continue
 				}
-				return errors.New("leveldb/record: invalid chunk (length overflows block)")
+				return fmt.Errorf("%w (length overflows block)", ErrInvalidChunk)
 			}
 			if checksum != r.crc(r.buf[r.i-1:r.j]) {
 				if r.recovering {
 					r.Recover()
 					continue
 				}
-				return errors.New("leveldb/record: invalid chunk (checksum mismatch)")
+				return ErrChecksumMismatch
 			}
 			if wantFirst {
 				if chunkType != fullChunkType && chunkType != firstChunkType {
@@ -305,6 +312,66 @@ func (r *Reader) SeekRecord(offset int64) error {
 	return nil
 }
 
+// LastValidOffset scans the records read from r and returns the offset just
+// past the end of the last complete record whose chunks all have valid
+// checksums. A corrupt chunk invalidates the record it belongs to and, like
+// the Reader when recovering, the rest of its block, so a corrupt block in
+// the middle of the log does not hide the valid records that follow it.
+func LastValidOffset(r io.Reader, algo CRCAlgo) (int64, error) {
+	crc := CRCCustom
+	if algo == CRCAlgoIEEE {
+		crc = CRCStandard
+	}
+	var buf [blockSize]byte
+	var offset, end int64
+	inRecord := false
+	for {
+		n, err := io.ReadFull(r, buf[:])
+		if err == io.EOF {
+			return end, nil
+		}
+		if err != nil && err != io.ErrUnexpectedEOF {
+			return 0, err
+		}
+	chunks:
+		for j := 0; j+headerSize <= n; {
+			checksum := binary.LittleEndian.Uint32(buf[j+0 : j+4])
+			length := binary.LittleEndian.Uint16(buf[j+4 : j+6])
+			chunkType := buf[j+6]
+			if checksum == 0 && length == 0 && chunkType == 0 {
+				// the rest of the block is zeroed
+				break
+			}
+			k := j + headerSize + int(length)
+			if k > n || checksum != crc(buf[j+6:k]) {
+				inRecord = false
+				break
+			}
+			switch chunkType {
+			case fullChunkType:
+				inRecord = false
+				end = offset + int64(k)
+			case firstChunkType:
+				inRecord = true
+			case middleChunkType:
+			case lastChunkType:
+				if inRecord {
+					end = offset + int64(k)
+				}
+				inRecord = false
+			default:
+				inRecord = false
+				break chunks
+			}
+			j = k
+		}
+		offset += int64(n)
+		if n < blockSize {
+			return end, nil
+		}
+	}
+}
+
 type singleReader struct {
 	r   *Reader
 	seq int
@@ -799,3 +799,38 @@ func TestNoLastRecordOffset(t *testing.T) {
 		t.Fatalf("LastRecordOffset: got %d, want 0", off)
 	}
 }
+
+func TestLastValidOffset(t *testing.T) {
+	recs, err := makeTestRecords(
+		blockSize*3,
+		3*(blockSize-headerSize)-2*blockSize-2*headerSize,
+		blockSize-headerSize,
+		blockSize-headerSize,
+		blockSize/2,
+	)
+	if err != nil {
+		t.Fatalf("makeTestRecords: %v", err)
+	}
+	n := int64(len(recs.buf))
+
+	if got, err := LastValidOffset(bytes.NewReader(recs.buf), CRCAlgoCustom); err != nil || got != n {
+		t.Fatalf("complete log: got %d, %v, want %d", got, err, n)
+	}
+
+	// A partially written last record is not valid.
+	truncated := recs.buf[:n-1]
+	if got, err := LastValidOffset(bytes.NewReader(truncated), CRCAlgoCustom); err != nil || got != recs.offsets[4] {
+		t.Fatalf("truncated log: got %d, %v, want %d", got, err, recs.offsets[4])
+	}
+
+	// A corrupt record in the middle does not hide the records after it.
+	corrupt := append([]byte(nil), recs.buf...)
+	corrupt[recs.offsets[1]+headerSize] ^= 0xff
+	if got, err := LastValidOffset(bytes.NewReader(corrupt), CRCAlgoCustom); err != nil || got != n {
+		t.Fatalf("corrupt log: got %d, %v, want %d", got, err, n)
+	}
+
+	if got, err := LastValidOffset(bytes.NewReader(nil), CRCAlgoCustom); err != nil || got != 0 {
+		t.Fatalf("empty log: got %d, %v, want 0", got, err)
+	}
+}
@@ -290,5 +290,15 @@ func (g *GPUNvidia) Probe() *service.MetadataRequest {
 
 	info.GpuType = "[" + strings.Join(names, ", ") + "]"
 
+	environment := &service.EnvironmentRecord{}
+	if version, ret := nvml.SystemGetDriverVersion(); ret == nvml.SUCCESS {
+		environment.NvidiaDriverVersion = version
+	}
+	// the CUDA version is encoded as 1000 * major + 10 * minor
+	if version, ret := nvml.SystemGetCudaDriverVersion(); ret == nvml.SUCCESS {
+		environment.CudaVersion = fmt.Sprintf("%d.%d", version/1000, version%1000/10)
+	}
+	info.Environment = environment
+
 	return &info
 }
@@ -0,0 +1,51 @@
+package observability
+
+import (
+	"context"
+	"net/http"
+	"time"
+)
+
+// RequestTracer observes the requests made to the backend, for example to
+// record them as OpenTelemetry spans or to log them.
+//
+// The hooks are called for each attempt of a request, from the goroutine
+// that makes it, so they must be safe for concurrent use and return quickly.
+type RequestTracer interface {
+	// OnRequestStart is called before an attempt is sent. The returned
+	// context is the context of the attempt, it is passed to OnRequestEnd.
+	OnRequestStart(ctx context.Context, request *RequestInfo) context.Context
+
+	// OnRequestEnd is called once the response headers of the attempt are
+	// received, or once it failed.
+	OnRequestEnd(ctx context.Context, request *RequestInfo, result *RequestResult)
+}
+
+// RequestInfo describes an attempt of a request to the backend.
+type RequestInfo struct {
+	Method string
+	URL    string
+
+	// Header is the header of the request, the tracer can add to it, for
+	// example to propagate a trace context
+	Header http.Header
+
+	// Size is the size of the request body, -1 if it is unknown
+	Size int64
+}
+
+// RequestResult is the outcome of an attempt of a request to the backend.
+type RequestResult struct {
+	// StatusCode is the status code of the response, zero if there is none
+	StatusCode int
+
+	// Size is the size of the response body, -1 if it is unknown
+	Size int64
+
+	// Duration is the time until the response headers were received, or
+	// until the attempt failed
+	Duration time.Duration
+
+	// Err is the error of the attempt, if it got no response
+	Err error
+}
@@ -0,0 +1,68 @@
+package server
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/wandb/wandb/core/internal/gql"
+	"github.com/wandb/wandb/core/pkg/service"
+)
+
+// alertSeverity returns the severity of an alert level, INFO if the level is
+// not set. It returns false if the level is unknown.
+func alertSeverity(level string) (gql.AlertSeverity, bool) {
+	switch severity := gql.AlertSeverity(strings.ToUpper(level)); severity {
+	case "":
+		return gql.AlertSeverityInfo, true
+	case gql.AlertSeverityInfo, gql.AlertSeverityWarn, gql.AlertSeverityError:
+		return severity, true
+	default:
+		return "", false
+	}
+}
+
+// handleAlert validates an alert and sends it, unless an alert with the same
+// title was sent within the wait duration of that alert
+func (h *Handler) handleAlert(record *service.Record, alert *service.AlertRecord) {
+	if err := validateAlert(alert); err != nil {
+		h.logger.CaptureWarn("handler: dropping an invalid alert", "error", err)
+		h.internalMessages.Warning = append(h.internalMessages.Warning,
+			fmt.Sprintf("The alert was not sent: %v.", err))
+		return
+	}
+	severity, _ := alertSeverity(alert.GetLevel())
+	alert.Level = string(severity)
+
+	if !h.dedupeAlert(alert, time.Now()) {
+		h.logger.Debug("handler: dropping a repeated alert", "title", alert.GetTitle())
+		return
+	}
+	h.sendRecord(record)
+}
+
+func validateAlert(alert *service.AlertRecord) error {
+	switch {
+	case alert.GetTitle() == "":
+		return fmt.Errorf("an alert needs a title")
+	case alert.GetWaitDuration() < 0:
+		return fmt.Errorf("the alert %q has a negative wait duration", alert.GetTitle())
+	}
+	if _, ok := alertSeverity(alert.GetLevel()); !ok {
+		return fmt.Errorf("the alert %q has an unknown level %q", alert.GetTitle(), alert.GetLevel())
+	}
+	return nil
+}
+
+// dedupeAlert returns false if an alert with the same title was sent less
+// than its wait duration, in seconds, before now
+func (h *Handler) dedupeAlert(alert *service.AlertRecord, now time.Time) bool {
+	if h.alertsWait == nil {
+		h.alertsWait = make(map[string]time.Time)
+	}
+	if until, ok := h.alertsWait[alert.GetTitle()]; ok && now.Before(until) {
+		return false
+	}
+	h.alertsWait[alert.GetTitle()] = now.Add(time.Duration(alert.GetWaitDuration()) * time.Second)
+	return true
+}
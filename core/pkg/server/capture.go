@@ -0,0 +1,88 @@
+package server
+
+import (
+	"context"
+	"os"
+	"sync"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+
+	"github.com/wandb/wandb/core/pkg/observability"
+	"github.com/wandb/wandb/core/pkg/service"
+)
+
+// Capture records the records received by the handler of a stream, in the
+// order it receives them and with the time it does, to a store that can be
+// replayed with ReplayCapture to reproduce an ordering bug. It is enabled by
+// setting _capture_path.
+type Capture struct {
+	mu     sync.Mutex
+	store  *Store
+	start  time.Time
+	logger *observability.CoreLogger
+}
+
+// NewCapture creates a capture to the store fileName
+func NewCapture(ctx context.Context, fileName string, logger *observability.CoreLogger) (*Capture, error) {
+	store := NewStore(ctx, fileName, logger)
+	if err := store.Open(os.O_WRONLY); err != nil {
+		return nil, err
+	}
+	return &Capture{store: store, start: time.Now(), logger: logger}, nil
+}
+
+// newCapture creates the capture set by the settings of a stream, nil if
+// there is none
+func newCapture(ctx context.Context, settings *service.Settings, logger *observability.CoreLogger) (*Capture, error) {
+	fileName := settings.GetXCapturePath().GetValue()
+	if fileName == "" {
+		return nil, nil
+	}
+	return NewCapture(ctx, fileName, logger)
+}
+
+// Forward captures a record and sends it to the handler. The records of the
+// client and those the stream sends to itself are forwarded concurrently,
+// the capture is in the order they are sent.
+func (c *Capture) Forward(record *service.Record, loopback bool, fwdChan chan<- *service.Record) {
+	if c == nil {
+		fwdChan <- record
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.store != nil {
+		captured := proto.Clone(record).(*service.Record)
+		if captured.Control == nil {
+			captured.Control = &service.Control{}
+		}
+		captured.Control.CaptureMicros = time.Since(c.start).Microseconds()
+		captured.Control.Loopback = loopback
+		if err := c.store.Write(captured); err != nil {
+			c.logger.CaptureError("capture: stopped capturing the records", err)
+			c.close()
+		}
+	}
+	fwdChan <- record
+}
+
+// Close closes the store of the capture
+func (c *Capture) Close() {
+	if c == nil {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.close()
+}
+
+func (c *Capture) close() {
+	if c.store == nil {
+		return
+	}
+	if err := c.store.Close(); err != nil {
+		c.logger.CaptureError("capture: failed to close the store", err)
+	}
+	c.store = nil
+}
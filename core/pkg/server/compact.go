@@ -0,0 +1,356 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"io"
+	"os"
+
+	"google.golang.org/protobuf/proto"
+
+	"github.com/wandb/wandb/core/pkg/observability"
+	"github.com/wandb/wandb/core/pkg/service"
+)
+
+// CompactStats are the counts of the records of a compacted store
+type CompactStats struct {
+	// RecordsRead is the number of records in the original store
+	RecordsRead int64
+	// RecordsWritten is the number of records in the compacted store
+	RecordsWritten int64
+}
+
+// CompactStore rewrites the store whose first segment is fileName to a single
+// segment named outputName, dropping the records that are superseded by later
+// ones: all config records are merged into the last one, and so are all
+// summary records, and console lines that repeat the previous console line
+// are dropped. The remaining records keep their numbers, compression and
+// encryption.
+//
+// If outputName is empty or fileName, the store is compacted in place: the
+// compacted store replaces the first segment and the other segments are
+// removed.
+func CompactStore(
+	ctx context.Context,
+	fileName string,
+	outputName string,
+	logger *observability.CoreLogger,
+	opts StoreOptions,
+) (CompactStats, error) {
+	inPlace := outputName == "" || outputName == fileName
+	if inPlace {
+		outputName = fileName + ".compact"
+	}
+
+	// the first pass merges the config and summary records
+	compactor := newStoreCompactor()
+	header, err := compactor.scan(ctx, fileName, logger, opts)
+	if err != nil {
+		return CompactStats{}, err
+	}
+
+	_, err = os.Stat(storeIndexName(fileName))
+	outputOpts := StoreOptions{
+		Compression: header.Compression,
+		Index:       err == nil,
+		EmbedSchema: header.Schema != nil,
+		Metadata:    header.Metadata,
+	}
+	if header.Encryption != EncryptionNone {
+		outputOpts.EncryptionKey = opts.EncryptionKey
+	}
+	stats, err := compactor.write(ctx, fileName, outputName, logger, opts, outputOpts)
+	if err != nil {
+		_ = os.Remove(outputName)
+		_ = os.Remove(storeIndexName(outputName))
+		return stats, err
+	}
+	if inPlace {
+		return stats, replaceStore(fileName, outputName, outputOpts.Index)
+	}
+	return stats, nil
+}
+
+// replaceStore replaces the store whose first segment is fileName with the
+// single segment store compacted to outputName
+func replaceStore(fileName, outputName string, index bool) error {
+	segments, err := LoadSegmentIndex(fileName)
+	if err != nil {
+		return err
+	}
+	if index {
+		if err := os.Rename(storeIndexName(outputName), storeIndexName(fileName)); err != nil {
+			return err
+		}
+	}
+	if err := os.Rename(outputName, fileName); err != nil {
+		return err
+	}
+	if err := os.Remove(segmentIndexName(fileName)); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	if err := removeShards(fileName); err != nil {
+		return err
+	}
+	paths := segments.Paths(fileName)
+	if len(paths) == 0 {
+		return nil
+	}
+	var errs []error
+	for _, path := range paths[1:] {
+		for _, name := range []string{path, storeIndexName(path)} {
+			if err := os.Remove(name); err != nil && !os.IsNotExist(err) {
+				errs = append(errs, err)
+			}
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// storeCompactor drops the superseded records of a store
+type storeCompactor struct {
+	config  mergedItems[*service.ConfigItem]
+	summary mergedItems[*service.SummaryItem]
+
+	// lastConfig and lastSummary are the numbers of the last config and
+	// summary records, the merged records are written in their place
+	lastConfig  int64
+	lastSummary int64
+
+	// lastSnapshot is the number of the last snapshot record, the earlier
+	// snapshots are superseded by it
+	lastSnapshot int64
+
+	// lastLine is the previous console line written
+	lastLine proto.Message
+}
+
+func newStoreCompactor() *storeCompactor {
+	return &storeCompactor{}
+}
+
+// scan merges the config and summary records of the store and returns the
+// header of its first segment
+func (c *storeCompactor) scan(
+	ctx context.Context,
+	fileName string,
+	logger *observability.CoreLogger,
+	opts StoreOptions,
+) (HeaderOptions, error) {
+	reader := NewSegmentReaderWithOptions(ctx, fileName, logger, opts)
+	if err := reader.Open(); err != nil {
+		return HeaderOptions{}, err
+	}
+	defer reader.Close()
+	header := reader.Header()
+
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			return header, nil
+		} else if err != nil {
+			return header, err
+		}
+		switch x := record.RecordType.(type) {
+		case *service.Record_Config:
+			c.config.apply(x.Config.GetUpdate(), x.Config.GetRemove())
+			c.lastConfig = record.Num
+		case *service.Record_Summary:
+			c.summary.apply(x.Summary.GetUpdate(), x.Summary.GetRemove())
+			c.lastSummary = record.Num
+		case *service.Record_Snapshot:
+			c.lastSnapshot = record.Num
+		}
+	}
+}
+
+// write copies the records of the store that are not superseded to a new
+// store at outputName
+func (c *storeCompactor) write(
+	ctx context.Context,
+	fileName string,
+	outputName string,
+	logger *observability.CoreLogger,
+	opts StoreOptions,
+	outputOpts StoreOptions,
+) (CompactStats, error) {
+	stats := CompactStats{}
+	reader := NewSegmentReaderWithOptions(ctx, fileName, logger, opts)
+	if err := reader.Open(); err != nil {
+		return stats, err
+	}
+	defer reader.Close()
+
+	output := NewStoreWithOptions(ctx, outputName, logger, outputOpts)
+	if err := output.Open(os.O_WRONLY); err != nil {
+		return stats, err
+	}
+
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			_ = output.Close()
+			return stats, err
+		}
+		stats.RecordsRead++
+		if record = c.compact(record); record == nil {
+			continue
+		}
+		if err := output.Write(record); err != nil {
+			_ = output.Close()
+			return stats, err
+		}
+		stats.RecordsWritten++
+	}
+	if err := output.Sync(); err != nil {
+		_ = output.Close()
+		return stats, err
+	}
+	return stats, output.Close()
+}
+
+// compact returns the record to write in place of the given record, or nil
+// if the record is dropped
+func (c *storeCompactor) compact(record *service.Record) *service.Record {
+	switch x := record.RecordType.(type) {
+	case *service.Record_Config:
+		if record.Num != c.lastConfig {
+			return nil
+		}
+		update, remove := c.config.result()
+		record.RecordType = &service.Record_Config{
+			Config: &service.ConfigRecord{Update: update, Remove: remove, XInfo: x.Config.GetXInfo()},
+		}
+	case *service.Record_Summary:
+		if record.Num != c.lastSummary {
+			return nil
+		}
+		update, remove := c.summary.result()
+		record.RecordType = &service.Record_Summary{
+			Summary: &service.SummaryRecord{Update: update, Remove: remove, XInfo: x.Summary.GetXInfo()},
+		}
+	case *service.Record_Snapshot:
+		if record.Num != c.lastSnapshot {
+			return nil
+		}
+	case *service.Record_Output:
+		line := &service.OutputRecord{OutputType: x.Output.GetOutputType(), Line: x.Output.GetLine()}
+		if c.isRepeatedLine(line) {
+			return nil
+		}
+	case *service.Record_OutputRaw:
+		line := &service.OutputRawRecord{OutputType: x.OutputRaw.GetOutputType(), Line: x.OutputRaw.GetLine()}
+		if c.isRepeatedLine(line) {
+			return nil
+		}
+	}
+	return record
+}
+
+// isRepeatedLine reports whether the console line, without its timestamp,
+// is the same as the previous one
+func (c *storeCompactor) isRepeatedLine(line proto.Message) bool {
+	repeated := c.lastLine != nil && proto.Equal(c.lastLine, line)
+	c.lastLine = line
+	return repeated
+}
+
+// keyedItem is a config or summary item
+type keyedItem interface {
+	GetKey() string
+	GetNestedKey() []string
+}
+
+// itemPath returns the path of the value of an item
+func itemPath(item keyedItem) []string {
+	if len(item.GetNestedKey()) > 0 {
+		return item.GetNestedKey()
+	}
+	return []string{item.GetKey()}
+}
+
+// hasPrefix reports whether path starts with prefix
+func hasPrefix(path, prefix []string) bool {
+	if len(prefix) > len(path) {
+		return false
+	}
+	for i := range prefix {
+		if path[i] != prefix[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// mergedItems merges the updates and removes of a sequence of config or
+// summary records into a single update and remove
+type mergedItems[T keyedItem] struct {
+	// items are the last update or remove of each path, in the order the
+	// paths were first seen
+	items []mergedItem[T]
+}
+
+type mergedItem[T keyedItem] struct {
+	path    []string
+	item    T
+	removed bool
+}
+
+// apply merges the updates and then the removes of a record
+func (m *mergedItems[T]) apply(update, remove []T) {
+	for _, item := range update {
+		m.set(mergedItem[T]{path: itemPath(item), item: item})
+	}
+	for _, item := range remove {
+		path := itemPath(item)
+		// removing a value also removes the values nested in it
+		kept := m.items[:0]
+		for _, merged := range m.items {
+			if merged.removed || !hasPrefix(merged.path, path) || len(merged.path) == len(path) {
+				kept = append(kept, merged)
+			}
+		}
+		m.items = kept
+		m.set(mergedItem[T]{path: path, item: item, removed: true})
+	}
+}
+
+// set replaces the item with the same path, or adds it
+func (m *mergedItems[T]) set(item mergedItem[T]) {
+	for i, merged := range m.items {
+		if len(merged.path) == len(item.path) && hasPrefix(merged.path, item.path) {
+			m.items[i] = item
+			return
+		}
+	}
+	m.items = append(m.items, item)
+}
+
+// result returns the merged updates and removes. A remove is dropped if a
+// value nested in the removed one is updated later, as removes are applied
+// after updates.
+func (m *mergedItems[T]) result() (update, remove []T) {
+	for _, merged := range m.items {
+		if !merged.removed {
+			update = append(update, merged.item)
+		}
+	}
+	for _, merged := range m.items {
+		if !merged.removed {
+			continue
+		}
+		shadowed := false
+		for _, other := range m.items {
+			if !other.removed && hasPrefix(other.path, merged.path) {
+				shadowed = true
+				break
+			}
+		}
+		if !shadowed {
+			remove = append(remove, merged.item)
+		}
+	}
+	return update, remove
+}
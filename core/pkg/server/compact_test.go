@@ -0,0 +1,109 @@
+package server_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	"github.com/wandb/wandb/core/pkg/observability"
+	"github.com/wandb/wandb/core/pkg/server"
+	"github.com/wandb/wandb/core/pkg/service"
+)
+
+func configRecord(update []string, remove ...string) *service.Record {
+	config := &service.ConfigRecord{}
+	for _, key := range update {
+		config.Update = append(config.Update, &service.ConfigItem{Key: key, ValueJson: `"` + key + `"`})
+	}
+	for _, key := range remove {
+		config.Remove = append(config.Remove, &service.ConfigItem{Key: key})
+	}
+	return &service.Record{RecordType: &service.Record_Config{Config: config}}
+}
+
+func summaryRecord(key, value string) *service.Record {
+	return &service.Record{RecordType: &service.Record_Summary{
+		Summary: &service.SummaryRecord{Update: []*service.SummaryItem{{Key: key, ValueJson: value}}},
+	}}
+}
+
+func outputRecord(line string) *service.Record {
+	return &service.Record{RecordType: &service.Record_OutputRaw{
+		OutputRaw: &service.OutputRawRecord{Line: line},
+	}}
+}
+
+func compactRecords() []*service.Record {
+	return []*service.Record{
+		{RecordType: &service.Record_Run{Run: &service.RunRecord{}}},
+		configRecord([]string{"a", "b"}),
+		summaryRecord("loss", "1"),
+		outputRecord("epoch 1"),
+		outputRecord("epoch 1"),
+		configRecord([]string{"c"}, "a"),
+		summaryRecord("loss", "0.5"),
+		outputRecord("epoch 2"),
+		outputRecord("epoch 1"),
+		{RecordType: &service.Record_Exit{Exit: &service.RunExitRecord{}}},
+	}
+}
+
+func TestCompactStore(t *testing.T) {
+	dir := t.TempDir()
+	fileName := filepath.Join(dir, "run.wandb")
+	outputName := filepath.Join(dir, "compact.wandb")
+	settings := &service.Settings{SyncFile: &wrapperspb.StringValue{Value: fileName}}
+	runWriter(t, compactRecords(), server.WithWriterSettings(settings))
+
+	stats, err := server.CompactStore(context.Background(), fileName, outputName,
+		observability.NewNoOpLogger(), server.StoreOptions{},
+	)
+	assert.NoError(t, err)
+	assert.Equal(t, server.CompactStats{RecordsRead: 10, RecordsWritten: 7}, stats)
+	assert.Len(t, readStore(t, fileName), 10)
+
+	records := readStore(t, outputName)
+	var nums []int64
+	for _, record := range records {
+		nums = append(nums, record.Num)
+	}
+	// the merged records take the place of the last config and summary
+	assert.Equal(t, []int64{1, 4, 6, 7, 8, 9, 10}, nums)
+
+	config := records[2].GetConfig()
+	assert.Len(t, config.GetUpdate(), 2)
+	assert.Equal(t, "b", config.GetUpdate()[0].GetKey())
+	assert.Equal(t, "c", config.GetUpdate()[1].GetKey())
+	assert.Len(t, config.GetRemove(), 1)
+	assert.Equal(t, "a", config.GetRemove()[0].GetKey())
+
+	summary := records[3].GetSummary()
+	assert.Len(t, summary.GetUpdate(), 1)
+	assert.Equal(t, "0.5", summary.GetUpdate()[0].GetValueJson())
+}
+
+func TestCompactStoreInPlace(t *testing.T) {
+	fileName := filepath.Join(t.TempDir(), "run.wandb")
+	settings := &service.Settings{SyncFile: &wrapperspb.StringValue{Value: fileName}}
+	runWriter(t, compactRecords(),
+		server.WithWriterSettings(settings),
+		server.WithWriterMaxSegmentBytes(20),
+	)
+	_, err := os.Stat(fileName + ".1")
+	assert.NoError(t, err)
+
+	stats, err := server.CompactStore(context.Background(), fileName, "",
+		observability.NewNoOpLogger(), server.StoreOptions{},
+	)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(7), stats.RecordsWritten)
+
+	// the compacted store has a single segment
+	_, err = os.Stat(fileName + ".1")
+	assert.True(t, os.IsNotExist(err))
+	assert.Len(t, readSegments(t, fileName), 7)
+}
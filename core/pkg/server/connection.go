@@ -9,6 +9,7 @@ import (
 	"log/slog"
 	"net"
 	"net/url"
+	"strconv"
 	"sync"
 
 	"github.com/wandb/wandb/core/pkg/observability"
@@ -51,6 +52,14 @@ type Connection struct {
 	// stream is the stream for the connection, each connection has a single stream
 	// however, a stream can have multiple connections
 	stream *Stream
+
+	// streams are the streams of the runs that share the connection, by
+	// their id, the requests of a run go to the stream of its id
+	streams map[string]*Stream
+
+	// rank is the rank of the process among the writers of a shared run,
+	// empty if it did not set one
+	rank string
 }
 
 // NewConnection creates a new connection
@@ -67,10 +76,20 @@ func NewConnection(
 		inChan:       make(chan *service.ServerRequest, BufferSize),
 		outChan:      make(chan *service.ServerResponse, BufferSize),
 		teardownChan: teardown, // TODO: should we trigger teardown from a connection?
+		streams:      make(map[string]*Stream),
 	}
 	return nc
 }
 
+// streamFor returns the stream of the stream id, the stream of the
+// connection if the id is not one of the streams sharing the connection
+func (nc *Connection) streamFor(streamId string) *Stream {
+	if stream, ok := nc.streams[streamId]; ok {
+		return stream
+	}
+	return nc.stream
+}
+
 // HandleConnection handles the connection by reading from the connection
 // and passing the messages to the stream
 // and writing messages from the stream to the connection
@@ -217,6 +236,14 @@ func (nc *Connection) handleServerRequest() {
 			nc.handleInformFinish(x.InformFinish)
 		case *service.ServerRequest_InformTeardown:
 			nc.handleInformTeardown(x.InformTeardown)
+		case *service.ServerRequest_CompactStore:
+			nc.handleCompactStore(x.CompactStore)
+		case *service.ServerRequest_ExportStore:
+			nc.handleExportStore(x.ExportStore)
+		case *service.ServerRequest_ImportStore:
+			nc.handleImportStore(x.ImportStore)
+		case *service.ServerRequest_VerifyStore:
+			nc.handleVerifyStore(x.VerifyStore)
 		case nil:
 			slog.Error("ServerRequestType is nil", "id", nc.id)
 			panic("ServerRequestType is nil")
@@ -229,6 +256,16 @@ func (nc *Connection) handleServerRequest() {
 	slog.Debug("finished handleServerRequest", "id", nc.id)
 }
 
+// netrcApiKey returns the API key of the host of baseUrl in the netrc file
+func netrcApiKey(baseUrl string) (string, error) {
+	u, err := url.Parse(baseUrl)
+	if err != nil {
+		return "", fmt.Errorf("error parsing url %q: %w", baseUrl, err)
+	}
+	_, password, err := auth.GetNetrcLogin(u.Hostname())
+	return password, err
+}
+
 // handleInformInit is called when the client sends an InformInit message
 // to the server, to start a new stream
 func (nc *Connection) handleInformInit(msg *service.ServerInformInitRequest) {
@@ -240,14 +277,7 @@ func (nc *Connection) handleInformInit(msg *service.ServerInformInitRequest) {
 		if s.GetXOffline().GetValue() {
 			return
 		}
-		baseUrl := s.GetBaseUrl().GetValue()
-		u, err := url.Parse(baseUrl)
-		if err != nil {
-			slog.Error("error parsing url", "err", err, "url", baseUrl)
-			panic(err)
-		}
-		host := u.Hostname()
-		_, password, err := auth.GetNetrcLogin(host)
+		password, err := netrcApiKey(s.GetBaseUrl().GetValue())
 		if err != nil {
 			slog.Error("error getting password from netrc", "err", err, "id", nc.id)
 			panic(err)
@@ -257,11 +287,26 @@ func (nc *Connection) handleInformInit(msg *service.ServerInformInitRequest) {
 
 	streamId := msg.GetXInfo().GetStreamId()
 	slog.Info("connection init received", "streamId", streamId, "id", nc.id)
+
+	// the processes of a shared run log to the stream of the first one
+	if settings.GetXShared().GetValue() {
+		if rank := settings.GetXRank(); rank != nil {
+			nc.rank = strconv.Itoa(int(rank.GetValue()))
+		}
+		if stream, err := streamMux.GetStream(streamId); err == nil {
+			slog.Info("connection init attached to the shared run", "streamId", streamId, "id", nc.id, "rank", nc.rank)
+			nc.stream = stream
+			nc.stream.AddResponders(ResponderEntry{nc, nc.id})
+			nc.streams[streamId] = stream
+			return
+		}
+	}
 	// TODO: redo this function, to only init the stream and have the stream
 	//       handle the rest of the startup
 	nc.stream = NewStream(nc.ctx, settings, streamId)
 	nc.stream.AddResponders(ResponderEntry{nc, nc.id})
 	nc.stream.Start()
+	nc.streams[streamId] = nc.stream
 
 	if err := streamMux.AddStream(streamId, nc.stream); err != nil {
 		slog.Error("connection init failed, stream already exists", "streamId", streamId, "id", nc.id)
@@ -276,15 +321,20 @@ func (nc *Connection) handleInformInit(msg *service.ServerInformInitRequest) {
 func (nc *Connection) handleInformStart(msg *service.ServerInformStartRequest) {
 	// todo: if we keep this and end up updating the settings here
 	//       we should update the stream logger to use the new settings as well
-	nc.stream.settings = msg.GetSettings()
+	stream := nc.streamFor(msg.GetXInfo().GetStreamId())
+	if stream == nil {
+		slog.Error("handleInformStart: stream not found", "streamId", msg.GetXInfo().GetStreamId(), "id", nc.id)
+		return
+	}
+	stream.settings = msg.GetSettings()
 	// update sentry tags
 	// add attrs from settings:
-	nc.stream.logger.SetTags(observability.Tags{
-		"run_url": nc.stream.settings.GetRunUrl().GetValue(),
-		"entity":  nc.stream.settings.GetEntity().GetValue(),
+	stream.logger.SetTags(observability.Tags{
+		"run_url": stream.settings.GetRunUrl().GetValue(),
+		"entity":  stream.settings.GetEntity().GetValue(),
 	})
 	// TODO: remove this once we have a better observability setup
-	nc.stream.logger.CaptureInfo("core", nil)
+	stream.logger.CaptureInfo("core", nil)
 }
 
 // handleInformAttach is called when the client sends an InformAttach message
@@ -300,6 +350,7 @@ func (nc *Connection) handleInformAttach(msg *service.ServerInformAttachRequest)
 		slog.Error("handleInformAttach: stream not found", "streamId", streamId, "id", nc.id)
 	} else {
 		nc.stream.AddResponders(ResponderEntry{nc, nc.id})
+		nc.streams[streamId] = nc.stream
 		// TODO: we should redo this attach logic, so that the stream handles
 		//       the attach logic
 		resp := &service.ServerResponse{
@@ -321,7 +372,8 @@ func (nc *Connection) handleInformAttach(msg *service.ServerInformAttachRequest)
 func (nc *Connection) handleInformRecord(msg *service.Record) {
 	streamId := msg.GetXInfo().GetStreamId()
 	slog.Debug("handle record received", "streamId", streamId, "id", nc.id)
-	if nc.stream == nil {
+	stream := nc.streamFor(streamId)
+	if stream == nil {
 		slog.Error("handleInformRecord: stream not found", "streamId", streamId, "id", nc.id)
 	} else {
 		// add connection id to control message
@@ -332,7 +384,8 @@ func (nc *Connection) handleInformRecord(msg *service.Record) {
 		} else {
 			msg.Control = &service.Control{ConnectionId: nc.id}
 		}
-		nc.stream.HandleRecord(msg)
+		msg.Control.Rank = nc.rank
+		stream.HandleRecord(msg)
 	}
 }
 
@@ -341,6 +394,13 @@ func (nc *Connection) handleInformRecord(msg *service.Record) {
 func (nc *Connection) handleInformFinish(msg *service.ServerInformFinishRequest) {
 	streamId := msg.XInfo.StreamId
 	slog.Info("handle finish received", "streamId", streamId, "id", nc.id)
+	stream := nc.streamFor(streamId)
+	delete(nc.streams, streamId)
+	// the other writers of a shared run are still logging to it
+	if stream != nil && stream.Detach(nc.id) {
+		slog.Info("handleInformFinish: detached from the shared run", "streamId", streamId, "id", nc.id)
+		return
+	}
 	if stream, err := streamMux.RemoveStream(streamId); err != nil {
 		slog.Error("handleInformFinish:", "err", err, "streamId", streamId, "id", nc.id)
 	} else {
@@ -356,3 +416,138 @@ func (nc *Connection) handleInformTeardown(teardown *service.ServerInformTeardow
 	close(nc.teardownChan)
 	streamMux.FinishAndCloseAllStreams(teardown.ExitCode)
 }
+
+// handleCompactStore is called when the client sends a CompactStore message
+// to rewrite a .wandb file without its superseded records, it is not tied to
+// a stream
+func (nc *Connection) handleCompactStore(msg *service.ServerCompactStoreRequest) {
+	slog.Debug("handle compact store received", "path", msg.GetPath(), "id", nc.id)
+	response := &service.ServerCompactStoreResponse{XInfo: msg.XInfo}
+	key, err := StoreEncryptionKey(nil)
+	if err == nil {
+		var stats CompactStats
+		stats, err = CompactStore(nc.ctx, msg.GetPath(), msg.GetOutputPath(),
+			observability.NewCoreLogger(slog.Default()),
+			StoreOptions{EncryptionKey: key},
+		)
+		response.RecordsRead = stats.RecordsRead
+		response.RecordsWritten = stats.RecordsWritten
+	}
+	if err != nil {
+		slog.Error("error compacting store", "err", err, "path", msg.GetPath(), "id", nc.id)
+		response.Error = &service.ErrorInfo{
+			Message: err.Error(),
+			Code:    service.ErrorInfo_UNKNOWN,
+		}
+	}
+	nc.Respond(&service.ServerResponse{
+		ServerResponseType: &service.ServerResponse_CompactStoreResponse{
+			CompactStoreResponse: response,
+		},
+	})
+}
+
+// handleExportStore is called when the client sends an ExportStore message
+// to write the records of a .wandb file to files readable by other tools, it
+// is not tied to a stream
+func (nc *Connection) handleExportStore(msg *service.ServerExportStoreRequest) {
+	slog.Debug("handle export store received", "path", msg.GetPath(), "id", nc.id)
+	response := &service.ServerExportStoreResponse{XInfo: msg.XInfo}
+	format, err := ParseExportFormat(msg.GetFormat())
+	var key []byte
+	if err == nil {
+		key, err = StoreEncryptionKey(nil)
+	}
+	if err == nil {
+		var stats ExportStats
+		stats, err = ExportStore(nc.ctx, msg.GetPath(), msg.GetOutputDir(), format,
+			observability.NewCoreLogger(slog.Default()),
+			StoreOptions{EncryptionKey: key},
+		)
+		response.HistoryRows = stats.History
+		response.ConfigRows = stats.Config
+		response.SummaryRows = stats.Summary
+		response.ConsoleRows = stats.Console
+	}
+	if err != nil {
+		slog.Error("error exporting store", "err", err, "path", msg.GetPath(), "id", nc.id)
+		response.Error = &service.ErrorInfo{
+			Message: err.Error(),
+			Code:    service.ErrorInfo_UNKNOWN,
+		}
+	}
+	nc.Respond(&service.ServerResponse{
+		ServerResponseType: &service.ServerResponse_ExportStoreResponse{
+			ExportStoreResponse: response,
+		},
+	})
+}
+
+// handleImportStore is called when the client sends an ImportStore message
+// to write a .wandb file with the metrics of external logs, it is not tied to
+// a stream
+func (nc *Connection) handleImportStore(msg *service.ServerImportStoreRequest) {
+	slog.Debug("handle import store received", "path", msg.GetPath(), "id", nc.id)
+	response := &service.ServerImportStoreResponse{XInfo: msg.XInfo}
+	format, err := ParseImportFormat(msg.GetFormat())
+	var key []byte
+	if err == nil {
+		key, err = StoreEncryptionKey(nil)
+	}
+	if err == nil {
+		var stats ImportStats
+		stats, err = ImportStore(nc.ctx, msg.GetPath(), msg.GetOutputPath(), format,
+			observability.NewCoreLogger(slog.Default()),
+			ImportOptions{
+				RunID:   msg.GetRunId(),
+				Project: msg.GetProject(),
+				Entity:  msg.GetEntity(),
+				Store:   StoreOptions{EncryptionKey: key},
+			},
+		)
+		response.RecordsWritten = stats.RecordsWritten
+		response.HistoryRows = stats.HistoryRows
+		response.Skipped = stats.Skipped
+	}
+	if err != nil {
+		slog.Error("error importing store", "err", err, "path", msg.GetPath(), "id", nc.id)
+		response.Error = &service.ErrorInfo{
+			Message: err.Error(),
+			Code:    service.ErrorInfo_UNKNOWN,
+		}
+	}
+	nc.Respond(&service.ServerResponse{
+		ServerResponseType: &service.ServerResponse_ImportStoreResponse{
+			ImportStoreResponse: response,
+		},
+	})
+}
+
+// handleVerifyStore is called when the client sends a VerifyStore message to
+// check the integrity of a .wandb file, it is not tied to a stream
+func (nc *Connection) handleVerifyStore(msg *service.ServerVerifyStoreRequest) {
+	slog.Debug("handle verify store received", "path", msg.GetPath(), "id", nc.id)
+	response := &service.ServerVerifyStoreResponse{XInfo: msg.XInfo}
+	key, err := StoreEncryptionKey(nil)
+	if err == nil {
+		response.Report, err = VerifyStore(nc.ctx, msg.GetPath(),
+			observability.NewCoreLogger(slog.Default()),
+			StoreOptions{EncryptionKey: key},
+		)
+	}
+	if err == nil {
+		err = verifyError(response.Report)
+	}
+	if err != nil {
+		slog.Error("error verifying store", "err", err, "path", msg.GetPath(), "id", nc.id)
+		response.Error = &service.ErrorInfo{
+			Message: err.Error(),
+			Code:    service.ErrorInfo_UNKNOWN,
+		}
+	}
+	nc.Respond(&service.ServerResponse{
+		ServerResponseType: &service.ServerResponse_VerifyStoreResponse{
+			VerifyStoreResponse: response,
+		},
+	})
+}
@@ -0,0 +1,272 @@
+package server
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/proto"
+
+	"github.com/wandb/wandb/core/internal/clients"
+	"github.com/wandb/wandb/core/pkg/service"
+)
+
+// The handling of the ANSI escape codes of the console lines, set with
+// _console_ansi
+const (
+	// ConsoleANSIKeep keeps the escape codes, so that the UI renders the
+	// colors, the default
+	ConsoleANSIKeep = "keep"
+
+	// ConsoleANSIStrip removes the escape codes from the lines
+	ConsoleANSIStrip = "strip"
+)
+
+const (
+	// defaultConsoleMaxLineBytes is the size of the chunks of the lines
+	// longer than _console_max_line_bytes when it is not set
+	defaultConsoleMaxLineBytes = 60_000
+
+	// defaultConsoleReorderDelay is how long the lines are held to order the
+	// stdout and stderr streams when _console_reorder_seconds is not set
+	defaultConsoleReorderDelay = 100 * time.Millisecond
+
+	// consoleMaxPending is the number of lines held before they are sent,
+	// even if the delay is not over
+	consoleMaxPending = 1024
+)
+
+// ansiEscape matches the CSI and OSC sequences and the two byte escapes
+var ansiEscape = regexp.MustCompile(`\x1b(?:\[[0-?]*[ -/]*[@-~]|\][^\x07\x1b]*(?:\x07|\x1b\\)|[@-Z\\-_])`)
+
+// Console processes the console lines of a run before they are forwarded. It
+// holds the lines for a short delay to send the stdout and stderr lines, of
+// all the writers of a shared run, in the order of their timestamps, strips their escape codes if asked to, chunks the
+// lines that are too long and collapses the identical lines repeated in a row
+// of a stream into a marker with their count.
+type Console struct {
+	ansi         string
+	maxLineBytes int
+	delay        time.Duration
+
+	// pending are the lines held to be ordered
+	pending []pendingLine
+
+	// repeats is the last line of each stream and how many times it was
+	// repeated since
+	repeats map[consoleStream]*consoleRepeat
+
+	// start is when the first pending line was added
+	start time.Time
+
+	timer *time.Timer
+}
+
+type pendingLine struct {
+	record *service.Record
+	time   time.Time
+}
+
+// consoleStream is a stream of the console lines, of a writer for a shared
+// run
+type consoleStream struct {
+	writer     string
+	outputType service.OutputRawRecord_OutputType
+}
+
+type consoleRepeat struct {
+	line  string
+	count int
+
+	// last is the record of the last repetition
+	last *service.Record
+}
+
+// NewConsole returns the processor of the console lines
+func NewConsole(ansi string, maxLineBytes int, delay time.Duration) (*Console, error) {
+	switch ansi {
+	case "":
+		ansi = ConsoleANSIKeep
+	case ConsoleANSIKeep, ConsoleANSIStrip:
+	default:
+		return nil, fmt.Errorf("invalid console ANSI handling %q", ansi)
+	}
+	if maxLineBytes <= 0 {
+		maxLineBytes = defaultConsoleMaxLineBytes
+	}
+	if delay <= 0 {
+		delay = defaultConsoleReorderDelay
+	}
+	return &Console{
+		ansi:         ansi,
+		maxLineBytes: maxLineBytes,
+		delay:        delay,
+		repeats:      make(map[consoleStream]*consoleRepeat),
+	}, nil
+}
+
+// newConsole returns the processor of the console lines from the settings
+func newConsole(settings *service.Settings) (*Console, error) {
+	return NewConsole(
+		settings.GetXConsoleAnsi().GetValue(),
+		int(settings.GetXConsoleMaxLineBytes().GetValue()),
+		clients.SecondsToDuration(settings.GetXConsoleReorderSeconds().GetValue()),
+	)
+}
+
+// Add holds a console line until the lines are flushed. It returns true when
+// enough lines are held that they should be flushed right away.
+func (c *Console) Add(record *service.Record, now time.Time) bool {
+	if len(c.pending) == 0 {
+		c.start = now
+	}
+	t := now
+	if timestamp := record.GetOutputRaw().GetTimestamp(); timestamp != nil {
+		t = timestamp.AsTime()
+	}
+	c.pending = append(c.pending, pendingLine{record: record, time: t})
+	return len(c.pending) >= consoleMaxPending
+}
+
+// Ready returns a channel that receives once the pending lines are due, nil
+// if there are none
+func (c *Console) Ready() <-chan time.Time {
+	if c == nil || len(c.pending) == 0 {
+		return nil
+	}
+	delay := time.Until(c.start.Add(c.delay))
+	if c.timer == nil {
+		c.timer = time.NewTimer(delay)
+	} else {
+		if !c.timer.Stop() {
+			select {
+			case <-c.timer.C:
+			default:
+			}
+		}
+		c.timer.Reset(delay)
+	}
+	return c.timer.C
+}
+
+// Flush returns the records of the pending lines, in the order of their
+// timestamps. A line repeated at the end of a stream is kept back, in case it
+// is repeated again, unless final is set.
+func (c *Console) Flush(final bool) []*service.Record {
+	if c == nil {
+		return nil
+	}
+	sort.SliceStable(c.pending, func(i, j int) bool {
+		return c.pending[i].time.Before(c.pending[j].time)
+	})
+
+	var records []*service.Record
+	for _, pending := range c.pending {
+		records = append(records, c.process(pending.record)...)
+	}
+	clear(c.pending)
+	c.pending = c.pending[:0]
+
+	if final {
+		var markers []*service.Record
+		for _, repeat := range c.repeats {
+			if marker := repeat.marker(); marker != nil {
+				markers = append(markers, marker)
+			}
+		}
+		sort.SliceStable(markers, func(i, j int) bool {
+			return markers[i].GetOutputRaw().GetTimestamp().AsTime().
+				Before(markers[j].GetOutputRaw().GetTimestamp().AsTime())
+		})
+		records = append(records, markers...)
+		clear(c.repeats)
+	}
+	return records
+}
+
+// process returns the records of a console line
+func (c *Console) process(record *service.Record) []*service.Record {
+	output := record.GetOutputRaw()
+	line := output.GetLine()
+	// the empty new lines are dropped by the sender, they don't break a
+	// sequence of repeated lines
+	if line == "\n" {
+		return []*service.Record{record}
+	}
+	if c.ansi == ConsoleANSIStrip {
+		line = ansiEscape.ReplaceAllString(line, "")
+		if line == "" && output.GetLine() != "" {
+			return nil
+		}
+	}
+
+	// the lines of the writers of a shared run are told apart by their rank
+	if rank := record.GetControl().GetRank(); rank != "" {
+		line = fmt.Sprintf("[rank %s] %s", rank, line)
+	}
+
+	var records []*service.Record
+	stream := consoleStream{writer: writerKey(record), outputType: output.GetOutputType()}
+	repeat, ok := c.repeats[stream]
+	switch {
+	case !ok:
+		c.repeats[stream] = &consoleRepeat{line: line}
+	case repeat.line == line:
+		repeat.count++
+		repeat.last = record
+		return nil
+	default:
+		if marker := repeat.marker(); marker != nil {
+			records = append(records, marker)
+		}
+		*repeat = consoleRepeat{line: line}
+	}
+
+	for _, chunk := range chunkLine(line, c.maxLineBytes) {
+		records = append(records, withConsoleLine(record, chunk))
+	}
+	return records
+}
+
+// marker returns the record of the line that tells how many times the last
+// line was repeated, nil if it was not
+func (r *consoleRepeat) marker() *service.Record {
+	if r.count == 0 {
+		return nil
+	}
+	marker := withConsoleLine(r.last, fmt.Sprintf("(previous line repeated %d times)", r.count))
+	r.count = 0
+	r.last = nil
+	return marker
+}
+
+// withConsoleLine returns the record with the line, a copy if the line
+// changes
+func withConsoleLine(record *service.Record, line string) *service.Record {
+	if record.GetOutputRaw().GetLine() == line {
+		return record
+	}
+	record = proto.Clone(record).(*service.Record)
+	record.GetOutputRaw().Line = line
+	return record
+}
+
+// chunkLine splits a line in chunks of at most size bytes, without splitting
+// its characters
+func chunkLine(line string, size int) []string {
+	var chunks []string
+	for len(line) > size {
+		end := size
+		for end > 0 && !utf8.RuneStart(line[end]) {
+			end--
+		}
+		if end == 0 {
+			end = size
+		}
+		chunks = append(chunks, line[:end])
+		line = line[end:]
+	}
+	return append(chunks, line)
+}
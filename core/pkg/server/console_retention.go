@@ -0,0 +1,113 @@
+package server
+
+import (
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/wandb/wandb/core/pkg/service"
+)
+
+// ConsoleRetention limits how much console output the writer stores. Console
+// lines over the limits are still sent, but not written to the store.
+type ConsoleRetention struct {
+	// MaxLines is the number of console lines stored, zero is no limit
+	MaxLines int64
+
+	// MaxBytes is the number of bytes of console lines stored, zero is no
+	// limit
+	MaxBytes int64
+
+	// Window is the duration over which the limits apply: at most MaxLines
+	// and MaxBytes are stored in any window of that duration, based on the
+	// timestamps of the lines. If it is zero the limits apply to the whole
+	// run, and the first lines are stored.
+	Window time.Duration
+}
+
+// enabled reports whether the retention limits any output
+func (r ConsoleRetention) enabled() bool {
+	return r.MaxLines > 0 || r.MaxBytes > 0
+}
+
+// consoleLine is a console line stored within the retention window
+type consoleLine struct {
+	time time.Time
+	size int64
+}
+
+// consoleLimiter enforces a console retention on the records being stored
+type consoleLimiter struct {
+	retention ConsoleRetention
+
+	// window are the lines stored within the retention window, oldest first,
+	// it is only used if the retention has a window
+	window []consoleLine
+
+	// lines and bytes are the lines and bytes stored within the window
+	lines int64
+	bytes int64
+}
+
+func newConsoleLimiter(retention ConsoleRetention) *consoleLimiter {
+	return &consoleLimiter{retention: retention}
+}
+
+// consoleOutput returns the timestamp and line of a console record, it
+// reports false for other records
+func consoleOutput(record *service.Record) (time.Time, string, bool) {
+	switch x := record.RecordType.(type) {
+	case *service.Record_Output:
+		return outputTime(x.Output.GetTimestamp()), x.Output.GetLine(), true
+	case *service.Record_OutputRaw:
+		return outputTime(x.OutputRaw.GetTimestamp()), x.OutputRaw.GetLine(), true
+	}
+	return time.Time{}, "", false
+}
+
+// outputTime returns the time of a console line, which is the time the
+// writer got it if the line has no timestamp
+func outputTime(timestamp *timestamppb.Timestamp) time.Time {
+	if timestamp == nil {
+		return time.Now()
+	}
+	return timestamp.AsTime()
+}
+
+// allow reports whether the record can be stored, and accounts for it.
+// Records other than console lines are always allowed.
+func (l *consoleLimiter) allow(record *service.Record) bool {
+	now, line, ok := consoleOutput(record)
+	if !ok {
+		return true
+	}
+	size := int64(len(line))
+	if l.retention.Window > 0 {
+		l.expire(now)
+	}
+	if (l.retention.MaxLines > 0 && l.lines+1 > l.retention.MaxLines) ||
+		(l.retention.MaxBytes > 0 && l.bytes+size > l.retention.MaxBytes) {
+		return false
+	}
+	l.lines++
+	l.bytes += size
+	if l.retention.Window > 0 {
+		l.window = append(l.window, consoleLine{time: now, size: size})
+	}
+	return true
+}
+
+// expire forgets the lines that are older than the window
+func (l *consoleLimiter) expire(now time.Time) {
+	start := now.Add(-l.retention.Window)
+	expired := 0
+	for _, line := range l.window {
+		if line.time.After(start) {
+			break
+		}
+		l.lines--
+		l.bytes -= line.size
+		expired++
+	}
+	l.window = l.window[expired:]
+}
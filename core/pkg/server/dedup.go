@@ -0,0 +1,135 @@
+package server
+
+import (
+	"bytes"
+	"container/list"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"github.com/wandb/wandb/core/pkg/service"
+)
+
+// defaultDedupWindow is the default number of record identities remembered
+// by the deduplicator
+const defaultDedupWindow = 10000
+
+// recordDeduper remembers the identities of the most recently seen records in
+// a bounded LRU, so that records that are replayed can be dropped.
+type recordDeduper struct {
+	// size is the maximum number of identities to remember
+	size int
+
+	// order is the list of identities, most recently seen first
+	order *list.List
+
+	// entries maps identities to their element in the order list
+	entries map[string]*list.Element
+}
+
+func newRecordDeduper(size int) *recordDeduper {
+	if size <= 0 {
+		size = defaultDedupWindow
+	}
+	return &recordDeduper{
+		size:    size,
+		order:   list.New(),
+		entries: make(map[string]*list.Element),
+	}
+}
+
+// recordIdentity returns the identity of a record, which is its uuid or
+// mailbox slot if it has one, or else a hash of its contents.
+func recordIdentity(record *service.Record) string {
+	if uuid := record.GetUuid(); uuid != "" {
+		return "uuid:" + uuid
+	}
+	if slot := record.GetControl().GetMailboxSlot(); slot != "" {
+		return "slot:" + slot
+	}
+	// the record number is assigned by the writer, so a replayed record may
+	// already carry one
+	if record.GetNum() != 0 {
+		record = proto.Clone(record).(*service.Record)
+		record.Num = 0
+	}
+	data, err := proto.MarshalOptions{Deterministic: true}.Marshal(record)
+	if err != nil {
+		return ""
+	}
+	sum := sha256.Sum256(data)
+	return "hash:" + hex.EncodeToString(sum[:])
+}
+
+// isDuplicate reports whether the record was seen recently, and remembers it.
+// Records that must always be sent are never considered duplicates.
+func (d *recordDeduper) isDuplicate(record *service.Record) bool {
+	if record.GetControl().GetAlwaysSend() {
+		return false
+	}
+	id := recordIdentity(record)
+	if id == "" {
+		return false
+	}
+	if elem, ok := d.entries[id]; ok {
+		d.order.MoveToFront(elem)
+		return true
+	}
+	d.entries[id] = d.order.PushFront(id)
+	if d.order.Len() > d.size {
+		oldest := d.order.Back()
+		d.order.Remove(oldest)
+		delete(d.entries, oldest.Value.(string))
+	}
+	return false
+}
+
+// repeatDeduper drops the records of some types that are byte-identical to
+// the previous record of the same type, such as the config and telemetry
+// records that clients send again when they retry.
+type repeatDeduper struct {
+	// last is the encoding of the previous record of each deduplicated type
+	last map[protoreflect.Name][]byte
+}
+
+// newRepeatDeduper returns a deduper of the records of the given types, which
+// are named after the record_type fields of the Record message
+func newRepeatDeduper(types []string) (*repeatDeduper, error) {
+	d := &repeatDeduper{last: make(map[protoreflect.Name][]byte)}
+	for _, name := range types {
+		field := recordTypes.Fields().ByName(protoreflect.Name(name))
+		if field == nil {
+			return nil, fmt.Errorf("unknown record type %q", name)
+		}
+		d.last[field.Name()] = nil
+	}
+	return d, nil
+}
+
+// isRepeated reports whether the record is identical to the previous record
+// of its type, and remembers it. Records of the types that are not
+// deduplicated are never repeated.
+func (d *repeatDeduper) isRepeated(record *service.Record) bool {
+	field := record.ProtoReflect().WhichOneof(recordTypes)
+	if field == nil {
+		return false
+	}
+	last, ok := d.last[field.Name()]
+	if !ok {
+		return false
+	}
+	// the record number is assigned when the record is stored
+	if record.GetNum() != 0 {
+		record = proto.Clone(record).(*service.Record)
+		record.Num = 0
+	}
+	data, err := proto.MarshalOptions{Deterministic: true}.Marshal(record)
+	if err != nil {
+		return false
+	}
+	d.last[field.Name()] = data
+	return last != nil && bytes.Equal(last, data)
+}
@@ -0,0 +1,101 @@
+package server
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/segmentio/encoding/json"
+
+	"github.com/wandb/wandb/core/internal/expr"
+	"github.com/wandb/wandb/core/pkg/service"
+)
+
+// derivedMetric is a metric computed from the keys of each history row
+type derivedMetric struct {
+	name string
+	expr *expr.Expr
+}
+
+// handleDerivedMetric declares a derived metric, it replaces the metric of
+// the same name
+func (h *Handler) handleDerivedMetric(_ *service.Record, request *service.DerivedMetricRequest, response *service.Response) {
+	metric, err := newDerivedMetric(request)
+	result := &service.DerivedMetricResponse{}
+	if err != nil {
+		result.Error = &service.ErrorInfo{Code: service.ErrorInfo_USAGE, Message: err.Error()}
+	} else {
+		h.addDerivedMetric(metric)
+	}
+	response.ResponseType = &service.Response_DerivedMetricResponse{DerivedMetricResponse: result}
+}
+
+func newDerivedMetric(request *service.DerivedMetricRequest) (*derivedMetric, error) {
+	name := request.GetName()
+	switch {
+	case name == "":
+		return nil, fmt.Errorf("handler: a derived metric needs a name")
+	case strings.HasPrefix(name, "_"):
+		return nil, fmt.Errorf("handler: the derived metric %s can't be internal", name)
+	}
+	e, err := expr.Parse(request.GetExpression())
+	if err != nil {
+		return nil, err
+	}
+	for _, key := range e.Names() {
+		if key == name {
+			return nil, fmt.Errorf("handler: the derived metric %s depends on itself", name)
+		}
+	}
+	return &derivedMetric{name: name, expr: e}, nil
+}
+
+func (h *Handler) addDerivedMetric(metric *derivedMetric) {
+	for i, other := range h.derivedMetrics {
+		if other.name == metric.name {
+			h.derivedMetrics[i] = metric
+			return
+		}
+	}
+	h.derivedMetrics = append(h.derivedMetrics, metric)
+}
+
+// deriveMetrics returns the items of the derived metrics of a history row.
+// The metrics are computed in the order they were declared, so that one can
+// use the ones before. A metric is skipped if a key it needs is not in the
+// row, and a key logged in the row is not overwritten.
+func (h *Handler) deriveMetrics(items []*service.HistoryItem) []*service.HistoryItem {
+	if len(h.derivedMetrics) == 0 {
+		return nil
+	}
+	logged := make(map[string]bool, len(items))
+	values := make(map[string]float64, len(items))
+	for _, item := range items {
+		logged[item.GetKey()] = true
+		var value float64
+		if err := json.Unmarshal([]byte(item.GetValueJson()), &value); err == nil {
+			values[item.GetKey()] = value
+		}
+	}
+
+	var derived []*service.HistoryItem
+	for _, metric := range h.derivedMetrics {
+		if logged[metric.name] {
+			continue
+		}
+		value, err := metric.expr.Eval(values)
+		if err != nil {
+			if !errors.Is(err, expr.ErrMissing) {
+				h.logger.Debug("handler: failed to derive a metric", "name", metric.name, "error", err)
+			}
+			continue
+		}
+		encoded, err := json.Marshal(value)
+		if err != nil {
+			continue
+		}
+		values[metric.name] = value
+		derived = append(derived, &service.HistoryItem{Key: metric.name, ValueJson: string(encoded)})
+	}
+	return derived
+}
@@ -0,0 +1,96 @@
+package server
+
+import (
+	"os"
+	"runtime"
+	"strings"
+
+	"github.com/wandb/wandb/core/pkg/observability"
+	"github.com/wandb/wandb/core/pkg/service"
+)
+
+// launchVariables are the variables of the environment that tell how a run
+// was launched, by torchrun, MPI, SLURM or W&B Launch. The other variables
+// are not captured, they may hold secrets.
+var launchVariables = []string{
+	"RANK",
+	"LOCAL_RANK",
+	"WORLD_SIZE",
+	"LOCAL_WORLD_SIZE",
+	"MASTER_ADDR",
+	"MASTER_PORT",
+	"TORCHELASTIC_RUN_ID",
+	"OMPI_COMM_WORLD_RANK",
+	"OMPI_COMM_WORLD_SIZE",
+	"SLURM_JOB_ID",
+	"SLURM_PROCID",
+	"SLURM_NTASKS",
+	"CUDA_VISIBLE_DEVICES",
+	"CONDA_DEFAULT_ENV",
+	"VIRTUAL_ENV",
+	"WANDB_LAUNCH",
+	"WANDB_LAUNCH_QUEUE_NAME",
+}
+
+// NewEnvironmentRecord returns the fingerprint of the environment of a run,
+// from its settings, its git repository and the variables of the process.
+// The versions of the GPU driver are probed by the system monitor.
+func NewEnvironmentRecord(
+	settings *service.Settings,
+	run *service.RunRecord,
+	logger *observability.CoreLogger,
+) *service.EnvironmentRecord {
+	environment := &service.EnvironmentRecord{
+		GitCommit:     run.GetGit().GetCommit(),
+		PythonVersion: settings.GetXPython().GetValue(),
+		GoVersion:     runtime.Version(),
+		CudaVersion:   settings.GetXCuda().GetValue(),
+	}
+	environment.ContainerImage, environment.ContainerImageDigest = splitImageDigest(settings.GetDocker().GetValue())
+
+	for _, name := range launchVariables {
+		if value, ok := os.LookupEnv(name); ok {
+			if environment.Launch == nil {
+				environment.Launch = make(map[string]string)
+			}
+			environment.Launch[name] = value
+		}
+	}
+
+	if settings.GetDisableGit().GetValue() {
+		return environment
+	}
+	git := NewGit(settings.GetRootDir().GetValue(), logger)
+	if !git.IsAvailable() {
+		return environment
+	}
+	if environment.GitCommit == "" {
+		if commit, err := git.LatestCommit("HEAD"); err != nil {
+			logger.Error("error getting latest commit", "error", err)
+		} else {
+			environment.GitCommit = commit
+		}
+	}
+	if branch, err := git.Branch(); err != nil {
+		logger.Error("error getting git branch", "error", err)
+	} else {
+		environment.GitBranch = branch
+	}
+	if digest, err := git.DiffDigest("HEAD"); err != nil {
+		logger.Error("error generating diff", "error", err)
+	} else {
+		environment.GitDirty = digest != ""
+		environment.GitDiffSha256 = digest
+	}
+	return environment
+}
+
+// splitImageDigest splits the digest from a container image reference, like
+// wandb/local@sha256:...
+func splitImageDigest(image string) (string, string) {
+	name, digest, found := strings.Cut(image, "@")
+	if !found {
+		return image, ""
+	}
+	return name, digest
+}
@@ -0,0 +1,77 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/wandb/wandb/core/internal/clients"
+	"github.com/wandb/wandb/core/pkg/service"
+)
+
+// statusPattern matches the status of the errors the GraphQL client returns
+// for the responses that are not retried, e.g. "returned error 401 Unauthorized: ..."
+var statusPattern = regexp.MustCompile(`returned error (\d{3})`)
+
+// errorInfo returns the error to report to the client for a failed request
+// to the backend.
+func errorInfo(err error) *service.ErrorInfo {
+	return &service.ErrorInfo{
+		Message: err.Error(),
+		Code:    errorCode(err),
+	}
+}
+
+// errorCode classifies the error of a request to the backend
+func errorCode(err error) service.ErrorInfo_ErrorCode {
+	var requestErr *clients.RequestError
+	if errors.As(err, &requestErr) && requestErr.StatusCode != 0 {
+		return statusErrorCode(requestErr.StatusCode)
+	}
+	if match := statusPattern.FindStringSubmatch(err.Error()); match != nil {
+		status, _ := strconv.Atoi(match[1])
+		return statusErrorCode(status)
+	}
+
+	var netErr net.Error
+	if requestErr != nil || errors.As(err, &netErr) ||
+		errors.Is(err, context.DeadlineExceeded) || errors.Is(err, context.Canceled) {
+		return service.ErrorInfo_COMMUNICATION
+	}
+
+	// the backend answers some failed operations with a GraphQL error
+	message := strings.ToLower(err.Error())
+	switch {
+	case strings.Contains(message, "quota") || strings.Contains(message, "storage limit"):
+		return service.ErrorInfo_QUOTA_EXCEEDED
+	case strings.Contains(message, "permission denied") || strings.Contains(message, "not authorized") ||
+		strings.Contains(message, "unauthorized"):
+		return service.ErrorInfo_AUTHENTICATION
+	case strings.Contains(message, "not found") &&
+		(strings.Contains(message, "project") || strings.Contains(message, "entity")):
+		return service.ErrorInfo_PROJECT_NOT_FOUND
+	}
+	return service.ErrorInfo_COMMUNICATION
+}
+
+// statusErrorCode classifies the status code of a failed response
+func statusErrorCode(status int) service.ErrorInfo_ErrorCode {
+	switch {
+	case status == http.StatusUnauthorized || status == http.StatusForbidden:
+		return service.ErrorInfo_AUTHENTICATION
+	case status == http.StatusPaymentRequired:
+		return service.ErrorInfo_QUOTA_EXCEEDED
+	case status == http.StatusNotFound:
+		return service.ErrorInfo_PROJECT_NOT_FOUND
+	case status >= http.StatusInternalServerError:
+		return service.ErrorInfo_SERVER
+	case status >= http.StatusBadRequest && status != http.StatusTooManyRequests:
+		return service.ErrorInfo_USAGE
+	default:
+		return service.ErrorInfo_COMMUNICATION
+	}
+}
@@ -0,0 +1,90 @@
+package server
+
+import (
+	"sync"
+	"time"
+
+	"github.com/wandb/wandb/core/pkg/service"
+)
+
+// exitFlush bounds the final flush of a run.
+//
+// When the deadline passes, the requests to the backend are cancelled: the
+// uploads in flight fail right away, so that the defer state machine goes
+// through its stages without waiting for them. The payloads that are left
+// unsent stay in the transaction log of the run, which can be synced again
+// once the backend is reachable.
+type exitFlush struct {
+	mu sync.Mutex
+
+	// timer cancels the requests to the backend at the deadline
+	timer *time.Timer
+
+	// timedOut is set once the deadline has passed
+	timedOut bool
+
+	// unsentFiles are the paths of the files whose upload was cancelled
+	unsentFiles []string
+
+	// unsentArtifacts are the names of the artifacts whose upload was
+	// cancelled
+	unsentArtifacts []string
+}
+
+// start cancels the requests with cancel once timeout has passed, a timeout
+// of zero or less means no deadline
+func (f *exitFlush) start(timeout time.Duration, cancel func()) {
+	if timeout <= 0 {
+		return
+	}
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.timer != nil {
+		return
+	}
+	f.timer = time.AfterFunc(timeout, func() {
+		f.mu.Lock()
+		f.timedOut = true
+		f.mu.Unlock()
+		cancel()
+	})
+}
+
+// stop stops the deadline, it returns whether it had passed
+func (f *exitFlush) stop() bool {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.timer != nil {
+		f.timer.Stop()
+	}
+	return f.timedOut
+}
+
+func (f *exitFlush) addUnsentFile(path string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.unsentFiles = append(f.unsentFiles, path)
+}
+
+func (f *exitFlush) addUnsentArtifact(name string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.unsentArtifacts = append(f.unsentArtifacts, name)
+}
+
+// result returns the exit result of the run, which lists the payloads left
+// unsent if the deadline has passed
+func (f *exitFlush) result(unsentFileStreamRequests int32, syncFile string) *service.RunExitResult {
+	if !f.stop() {
+		return &service.RunExitResult{}
+	}
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return &service.RunExitResult{
+		PartialSync:              true,
+		UnsentFiles:              f.unsentFiles,
+		UnsentArtifacts:          f.unsentArtifacts,
+		UnsentFileStreamRequests: unsentFileStreamRequests,
+		SyncFile:                 syncFile,
+	}
+}
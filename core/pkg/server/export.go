@@ -0,0 +1,228 @@
+package server
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/wandb/wandb/core/pkg/observability"
+	"github.com/wandb/wandb/core/pkg/service"
+)
+
+// ExportFormat is the file format a store is exported to
+type ExportFormat int
+
+const (
+	// ExportJSONL writes one JSON object per line.
+	ExportJSONL ExportFormat = iota
+	// ExportParquet writes Parquet files, it is not supported yet as no
+	// Parquet encoder is available to the core.
+	ExportParquet
+)
+
+// errParquetUnsupported is returned when exporting to Parquet
+var errParquetUnsupported = errors.New("export: parquet is not supported yet, use jsonl")
+
+// ParseExportFormat returns the export format with the given name, "jsonl"
+// or "parquet". An empty name is JSONL.
+func ParseExportFormat(name string) (ExportFormat, error) {
+	switch strings.ToLower(name) {
+	case "", "jsonl":
+		return ExportJSONL, nil
+	case "parquet":
+		return ExportParquet, nil
+	default:
+		return ExportJSONL, fmt.Errorf("unknown export format %q", name)
+	}
+}
+
+// ExportStats are the number of records exported to each file
+type ExportStats struct {
+	History int64
+	Config  int64
+	Summary int64
+	Console int64
+}
+
+// exportFiles are the names of the exported files, by kind of record
+var exportFiles = [...]string{"history", "config", "summary", "console"}
+
+// ExportStore writes the history, config, summary and console records of the
+// store whose first segment is fileName to files named after them in dir,
+// e.g. history.jsonl. Each history record becomes an object of its values,
+// each config and summary record an object of its updates and removed keys,
+// and each console line an object of its stream and text. All objects carry
+// the number of the record they were exported from as _num.
+func ExportStore(
+	ctx context.Context,
+	fileName string,
+	dir string,
+	format ExportFormat,
+	logger *observability.CoreLogger,
+	opts StoreOptions,
+) (ExportStats, error) {
+	stats := ExportStats{}
+	if format == ExportParquet {
+		return stats, errParquetUnsupported
+	}
+
+	reader := NewSegmentReaderWithOptions(ctx, fileName, logger, opts)
+	if err := reader.Open(); err != nil {
+		return stats, err
+	}
+	defer reader.Close()
+
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return stats, err
+	}
+	var sinks [len(exportFiles)]*jsonlSink
+	for i, name := range exportFiles {
+		sink, err := newJSONLSink(filepath.Join(dir, name+".jsonl"))
+		if err != nil {
+			closeSinks(sinks[:i])
+			return stats, err
+		}
+		sinks[i] = sink
+	}
+	history, config, summary, console := sinks[0], sinks[1], sinks[2], sinks[3]
+
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			closeSinks(sinks[:])
+			return stats, err
+		}
+
+		switch x := record.RecordType.(type) {
+		case *service.Record_History:
+			err = history.write(exportHistory(record.Num, x.History))
+			stats.History++
+		case *service.Record_Config:
+			err = config.write(exportItems(record.Num, x.Config.GetUpdate(), x.Config.GetRemove()))
+			stats.Config++
+		case *service.Record_Summary:
+			err = summary.write(exportItems(record.Num, x.Summary.GetUpdate(), x.Summary.GetRemove()))
+			stats.Summary++
+		case *service.Record_Output:
+			err = console.write(exportLine(record.Num, x.Output.GetOutputType().String(),
+				x.Output.GetTimestamp(), x.Output.GetLine()))
+			stats.Console++
+		case *service.Record_OutputRaw:
+			err = console.write(exportLine(record.Num, x.OutputRaw.GetOutputType().String(),
+				x.OutputRaw.GetTimestamp(), x.OutputRaw.GetLine()))
+			stats.Console++
+		}
+		if err != nil {
+			closeSinks(sinks[:])
+			return stats, err
+		}
+	}
+
+	var errs []error
+	for _, sink := range sinks {
+		errs = append(errs, sink.close())
+	}
+	return stats, errors.Join(errs...)
+}
+
+// jsonValue returns the raw JSON of a value, or the value as a JSON string if
+// it is not valid JSON
+func jsonValue(valueJSON string) json.RawMessage {
+	if json.Valid([]byte(valueJSON)) {
+		return json.RawMessage(valueJSON)
+	}
+	data, _ := json.Marshal(valueJSON)
+	return data
+}
+
+// exportKey returns the key of an exported value, nested keys are joined
+// with dots
+func exportKey(item keyedItem) string {
+	return strings.Join(itemPath(item), ".")
+}
+
+func exportHistory(num int64, history *service.HistoryRecord) map[string]any {
+	row := map[string]any{"_num": num}
+	if step := history.GetStep(); step != nil {
+		row["_step"] = step.GetNum()
+	}
+	for _, item := range history.GetItem() {
+		row[exportKey(item)] = jsonValue(item.GetValueJson())
+	}
+	return row
+}
+
+// valueItem is a config or summary item, which carries a JSON value
+type valueItem interface {
+	keyedItem
+	GetValueJson() string
+}
+
+func exportItems[T valueItem](num int64, update, remove []T) map[string]any {
+	updated := make(map[string]json.RawMessage, len(update))
+	for _, item := range update {
+		updated[exportKey(item)] = jsonValue(item.GetValueJson())
+	}
+	removed := make([]string, 0, len(remove))
+	for _, item := range remove {
+		removed = append(removed, exportKey(item))
+	}
+	return map[string]any{"_num": num, "update": updated, "remove": removed}
+}
+
+func exportLine(num int64, stream string, timestamp *timestamppb.Timestamp, line string) map[string]any {
+	row := map[string]any{
+		"_num":   num,
+		"stream": strings.ToLower(stream),
+		"line":   line,
+	}
+	if timestamp != nil {
+		row["_timestamp"] = float64(timestamp.AsTime().UnixNano()) / 1e9
+	}
+	return row
+}
+
+// jsonlSink writes JSON objects to a file, one per line
+type jsonlSink struct {
+	file    *os.File
+	writer  *bufio.Writer
+	encoder *json.Encoder
+}
+
+func newJSONLSink(name string) (*jsonlSink, error) {
+	file, err := os.Create(name)
+	if err != nil {
+		return nil, err
+	}
+	writer := bufio.NewWriter(file)
+	return &jsonlSink{file: file, writer: writer, encoder: json.NewEncoder(writer)}, nil
+}
+
+func (s *jsonlSink) write(row map[string]any) error {
+	return s.encoder.Encode(row)
+}
+
+func (s *jsonlSink) close() error {
+	if err := s.writer.Flush(); err != nil {
+		_ = s.file.Close()
+		return err
+	}
+	return s.file.Close()
+}
+
+// closeSinks closes the sinks after an error, the close errors are ignored
+func closeSinks(sinks []*jsonlSink) {
+	for _, sink := range sinks {
+		_ = sink.close()
+	}
+}
@@ -0,0 +1,90 @@
+package server_test
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	"github.com/wandb/wandb/core/pkg/observability"
+	"github.com/wandb/wandb/core/pkg/server"
+	"github.com/wandb/wandb/core/pkg/service"
+)
+
+func readJSONL(t *testing.T, name string) []map[string]any {
+	file, err := os.Open(name)
+	assert.NoError(t, err)
+	defer file.Close()
+	var rows []map[string]any
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		row := map[string]any{}
+		assert.NoError(t, json.Unmarshal(scanner.Bytes(), &row))
+		rows = append(rows, row)
+	}
+	assert.NoError(t, scanner.Err())
+	return rows
+}
+
+func TestExportStore(t *testing.T) {
+	dir := t.TempDir()
+	fileName := filepath.Join(dir, "run.wandb")
+	settings := &service.Settings{SyncFile: &wrapperspb.StringValue{Value: fileName}}
+	history := &service.Record{RecordType: &service.Record_History{History: &service.HistoryRecord{
+		Step: &service.HistoryStep{Num: 3},
+		Item: []*service.HistoryItem{
+			{Key: "loss", ValueJson: "0.5"},
+			{NestedKey: []string{"eval", "acc"}, ValueJson: "0.9"},
+		},
+	}}}
+	runWriter(t, append(compactRecords(), history), server.WithWriterSettings(settings))
+
+	outputDir := filepath.Join(dir, "export")
+	stats, err := server.ExportStore(context.Background(), fileName, outputDir,
+		server.ExportJSONL, observability.NewNoOpLogger(), server.StoreOptions{},
+	)
+	assert.NoError(t, err)
+	assert.Equal(t, server.ExportStats{History: 1, Config: 2, Summary: 2, Console: 4}, stats)
+
+	rows := readJSONL(t, filepath.Join(outputDir, "history.jsonl"))
+	assert.Equal(t, []map[string]any{
+		{"_num": 11.0, "_step": 3.0, "loss": 0.5, "eval.acc": 0.9},
+	}, rows)
+
+	rows = readJSONL(t, filepath.Join(outputDir, "config.jsonl"))
+	assert.Len(t, rows, 2)
+	assert.Equal(t, map[string]any{"c": "c"}, rows[1]["update"])
+	assert.Equal(t, []any{"a"}, rows[1]["remove"])
+
+	rows = readJSONL(t, filepath.Join(outputDir, "summary.jsonl"))
+	assert.Len(t, rows, 2)
+	assert.Equal(t, map[string]any{"loss": 0.5}, rows[1]["update"])
+
+	rows = readJSONL(t, filepath.Join(outputDir, "console.jsonl"))
+	assert.Len(t, rows, 4)
+	assert.Equal(t, "epoch 2", rows[2]["line"])
+	assert.Equal(t, "stderr", rows[2]["stream"])
+}
+
+func TestExportStoreParquet(t *testing.T) {
+	_, err := server.ExportStore(context.Background(), "run.wandb", t.TempDir(),
+		server.ExportParquet, observability.NewNoOpLogger(), server.StoreOptions{},
+	)
+	assert.Error(t, err)
+}
+
+func TestParseExportFormat(t *testing.T) {
+	format, err := server.ParseExportFormat("")
+	assert.NoError(t, err)
+	assert.Equal(t, server.ExportJSONL, format)
+	format, err = server.ParseExportFormat("parquet")
+	assert.NoError(t, err)
+	assert.Equal(t, server.ExportParquet, format)
+	_, err = server.ParseExportFormat("csv")
+	assert.Error(t, err)
+}
@@ -0,0 +1,41 @@
+package server
+
+import (
+	"path"
+	"sync"
+)
+
+// fileDigests keeps the digests of the run files uploaded by the sender, so
+// that a file saved again with the same content, like a checkpoint that is
+// saved every epoch but rarely changes, is not uploaded again
+type fileDigests struct {
+	mu sync.Mutex
+
+	// digests are the base64 MD5 of the uploaded files, by run and name
+	digests map[string]string
+}
+
+func newFileDigests() *fileDigests {
+	return &fileDigests{digests: make(map[string]string)}
+}
+
+// fileDigestKey returns the key of a file of a run, a moved or forked run
+// uploads its files again
+func fileDigestKey(entity, project, runID, name string) string {
+	return path.Join(entity, project, runID, name)
+}
+
+// uploaded returns whether the file was uploaded with the digest
+func (d *fileDigests) uploaded(key, digest string) bool {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	uploaded, ok := d.digests[key]
+	return ok && uploaded == digest
+}
+
+// record records the digest of an uploaded file
+func (d *fileDigests) record(key, digest string) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.digests[key] = digest
+}
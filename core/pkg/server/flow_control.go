@@ -0,0 +1,91 @@
+package server
+
+import (
+	"time"
+
+	"github.com/wandb/wandb/core/pkg/service"
+)
+
+// flowCreditsRetry is how often the credits asked for by the clients are
+// granted again while there is no capacity left downstream of the handler
+const flowCreditsRetry = 10 * time.Millisecond
+
+// FlowControl grants the clients the credits to send records, from the
+// capacity left in the queues downstream of the handler. A client that ran
+// out of credits waits for the handler to grant more, so that it slows
+// down instead of the records piling up in the core process.
+type FlowControl struct {
+	// capacity returns the number of records the queues downstream of the
+	// handler can take
+	capacity func() int
+
+	// pending are the credit requests not yet granted, in order
+	pending []*service.Record
+
+	timer *time.Timer
+}
+
+// NewFlowControl creates the flow control of a handler, capacity returns the
+// number of records its downstream queues can take
+func NewFlowControl(capacity func() int) *FlowControl {
+	return &FlowControl{capacity: capacity}
+}
+
+// Ready returns a channel that fires when the pending credit requests must
+// be granted again, nil if there are none
+func (fc *FlowControl) Ready() <-chan time.Time {
+	if fc == nil || len(fc.pending) == 0 {
+		return nil
+	}
+	if fc.timer == nil {
+		fc.timer = time.NewTimer(flowCreditsRetry)
+	} else {
+		if !fc.timer.Stop() {
+			select {
+			case <-fc.timer.C:
+			default:
+			}
+		}
+		fc.timer.Reset(flowCreditsRetry)
+	}
+	return fc.timer.C
+}
+
+// handleFlowCredit queues a credit request, it is granted once there is
+// capacity downstream of the handler
+func (h *Handler) handleFlowCredit(record *service.Record) {
+	if h.flowControl == nil {
+		h.flowControl = NewFlowControl(func() int { return cap(h.fwdChan) - len(h.fwdChan) })
+	}
+	h.flowControl.pending = append(h.flowControl.pending, record)
+	h.grantCredits(false)
+}
+
+// grantCredits grants the pending credit requests in order, as many credits
+// as they want up to the capacity left. With final, the requests are granted
+// in full, so that the clients are not left waiting on a closed stream.
+func (h *Handler) grantCredits(final bool) {
+	fc := h.flowControl
+	if fc == nil {
+		return
+	}
+	free := fc.capacity()
+	for len(fc.pending) > 0 && (free > 0 || final) {
+		record := fc.pending[0]
+		want := int(record.GetRequest().GetFlowCredit().GetWant())
+		if want <= 0 {
+			want = int(h.settings.GetXFlowControlCredits().GetValue())
+		}
+		credits := max(want, 1)
+		if !final {
+			credits = min(credits, free)
+			free -= credits
+		}
+		fc.pending = fc.pending[1:]
+		h.sendResponse(record, &service.Response{
+			ResponseType: &service.Response_FlowCreditResponse{
+				FlowCreditResponse: &service.FlowCreditResponse{Credits: int32(credits)},
+			},
+		})
+	}
+}
@@ -1,6 +1,8 @@
 package server
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
 	"os"
 	"os/exec"
@@ -81,3 +83,28 @@ func (g *Git) SavePatch(ref, output string) error {
 	}
 	return nil
 }
+
+// Branch returns the name of the checked out branch, HEAD if it is detached
+func (g *Git) Branch() (string, error) {
+	command := []string{"git", "rev-parse", "--abbrev-ref", "HEAD"}
+	output, err := runCommandWithOutput(command, g.path)
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(string(output)), nil
+}
+
+// DiffDigest returns the SHA-256 of the diff between the current working tree
+// and the given ref, empty if there is no diff.
+func (g *Git) DiffDigest(ref string) (string, error) {
+	command := []string{"git", "diff", ref, "--submodule=diff"}
+	output, err := runCommandWithOutput(command, g.path)
+	if err != nil {
+		return "", err
+	}
+	if len(output) == 0 {
+		return "", nil
+	}
+	digest := sha256.Sum256(output)
+	return hex.EncodeToString(digest[:]), nil
+}
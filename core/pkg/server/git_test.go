@@ -4,13 +4,17 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"runtime"
 	"testing"
 
 	"github.com/go-git/go-git/v5"
 	"github.com/go-git/go-git/v5/plumbing/object"
 	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
 	"github.com/wandb/wandb/core/pkg/observability"
 	"github.com/wandb/wandb/core/pkg/server"
+	"github.com/wandb/wandb/core/pkg/service"
 )
 
 func setupTestRepo() (string, func(), error) {
@@ -115,3 +119,60 @@ func TestSavePatch(t *testing.T) {
 	}
 	assert.Contains(t, string(patch), "+test content")
 }
+
+func TestDiffDigest(t *testing.T) {
+	repoPath, cleanup, err := setupTestRepo()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer cleanup()
+
+	logger := observability.NewNoOpLogger()
+	git := server.NewGit(repoPath, logger)
+	digest, err := git.DiffDigest("HEAD")
+	assert.NoError(t, err)
+	assert.Empty(t, digest)
+
+	err = os.WriteFile(filepath.Join(repoPath, "temp.txt"), []byte("test content\n"), 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+	digest, err = git.DiffDigest("HEAD")
+	assert.NoError(t, err)
+	assert.Len(t, digest, 64)
+}
+
+func TestNewEnvironmentRecord(t *testing.T) {
+	repoPath, cleanup, err := setupTestRepo()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer cleanup()
+	err = os.WriteFile(filepath.Join(repoPath, "temp.txt"), []byte("test content\n"), 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Setenv("WORLD_SIZE", "8")
+	t.Setenv("WANDB_API_KEY", "secret")
+
+	settings := &service.Settings{
+		RootDir: wrapperspb.String(repoPath),
+		XPython: wrapperspb.String("3.11.4"),
+		Docker:  wrapperspb.String("wandb/train@sha256:0123"),
+	}
+	environment := server.NewEnvironmentRecord(settings, &service.RunRecord{}, observability.NewNoOpLogger())
+	assert.Len(t, environment.GetGitCommit(), 40)
+	assert.Equal(t, "master", environment.GetGitBranch())
+	assert.True(t, environment.GetGitDirty())
+	assert.Len(t, environment.GetGitDiffSha256(), 64)
+	assert.Equal(t, "3.11.4", environment.GetPythonVersion())
+	assert.Equal(t, runtime.Version(), environment.GetGoVersion())
+	assert.Equal(t, "wandb/train", environment.GetContainerImage())
+	assert.Equal(t, "sha256:0123", environment.GetContainerImageDigest())
+	assert.Equal(t, "8", environment.GetLaunch()["WORLD_SIZE"])
+	assert.NotContains(t, environment.GetLaunch(), "WANDB_API_KEY")
+
+	settings.DisableGit = wrapperspb.Bool(true)
+	environment = server.NewEnvironmentRecord(settings, &service.RunRecord{}, observability.NewNoOpLogger())
+	assert.Empty(t, environment.GetGitCommit())
+}
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"time"
 
 	"github.com/wandb/wandb/core/pkg/monitor"
 	"google.golang.org/protobuf/encoding/protojson"
@@ -85,6 +86,31 @@ func WithHandlerMetricHandler(handler *MetricHandler) HandlerOption {
 	}
 }
 
+// WithHandlerStatsWindow aggregates the system metrics over the window before
+// they are forwarded, they are forwarded as sampled if it is nil
+func WithHandlerStatsWindow(window *StatsWindow) HandlerOption {
+	return func(h *Handler) {
+		h.statsWindow = window
+	}
+}
+
+// WithHandlerConsole processes the console lines before they are forwarded,
+// they are forwarded as received if it is nil
+func WithHandlerConsole(console *Console) HandlerOption {
+	return func(h *Handler) {
+		h.console = console
+	}
+}
+
+// WithHandlerFlowControl grants the clients the credits to send records, from
+// the capacity left downstream of the handler, by default the capacity of
+// its forward channel
+func WithHandlerFlowControl(flowControl *FlowControl) HandlerOption {
+	return func(h *Handler) {
+		h.flowControl = flowControl
+	}
+}
+
 func WithHandlerSummaryHandler(handler *SummaryHandler) HandlerOption {
 	return func(h *Handler) {
 		h.summaryHandler = handler
@@ -132,6 +158,39 @@ type Handler struct {
 	// systemMonitor is the system monitor for the stream
 	systemMonitor *monitor.SystemMonitor
 
+	// statsWindow aggregates the system metrics before they are forwarded
+	statsWindow *StatsWindow
+
+	// console processes the console lines before they are forwarded
+	console *Console
+
+	// flowControl grants the clients the credits to send records
+	flowControl *FlowControl
+
+	// clientStep is the step the client logged the row being collected at,
+	// when the rows are numbered in the order they are received
+	clientStep *int64
+
+	// stepWarned is set once the client was warned that it logged to a step
+	// that went back
+	stepWarned bool
+
+	// historyKeys are the distinct keys logged to the history, up to the
+	// maximum that is guarded
+	historyKeys map[string]bool
+
+	// keysWarned is set once the client was warned that it logged too many
+	// distinct history keys
+	keysWarned bool
+
+	// derivedMetrics are the metrics computed from the keys of each history
+	// row, in the order they were declared
+	derivedMetrics []*derivedMetric
+
+	// stopConditions are the conditions over the history that stop the run,
+	// in the order they were registered
+	stopConditions []*stopCondition
+
 	// watcher is the watcher for the stream
 	watcher *watcher.Watcher
 
@@ -143,6 +202,44 @@ type Handler struct {
 
 	// filesInfoHandler is the file transfer info for the stream
 	filesInfoHandler *FilesInfoHandler
+
+	// internalMessages are the warnings not yet returned to the client
+	internalMessages *service.InternalMessages
+
+	// writers are the processes logging to a shared run, by key, and whether
+	// they exited
+	writers map[string]bool
+
+	// writerHistory is the partial history of each writer of a shared run
+	writerHistory map[string]*ActiveHistory
+
+	// sharedConfig is the config of a shared run, by path, to drop the
+	// updates another writer already made
+	sharedConfig map[string]string
+
+	// runStopped is set once the run was stopped from the UI, the client
+	// learns it from its stop status requests
+	runStopped bool
+
+	// stopReason is the stop condition that stopped the run, if one did
+	stopReason string
+
+	// tables are the tables of the history whose rows are appended
+	// incrementally, by key
+	tables map[string]*table
+
+	// alertsWait is until when the alerts can't be sent again, by title
+	alertsWait map[string]time.Time
+
+	// preempting is set once the run is being preempted, the client learns
+	// it from its stop status requests to save and exit in time
+	preempting bool
+
+	// online is set once a run started offline goes online
+	online bool
+
+	// exited is set once the exit of the run was handled
+	exited bool
 }
 
 // NewHandler creates a new handler
@@ -152,8 +249,9 @@ func NewHandler(
 	opts ...HandlerOption,
 ) *Handler {
 	h := &Handler{
-		ctx:    ctx,
-		logger: logger,
+		ctx:              ctx,
+		logger:           logger,
+		internalMessages: &service.InternalMessages{},
 	}
 	for _, opt := range opts {
 		opt(h)
@@ -165,11 +263,30 @@ func NewHandler(
 func (h *Handler) Do(inChan <-chan *service.Record) {
 	defer h.logger.Reraise()
 	h.logger.Info("handler: started", "stream_id", h.settings.RunId)
-	for record := range inChan {
-		h.logger.Debug("handling record", "record", record)
-		h.handleRecord(record)
+	for {
+		select {
+		case record, ok := <-inChan:
+			if !ok {
+				h.summaryHandler.Flush(h.sendSummary)
+				h.flushStats()
+				h.flushConsole(true)
+				h.grantCredits(true)
+				h.Close()
+				return
+			}
+			h.logger.Debug("handling record", "record", record)
+			h.handleRecord(record)
+		case <-h.summaryHandler.Ready():
+			// the summary is sent once it is due, even if no records come
+			h.summaryHandler.Debounce(h.sendSummary)
+		case <-h.statsWindow.Ready():
+			h.flushStats()
+		case <-h.console.Ready():
+			h.flushConsole(false)
+		case <-h.flowControl.Ready():
+			h.grantCredits(false)
+		}
 	}
-	h.Close()
 }
 
 func (h *Handler) Close() {
@@ -213,12 +330,16 @@ func (h *Handler) sendRecord(record *service.Record) {
 //gocyclo:ignore
 func (h *Handler) handleRecord(record *service.Record) {
 	h.summaryHandler.Debounce(h.sendSummary)
+	h.trackWriter(record)
 	recordType := record.GetRecordType()
 	h.logger.Debug("handle: got a message", "record_type", recordType)
 	switch x := record.RecordType.(type) {
 	case *service.Record_Alert:
-		h.handleAlert(record)
+		h.handleAlert(record, x.Alert)
+	case *service.Record_Table:
+		h.handleTable(record, x.Table)
 	case *service.Record_Artifact:
+		h.handleArtifact(record)
 	case *service.Record_Config:
 		h.handleConfig(record)
 	case *service.Record_Exit:
@@ -232,7 +353,7 @@ func (h *Handler) handleRecord(record *service.Record) {
 	case *service.Record_Header:
 		h.handleHeader(record)
 	case *service.Record_History:
-		h.handleHistory(x.History)
+		h.withWriterHistory(record, func() { h.handleHistory(x.History) })
 	case *service.Record_LinkArtifact:
 		h.handleLinkArtifact(record)
 	case *service.Record_Metric:
@@ -242,6 +363,8 @@ func (h *Handler) handleRecord(record *service.Record) {
 		h.handleOutputRaw(record)
 	case *service.Record_Preempting:
 		h.handlePreempting(record)
+	case *service.Record_Environment:
+		h.sendRecord(record)
 	case *service.Record_Request:
 		h.handleRequest(record)
 	case *service.Record_Run:
@@ -279,7 +402,7 @@ func (h *Handler) handleRequest(record *service.Record) {
 	case *service.Request_Keepalive:
 	case *service.Request_NetworkStatus:
 	case *service.Request_PartialHistory:
-		h.handlePartialHistory(record, x.PartialHistory)
+		h.withWriterHistory(record, func() { h.handlePartialHistory(record, x.PartialHistory) })
 		response = nil
 	case *service.Request_PollExit:
 		h.handlePollExit(record)
@@ -296,6 +419,7 @@ func (h *Handler) handleRequest(record *service.Record) {
 		response = nil
 	case *service.Request_Shutdown:
 	case *service.Request_StopStatus:
+		h.handleStopStatus(response)
 	case *service.Request_LogArtifact:
 		h.handleLogArtifact(record)
 		response = nil
@@ -316,12 +440,38 @@ func (h *Handler) handleRequest(record *service.Record) {
 	case *service.Request_FileTransferInfo:
 		h.handleFileTransferInfo(record)
 	case *service.Request_InternalMessages:
+		h.handleInternalMessages(response)
+	case *service.Request_StoreStatus:
+		h.handleStoreStatus(x.StoreStatus)
+		response = nil
+	case *service.Request_CircuitBreaker:
+		h.handleCircuitBreaker(x.CircuitBreaker)
+		response = nil
+	case *service.Request_RunStopped:
+		h.handleRunStopped()
+		response = nil
 	case *service.Request_Sync:
 		h.handleSync(record)
 		response = nil
 	case *service.Request_SenderRead:
 		h.handleSenderRead(record)
 		response = nil
+	case *service.Request_RunMove:
+		h.handleRunMove(record)
+		response = nil
+	case *service.Request_Preflight:
+		h.handlePreflight(record)
+		response = nil
+	case *service.Request_DerivedMetric:
+		h.handleDerivedMetric(record, x.DerivedMetric, response)
+	case *service.Request_StopCondition:
+		h.handleStopCondition(record, x.StopCondition, response)
+	case *service.Request_FlowCredit:
+		h.handleFlowCredit(record)
+		response = nil
+	case *service.Request_GoOnline:
+		h.handleGoOnline(record)
+		response = nil
 	default:
 		err := fmt.Errorf("handleRequest: unknown request type %T", x)
 		h.logger.CaptureFatalAndPanic("error handling request", err)
@@ -339,8 +489,9 @@ func (h *Handler) handleDefer(record *service.Record, request *service.DeferRequ
 		// stop the system monitor to ensure that we don't send any more system metrics
 		// after the run has exited
 		h.systemMonitor.Stop()
+		h.flushStats()
 	case service.DeferRequest_FLUSH_PARTIAL_HISTORY:
-		h.activeHistory.Flush()
+		h.flushPartialHistory()
 	case service.DeferRequest_FLUSH_TB:
 		h.tbHandler.Close()
 	case service.DeferRequest_FLUSH_SUM:
@@ -349,6 +500,7 @@ func (h *Handler) handleDefer(record *service.Record, request *service.DeferRequ
 		h.writeAndSendSummaryFile()
 	case service.DeferRequest_FLUSH_DEBOUNCER:
 	case service.DeferRequest_FLUSH_OUTPUT:
+		h.flushConsole(true)
 	case service.DeferRequest_FLUSH_JOB:
 	case service.DeferRequest_FLUSH_DIR:
 		h.watcher.Close()
@@ -376,8 +528,23 @@ func (h *Handler) handleDefer(record *service.Record, request *service.DeferRequ
 	)
 }
 
+// handleArtifact passes on an artifact logged while offline, it is stored
+// and saved when the run is synced
+func (h *Handler) handleArtifact(record *service.Record) {
+	h.sendRecord(record)
+}
+
 func (h *Handler) handleLogArtifact(record *service.Record) {
 	h.sendRecord(record)
+	// the artifact is stored to be saved when the run is synced, there is no
+	// ID to respond with until then
+	if h.settings.GetXOffline().GetValue() && !h.online {
+		h.sendResponse(record, &service.Response{
+			ResponseType: &service.Response_LogArtifactResponse{
+				LogArtifactResponse: &service.LogArtifactResponse{},
+			},
+		})
+	}
 }
 
 func (h *Handler) handleDownloadArtifact(record *service.Record) {
@@ -470,11 +637,26 @@ func (h *Handler) handleRunStart(record *service.Record, request *service.RunSta
 	var ok bool
 	run := request.Run
 
+	// the run was started by the first writer of a shared run
+	if h.settings.GetXShared().GetValue() && h.runRecord != nil {
+		return
+	}
+
 	// start the run timer
 	h.timer = Timer{}
 	startTime := run.StartTime.AsTime()
 	h.timer.Start(&startTime)
 
+	// a resumed run continues its runtime and summary
+	if run.GetResumed() {
+		h.timer.Accumulate(time.Duration(run.GetRuntime()) * time.Second)
+		if h.summaryHandler != nil {
+			for _, item := range run.GetSummary().GetUpdate() {
+				h.summaryHandler.consolidatedSummary[item.GetKey()] = item.GetValueJson()
+			}
+		}
+	}
+
 	if h.runRecord, ok = proto.Clone(run).(*service.RunRecord); !ok {
 		err := fmt.Errorf("handleRunStart: failed to clone run")
 		h.logger.CaptureFatalAndPanic("error handling run start", err)
@@ -546,9 +728,24 @@ func (h *Handler) handleRunStart(record *service.Record, request *service.RunSta
 			proto.Merge(metadata, systemInfo)
 		}
 	}
+	h.handleEnvironment(run, metadata)
 	h.handleMetadata(metadata)
 }
 
+// handleEnvironment stores the fingerprint of the environment of the run, and
+// adds it to its metadata
+func (h *Handler) handleEnvironment(run *service.RunRecord, metadata *service.MetadataRequest) {
+	if h.settings.GetXDisableMeta().GetValue() {
+		return
+	}
+	environment := NewEnvironmentRecord(h.settings, run, h.logger)
+	proto.Merge(environment, metadata.GetEnvironment())
+	metadata.Environment = environment
+	h.sendRecord(&service.Record{
+		RecordType: &service.Record_Environment{Environment: environment},
+	})
+}
+
 func (h *Handler) handlePythonPackages(_ *service.Record, request *service.PythonPackagesRequest) {
 	// write all requirements to a file
 	// send the file as a Files record
@@ -730,18 +927,59 @@ func (h *Handler) handleResume() {
 }
 
 func (h *Handler) handleSystemMetrics(record *service.Record) {
-	h.sendRecord(record)
+	if h.statsWindow == nil || record.GetStats().GetStatsType() != service.StatsRecord_SYSTEM {
+		h.sendRecord(record)
+		return
+	}
+	h.statsWindow.Add(record.GetStats(), time.Now())
+}
+
+// flushStats forwards the system metrics aggregated in the window so far
+func (h *Handler) flushStats() {
+	if record := h.statsWindow.Flush(); record != nil {
+		h.sendRecord(record)
+	}
 }
 
 func (h *Handler) handleOutputRaw(record *service.Record) {
-	h.sendRecord(record)
+	if h.console == nil {
+		h.sendRecord(record)
+		return
+	}
+	if h.console.Add(record, time.Now()) {
+		h.flushConsole(false)
+	}
+}
+
+// flushConsole forwards the processed console lines, final also forwards the
+// markers of the lines repeated at the end of the streams
+func (h *Handler) flushConsole(final bool) {
+	for _, record := range h.console.Flush(final) {
+		h.sendRecord(record)
+	}
 }
 
+// handlePreempting marks the run preempting, and checkpoints what the handler
+// holds, before the run is killed at the end of the grace period
 func (h *Handler) handlePreempting(record *service.Record) {
-	h.sendRecord(record)
+	if h.exited {
+		h.logger.Info("handler: ignoring the preemption of a run that exited")
+		return
+	}
+	h.preempting = true
+	h.flushPartialHistory()
+	h.flushConsole(true)
+	h.flushStats()
+	h.summaryHandler.Flush(h.sendSummary)
+	h.sendRecordWithControl(record,
+		func(control *service.Control) {
+			control.AlwaysSend = true
+		},
+	)
 }
 
 func (h *Handler) handleRun(record *service.Record) {
+	h.dedupeConfig(record.GetRun().GetConfig())
 	h.sendRecordWithControl(record,
 		func(control *service.Control) {
 			control.AlwaysSend = true
@@ -750,14 +988,23 @@ func (h *Handler) handleRun(record *service.Record) {
 }
 
 func (h *Handler) handleConfig(record *service.Record) {
-	h.sendRecord(record)
-}
-
-func (h *Handler) handleAlert(record *service.Record) {
+	if !h.dedupeConfig(record.GetConfig()) {
+		return
+	}
 	h.sendRecord(record)
 }
 
 func (h *Handler) handleExit(record *service.Record, exit *service.RunExitRecord) {
+	// the other writers of a shared run are still logging to it
+	if h.exitWriter(record) {
+		return
+	}
+
+	h.exited = true
+
+	// log the rows appended to the tables since they were last committed
+	h.commitTables(record)
+
 	// stop the run timer and set the runtime
 	h.timer.Pause()
 	runtime := int32(h.timer.Elapsed().Seconds())
@@ -835,6 +1082,59 @@ func (h *Handler) handleFileTransferInfo(record *service.Record) {
 	h.filesInfoHandler.Handle(record)
 }
 
+// handleInternalMessages returns the pending warnings, each warning is only
+// returned once
+func (h *Handler) handleInternalMessages(response *service.Response) {
+	response.ResponseType = &service.Response_InternalMessagesResponse{
+		InternalMessagesResponse: &service.InternalMessagesResponse{
+			Messages: h.internalMessages,
+		},
+	}
+	h.internalMessages = &service.InternalMessages{}
+}
+
+// handleStoreStatus adds a warning for the store errors reported by the
+// writer, so that the user notices that the run is not fully persisted
+func (h *Handler) handleStoreStatus(status *service.StoreStatusRequest) {
+	failed := "Failed to write to the run's transaction log"
+	if status.GetErrors() > 0 {
+		failed = fmt.Sprintf("Failed to write %d record(s) to the run's transaction log",
+			status.GetErrors())
+	}
+	h.internalMessages.Warning = append(h.internalMessages.Warning, fmt.Sprintf(
+		"%s (%s), the run may not be fully saved locally. Check the available disk space.",
+		failed, status.GetError()))
+}
+
+// handleCircuitBreaker adds a warning for the requests to the backend that
+// are paused by the sender
+func (h *Handler) handleCircuitBreaker(status *service.CircuitBreakerRequest) {
+	cooldown := time.Duration(status.GetCooldownSeconds() * float64(time.Second))
+	h.internalMessages.Warning = append(h.internalMessages.Warning, fmt.Sprintf(
+		"The W&B server is unreachable after %d failed requests (%s), retrying every %v."+
+			" The run data is kept locally and uploaded once the server is reachable again.",
+		status.GetFailures(), status.GetError(), cooldown))
+}
+
+// handleRunStopped records that the run was stopped from the UI
+func (h *Handler) handleRunStopped() {
+	h.logger.Info("handler: the run was stopped from the UI")
+	h.runStopped = true
+}
+
+// handleStopStatus tells the client whether the run was stopped, from the UI
+// or by a stop condition, the client then cancels the user process, and
+// whether it is preempting
+func (h *Handler) handleStopStatus(response *service.Response) {
+	response.ResponseType = &service.Response_StopStatusResponse{
+		StopStatusResponse: &service.StopStatusResponse{
+			RunShouldStop: h.runStopped,
+			RunPreempting: h.preempting,
+			StopReason:    h.stopReason,
+		},
+	}
+}
+
 func (h *Handler) handleSync(record *service.Record) {
 	h.sendRecord(record)
 }
@@ -843,6 +1143,37 @@ func (h *Handler) handleSenderRead(record *service.Record) {
 	h.sendRecord(record)
 }
 
+// handleRunMove passes the move of the run to the sender, which responds
+// with the run at its new location, or fails if the run is offline
+func (h *Handler) handleRunMove(record *service.Record) {
+	h.sendRecordWithControl(record,
+		func(control *service.Control) {
+			control.AlwaysSend = true
+		},
+	)
+}
+
+// handleGoOnline passes the request to the writer, which forwards the records
+// stored while the run was offline once the sender is online
+func (h *Handler) handleGoOnline(record *service.Record) {
+	h.online = true
+	h.sendRecordWithControl(record,
+		func(control *service.Control) {
+			control.AlwaysSend = true
+		},
+	)
+}
+
+// handlePreflight passes the request to the sender, which made the checks of
+// the connection to the backend
+func (h *Handler) handlePreflight(record *service.Record) {
+	h.sendRecordWithControl(record,
+		func(control *service.Control) {
+			control.AlwaysSend = true
+		},
+	)
+}
+
 func (h *Handler) handleTelemetry(record *service.Record) {
 	h.sendRecord(record)
 }
@@ -895,6 +1226,9 @@ func (h *Handler) sendSummary() {
 			Key: key, ValueJson: value,
 		})
 	}
+	for key := range h.summaryHandler.summaryRemoved {
+		summaryRecord.Remove = append(summaryRecord.Remove, &service.SummaryItem{Key: key})
+	}
 
 	record := &service.Record{
 		RecordType: &service.Record_Summary{
@@ -904,6 +1238,7 @@ func (h *Handler) sendSummary() {
 	h.sendRecord(record)
 	// reset delta summary
 	clear(h.summaryHandler.summaryDelta)
+	clear(h.summaryHandler.summaryRemoved)
 }
 
 func (h *Handler) handleSummary(_ *service.Record, summary *service.SummaryRecord) {
@@ -919,8 +1254,9 @@ func (h *Handler) handleSummary(_ *service.Record, summary *service.SummaryRecor
 		Key: "_wandb", ValueJson: fmt.Sprintf(`{"runtime": %d}`, runtime),
 	})
 
-	summaryRecord := corelib.ConsolidateSummaryItems(h.summaryHandler.consolidatedSummary, summary.Update)
-	h.summaryHandler.updateSummaryDelta(summaryRecord)
+	if err := h.summaryHandler.applySummary(summary); err != nil {
+		h.logger.CaptureError("error updating summary", err)
+	}
 }
 
 func (h *Handler) handleTBrecord(record *service.Record) {
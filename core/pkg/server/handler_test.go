@@ -2,6 +2,16 @@ package server_test
 
 import (
 	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
 
 	"github.com/wandb/wandb/core/pkg/observability"
 	server "github.com/wandb/wandb/core/pkg/server"
@@ -36,3 +46,664 @@ func makeHandler(
 
 	return h
 }
+
+func TestHandleStoreStatus(t *testing.T) {
+	inChan, loopbackChan := makeInboundChannels()
+	fwdChan, outChan := makeOutboundChannels()
+	makeHandler(inChan, loopbackChan, fwdChan, outChan, false)
+
+	internalMessages := &service.Record{RecordType: &service.Record_Request{Request: &service.Request{
+		RequestType: &service.Request_InternalMessages{InternalMessages: &service.InternalMessagesRequest{}},
+	}}}
+	inChan <- &service.Record{RecordType: &service.Record_Request{Request: &service.Request{
+		RequestType: &service.Request_StoreStatus{StoreStatus: &service.StoreStatusRequest{
+			Error:  "no space left on device",
+			Errors: 2,
+		}},
+	}}}
+	inChan <- internalMessages
+	warnings := (<-outChan).GetResponse().GetInternalMessagesResponse().GetMessages().GetWarning()
+	assert.Len(t, warnings, 1)
+	assert.Contains(t, warnings[0], "Failed to write 2 record(s)")
+	assert.Contains(t, warnings[0], "no space left on device")
+
+	// each warning is only returned once
+	inChan <- internalMessages
+	warnings = (<-outChan).GetResponse().GetInternalMessagesResponse().GetMessages().GetWarning()
+	assert.Empty(t, warnings)
+	close(inChan)
+}
+
+func TestHandleCircuitBreaker(t *testing.T) {
+	inChan, loopbackChan := makeInboundChannels()
+	fwdChan, outChan := makeOutboundChannels()
+	makeHandler(inChan, loopbackChan, fwdChan, outChan, false)
+
+	inChan <- &service.Record{RecordType: &service.Record_Request{Request: &service.Request{
+		RequestType: &service.Request_CircuitBreaker{CircuitBreaker: &service.CircuitBreakerRequest{
+			Failures:        5,
+			CooldownSeconds: 30,
+			Error:           "connection refused",
+		}},
+	}}}
+	inChan <- &service.Record{RecordType: &service.Record_Request{Request: &service.Request{
+		RequestType: &service.Request_InternalMessages{InternalMessages: &service.InternalMessagesRequest{}},
+	}}}
+	warnings := (<-outChan).GetResponse().GetInternalMessagesResponse().GetMessages().GetWarning()
+	assert.Len(t, warnings, 1)
+	assert.Contains(t, warnings[0], "after 5 failed requests (connection refused)")
+	assert.Contains(t, warnings[0], "retrying every 30s")
+	close(inChan)
+}
+
+func TestHandleAlert(t *testing.T) {
+	inChan, loopbackChan := makeInboundChannels()
+	fwdChan, outChan := makeOutboundChannels()
+	makeHandler(inChan, loopbackChan, fwdChan, outChan, false)
+
+	alert := func(title, level string, wait int64) *service.Record {
+		return &service.Record{RecordType: &service.Record_Alert{Alert: &service.AlertRecord{
+			Title: title, Text: "text", Level: level, WaitDuration: wait,
+		}}}
+	}
+	inChan <- alert("", "INFO", 0)
+	inChan <- alert("loss", "fatal", 0)
+	inChan <- alert("loss", "warn", 60)
+	// sent within the wait duration of the first
+	inChan <- alert("loss", "WARN", 0)
+	inChan <- alert("accuracy", "", 0)
+	inChan <- alert("accuracy", "ERROR", 0)
+
+	for _, level := range []string{"WARN", "INFO", "ERROR"} {
+		assert.Equal(t, level, (<-fwdChan).GetAlert().GetLevel())
+	}
+	inChan <- &service.Record{RecordType: &service.Record_Request{Request: &service.Request{
+		RequestType: &service.Request_InternalMessages{InternalMessages: &service.InternalMessagesRequest{}},
+	}}}
+	warnings := (<-outChan).GetResponse().GetInternalMessagesResponse().GetMessages().GetWarning()
+	assert.Len(t, warnings, 2)
+	assert.Contains(t, warnings[1], `unknown level "fatal"`)
+	assert.Empty(t, fwdChan)
+	close(inChan)
+}
+
+func TestHandleRunStopped(t *testing.T) {
+	inChan, loopbackChan := makeInboundChannels()
+	fwdChan, outChan := makeOutboundChannels()
+	makeHandler(inChan, loopbackChan, fwdChan, outChan, false)
+
+	stopStatus := &service.Record{RecordType: &service.Record_Request{Request: &service.Request{
+		RequestType: &service.Request_StopStatus{StopStatus: &service.StopStatusRequest{}},
+	}}}
+	inChan <- stopStatus
+	assert.False(t, (<-outChan).GetResponse().GetStopStatusResponse().GetRunShouldStop())
+
+	inChan <- &service.Record{RecordType: &service.Record_Request{Request: &service.Request{
+		RequestType: &service.Request_RunStopped{RunStopped: &service.RunStoppedRequest{}},
+	}}}
+	inChan <- stopStatus
+	assert.True(t, (<-outChan).GetResponse().GetStopStatusResponse().GetRunShouldStop())
+	close(inChan)
+}
+
+func TestHandlePreempting(t *testing.T) {
+	inChan, loopbackChan := makeInboundChannels()
+	fwdChan, outChan := makeOutboundChannels()
+	makeHandler(inChan, loopbackChan, fwdChan, outChan, false)
+
+	// the partial history is checkpointed before the preemption is forwarded
+	inChan <- &service.Record{RecordType: &service.Record_Request{Request: &service.Request{
+		RequestType: &service.Request_PartialHistory{PartialHistory: &service.PartialHistoryRequest{
+			Item:   []*service.HistoryItem{{Key: "loss", ValueJson: "0.5"}},
+			Action: &service.HistoryAction{Flush: false},
+		}},
+	}}}
+	inChan <- &service.Record{RecordType: &service.Record_Preempting{Preempting: &service.RunPreemptingRecord{}}}
+	history := <-fwdChan
+	assert.Equal(t, "loss", history.GetHistory().GetItem()[0].GetKey())
+	preempting := <-fwdChan
+	assert.NotNil(t, preempting.GetPreempting())
+	assert.True(t, preempting.GetControl().GetAlwaysSend())
+
+	// the client learns that the run is preempting
+	inChan <- &service.Record{RecordType: &service.Record_Request{Request: &service.Request{
+		RequestType: &service.Request_StopStatus{StopStatus: &service.StopStatusRequest{}},
+	}}}
+	response := (<-outChan).GetResponse().GetStopStatusResponse()
+	assert.True(t, response.GetRunPreempting())
+	assert.False(t, response.GetRunShouldStop())
+	close(inChan)
+}
+
+func TestHandleLogArtifactOffline(t *testing.T) {
+	inChan, _ := makeInboundChannels()
+	fwdChan, outChan := makeOutboundChannels()
+	h := server.NewHandler(context.Background(),
+		observability.NewNoOpLogger(),
+		server.WithHandlerSettings(&service.Settings{XOffline: &wrapperspb.BoolValue{Value: true}}),
+		server.WithHandlerFwdChannel(fwdChan),
+		server.WithHandlerOutChannel(outChan),
+	)
+	go h.Do(inChan)
+
+	// the artifact is passed on to be stored, and the client is answered
+	// without an ID
+	record := &service.Record{
+		RecordType: &service.Record_Request{Request: &service.Request{
+			RequestType: &service.Request_LogArtifact{LogArtifact: &service.LogArtifactRequest{
+				Artifact: &service.ArtifactRecord{Name: "model"},
+			}},
+		}},
+		Uuid: "log-artifact",
+	}
+	inChan <- record
+	assert.Equal(t, "model", (<-fwdChan).GetRequest().GetLogArtifact().GetArtifact().GetName())
+	result := <-outChan
+	assert.Equal(t, "log-artifact", result.GetUuid())
+	assert.NotNil(t, result.GetResponse().GetLogArtifactResponse())
+	assert.Empty(t, result.GetResponse().GetLogArtifactResponse().GetArtifactId())
+
+	inChan <- &service.Record{RecordType: &service.Record_Artifact{Artifact: &service.ArtifactRecord{Name: "dataset"}}}
+	assert.Equal(t, "dataset", (<-fwdChan).GetArtifact().GetName())
+	close(inChan)
+}
+
+func TestHandleRunMove(t *testing.T) {
+	inChan, loopbackChan := makeInboundChannels()
+	fwdChan, outChan := makeOutboundChannels()
+	makeHandler(inChan, loopbackChan, fwdChan, outChan, false)
+
+	// the move is always passed on, the sender responds to it
+	inChan <- &service.Record{RecordType: &service.Record_Request{Request: &service.Request{
+		RequestType: &service.Request_RunMove{RunMove: &service.RunMoveRequest{Project: "other"}},
+	}}}
+	forwarded := <-fwdChan
+	assert.Equal(t, "other", forwarded.GetRequest().GetRunMove().GetProject())
+	assert.True(t, forwarded.GetControl().GetAlwaysSend())
+	assert.Empty(t, outChan)
+	close(inChan)
+}
+
+func TestHandleSummaryDebounced(t *testing.T) {
+	inChan, _ := makeInboundChannels()
+	fwdChan, outChan := makeOutboundChannels()
+	settings := &service.Settings{XDebounceSeconds: &wrapperspb.DoubleValue{Value: 0.05}}
+	h := server.NewHandler(context.Background(),
+		observability.NewNoOpLogger(),
+		server.WithHandlerSettings(settings),
+		server.WithHandlerFwdChannel(fwdChan),
+		server.WithHandlerOutChannel(outChan),
+		server.WithHandlerSummaryHandler(server.NewSummaryHandler(observability.NewNoOpLogger(), settings)),
+	)
+	go h.Do(inChan)
+
+	summary := func(value string) *service.Record {
+		return &service.Record{RecordType: &service.Record_Summary{Summary: &service.SummaryRecord{
+			Update: []*service.SummaryItem{{Key: "loss", ValueJson: value}},
+		}}}
+	}
+	loss := func(record *service.Record) string {
+		for _, item := range record.GetSummary().GetUpdate() {
+			if item.GetKey() == "loss" {
+				return item.GetValueJson()
+			}
+		}
+		return ""
+	}
+
+	// the first update is sent with the next record, the following ones
+	// once the debounce period passed, without waiting for more records
+	inChan <- summary("1")
+	inChan <- summary("2")
+	inChan <- summary("3")
+	assert.Equal(t, "1", loss(<-fwdChan))
+	select {
+	case record := <-fwdChan:
+		assert.Equal(t, "3", loss(record))
+	case <-time.After(time.Second):
+		t.Fatal("the debounced summary was not sent")
+	}
+	close(inChan)
+}
+
+func TestHandleSummaryReconciled(t *testing.T) {
+	inChan, _ := makeInboundChannels()
+	fwdChan, outChan := makeOutboundChannels()
+	settings := &service.Settings{}
+	h := server.NewHandler(context.Background(),
+		observability.NewNoOpLogger(),
+		server.WithHandlerSettings(settings),
+		server.WithHandlerFwdChannel(fwdChan),
+		server.WithHandlerOutChannel(outChan),
+		server.WithHandlerSummaryHandler(server.NewSummaryHandler(observability.NewNoOpLogger(), settings)),
+	)
+	go h.Do(inChan)
+
+	summary := func(update, remove []*service.SummaryItem) *service.Record {
+		return &service.Record{RecordType: &service.Record_Summary{Summary: &service.SummaryRecord{
+			Update: update,
+			Remove: remove,
+		}}}
+	}
+	inChan <- summary([]*service.SummaryItem{
+		{Key: "note", ValueJson: `"first try"`},
+		{NestedKey: []string{"eval", "acc"}, ValueJson: "0.9"},
+		{NestedKey: []string{"eval", "f1"}, ValueJson: "0.8"},
+	}, nil)
+	inChan <- &service.Record{RecordType: &service.Record_History{History: &service.HistoryRecord{
+		Item: []*service.HistoryItem{{Key: "loss", ValueJson: "0.5"}},
+		Step: &service.HistoryStep{Num: 0},
+	}}}
+	inChan <- summary(
+		[]*service.SummaryItem{{Key: "loss", ValueJson: "0.1"}},
+		[]*service.SummaryItem{{Key: "note"}, {NestedKey: []string{"eval", "f1"}}},
+	)
+	close(inChan)
+
+	// the summary records sent, applied in order, give the reconciled summary
+	consolidated := map[string]string{}
+	for record := range fwdChan {
+		for _, item := range record.GetSummary().GetRemove() {
+			delete(consolidated, item.GetKey())
+		}
+		for _, item := range record.GetSummary().GetUpdate() {
+			assert.Empty(t, item.GetNestedKey())
+			consolidated[item.GetKey()] = item.GetValueJson()
+		}
+	}
+	assert.NotContains(t, consolidated, "note")
+	assert.JSONEq(t, `{"acc":0.9}`, consolidated["eval"])
+	assert.Equal(t, "0.1", consolidated["loss"])
+}
+
+func TestHandleGlobMetric(t *testing.T) {
+	inChan, _ := makeInboundChannels()
+	fwdChan, outChan := makeOutboundChannels()
+	h := server.NewHandler(context.Background(),
+		observability.NewNoOpLogger(),
+		server.WithHandlerSettings(&service.Settings{}),
+		server.WithHandlerFwdChannel(fwdChan),
+		server.WithHandlerOutChannel(outChan),
+		server.WithHandlerMetricHandler(server.NewMetricHandler()),
+	)
+	go h.Do(inChan)
+
+	glob := func(pattern string, metric *service.MetricRecord) *service.Record {
+		metric.GlobName = pattern
+		return &service.Record{RecordType: &service.Record_Metric{Metric: metric}}
+	}
+	history := func(step int64, key string) *service.Record {
+		return &service.Record{RecordType: &service.Record_History{History: &service.HistoryRecord{
+			Item: []*service.HistoryItem{{Key: key, ValueJson: "1"}},
+			Step: &service.HistoryStep{Num: step},
+		}}}
+	}
+	inChan <- glob("train/*", &service.MetricRecord{StepMetric: "epoch"})
+	inChan <- history(0, "train/layer1/loss")
+	inChan <- history(1, "val")
+	// the keys that matched no glob so far are matched by a later one
+	inChan <- glob("*", &service.MetricRecord{Options: &service.MetricOptions{Hidden: true}})
+	inChan <- history(2, "val")
+	inChan <- history(3, "train/acc")
+	close(inChan)
+
+	metrics := map[string]*service.MetricRecord{}
+	for record := range fwdChan {
+		if metric := record.GetMetric(); metric.GetName() != "" {
+			metrics[metric.GetName()] = metric
+		}
+	}
+	// a wildcard matches the slashes, the first glob defined applies
+	assert.Equal(t, "epoch", metrics["train/layer1/loss"].GetStepMetric())
+	assert.Equal(t, "epoch", metrics["train/acc"].GetStepMetric())
+	assert.True(t, metrics["val"].GetOptions().GetHidden())
+	assert.Contains(t, metrics, "epoch")
+}
+
+func TestHandleSystemMetricsWindow(t *testing.T) {
+	stats := func(items map[string]string) *service.Record {
+		record := &service.Record{RecordType: &service.Record_Stats{Stats: &service.StatsRecord{
+			StatsType: service.StatsRecord_SYSTEM,
+		}}}
+		for key, value := range items {
+			record.GetStats().Item = append(record.GetStats().Item, &service.StatsItem{Key: key, ValueJson: value})
+		}
+		return record
+	}
+	values := func(record *service.Record) map[string]string {
+		items := map[string]string{}
+		for _, item := range record.GetStats().GetItem() {
+			items[item.GetKey()] = item.GetValueJson()
+		}
+		return items
+	}
+
+	inChan, _ := makeInboundChannels()
+	fwdChan, outChan := makeOutboundChannels()
+	window, err := server.NewStatsWindow(50*time.Millisecond, server.StatsWindowMean)
+	assert.NoError(t, err)
+	h := server.NewHandler(context.Background(),
+		observability.NewNoOpLogger(),
+		server.WithHandlerSettings(&service.Settings{}),
+		server.WithHandlerFwdChannel(fwdChan),
+		server.WithHandlerOutChannel(outChan),
+		server.WithHandlerStatsWindow(window),
+	)
+	go h.Do(inChan)
+
+	// the samples of the window are forwarded as one record once it is over
+	inChan <- stats(map[string]string{"cpu": "1", "gpu.0.temp": "40"})
+	inChan <- stats(map[string]string{"cpu": "2"})
+	inChan <- stats(map[string]string{"cpu": "6", "disk": `"n/a"`})
+	select {
+	case record := <-fwdChan:
+		assert.Equal(t, map[string]string{"cpu": "3", "gpu.0.temp": "40"}, values(record))
+		assert.True(t, record.GetControl().GetAlwaysSend())
+	case <-time.After(time.Second):
+		t.Fatal("the window of the system metrics was not forwarded")
+	}
+	close(inChan)
+
+	window, err = server.NewStatsWindow(time.Minute, server.StatsWindowMax)
+	assert.NoError(t, err)
+	window.Add(stats(map[string]string{"cpu": "1"}).GetStats(), time.Now())
+	window.Add(stats(map[string]string{"cpu": "6"}).GetStats(), time.Now())
+	window.Add(stats(map[string]string{"cpu": "2"}).GetStats(), time.Now())
+	assert.Equal(t, map[string]string{"cpu": "6"}, values(window.Flush()))
+	assert.Nil(t, window.Flush())
+
+	_, err = server.NewStatsWindow(time.Minute, "median")
+	assert.Error(t, err)
+}
+
+func TestHandleOutputRawConsole(t *testing.T) {
+	start := time.Now()
+	output := func(outputType service.OutputRawRecord_OutputType, line string, offset int) *service.Record {
+		return &service.Record{RecordType: &service.Record_OutputRaw{OutputRaw: &service.OutputRawRecord{
+			OutputType: outputType,
+			Timestamp:  timestamppb.New(start.Add(time.Duration(offset) * time.Millisecond)),
+			Line:       line,
+		}}}
+	}
+	lines := func(records []*service.Record) []string {
+		var lines []string
+		for _, record := range records {
+			lines = append(lines, record.GetOutputRaw().GetOutputType().String()+" "+record.GetOutputRaw().GetLine())
+		}
+		return lines
+	}
+
+	inChan, _ := makeInboundChannels()
+	fwdChan, outChan := makeOutboundChannels()
+	console, err := server.NewConsole(server.ConsoleANSIStrip, 4, 50*time.Millisecond)
+	assert.NoError(t, err)
+	h := server.NewHandler(context.Background(),
+		observability.NewNoOpLogger(),
+		server.WithHandlerSettings(&service.Settings{}),
+		server.WithHandlerFwdChannel(fwdChan),
+		server.WithHandlerOutChannel(outChan),
+		server.WithHandlerConsole(console),
+	)
+	go h.Do(inChan)
+
+	inChan <- output(service.OutputRawRecord_STDOUT, "ab\x1b[31mcdef\x1b[0m", 2)
+	inChan <- output(service.OutputRawRecord_STDERR, "err", 1)
+	inChan <- output(service.OutputRawRecord_STDOUT, "x", 3)
+	inChan <- output(service.OutputRawRecord_STDOUT, "\x1b[2K", 4)
+	inChan <- output(service.OutputRawRecord_STDOUT, "x", 5)
+	inChan <- output(service.OutputRawRecord_STDOUT, "x", 6)
+	inChan <- output(service.OutputRawRecord_STDOUT, "y", 7)
+
+	// the lines are forwarded in the order of their timestamps once the
+	// delay is over
+	var records []*service.Record
+	for len(records) < 6 {
+		select {
+		case record := <-fwdChan:
+			records = append(records, record)
+		case <-time.After(time.Second):
+			t.Fatal("the console lines were not forwarded")
+		}
+	}
+	assert.Equal(t, []string{
+		"STDERR err",
+		"STDOUT abcd",
+		"STDOUT ef",
+		"STDOUT x",
+		"STDOUT (previous line repeated 2 times)",
+		"STDOUT y",
+	}, lines(records))
+	close(inChan)
+
+	// a line repeated at the end of a stream is counted once the streams are
+	// flushed at the end of the run
+	console, err = server.NewConsole("", 0, 0)
+	assert.NoError(t, err)
+	console.Add(output(service.OutputRawRecord_STDOUT, "\x1b[32mz\x1b[0m", 1), start)
+	console.Add(output(service.OutputRawRecord_STDOUT, "\x1b[32mz\x1b[0m", 2), start)
+	assert.Equal(t, []string{"STDOUT \x1b[32mz\x1b[0m"}, lines(console.Flush(false)))
+	console.Add(output(service.OutputRawRecord_STDOUT, "\x1b[32mz\x1b[0m", 3), start)
+	assert.Empty(t, console.Flush(false))
+	assert.Equal(t, []string{"STDOUT (previous line repeated 2 times)"}, lines(console.Flush(true)))
+
+	_, err = server.NewConsole("html", 0, 0)
+	assert.Error(t, err)
+}
+
+func TestHandleSharedRunWriters(t *testing.T) {
+	inChan, _ := makeInboundChannels()
+	fwdChan, outChan := makeOutboundChannels()
+	settings := &service.Settings{XShared: wrapperspb.Bool(true)}
+	logger := observability.NewNoOpLogger()
+	h := server.NewHandler(context.Background(), logger,
+		server.WithHandlerSettings(settings),
+		server.WithHandlerFwdChannel(fwdChan),
+		server.WithHandlerOutChannel(outChan),
+		server.WithHandlerSummaryHandler(server.NewSummaryHandler(logger, settings)),
+	)
+	go h.Do(inChan)
+
+	writer := func(rank string) *service.Control {
+		return &service.Control{ConnectionId: "connection-" + rank, Rank: rank}
+	}
+	partialHistory := func(rank, key, value string, flush bool) *service.Record {
+		return &service.Record{
+			RecordType: &service.Record_Request{Request: &service.Request{
+				RequestType: &service.Request_PartialHistory{PartialHistory: &service.PartialHistoryRequest{
+					Item:   []*service.HistoryItem{{Key: key, ValueJson: value}},
+					Action: &service.HistoryAction{Flush: flush},
+				}},
+			}},
+			Control: writer(rank),
+		}
+	}
+	config := func(rank, value string) *service.Record {
+		return &service.Record{
+			RecordType: &service.Record_Config{Config: &service.ConfigRecord{
+				Update: []*service.ConfigItem{{Key: "lr", ValueJson: value}},
+			}},
+			Control: writer(rank),
+		}
+	}
+	exit := func(rank string) *service.Record {
+		return &service.Record{
+			RecordType: &service.Record_Exit{Exit: &service.RunExitRecord{}},
+			Control:    writer(rank),
+		}
+	}
+	next := func() *service.Record {
+		for {
+			select {
+			case record := <-fwdChan:
+				if record.GetSummary() == nil {
+					return record
+				}
+			case <-time.After(time.Second):
+				t.Fatal("no record was forwarded")
+				return nil
+			}
+		}
+	}
+	historyItems := func(record *service.Record) map[string]string {
+		items := map[string]string{}
+		for _, item := range record.GetHistory().GetItem() {
+			if item.GetKey() != "_runtime" {
+				items[item.GetKey()] = item.GetValueJson()
+			}
+		}
+		return items
+	}
+
+	// the partial history of the writers is not mixed
+	inChan <- partialHistory("0", "a", "1", false)
+	inChan <- partialHistory("1", "a", "2", true)
+	inChan <- partialHistory("0", "b", "3", true)
+	assert.Equal(t, map[string]string{"a": "2"}, historyItems(next()))
+	assert.Equal(t, map[string]string{"a": "1", "b": "3"}, historyItems(next()))
+
+	// the config set by each writer is sent once
+	inChan <- config("0", "0.1")
+	inChan <- config("1", "0.1")
+	inChan <- config("1", "0.2")
+	assert.Equal(t, "0.1", next().GetConfig().GetUpdate()[0].GetValueJson())
+	assert.Equal(t, "0.2", next().GetConfig().GetUpdate()[0].GetValueJson())
+
+	// the run exits with its last writer
+	inChan <- exit("1")
+	select {
+	case result := <-outChan:
+		assert.NotNil(t, result.GetExitResult())
+		assert.Equal(t, "connection-1", result.GetControl().GetConnectionId())
+	case <-time.After(time.Second):
+		t.Fatal("the exit of the writer was not answered")
+	}
+	inChan <- exit("0")
+	assert.NotNil(t, next().GetExit())
+	close(inChan)
+}
+
+func TestHandleTable(t *testing.T) {
+	filesDir := t.TempDir()
+	inChan, _ := makeInboundChannels()
+	fwdChan, outChan := makeOutboundChannels()
+	h := server.NewHandler(context.Background(),
+		observability.NewNoOpLogger(),
+		server.WithHandlerSettings(&service.Settings{
+			RunId:            &wrapperspb.StringValue{Value: "run1"},
+			FilesDir:         &wrapperspb.StringValue{Value: filesDir},
+			TmpDir:           &wrapperspb.StringValue{Value: t.TempDir()},
+			XTableBufferRows: &wrapperspb.Int32Value{Value: 2},
+		}),
+		server.WithHandlerFwdChannel(fwdChan),
+		server.WithHandlerOutChannel(outChan),
+	)
+	go h.Do(inChan)
+
+	inChan <- &service.Record{RecordType: &service.Record_Table{Table: &service.TableRecord{
+		Key:      "preds",
+		Columns:  []string{"id", "score"},
+		Dtypes:   []string{"string", "number"},
+		RowsJson: []string{`["a", 0.5]`, `["b", NaN]`, `["c", "high"]`},
+	}}}
+	inChan <- &service.Record{RecordType: &service.Record_Table{Table: &service.TableRecord{
+		Key:      "preds",
+		RowsJson: []string{`["d", 1]`, `["e", 2]`},
+		Commit:   true,
+	}}}
+	// a later record must have the columns of the table
+	inChan <- &service.Record{RecordType: &service.Record_Table{Table: &service.TableRecord{
+		Key:      "preds",
+		Columns:  []string{"id"},
+		RowsJson: []string{`["f"]`},
+	}}}
+	inChan <- &service.Record{RecordType: &service.Record_Request{Request: &service.Request{
+		RequestType: &service.Request_PartialHistory{PartialHistory: &service.PartialHistoryRequest{
+			Item:   []*service.HistoryItem{{Key: "loss", ValueJson: "1"}},
+			Action: &service.HistoryAction{Flush: true},
+		}},
+	}}}
+
+	artifact := (<-fwdChan).GetArtifact()
+	assert.Equal(t, "run-run1-preds", artifact.GetName())
+	assert.Equal(t, "run_table", artifact.GetType())
+	entries := artifact.GetManifest().GetContents()
+	assert.Len(t, entries, 1)
+	assert.Equal(t, "preds.table.json", entries[0].GetPath())
+
+	contents, err := os.ReadFile(entries[0].GetLocalPath())
+	assert.NoError(t, err)
+	assert.Equal(t, entries[0].GetSize(), int64(len(contents)))
+	assert.JSONEq(t,
+		`{"_type":"table","columns":["id","score"],"data":[["a",0.5],["b",null],["d",1],["e",2]],"ncols":2,"nrows":4}`,
+		string(contents))
+
+	var value map[string]interface{}
+	for _, item := range (<-fwdChan).GetHistory().GetItem() {
+		if item.GetKey() == "preds" {
+			assert.NoError(t, json.Unmarshal([]byte(item.GetValueJson()), &value))
+		}
+	}
+	assert.Equal(t, "table-file", value["_type"])
+	assert.Equal(t, float64(4), value["nrows"])
+	assert.FileExists(t, filepath.Join(filesDir, value["path"].(string)))
+
+	inChan <- &service.Record{RecordType: &service.Record_Request{Request: &service.Request{
+		RequestType: &service.Request_InternalMessages{InternalMessages: &service.InternalMessagesRequest{}},
+	}}}
+	warnings := (<-outChan).GetResponse().GetInternalMessagesResponse().GetMessages().GetWarning()
+	assert.Len(t, warnings, 2)
+	assert.Contains(t, warnings[0], `the value "high" of the column score is not a number`)
+	assert.Contains(t, warnings[1], "are not the columns")
+	close(inChan)
+}
+
+func TestHandleFlowCredit(t *testing.T) {
+	var free atomic.Int32
+	free.Store(5)
+	inChan, _ := makeInboundChannels()
+	fwdChan, outChan := makeOutboundChannels()
+	h := server.NewHandler(context.Background(),
+		observability.NewNoOpLogger(),
+		server.WithHandlerSettings(&service.Settings{
+			XFlowControlCredits: &wrapperspb.Int32Value{Value: 4},
+		}),
+		server.WithHandlerFwdChannel(fwdChan),
+		server.WithHandlerOutChannel(outChan),
+		server.WithHandlerFlowControl(server.NewFlowControl(func() int { return int(free.Load()) })),
+	)
+	go h.Do(inChan)
+
+	credit := func(want int32) *service.Record {
+		return &service.Record{RecordType: &service.Record_Request{Request: &service.Request{
+			RequestType: &service.Request_FlowCredit{FlowCredit: &service.FlowCreditRequest{Want: want}},
+		}}}
+	}
+	credits := func() int32 {
+		return (<-outChan).GetResponse().GetFlowCreditResponse().GetCredits()
+	}
+	inChan <- credit(3)
+	assert.Equal(t, int32(3), credits())
+	// the default of the settings, up to the capacity left
+	inChan <- credit(0)
+	assert.Equal(t, int32(4), credits())
+	free.Store(2)
+	inChan <- credit(0)
+	assert.Equal(t, int32(2), credits())
+
+	// the credits are granted once there is capacity again
+	free.Store(0)
+	inChan <- credit(3)
+	select {
+	case <-outChan:
+		t.Fatal("credits granted without capacity")
+	case <-time.After(50 * time.Millisecond):
+	}
+	free.Store(1)
+	assert.Equal(t, int32(1), credits())
+
+	// the requests left are granted in full once the stream closes
+	free.Store(0)
+	inChan <- credit(3)
+	close(inChan)
+	assert.Equal(t, int32(3), credits())
+}
@@ -189,6 +189,8 @@ func (h *Handler) flushHistory(history *service.HistoryRecord) {
 	if history.GetItem() == nil {
 		return
 	}
+	h.validateHistory(history)
+	h.guardHistoryKeys(history)
 
 	// adds internal history items to the history record
 	// these items are used for internal bookkeeping and are not sent by the user
@@ -212,6 +214,9 @@ func (h *Handler) flushHistory(history *service.HistoryRecord) {
 		)
 	}
 
+	history.Item = append(history.Item, h.deriveMetrics(history.GetItem())...)
+	h.evalStopConditions(history)
+
 	// handles all history items. It is responsible for matching current history
 	// items with defined metrics, and creating new metrics if needed. It also handles step metric in case
 	// it needs to be synced, but not part of the history record.
@@ -239,7 +244,7 @@ func (h *Handler) flushHistory(history *service.HistoryRecord) {
 	if h.summaryHandler == nil {
 		return
 	}
-	summary := corelib.ConsolidateSummaryItems(h.summaryHandler.consolidatedSummary, history.GetItem())
+	summary := corelib.ConsolidateSummaryItems(h.summaryHandler.consolidatedSummary, h.summaryItems(history.GetItem()))
 	h.summaryHandler.updateSummaryDelta(summary)
 }
 
@@ -247,6 +252,12 @@ func (h *Handler) flushHistory(history *service.HistoryRecord) {
 // It is responsible for handling the history record internally, processing it,
 // and forwarding it to the Writer.
 func (h *Handler) handleHistory(history *service.HistoryRecord) {
+	// the partial history being collected must not be dropped
+	if h.activeHistory != nil && h.activeHistory.flush != nil {
+		h.coalesceHistory(history)
+		return
+	}
+
 	// TODO replace history encoding with a map, this will make it easier to handle history
 	h.activeHistory = NewActiveHistory(
 		WithStep(history.GetStep().GetNum()),
@@ -258,6 +269,30 @@ func (h *Handler) handleHistory(history *service.HistoryRecord) {
 	h.activeHistory.Flush()
 }
 
+// coalesceHistory merges a history record into the partial history being
+// collected. A record at the current step completes the row of the step, the
+// row of an earlier step is flushed first, like for a partial history request
+// that is flushed.
+func (h *Handler) coalesceHistory(history *service.HistoryRecord) {
+	if h.settings.GetXShared().GetValue() {
+		h.activeHistory.UpdateValues(history.GetItem())
+		h.activeHistory.Flush()
+		return
+	}
+
+	step, ok := h.historyStep(history.GetStep().GetNum())
+	if !ok {
+		return
+	}
+	if step > h.activeHistory.GetStep().Num {
+		h.activeHistory.Flush()
+		h.activeHistory.UpdateStep(step)
+	}
+	h.activeHistory.UpdateValues(history.GetItem())
+	h.activeHistory.Flush()
+	h.activeHistory.UpdateStep(step + 1)
+}
+
 // imputeStepMetric imputes a step metric if it needs to be synced, but not part of the history record.
 func (h *Handler) imputeStepMetric(item *service.HistoryItem) *service.HistoryItem {
 
@@ -275,8 +310,13 @@ func (h *Handler) imputeStepMetric(item *service.HistoryItem) *service.HistoryIt
 		return nil
 	}
 
-	// we use the summary value of the metric as the algorithm for imputing the step metric
-	if value, ok := h.summaryHandler.consolidatedSummary[key]; ok {
+	// we use the summary value of the metric as the algorithm for imputing the step metric,
+	// the last value of a step metric with a summary of aggregations
+	value, ok := h.summaryHandler.consolidatedSummary[key]
+	if summary, aggregated := h.metricHandler.summaries[key]; aggregated {
+		value, ok = summary.lastJSON, true
+	}
+	if ok {
 		// TODO: add nested key support
 		hi := &service.HistoryItem{
 			Key:       key,
@@ -363,7 +403,7 @@ func (h *Handler) handlePartialHistorySync(request *service.PartialHistoryReques
 	if h.activeHistory == nil {
 		var step int64
 		switch {
-		case request.Step != nil:
+		case request.Step != nil && h.settings.GetXHistoryStepPolicy().GetValue() != historyStepsRewrite:
 			step = request.Step.Num
 		case h.runRecord != nil:
 			step = h.runRecord.StartingStep
@@ -403,7 +443,8 @@ func (h *Handler) handlePartialHistorySync(request *service.PartialHistoryReques
 	//		than the current step number, the current history record is flushed
 	//		and a new history record is created.
 	// - If the step number in the request is less than the current step number,
-	//		we ignore the request and log a warning.
+	//		the policy of _history_step_policy applies: by default we ignore
+	//		the request and warn the user.
 	// 		NOTE: the server requires the steps of the history records
 	// 		to be monotonically increasing.
 	// -  If the step number in the request matches the current step number, the
@@ -416,15 +457,13 @@ func (h *Handler) handlePartialHistorySync(request *service.PartialHistoryReques
 	//	equivalent to step being equal to the current step number and a flush flag
 	//	being set to true.
 	if request.GetStep() != nil {
-		step := request.Step.GetNum()
-		current := h.activeHistory.GetStep().Num
-		if step > current {
+		step, ok := h.historyStep(request.Step.GetNum())
+		if !ok {
+			return
+		}
+		if step > h.activeHistory.GetStep().Num {
 			h.activeHistory.Flush()
 			h.activeHistory.UpdateStep(step)
-		} else if step < current {
-			h.logger.CaptureWarn("received history record for a step that has already been received",
-				"received", step, "current", current)
-			return
 		}
 	}
 
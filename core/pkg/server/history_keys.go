@@ -0,0 +1,102 @@
+package server
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/segmentio/encoding/json"
+
+	"github.com/wandb/wandb/core/pkg/service"
+)
+
+// defaultHistoryMaxKeys is the number of distinct keys of the history above
+// which the keys are guarded, unless _history_max_keys is set
+const defaultHistoryMaxKeys = 10000
+
+// The policies for the keys of the history above its maximum, set with
+// _history_key_overflow
+const (
+	// historyKeysWarn logs the keys above the maximum, with a warning, the
+	// default
+	historyKeysWarn = "warn"
+
+	// historyKeysFold logs the keys above the maximum as the entries of a
+	// single map-valued metric, historyOverflowKey
+	historyKeysFold = "fold"
+)
+
+// historyOverflowKey is the metric the keys above the maximum are folded into
+const historyOverflowKey = "history_overflow"
+
+// historyItemKey returns the key of a history item, its nested key joined by
+// dots if it is nested
+func historyItemKey(item *service.HistoryItem) string {
+	if item.GetKey() != "" {
+		return item.GetKey()
+	}
+	return strings.Join(item.GetNestedKey(), ".")
+}
+
+// guardHistoryKeys tracks the distinct keys logged to the history, the
+// internal ones aside. Once there are more than the maximum, it warns the
+// client, and folds the items of the keys above it if the policy says so.
+func (h *Handler) guardHistoryKeys(history *service.HistoryRecord) {
+	maxKeys := defaultHistoryMaxKeys
+	if setting := h.settings.GetXHistoryMaxKeys(); setting != nil {
+		maxKeys = int(setting.GetValue())
+	}
+	if maxKeys <= 0 {
+		return
+	}
+	if h.historyKeys == nil {
+		h.historyKeys = make(map[string]bool)
+	}
+	fold := h.settings.GetXHistoryKeyOverflow().GetValue() == historyKeysFold
+
+	items := make([]*service.HistoryItem, 0, len(history.GetItem()))
+	overflow := make(map[string]json.RawMessage)
+	for _, item := range history.GetItem() {
+		key := historyItemKey(item)
+		if strings.HasPrefix(key, "_") || key == historyOverflowKey || h.historyKeys[key] {
+			items = append(items, item)
+			continue
+		}
+		if len(h.historyKeys) < maxKeys {
+			h.historyKeys[key] = true
+			items = append(items, item)
+			continue
+		}
+		h.warnHistoryKeys(maxKeys, fold)
+		if !fold {
+			items = append(items, item)
+			continue
+		}
+		overflow[key] = json.RawMessage(item.GetValueJson())
+	}
+	if len(overflow) > 0 {
+		encoded, err := json.Marshal(overflow)
+		if err != nil {
+			h.logger.CaptureError("handler: failed to fold the history keys", err)
+		} else {
+			items = append(items, &service.HistoryItem{Key: historyOverflowKey, ValueJson: string(encoded)})
+		}
+	}
+	history.Item = items
+}
+
+// warnHistoryKeys warns the client, once per run, that it logged more
+// distinct keys than the maximum
+func (h *Handler) warnHistoryKeys(maxKeys int, fold bool) {
+	if h.keysWarned {
+		return
+	}
+	h.keysWarned = true
+	h.logger.CaptureWarn("handler: too many distinct history keys", "max", maxKeys)
+	outcome := "they are logged anyway, set _history_key_overflow to fold them into a single metric"
+	if fold {
+		outcome = fmt.Sprintf("the new keys are logged as the entries of %s", historyOverflowKey)
+	}
+	h.internalMessages.Warning = append(h.internalMessages.Warning, fmt.Sprintf(
+		"More than %d distinct keys were logged to the history, e.g. by logging a dict keyed"+
+			" by sample, %s.", maxKeys, outcome))
+}
@@ -0,0 +1,232 @@
+package server
+
+import (
+	"strings"
+	"time"
+
+	"github.com/segmentio/encoding/json"
+
+	"github.com/wandb/wandb/core/internal/clients"
+	"github.com/wandb/wandb/core/pkg/service"
+)
+
+const (
+	// historySamplingPolicy is the name of the sampling policy, it is recorded
+	// in the config of the downsampled runs
+	historySamplingPolicy = "every_nth_min_max"
+
+	// defaultHistorySamplingEvery is how many rows of the history are sampled
+	// into one, plus their extremes
+	defaultHistorySamplingEvery = 10
+
+	// defaultHistoryOverload is how long the file stream must stay overloaded
+	// before the history is sampled, and then not overloaded before it is not
+	defaultHistoryOverload = 30 * time.Second
+
+	// historyOverloadThreshold is the load of the file stream over which it
+	// is overloaded
+	historyOverloadThreshold = 0.75
+)
+
+// HistorySampler downsamples the history of a run. In each window of N rows,
+// it keeps the Nth row and the rows with the min or the max of a metric.
+type HistorySampler struct {
+	every  int
+	window []*service.HistoryRecord
+}
+
+// NewHistorySampler returns a sampler that keeps every Nth row of the history
+func NewHistorySampler(every int) *HistorySampler {
+	return &HistorySampler{every: every}
+}
+
+// Every returns the size of the windows of the sampler
+func (s *HistorySampler) Every() int {
+	return s.every
+}
+
+// Add adds a row to the window, it returns the sampled rows once the window
+// is full
+func (s *HistorySampler) Add(history *service.HistoryRecord) []*service.HistoryRecord {
+	s.window = append(s.window, history)
+	if len(s.window) < s.every {
+		return nil
+	}
+	return s.Flush()
+}
+
+// Flush returns the sampled rows of the window so far, and empties it. The
+// last row is kept like the Nth row of a full window.
+func (s *HistorySampler) Flush() []*service.HistoryRecord {
+	if len(s.window) == 0 {
+		return nil
+	}
+	keep := make([]bool, len(s.window))
+	keep[len(s.window)-1] = true
+	for _, index := range windowExtremes(s.window) {
+		keep[index] = true
+	}
+
+	var sampled []*service.HistoryRecord
+	for i, history := range s.window {
+		if keep[i] {
+			sampled = append(sampled, history)
+		}
+	}
+	s.window = nil
+	return sampled
+}
+
+// windowExtremes returns the indexes of the rows with the min or the max of
+// each numeric metric of the window, the internal keys are ignored
+func windowExtremes(window []*service.HistoryRecord) []int {
+	type extremes struct {
+		min, max           float64
+		minIndex, maxIndex int
+	}
+	metrics := make(map[string]*extremes)
+	for i, history := range window {
+		for _, item := range history.GetItem() {
+			if strings.HasPrefix(item.GetKey(), "_") {
+				continue
+			}
+			var value float64
+			if err := json.Unmarshal([]byte(item.GetValueJson()), &value); err != nil {
+				continue
+			}
+			metric, ok := metrics[item.GetKey()]
+			if !ok {
+				metrics[item.GetKey()] = &extremes{min: value, max: value, minIndex: i, maxIndex: i}
+				continue
+			}
+			if value < metric.min {
+				metric.min, metric.minIndex = value, i
+			}
+			if value > metric.max {
+				metric.max, metric.maxIndex = value, i
+			}
+		}
+	}
+	indexes := make([]int, 0, 2*len(metrics))
+	for _, metric := range metrics {
+		indexes = append(indexes, metric.minIndex, metric.maxIndex)
+	}
+	return indexes
+}
+
+// OverloadDetector reports whether a load stays over a threshold. The state
+// changes once the load stayed on the other side of the threshold for the
+// period, so that short bursts are ignored.
+type OverloadDetector struct {
+	threshold float64
+	period    time.Duration
+
+	overloaded bool
+
+	// since is when the load crossed the threshold, zero while it is on the
+	// side of the current state
+	since time.Time
+}
+
+// NewOverloadDetector returns a detector of the loads over the threshold for
+// the period
+func NewOverloadDetector(threshold float64, period time.Duration) *OverloadDetector {
+	return &OverloadDetector{threshold: threshold, period: period}
+}
+
+// Update records the load at a time, and returns whether it is overloaded
+func (d *OverloadDetector) Update(load float64, now time.Time) bool {
+	if (load > d.threshold) == d.overloaded {
+		d.since = time.Time{}
+		return d.overloaded
+	}
+	if d.since.IsZero() {
+		d.since = now
+	}
+	if now.Sub(d.since) >= d.period {
+		d.overloaded = !d.overloaded
+		d.since = time.Time{}
+	}
+	return d.overloaded
+}
+
+// newHistorySampling returns the sampler and the detector of the overloads of
+// the file stream from the settings, nil if the sampling is disabled
+func newHistorySampling(settings *service.Settings) (*HistorySampler, *OverloadDetector) {
+	// a synced run is sent as fast as possible, and must be sent as it was
+	// stored
+	if settings.GetXSync().GetValue() {
+		return nil, nil
+	}
+	every := defaultHistorySamplingEvery
+	if setting := settings.GetXHistorySamplingEvery(); setting != nil {
+		every = int(setting.GetValue())
+	}
+	if every <= 1 {
+		return nil, nil
+	}
+	period := defaultHistoryOverload
+	if setting := settings.GetXHistorySamplingOverloadSeconds(); setting.GetValue() > 0 {
+		period = clients.SecondsToDuration(setting.GetValue())
+	}
+	return NewHistorySampler(every), NewOverloadDetector(historyOverloadThreshold, period)
+}
+
+// sendHistory streams a row of the history to the backend. While the file
+// stream is overloaded, the history is sampled and the run is flagged as
+// downsampled.
+func (s *Sender) sendHistory(record *service.Record, history *service.HistoryRecord) {
+	// the history after the exit is not sampled, it is flushed as the run
+	// finishes
+	if s.historySampler == nil || s.exitRecord != nil {
+		s.fileStream.StreamRecord(record)
+		return
+	}
+
+	overloaded := s.historyOverload.Update(s.fileStream.Load(), time.Now())
+	switch {
+	case overloaded && !s.historySampling:
+		s.historySampling = true
+		s.logger.CaptureWarn("sender: the file stream is overloaded, sampling the history",
+			"policy", historySamplingPolicy, "every", s.historySampler.Every())
+		s.recordHistorySampling()
+	case !overloaded && s.historySampling:
+		s.historySampling = false
+		s.logger.Info("sender: the file stream caught up, not sampling the history")
+		s.streamHistory(s.historySampler.Flush())
+	}
+
+	if !s.historySampling {
+		s.fileStream.StreamRecord(record)
+		return
+	}
+	s.streamHistory(s.historySampler.Add(history))
+}
+
+// flushHistorySampling streams the rows left in the window of the sampler
+func (s *Sender) flushHistorySampling() {
+	if s.historySampler == nil {
+		return
+	}
+	s.streamHistory(s.historySampler.Flush())
+}
+
+// streamHistory streams rows of the history to the backend
+func (s *Sender) streamHistory(rows []*service.HistoryRecord) {
+	for _, history := range rows {
+		s.fileStream.StreamRecord(&service.Record{
+			RecordType: &service.Record_History{History: history},
+		})
+	}
+}
+
+// recordHistorySampling flags the run as downsampled in its config, with the
+// policy of the sampling
+func (s *Sender) recordHistorySampling() {
+	s.updateConfigPrivate(nil /*telemetry*/)
+	s.configMap["_wandb"].(map[string]interface{})["history_sampling"] = map[string]interface{}{
+		"policy": historySamplingPolicy,
+		"every":  s.historySampler.Every(),
+	}
+	s.sendConfig(nil, nil /*configRecord*/)
+}
@@ -0,0 +1,73 @@
+package server_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/wandb/wandb/core/pkg/server"
+	"github.com/wandb/wandb/core/pkg/service"
+)
+
+func makeHistoryRow(step int, loss float64) *service.HistoryRecord {
+	return &service.HistoryRecord{Item: []*service.HistoryItem{
+		{Key: "_step", ValueJson: fmt.Sprint(step)},
+		{Key: "loss", ValueJson: fmt.Sprint(loss)},
+		{Key: "name", ValueJson: `"run"`},
+	}}
+}
+
+// rowSteps returns the steps of the rows
+func rowSteps(rows []*service.HistoryRecord) []string {
+	steps := make([]string, len(rows))
+	for i, row := range rows {
+		steps[i] = row.GetItem()[0].GetValueJson()
+	}
+	return steps
+}
+
+func TestHistorySampler(t *testing.T) {
+	sampler := server.NewHistorySampler(5)
+
+	// the rows are kept until the window is full, then the 5th row and the
+	// extremes of the loss are kept, in order
+	losses := []float64{3, 1, 4, 1, 5, 9, 2, 6, 5, 3}
+	var sampled []*service.HistoryRecord
+	for step, loss := range losses[:4] {
+		assert.Empty(t, sampler.Add(makeHistoryRow(step, loss)))
+	}
+	sampled = sampler.Add(makeHistoryRow(4, losses[4]))
+	assert.Equal(t, []string{"1", "4"}, rowSteps(sampled))
+
+	for step, loss := range losses[5:] {
+		sampled = sampler.Add(makeHistoryRow(step+5, loss))
+	}
+	assert.Equal(t, []string{"5", "6", "9"}, rowSteps(sampled))
+
+	// the rows left are flushed like a full window
+	assert.Empty(t, sampler.Flush())
+	sampler.Add(makeHistoryRow(10, 1))
+	sampler.Add(makeHistoryRow(11, 0))
+	sampler.Add(makeHistoryRow(12, 0.5))
+	assert.Equal(t, []string{"10", "11", "12"}, rowSteps(sampler.Flush()))
+}
+
+func TestOverloadDetector(t *testing.T) {
+	detector := server.NewOverloadDetector(0.75, time.Minute)
+	start := time.Now()
+
+	// a short burst is ignored
+	assert.False(t, detector.Update(1, start))
+	assert.False(t, detector.Update(0.5, start.Add(30*time.Second)))
+	assert.False(t, detector.Update(1, start.Add(40*time.Second)))
+	assert.False(t, detector.Update(1, start.Add(90*time.Second)))
+
+	// a sustained overload is not
+	assert.True(t, detector.Update(1, start.Add(100*time.Second)))
+	assert.True(t, detector.Update(0, start.Add(110*time.Second)))
+	assert.True(t, detector.Update(1, start.Add(120*time.Second)))
+	assert.True(t, detector.Update(0, start.Add(130*time.Second)))
+	assert.False(t, detector.Update(0, start.Add(190*time.Second)))
+}
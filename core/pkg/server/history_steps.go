@@ -0,0 +1,61 @@
+package server
+
+import "fmt"
+
+// The policies for the steps of the history that go back, to a step whose row
+// was already flushed, set with _history_step_policy
+const (
+	// historyStepsReject drops the rows of the steps that go back, with a
+	// warning, the default
+	historyStepsReject = "reject"
+
+	// historyStepsIncrement logs the rows of the steps that go back at the
+	// current step instead
+	historyStepsIncrement = "increment"
+
+	// historyStepsRewrite numbers the rows in the order they are received,
+	// the steps of the client only tell the rows apart
+	historyStepsRewrite = "rewrite"
+)
+
+// historyStep returns the step of the row that the items logged by the client
+// at step go to, false if they must be dropped
+func (h *Handler) historyStep(step int64) (int64, bool) {
+	current := h.activeHistory.GetStep().Num
+	switch h.settings.GetXHistoryStepPolicy().GetValue() {
+	case historyStepsRewrite:
+		if h.clientStep != nil && *h.clientStep != step && len(h.activeHistory.values) > 0 {
+			h.activeHistory.Flush()
+			current++
+			h.activeHistory.UpdateStep(current)
+		}
+		h.clientStep = &step
+		return current, true
+	case historyStepsIncrement:
+		if step < current {
+			h.warnStep(step, fmt.Sprintf("it was logged at step %d instead", current))
+			return current, true
+		}
+	default:
+		if step < current {
+			h.logger.CaptureWarn("received history record for a step that has already been received",
+				"received", step, "current", current)
+			h.warnStep(step, "it was dropped")
+			return 0, false
+		}
+	}
+	return step, true
+}
+
+// warnStep warns the client, once per run, that it logged to a step that
+// went back
+func (h *Handler) warnStep(step int64, outcome string) {
+	if h.stepWarned {
+		return
+	}
+	h.stepWarned = true
+	h.internalMessages.Warning = append(h.internalMessages.Warning, fmt.Sprintf(
+		"Tried to log to step %d that is less than the current step %d, %s. Steps must be"+
+			" monotonically increasing, set _history_step_policy to change how this is handled.",
+		step, h.activeHistory.GetStep().Num, outcome))
+}
@@ -1,8 +1,14 @@
 package server_test
 
 import (
+	"context"
 	"testing"
 
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	"github.com/wandb/wandb/core/pkg/observability"
+	"github.com/wandb/wandb/core/pkg/server"
 	"github.com/wandb/wandb/core/pkg/service"
 )
 
@@ -818,3 +824,382 @@ func TestHandleHistory(t *testing.T) {
 	}
 
 }
+
+func TestHandleHistoryMetricSummary(t *testing.T) {
+	inChan, _ := makeInboundChannels()
+	fwdChan, outChan := makeOutboundChannels()
+	settings := &service.Settings{}
+	h := server.NewHandler(context.Background(),
+		observability.NewNoOpLogger(),
+		server.WithHandlerSettings(settings),
+		server.WithHandlerFwdChannel(fwdChan),
+		server.WithHandlerOutChannel(outChan),
+		server.WithHandlerMetricHandler(server.NewMetricHandler()),
+		server.WithHandlerSummaryHandler(server.NewSummaryHandler(observability.NewNoOpLogger(), settings)),
+	)
+	go h.Do(inChan)
+
+	metrics := []*service.MetricRecord{
+		{
+			Name:    "loss",
+			Summary: &service.MetricSummary{Min: true, Mean: true, Last: true, Best: true},
+			Goal:    service.MetricRecord_GOAL_MINIMIZE,
+		},
+		{Name: "acc", Summary: &service.MetricSummary{Max: true}},
+		{Name: "lr", Summary: &service.MetricSummary{None: true}},
+		{Name: "epoch"},
+	}
+	for _, metric := range metrics {
+		inChan <- &service.Record{RecordType: &service.Record_Metric{Metric: metric}}
+	}
+	for i, loss := range []string{"3", "1", "2"} {
+		inChan <- makeHistoryRecord(data{
+			items: map[string]string{"loss": loss, "acc": loss, "lr": "0.1", "epoch": loss},
+			step:  int64(i),
+		})
+	}
+	close(inChan)
+
+	summary := map[string]string{}
+	for record := range fwdChan {
+		for _, item := range record.GetSummary().GetUpdate() {
+			summary[item.GetKey()] = item.GetValueJson()
+		}
+	}
+	assert.JSONEq(t, `{"min":1,"mean":2,"last":2,"best":1}`, summary["loss"])
+	assert.JSONEq(t, `{"max":3}`, summary["acc"])
+	assert.NotContains(t, summary, "lr")
+	assert.Equal(t, "2", summary["epoch"])
+}
+
+func TestHandlePartialHistoryWithHistory(t *testing.T) {
+	inChan, loopbackChan := makeInboundChannels()
+	fwdChan, outChan := makeOutboundChannels()
+	makeHandler(inChan, loopbackChan, fwdChan, outChan, false)
+
+	// a full row at the step of the partial one completes it
+	inChan <- makePartialHistoryRecord(data{items: map[string]string{"a": "1"}, step: 0, flush: false})
+	inChan <- makeHistoryRecord(data{items: map[string]string{"b": "2"}, step: 0})
+	// a full row at a later step flushes the partial one first
+	inChan <- makePartialHistoryRecord(data{items: map[string]string{"a": "3"}, step: 1, flush: false})
+	inChan <- makeHistoryRecord(data{items: map[string]string{"b": "4"}, step: 2})
+	// the partial history keeps being coalesced after the full rows
+	inChan <- makePartialHistoryRecord(data{items: map[string]string{"a": "5"}, step: 3, flush: false})
+	inChan <- makePartialHistoryRecord(data{items: map[string]string{"b": "6"}, step: 3, flush: true})
+
+	expected := []data{
+		{items: map[string]string{"a": "1", "b": "2"}, step: 0},
+		{items: map[string]string{"a": "3"}, step: 1},
+		{items: map[string]string{"b": "4"}, step: 2},
+		{items: map[string]string{"a": "5", "b": "6"}, step: 3},
+	}
+	for _, d := range expected {
+		actual := makeOutput(<-fwdChan)
+		assert.Equal(t, d.step, actual.step)
+		for key, value := range d.items {
+			assert.Equal(t, value, actual.items[key])
+		}
+		assert.Len(t, actual.items, len(d.items)+2) // with _step and _runtime
+	}
+	close(inChan)
+}
+
+func TestHandleDerivedMetric(t *testing.T) {
+	inChan, loopbackChan := makeInboundChannels()
+	fwdChan, outChan := makeOutboundChannels()
+	makeHandler(inChan, loopbackChan, fwdChan, outChan, false)
+
+	derive := func(name, expression string) *service.ErrorInfo {
+		inChan <- &service.Record{
+			RecordType: &service.Record_Request{Request: &service.Request{
+				RequestType: &service.Request_DerivedMetric{DerivedMetric: &service.DerivedMetricRequest{
+					Name:       name,
+					Expression: expression,
+				}},
+			}},
+			Control: &service.Control{MailboxSlot: "derive"},
+		}
+		return (<-outChan).GetResponse().GetDerivedMetricResponse().GetError()
+	}
+	assert.Nil(t, derive("tokens_per_sec", "tokens / step_time"))
+	assert.Nil(t, derive("tokens_per_ms", "tokens_per_sec / 1000"))
+	assert.NotNil(t, derive("broken", "tokens /"))
+	assert.NotNil(t, derive("loop", "loop + 1"))
+
+	inChan <- makeHistoryRecord(data{items: map[string]string{"tokens": "512", "step_time": "0.5"}, step: 0})
+	inChan <- makeHistoryRecord(data{items: map[string]string{"tokens": "512"}, step: 1})
+	inChan <- makeHistoryRecord(data{items: map[string]string{"tokens": "512", "step_time": "0"}, step: 2})
+	// a logged key is not overwritten
+	inChan <- makeHistoryRecord(data{items: map[string]string{"tokens": "1", "step_time": "1", "tokens_per_sec": "7"}, step: 3})
+
+	expected := []map[string]string{
+		{"tokens_per_sec": "1024", "tokens_per_ms": "1.024"},
+		{},
+		{},
+		{"tokens_per_sec": "7", "tokens_per_ms": "0.007"},
+	}
+	for _, derived := range expected {
+		actual := makeOutput(<-fwdChan)
+		for _, key := range []string{"tokens_per_sec", "tokens_per_ms"} {
+			value, ok := derived[key]
+			if !ok {
+				assert.NotContains(t, actual.items, key)
+				continue
+			}
+			assert.Equal(t, value, actual.items[key])
+		}
+	}
+	close(inChan)
+}
+
+func TestHandleStopCondition(t *testing.T) {
+	inChan, loopbackChan := makeInboundChannels()
+	fwdChan, outChan := makeOutboundChannels()
+	makeHandler(inChan, loopbackChan, fwdChan, outChan, false)
+
+	register := func(condition *service.StopConditionRequest) *service.ErrorInfo {
+		inChan <- &service.Record{
+			RecordType: &service.Record_Request{Request: &service.Request{
+				RequestType: &service.Request_StopCondition{StopCondition: condition},
+			}},
+			Control: &service.Control{MailboxSlot: "stop"},
+		}
+		return (<-outChan).GetResponse().GetStopConditionResponse().GetError()
+	}
+	stopStatus := func() *service.StopStatusResponse {
+		inChan <- &service.Record{
+			RecordType: &service.Record_Request{Request: &service.Request{
+				RequestType: &service.Request_StopStatus{StopStatus: &service.StopStatusRequest{}},
+			}},
+			Control: &service.Control{MailboxSlot: "status"},
+		}
+		return (<-outChan).GetResponse().GetStopStatusResponse()
+	}
+	assert.NotNil(t, register(&service.StopConditionRequest{Metric: "loss", Op: ">"}))
+	assert.NotNil(t, register(&service.StopConditionRequest{Name: "bad", Metric: "loss", Op: "!="}))
+	assert.NotNil(t, register(&service.StopConditionRequest{
+		Name: "bad", Metric: "loss", Kind: service.StopConditionRequest_PLATEAU,
+	}))
+	assert.Nil(t, register(&service.StopConditionRequest{
+		Name: "diverged", Metric: "loss", Op: ">", Threshold: 10, Steps: 2,
+	}))
+	assert.Nil(t, register(&service.StopConditionRequest{
+		Name: "plateau", Metric: "acc", Kind: service.StopConditionRequest_PLATEAU,
+		Goal: service.MetricRecord_GOAL_MAXIMIZE, MinDelta: 0.01, Steps: 3,
+	}))
+
+	// the threshold is reset by a step where it doesn't hold
+	for step, loss := range []string{"11", "12", "5", "11", "12"} {
+		inChan <- makeHistoryRecord(data{items: map[string]string{"loss": loss}, step: int64(step)})
+		<-fwdChan
+	}
+	assert.False(t, stopStatus().GetRunShouldStop())
+
+	// a plateau is reset by an improvement greater than the min delta
+	for step, acc := range []string{"0.5", "0.505", "0.509", "0.6"} {
+		inChan <- makeHistoryRecord(data{items: map[string]string{"acc": acc}, step: int64(step + 5)})
+		<-fwdChan
+	}
+	assert.False(t, stopStatus().GetRunShouldStop())
+	inChan <- makeHistoryRecord(data{items: map[string]string{"acc": "0.605"}, step: 11})
+	<-fwdChan
+	status := stopStatus()
+	assert.True(t, status.GetRunShouldStop())
+	assert.Contains(t, status.GetStopReason(), "plateau")
+	close(inChan)
+}
+
+func TestHandleHistoryInvalidValues(t *testing.T) {
+	logged := map[string]string{
+		"ok":      "1.5",
+		"text":    `"NaN"`,
+		"nan":     "NaN",
+		"inf":     "-Infinity",
+		"nested":  `{"a": Infinity, "b": "Infinity"}`,
+		"garbage": "{oops",
+	}
+	testCases := []struct {
+		policy   string
+		expected map[string]string
+	}{
+		{"", map[string]string{"ok": "1.5", "text": `"NaN"`}},
+		{"null", map[string]string{
+			"ok": "1.5", "text": `"NaN"`, "nan": "null", "inf": "null",
+			"nested": `{"a": null, "b": "Infinity"}`, "garbage": "null",
+		}},
+		{"clamp", map[string]string{
+			"ok": "1.5", "text": `"NaN"`, "nan": "null", "inf": "-1.7976931348623157e+308",
+			"nested": `{"a": 1.7976931348623157e+308, "b": "Infinity"}`,
+		}},
+		{"error", map[string]string{"ok": "1.5", "text": `"NaN"`}},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.policy, func(t *testing.T) {
+			inChan, _ := makeInboundChannels()
+			fwdChan, outChan := makeOutboundChannels()
+			h := server.NewHandler(context.Background(),
+				observability.NewNoOpLogger(),
+				server.WithHandlerSettings(&service.Settings{
+					XHistoryInvalidValues: &wrapperspb.StringValue{Value: tc.policy},
+				}),
+				server.WithHandlerFwdChannel(fwdChan),
+				server.WithHandlerOutChannel(outChan),
+			)
+			go h.Do(inChan)
+
+			inChan <- makeHistoryRecord(data{items: logged, step: 3})
+			inChan <- makeHistoryRecord(data{items: map[string]string{"nan": "NaN"}, step: 4})
+			actual := makeOutput(<-fwdChan)
+			delete(actual.items, "_step")
+			delete(actual.items, "_runtime")
+			assert.Equal(t, tc.expected, actual.items)
+			<-fwdChan
+
+			inChan <- &service.Record{
+				RecordType: &service.Record_Request{Request: &service.Request{
+					RequestType: &service.Request_InternalMessages{InternalMessages: &service.InternalMessagesRequest{}},
+				}},
+				Control: &service.Control{MailboxSlot: "messages"},
+			}
+			messages := (<-outChan).GetResponse().GetInternalMessagesResponse().GetMessages()
+			if tc.policy != "error" {
+				assert.Empty(t, messages.GetInvalidHistoryValue())
+			} else {
+				invalid := map[string]*service.InvalidHistoryValue{}
+				for _, value := range messages.GetInvalidHistoryValue() {
+					invalid[value.GetKey()] = value
+				}
+				assert.Len(t, invalid, 4)
+				assert.Equal(t, int64(2), invalid["nan"].GetCount())
+				assert.Equal(t, int64(3), invalid["nan"].GetStep())
+				assert.Len(t, messages.GetWarning(), 4)
+			}
+			close(inChan)
+		})
+	}
+}
+
+func TestHandleHistoryMaxKeys(t *testing.T) {
+	testCases := []struct {
+		policy   string
+		expected map[string]string
+	}{
+		{"", map[string]string{"a": "3", "c": "4", "d": "5"}},
+		{"fold", map[string]string{"a": "3", "history_overflow": `{"c":4,"d":5}`}},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.policy, func(t *testing.T) {
+			inChan, _ := makeInboundChannels()
+			fwdChan, outChan := makeOutboundChannels()
+			h := server.NewHandler(context.Background(),
+				observability.NewNoOpLogger(),
+				server.WithHandlerSettings(&service.Settings{
+					XHistoryMaxKeys:     &wrapperspb.Int32Value{Value: 2},
+					XHistoryKeyOverflow: &wrapperspb.StringValue{Value: tc.policy},
+				}),
+				server.WithHandlerFwdChannel(fwdChan),
+				server.WithHandlerOutChannel(outChan),
+			)
+			go h.Do(inChan)
+
+			inChan <- makeHistoryRecord(data{items: map[string]string{"a": "1", "b": "2"}, step: 0})
+			inChan <- makeHistoryRecord(data{items: map[string]string{"a": "3", "c": "4", "d": "5"}, step: 1})
+			<-fwdChan
+			actual := makeOutput(<-fwdChan)
+			delete(actual.items, "_step")
+			delete(actual.items, "_runtime")
+			assert.Equal(t, tc.expected, actual.items)
+
+			inChan <- &service.Record{
+				RecordType: &service.Record_Request{Request: &service.Request{
+					RequestType: &service.Request_InternalMessages{InternalMessages: &service.InternalMessagesRequest{}},
+				}},
+				Control: &service.Control{MailboxSlot: "messages"},
+			}
+			warnings := (<-outChan).GetResponse().GetInternalMessagesResponse().GetMessages().GetWarning()
+			assert.Len(t, warnings, 1)
+			assert.Contains(t, warnings[0], "More than 2 distinct keys")
+			close(inChan)
+		})
+	}
+}
+
+func TestHandlePartialHistoryStepPolicy(t *testing.T) {
+	logged := []data{
+		{items: map[string]string{"a": "1"}, step: 0, flush: true},
+		{items: map[string]string{"a": "2"}, step: 1, flush: true},
+		{items: map[string]string{"a": "3"}, step: 1, flush: true},
+		{items: map[string]string{"a": "4"}, step: 0, flush: true},
+		{items: map[string]string{"a": "5"}, step: 5, flush: true},
+	}
+	testCases := []struct {
+		policy   string
+		expected []data
+		warning  string
+	}{
+		{"", []data{
+			{items: map[string]string{"a": "1"}, step: 0},
+			{items: map[string]string{"a": "2"}, step: 1},
+			{items: map[string]string{"a": "5"}, step: 5},
+		}, "it was dropped"},
+		{"increment", []data{
+			{items: map[string]string{"a": "1"}, step: 0},
+			{items: map[string]string{"a": "2"}, step: 1},
+			{items: map[string]string{"a": "3"}, step: 2},
+			{items: map[string]string{"a": "4"}, step: 3},
+			{items: map[string]string{"a": "5"}, step: 5},
+		}, "it was logged at step 2 instead"},
+		{"rewrite", []data{
+			{items: map[string]string{"a": "1"}, step: 0},
+			{items: map[string]string{"a": "2"}, step: 1},
+			{items: map[string]string{"a": "3"}, step: 2},
+			{items: map[string]string{"a": "4"}, step: 3},
+			{items: map[string]string{"a": "5"}, step: 4},
+		}, ""},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.policy, func(t *testing.T) {
+			inChan, _ := makeInboundChannels()
+			fwdChan, outChan := makeOutboundChannels()
+			h := server.NewHandler(context.Background(),
+				observability.NewNoOpLogger(),
+				server.WithHandlerSettings(&service.Settings{
+					XHistoryStepPolicy: &wrapperspb.StringValue{Value: tc.policy},
+				}),
+				server.WithHandlerFwdChannel(fwdChan),
+				server.WithHandlerOutChannel(outChan),
+			)
+			go h.Do(inChan)
+
+			for _, d := range logged {
+				inChan <- makePartialHistoryRecord(d)
+			}
+			inChan <- makeFlushRecord()
+			var actual []data
+			for record := range fwdChan {
+				output := makeOutput(record)
+				if output.flush {
+					break
+				}
+				delete(output.items, "_step")
+				delete(output.items, "_runtime")
+				actual = append(actual, output)
+			}
+			assert.Equal(t, tc.expected, actual)
+
+			inChan <- &service.Record{
+				RecordType: &service.Record_Request{Request: &service.Request{
+					RequestType: &service.Request_InternalMessages{InternalMessages: &service.InternalMessagesRequest{}},
+				}},
+				Control: &service.Control{MailboxSlot: "messages"},
+			}
+			warnings := (<-outChan).GetResponse().GetInternalMessagesResponse().GetMessages().GetWarning()
+			if tc.warning == "" {
+				assert.Empty(t, warnings)
+			} else if assert.Len(t, warnings, 1) {
+				assert.Contains(t, warnings[0], tc.warning)
+			}
+			close(inChan)
+		})
+	}
+}
@@ -0,0 +1,133 @@
+package server
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/segmentio/encoding/json"
+
+	"github.com/wandb/wandb/core/pkg/service"
+)
+
+// The policies for the values of the history that are not valid JSON, like
+// the NaN and infinite numbers of the clients, set with
+// _history_invalid_values
+const (
+	// historyValuesDrop drops the invalid values from the rows, the default
+	historyValuesDrop = "drop"
+
+	// historyValuesClamp replaces the infinite numbers with the largest
+	// finite ones and NaN with null, the other invalid values are dropped
+	historyValuesClamp = "clamp"
+
+	// historyValuesNull replaces the invalid values with null
+	historyValuesNull = "null"
+
+	// historyValuesError drops the invalid values, and reports them to the
+	// client with the internal messages
+	historyValuesError = "error"
+)
+
+const (
+	maxFloatJSON = "1.7976931348623157e+308"
+	minFloatJSON = "-1.7976931348623157e+308"
+	nullJSON     = "null"
+)
+
+// replaceNonFinite replaces the NaN and infinite numbers of a JSON value,
+// outside of its strings
+func replaceNonFinite(value, nan, inf, negInf string) string {
+	var b strings.Builder
+	inString := false
+	for i := 0; i < len(value); i++ {
+		c := value[i]
+		switch {
+		case inString:
+			b.WriteByte(c)
+			if c == '\\' && i+1 < len(value) {
+				i++
+				b.WriteByte(value[i])
+			} else if c == '"' {
+				inString = false
+			}
+		case c == '"':
+			inString = true
+			b.WriteByte(c)
+		case strings.HasPrefix(value[i:], "NaN"):
+			b.WriteString(nan)
+			i += len("NaN") - 1
+		case strings.HasPrefix(value[i:], "-Infinity"):
+			b.WriteString(negInf)
+			i += len("-Infinity") - 1
+		case strings.HasPrefix(value[i:], "Infinity"):
+			b.WriteString(inf)
+			i += len("Infinity") - 1
+		default:
+			b.WriteByte(c)
+		}
+	}
+	return b.String()
+}
+
+// sanitizeHistoryValue applies the policy to a value of the history, it
+// returns false if the value must be dropped
+func sanitizeHistoryValue(value, policy string) (string, bool) {
+	if json.Valid([]byte(value)) {
+		return value, true
+	}
+	switch policy {
+	case historyValuesNull:
+		if replaced := replaceNonFinite(value, nullJSON, nullJSON, nullJSON); json.Valid([]byte(replaced)) {
+			return replaced, true
+		}
+		return nullJSON, true
+	case historyValuesClamp:
+		if replaced := replaceNonFinite(value, nullJSON, maxFloatJSON, minFloatJSON); json.Valid([]byte(replaced)) {
+			return replaced, true
+		}
+	}
+	return "", false
+}
+
+// validateHistory applies the policy of the invalid values to the items of a
+// history row
+func (h *Handler) validateHistory(history *service.HistoryRecord) {
+	policy := h.settings.GetXHistoryInvalidValues().GetValue()
+	items := make([]*service.HistoryItem, 0, len(history.GetItem()))
+	for _, item := range history.GetItem() {
+		value, ok := sanitizeHistoryValue(item.GetValueJson(), policy)
+		switch {
+		case !ok:
+			if policy == historyValuesError {
+				h.reportInvalidValue(item, history.GetStep().GetNum())
+			}
+			h.logger.Debug("handler: dropping an invalid history value", "key", item.GetKey(), "value", item.GetValueJson())
+		case value != item.GetValueJson():
+			items = append(items, &service.HistoryItem{Key: item.GetKey(), NestedKey: item.GetNestedKey(), ValueJson: value})
+		default:
+			items = append(items, item)
+		}
+	}
+	history.Item = items
+}
+
+// reportInvalidValue adds a rejected value of the history to the internal
+// messages, once per key until they are returned to the client
+func (h *Handler) reportInvalidValue(item *service.HistoryItem, step int64) {
+	for _, invalid := range h.internalMessages.InvalidHistoryValue {
+		if invalid.GetKey() == item.GetKey() {
+			invalid.Count++
+			return
+		}
+	}
+	h.internalMessages.InvalidHistoryValue = append(h.internalMessages.InvalidHistoryValue,
+		&service.InvalidHistoryValue{
+			Key:       item.GetKey(),
+			ValueJson: item.GetValueJson(),
+			Step:      step,
+			Count:     1,
+		})
+	h.internalMessages.Warning = append(h.internalMessages.Warning, fmt.Sprintf(
+		"The value %s logged for %s at step %d is not a valid number, it was not saved.",
+		item.GetValueJson(), item.GetKey(), step))
+}
@@ -0,0 +1,427 @@
+package server
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/wandb/wandb/core/internal/shared"
+	"github.com/wandb/wandb/core/pkg/observability"
+	"github.com/wandb/wandb/core/pkg/service"
+)
+
+// ImportFormat is the format of the metric logs imported into a store
+type ImportFormat int
+
+const (
+	// ImportAuto picks the format from the name of the input.
+	ImportAuto ImportFormat = iota
+	// ImportTensorBoard reads the scalars of TensorBoard event files.
+	ImportTensorBoard
+	// ImportCSV reads a CSV file with a header row of metric names.
+	ImportCSV
+)
+
+// ParseImportFormat returns the import format with the given name,
+// "tensorboard" or "csv". An empty name picks the format from the input.
+func ParseImportFormat(name string) (ImportFormat, error) {
+	switch strings.ToLower(name) {
+	case "", "auto":
+		return ImportAuto, nil
+	case "tensorboard", "tfevents":
+		return ImportTensorBoard, nil
+	case "csv":
+		return ImportCSV, nil
+	default:
+		return ImportAuto, fmt.Errorf("unknown import format %q", name)
+	}
+}
+
+// ImportOptions describe the run the imported metrics are logged to
+type ImportOptions struct {
+	// RunID is the id of the run, a random id is used if it is empty
+	RunID   string
+	Project string
+	Entity  string
+
+	// Store are the options of the written store
+	Store StoreOptions
+}
+
+// ImportStats are the counts of an import
+type ImportStats struct {
+	// RecordsWritten is the number of records in the written store
+	RecordsWritten int64
+	// HistoryRows is the number of history records in the written store
+	HistoryRows int64
+	// Skipped is the number of values that could not be imported, such as
+	// non-scalar TensorBoard summaries
+	Skipped int64
+}
+
+// errImportFormat is returned for an import format that is not known
+var errImportFormat = errors.New("import: unknown format")
+
+// csvStepColumns and csvTimeColumns are the names of the CSV columns that
+// hold the step and the time of a row, the first one found is used
+var (
+	csvStepColumns = []string{"_step", "step", "global_step", "iteration", "epoch"}
+	csvTimeColumns = []string{"_timestamp", "timestamp", "wall_time", "time"}
+)
+
+// ImportStore writes a store at fileName with the metrics of the TensorBoard
+// event files or CSV log at inputName, so that they can be synced like the
+// store of a run. The input is either a TensorBoard event file, a directory
+// that holds event files, or a CSV file.
+//
+// The store holds a run record, a history record per step with the metrics
+// logged at that step in increasing step order, a summary record with the
+// last value of each metric, and an exit record.
+func ImportStore(
+	ctx context.Context,
+	inputName string,
+	fileName string,
+	format ImportFormat,
+	logger *observability.CoreLogger,
+	opts ImportOptions,
+) (ImportStats, error) {
+	if format == ImportAuto {
+		format = detectImportFormat(inputName)
+	}
+	rows := newImportRows()
+	var err error
+	switch format {
+	case ImportTensorBoard:
+		err = rows.readTensorBoard(inputName)
+	case ImportCSV:
+		err = rows.readCSV(inputName)
+	default:
+		err = errImportFormat
+	}
+	if err != nil {
+		return ImportStats{Skipped: rows.skipped}, err
+	}
+	if len(rows.steps) == 0 {
+		return ImportStats{Skipped: rows.skipped}, fmt.Errorf("import: no metrics found in %s", inputName)
+	}
+	return rows.write(ctx, fileName, logger, opts)
+}
+
+// detectImportFormat returns the format of the input with the given name
+func detectImportFormat(inputName string) ImportFormat {
+	if strings.EqualFold(filepath.Ext(inputName), ".csv") {
+		return ImportCSV
+	}
+	return ImportTensorBoard
+}
+
+// importRow are the metrics logged at a step
+type importRow struct {
+	// time is the time the metrics were logged, in seconds since the epoch
+	time   float64
+	values map[string]float64
+	// strings are the non numeric values of a CSV row
+	strings map[string]string
+}
+
+// importRows are the metrics read from the input, by step
+type importRows struct {
+	steps   map[int64]*importRow
+	skipped int64
+}
+
+func newImportRows() *importRows {
+	return &importRows{steps: make(map[int64]*importRow)}
+}
+
+// row returns the row of a step
+func (r *importRows) row(step int64) *importRow {
+	row, ok := r.steps[step]
+	if !ok {
+		row = &importRow{values: make(map[string]float64)}
+		r.steps[step] = row
+	}
+	return row
+}
+
+// readTensorBoard reads the scalars of an event file, or of all the event
+// files in a directory and its subdirectories
+func (r *importRows) readTensorBoard(inputName string) error {
+	info, err := os.Stat(inputName)
+	if err != nil {
+		return err
+	}
+	if !info.IsDir() {
+		return r.readEventFile(inputName, "")
+	}
+	var names []string
+	err = filepath.WalkDir(inputName, func(path string, entry os.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if !entry.IsDir() && strings.Contains(entry.Name(), "tfevents") {
+			names = append(names, path)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		// the scalars of event files in subdirectories, such as train and
+		// validation, are prefixed by the subdirectory
+		prefix, err := filepath.Rel(inputName, filepath.Dir(name))
+		if err != nil || prefix == "." {
+			prefix = ""
+		}
+		if err := r.readEventFile(name, filepath.ToSlash(prefix)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (r *importRows) readEventFile(name string, prefix string) error {
+	file, err := os.Open(name)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	reader := newTFRecordReader(file)
+	for {
+		data, err := reader.next()
+		if err == io.EOF {
+			return nil
+		} else if err != nil {
+			return fmt.Errorf("import: %s: %w", name, err)
+		}
+		event, err := parseTBEvent(data)
+		if err != nil {
+			return fmt.Errorf("import: %s: invalid event: %w", name, err)
+		}
+		r.skipped += int64(event.skipped)
+		if len(event.scalars) == 0 {
+			continue
+		}
+		row := r.row(event.step)
+		row.time = math.Max(row.time, event.wallTime)
+		for _, scalar := range event.scalars {
+			key := scalar.tag
+			if prefix != "" {
+				key = prefix + "/" + key
+			}
+			row.values[key] = scalar.value
+		}
+	}
+}
+
+// readCSV reads the rows of a CSV file. The step of a row is taken from the
+// first column named like a step, or is the index of the row.
+func (r *importRows) readCSV(inputName string) error {
+	file, err := os.Open(inputName)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	reader := csv.NewReader(file)
+	header, err := reader.Read()
+	if err != nil {
+		return fmt.Errorf("import: %s: can't read header: %w", inputName, err)
+	}
+	stepColumn := findColumn(header, csvStepColumns)
+	timeColumn := findColumn(header, csvTimeColumns)
+
+	for index := int64(0); ; index++ {
+		record, err := reader.Read()
+		if err == io.EOF {
+			return nil
+		} else if err != nil {
+			return fmt.Errorf("import: %s: %w", inputName, err)
+		}
+		step := index
+		if stepColumn >= 0 {
+			value, err := strconv.ParseFloat(record[stepColumn], 64)
+			if err != nil {
+				return fmt.Errorf("import: %s: invalid step %q on row %d", inputName, record[stepColumn], index+1)
+			}
+			step = int64(value)
+		}
+		row := r.row(step)
+		for i, cell := range record {
+			if i == stepColumn || cell == "" {
+				continue
+			}
+			value, err := strconv.ParseFloat(cell, 64)
+			switch {
+			case i == timeColumn && err == nil:
+				row.time = value
+			case err == nil:
+				row.values[header[i]] = value
+			default:
+				if row.strings == nil {
+					row.strings = make(map[string]string)
+				}
+				row.strings[header[i]] = cell
+			}
+		}
+	}
+}
+
+// findColumn returns the index of the first of names in the header, or -1
+func findColumn(header []string, names []string) int {
+	for _, name := range names {
+		for i, column := range header {
+			if strings.EqualFold(strings.TrimSpace(column), name) {
+				return i
+			}
+		}
+	}
+	return -1
+}
+
+// write writes the store of the imported rows
+func (r *importRows) write(
+	ctx context.Context,
+	fileName string,
+	logger *observability.CoreLogger,
+	opts ImportOptions,
+) (ImportStats, error) {
+	stats := ImportStats{Skipped: r.skipped}
+	steps := make([]int64, 0, len(r.steps))
+	for step := range r.steps {
+		steps = append(steps, step)
+	}
+	sort.Slice(steps, func(i, j int) bool { return steps[i] < steps[j] })
+
+	runID := opts.RunID
+	if runID == "" {
+		runID = shared.ShortID(8)
+	}
+	startTime := r.steps[steps[0]].time
+	if startTime == 0 {
+		startTime = float64(time.Now().Unix())
+	}
+
+	store := NewStoreWithOptions(ctx, fileName, logger, opts.Store)
+	if err := store.Open(os.O_WRONLY); err != nil {
+		return stats, err
+	}
+	write := func(record *service.Record) error {
+		stats.RecordsWritten++
+		record.Num = stats.RecordsWritten
+		return store.Write(record)
+	}
+
+	err := write(&service.Record{RecordType: &service.Record_Run{Run: &service.RunRecord{
+		RunId:     runID,
+		Project:   opts.Project,
+		Entity:    opts.Entity,
+		StartTime: timestamppb.New(floatTime(startTime)),
+	}}})
+	summary := map[string]*service.SummaryItem{}
+	for _, step := range steps {
+		if err != nil {
+			break
+		}
+		history := r.steps[step].history(step, startTime)
+		for _, item := range history.Item {
+			summary[item.Key] = &service.SummaryItem{Key: item.Key, ValueJson: item.ValueJson}
+		}
+		err = write(&service.Record{RecordType: &service.Record_History{History: history}})
+		stats.HistoryRows++
+	}
+	if err == nil {
+		keys := make([]string, 0, len(summary))
+		for key := range summary {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		update := make([]*service.SummaryItem, 0, len(keys))
+		for _, key := range keys {
+			update = append(update, summary[key])
+		}
+		err = write(&service.Record{RecordType: &service.Record_Summary{
+			Summary: &service.SummaryRecord{Update: update},
+		}})
+	}
+	if err == nil {
+		err = write(&service.Record{RecordType: &service.Record_Exit{Exit: &service.RunExitRecord{}}})
+	}
+	if err == nil {
+		err = store.Sync()
+	}
+	if err != nil {
+		_ = store.Close()
+		return stats, err
+	}
+	return stats, store.Close()
+}
+
+// history returns the history record of the row logged at step
+func (row *importRow) history(step int64, startTime float64) *service.HistoryRecord {
+	stepJSON, _ := json.Marshal(step)
+	history := &service.HistoryRecord{
+		Step: &service.HistoryStep{Num: step},
+		Item: []*service.HistoryItem{{Key: "_step", ValueJson: string(stepJSON)}},
+	}
+	if row.time > 0 {
+		history.Item = append(history.Item,
+			&service.HistoryItem{Key: "_timestamp", ValueJson: marshalFloat(row.time)},
+			&service.HistoryItem{Key: "_runtime", ValueJson: marshalFloat(math.Max(row.time-startTime, 0))},
+		)
+	}
+	keys := make([]string, 0, len(row.values)+len(row.strings))
+	for key := range row.values {
+		keys = append(keys, key)
+	}
+	for key := range row.strings {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		var valueJSON string
+		if value, ok := row.values[key]; ok {
+			valueJSON = marshalFloat(value)
+		} else {
+			data, _ := json.Marshal(row.strings[key])
+			valueJSON = string(data)
+		}
+		history.Item = append(history.Item, &service.HistoryItem{Key: key, ValueJson: valueJSON})
+	}
+	return history
+}
+
+// marshalFloat returns the JSON of a metric value, the values that JSON can't
+// represent are written the way the Python client writes them
+func marshalFloat(value float64) string {
+	switch {
+	case math.IsNaN(value):
+		return "NaN"
+	case math.IsInf(value, 1):
+		return "Infinity"
+	case math.IsInf(value, -1):
+		return "-Infinity"
+	}
+	return strconv.FormatFloat(value, 'g', -1, 64)
+}
+
+// floatTime returns the time of a number of seconds since the epoch
+func floatTime(seconds float64) time.Time {
+	whole, frac := math.Modf(seconds)
+	return time.Unix(int64(whole), int64(frac*1e9))
+}
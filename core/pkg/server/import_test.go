@@ -0,0 +1,167 @@
+package server_test
+
+import (
+	"context"
+	"encoding/binary"
+	"hash/crc32"
+	"math"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/encoding/protowire"
+
+	"github.com/wandb/wandb/core/pkg/observability"
+	"github.com/wandb/wandb/core/pkg/server"
+	"github.com/wandb/wandb/core/pkg/service"
+)
+
+func maskedCRC(data []byte) uint32 {
+	crc := crc32.Checksum(data, crc32.MakeTable(crc32.Castagnoli))
+	return ((crc >> 15) | (crc << 17)) + 0xa282ead8
+}
+
+// tbEvent encodes a tensorflow.Event with a scalar summary value per tag,
+// the first as a simple value and the others as float tensors
+func tbEvent(step int64, wallTime float64, tags []string, values []float32) []byte {
+	var summary []byte
+	for i, tag := range tags {
+		var value []byte
+		value = protowire.AppendTag(value, 1, protowire.BytesType)
+		value = protowire.AppendString(value, tag)
+		if i == 0 {
+			value = protowire.AppendTag(value, 2, protowire.Fixed32Type)
+			value = protowire.AppendFixed32(value, math.Float32bits(values[i]))
+		} else {
+			var tensor []byte
+			tensor = protowire.AppendTag(tensor, 1, protowire.VarintType)
+			tensor = protowire.AppendVarint(tensor, 1)
+			tensor = protowire.AppendTag(tensor, 5, protowire.BytesType)
+			tensor = protowire.AppendBytes(tensor, protowire.AppendFixed32(nil, math.Float32bits(values[i])))
+			value = protowire.AppendTag(value, 8, protowire.BytesType)
+			value = protowire.AppendBytes(value, tensor)
+		}
+		summary = protowire.AppendTag(summary, 1, protowire.BytesType)
+		summary = protowire.AppendBytes(summary, value)
+	}
+	var event []byte
+	event = protowire.AppendTag(event, 1, protowire.Fixed64Type)
+	event = protowire.AppendFixed64(event, math.Float64bits(wallTime))
+	event = protowire.AppendTag(event, 2, protowire.VarintType)
+	event = protowire.AppendVarint(event, uint64(step))
+	event = protowire.AppendTag(event, 5, protowire.BytesType)
+	event = protowire.AppendBytes(event, summary)
+	return event
+}
+
+func writeEventFile(t *testing.T, name string, events ...[]byte) {
+	var data []byte
+	for _, event := range events {
+		length := binary.LittleEndian.AppendUint64(nil, uint64(len(event)))
+		data = append(data, length...)
+		data = binary.LittleEndian.AppendUint32(data, maskedCRC(length))
+		data = append(data, event...)
+		data = binary.LittleEndian.AppendUint32(data, maskedCRC(event))
+	}
+	assert.NoError(t, os.WriteFile(name, data, 0644))
+}
+
+func historyValues(record *service.Record) map[string]string {
+	values := map[string]string{}
+	for _, item := range record.GetHistory().GetItem() {
+		values[item.GetKey()] = item.GetValueJson()
+	}
+	return values
+}
+
+func TestImportTensorBoard(t *testing.T) {
+	dir := t.TempDir()
+	logDir := filepath.Join(dir, "logs")
+	assert.NoError(t, os.MkdirAll(filepath.Join(logDir, "eval"), 0755))
+	writeEventFile(t, filepath.Join(logDir, "events.out.tfevents.1.host"),
+		tbEvent(2, 1002, []string{"loss"}, []float32{0.25}),
+		tbEvent(1, 1001, []string{"loss", "lr"}, []float32{0.5, 0.125}),
+	)
+	writeEventFile(t, filepath.Join(logDir, "eval", "events.out.tfevents.2.host"),
+		tbEvent(2, 1003, []string{"acc"}, []float32{0.75}),
+	)
+
+	fileName := filepath.Join(dir, "run.wandb")
+	stats, err := server.ImportStore(context.Background(), logDir, fileName, server.ImportAuto,
+		observability.NewNoOpLogger(), server.ImportOptions{RunID: "imported", Project: "tb"},
+	)
+	assert.NoError(t, err)
+	assert.Equal(t, server.ImportStats{RecordsWritten: 5, HistoryRows: 2}, stats)
+
+	records := readStore(t, fileName)
+	assert.Len(t, records, 5)
+	assert.Equal(t, "imported", records[0].GetRun().GetRunId())
+	assert.Equal(t, "tb", records[0].GetRun().GetProject())
+	assert.Equal(t, int64(1001), records[0].GetRun().GetStartTime().GetSeconds())
+
+	assert.Equal(t, int64(1), records[1].GetHistory().GetStep().GetNum())
+	assert.Equal(t, map[string]string{
+		"_step": "1", "_timestamp": "1001", "_runtime": "0", "loss": "0.5", "lr": "0.125",
+	}, historyValues(records[1]))
+	assert.Equal(t, map[string]string{
+		"_step": "2", "_timestamp": "1003", "_runtime": "2", "loss": "0.25", "eval/acc": "0.75",
+	}, historyValues(records[2]))
+
+	summary := map[string]string{}
+	for _, item := range records[3].GetSummary().GetUpdate() {
+		summary[item.GetKey()] = item.GetValueJson()
+	}
+	assert.Equal(t, "0.25", summary["loss"])
+	assert.Equal(t, "0.125", summary["lr"])
+	assert.NotNil(t, records[4].GetExit())
+}
+
+func TestImportTensorBoardCorrupt(t *testing.T) {
+	dir := t.TempDir()
+	eventName := filepath.Join(dir, "events.out.tfevents.1.host")
+	writeEventFile(t, eventName, tbEvent(1, 1001, []string{"loss"}, []float32{0.5}))
+	data, err := os.ReadFile(eventName)
+	assert.NoError(t, err)
+	data[len(data)-5] ^= 0xff
+	assert.NoError(t, os.WriteFile(eventName, data, 0644))
+
+	_, err = server.ImportStore(context.Background(), eventName, filepath.Join(dir, "run.wandb"),
+		server.ImportTensorBoard, observability.NewNoOpLogger(), server.ImportOptions{},
+	)
+	assert.ErrorContains(t, err, "checksum")
+}
+
+func TestImportCSV(t *testing.T) {
+	dir := t.TempDir()
+	csvName := filepath.Join(dir, "metrics.csv")
+	assert.NoError(t, os.WriteFile(csvName, []byte(
+		"epoch,loss,phase\n"+
+			"0,1.5,warmup\n"+
+			"1,0.75,\n",
+	), 0644))
+
+	fileName := filepath.Join(dir, "run.wandb")
+	stats, err := server.ImportStore(context.Background(), csvName, fileName, server.ImportAuto,
+		observability.NewNoOpLogger(), server.ImportOptions{},
+	)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(2), stats.HistoryRows)
+
+	records := readStore(t, fileName)
+	assert.Len(t, records, 5)
+	assert.NotEmpty(t, records[0].GetRun().GetRunId())
+	assert.Equal(t, map[string]string{"_step": "0", "loss": "1.5", "phase": `"warmup"`}, historyValues(records[1]))
+	assert.Equal(t, map[string]string{"_step": "1", "loss": "0.75"}, historyValues(records[2]))
+}
+
+func TestParseImportFormat(t *testing.T) {
+	format, err := server.ParseImportFormat("csv")
+	assert.NoError(t, err)
+	assert.Equal(t, server.ImportCSV, format)
+	format, err = server.ParseImportFormat("tensorboard")
+	assert.NoError(t, err)
+	assert.Equal(t, server.ImportTensorBoard, format)
+	_, err = server.ParseImportFormat("parquet")
+	assert.Error(t, err)
+}
@@ -2,7 +2,12 @@ package server
 
 import (
 	"errors"
-	"path/filepath"
+	"fmt"
+	"math"
+	"path"
+	"strings"
+
+	"github.com/segmentio/encoding/json"
 
 	"github.com/wandb/wandb/core/internal/corelib"
 	"github.com/wandb/wandb/core/pkg/service"
@@ -12,13 +17,106 @@ import (
 type MetricHandler struct {
 	definedMetrics map[string]*service.MetricRecord
 	globMetrics    map[string]*service.MetricRecord
+
+	// globPatterns are the patterns of the glob metrics in the order they
+	// were defined, the first that matches a key applies
+	globPatterns []string
+
+	// unmatched are the keys that match no glob metric, they are checked
+	// again once another glob metric is defined
+	unmatched map[string]struct{}
+
+	// summaries are the aggregations of the history of the metrics with a
+	// summary, by name
+	summaries map[string]*metricSummary
 }
 
 func NewMetricHandler() *MetricHandler {
 	return &MetricHandler{
 		definedMetrics: make(map[string]*service.MetricRecord),
 		globMetrics:    make(map[string]*service.MetricRecord),
+		unmatched:      make(map[string]struct{}),
+		summaries:      make(map[string]*metricSummary),
+	}
+}
+
+// metricSummary aggregates the values of a metric logged to the history. The
+// extremes and the last value are kept as they were logged.
+type metricSummary struct {
+	count int64
+	total float64
+
+	min, max         float64
+	minJSON, maxJSON string
+	lastJSON         string
+}
+
+// update adds a value of the metric to the aggregations
+func (ms *metricSummary) update(value float64, valueJSON string) {
+	if ms.count == 0 || value < ms.min {
+		ms.min, ms.minJSON = value, valueJSON
+	}
+	if ms.count == 0 || value > ms.max {
+		ms.max, ms.maxJSON = value, valueJSON
+	}
+	ms.count++
+	ms.total += value
+	ms.lastJSON = valueJSON
+}
+
+// summarize adds the value of a history item to the aggregations of its
+// metric, and returns the summary of the metric with the aggregations it
+// requests, for example {"min": 0.1, "last": 0.2}. It returns false if the
+// metric has no summary, or if the value is not a number.
+func (mh *MetricHandler) summarize(metric *service.MetricRecord, item *service.HistoryItem) (string, bool) {
+	summary := metric.GetSummary()
+	if summary.GetNone() {
+		return "", false
+	}
+	var value float64
+	if err := json.Unmarshal([]byte(item.GetValueJson()), &value); err != nil || math.IsNaN(value) {
+		return "", false
+	}
+	state, ok := mh.summaries[item.GetKey()]
+	if !ok {
+		state = &metricSummary{}
+		mh.summaries[item.GetKey()] = state
+	}
+	state.update(value, item.GetValueJson())
+
+	aggregations := make(map[string]json.RawMessage)
+	if summary.GetMin() {
+		aggregations["min"] = json.RawMessage(state.minJSON)
+	}
+	if summary.GetMax() {
+		aggregations["max"] = json.RawMessage(state.maxJSON)
+	}
+	if summary.GetMean() {
+		mean, err := json.Marshal(state.total / float64(state.count))
+		if err == nil {
+			aggregations["mean"] = mean
+		}
+	}
+	if summary.GetLast() {
+		aggregations["last"] = json.RawMessage(state.lastJSON)
 	}
+	// the best value depends on the goal of the metric
+	if summary.GetBest() {
+		switch metric.GetGoal() {
+		case service.MetricRecord_GOAL_MINIMIZE:
+			aggregations["best"] = json.RawMessage(state.minJSON)
+		case service.MetricRecord_GOAL_MAXIMIZE:
+			aggregations["best"] = json.RawMessage(state.maxJSON)
+		}
+	}
+	if len(aggregations) == 0 {
+		return "", false
+	}
+	encoded, err := json.Marshal(aggregations)
+	if err != nil {
+		return "", false
+	}
+	return string(encoded), true
 }
 
 // addMetric adds a metric to the target map. If the metric already exists, it will be merged
@@ -50,21 +148,55 @@ func addMetric(arg interface{}, key string, target *map[string]*service.MetricRe
 	return metric, nil
 }
 
+// addGlobMetric adds a glob metric, the keys that matched no glob metric so
+// far are matched again
+func (mh *MetricHandler) addGlobMetric(metric *service.MetricRecord) error {
+	pattern := metric.GetGlobName()
+	if _, defined := mh.globMetrics[pattern]; !defined {
+		// the pattern is checked once, not for every key of the history
+		if _, err := path.Match(pattern, ""); err != nil {
+			return fmt.Errorf("invalid glob %q: %v", pattern, err)
+		}
+		mh.globPatterns = append(mh.globPatterns, pattern)
+	}
+	if _, err := addMetric(metric, pattern, &mh.globMetrics); err != nil {
+		return err
+	}
+	clear(mh.unmatched)
+	return nil
+}
+
+// matchGlob reports whether a key matches a glob pattern. Like in fnmatch, a
+// wildcard also matches the slashes, so that "train/*" matches "train/a/b"
+// and "*" matches every key.
+func matchGlob(pattern, key string) bool {
+	const slash = "\x00"
+	match, err := path.Match(
+		strings.ReplaceAll(pattern, "/", slash),
+		strings.ReplaceAll(key, "/", slash),
+	)
+	return err == nil && match
+}
+
 // createMatchingGlobMetric check if a key matches a glob pattern, if it does create a new defined metric
-// based on the glob metric and return it.
+// based on the first glob metric it matches and return it.
 func (mh *MetricHandler) createMatchingGlobMetric(key string) *service.MetricRecord {
-	for pattern, globMetric := range mh.globMetrics {
-		if match, err := filepath.Match(pattern, key); err != nil {
-			// h.logger.CaptureError("error matching metric", err)
+	if _, ok := mh.unmatched[key]; ok {
+		return nil
+	}
+	for _, pattern := range mh.globPatterns {
+		if !matchGlob(pattern, key) {
 			continue
-		} else if match {
-			metric := proto.Clone(globMetric).(*service.MetricRecord)
-			metric.Name = key
+		}
+		metric := proto.Clone(mh.globMetrics[pattern]).(*service.MetricRecord)
+		metric.Name = key
+		if metric.Options != nil {
 			metric.Options.Defined = false
-			metric.GlobName = ""
-			return metric
 		}
+		metric.GlobName = ""
+		return metric
 	}
+	mh.unmatched[key] = struct{}{}
 	return nil
 }
 
@@ -103,7 +235,7 @@ func (h *Handler) handleMetric(record *service.Record, metric *service.MetricRec
 	// TODO: replace glob-name/name with one-of field
 	switch {
 	case metric.GetGlobName() != "":
-		if _, err := addMetric(metric, metric.GetGlobName(), &h.metricHandler.globMetrics); err != nil {
+		if err := h.metricHandler.addGlobMetric(metric); err != nil {
 			h.logger.CaptureError("error adding metric to map", err)
 			return
 		}
@@ -120,6 +252,27 @@ func (h *Handler) handleMetric(record *service.Record, metric *service.MetricRec
 	}
 }
 
+// summaryItems returns the items of the summary for the items of a history
+// row. The value of a metric defined with a summary is replaced with its
+// aggregations, or left out if there are none, like the legacy service does.
+func (h *Handler) summaryItems(items []*service.HistoryItem) []*service.HistoryItem {
+	if h.metricHandler == nil {
+		return items
+	}
+	summary := make([]*service.HistoryItem, 0, len(items))
+	for _, item := range items {
+		metric := h.metricHandler.definedMetrics[item.GetKey()]
+		if metric.GetSummary() == nil || metric.GetSummary().GetCopy() {
+			summary = append(summary, item)
+			continue
+		}
+		if value, ok := h.metricHandler.summarize(metric, item); ok {
+			summary = append(summary, &service.HistoryItem{Key: item.GetKey(), ValueJson: value})
+		}
+	}
+	return summary
+}
+
 type MetricSender struct {
 	definedMetrics map[string]*service.MetricRecord
 	metricIndex    map[string]int32
@@ -0,0 +1,63 @@
+package server
+
+import (
+	"fmt"
+
+	"github.com/wandb/wandb/core/pkg/service"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// recordTypes is the oneof of the record types in a record
+var recordTypes = (&service.Record{}).ProtoReflect().Descriptor().Oneofs().ByName("record_type")
+
+// requiredRecordTypes are the record types that are always stored, the
+// store can't be synced without them
+var requiredRecordTypes = map[protoreflect.Name]bool{
+	"run":  true,
+	"exit": true,
+}
+
+// recordTypeName returns the name of the record_type field set in the record
+func recordTypeName(record *service.Record) string {
+	field := record.ProtoReflect().WhichOneof(recordTypes)
+	if field == nil {
+		return "unknown"
+	}
+	return string(field.Name())
+}
+
+// PersistencePolicy decides which records the writer stores in the
+// append-only log and which are only forwarded to the sender.
+type PersistencePolicy struct {
+	// forwardOnly are the record types that are not stored
+	forwardOnly map[protoreflect.Name]bool
+}
+
+// NewPersistencePolicy returns a policy that stores all records except
+// requests and the records of the given types. Types are named after the
+// record_type fields of the Record message, e.g. "output_raw" or "stats".
+func NewPersistencePolicy(excludeTypes []string) (*PersistencePolicy, error) {
+	policy := &PersistencePolicy{
+		forwardOnly: map[protoreflect.Name]bool{"request": true},
+	}
+	for _, name := range excludeTypes {
+		field := recordTypes.Fields().ByName(protoreflect.Name(name))
+		if field == nil {
+			return nil, fmt.Errorf("unknown record type %q", name)
+		}
+		if requiredRecordTypes[field.Name()] {
+			return nil, fmt.Errorf("record type %q is required and can't be excluded", name)
+		}
+		policy.forwardOnly[field.Name()] = true
+	}
+	return policy, nil
+}
+
+// ShouldStore reports whether the record is stored in the append-only log
+func (p *PersistencePolicy) ShouldStore(record *service.Record) bool {
+	field := record.ProtoReflect().WhichOneof(recordTypes)
+	if field == nil {
+		return false
+	}
+	return !p.forwardOnly[field.Name()]
+}
@@ -0,0 +1,42 @@
+package server
+
+import (
+	"log/slog"
+	"os"
+	"os/signal"
+	"time"
+)
+
+// abortTimeout is how long the writers of the streams are waited for on a
+// second preemption notice before the process stops
+const abortTimeout = 10 * time.Second
+
+// watchPreemption marks the runs of all the streams as preempting once the
+// process receives a preemption notice, like the SIGTERM that SLURM sends a
+// job before it requeues it. The clients learn it from their stop status
+// requests, to save and exit in the grace period. A second notice aborts the
+// writers of the streams, which flush the records they accepted to their
+// store, and then stops the process as if it was not watched.
+func (s *Server) watchPreemption() {
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, preemptionSignals...)
+	go func() {
+		defer signal.Stop(signals)
+		select {
+		case sig := <-signals:
+			slog.Info("server: preemption notice received", "signal", sig)
+			streamMux.PreemptAllStreams()
+		case <-s.shutdownChan:
+			return
+		}
+		select {
+		case sig := <-signals:
+			slog.Info("server: second preemption notice received, stopping", "signal", sig)
+			// a third notice stops the process right away
+			signal.Reset(preemptionSignals...)
+			streamMux.AbortAllStreams(abortTimeout)
+			stopProcess(sig)
+		case <-s.shutdownChan:
+		}
+	}()
+}
@@ -0,0 +1,19 @@
+//go:build !windows
+
+package server
+
+import (
+	"os"
+	"syscall"
+)
+
+// preemptionSignals are the signals of a preemption notice: SIGTERM, and
+// SIGUSR1 that SLURM jobs usually ask for with --signal ahead of their end
+var preemptionSignals = []os.Signal{syscall.SIGTERM, syscall.SIGUSR1}
+
+// stopProcess stops the process with the signal, as if it was not handled
+func stopProcess(sig os.Signal) {
+	if err := syscall.Kill(os.Getpid(), sig.(syscall.Signal)); err != nil {
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,17 @@
+//go:build windows
+
+package server
+
+import (
+	"os"
+	"syscall"
+)
+
+// preemptionSignals are the signals of a preemption notice
+var preemptionSignals = []os.Signal{syscall.SIGTERM}
+
+// stopProcess stops the process, the signals can't be raised again on
+// windows
+func stopProcess(os.Signal) {
+	os.Exit(1)
+}
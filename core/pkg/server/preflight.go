@@ -0,0 +1,111 @@
+package server
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/wandb/wandb/core/internal/clients"
+	"github.com/wandb/wandb/core/pkg/service"
+)
+
+// senderPreflight is the check of the connection to the backend made as the
+// stream starts
+type senderPreflight struct {
+	// done is closed once the checks are made
+	done chan struct{}
+
+	// checks are the stages checked, up to the first that failed
+	checks []clients.PreflightCheck
+}
+
+// startPreflight checks the connection to the backend in the background, so
+// that a run that hangs at init logs which layer of the connection failed
+func (s *Sender) startPreflight(transport *http.Transport) {
+	baseURL := s.settings.GetBaseUrl().GetValue()
+	if baseURL == "" || s.settings.GetXSync().GetValue() {
+		return
+	}
+	preflight := &senderPreflight{done: make(chan struct{})}
+	s.preflight = preflight
+
+	config := clients.PreflightConfig{
+		BaseURL:   baseURL,
+		APIKey:    s.settings.GetApiKey().GetValue(),
+		Transport: transport,
+	}
+	go func() {
+		defer close(preflight.done)
+		preflight.checks = clients.Preflight(s.backendCtx, config)
+		if s.backendCtx.Err() != nil {
+			return
+		}
+		if failed := preflight.failed(); failed != nil {
+			s.logger.CaptureWarn("sender: the connection to the backend failed the preflight",
+				"stage", failed.Stage, "address", failed.Address, "error", failed.Err, "hint", failed.Hint())
+			return
+		}
+		s.logger.Info("sender: the connection to the backend passed the preflight", "base_url", baseURL)
+	}()
+}
+
+// failed returns the stage that failed, nil if all passed
+func (p *senderPreflight) failed() *clients.PreflightCheck {
+	for i := range p.checks {
+		if p.checks[i].Err != nil {
+			return &p.checks[i]
+		}
+	}
+	return nil
+}
+
+// withPreflight adds the stage that failed the preflight, if it is done, to
+// the error of a request to the backend
+func (s *Sender) withPreflight(info *service.ErrorInfo) *service.ErrorInfo {
+	if s.preflight == nil {
+		return info
+	}
+	select {
+	case <-s.preflight.done:
+	default:
+		return info
+	}
+	if failed := s.preflight.failed(); failed != nil {
+		info.Message = fmt.Sprintf("%s (the %s check of %s failed: %v; %s)",
+			info.Message, failed.Stage, failed.Address, failed.Err, failed.Hint())
+	}
+	return info
+}
+
+// sendPreflight responds with the checks of the preflight, once they are made
+func (s *Sender) sendPreflight(record *service.Record, _ *service.PreflightRequest) {
+	response := &service.PreflightResponse{}
+	if s.preflight != nil {
+		<-s.preflight.done
+		response.Ok = len(s.preflight.checks) > 0 && s.preflight.failed() == nil
+		for _, check := range s.preflight.checks {
+			result := &service.PreflightCheck{
+				Stage:           string(check.Stage),
+				Address:         check.Address,
+				Ok:              check.Err == nil,
+				Hint:            check.Hint(),
+				DurationSeconds: check.Duration.Seconds(),
+			}
+			if check.Err != nil {
+				result.Error = check.Err.Error()
+			}
+			response.Checks = append(response.Checks, result)
+		}
+	}
+
+	s.outChan <- &service.Result{
+		ResultType: &service.Result_Response{
+			Response: &service.Response{
+				ResponseType: &service.Response_PreflightResponse{
+					PreflightResponse: response,
+				},
+			},
+		},
+		Control: record.Control,
+		Uuid:    record.Uuid,
+	}
+}
@@ -0,0 +1,55 @@
+package server
+
+import (
+	"sync"
+
+	"google.golang.org/protobuf/proto"
+
+	"github.com/wandb/wandb/core/pkg/service"
+)
+
+const (
+	// marshalBufferSize is the initial capacity of the pooled marshal buffers
+	marshalBufferSize = 4 * 1024
+
+	// maxPooledMarshalBufferSize is the capacity above which a marshal buffer
+	// is not returned to the pool, so that a few large records do not keep
+	// large buffers alive
+	maxPooledMarshalBufferSize = 1024 * 1024
+)
+
+// marshalBuffer is a pooled buffer a record is marshaled into
+type marshalBuffer struct {
+	data []byte
+}
+
+// marshalBuffers are the buffers the stores marshal the records into, so
+// that storing a record does not allocate a new buffer
+var marshalBuffers = sync.Pool{
+	New: func() any {
+		return &marshalBuffer{data: make([]byte, 0, marshalBufferSize)}
+	},
+}
+
+// marshalRecord marshals a record into a pooled buffer. The encoding is only
+// valid until the buffer is released.
+func marshalRecord(record *service.Record) (*marshalBuffer, error) {
+	buf := marshalBuffers.Get().(*marshalBuffer)
+	// the size is cached in the record, so it is not computed again when
+	// marshaling
+	if size := proto.Size(record); cap(buf.data) < size {
+		buf.data = make([]byte, 0, size)
+	}
+	var err error
+	buf.data, err = proto.MarshalOptions{UseCachedSize: true}.MarshalAppend(buf.data[:0], record)
+	return buf, err
+}
+
+// release returns the buffer to the pool
+func (buf *marshalBuffer) release() {
+	if cap(buf.data) > maxPooledMarshalBufferSize {
+		return
+	}
+	buf.data = buf.data[:0]
+	marshalBuffers.Put(buf)
+}
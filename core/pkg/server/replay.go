@@ -0,0 +1,108 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"io"
+	"os"
+	"time"
+
+	"github.com/wandb/wandb/core/pkg/observability"
+	"github.com/wandb/wandb/core/pkg/service"
+)
+
+// replayConnectionId is the responder of the records replayed to a stream
+const replayConnectionId = "replay"
+
+type replayOptions struct {
+	speed float64
+}
+
+type ReplayOption func(*replayOptions)
+
+// WithReplaySpeed sets the pace of a replay relative to the capture, 1 to
+// replay the records at the time they were captured, 0 to replay them as
+// fast as possible, the default
+func WithReplaySpeed(speed float64) ReplayOption {
+	return func(o *replayOptions) {
+		o.speed = speed
+	}
+}
+
+// ReplayCapture feeds the records of a capture to handle, in the order they
+// were captured and at the pace set by the options. The records the stream
+// sent to itself are skipped, the pipeline they are replayed to sends them
+// again. It returns the number of records replayed.
+func ReplayCapture(
+	ctx context.Context,
+	fileName string,
+	logger *observability.CoreLogger,
+	handle func(*service.Record),
+	opts ...ReplayOption,
+) (int, error) {
+	var options replayOptions
+	for _, opt := range opts {
+		opt(&options)
+	}
+
+	store := NewStore(ctx, fileName, logger)
+	if err := store.Open(os.O_RDONLY); err != nil {
+		return 0, err
+	}
+	defer store.Close()
+
+	start := time.Now()
+	replayed := 0
+	for {
+		record, err := store.Read()
+		if errors.Is(err, io.EOF) {
+			return replayed, nil
+		}
+		if err != nil {
+			return replayed, err
+		}
+		control := record.GetControl()
+		if control.GetLoopback() {
+			continue
+		}
+		if options.speed > 0 {
+			at := time.Duration(float64(control.GetCaptureMicros())/options.speed) * time.Microsecond
+			select {
+			case <-ctx.Done():
+				return replayed, ctx.Err()
+			case <-time.After(time.Until(start.Add(at))):
+			}
+		}
+		if control != nil {
+			control.CaptureMicros = 0
+		}
+		handle(record)
+		replayed++
+	}
+}
+
+// replayResponder drops the responses to the records replayed to a stream
+type replayResponder struct{}
+
+func (replayResponder) Respond(*service.ServerResponse) {}
+
+// Replay feeds the records of a capture to the stream, and closes it once
+// the run exited. The responses to the records are dropped.
+func (s *Stream) Replay(fileName string, opts ...ReplayOption) error {
+	s.AddResponders(ResponderEntry{replayResponder{}, replayConnectionId})
+	exited := false
+	replayed, err := ReplayCapture(s.ctx, fileName, s.logger, func(record *service.Record) {
+		if record.GetControl().GetConnectionId() != "" {
+			record.Control.ConnectionId = replayConnectionId
+		}
+		exited = exited || record.GetExit() != nil
+		s.HandleRecord(record)
+	}, opts...)
+	s.logger.Info("stream: replayed the capture", "name", fileName, "records", replayed)
+	if err != nil || !exited {
+		s.FinishAndClose(0)
+	} else {
+		s.Close()
+	}
+	return err
+}
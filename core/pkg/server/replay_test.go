@@ -0,0 +1,53 @@
+package server_test
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/wandb/wandb/core/pkg/observability"
+	"github.com/wandb/wandb/core/pkg/server"
+	"github.com/wandb/wandb/core/pkg/service"
+)
+
+func TestReplayCapture(t *testing.T) {
+	fileName := filepath.Join(t.TempDir(), "capture.wandb")
+	logger := observability.NewNoOpLogger()
+	capture, err := server.NewCapture(context.Background(), fileName, logger)
+	assert.NoError(t, err)
+
+	records := []*service.Record{
+		{RecordType: &service.Record_Run{Run: &service.RunRecord{RunId: "run1"}}},
+		{RecordType: &service.Record_Request{Request: &service.Request{
+			RequestType: &service.Request_Defer{Defer: &service.DeferRequest{}},
+		}}},
+		{
+			RecordType: &service.Record_Exit{Exit: &service.RunExitRecord{ExitCode: 1}},
+			Control:    &service.Control{ConnectionId: "client"},
+		},
+	}
+	fwdChan := make(chan *service.Record, len(records))
+	for i, record := range records {
+		capture.Forward(record, i == 1, fwdChan)
+	}
+	capture.Close()
+	for _, record := range records {
+		assert.Same(t, record, <-fwdChan)
+	}
+	// the records forwarded to the handler are not changed
+	assert.Nil(t, records[0].GetControl())
+
+	var replayed []*service.Record
+	n, err := server.ReplayCapture(context.Background(), fileName, logger,
+		func(record *service.Record) { replayed = append(replayed, record) },
+		server.WithReplaySpeed(1000),
+	)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, n)
+	assert.Equal(t, "run1", replayed[0].GetRun().GetRunId())
+	assert.Equal(t, int32(1), replayed[1].GetExit().GetExitCode())
+	assert.Equal(t, "client", replayed[1].GetControl().GetConnectionId())
+	assert.Zero(t, replayed[1].GetControl().GetCaptureMicros())
+}
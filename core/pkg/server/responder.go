@@ -2,6 +2,7 @@ package server
 
 import (
 	"fmt"
+	"sync"
 
 	"github.com/wandb/wandb/core/pkg/observability"
 	"github.com/wandb/wandb/core/pkg/service"
@@ -17,12 +18,19 @@ type ResponderEntry struct {
 }
 
 type Dispatcher struct {
+	mu         sync.RWMutex
 	responders map[string]Responder
 	logger     *observability.CoreLogger
+
+	// detached are the responders that were removed, their late results
+	// are dropped
+	detached map[string]struct{}
 }
 
 // AddResponders adds the given responders to the stream's dispatcher.
 func (d *Dispatcher) AddResponders(entries ...ResponderEntry) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
 	if d.responders == nil {
 		d.responders = make(map[string]Responder)
 	}
@@ -36,6 +44,22 @@ func (d *Dispatcher) AddResponders(entries ...ResponderEntry) {
 	}
 }
 
+// RemoveResponder removes a responder, unless it is the last one. It returns
+// false if it was not removed.
+func (d *Dispatcher) RemoveResponder(responderId string) bool {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if _, ok := d.responders[responderId]; !ok || len(d.responders) == 1 {
+		return false
+	}
+	delete(d.responders, responderId)
+	if d.detached == nil {
+		d.detached = make(map[string]struct{})
+	}
+	d.detached[responderId] = struct{}{}
+	return true
+}
+
 func (d *Dispatcher) handleRespond(result *service.Result) {
 	responderId := result.GetControl().GetConnectionId()
 	d.logger.Debug("dispatch: got result", "result", result)
@@ -48,8 +72,14 @@ func (d *Dispatcher) handleRespond(result *service.Result) {
 			ResultCommunicate: result,
 		},
 	}
-	if responder, ok := d.responders[responderId]; ok {
+	d.mu.RLock()
+	responder, ok := d.responders[responderId]
+	_, detached := d.detached[responderId]
+	d.mu.RUnlock()
+	if ok {
 		responder.Respond(response)
+	} else if detached {
+		d.logger.Debug("dispatch: responder detached", "responder", responderId)
 	} else {
 		err := fmt.Errorf("dispatch: no responder found: %s", responderId)
 		d.logger.CaptureFatalAndPanic("dispatch: no responder found", err)
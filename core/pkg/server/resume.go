@@ -9,6 +9,7 @@ import (
 	fs "github.com/wandb/wandb/core/pkg/filestream"
 	"github.com/wandb/wandb/core/pkg/observability"
 	"github.com/wandb/wandb/core/pkg/service"
+	"github.com/wandb/wandb/core/pkg/utils"
 )
 
 type ResumeState struct {
@@ -77,17 +78,18 @@ func (r *ResumeState) Update(
 	bucket := data.GetModel().GetBucket()
 	run.Resumed = true
 
-	r.AddOffset(fs.HistoryChunk, *bucket.GetHistoryLineCount())
+	// the line counts are missing for the runs that never streamed the files
+	r.AddOffset(fs.HistoryChunk, utils.ZeroIfNil(bucket.GetHistoryLineCount()))
 	if result, err := r.updateHistory(run, bucket); err != nil {
 		return result, err
 	}
 
-	r.AddOffset(fs.EventsChunk, *bucket.GetEventsLineCount())
+	r.AddOffset(fs.EventsChunk, utils.ZeroIfNil(bucket.GetEventsLineCount()))
 	if result, err := r.updateSummary(run, bucket); err != nil {
 		return result, err
 	}
 
-	r.AddOffset(fs.OutputChunk, *bucket.GetLogLineCount())
+	r.AddOffset(fs.OutputChunk, utils.ZeroIfNil(bucket.GetLogLineCount()))
 	if result, err := r.updateConfig(bucket, config); err != nil {
 		return result, err
 	}
@@ -101,6 +103,10 @@ func (r *ResumeState) updateHistory(run *service.RunRecord, bucket *Bucket) (*se
 	var historyTail []string
 	var historyTailMap map[string]interface{}
 
+	if bucket.GetHistoryTail() == nil {
+		return nil, nil
+	}
+
 	if err := json.Unmarshal([]byte(*bucket.GetHistoryTail()), &historyTail); err != nil {
 		err = fmt.Errorf("failed to unmarshal history tail: %s", err)
 		if r.ResumeMode == "must" {
@@ -149,6 +155,9 @@ func (r *ResumeState) updateSummary(run *service.RunRecord, bucket *Bucket) (*se
 	// If we are unable to parse the config, we should fail if resume is set to must
 	// for any other case of resume status, it is fine to ignore it
 	var summary map[string]interface{}
+	if bucket.GetSummaryMetrics() == nil {
+		return nil, nil
+	}
 	if err := json.Unmarshal([]byte(*bucket.GetSummaryMetrics()), &summary); err != nil {
 		err = fmt.Errorf("failed to unmarshal summary metrics: %s", err)
 		if r.ResumeMode == "must" {
@@ -177,6 +186,9 @@ func (r *ResumeState) updateSummary(run *service.RunRecord, bucket *Bucket) (*se
 
 func (r *ResumeState) updateConfig(bucket *Bucket, config map[string]interface{}) (*service.RunUpdateResult, error) {
 	var cfg map[string]interface{}
+	if bucket.GetConfig() == nil {
+		return nil, nil
+	}
 	if err := json.Unmarshal([]byte(*bucket.GetConfig()), &cfg); err != nil {
 		err = fmt.Errorf("sender: checkAndUpdateResumeState: failed to unmarshal config: %s", err)
 		if r.ResumeMode == "must" {
@@ -6,6 +6,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"github.com/wandb/wandb/core/internal/gql"
+	fs "github.com/wandb/wandb/core/pkg/filestream"
 	"github.com/wandb/wandb/core/pkg/observability"
 	server "github.com/wandb/wandb/core/pkg/server"
 	"github.com/wandb/wandb/core/pkg/service"
@@ -268,3 +269,22 @@ func TestUpdate(t *testing.T) {
 		})
 	}
 }
+
+func TestUpdateMissingFields(t *testing.T) {
+	logger := observability.NewNoOpLogger()
+	rs := server.NewResumeState(logger, "must")
+	run := &service.RunRecord{Project: "test", RunId: "abc123"}
+	resp := &gql.RunResumeStatusResponse{
+		Model: &gql.RunResumeStatusModelProject{
+			Bucket: &gql.RunResumeStatusModelProjectBucketRun{},
+		},
+	}
+
+	// a run that never streamed any files resumes from the start
+	_, err := rs.Update(resp, run, make(map[string]interface{}))
+	require.NoError(t, err)
+	assert.True(t, run.Resumed)
+	assert.Equal(t, int64(0), run.StartingStep)
+	assert.Equal(t, 0, rs.GetFileStreamOffset()[fs.HistoryChunk])
+	assert.Equal(t, 0, rs.GetFileStreamOffset()[fs.OutputChunk])
+}
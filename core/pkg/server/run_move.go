@@ -0,0 +1,115 @@
+package server
+
+import (
+	"context"
+	"fmt"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	"github.com/wandb/wandb/core/internal/clients"
+	"github.com/wandb/wandb/core/internal/shared"
+	"github.com/wandb/wandb/core/pkg/service"
+)
+
+// forkRunIDLength is the length of the generated ids of the forks
+const forkRunIDLength = 8
+
+// sendRunMove moves the run to another project or entity, or forks it into a
+// new run there, and responds with the run at its new location
+func (s *Sender) sendRunMove(record *service.Record, request *service.RunMoveRequest) {
+	response := &service.RunMoveResponse{}
+	run, errInfo := s.moveRun(request)
+	if errInfo != nil {
+		s.logger.Error("sender: sendRunMove: failed to move the run", "error", errInfo.Message)
+		response.Error = errInfo
+	} else {
+		response.Run = run
+	}
+
+	s.outChan <- &service.Result{
+		ResultType: &service.Result_Response{
+			Response: &service.Response{
+				ResponseType: &service.Response_RunMoveResponse{
+					RunMoveResponse: response,
+				},
+			},
+		},
+		Control: record.Control,
+		Uuid:    record.Uuid,
+	}
+}
+
+// moveRun upserts the metadata and the config of the run at the location of
+// the request, and continues the file stream of the run there. The run at the
+// old location is kept, and is finished by the file stream.
+func (s *Sender) moveRun(request *service.RunMoveRequest) (*service.RunRecord, *service.ErrorInfo) {
+	if s.graphqlClient == nil || s.RunRecord == nil {
+		return nil, &service.ErrorInfo{
+			Code:    service.ErrorInfo_USAGE,
+			Message: "sender: only a started online run can be moved",
+		}
+	}
+	if s.exitRecord != nil {
+		return nil, &service.ErrorInfo{
+			Code:    service.ErrorInfo_USAGE,
+			Message: "sender: a finished run can't be moved",
+		}
+	}
+
+	target, ok := proto.Clone(s.RunRecord).(*service.RunRecord)
+	if !ok {
+		err := fmt.Errorf("failed to clone RunRecord")
+		s.logger.CaptureFatalAndPanic("sender: moveRun: ", err)
+	}
+	if request.Entity != "" {
+		target.Entity = request.Entity
+	}
+	if request.Project != "" {
+		target.Project = request.Project
+	}
+	if request.Fork {
+		target.RunId = request.RunId
+		if target.RunId == "" {
+			target.RunId = shared.ShortID(forkRunIDLength)
+		}
+	}
+	if target.Entity == s.RunRecord.Entity && target.Project == s.RunRecord.Project &&
+		target.RunId == s.RunRecord.RunId {
+		return s.RunRecord, nil
+	}
+
+	ctx := context.WithValue(s.ctx, clients.CtxRetryPolicyKey, clients.UpsertBucketRetryPolicy)
+	ctx = clients.WithIdempotencyKey(ctx)
+	data, err := s.upsertRun(ctx, target, s.serializeConfig("json"))
+	if err != nil {
+		return nil, errorInfo(fmt.Errorf("failed to upsert bucket: %w", err))
+	}
+	target.DisplayName = *data.UpsertBucket.Bucket.DisplayName
+	target.Project = data.UpsertBucket.Bucket.Project.Name
+	target.Entity = data.UpsertBucket.Bucket.Project.Entity.Name
+
+	s.logger.Info("sender: moving the run",
+		"from", fmt.Sprintf("%s/%s/%s", s.RunRecord.Entity, s.RunRecord.Project, s.RunRecord.RunId),
+		"to", fmt.Sprintf("%s/%s/%s", target.Entity, target.Project, target.RunId),
+		"fork", request.Fork)
+	s.finishStopPolling()
+	s.RunRecord = target
+	s.settings.Entity = &wrapperspb.StringValue{Value: target.Entity}
+	s.settings.Project = &wrapperspb.StringValue{Value: target.Project}
+
+	// the file stream sends what was streamed before the move to the old
+	// run, and starts the files of the new one with the full summary
+	s.fileStream.StreamRecord(&service.Record{
+		RecordType: &service.Record_Request{Request: &service.Request{
+			RequestType: &service.Request_RunMove{RunMove: &service.RunMoveRequest{
+				Entity:  target.Entity,
+				Project: target.Project,
+				RunId:   target.RunId,
+			}},
+		}},
+	})
+	s.sendSummary(nil, &service.SummaryRecord{})
+	s.startStopPolling()
+	return proto.Clone(target).(*service.RunRecord), nil
+}
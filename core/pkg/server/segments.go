@@ -0,0 +1,272 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/wandb/wandb/core/pkg/observability"
+	"github.com/wandb/wandb/core/pkg/service"
+)
+
+// SegmentInfo describes a segment of a store split by the writer
+type SegmentInfo struct {
+	// Name is the file name of the segment, relative to the directory of
+	// the first segment
+	Name string `json:"name"`
+
+	// FirstRecord is the number of the first record in the segment
+	FirstRecord int64 `json:"first_record"`
+}
+
+// SegmentIndex lists the segments of a store in order. The writer saves it
+// next to the first segment, named after it with a .segments suffix.
+type SegmentIndex struct {
+	Segments []SegmentInfo `json:"segments"`
+}
+
+// segmentIndexName returns the name of the segment index of a store
+func segmentIndexName(fileName string) string {
+	return fileName + ".segments"
+}
+
+// LoadSegmentIndex returns the segment index of the store whose first
+// segment is fileName. Stores written without an index are indexed by
+// looking for the segment files next to the first one.
+func LoadSegmentIndex(fileName string) (*SegmentIndex, error) {
+	data, err := os.ReadFile(segmentIndexName(fileName))
+	if os.IsNotExist(err) {
+		return probeSegments(fileName), nil
+	} else if err != nil {
+		return nil, err
+	}
+	index := &SegmentIndex{}
+	if err := json.Unmarshal(data, index); err != nil {
+		return nil, fmt.Errorf("invalid segment index: %w", err)
+	}
+	return index, nil
+}
+
+// probeSegments returns the index of the segments that exist on disk
+func probeSegments(fileName string) *SegmentIndex {
+	index := &SegmentIndex{
+		Segments: []SegmentInfo{{Name: filepath.Base(fileName)}},
+	}
+	for segment := 1; ; segment++ {
+		name := fmt.Sprintf("%s.%d", fileName, segment)
+		if _, err := os.Stat(name); err != nil {
+			return index
+		}
+		index.Segments = append(index.Segments, SegmentInfo{Name: filepath.Base(name)})
+	}
+}
+
+// add appends a segment to the index
+func (idx *SegmentIndex) add(fileName string, firstRecord int64) {
+	idx.Segments = append(idx.Segments, SegmentInfo{
+		Name:        filepath.Base(fileName),
+		FirstRecord: firstRecord,
+	})
+}
+
+// Paths returns the paths of the segments of the store whose first segment
+// is fileName
+func (idx *SegmentIndex) Paths(fileName string) []string {
+	dir := filepath.Dir(fileName)
+	paths := make([]string, len(idx.Segments))
+	for i, segment := range idx.Segments {
+		paths[i] = filepath.Join(dir, segment.Name)
+	}
+	return paths
+}
+
+// save atomically writes the index of the store whose first segment is
+// fileName
+func (idx *SegmentIndex) save(fileName string) error {
+	data, err := json.Marshal(idx)
+	if err != nil {
+		return err
+	}
+	name := segmentIndexName(fileName)
+	tmp := name + ".tmp"
+	if err := os.WriteFile(tmp, data, 0644); err != nil {
+		return err
+	}
+	return os.Rename(tmp, name)
+}
+
+// SegmentReader reads the records of a store across all of its segments
+type SegmentReader struct {
+	ctx    context.Context
+	name   string
+	logger *observability.CoreLogger
+
+	// opts are the options the segments are opened with
+	opts StoreOptions
+
+	// index lists the segments of the store
+	index *SegmentIndex
+
+	// paths are the paths of the segments to read
+	paths []string
+
+	// store is the segment being read
+	store *Store
+
+	// shards merges the shards of a sharded store, the store is not split
+	// into segments if it is set
+	shards *shardMerger
+}
+
+// NewSegmentReader returns a reader for the store whose first segment is
+// fileName
+func NewSegmentReader(ctx context.Context, fileName string, logger *observability.CoreLogger) *SegmentReader {
+	return NewSegmentReaderWithOptions(ctx, fileName, logger, StoreOptions{})
+}
+
+// NewSegmentReaderWithOptions is like NewSegmentReader, but opens the
+// segments with the given options
+func NewSegmentReaderWithOptions(
+	ctx context.Context,
+	fileName string,
+	logger *observability.CoreLogger,
+	opts StoreOptions,
+) *SegmentReader {
+	return &SegmentReader{
+		ctx:    ctx,
+		name:   fileName,
+		logger: logger,
+		opts:   opts,
+	}
+}
+
+// Open loads the segment index and opens the first segment. The shards of a
+// sharded store are all opened, and read in record number order.
+func (r *SegmentReader) Open() error {
+	manifest, err := LoadShardManifest(r.name)
+	if err != nil {
+		r.logger.CaptureError("can't load shard manifest", err)
+		return err
+	}
+	if manifest != nil && manifest.Shards > 1 {
+		shards, err := openShardMerger(r, manifest.paths(r.name))
+		if err != nil {
+			return err
+		}
+		r.shards = shards
+		return nil
+	}
+	index, err := LoadSegmentIndex(r.name)
+	if err != nil {
+		r.logger.CaptureError("can't load segment index", err)
+		return err
+	}
+	if len(index.Segments) == 0 {
+		err := fmt.Errorf("segment index has no segments")
+		r.logger.CaptureError("can't load segment index", err)
+		return err
+	}
+	r.index = index
+	r.paths = index.Paths(r.name)
+	return r.openNext()
+}
+
+// Header returns the header of the segment being read
+func (r *SegmentReader) Header() HeaderOptions {
+	if r.shards != nil {
+		return r.shards.Header()
+	}
+	if r.store == nil {
+		return HeaderOptions{}
+	}
+	return r.store.Header()
+}
+
+// SeekRecord moves the reader so that the next Read returns the first record
+// whose number is at least num. The segment index is used to skip the
+// segments before the one holding the record, and the sidecar index of that
+// segment, if any, to skip the records before it.
+func (r *SegmentReader) SeekRecord(num int64) error {
+	if r.shards != nil {
+		return r.shards.SeekRecord(num)
+	}
+	if r.index == nil {
+		return fmt.Errorf("segment reader is not open")
+	}
+	if err := r.Close(); err != nil {
+		return err
+	}
+	first := 0
+	for i, segment := range r.index.Segments {
+		// segments found on disk without an index have no first record
+		if segment.FirstRecord > 0 && segment.FirstRecord <= num {
+			first = i
+		}
+	}
+	r.paths = r.index.Paths(r.name)[first:]
+	for {
+		if err := r.openNext(); err != nil {
+			return err
+		}
+		err := r.store.SeekRecord(num)
+		if err != io.EOF || len(r.paths) == 0 {
+			return err
+		}
+		if err := r.Close(); err != nil {
+			return err
+		}
+	}
+}
+
+// openNext opens the next segment
+func (r *SegmentReader) openNext() error {
+	store := NewStoreWithOptions(r.ctx, r.paths[0], r.logger, r.opts)
+	if err := store.Open(os.O_RDONLY); err != nil {
+		return err
+	}
+	r.store = store
+	r.paths = r.paths[1:]
+	return nil
+}
+
+// Read returns the next record, moving on to the next segment at the end of
+// each segment. It returns io.EOF after the last record of the last segment.
+func (r *SegmentReader) Read() (*service.Record, error) {
+	if r.shards != nil {
+		return r.shards.Read()
+	}
+	if r.store == nil {
+		return nil, fmt.Errorf("segment reader is closed")
+	}
+	for {
+		record, err := r.store.Read()
+		if err != io.EOF || len(r.paths) == 0 {
+			return record, err
+		}
+		if err := r.store.Close(); err != nil {
+			return nil, err
+		}
+		r.store = nil
+		if err := r.openNext(); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// Close closes the segment being read
+func (r *SegmentReader) Close() error {
+	if r.shards != nil {
+		err := r.shards.Close()
+		r.shards = nil
+		return err
+	}
+	if r.store == nil {
+		return nil
+	}
+	err := r.store.Close()
+	r.store = nil
+	return err
+}
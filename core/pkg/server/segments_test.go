@@ -0,0 +1,154 @@
+package server_test
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	"github.com/wandb/wandb/core/pkg/observability"
+	"github.com/wandb/wandb/core/pkg/server"
+	"github.com/wandb/wandb/core/pkg/service"
+)
+
+// readSegments returns all the records of a segmented store
+func readSegments(t *testing.T, fileName string) []*service.Record {
+	t.Helper()
+
+	reader := server.NewSegmentReader(context.Background(), fileName, observability.NewNoOpLogger())
+	assert.NoError(t, reader.Open())
+	defer reader.Close()
+
+	var records []*service.Record
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			return records
+		}
+		assert.NoError(t, err)
+		records = append(records, record)
+	}
+}
+
+func TestSegmentReader(t *testing.T) {
+	fileName := filepath.Join(t.TempDir(), "run.wandb")
+	settings := &service.Settings{
+		SyncFile:              &wrapperspb.StringValue{Value: fileName},
+		XStoreMaxSegmentBytes: &wrapperspb.Int64Value{Value: 40},
+	}
+	runWriter(t, makeOutputRecords(6), server.WithWriterSettings(settings))
+
+	index, err := server.LoadSegmentIndex(fileName)
+	assert.NoError(t, err)
+	assert.Greater(t, len(index.Segments), 1)
+	assert.Equal(t, "run.wandb", index.Segments[0].Name)
+	assert.Equal(t, int64(1), index.Segments[0].FirstRecord)
+	for i, segment := range index.Segments[1:] {
+		assert.Greater(t, segment.FirstRecord, index.Segments[i].FirstRecord)
+	}
+
+	records := readSegments(t, fileName)
+	assert.Len(t, records, 6)
+	for i, record := range records {
+		assert.Equal(t, int64(i+1), record.Num)
+	}
+}
+
+func TestSegmentReaderWithoutIndex(t *testing.T) {
+	fileName := filepath.Join(t.TempDir(), "run.wandb")
+	settings := &service.Settings{SyncFile: &wrapperspb.StringValue{Value: fileName}}
+	runWriter(t, makeOutputRecords(6),
+		server.WithWriterSettings(settings),
+		server.WithWriterMaxSegmentBytes(40),
+	)
+
+	// stores written before the index existed are found by their names
+	assert.NoError(t, os.Remove(fileName+".segments"))
+	assert.Len(t, readSegments(t, fileName), 6)
+}
+
+func TestSegmentResume(t *testing.T) {
+	fileName := filepath.Join(t.TempDir(), "run.wandb")
+	settings := &service.Settings{SyncFile: &wrapperspb.StringValue{Value: fileName}}
+	runWriter(t, makeOutputRecords(6),
+		server.WithWriterSettings(settings),
+		server.WithWriterMaxSegmentBytes(40),
+	)
+	index, err := server.LoadSegmentIndex(fileName)
+	assert.NoError(t, err)
+	numSegments := len(index.Segments)
+
+	// resuming appends to the last segment before rotating again
+	runWriter(t, makeOutputRecords(4),
+		server.WithWriterSettings(settings),
+		server.WithWriterMaxSegmentBytes(40),
+		server.WithWriterResume(),
+	)
+	index, err = server.LoadSegmentIndex(fileName)
+	assert.NoError(t, err)
+	assert.Greater(t, len(index.Segments), numSegments)
+
+	records := readSegments(t, fileName)
+	assert.Len(t, records, 10)
+	for i, record := range records {
+		assert.Equal(t, int64(i+1), record.Num)
+	}
+}
+
+func TestSegmentResumeStaleIndex(t *testing.T) {
+	fileName := filepath.Join(t.TempDir(), "run.wandb")
+	settings := &service.Settings{SyncFile: &wrapperspb.StringValue{Value: fileName}}
+	runWriter(t, makeOutputRecords(6),
+		server.WithWriterSettings(settings),
+		server.WithWriterMaxSegmentBytes(40),
+	)
+
+	// the writer stopped after creating the last segment but before
+	// indexing it
+	index, err := server.LoadSegmentIndex(fileName)
+	assert.NoError(t, err)
+	index.Segments = index.Segments[:len(index.Segments)-1]
+	data, err := json.Marshal(index)
+	assert.NoError(t, err)
+	assert.NoError(t, os.WriteFile(fileName+".segments", data, 0o644))
+
+	runWriter(t, makeOutputRecords(4),
+		server.WithWriterSettings(settings),
+		server.WithWriterMaxSegmentBytes(40),
+		server.WithWriterResume(),
+	)
+
+	records := readSegments(t, fileName)
+	assert.Len(t, records, 10)
+	for i, record := range records {
+		assert.Equal(t, int64(i+1), record.Num)
+	}
+}
+
+func TestSegmentResumeWithoutRotation(t *testing.T) {
+	fileName := filepath.Join(t.TempDir(), "run.wandb")
+	settings := &service.Settings{SyncFile: &wrapperspb.StringValue{Value: fileName}}
+	runWriter(t, makeOutputRecords(6),
+		server.WithWriterSettings(settings),
+		server.WithWriterMaxSegmentBytes(40),
+	)
+	first := readStore(t, fileName)
+
+	// a resumed run that no longer rotates still appends to the last segment
+	runWriter(t, makeOutputRecords(4),
+		server.WithWriterSettings(settings),
+		server.WithWriterResume(),
+	)
+
+	assert.Equal(t, first, readStore(t, fileName))
+	records := readSegments(t, fileName)
+	assert.Len(t, records, 10)
+	for i, record := range records {
+		assert.Equal(t, int64(i+1), record.Num)
+	}
+}
@@ -14,6 +14,7 @@ import (
 	"gopkg.in/yaml.v3"
 
 	"github.com/Khan/genqlient/graphql"
+	"golang.org/x/time/rate"
 	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/wrapperspb"
 
@@ -37,6 +38,22 @@ const (
 	RFC3339Micro             = "2006-01-02T15:04:05.000000Z07:00"
 	configDebouncerRateLimit = 1 / 30.0 // todo: audit rate limit
 	configDebouncerBurstSize = 1        // todo: audit burst size
+
+	// defaultCircuitBreakerFailures is the default number of consecutive
+	// failed requests after which the requests to the backend are paused
+	defaultCircuitBreakerFailures = 5
+
+	// defaultCircuitBreakerCooldown is how long the requests to the backend
+	// are paused by default
+	defaultCircuitBreakerCooldown = 30 * time.Second
+
+	// defaultFailoverRecover is how long a down backend endpoint is skipped
+	// before it is tried again
+	defaultFailoverRecover = 60 * time.Second
+
+	// backendTransportHTTP is the backend transport of GraphQL and the file
+	// stream over HTTPS
+	backendTransportHTTP = "http"
 )
 
 type SenderOption func(*Sender)
@@ -53,6 +70,48 @@ func WithSenderOutChannel(out chan *service.Result) SenderOption {
 	}
 }
 
+// WithSenderConnectivity makes the sender report whether the backend is
+// reachable, so that the writer spools the records while it is not
+func WithSenderConnectivity(connectivity *Connectivity) SenderOption {
+	return func(s *Sender) {
+		s.connectivity = connectivity
+	}
+}
+
+// WithSenderRequestTracer calls the hooks of the tracer around each request
+// of the sender to the backend
+func WithSenderRequestTracer(tracer observability.RequestTracer) SenderOption {
+	return func(s *Sender) {
+		s.requestTracer = tracer
+	}
+}
+
+// SenderStats are the metrics of the worker pools of the sender
+type SenderStats struct {
+	// Uploads are the metrics of the pool of the artifact uploads and
+	// downloads
+	Uploads WorkerPoolStats
+	// Metadata are the metrics of the pool of the requests for the file
+	// uploads and the alerts
+	Metadata WorkerPoolStats
+}
+
+// WithSenderPriorityChannel makes the sender take the records forwarded by
+// the writer on the priority channel ahead of the queued records
+func WithSenderPriorityChannel(priority <-chan *service.Record) SenderOption {
+	return func(s *Sender) {
+		s.priorityChan = priority
+	}
+}
+
+// WithSenderDebounceClock sets the clock that tells when the debounced
+// config is due, it is meant for tests
+func WithSenderDebounceClock(clock debounce.Clock) SenderOption {
+	return func(s *Sender) {
+		s.configDebouncer.SetClock(clock)
+	}
+}
+
 // Sender is the sender for a stream it handles the incoming messages and sends to the server
 // or/and to the dispatcher/handler
 type Sender struct {
@@ -62,6 +121,37 @@ type Sender struct {
 	// cancel is the cancel function for the handler
 	cancel context.CancelFunc
 
+	// backendCtx is the context of the uploads and the requests made while
+	// the run finishes, it is cancelled when the final flush times out
+	backendCtx context.Context
+
+	// cancelBackend cancels backendCtx
+	cancelBackend context.CancelFunc
+
+	// exitFlush bounds the final flush of the run
+	exitFlush exitFlush
+
+	// stopPoller asks the backend whether the run was stopped from the UI,
+	// while the run is live
+	stopPoller *stopPoller
+
+	// preflight checks the connection to the backend as the stream starts,
+	// nil if offline
+	preflight *senderPreflight
+
+	// historySampler downsamples the history while the file stream is
+	// overloaded, nil if the sampling is disabled
+	historySampler *HistorySampler
+
+	// historyOverload detects when the file stream is overloaded
+	historyOverload *OverloadDetector
+
+	// historySampling is whether the history is being sampled
+	historySampling bool
+
+	// requestTracer observes the requests to the backend, if set
+	requestTracer observability.RequestTracer
+
 	// logger is the logger for the sender
 	logger *observability.CoreLogger
 
@@ -74,15 +164,37 @@ type Sender struct {
 	// outChan is the channel for dispatcher messages
 	outChan chan *service.Result
 
+	// priorityChan is the channel of the records to send ahead of the ones
+	// of the input channel
+	priorityChan <-chan *service.Record
+
 	// graphqlClient is the graphql client
 	graphqlClient graphql.Client
 
+	// startOnRun is set when a run started offline goes online, its
+	// resources are started once the run is sent again
+	startOnRun bool
+
 	// fileStream is the file stream
 	fileStream *fs.FileStream
 
+	// connectivity tracks whether the requests of the sender reach the
+	// backend
+	connectivity *Connectivity
+
 	// filetransfer is the file uploader/downloader
 	fileTransferManager *filetransfer.FileTransferManager
 
+	// uploadPool runs the artifact uploads and downloads
+	uploadPool *workerPool
+
+	// metadataPool runs the requests for the file uploads and the alerts
+	metadataPool *workerPool
+
+	// uploadedFiles are the digests of the run files uploaded, to skip the
+	// files saved again unchanged
+	uploadedFiles *fileDigests
+
 	// RunRecord is the run record
 	// TODO: remove this and use properly updated settings
 	//       + a flag indicating whether the run has started
@@ -111,7 +223,7 @@ type Sender struct {
 
 	syncService *SyncService
 
-	store *Store
+	store *SegmentReader
 
 	jobBuilder *launch.JobBuilder
 }
@@ -125,93 +237,23 @@ func NewSender(
 	opts ...SenderOption,
 ) *Sender {
 
+	backendCtx, cancelBackend := context.WithCancel(ctx)
 	sender := &Sender{
-		ctx:        ctx,
-		cancel:     cancel,
-		settings:   settings,
-		logger:     logger,
-		summaryMap: make(map[string]*service.SummaryItem),
-		configMap:  make(map[string]interface{}),
-		telemetry:  &service.TelemetryRecord{CoreVersion: version.Version},
+		ctx:           ctx,
+		cancel:        cancel,
+		backendCtx:    backendCtx,
+		cancelBackend: cancelBackend,
+		settings:      settings,
+		logger:        logger,
+		summaryMap:    make(map[string]*service.SummaryItem),
+		configMap:     make(map[string]interface{}),
+		telemetry:     &service.TelemetryRecord{CoreVersion: version.Version},
 	}
 	if !settings.GetXOffline().GetValue() {
-		baseHeaders := map[string]string{
-			"X-WANDB-USERNAME":   settings.GetUsername().GetValue(),
-			"X-WANDB-USER-EMAIL": settings.GetEmail().GetValue(),
-		}
-		graphqlRetryClient := clients.NewRetryClient(
-			clients.WithRetryClientLogger(logger),
-			clients.WithRetryClientHttpAuthTransport(
-				settings.GetApiKey().GetValue(),
-				baseHeaders,
-				settings.GetXExtraHttpHeaders().GetValue(),
-			),
-			clients.WithRetryClientRetryPolicy(clients.CheckRetry),
-			clients.WithRetryClientResponseLogger(logger.Logger, func(resp *http.Response) bool {
-				return resp.StatusCode >= 400
-			}),
-			clients.WithRetryClientRetryMax(int(settings.GetXGraphqlRetryMax().GetValue())),
-			clients.WithRetryClientRetryWaitMin(clients.SecondsToDuration(settings.GetXGraphqlRetryWaitMinSeconds().GetValue())),
-			clients.WithRetryClientRetryWaitMax(clients.SecondsToDuration(settings.GetXGraphqlRetryWaitMaxSeconds().GetValue())),
-			clients.WithRetryClientHttpTimeout(clients.SecondsToDuration(settings.GetXGraphqlTimeoutSeconds().GetValue())),
-			clients.WithRetryClientBackoff(clients.ExponentialBackoffWithJitter),
-		)
-		url := fmt.Sprintf("%s/graphql", settings.GetBaseUrl().GetValue())
-		sender.graphqlClient = graphql.NewClient(url, graphqlRetryClient.StandardClient())
-
-		headers := map[string]string{}
-		if settings.GetXShared().GetValue() {
-			headers["X-WANDB-USE-ASYNC-FILESTREAM"] = "true"
-		}
-		fileStreamRetryClient := clients.NewRetryClient(
-			clients.WithRetryClientLogger(logger),
-			clients.WithRetryClientResponseLogger(logger.Logger, func(resp *http.Response) bool {
-				return resp.StatusCode >= 400
-			}),
-			clients.WithRetryClientRetryMax(int(settings.GetXFileStreamRetryMax().GetValue())),
-			clients.WithRetryClientRetryWaitMin(clients.SecondsToDuration(settings.GetXFileStreamRetryWaitMinSeconds().GetValue())),
-			clients.WithRetryClientRetryWaitMax(clients.SecondsToDuration(settings.GetXFileStreamRetryWaitMaxSeconds().GetValue())),
-			clients.WithRetryClientHttpTimeout(clients.SecondsToDuration(settings.GetXFileStreamTimeoutSeconds().GetValue())),
-			clients.WithRetryClientHttpAuthTransport(sender.settings.GetApiKey().GetValue(), headers),
-			clients.WithRetryClientBackoff(clients.ExponentialBackoffWithJitter),
-			// TODO(core:beta): add custom retry function
-			// retryClient.CheckRetry = fs.GetCheckRetryFunc()
-		)
-		sender.fileStream = fs.NewFileStream(
-			fs.WithSettings(settings),
-			fs.WithLogger(logger),
-			fs.WithHttpClient(fileStreamRetryClient),
-			fs.WithClientId(shared.ShortID(32)),
-		)
-
-		fileTransferRetryClient := clients.NewRetryClient(
-			clients.WithRetryClientLogger(logger),
-			clients.WithRetryClientRetryPolicy(clients.CheckRetry),
-			clients.WithRetryClientRetryMax(int(settings.GetXFileTransferRetryMax().GetValue())),
-			clients.WithRetryClientRetryWaitMin(clients.SecondsToDuration(settings.GetXFileTransferRetryWaitMinSeconds().GetValue())),
-			clients.WithRetryClientRetryWaitMax(clients.SecondsToDuration(settings.GetXFileTransferRetryWaitMaxSeconds().GetValue())),
-			clients.WithRetryClientHttpTimeout(clients.SecondsToDuration(settings.GetXFileTransferTimeoutSeconds().GetValue())),
-			clients.WithRetryClientBackoff(clients.ExponentialBackoffWithJitter),
-		)
-		defaultFileTransfer := filetransfer.NewDefaultFileTransfer(
-			logger,
-			fileTransferRetryClient,
-		)
-		sender.fileTransferManager = filetransfer.NewFileTransferManager(
-			filetransfer.WithLogger(logger),
-			filetransfer.WithSettings(settings),
-			filetransfer.WithFileTransfer(defaultFileTransfer),
-			filetransfer.WithFSCChan(sender.fileStream.GetInputChan()),
-		)
-
-		sender.getServerInfo()
-
-		if !settings.GetDisableJobCreation().GetValue() {
-			sender.jobBuilder = launch.NewJobBuilder(settings, logger)
-		}
+		sender.startBackend()
 	}
 	sender.configDebouncer = debounce.NewDebouncer(
-		configDebouncerRateLimit,
+		debounceLimit(settings, configDebouncerRateLimit),
 		configDebouncerBurstSize,
 		logger,
 	)
@@ -223,23 +265,390 @@ func NewSender(
 	return sender
 }
 
+// startBackend makes the clients of the backend, for the runs that are not
+// offline as the sender is made and for the runs that go online later
+func (s *Sender) startBackend() {
+	baseHeaders := map[string]string{
+		"X-WANDB-USERNAME":   s.settings.GetUsername().GetValue(),
+		"X-WANDB-USER-EMAIL": s.settings.GetEmail().GetValue(),
+	}
+	s.checkBackendTransport()
+	// the proxies and certificates are the same for all the clients
+	transport := s.newTransport()
+	// the layer of the connection that fails is reported at the start
+	s.startPreflight(transport)
+	// the requests to the backend are paused during outages
+	breaker := s.newCircuitBreaker()
+	// the connectivity is set by the options, after the clients are made
+	reportConnectivity := func(connected bool) {
+		s.connectivity.SetConnected(connected && !breaker.IsOpen())
+	}
+	// the uploads of the file stream and the files share the bandwidth cap
+	bandwidth := clients.NewBandwidthLimiter(s.settings.GetXUploadBandwidthBytes().GetValue())
+	// the bodies sent to the backend are compressed once it supports it
+	compressor := s.newCompressor()
+	// the tracer is set by the options, after the clients are made
+	tracer := senderTracer{sender: s}
+	// the requests go to the secondary endpoints while the primary is down
+	failover := s.newFailover()
+	graphqlRetryClient := clients.NewRetryClient(
+		clients.WithRetryClientLogger(s.logger),
+		clients.WithRetryClientHttpTransport(transport),
+		clients.WithRetryClientTracer(tracer),
+		clients.WithRetryClientFailover(failover),
+		clients.WithRetryClientHttpAuthTransport(
+			s.settings.GetApiKey().GetValue(),
+			baseHeaders,
+			s.settings.GetXExtraHttpHeaders().GetValue(),
+		),
+		clients.WithRetryClientResponseLogger(s.logger.Logger, func(resp *http.Response) bool {
+			return resp.StatusCode >= 400
+		}),
+		clients.WithRetryClientConfig(retryConfig(
+			s.settings,
+			s.logger,
+			s.settings.GetXGraphqlRetryMax(),
+			s.settings.GetXGraphqlRetryWaitMinSeconds(),
+			s.settings.GetXGraphqlRetryWaitMaxSeconds(),
+			s.settings.GetXGraphqlTimeoutSeconds(),
+		)),
+		clients.WithRetryClientConnectivity(reportConnectivity),
+		clients.WithRetryClientCircuitBreaker(breaker),
+		clients.WithRetryClientIdempotencyKeys(),
+		clients.WithRetryClientCompression(compressor),
+	)
+	url := fmt.Sprintf("%s/graphql", s.settings.GetBaseUrl().GetValue())
+	s.graphqlClient = graphql.NewClient(url, graphqlRetryClient.StandardClient())
+
+	headers := map[string]string{}
+	if s.settings.GetXShared().GetValue() {
+		headers["X-WANDB-USE-ASYNC-FILESTREAM"] = "true"
+	}
+	fileStreamRetryClient := clients.NewRetryClient(
+		clients.WithRetryClientLogger(s.logger),
+		clients.WithRetryClientHttpTransport(transport),
+		clients.WithRetryClientTracer(tracer),
+		clients.WithRetryClientFailover(failover),
+		clients.WithRetryClientResponseLogger(s.logger.Logger, func(resp *http.Response) bool {
+			return resp.StatusCode >= 400
+		}),
+		clients.WithRetryClientConfig(retryConfig(
+			s.settings,
+			s.logger,
+			s.settings.GetXFileStreamRetryMax(),
+			s.settings.GetXFileStreamRetryWaitMinSeconds(),
+			s.settings.GetXFileStreamRetryWaitMaxSeconds(),
+			s.settings.GetXFileStreamTimeoutSeconds(),
+		)),
+		clients.WithRetryClientHttpAuthTransport(s.settings.GetApiKey().GetValue(), headers),
+		// slow down the uploads when the server reports rate limits
+		clients.WithRetryClientRateLimiter(clients.NewRateLimiter()),
+		clients.WithRetryClientConnectivity(reportConnectivity),
+		clients.WithRetryClientCircuitBreaker(breaker),
+		clients.WithRetryClientBandwidthLimiter(bandwidth),
+		clients.WithRetryClientCompression(compressor),
+	)
+	fileStreamOpts := []fs.FileStreamOption{
+		fs.WithSettings(s.settings),
+		fs.WithLogger(s.logger),
+		fs.WithHttpClient(fileStreamRetryClient),
+		fs.WithClientId(shared.ShortID(32)),
+		fs.WithContext(s.backendCtx),
+	}
+	// batch the history lines into fewer requests
+	if flushInterval := s.settings.GetXFileStreamFlushIntervalSeconds(); flushInterval != nil {
+		fileStreamOpts = append(fileStreamOpts,
+			fs.WithDelayProcess(clients.SecondsToDuration(flushInterval.GetValue())))
+	}
+	if maxBytes := s.settings.GetXFileStreamMaxBytes(); maxBytes != nil {
+		fileStreamOpts = append(fileStreamOpts, fs.WithMaxBytesPerPush(int(maxBytes.GetValue())))
+	}
+	// keep the run alive in the UI while nothing is logged
+	if heartbeat := s.settings.GetHeartbeatSeconds(); heartbeat != nil {
+		fileStreamOpts = append(fileStreamOpts,
+			fs.WithKeepaliveTime(time.Duration(heartbeat.GetValue())*time.Second))
+	}
+	s.fileStream = fs.NewFileStream(fileStreamOpts...)
+	s.historySampler, s.historyOverload = newHistorySampling(s.settings)
+
+	fileTransferRetryClient := clients.NewRetryClient(
+		clients.WithRetryClientLogger(s.logger),
+		clients.WithRetryClientHttpTransport(transport),
+		clients.WithRetryClientTracer(tracer),
+		clients.WithRetryClientFailover(failover),
+		clients.WithRetryClientConfig(retryConfig(
+			s.settings,
+			s.logger,
+			s.settings.GetXFileTransferRetryMax(),
+			s.settings.GetXFileTransferRetryWaitMinSeconds(),
+			s.settings.GetXFileTransferRetryWaitMaxSeconds(),
+			s.settings.GetXFileTransferTimeoutSeconds(),
+		)),
+		clients.WithRetryClientBandwidthLimiter(bandwidth),
+	)
+	defaultFileTransfer := filetransfer.NewDefaultFileTransfer(
+		s.backendCtx,
+		s.logger,
+		fileTransferRetryClient,
+	)
+	s.fileTransferManager = filetransfer.NewFileTransferManager(
+		filetransfer.WithLogger(s.logger),
+		filetransfer.WithSettings(s.settings),
+		filetransfer.WithFileTransfer(defaultFileTransfer),
+		filetransfer.WithFSCChan(s.fileStream.GetInputChan()),
+	)
+
+	// a slow artifact upload doesn't hold up the history
+	s.uploadPool = newWorkerPool("uploads", s.logger,
+		poolWorkers(s.logger, "_sender_upload_workers", s.settings.GetXSenderUploadWorkers(), defaultUploadWorkers),
+		BufferSize,
+	)
+	s.metadataPool = newWorkerPool("metadata", s.logger,
+		poolWorkers(s.logger, "_sender_metadata_workers", s.settings.GetXSenderMetadataWorkers(), defaultMetadataWorkers),
+		BufferSize,
+	)
+
+	s.uploadedFiles = newFileDigests()
+
+	s.getServerInfo()
+
+	if !s.settings.GetDisableJobCreation().GetValue() {
+		s.jobBuilder = launch.NewJobBuilder(s.settings, s.logger)
+	}
+}
+
+// retryConfig returns the retry configuration of a client of the sender from
+// its settings, the jitter and the retryable status codes are shared by all
+// the clients
+func retryConfig(
+	settings *service.Settings,
+	logger *observability.CoreLogger,
+	maxRetries *wrapperspb.Int32Value,
+	waitMin, waitMax, timeout *wrapperspb.DoubleValue,
+) clients.RetryConfig {
+	config := clients.RetryConfig{
+		MaxRetries: int(maxRetries.GetValue()),
+		WaitMin:    clients.SecondsToDuration(waitMin.GetValue()),
+		WaitMax:    clients.SecondsToDuration(waitMax.GetValue()),
+		Jitter:     clients.DefaultRetryJitter,
+		Timeout:    clients.SecondsToDuration(timeout.GetValue()),
+	}
+	if jitter := settings.GetXRetryJitter(); jitter != nil {
+		if jitter.GetValue() < 0 || jitter.GetValue() > 1 {
+			logger.CaptureWarn("sender: retry jitter must be between 0 and 1, using the default",
+				"jitter", jitter.GetValue())
+		} else {
+			config.Jitter = jitter.GetValue()
+		}
+	}
+	if codes := settings.GetXRetryStatusCodes().GetValue(); len(codes) > 0 {
+		var err error
+		if config.RetryableStatusCodes, err = clients.ParseRetryStatusCodes(codes); err != nil {
+			logger.CaptureWarn("sender: ignoring the retry status codes", "error", err)
+		}
+	}
+	return config
+}
+
+// newTransport returns the base transport of the clients, with the proxies
+// and the CA bundle of the settings
+func (s *Sender) newTransport() *http.Transport {
+	transport, err := clients.NewTransport(clients.TransportConfig{
+		Proxies:      s.settings.GetXProxies().GetValue(),
+		NoProxy:      s.settings.GetXNoProxy().GetValue(),
+		CABundlePath: s.settings.GetXCaBundlePath().GetValue(),
+	})
+	if err != nil {
+		s.logger.CaptureError("sender: error loading CA bundle, using the system certificates", err)
+	}
+	return transport
+}
+
+// checkBackendTransport warns if the settings select a backend transport
+// other than GraphQL and the file stream over HTTPS, which is the only one
+// the backend serves
+func (s *Sender) checkBackendTransport() {
+	switch transport := s.settings.GetXBackendTransport().GetValue(); transport {
+	case "", backendTransportHTTP:
+	default:
+		s.logger.CaptureWarn("sender: unsupported backend transport, using https",
+			"transport", transport)
+	}
+}
+
+// newCompressor returns the compressor of the request bodies sent to the
+// backend, the uploads to the storage are not compressed
+func (s *Sender) newCompressor() *clients.Compressor {
+	mode, err := clients.ParseCompressionMode(s.settings.GetXRequestCompression().GetValue())
+	if err != nil {
+		s.logger.CaptureWarn("sender: ignoring the request compression setting", "error", err)
+	}
+	return clients.NewCompressor(mode)
+}
+
+// newCircuitBreaker returns the circuit breaker of the requests to the
+// backend, or nil if it is disabled
+func (s *Sender) newCircuitBreaker() *clients.CircuitBreaker {
+	failures := defaultCircuitBreakerFailures
+	if setting := s.settings.GetXCircuitBreakerFailures(); setting != nil {
+		failures = int(setting.GetValue())
+	}
+	if failures <= 0 {
+		return nil
+	}
+	cooldown := defaultCircuitBreakerCooldown
+	if setting := s.settings.GetXCircuitBreakerCooldownSeconds(); setting.GetValue() > 0 {
+		cooldown = clients.SecondsToDuration(setting.GetValue())
+	}
+	return clients.NewCircuitBreaker(failures, cooldown,
+		func(failures int, err error) { s.circuitOpened(failures, cooldown, err) },
+		s.circuitClosed,
+	)
+}
+
+// circuitOpened switches the run to buffered mode while the requests to the
+// backend are paused, and warns the user once for the outage
+func (s *Sender) circuitOpened(failures int, cooldown time.Duration, err error) {
+	s.logger.CaptureWarn("sender: backend unreachable, pausing requests",
+		"failures", failures, "cooldown", cooldown, "error", err)
+	s.connectivity.SetConnected(false)
+	s.forwardAsync(&service.Record{
+		RecordType: &service.Record_Request{Request: &service.Request{
+			RequestType: &service.Request_CircuitBreaker{CircuitBreaker: &service.CircuitBreakerRequest{
+				Failures:        int32(failures),
+				CooldownSeconds: clients.DurationToSeconds(cooldown),
+				Error:           err.Error(),
+			}},
+		}},
+		Control: &service.Control{Local: true, AlwaysSend: true},
+	})
+}
+
+// forwardAsync forwards a record to the handler from outside of the loop of
+// the sender, unless the stream is closing: the forward channel is closed
+// once the context is cancelled at the end of the defer state machine
+func (s *Sender) forwardAsync(record *service.Record) {
+	if s.ctx.Err() != nil {
+		return
+	}
+	select {
+	case s.fwdChan <- record:
+	case <-s.ctx.Done():
+	}
+}
+
+// circuitClosed resumes forwarding the records once the backend is reachable
+// again
+func (s *Sender) circuitClosed() {
+	s.logger.Info("sender: backend reachable again, resuming requests")
+	s.connectivity.SetConnected(true)
+}
+
+// newFailover returns the failover among the base URL and the failover base
+// URLs of the settings, nil if there are none
+func (s *Sender) newFailover() *clients.Failover {
+	secondaries := s.settings.GetXFailoverBaseUrls().GetValue()
+	if len(secondaries) == 0 {
+		return nil
+	}
+	recoverAfter := defaultFailoverRecover
+	if setting := s.settings.GetXFailoverRecoverSeconds(); setting.GetValue() > 0 {
+		recoverAfter = clients.SecondsToDuration(setting.GetValue())
+	}
+	failover, err := clients.NewFailover(
+		append([]string{s.settings.GetBaseUrl().GetValue()}, secondaries...),
+		recoverAfter,
+		func(from, to string) {
+			s.logger.Warn("sender: switching the backend endpoint", "from", from, "to", to)
+		},
+	)
+	if err != nil {
+		s.logger.CaptureWarn("sender: ignoring the failover base urls", "error", err)
+		return nil
+	}
+	return failover
+}
+
 // do sending of messages to the server
 func (s *Sender) Do(inChan <-chan *service.Record) {
 	defer s.logger.Reraise()
 	s.logger.Info("sender: started", "stream_id", s.settings.RunId)
 
-	for record := range inChan {
-		s.sendRecord(record)
+	for {
+		record, ok := s.nextRecord(inChan)
+		if !ok {
+			break
+		}
+		if record != nil {
+			s.sendRecord(record)
+		}
 		// TODO: reevaluate the logic here
 		s.configDebouncer.Debounce(s.upsertConfig)
 	}
+	s.configDebouncer.Flush(s.upsertConfig)
 	s.Close()
 	s.logger.Info("sender: closed", "stream_id", s.settings.RunId)
 }
 
+// nextRecord returns the next record to send, the priority records first. It
+// returns a nil record once the debounced config update is due, and false
+// once the input channel is closed and the priority records are sent.
+func (s *Sender) nextRecord(inChan <-chan *service.Record) (*service.Record, bool) {
+	priority := s.priorityChan
+	if s.RunRecord == nil && len(inChan) > 0 {
+		// the queued records may set up the run the priority records
+		// refer to
+		priority = nil
+	}
+	select {
+	case record, ok := <-priority:
+		if ok {
+			return record, true
+		}
+		s.priorityChan = nil
+		return s.nextRecord(inChan)
+	default:
+	}
+	select {
+	case record, ok := <-inChan:
+		if ok {
+			return record, true
+		}
+		// the writer closes the priority channel first
+		if s.priorityChan != nil {
+			if record, ok := <-s.priorityChan; ok {
+				return record, true
+			}
+			s.priorityChan = nil
+		}
+		return nil, false
+	case record, ok := <-priority:
+		if ok {
+			return record, true
+		}
+		s.priorityChan = nil
+		return s.nextRecord(inChan)
+	case <-s.configDebouncer.Ready():
+		return nil, true
+	}
+}
+
 func (s *Sender) Close() {
+	// the tasks of the pools respond on the dispatch channel
+	s.uploadPool.close()
+	s.metadataPool.close()
 	// sender is done processing data, close our dispatch channel
 	close(s.outChan)
+	s.finishStopPolling()
+	s.cancelBackend()
+}
+
+// Stats returns the metrics of the worker pools of the sender
+func (s *Sender) Stats() SenderStats {
+	return SenderStats{
+		Uploads:  s.uploadPool.stats(),
+		Metadata: s.metadataPool.stats(),
+	}
 }
 
 func (s *Sender) GetOutboundChannel() chan *service.Result {
@@ -263,6 +672,9 @@ func (s *Sender) sendRecord(record *service.Record) {
 		s.sendRun(record, x.Run)
 	case *service.Record_Footer:
 	case *service.Record_Header:
+	case *service.Record_Snapshot:
+	// the environment is synced with the metadata file of the run
+	case *service.Record_Environment:
 	case *service.Record_Final:
 	case *service.Record_Exit:
 		s.sendExit(record, x.Exit)
@@ -293,6 +705,7 @@ func (s *Sender) sendRecord(record *service.Record) {
 	case *service.Record_UseArtifact:
 		s.sendUseArtifact(record)
 	case *service.Record_Artifact:
+		s.sendArtifact(record, x.Artifact)
 	case nil:
 		err := fmt.Errorf("sender: sendRecord: nil RecordType")
 		s.logger.CaptureFatalAndPanic("sender: sendRecord: nil RecordType", err)
@@ -323,6 +736,14 @@ func (s *Sender) sendRequest(record *service.Record, request *service.Request) {
 		s.sendSync(record, x.Sync)
 	case *service.Request_SenderRead:
 		s.sendSenderRead(record, x.SenderRead)
+	case *service.Request_StoreStatus:
+		s.sendStoreStatus(record)
+	case *service.Request_RunMove:
+		s.sendRunMove(record, x.RunMove)
+	case *service.Request_Preflight:
+		s.sendPreflight(record, x.Preflight)
+	case *service.Request_GoOnline:
+		s.sendGoOnline(record)
 	case *service.Request_Cancel:
 		// TODO: audit this
 	case nil:
@@ -358,10 +779,19 @@ func (s *Sender) updateSettings() {
 	}
 }
 
+// debounceLimit returns the rate of the debounced updates of the run, the
+// default unless it is set in the settings
+func debounceLimit(settings *service.Settings, limit rate.Limit) rate.Limit {
+	if seconds := settings.GetXDebounceSeconds().GetValue(); seconds > 0 {
+		return rate.Every(clients.SecondsToDuration(seconds))
+	}
+	return limit
+}
+
 // sendRun starts up all the resources for a run
 func (s *Sender) sendRunStart(_ *service.RunStartRequest) {
-	fsPath := fmt.Sprintf("%s/files/%s/%s/%s/file_stream",
-		s.settings.GetBaseUrl().GetValue(), s.RunRecord.Entity, s.RunRecord.Project, s.RunRecord.RunId)
+	fsPath := fs.Path(s.settings.GetBaseUrl().GetValue(),
+		s.RunRecord.Entity, s.RunRecord.Project, s.RunRecord.RunId)
 
 	fs.WithPath(fsPath)(s.fileStream)
 	fs.WithOffsets(s.resumeState.GetFileStreamOffset())(s.fileStream)
@@ -369,6 +799,7 @@ func (s *Sender) sendRunStart(_ *service.RunStartRequest) {
 	s.updateSettings()
 	s.fileStream.Start()
 	s.fileTransferManager.Start()
+	s.startStopPolling()
 }
 
 func (s *Sender) sendNetworkStatusRequest(_ *service.NetworkStatusRequest) {
@@ -402,7 +833,7 @@ func (s *Sender) sendJobFlush() {
 		return
 	}
 	saver := artifacts.NewArtifactSaver(
-		s.ctx, s.graphqlClient, s.fileTransferManager, artifact, 0, "",
+		s.backendCtx, s.graphqlClient, s.fileTransferManager, artifact, 0, "",
 	)
 	if _, err = saver.Save(s.fwdChan); err != nil {
 		s.logger.Error("sender: sendDefer: failed to save job artifact", "error", err)
@@ -438,6 +869,8 @@ func (s *Sender) sendDefer(request *service.DeferRequest) {
 		request.State++
 		s.sendRequestDefer(request)
 	case service.DeferRequest_FLUSH_JOB:
+		// the job refers to the code artifact that may still be uploading
+		s.uploadPool.wait()
 		s.sendJobFlush()
 		request.State++
 		s.sendRequestDefer(request)
@@ -445,6 +878,9 @@ func (s *Sender) sendDefer(request *service.DeferRequest) {
 		request.State++
 		s.sendRequestDefer(request)
 	case service.DeferRequest_FLUSH_FP:
+		// the pools schedule the file transfers
+		s.metadataPool.wait()
+		s.uploadPool.wait()
 		s.fileTransferManager.Close()
 		request.State++
 		s.sendRequestDefer(request)
@@ -462,6 +898,8 @@ func (s *Sender) sendDefer(request *service.DeferRequest) {
 		request.State++
 		s.syncService.Flush()
 		s.respondExit(s.exitRecord)
+		// the poller forwards to the loopback channel, it is stopped first
+		s.finishStopPolling()
 		// cancel tells the stream to close the loopback channel
 		s.cancel()
 	default:
@@ -480,6 +918,12 @@ func (s *Sender) sendRequestDefer(request *service.DeferRequest) {
 	s.fwdChan <- rec
 }
 
+// sendStoreStatus passes a store error reported by the writer back to the
+// handler, which surfaces it to the client
+func (s *Sender) sendStoreStatus(record *service.Record) {
+	s.fwdChan <- record
+}
+
 func (s *Sender) sendTelemetry(_ *service.Record, telemetry *service.TelemetryRecord) {
 	proto.Merge(s.telemetry, telemetry)
 	s.updateConfigPrivate(s.telemetry)
@@ -572,6 +1016,24 @@ func (s *Sender) updateConfigPrivate(telemetry *service.TelemetryRecord) {
 	}
 }
 
+// configRecord returns the keys of the config map as a config record,
+// without the private _wandb key
+func (s *Sender) configRecord() *service.ConfigRecord {
+	record := &service.ConfigRecord{}
+	for key, value := range s.configMap {
+		if key == "_wandb" {
+			continue
+		}
+		valueJson, err := json.Marshal(value)
+		if err != nil {
+			s.logger.CaptureError("sender: configRecord: failed to marshal config value", err)
+			continue
+		}
+		record.Update = append(record.Update, &service.ConfigItem{Key: key, ValueJson: string(valueJson)})
+	}
+	return record
+}
+
 // serializeConfig serializes the config map to a json string
 // that can be sent to the server
 func (s *Sender) serializeConfig(format string) string {
@@ -623,13 +1085,9 @@ func (s *Sender) checkAndUpdateResumeState(record *service.Record) error {
 	// If we couldn't get the resume status, we should fail if resume is set
 	data, err := gql.RunResumeStatus(s.ctx, s.graphqlClient, &run.Project, utils.NilIfZero(run.Entity), run.RunId)
 	if err != nil {
-		err = fmt.Errorf("failed to get run resume status: %s", err)
+		err = fmt.Errorf("failed to get run resume status: %w", err)
 		s.logger.Error("sender:", "error", err)
-		result := &service.RunUpdateResult{
-			Error: &service.ErrorInfo{
-				Message: err.Error(),
-				Code:    service.ErrorInfo_COMMUNICATION,
-			}}
+		result := &service.RunUpdateResult{Error: errorInfo(err)}
 		s.sendRunResult(record, result)
 		return err
 	}
@@ -639,6 +1097,11 @@ func (s *Sender) checkAndUpdateResumeState(record *service.Record) error {
 		return err
 	}
 
+	// the summary file has the keys of the resumed run
+	for _, item := range s.RunRecord.GetSummary().GetUpdate() {
+		s.summaryMap[item.GetKey()] = item
+	}
+
 	return nil
 }
 
@@ -664,58 +1127,25 @@ func (s *Sender) sendRun(record *service.Record, run *service.RunRecord) {
 		proto.Merge(s.telemetry, run.Telemetry)
 		s.updateConfigPrivate(run.Telemetry)
 		config := s.serializeConfig("json")
-
-		var tags []string
-		tags = append(tags, run.Tags...)
-
-		var commit, repo string
-		git := run.GetGit()
-		if git != nil {
-			commit = git.GetCommit()
-			repo = git.GetRemoteUrl()
+		// a resumed run gets back the config it was logged with
+		if s.RunRecord.GetResumed() {
+			s.RunRecord.Config = s.configRecord()
 		}
 
-		program := s.settings.GetProgram().GetValue()
 		// start a new context with an additional argument from the parent context
 		// this is used to pass the retry function to the graphql client
 		ctx := context.WithValue(s.ctx, clients.CtxRetryPolicyKey, clients.UpsertBucketRetryPolicy)
-		data, err := gql.UpsertBucket(
-			ctx,                              // ctx
-			s.graphqlClient,                  // client
-			nil,                              // id
-			&run.RunId,                       // name
-			utils.NilIfZero(run.Project),     // project
-			utils.NilIfZero(run.Entity),      // entity
-			utils.NilIfZero(run.RunGroup),    // groupName
-			nil,                              // description
-			utils.NilIfZero(run.DisplayName), // displayName
-			utils.NilIfZero(run.Notes),       // notes
-			utils.NilIfZero(commit),          // commit
-			&config,                          // config
-			utils.NilIfZero(run.Host),        // host
-			nil,                              // debug
-			utils.NilIfZero(program),         // program
-			utils.NilIfZero(repo),            // repo
-			utils.NilIfZero(run.JobType),     // jobType
-			nil,                              // state
-			nil,                              // sweep
-			tags,                             // tags []string,
-			nil,                              // summaryMetrics
-		)
+		ctx = clients.WithIdempotencyKey(ctx)
+		data, err := s.upsertRun(ctx, run, config)
 		if err != nil {
-			err = fmt.Errorf("failed to upsert bucket: %s", err)
+			err = fmt.Errorf("failed to upsert bucket: %w", err)
 			s.logger.Error("sender: sendRun:", "error", err)
 			// TODO(sync): make this more robust in case of a failed UpsertBucket request.
 			//  Need to inform the sync service that this ops failed.
 			if record.GetControl().GetReqResp() || record.GetControl().GetMailboxSlot() != "" {
 				result := &service.Result{
 					ResultType: &service.Result_RunResult{
-						RunResult: &service.RunUpdateResult{
-							Error: &service.ErrorInfo{
-								Message: err.Error(),
-								Code:    service.ErrorInfo_COMMUNICATION,
-							},
-						},
+						RunResult: &service.RunUpdateResult{Error: s.withPreflight(errorInfo(err))},
 					},
 					Control: record.Control,
 					Uuid:    record.Uuid,
@@ -728,6 +1158,11 @@ func (s *Sender) sendRun(record *service.Record, run *service.RunRecord) {
 		s.RunRecord.DisplayName = *data.UpsertBucket.Bucket.DisplayName
 		s.RunRecord.Project = data.UpsertBucket.Bucket.Project.Name
 		s.RunRecord.Entity = data.UpsertBucket.Bucket.Project.Entity.Name
+
+		if s.startOnRun {
+			s.startOnRun = false
+			s.sendRunStart(nil)
+		}
 	}
 
 	if record.GetControl().GetReqResp() || record.GetControl().GetMailboxSlot() != "" {
@@ -746,19 +1181,59 @@ func (s *Sender) sendRun(record *service.Record, run *service.RunRecord) {
 	}
 }
 
-// sendHistory sends a history record to the file stream,
-// which will then send it to the server
-func (s *Sender) sendHistory(record *service.Record, _ *service.HistoryRecord) {
-	s.fileStream.StreamRecord(record)
+// upsertRun creates or updates the run on the backend with its metadata and
+// the serialized config
+func (s *Sender) upsertRun(ctx context.Context, run *service.RunRecord, config string) (*gql.UpsertBucketResponse, error) {
+	var tags []string
+	tags = append(tags, run.Tags...)
+
+	var commit, repo string
+	git := run.GetGit()
+	if git != nil {
+		commit = git.GetCommit()
+		repo = git.GetRemoteUrl()
+	}
+
+	program := s.settings.GetProgram().GetValue()
+	// the runs of a sweep agent are part of the sweep
+	sweep := s.settings.GetSweepId().GetValue()
+	return gql.UpsertBucket(
+		ctx,                              // ctx
+		s.graphqlClient,                  // client
+		nil,                              // id
+		&run.RunId,                       // name
+		utils.NilIfZero(run.Project),     // project
+		utils.NilIfZero(run.Entity),      // entity
+		utils.NilIfZero(run.RunGroup),    // groupName
+		nil,                              // description
+		utils.NilIfZero(run.DisplayName), // displayName
+		utils.NilIfZero(run.Notes),       // notes
+		utils.NilIfZero(commit),          // commit
+		&config,                          // config
+		utils.NilIfZero(run.Host),        // host
+		nil,                              // debug
+		utils.NilIfZero(program),         // program
+		utils.NilIfZero(repo),            // repo
+		utils.NilIfZero(run.JobType),     // jobType
+		nil,                              // state
+		utils.NilIfZero(sweep),           // sweep
+		tags,                             // tags []string,
+		nil,                              // summaryMetrics
+	)
 }
 
+// sendHistory sends a history record to the file stream,
+// which will then send it to the server
 func (s *Sender) sendSummary(_ *service.Record, summary *service.SummaryRecord) {
 	// TODO(network): buffer summary sending for network efficiency until we can send only updates
-	// TODO(compat): handle deletes, nested keys
 	// TODO(compat): write summary file
 
-	// track each key in the in memory summary store
+	// track each key in the in memory summary store, the handler
+	// consolidates the nested keys into their top level keys
 	// TODO(memory): avoid keeping summary for all distinct keys
+	for _, item := range summary.GetRemove() {
+		delete(s.summaryMap, item.GetKey())
+	}
 	for _, item := range summary.Update {
 		s.summaryMap[item.Key] = item
 	}
@@ -782,12 +1257,13 @@ func (s *Sender) sendSummary(_ *service.Record, summary *service.SummaryRecord)
 }
 
 func (s *Sender) upsertConfig() {
-	if s.graphqlClient == nil {
+	if s.graphqlClient == nil || s.RunRecord == nil {
 		return
 	}
 	config := s.serializeConfig("json")
 
-	ctx := context.WithValue(s.ctx, clients.CtxRetryPolicyKey, clients.UpsertBucketRetryPolicy)
+	ctx := context.WithValue(s.backendCtx, clients.CtxRetryPolicyKey, clients.UpsertBucketRetryPolicy)
+	ctx = clients.WithIdempotencyKey(ctx)
 	_, err := gql.UpsertBucket(
 		ctx,                                  // ctx
 		s.graphqlClient,                      // client
@@ -861,7 +1337,6 @@ func (s *Sender) sendOutputRaw(record *service.Record, _ *service.OutputRawRecor
 	// TODO: match logic handling of lines to the one in the python version
 	// - handle carriage returns (for tqdm-like progress bars)
 	// - handle caching multiple (non-new lines) and sending them in one chunk
-	// - handle lines longer than ~60_000 characters
 
 	// copy the record to avoid mutating the original
 	recordCopy := proto.Clone(record).(*service.Record)
@@ -888,7 +1363,12 @@ func (s *Sender) sendOutputRaw(record *service.Record, _ *service.OutputRawRecor
 	}()
 
 	// generate compatible timestamp to python iso-format (microseconds without Z)
-	t := strings.TrimSuffix(time.Now().UTC().Format(RFC3339Micro), "Z")
+	// from the time the line was written, for the lines held by the handler
+	now := time.Now()
+	if outputRaw.GetTimestamp() != nil {
+		now = outputRaw.GetTimestamp().AsTime()
+	}
+	t := strings.TrimSuffix(now.UTC().Format(RFC3339Micro), "Z")
 	outputRaw.Line = fmt.Sprintf("%s %s", t, outputRaw.Line)
 	if outputRaw.OutputType == service.OutputRawRecord_STDERR {
 		outputRaw.Line = fmt.Sprintf("ERROR %s", outputRaw.Line)
@@ -905,37 +1385,51 @@ func (s *Sender) sendAlert(_ *service.Record, alert *service.AlertRecord) {
 		err := fmt.Errorf("sender: sendAlert: RunRecord not set")
 		s.logger.CaptureFatalAndPanic("sender received error", err)
 	}
-	// TODO: handle invalid alert levels
-	severity := gql.AlertSeverity(alert.Level)
-
-	data, err := gql.NotifyScriptableRunAlert(
-		s.ctx,
-		s.graphqlClient,
-		s.RunRecord.Entity,
-		s.RunRecord.Project,
-		s.RunRecord.RunId,
-		alert.Title,
-		alert.Text,
-		&severity,
-		&alert.WaitDuration,
-	)
-	if err != nil {
-		err = fmt.Errorf("sender: sendAlert: failed to notify scriptable run alert: %s", err)
-		s.logger.CaptureError("sender received error", err)
-	} else {
-		s.logger.Info("sender: sendAlert: notified scriptable run alert", "data", data)
-	}
-
+	// the level of an alert read from a transaction log was not validated
+	severity, ok := alertSeverity(alert.GetLevel())
+	if !ok {
+		s.logger.CaptureWarn("sender: sendAlert: unknown alert level", "level", alert.GetLevel())
+		severity = gql.AlertSeverityInfo
+	}
+	entity, project, runID := s.RunRecord.Entity, s.RunRecord.Project, s.RunRecord.RunId
+
+	s.metadataPool.submit(func() {
+		data, err := gql.NotifyScriptableRunAlert(
+			clients.WithIdempotencyKey(s.backendCtx),
+			s.graphqlClient,
+			entity,
+			project,
+			runID,
+			alert.Title,
+			alert.Text,
+			&severity,
+			&alert.WaitDuration,
+		)
+		if err != nil {
+			err = fmt.Errorf("sender: sendAlert: failed to notify scriptable run alert: %s", err)
+			s.logger.CaptureError("sender received error", err)
+		} else {
+			s.logger.Info("sender: sendAlert: notified scriptable run alert", "data", data)
+		}
+	})
 }
 
 // respondExit called from the end of the defer state machine
 func (s *Sender) respondExit(record *service.Record) {
+	exitResult := s.exitFlush.result(s.fileStream.UnsentRequests(), s.settings.GetSyncFile().GetValue())
+	if exitResult.GetPartialSync() {
+		s.logger.CaptureWarn("sender: run finished with unsent data",
+			"files", len(exitResult.GetUnsentFiles()),
+			"artifacts", len(exitResult.GetUnsentArtifacts()),
+			"fileStreamRequests", exitResult.GetUnsentFileStreamRequests(),
+			"syncFile", exitResult.GetSyncFile())
+	}
 	if record == nil || s.settings.GetXSync().GetValue() {
 		return
 	}
 	if record.Control.ReqResp || record.Control.MailboxSlot != "" {
 		result := &service.Result{
-			ResultType: &service.Result_ExitResult{ExitResult: &service.RunExitResult{}},
+			ResultType: &service.Result_ExitResult{ExitResult: exitResult},
 			Control:    record.Control,
 			Uuid:       record.Uuid,
 		}
@@ -947,7 +1441,19 @@ func (s *Sender) respondExit(record *service.Record) {
 func (s *Sender) sendExit(record *service.Record, _ *service.RunExitRecord) {
 	// response is done by respondExit() and called when defer state machine is complete
 	s.exitRecord = record
+	s.finishStopPolling()
+
+	// the uploads left at the deadline are cancelled so that the run finishes
+	s.exitFlush.start(
+		clients.SecondsToDuration(s.settings.GetXExitFlushTimeoutSeconds().GetValue()),
+		func() {
+			s.logger.CaptureWarn("sender: final flush timed out, cancelling the uploads",
+				"timeout", s.settings.GetXExitFlushTimeoutSeconds().GetValue())
+			s.cancelBackend()
+		},
+	)
 
+	s.flushHistorySampling()
 	s.fileStream.StreamRecord(record)
 
 	// send a defer request to the handler to indicate that the user requested to finish the stream
@@ -1007,28 +1513,53 @@ func (s *Sender) sendFile(file *service.FilesItem) {
 		s.logger.CaptureFatalAndPanic("sender received error", err)
 	}
 
-	fullPath := filepath.Join(s.settings.GetFilesDir().GetValue(), file.GetPath())
+	filesDir := s.settings.GetFilesDir().GetValue()
+	fullPath := filepath.Join(filesDir, file.GetPath())
 	if _, err := os.Stat(fullPath); os.IsNotExist(err) {
 		s.logger.Warn("sender: sendFile: file does not exist", "path", fullPath)
 		return
 	}
 
+	// the upload URLs are requested outside of the sender loop
+	entity, project, runID := s.RunRecord.Entity, s.RunRecord.Project, s.RunRecord.RunId
+	s.metadataPool.submit(func() {
+		s.uploadFile(file, filesDir, entity, project, runID)
+	})
+}
+
+// uploadFile gets the upload URL of a run file and schedules its upload. A
+// file with the content already uploaded is skipped.
+func (s *Sender) uploadFile(file *service.FilesItem, filesDir, entity, project, runID string) {
+	key := fileDigestKey(entity, project, runID, file.GetPath())
+	digest, err := utils.ComputeFileB64MD5(filepath.Join(filesDir, file.GetPath()))
+	if err != nil {
+		s.logger.Warn("sender: uploadFile: failed to compute the digest", "path", file.GetPath(), "error", err)
+		digest = ""
+	}
+	if digest != "" && s.uploadedFiles.uploaded(key, digest) {
+		s.logger.Debug("sender: uploadFile: skipping unchanged file", "path", file.GetPath(), "digest", digest)
+		return
+	}
+
 	data, err := gql.CreateRunFiles(
-		s.ctx,
+		clients.WithIdempotencyKey(s.backendCtx),
 		s.graphqlClient,
-		s.RunRecord.Entity,
-		s.RunRecord.Project,
-		s.RunRecord.RunId,
+		entity,
+		project,
+		runID,
 		[]string{file.GetPath()},
 	)
 	if err != nil {
+		if s.backendCtx.Err() != nil {
+			s.exitFlush.addUnsentFile(filepath.Join(filesDir, file.GetPath()))
+		}
 		err = fmt.Errorf("sender: sendFile: failed to get upload urls: %s", err)
 		s.logger.CaptureError("sender received error", err)
 		return
 	}
 
 	for _, f := range data.GetCreateRunFiles().GetFiles() {
-		fullPath := filepath.Join(s.settings.GetFilesDir().GetValue(), f.Name)
+		fullPath := filepath.Join(filesDir, f.Name)
 		task := &filetransfer.Task{
 			Type: filetransfer.UploadTask,
 			Path: fullPath,
@@ -1059,6 +1590,16 @@ func (s *Sender) sendFile(file *service.FilesItem) {
 			},
 		)
 		task.AddCompletionCallback(s.fileTransferManager.FileStreamCallback())
+		task.AddCompletionCallback(
+			func(task *filetransfer.Task) {
+				if task.Err != nil && s.backendCtx.Err() != nil {
+					s.exitFlush.addUnsentFile(fullPath)
+				}
+				if task.Err == nil && digest != "" {
+					s.uploadedFiles.record(key, digest)
+				}
+			},
+		)
 		task.AddCompletionCallback(
 			func(*filetransfer.Task) {
 				fileCounts := &service.FileCounts{}
@@ -1095,12 +1636,42 @@ func (s *Sender) sendFile(file *service.FilesItem) {
 }
 
 func (s *Sender) sendLogArtifact(record *service.Record, msg *service.LogArtifactRequest) {
+	s.uploadPool.submit(func() {
+		s.logArtifact(record, msg)
+	})
+}
+
+// sendArtifact saves an artifact logged while offline, no one waits for its
+// ID
+func (s *Sender) sendArtifact(_ *service.Record, artifact *service.ArtifactRecord) {
+	if s.graphqlClient == nil {
+		return
+	}
+	s.uploadPool.submit(func() {
+		// the files of the artifact are not staged, they are kept once saved
+		saver := artifacts.NewArtifactSaver(
+			s.backendCtx, s.graphqlClient, s.fileTransferManager, artifact, 0, "",
+		)
+		if _, err := saver.Save(s.fwdChan); err != nil {
+			if s.backendCtx.Err() != nil {
+				s.exitFlush.addUnsentArtifact(artifact.GetName())
+			}
+			s.logger.CaptureError("sender: failed to save artifact", err, "name", artifact.GetName())
+		}
+	})
+}
+
+// logArtifact saves an artifact and responds with its ID
+func (s *Sender) logArtifact(record *service.Record, msg *service.LogArtifactRequest) {
 	var response service.LogArtifactResponse
 	saver := artifacts.NewArtifactSaver(
-		s.ctx, s.graphqlClient, s.fileTransferManager, msg.Artifact, msg.HistoryStep, msg.StagingDir,
+		s.backendCtx, s.graphqlClient, s.fileTransferManager, msg.Artifact, msg.HistoryStep, msg.StagingDir,
 	)
 	artifactID, err := saver.Save(s.fwdChan)
 	if err != nil {
+		if s.backendCtx.Err() != nil {
+			s.exitFlush.addUnsentArtifact(msg.GetArtifact().GetName())
+		}
 		response.ErrorMessage = err.Error()
 	} else {
 		response.ArtifactId = artifactID
@@ -1125,8 +1696,15 @@ func (s *Sender) sendDownloadArtifact(record *service.Record, msg *service.Downl
 	// TODO: this should be handled by a separate service starup mechanism
 	s.fileTransferManager.Start()
 
+	s.uploadPool.submit(func() {
+		s.downloadArtifact(record, msg)
+	})
+}
+
+// downloadArtifact downloads an artifact and responds when it is done
+func (s *Sender) downloadArtifact(record *service.Record, msg *service.DownloadArtifactRequest) {
 	var response service.DownloadArtifactResponse
-	downloader := artifacts.NewArtifactDownloader(s.ctx, s.graphqlClient, s.fileTransferManager, msg.ArtifactId, msg.DownloadRoot, &msg.AllowMissingReferences)
+	downloader := artifacts.NewArtifactDownloader(s.backendCtx, s.graphqlClient, s.fileTransferManager, msg.ArtifactId, msg.DownloadRoot, &msg.AllowMissingReferences, artifacts.NewFileCache(artifacts.DefaultCacheDir()))
 	err := downloader.Download()
 	if err != nil {
 		s.logger.CaptureError("senderError: downloadArtifact: failed to download artifact: %v", err)
@@ -1148,6 +1726,13 @@ func (s *Sender) sendDownloadArtifact(record *service.Record, msg *service.Downl
 }
 
 func (s *Sender) sendSync(record *service.Record, request *service.SyncRequest) {
+	if request.GetVerify() {
+		s.verifySyncFile(record)
+		return
+	}
+	if request.GetCompact() {
+		s.compactSyncFile()
+	}
 
 	s.syncService = NewSyncService(s.ctx,
 		WithSyncServiceLogger(s.logger),
@@ -1205,10 +1790,69 @@ func (s *Sender) sendSync(record *service.Record, request *service.SyncRequest)
 	s.fwdChan <- rec
 }
 
+// compactSyncFile compacts the store being synced in place, so that the
+// superseded records are not uploaded. The records are synced uncompacted if
+// it fails.
+func (s *Sender) compactSyncFile() {
+	key, err := StoreEncryptionKey(s.settings)
+	if err != nil {
+		s.logger.CaptureError("sender: compactSyncFile: invalid store encryption key", err)
+		return
+	}
+	fileName := s.settings.GetSyncFile().GetValue()
+	stats, err := CompactStore(s.ctx, fileName, "", s.logger, StoreOptions{EncryptionKey: key})
+	if err != nil {
+		s.logger.CaptureError("sender: compactSyncFile: failed to compact store", err)
+		return
+	}
+	s.logger.Info("sender: compacted sync file", "name", fileName,
+		"records_read", stats.RecordsRead, "records_written", stats.RecordsWritten)
+}
+
+// verifySyncFile responds to a sync request that only verifies the store,
+// the records are not synced
+func (s *Sender) verifySyncFile(record *service.Record) {
+	response := &service.SyncResponse{}
+	key, err := StoreEncryptionKey(s.settings)
+	if err == nil {
+		response.VerifyReport, err = VerifyStore(s.ctx, s.settings.GetSyncFile().GetValue(), s.logger,
+			StoreOptions{EncryptionKey: key},
+		)
+	}
+	if err == nil {
+		err = verifyError(response.VerifyReport)
+	}
+	if err != nil {
+		s.logger.CaptureError("sender: verifySyncFile: store verification failed", err)
+		response.Error = &service.ErrorInfo{
+			Message: err.Error(),
+			Code:    service.ErrorInfo_UNKNOWN,
+		}
+	}
+	s.outChan <- &service.Result{
+		ResultType: &service.Result_Response{
+			Response: &service.Response{
+				ResponseType: &service.Response_SyncResponse{SyncResponse: response},
+			},
+		},
+		Control: record.Control,
+		Uuid:    record.Uuid,
+	}
+}
+
 func (s *Sender) sendSenderRead(record *service.Record, request *service.SenderReadRequest) {
 	if s.store == nil {
-		store := NewStore(s.ctx, s.settings.GetSyncFile().GetValue(), s.logger)
-		err := store.Open(os.O_RDONLY)
+		key, err := StoreEncryptionKey(s.settings)
+		if err != nil {
+			s.logger.CaptureError("sender: sendSenderRead: invalid store encryption key", err)
+			return
+		}
+		// a file being synced is complete, so it can be mapped, while a file
+		// read during the run is still being written to
+		store := NewSegmentReaderWithOptions(s.ctx, s.settings.GetSyncFile().GetValue(), s.logger,
+			StoreOptions{EncryptionKey: key, Mmap: s.settings.GetXSync().GetValue()},
+		)
+		err = store.Open()
 		if err != nil {
 			s.logger.CaptureError("sender: sendSenderRead: failed to create store", err)
 			return
@@ -1290,3 +1934,51 @@ func (s *Sender) sendServerInfo(record *service.Record, _ *service.ServerInfoReq
 	}
 	s.outChan <- result
 }
+
+// sendGoOnline makes the clients of the backend for a run started offline,
+// the writer then forwards the records stored so far, the run first
+func (s *Sender) sendGoOnline(record *service.Record) {
+	if s.graphqlClient == nil {
+		if s.settings.GetApiKey().GetValue() == "" {
+			apiKey, err := netrcApiKey(s.settings.GetBaseUrl().GetValue())
+			if err != nil {
+				s.logger.CaptureError("sender: error getting the API key from netrc", err)
+			}
+			s.settings.ApiKey = &wrapperspb.StringValue{Value: apiKey}
+		}
+		s.logger.Info("sender: going online", "stream_id", s.settings.RunId)
+		s.startBackend()
+		s.startOnRun = true
+	}
+
+	if record.GetControl().GetReqResp() || record.GetControl().GetMailboxSlot() != "" {
+		s.outChan <- &service.Result{
+			ResultType: &service.Result_Response{Response: &service.Response{}},
+			Control:    record.Control,
+			Uuid:       record.Uuid,
+		}
+	}
+}
+
+// senderTracer passes the requests of the clients of the sender to the
+// tracer of the sender
+type senderTracer struct {
+	sender *Sender
+}
+
+func (t senderTracer) OnRequestStart(ctx context.Context, request *observability.RequestInfo) context.Context {
+	if t.sender.requestTracer == nil {
+		return ctx
+	}
+	return t.sender.requestTracer.OnRequestStart(ctx, request)
+}
+
+func (t senderTracer) OnRequestEnd(
+	ctx context.Context,
+	request *observability.RequestInfo,
+	result *observability.RequestResult,
+) {
+	if t.sender.requestTracer != nil {
+		t.sender.requestTracer.OnRequestEnd(ctx, request, result)
+	}
+}
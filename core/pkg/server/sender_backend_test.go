@@ -0,0 +1,625 @@
+package server_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	"github.com/wandb/wandb/core/internal/debounce/debouncetest"
+	"github.com/wandb/wandb/core/pkg/backendtest"
+	"github.com/wandb/wandb/core/pkg/observability"
+	"github.com/wandb/wandb/core/pkg/server"
+	"github.com/wandb/wandb/core/pkg/service"
+)
+
+// makeBackendSettings returns the settings of a run that talks to the fake
+// backend, with quick retries
+func makeBackendSettings(backend *backendtest.Server, filesDir string) *service.Settings {
+	return &service.Settings{
+		BaseUrl:                          &wrapperspb.StringValue{Value: backend.URL()},
+		ApiKey:                           &wrapperspb.StringValue{Value: "test-api-key"},
+		RunId:                            &wrapperspb.StringValue{Value: "run1"},
+		FilesDir:                         &wrapperspb.StringValue{Value: filesDir},
+		DisableJobCreation:               &wrapperspb.BoolValue{Value: true},
+		XGraphqlRetryMax:                 &wrapperspb.Int32Value{Value: 3},
+		XGraphqlRetryWaitMinSeconds:      &wrapperspb.DoubleValue{Value: 0.01},
+		XGraphqlRetryWaitMaxSeconds:      &wrapperspb.DoubleValue{Value: 0.01},
+		XFileStreamRetryMax:              &wrapperspb.Int32Value{Value: 3},
+		XFileStreamRetryWaitMinSeconds:   &wrapperspb.DoubleValue{Value: 0.01},
+		XFileStreamRetryWaitMaxSeconds:   &wrapperspb.DoubleValue{Value: 0.01},
+		XFileTransferRetryMax:            &wrapperspb.Int32Value{Value: 3},
+		XFileTransferRetryWaitMinSeconds: &wrapperspb.DoubleValue{Value: 0.01},
+		XFileTransferRetryWaitMaxSeconds: &wrapperspb.DoubleValue{Value: 0.01},
+	}
+}
+
+func makeBackendRunRecord() *service.Record {
+	return &service.Record{
+		RecordType: &service.Record_Run{Run: &service.RunRecord{
+			RunId:   "run1",
+			Project: "testProject",
+			Entity:  "testEntity",
+		}},
+		Control: &service.Control{MailboxSlot: "run"},
+	}
+}
+
+func TestSendRunBackendRetries(t *testing.T) {
+	backend := backendtest.NewServer()
+	defer backend.Close()
+	backend.Inject(backendtest.Fault{Operation: "UpsertBucket", Status: http.StatusInternalServerError, Times: 2})
+
+	inChan, outChan, stop := startLoopedSender(makeBackendSettings(backend, t.TempDir()), nil)
+	defer stop()
+
+	inChan <- makeBackendRunRecord()
+	result := (<-outChan).GetRunResult()
+	assert.Nil(t, result.GetError())
+	assert.Equal(t, "testEntity", result.GetRun().GetEntity())
+
+	upserts := backend.GraphQLRequests("UpsertBucket")
+	assert.Len(t, upserts, 3)
+	assert.True(t, upserts[0].Failed)
+	assert.False(t, upserts[2].Failed)
+	assert.Equal(t, "testProject", upserts[2].Variables["project"])
+
+	exitRun(t, inChan, outChan)
+}
+
+func TestSendFilesBackend(t *testing.T) {
+	backend := backendtest.NewServer()
+	defer backend.Close()
+	// the first upload attempt gets no response
+	backend.Inject(backendtest.Fault{Path: "/upload/", Times: 1})
+
+	filesDir := t.TempDir()
+	assert.NoError(t, os.WriteFile(filepath.Join(filesDir, "model.pt"), []byte("weights"), 0o600))
+
+	inChan, outChan, stop := startLoopedSender(makeBackendSettings(backend, filesDir), nil)
+	defer stop()
+
+	inChan <- makeBackendRunRecord()
+	assert.NotNil(t, (<-outChan).GetRunResult())
+	inChan <- &service.Record{RecordType: &service.Record_Request{Request: &service.Request{
+		RequestType: &service.Request_RunStart{RunStart: &service.RunStartRequest{}},
+	}}}
+	inChan <- &service.Record{RecordType: &service.Record_Files{Files: &service.FilesRecord{
+		Files: []*service.FilesItem{{Path: "model.pt", Type: service.FilesItem_OTHER}},
+	}}}
+	assert.Eventually(t, func() bool {
+		content, ok := backend.Upload("run1/model.pt")
+		return ok && string(content) == "weights"
+	}, 5*time.Second, 10*time.Millisecond)
+
+	exitResult := exitRun(t, inChan, outChan)
+	assert.False(t, exitResult.GetPartialSync())
+
+	// the file stream completes the run and lists the uploaded file
+	var complete bool
+	var uploaded []string
+	for _, data := range backend.FileStreamRequests() {
+		complete = complete || data.Complete != nil && *data.Complete
+		uploaded = append(uploaded, data.Uploaded...)
+	}
+	assert.True(t, complete)
+	assert.Contains(t, uploaded, "model.pt")
+}
+
+func TestSendFilesBackendUnchanged(t *testing.T) {
+	backend := backendtest.NewServer()
+	defer backend.Close()
+
+	filesDir := t.TempDir()
+	modelPath := filepath.Join(filesDir, "model.pt")
+	forwarded := make(chan *service.Record, server.BufferSize)
+	inChan, outChan, stop := startForwardingSender(makeBackendSettings(backend, filesDir), nil, forwarded)
+	defer stop()
+
+	inChan <- makeBackendRunRecord()
+	assert.NotNil(t, (<-outChan).GetRunResult())
+	inChan <- &service.Record{RecordType: &service.Record_Request{Request: &service.Request{
+		RequestType: &service.Request_RunStart{RunStart: &service.RunStartRequest{}},
+	}}}
+	saveModel := func() {
+		inChan <- &service.Record{RecordType: &service.Record_Files{Files: &service.FilesRecord{
+			Files: []*service.FilesItem{{Path: "model.pt", Type: service.FilesItem_OTHER}},
+		}}}
+	}
+	// waitUploaded waits for the counts of the uploaded file, reported once
+	// its upload completed
+	waitUploaded := func() {
+		for record := range forwarded {
+			if record.GetRequest().GetFileTransferInfo().GetFileCounts() != nil {
+				return
+			}
+		}
+	}
+
+	assert.NoError(t, os.WriteFile(modelPath, []byte("weights"), 0o600))
+	saveModel()
+	waitUploaded()
+	assert.NoError(t, os.WriteFile(modelPath, []byte("new weights"), 0o600))
+	saveModel()
+	waitUploaded()
+	content, _ := backend.Upload("run1/model.pt")
+	assert.Equal(t, "new weights", string(content))
+
+	// the file saved again unchanged is not uploaded again
+	saveModel()
+	go func() {
+		for range forwarded {
+		}
+	}()
+	exitRun(t, inChan, outChan)
+
+	var requests int
+	for _, request := range backend.GraphQLRequests("CreateRunFiles") {
+		if files, ok := request.Variables["files"].([]interface{}); ok && len(files) == 1 && files[0] == "model.pt" {
+			requests++
+		}
+	}
+	assert.Equal(t, 2, requests)
+}
+
+func TestSendRunBackendCompression(t *testing.T) {
+	backend := backendtest.NewServer()
+	defer backend.Close()
+
+	settings := makeBackendSettings(backend, t.TempDir())
+	settings.XRequestCompression = &wrapperspb.StringValue{Value: "gzip"}
+	inChan, outChan, stop := startLoopedSender(settings, nil)
+	defer stop()
+
+	inChan <- makeBackendRunRecord()
+	result := (<-outChan).GetRunResult()
+	assert.Nil(t, result.GetError())
+	assert.Equal(t, "testProject", backend.GraphQLRequests("UpsertBucket")[0].Variables["project"])
+
+	exitRun(t, inChan, outChan)
+}
+
+func TestSendRunBackendErrorCodes(t *testing.T) {
+	testCases := []struct {
+		name   string
+		status int
+		code   service.ErrorInfo_ErrorCode
+	}{
+		{"unauthorized", http.StatusUnauthorized, service.ErrorInfo_AUTHENTICATION},
+		{"forbidden", http.StatusForbidden, service.ErrorInfo_AUTHENTICATION},
+		{"payment required", http.StatusPaymentRequired, service.ErrorInfo_QUOTA_EXCEEDED},
+		{"not found", http.StatusNotFound, service.ErrorInfo_PROJECT_NOT_FOUND},
+		{"server error", http.StatusBadGateway, service.ErrorInfo_SERVER},
+		{"no response", 0, service.ErrorInfo_COMMUNICATION},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			backend := backendtest.NewServer()
+			defer backend.Close()
+			backend.Inject(backendtest.Fault{Operation: "UpsertBucket", Status: tc.status, Times: 10})
+
+			inChan, outChan, stop := startLoopedSender(makeBackendSettings(backend, t.TempDir()), nil)
+			defer stop()
+
+			inChan <- makeBackendRunRecord()
+			result := (<-outChan).GetRunResult()
+			assert.Equal(t, tc.code, result.GetError().GetCode(), result.GetError().GetMessage())
+
+			exitRun(t, inChan, outChan)
+		})
+	}
+}
+
+func TestSendRunStoppedFromUI(t *testing.T) {
+	backend := backendtest.NewServer()
+	defer backend.Close()
+	polls := 0
+	backend.HandleGraphQL("RunStoppedStatus", func(variables map[string]interface{}) (interface{}, error) {
+		polls++
+		return map[string]interface{}{
+			"project": map[string]interface{}{"run": map[string]interface{}{"stopped": polls > 1}},
+		}, nil
+	})
+
+	settings := makeBackendSettings(backend, t.TempDir())
+	settings.XStopPollingIntervalSeconds = &wrapperspb.DoubleValue{Value: 0.01}
+	forwarded := make(chan *service.Record, server.BufferSize)
+	inChan, outChan, stop := startForwardingSender(settings, nil, forwarded)
+	defer stop()
+
+	inChan <- makeBackendRunRecord()
+	assert.Nil(t, (<-outChan).GetRunResult().GetError())
+	inChan <- &service.Record{RecordType: &service.Record_Request{Request: &service.Request{
+		RequestType: &service.Request_RunStart{RunStart: &service.RunStartRequest{}},
+	}}}
+
+	// the handler is told once the backend reports the run as stopped
+	timeout := time.After(5 * time.Second)
+	for stopped := false; !stopped; {
+		select {
+		case record := <-forwarded:
+			stopped = record.GetRequest().GetRunStopped() != nil
+		case <-timeout:
+			t.Fatal("the handler was not told that the run was stopped")
+		}
+	}
+	requests := backend.GraphQLRequests("RunStoppedStatus")
+	assert.Len(t, requests, 2)
+	assert.Equal(t, "testProject", requests[0].Variables["projectName"])
+	assert.Equal(t, "run1", requests[0].Variables["runId"])
+
+	exitRun(t, inChan, outChan)
+}
+
+func TestSendRunBackendIdempotencyKeys(t *testing.T) {
+	backend := backendtest.NewServer()
+	defer backend.Close()
+	backend.Inject(backendtest.Fault{Operation: "UpsertBucket", Status: http.StatusBadGateway, Times: 1})
+
+	inChan, outChan, stop := startLoopedSender(makeBackendSettings(backend, t.TempDir()), nil)
+	defer stop()
+
+	inChan <- makeBackendRunRecord()
+	assert.Nil(t, (<-outChan).GetRunResult().GetError())
+	inChan <- makeBackendRunRecord()
+	assert.Nil(t, (<-outChan).GetRunResult().GetError())
+
+	// the retry of a mutation keeps its key, another mutation gets a new one
+	upserts := backend.GraphQLRequests("UpsertBucket")
+	assert.Len(t, upserts, 3)
+	key := upserts[0].Header.Get("Idempotency-Key")
+	assert.NotEmpty(t, key)
+	assert.Equal(t, key, upserts[1].Header.Get("Idempotency-Key"))
+	assert.NotEmpty(t, upserts[2].Header.Get("Idempotency-Key"))
+	assert.NotEqual(t, key, upserts[2].Header.Get("Idempotency-Key"))
+
+	exitRun(t, inChan, outChan)
+}
+
+// recordingTracer records the requests it traces
+type recordingTracer struct {
+	mu      sync.Mutex
+	started int
+	ended   []observability.RequestResult
+	urls    []string
+}
+
+func (r *recordingTracer) OnRequestStart(ctx context.Context, _ *observability.RequestInfo) context.Context {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.started++
+	return ctx
+}
+
+func (r *recordingTracer) OnRequestEnd(
+	_ context.Context,
+	request *observability.RequestInfo,
+	result *observability.RequestResult,
+) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.ended = append(r.ended, *result)
+	r.urls = append(r.urls, request.URL)
+}
+
+func TestSendRunBackendRequestTracer(t *testing.T) {
+	backend := backendtest.NewServer()
+	defer backend.Close()
+	backend.Inject(backendtest.Fault{Operation: "UpsertBucket", Status: http.StatusBadGateway, Times: 1})
+
+	tracer := &recordingTracer{}
+	inChan, outChan, stop := startForwardingSender(makeBackendSettings(backend, t.TempDir()), nil, nil,
+		server.WithSenderRequestTracer(tracer),
+	)
+	defer stop()
+
+	inChan <- makeBackendRunRecord()
+	assert.Nil(t, (<-outChan).GetRunResult().GetError())
+
+	// each attempt is traced
+	tracer.mu.Lock()
+	var statuses []int
+	for i, result := range tracer.ended {
+		if strings.HasSuffix(tracer.urls[i], "/graphql") {
+			statuses = append(statuses, result.StatusCode)
+		}
+	}
+	assert.Equal(t, tracer.started, len(tracer.ended))
+	tracer.mu.Unlock()
+	assert.Contains(t, statuses, http.StatusBadGateway)
+	assert.Contains(t, statuses, http.StatusOK)
+
+	exitRun(t, inChan, outChan)
+}
+
+func TestSendArtifactBackend(t *testing.T) {
+	backend := backendtest.NewServer()
+	defer backend.Close()
+	backend.HandleGraphQL("CreateArtifact", func(map[string]interface{}) (interface{}, error) {
+		return map[string]interface{}{"createArtifact": map[string]interface{}{
+			"artifact": map[string]interface{}{
+				"id":               "artifact1",
+				"state":            "COMMITTED",
+				"artifactSequence": map[string]interface{}{"latestArtifact": nil},
+			},
+		}}, nil
+	})
+
+	inChan, outChan, stop := startLoopedSender(makeBackendSettings(backend, t.TempDir()), nil)
+	defer stop()
+
+	inChan <- makeBackendRunRecord()
+	assert.Nil(t, (<-outChan).GetRunResult().GetError())
+
+	// an artifact logged while offline is saved as the run is synced, its
+	// files are not staged and are kept
+	localPath := filepath.Join(t.TempDir(), "data.csv")
+	assert.NoError(t, os.WriteFile(localPath, []byte("a,b"), 0644))
+	inChan <- &service.Record{RecordType: &service.Record_Artifact{Artifact: &service.ArtifactRecord{
+		RunId:   "run1",
+		Project: "testProject",
+		Entity:  "testEntity",
+		Type:    "dataset",
+		Name:    "data",
+		Manifest: &service.ArtifactManifest{
+			Version:  1,
+			Contents: []*service.ArtifactManifestEntry{{Path: "data.csv", LocalPath: localPath}},
+		},
+	}}}
+
+	assert.Eventually(t, func() bool {
+		return len(backend.GraphQLRequests("CreateArtifact")) == 1
+	}, 5*time.Second, 10*time.Millisecond)
+	created := backend.GraphQLRequests("CreateArtifact")[0]
+	assert.Equal(t, "data", created.Variables["artifactCollectionName"])
+	assert.Equal(t, "dataset", created.Variables["artifactTypeName"])
+
+	exitRun(t, inChan, outChan)
+	assert.FileExists(t, localPath)
+}
+
+func TestSendRunBackendFailover(t *testing.T) {
+	backend := backendtest.NewServer()
+	defer backend.Close()
+	primary := backendtest.NewServer()
+	primaryURL := primary.URL()
+	primary.Close()
+
+	settings := makeBackendSettings(backend, t.TempDir())
+	settings.BaseUrl = &wrapperspb.StringValue{Value: primaryURL}
+	settings.XFailoverBaseUrls = &service.ListStringValue{Value: []string{backend.URL()}}
+	inChan, outChan, stop := startLoopedSender(settings, nil)
+	defer stop()
+
+	// the primary is unreachable, the run is created on the secondary
+	inChan <- makeBackendRunRecord()
+	result := (<-outChan).GetRunResult()
+	assert.Nil(t, result.GetError())
+	assert.Equal(t, "testEntity", result.GetRun().GetEntity())
+	assert.NotEmpty(t, backend.GraphQLRequests("UpsertBucket"))
+
+	// and so is its file stream
+	inChan <- &service.Record{RecordType: &service.Record_Request{Request: &service.Request{
+		RequestType: &service.Request_RunStart{RunStart: &service.RunStartRequest{}},
+	}}}
+	exitRun(t, inChan, outChan)
+	var complete bool
+	for _, data := range backend.FileStreamRequests() {
+		complete = complete || data.Complete != nil && *data.Complete
+	}
+	assert.True(t, complete)
+}
+
+// makeRunMoveRecord returns a request to move the run, or to fork it
+func makeRunMoveRecord(project string, fork bool, runID string) *service.Record {
+	return &service.Record{
+		RecordType: &service.Record_Request{Request: &service.Request{
+			RequestType: &service.Request_RunMove{RunMove: &service.RunMoveRequest{
+				Project: project,
+				Fork:    fork,
+				RunId:   runID,
+			}},
+		}},
+		Control: &service.Control{MailboxSlot: "move"},
+	}
+}
+
+// fileStreamPaths returns the paths of the file stream requests to the fake
+func fileStreamPaths(backend *backendtest.Server) map[string]int {
+	paths := make(map[string]int)
+	for _, request := range backend.Requests() {
+		if strings.HasSuffix(request.Path, "/file_stream") {
+			paths[request.Path]++
+		}
+	}
+	return paths
+}
+
+func TestSendRunMoveBackend(t *testing.T) {
+	backend := backendtest.NewServer()
+	defer backend.Close()
+
+	inChan, outChan, stop := startLoopedSender(makeBackendSettings(backend, t.TempDir()), nil)
+	defer stop()
+
+	// a run can't be moved before it was created
+	inChan <- makeRunMoveRecord("otherProject", false, "")
+	response := (<-outChan).GetResponse().GetRunMoveResponse()
+	assert.Equal(t, service.ErrorInfo_USAGE, response.GetError().GetCode())
+
+	inChan <- makeBackendRunRecord()
+	<-outChan
+	inChan <- &service.Record{RecordType: &service.Record_Request{Request: &service.Request{
+		RequestType: &service.Request_RunStart{RunStart: &service.RunStartRequest{}},
+	}}}
+	inChan <- &service.Record{RecordType: &service.Record_Summary{Summary: &service.SummaryRecord{
+		Update: []*service.SummaryItem{{Key: "loss", ValueJson: "0.5"}},
+	}}}
+
+	inChan <- makeRunMoveRecord("otherProject", false, "")
+	response = (<-outChan).GetResponse().GetRunMoveResponse()
+	assert.Nil(t, response.GetError())
+	assert.Equal(t, "testEntity", response.GetRun().GetEntity())
+	assert.Equal(t, "otherProject", response.GetRun().GetProject())
+	assert.Equal(t, "run1", response.GetRun().GetRunId())
+
+	upserts := backend.GraphQLRequests("UpsertBucket")
+	if assert.Len(t, upserts, 2) {
+		assert.Equal(t, "otherProject", upserts[1].Variables["project"])
+		assert.Equal(t, "run1", upserts[1].Variables["name"])
+		assert.Contains(t, upserts[1].Variables["config"], "_wandb")
+	}
+
+	// the run finishes at its new location
+	exitRun(t, inChan, outChan)
+	paths := fileStreamPaths(backend)
+	assert.NotZero(t, paths["/files/testEntity/testProject/run1/file_stream"])
+	assert.NotZero(t, paths["/files/testEntity/otherProject/run1/file_stream"])
+	var summaries int
+	for _, data := range backend.FileStreamRequests() {
+		if _, ok := data.Files["wandb-summary.json"]; ok {
+			summaries++
+		}
+	}
+	assert.Equal(t, 2, summaries)
+}
+
+func TestSendRunForkBackend(t *testing.T) {
+	backend := backendtest.NewServer()
+	defer backend.Close()
+
+	inChan, outChan, stop := startLoopedSender(makeBackendSettings(backend, t.TempDir()), nil)
+	defer stop()
+
+	inChan <- makeBackendRunRecord()
+	<-outChan
+	inChan <- &service.Record{RecordType: &service.Record_Request{Request: &service.Request{
+		RequestType: &service.Request_RunStart{RunStart: &service.RunStartRequest{}},
+	}}}
+
+	inChan <- makeRunMoveRecord("", true, "fork1")
+	response := (<-outChan).GetResponse().GetRunMoveResponse()
+	assert.Nil(t, response.GetError())
+	assert.Equal(t, "testProject", response.GetRun().GetProject())
+	assert.Equal(t, "fork1", response.GetRun().GetRunId())
+
+	upserts := backend.GraphQLRequests("UpsertBucket")
+	if assert.Len(t, upserts, 2) {
+		assert.Equal(t, "fork1", upserts[1].Variables["name"])
+	}
+
+	exitRun(t, inChan, outChan)
+	paths := fileStreamPaths(backend)
+	assert.NotZero(t, paths["/files/testEntity/testProject/run1/file_stream"])
+	assert.NotZero(t, paths["/files/testEntity/testProject/fork1/file_stream"])
+}
+
+func TestSendConfigBackendDebounced(t *testing.T) {
+	backend := backendtest.NewServer()
+	defer backend.Close()
+
+	settings := makeBackendSettings(backend, t.TempDir())
+	settings.XDebounceSeconds = &wrapperspb.DoubleValue{Value: 0.1}
+	clock := debouncetest.NewFakeClock()
+	inChan, outChan, stop := startForwardingSender(
+		settings, nil, nil, server.WithSenderDebounceClock(clock),
+	)
+	defer stop()
+
+	inChan <- makeBackendRunRecord()
+	<-outChan
+	for i := 0; i < 5; i++ {
+		inChan <- &service.Record{RecordType: &service.Record_Config{Config: &service.ConfigRecord{
+			Update: []*service.ConfigItem{{Key: "lr", ValueJson: fmt.Sprint(i)}},
+		}}}
+	}
+
+	// the first update is sent right away, the preflight is answered once
+	// the sender took the others
+	inChan <- makePreflightRecord()
+	<-outChan
+	assert.Len(t, backend.GraphQLRequests("UpsertBucket"), 2)
+
+	// the others are sent in one upsert once the debounce period passed
+	clock.Advance(100 * time.Millisecond)
+	assert.Eventually(t, func() bool {
+		return len(backend.GraphQLRequests("UpsertBucket")) == 3
+	}, time.Second, 10*time.Millisecond)
+
+	// the exit flushes the config if an update is still pending
+	exitRun(t, inChan, outChan)
+	upserts := backend.GraphQLRequests("UpsertBucket")
+	if assert.Len(t, upserts, 3) {
+		assert.Contains(t, upserts[2].Variables["config"], `"lr":{"value":4}`)
+	}
+}
+
+// makePreflightRecord returns a request for the checks of the connection
+func makePreflightRecord() *service.Record {
+	return &service.Record{
+		RecordType: &service.Record_Request{Request: &service.Request{
+			RequestType: &service.Request_Preflight{Preflight: &service.PreflightRequest{}},
+		}},
+		Control: &service.Control{MailboxSlot: "preflight"},
+	}
+}
+
+func TestSendPreflightBackend(t *testing.T) {
+	backend := backendtest.NewServer()
+	defer backend.Close()
+
+	inChan, outChan, stop := startLoopedSender(makeBackendSettings(backend, t.TempDir()), nil)
+	defer stop()
+
+	inChan <- makePreflightRecord()
+	response := (<-outChan).GetResponse().GetPreflightResponse()
+	assert.True(t, response.GetOk())
+	var stages []string
+	for _, check := range response.GetChecks() {
+		stages = append(stages, check.GetStage())
+		assert.True(t, check.GetOk())
+	}
+	assert.Equal(t, []string{"dns", "tcp", "auth"}, stages)
+	assert.Len(t, backend.GraphQLRequests("Viewer"), 1)
+
+	inChan <- makeBackendRunRecord()
+	<-outChan
+	exitRun(t, inChan, outChan)
+}
+
+func TestSendPreflightBackendUnreachable(t *testing.T) {
+	backend := backendtest.NewServer()
+	backend.Close()
+
+	// the requests fail right away rather than wait for the backend
+	settings := makeBackendSettings(backend, t.TempDir())
+	settings.XCircuitBreakerFailures = &wrapperspb.Int32Value{Value: 0}
+	inChan, outChan, stop := startLoopedSender(settings, nil)
+	defer stop()
+
+	inChan <- makePreflightRecord()
+	response := (<-outChan).GetResponse().GetPreflightResponse()
+	assert.False(t, response.GetOk())
+	checks := response.GetChecks()
+	if assert.Len(t, checks, 2) {
+		assert.Equal(t, "tcp", checks[1].GetStage())
+		assert.NotEmpty(t, checks[1].GetError())
+		assert.Contains(t, checks[1].GetHint(), "firewall")
+	}
+
+	// the failure of the run creation tells which layer failed
+	inChan <- makeBackendRunRecord()
+	result := (<-outChan).GetRunResult()
+	assert.Equal(t, service.ErrorInfo_COMMUNICATION, result.GetError().GetCode())
+	assert.Contains(t, result.GetError().GetMessage(), "the tcp check of")
+
+	exitRun(t, inChan, outChan)
+}
@@ -2,7 +2,10 @@ package server_test
 
 import (
 	"context"
+	"os"
+	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/Khan/genqlient/graphql"
 	"github.com/golang/mock/gomock"
@@ -83,6 +86,77 @@ func TestSendRun(t *testing.T) {
 	<-sender.GetOutboundChannel()
 }
 
+func TestSendRunResumed(t *testing.T) {
+	to := coretest.MakeTestObject(t)
+	defer to.TeardownTest()
+
+	resultChan := make(chan *service.Result, 1)
+	ctx, cancel := context.WithCancel(context.Background())
+	sender := server.NewSender(
+		ctx,
+		cancel,
+		observability.NewNoOpLogger(),
+		&service.Settings{
+			RunId:  &wrapperspb.StringValue{Value: "run1"},
+			Resume: &wrapperspb.StringValue{Value: "allow"},
+		},
+		server.WithSenderFwdChannel(make(chan *service.Record, 1)),
+		server.WithSenderOutChannel(resultChan),
+	)
+	sender.SetGraphqlClient(to.MockClient)
+
+	resumeStatus := &graphql.Response{
+		Data: &gql.RunResumeStatusResponse{
+			Model: &gql.RunResumeStatusModelProject{
+				Bucket: &gql.RunResumeStatusModelProjectBucketRun{
+					Config:           coretest.StrPtr(`{"lr": {"value": 0.1}, "epochs": {"value": 10}}`),
+					HistoryTail:      coretest.StrPtr(`["{\"_step\": 4}"]`),
+					HistoryLineCount: func() *int { n := 5; return &n }(),
+				},
+			},
+		},
+	}
+	upsert := &graphql.Response{
+		Data: &gql.UpsertBucketResponse{
+			UpsertBucket: &gql.UpsertBucketUpsertBucketUpsertBucketPayload{
+				Bucket: &gql.UpsertBucketUpsertBucketUpsertBucketPayloadBucketRun{
+					DisplayName: coretest.StrPtr("FakeName"),
+					Project: &gql.UpsertBucketUpsertBucketUpsertBucketPayloadBucketRunProject{
+						Name: "FakeProject",
+					},
+				},
+			},
+		},
+	}
+	gomock.InOrder(
+		to.MockClient.EXPECT().MakeRequest(gomock.Any(), gomock.Any(), gomock.Any()).
+			Return(nil).Do(coretest.InjectResponse(resumeStatus, nil)),
+		to.MockClient.EXPECT().MakeRequest(gomock.Any(), gomock.Any(), gomock.Any()).
+			Return(nil).Do(coretest.InjectResponse(upsert, nil)),
+	)
+
+	sender.SendRecord(&service.Record{
+		RecordType: &service.Record_Run{
+			Run: &service.RunRecord{
+				Config: &service.ConfigRecord{Update: []*service.ConfigItem{
+					{Key: "epochs", ValueJson: "20"},
+				}},
+				Project: "testProject",
+			}},
+		Control: &service.Control{MailboxSlot: "junk"},
+	})
+	run := (<-resultChan).GetRunResult().GetRun()
+	assert.True(t, run.GetResumed())
+	assert.Equal(t, int64(5), run.GetStartingStep())
+
+	// the config of the resumed run, with the keys the run was created with
+	config := make(map[string]string)
+	for _, item := range run.GetConfig().GetUpdate() {
+		config[item.GetKey()] = item.GetValueJson()
+	}
+	assert.Equal(t, map[string]string{"lr": "0.1", "epochs": "20"}, config)
+}
+
 func TestSendLinkArtifact(t *testing.T) {
 	// Verify that arguments are properly passed through to graphql
 	to := coretest.MakeTestObject(t)
@@ -242,3 +316,263 @@ func TestSendUseArtifact(t *testing.T) {
 	}
 	sender.SendRecord(useArtifact)
 }
+
+// makeRunRecord returns a run record that expects a response
+func makeRunRecord(to coretest.TestObject) *service.Record {
+	return &service.Record{
+		RecordType: &service.Record_Run{
+			Run: &service.RunRecord{
+				Config:  to.MakeConfig(),
+				Project: "testProject",
+				Entity:  "testEntity",
+			}},
+		Control: &service.Control{
+			MailboxSlot: "junk",
+		},
+	}
+}
+
+// expectUpsertBucket expects the upsert of the run record
+func expectUpsertBucket(to coretest.TestObject) *gomock.Call {
+	respEncode := &graphql.Response{
+		Data: &gql.UpsertBucketResponse{
+			UpsertBucket: &gql.UpsertBucketUpsertBucketUpsertBucketPayload{
+				Bucket: &gql.UpsertBucketUpsertBucketUpsertBucketPayloadBucketRun{
+					DisplayName: coretest.StrPtr("FakeName"),
+					Project: &gql.UpsertBucketUpsertBucketUpsertBucketPayloadBucketRunProject{
+						Name: "FakeProject",
+						Entity: gql.UpsertBucketUpsertBucketUpsertBucketPayloadBucketRunProjectEntity{
+							Name: "FakeEntity",
+						},
+					},
+				},
+			},
+		},
+	}
+
+	return to.MockClient.EXPECT().MakeRequest(
+		gomock.Any(), // context.Context
+		gomock.Any(), // *graphql.Request
+		gomock.Any(), // *graphql.Response
+	).Return(nil).Do(coretest.InjectResponse(respEncode, nil))
+}
+
+func TestSendAlertWorkerPool(t *testing.T) {
+	// Verify that a slow request doesn't block the sender loop
+	to := coretest.MakeTestObject(t)
+	defer to.TeardownTest()
+
+	sender := makeSender(to.MockClient, make(chan *service.Result, 1))
+
+	release := make(chan struct{})
+	gomock.InOrder(
+		expectUpsertBucket(to),
+		to.MockClient.EXPECT().MakeRequest(
+			gomock.Any(), // context.Context
+			gomock.Any(), // *graphql.Request
+			gomock.Any(), // *graphql.Response
+		).Return(nil).Do(func(_ context.Context, req *graphql.Request, _ *graphql.Response) {
+			assert.Equal(t, "NotifyScriptableRunAlert", req.OpName)
+			<-release
+		}),
+	)
+
+	sender.SendRecord(makeRunRecord(to))
+	<-sender.GetOutboundChannel()
+
+	alert := &service.Record{
+		RecordType: &service.Record_Alert{
+			Alert: &service.AlertRecord{
+				Title: "title",
+				Text:  "text",
+			}},
+	}
+	sender.SendRecord(alert)
+	assert.Eventually(t, func() bool { return sender.Stats().Metadata.Active == 1 },
+		time.Second, time.Millisecond)
+
+	close(release)
+	assert.Eventually(t, func() bool { return sender.Stats().Metadata.Completed == 1 },
+		time.Second, time.Millisecond)
+	assert.Equal(t, server.WorkerPoolStats{Workers: 4, Completed: 1}, sender.Stats().Metadata)
+}
+
+// startPrioritySender starts a sender on the queued and priority records,
+// it returns its forward and output channels
+func startPrioritySender(
+	to coretest.TestObject,
+	queued []*service.Record,
+	priority []*service.Record,
+) (chan *service.Record, chan *service.Result) {
+	fwdChan := make(chan *service.Record, server.BufferSize)
+	outChan := make(chan *service.Result, server.BufferSize)
+	ctx, cancel := context.WithCancel(context.Background())
+	sender := server.NewSender(ctx, cancel, observability.NewNoOpLogger(),
+		&service.Settings{RunId: &wrapperspb.StringValue{Value: "run1"}},
+		server.WithSenderFwdChannel(fwdChan),
+		server.WithSenderOutChannel(outChan),
+		server.WithSenderPriorityChannel(makeClosedChannel(priority)),
+	)
+	sender.SetGraphqlClient(to.MockClient)
+	go sender.Do(makeClosedChannel(queued))
+	return fwdChan, outChan
+}
+
+func makeClosedChannel(records []*service.Record) chan *service.Record {
+	ch := make(chan *service.Record, len(records))
+	for _, record := range records {
+		ch <- record
+	}
+	close(ch)
+	return ch
+}
+
+func TestSenderPriorityChannel(t *testing.T) {
+	to := coretest.MakeTestObject(t)
+	defer to.TeardownTest()
+
+	deferRecord := &service.Record{RecordType: &service.Record_Request{Request: &service.Request{
+		RequestType: &service.Request_Defer{Defer: &service.DeferRequest{
+			State: service.DeferRequest_FLUSH_RUN,
+		}},
+	}}}
+	statusRecord := &service.Record{RecordType: &service.Record_Request{Request: &service.Request{
+		RequestType: &service.Request_StoreStatus{StoreStatus: &service.StoreStatusRequest{}},
+	}}}
+
+	// the queued records go first until the run is set up
+	fwdChan, outChan := startPrioritySender(to,
+		[]*service.Record{deferRecord}, []*service.Record{statusRecord})
+	assert.NotNil(t, (<-fwdChan).GetRequest().GetDefer())
+	assert.NotNil(t, (<-fwdChan).GetRequest().GetStoreStatus())
+	for range outChan {
+	}
+
+	// then the priority records go ahead of the queued ones
+	expectUpsertBucket(to)
+	fwdChan, outChan = startPrioritySender(to,
+		[]*service.Record{makeRunRecord(to), deferRecord}, []*service.Record{statusRecord})
+	assert.NotNil(t, (<-outChan).GetRunResult())
+	assert.NotNil(t, (<-fwdChan).GetRequest().GetStoreStatus())
+	assert.NotNil(t, (<-fwdChan).GetRequest().GetDefer())
+	for range outChan {
+	}
+}
+
+// startLoopedSender starts a sender whose defer requests and files records
+// to the handler are looped back to it, as the handler does. It returns its input and output channels,
+// and a function that stops it once the run has exited.
+func startLoopedSender(
+	settings *service.Settings,
+	client graphql.Client,
+) (chan *service.Record, chan *service.Result, func()) {
+	return startForwardingSender(settings, client, nil)
+}
+
+// startForwardingSender is startLoopedSender, the other records to the
+// handler are passed to forwarded if it is set.
+func startForwardingSender(
+	settings *service.Settings,
+	client graphql.Client,
+	forwarded chan<- *service.Record,
+	opts ...server.SenderOption,
+) (chan *service.Record, chan *service.Result, func()) {
+	fwdChan := make(chan *service.Record, server.BufferSize)
+	outChan := make(chan *service.Result, server.BufferSize)
+	ctx, cancel := context.WithCancel(context.Background())
+	opts = append([]server.SenderOption{
+		server.WithSenderFwdChannel(fwdChan),
+		server.WithSenderOutChannel(outChan),
+	}, opts...)
+	sender := server.NewSender(ctx, cancel, observability.NewNoOpLogger(), settings, opts...)
+	if client != nil {
+		sender.SetGraphqlClient(client)
+	}
+
+	inChan := make(chan *service.Record, server.BufferSize)
+	looped := make(chan struct{})
+	go func() {
+		defer close(looped)
+		for {
+			select {
+			case record := <-fwdChan:
+				if record.GetRequest().GetDefer() != nil || record.GetFiles() != nil {
+					inChan <- record
+				} else if forwarded != nil {
+					forwarded <- record
+				}
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	go sender.Do(inChan)
+
+	return inChan, outChan, func() {
+		<-looped
+		close(inChan)
+		for range outChan {
+		}
+	}
+}
+
+// exitRun sends the exit record of the run and returns its result
+func exitRun(t *testing.T, inChan chan *service.Record, outChan chan *service.Result) *service.RunExitResult {
+	inChan <- &service.Record{
+		RecordType: &service.Record_Exit{Exit: &service.RunExitRecord{}},
+		Control:    &service.Control{MailboxSlot: "exit"},
+	}
+	timeout := time.After(5 * time.Second)
+	for {
+		select {
+		case result := <-outChan:
+			if exitResult := result.GetExitResult(); exitResult != nil {
+				return exitResult
+			}
+		case <-timeout:
+			t.Fatal("the run did not finish")
+			return nil
+		}
+	}
+}
+
+func TestSendExitFlushTimeout(t *testing.T) {
+	to := coretest.MakeTestObject(t)
+	defer to.TeardownTest()
+
+	filesDir := t.TempDir()
+	assert.NoError(t, os.WriteFile(filepath.Join(filesDir, "model.pt"), []byte("weights"), 0o600))
+
+	// the requests for the upload URLs hang until they are cancelled
+	gomock.InOrder(
+		expectUpsertBucket(to),
+		to.MockClient.EXPECT().MakeRequest(
+			gomock.Any(), // context.Context
+			gomock.Any(), // *graphql.Request
+			gomock.Any(), // *graphql.Response
+		).DoAndReturn(func(ctx context.Context, _ *graphql.Request, _ *graphql.Response) error {
+			<-ctx.Done()
+			return ctx.Err()
+		}).AnyTimes(),
+	)
+
+	inChan, outChan, stop := startLoopedSender(&service.Settings{
+		RunId:                    &wrapperspb.StringValue{Value: "run1"},
+		FilesDir:                 &wrapperspb.StringValue{Value: filesDir},
+		SyncFile:                 &wrapperspb.StringValue{Value: "run1.wandb"},
+		DisableJobCreation:       &wrapperspb.BoolValue{Value: true},
+		XExitFlushTimeoutSeconds: &wrapperspb.DoubleValue{Value: 0.1},
+	}, to.MockClient)
+	defer stop()
+
+	inChan <- makeRunRecord(to)
+	assert.NotNil(t, (<-outChan).GetRunResult())
+	inChan <- &service.Record{RecordType: &service.Record_Files{Files: &service.FilesRecord{
+		Files: []*service.FilesItem{{Path: "model.pt", Type: service.FilesItem_OTHER}},
+	}}}
+
+	exitResult := exitRun(t, inChan, outChan)
+	assert.True(t, exitResult.GetPartialSync())
+	assert.Contains(t, exitResult.GetUnsentFiles(), filepath.Join(filesDir, "model.pt"))
+	assert.Equal(t, "run1.wandb", exitResult.GetSyncFile())
+}
@@ -48,6 +48,7 @@ func NewServer(ctx context.Context, addr string, portFile string) *Server {
 
 	port := s.listener.Addr().(*net.TCPAddr).Port
 	writePortFile(portFile, port)
+	s.watchPreemption()
 	s.wg.Add(1)
 	go s.Serve()
 	return s
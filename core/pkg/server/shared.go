@@ -0,0 +1,116 @@
+package server
+
+import (
+	"strings"
+
+	"github.com/wandb/wandb/core/pkg/service"
+)
+
+// writerKey returns the key of the process that wrote a record of a shared
+// run: its rank if it set one, else its connection. It is empty for the
+// records of the stream itself.
+func writerKey(record *service.Record) string {
+	if rank := record.GetControl().GetRank(); rank != "" {
+		return rank
+	}
+	if id := record.GetControl().GetConnectionId(); id != internalConnectionId {
+		return id
+	}
+	return ""
+}
+
+// trackWriter registers the process that wrote a record of a shared run, the
+// run exits once all of its writers did
+func (h *Handler) trackWriter(record *service.Record) {
+	key := writerKey(record)
+	if !h.settings.GetXShared().GetValue() || key == "" {
+		return
+	}
+	if h.writers == nil {
+		h.writers = make(map[string]bool)
+	}
+	if _, ok := h.writers[key]; !ok {
+		h.writers[key] = false
+	}
+}
+
+// withWriterHistory runs f with the partial history of the writer of a record
+// of a shared run, so that the rows of the writers are not mixed
+func (h *Handler) withWriterHistory(record *service.Record, f func()) {
+	key := writerKey(record)
+	if !h.settings.GetXShared().GetValue() || key == "" {
+		f()
+		return
+	}
+	if h.writerHistory == nil {
+		h.writerHistory = make(map[string]*ActiveHistory)
+	}
+	h.activeHistory = h.writerHistory[key]
+	f()
+	h.writerHistory[key] = h.activeHistory
+}
+
+// flushPartialHistory flushes the partial history, of each writer for a
+// shared run
+func (h *Handler) flushPartialHistory() {
+	h.activeHistory.Flush()
+	for _, history := range h.writerHistory {
+		if history == h.activeHistory {
+			continue
+		}
+		h.activeHistory = history
+		h.activeHistory.Flush()
+	}
+}
+
+// exitWriter handles the exit of a writer of a shared run. It returns false
+// if the run must exit, because it was the last writer logging to it.
+func (h *Handler) exitWriter(record *service.Record) bool {
+	key := writerKey(record)
+	if !h.settings.GetXShared().GetValue() || key == "" {
+		return false
+	}
+	h.writers[key] = true
+	if history, ok := h.writerHistory[key]; ok {
+		h.activeHistory = history
+		h.activeHistory.Flush()
+	}
+	for other, exited := range h.writers {
+		if other != key && !exited {
+			h.logger.Info("handler: writer of the shared run exited", "writer", key)
+			h.outChan <- &service.Result{
+				ResultType: &service.Result_ExitResult{ExitResult: &service.RunExitResult{}},
+				Control:    record.Control,
+				Uuid:       record.Uuid,
+			}
+			return true
+		}
+	}
+	return false
+}
+
+// dedupeConfig drops the items of a config record of a shared run that set a
+// value it already has, like the same config set by each of its writers. It
+// returns false if nothing is left to send.
+func (h *Handler) dedupeConfig(config *service.ConfigRecord) bool {
+	if !h.settings.GetXShared().GetValue() || config == nil {
+		return true
+	}
+	if h.sharedConfig == nil {
+		h.sharedConfig = make(map[string]string)
+	}
+	for _, item := range config.GetRemove() {
+		delete(h.sharedConfig, strings.Join(itemPath(item), "\x00"))
+	}
+	items := make([]*service.ConfigItem, 0, len(config.GetUpdate()))
+	for _, item := range config.GetUpdate() {
+		path := strings.Join(itemPath(item), "\x00")
+		if value, ok := h.sharedConfig[path]; ok && value == item.GetValueJson() {
+			continue
+		}
+		h.sharedConfig[path] = item.GetValueJson()
+		items = append(items, item)
+	}
+	config.Update = items
+	return len(config.GetUpdate()) > 0 || len(config.GetRemove()) > 0
+}
@@ -0,0 +1,171 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+
+	"google.golang.org/protobuf/proto"
+
+	"github.com/wandb/wandb/core/pkg/observability"
+	"github.com/wandb/wandb/core/pkg/service"
+)
+
+// snapshotName returns the name of the file that records the number of the
+// last snapshot record of a store
+func snapshotName(fileName string) string {
+	return fileName + ".snapshot"
+}
+
+// saveSnapshotNum atomically records the number of the last snapshot record
+// of the store whose first segment is fileName
+func saveSnapshotNum(fileName string, num int64) error {
+	name := snapshotName(fileName)
+	tmp := name + ".tmp"
+	if err := os.WriteFile(tmp, []byte(strconv.FormatInt(num, 10)), 0644); err != nil {
+		return err
+	}
+	return os.Rename(tmp, name)
+}
+
+// loadSnapshotNum returns the number of the last snapshot record of the store
+// whose first segment is fileName, or 0 if it is not known
+func loadSnapshotNum(fileName string) int64 {
+	data, err := os.ReadFile(snapshotName(fileName))
+	if err != nil {
+		return 0
+	}
+	num, err := strconv.ParseInt(strings.TrimSpace(string(data)), 10, 64)
+	if err != nil {
+		return 0
+	}
+	return num
+}
+
+// snapshotTracker consolidates the records of a store into the state written
+// in its snapshot records
+type snapshotTracker struct {
+	config  mergedItems[*service.ConfigItem]
+	summary mergedItems[*service.SummaryItem]
+
+	// step is the step of the last history record
+	step int64
+
+	// historyRows is the number of history records
+	historyRows int64
+
+	// lastNum is the number of the last record applied
+	lastNum int64
+
+	// sinceSnapshot is the number of records applied since the last snapshot
+	sinceSnapshot int64
+}
+
+func newSnapshotTracker() *snapshotTracker {
+	return &snapshotTracker{}
+}
+
+// apply accounts for a record, a snapshot record replaces the state
+func (t *snapshotTracker) apply(record *service.Record) {
+	t.lastNum = max(t.lastNum, record.Num)
+	switch x := record.RecordType.(type) {
+	case *service.Record_Snapshot:
+		t.restore(x.Snapshot)
+		return
+	case *service.Record_Config:
+		// the items are cloned, as the record is still used once stored
+		config := proto.Clone(x.Config).(*service.ConfigRecord)
+		t.config.apply(config.GetUpdate(), config.GetRemove())
+	case *service.Record_Summary:
+		summary := proto.Clone(x.Summary).(*service.SummaryRecord)
+		t.summary.apply(summary.GetUpdate(), summary.GetRemove())
+	case *service.Record_History:
+		t.step = x.History.GetStep().GetNum()
+		t.historyRows++
+	}
+	t.sinceSnapshot++
+}
+
+// restore replaces the state with the state of a snapshot
+func (t *snapshotTracker) restore(snapshot *service.SnapshotRecord) {
+	t.config = mergedItems[*service.ConfigItem]{}
+	t.config.apply(snapshot.GetConfig().GetUpdate(), snapshot.GetConfig().GetRemove())
+	t.summary = mergedItems[*service.SummaryItem]{}
+	t.summary.apply(snapshot.GetSummary().GetUpdate(), snapshot.GetSummary().GetRemove())
+	t.step = snapshot.GetStep()
+	t.historyRows = snapshot.GetHistoryRows()
+	t.lastNum = max(t.lastNum, snapshot.GetLastNum())
+	t.sinceSnapshot = 0
+}
+
+// snapshot returns the current state, and starts counting the records until
+// the next snapshot
+func (t *snapshotTracker) snapshot() *service.SnapshotRecord {
+	t.sinceSnapshot = 0
+	configUpdate, configRemove := t.config.result()
+	summaryUpdate, summaryRemove := t.summary.result()
+	return &service.SnapshotRecord{
+		LastNum:     t.lastNum,
+		Config:      &service.ConfigRecord{Update: configUpdate, Remove: configRemove},
+		Summary:     &service.SummaryRecord{Update: summaryUpdate, Remove: summaryRemove},
+		Step:        t.step,
+		HistoryRows: t.historyRows,
+	}
+}
+
+// LoadStoreSnapshot returns the state of the run at the end of the store
+// whose first segment is fileName: its merged config and summary and its
+// history counters. It starts from the last snapshot record written by the
+// writer, if it is known, instead of replaying all the records.
+func LoadStoreSnapshot(
+	ctx context.Context,
+	fileName string,
+	logger *observability.CoreLogger,
+	opts StoreOptions,
+) (*service.SnapshotRecord, error) {
+	reader := NewSegmentReaderWithOptions(ctx, fileName, logger, opts)
+	if err := reader.Open(); err != nil {
+		return nil, err
+	}
+	defer reader.Close()
+
+	tracker := newSnapshotTracker()
+	if num := loadSnapshotNum(fileName); num > 0 {
+		if record, err := seekSnapshot(reader, num); err == nil {
+			tracker.apply(record)
+		} else {
+			logger.CaptureWarn("store: can't read the last snapshot, replaying the store",
+				"num", num, "error", err)
+			if err := reader.SeekRecord(1); err != nil && err != io.EOF {
+				return nil, err
+			}
+		}
+	}
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			return tracker.snapshot(), nil
+		} else if err != nil {
+			return nil, err
+		}
+		tracker.apply(record)
+	}
+}
+
+// seekSnapshot moves the reader to the snapshot record num and returns it
+func seekSnapshot(reader *SegmentReader, num int64) (*service.Record, error) {
+	if err := reader.SeekRecord(num); err != nil {
+		return nil, err
+	}
+	record, err := reader.Read()
+	if err != nil {
+		return nil, err
+	}
+	if record.Num != num || record.GetSnapshot() == nil {
+		return nil, fmt.Errorf("record %d is not a snapshot", num)
+	}
+	return record, nil
+}
@@ -0,0 +1,122 @@
+package server_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	"github.com/wandb/wandb/core/pkg/observability"
+	"github.com/wandb/wandb/core/pkg/server"
+	"github.com/wandb/wandb/core/pkg/service"
+)
+
+func historyRecord(step int64) *service.Record {
+	return &service.Record{RecordType: &service.Record_History{
+		History: &service.HistoryRecord{Step: &service.HistoryStep{Num: step}},
+	}}
+}
+
+// snapshotRecords returns records that are snapshotted every three records
+func snapshotRecords() []*service.Record {
+	return []*service.Record{
+		configRecord([]string{"a", "b"}),
+		historyRecord(0),
+		summaryRecord("loss", "1"),
+		// snapshot 4
+		configRecord(nil, "b"),
+		historyRecord(1),
+		summaryRecord("loss", "0.5"),
+		// snapshot 8
+		historyRecord(2),
+	}
+}
+
+// configKeys returns the keys updated by a config record
+func configKeys(config *service.ConfigRecord) []string {
+	var keys []string
+	for _, item := range config.GetUpdate() {
+		keys = append(keys, item.Key)
+	}
+	return keys
+}
+
+func TestWriterSnapshots(t *testing.T) {
+	fileName := filepath.Join(t.TempDir(), "run.wandb")
+	settings := &service.Settings{
+		SyncFile:               &wrapperspb.StringValue{Value: fileName},
+		XStoreSnapshotInterval: &wrapperspb.Int64Value{Value: 3},
+	}
+	runWriter(t, snapshotRecords(), server.WithWriterSettings(settings))
+
+	var snapshots []int64
+	for _, record := range readStore(t, fileName) {
+		if record.GetSnapshot() != nil {
+			snapshots = append(snapshots, record.Num)
+		}
+	}
+	assert.Equal(t, []int64{4, 8}, snapshots)
+
+	for _, replay := range []bool{false, true} {
+		if replay {
+			// without the snapshot number, the whole store is replayed
+			assert.NoError(t, os.Remove(fileName+".snapshot"))
+		}
+		snapshot, err := server.LoadStoreSnapshot(context.Background(), fileName,
+			observability.NewNoOpLogger(), server.StoreOptions{})
+		assert.NoError(t, err)
+		assert.Equal(t, int64(9), snapshot.LastNum)
+		assert.Equal(t, []string{"a"}, configKeys(snapshot.Config))
+		assert.Len(t, snapshot.Summary.Update, 1)
+		assert.Equal(t, "0.5", snapshot.Summary.Update[0].ValueJson)
+		assert.Equal(t, int64(2), snapshot.Step)
+		assert.Equal(t, int64(3), snapshot.HistoryRows)
+	}
+}
+
+func TestWriterSnapshotsResume(t *testing.T) {
+	fileName := filepath.Join(t.TempDir(), "run.wandb")
+	settings := &service.Settings{SyncFile: &wrapperspb.StringValue{Value: fileName}}
+	runWriter(t, []*service.Record{configRecord([]string{"a"}), historyRecord(0)},
+		server.WithWriterSettings(settings),
+		server.WithWriterSnapshotInterval(2),
+	)
+	runWriter(t, []*service.Record{configRecord([]string{"b"}), historyRecord(1)},
+		server.WithWriterSettings(settings),
+		server.WithWriterSnapshotInterval(2),
+		server.WithWriterResume(),
+	)
+
+	// the snapshot of the resumed writer accounts for the records before
+	records := readStore(t, fileName)
+	last := records[len(records)-1].GetSnapshot()
+	assert.NotNil(t, last)
+	assert.Equal(t, []string{"a", "b"}, configKeys(last.Config))
+	assert.Equal(t, int64(2), last.HistoryRows)
+}
+
+func TestCompactSnapshots(t *testing.T) {
+	fileName := filepath.Join(t.TempDir(), "run.wandb")
+	settings := &service.Settings{SyncFile: &wrapperspb.StringValue{Value: fileName}}
+	runWriter(t, snapshotRecords(), server.WithWriterSettings(settings), server.WithWriterSnapshotInterval(3))
+
+	_, err := server.CompactStore(context.Background(), fileName, "",
+		observability.NewNoOpLogger(), server.StoreOptions{})
+	assert.NoError(t, err)
+
+	// only the last snapshot is kept, and it is still found
+	var snapshots []int64
+	for _, record := range readStore(t, fileName) {
+		if record.GetSnapshot() != nil {
+			snapshots = append(snapshots, record.Num)
+		}
+	}
+	assert.Equal(t, []int64{8}, snapshots)
+	snapshot, err := server.LoadStoreSnapshot(context.Background(), fileName,
+		observability.NewNoOpLogger(), server.StoreOptions{})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"a"}, configKeys(snapshot.Config))
+}
@@ -0,0 +1,118 @@
+package server
+
+import (
+	"encoding/binary"
+	"fmt"
+	"os"
+	"sync"
+
+	"github.com/wandb/wandb/core/pkg/service"
+	"google.golang.org/protobuf/proto"
+)
+
+// spillBuffer is a file backed FIFO queue of records. The writer spills the
+// records that do not fit in the store queue to it, so that handling records
+// is not gated by the latency of the store.
+type spillBuffer struct {
+	mu sync.Mutex
+
+	// file holds the length-prefixed marshaled records
+	file *os.File
+
+	// readOffset is the offset of the next record to pop
+	readOffset int64
+
+	// writeOffset is the offset the next record is pushed at
+	writeOffset int64
+
+	// count is the number of records in the buffer
+	count int
+}
+
+// newSpillBuffer creates a spill buffer backed by a temporary file in dir
+func newSpillBuffer(dir string) (*spillBuffer, error) {
+	file, err := os.CreateTemp(dir, "wandb-spill-*")
+	if err != nil {
+		return nil, err
+	}
+	return &spillBuffer{file: file}, nil
+}
+
+// push appends a record to the buffer
+func (b *spillBuffer) push(record *service.Record) error {
+	data, err := proto.Marshal(record)
+	if err != nil {
+		return err
+	}
+	frame := make([]byte, 4+len(data))
+	binary.LittleEndian.PutUint32(frame, uint32(len(data)))
+	copy(frame[4:], data)
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if _, err := b.file.WriteAt(frame, b.writeOffset); err != nil {
+		return err
+	}
+	b.writeOffset += int64(len(frame))
+	b.count++
+	return nil
+}
+
+// pop removes the oldest record from the buffer, it returns nil if the buffer
+// is empty. If the file can't be read the buffered records are dropped.
+func (b *spillBuffer) pop() (*service.Record, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.count == 0 {
+		return nil, nil
+	}
+	var size [4]byte
+	if _, err := b.file.ReadAt(size[:], b.readOffset); err != nil {
+		return nil, b.reset(err)
+	}
+	data := make([]byte, binary.LittleEndian.Uint32(size[:]))
+	if _, err := b.file.ReadAt(data, b.readOffset+4); err != nil {
+		return nil, b.reset(err)
+	}
+	b.readOffset += int64(4 + len(data))
+	b.count--
+	if b.count == 0 {
+		// start over so that the file does not grow while the writer keeps
+		// up with the records
+		if err := b.reset(nil); err != nil {
+			return nil, err
+		}
+	}
+	record := &service.Record{}
+	if err := proto.Unmarshal(data, record); err != nil {
+		return nil, fmt.Errorf("spill: can't unmarshal record: %w", err)
+	}
+	return record, nil
+}
+
+// reset empties the buffer, it returns err or the error truncating the file
+func (b *spillBuffer) reset(err error) error {
+	if err != nil {
+		err = fmt.Errorf("spill: dropping %d records: %w", b.count, err)
+	}
+	b.count, b.readOffset, b.writeOffset = 0, 0, 0
+	if truncErr := b.file.Truncate(0); err == nil {
+		err = truncErr
+	}
+	return err
+}
+
+// len returns the number of records in the buffer
+func (b *spillBuffer) len() int {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.count
+}
+
+// close closes and removes the file backing the buffer
+func (b *spillBuffer) close() error {
+	if err := b.file.Close(); err != nil {
+		return err
+	}
+	return os.Remove(b.file.Name())
+}
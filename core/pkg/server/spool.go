@@ -0,0 +1,215 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"io"
+	"os"
+	"sync/atomic"
+
+	"google.golang.org/protobuf/proto"
+
+	"github.com/wandb/wandb/core/pkg/observability"
+	"github.com/wandb/wandb/core/pkg/service"
+)
+
+// defaultSpoolMaxBytes is the default limit of the records spooled while the
+// backend is unreachable
+const defaultSpoolMaxBytes = 256 << 20
+
+// errSpoolFull is returned when a record does not fit in the spool
+var errSpoolFull = errors.New("spool: full")
+
+// Connectivity tracks whether the backend is reachable. The clients of the
+// sender update it, and the writer spools the records to forward while the
+// backend is unreachable.
+type Connectivity struct {
+	disconnected atomic.Bool
+
+	// reconnected is signaled when the backend becomes reachable again
+	reconnected chan struct{}
+}
+
+// NewConnectivity returns a connectivity that starts connected
+func NewConnectivity() *Connectivity {
+	return &Connectivity{reconnected: make(chan struct{}, 1)}
+}
+
+// SetConnected records whether the last request reached the backend
+func (c *Connectivity) SetConnected(connected bool) {
+	if c == nil {
+		return
+	}
+	if c.disconnected.Swap(!connected) && connected {
+		select {
+		case c.reconnected <- struct{}{}:
+		default:
+		}
+	}
+}
+
+// Connected returns whether the backend is reachable
+func (c *Connectivity) Connected() bool {
+	return c == nil || !c.disconnected.Load()
+}
+
+// Reconnected returns a channel that is signaled when the backend becomes
+// reachable again
+func (c *Connectivity) Reconnected() <-chan struct{} {
+	if c == nil {
+		return nil
+	}
+	return c.reconnected
+}
+
+// recordSpool is a durable FIFO queue of the records to forward to the
+// sender, in the store format. It is only used by the writer loop.
+type recordSpool struct {
+	ctx    context.Context
+	logger *observability.CoreLogger
+
+	// name is the file name of the spool
+	name string
+
+	// opts are the options of the spool store
+	opts StoreOptions
+
+	// store is the spool open for writing, it is nil until a record is
+	// pushed
+	store *Store
+
+	// count is the number of records in the spool
+	count int
+
+	// bytes is the size of the records in the spool
+	bytes int64
+
+	// maxBytes is the limit of the size of the records in the spool
+	maxBytes int64
+}
+
+func newRecordSpool(
+	ctx context.Context,
+	logger *observability.CoreLogger,
+	name string,
+	opts StoreOptions,
+	maxBytes int64,
+) *recordSpool {
+	return &recordSpool{
+		ctx:      ctx,
+		logger:   logger,
+		name:     name,
+		opts:     opts,
+		maxBytes: maxBytes,
+	}
+}
+
+// push appends a record to the spool, it returns errSpoolFull if the record
+// does not fit
+func (s *recordSpool) push(record *service.Record) error {
+	size := int64(proto.Size(record))
+	if s.bytes+size > s.maxBytes {
+		return errSpoolFull
+	}
+	if s.store == nil {
+		opts := s.opts
+		opts.Resume = s.count > 0
+		store := NewStoreWithOptions(s.ctx, s.name, s.logger, opts)
+		if err := store.Open(os.O_WRONLY); err != nil {
+			return err
+		}
+		s.store = store
+	}
+	if err := s.store.Write(record); err != nil {
+		return err
+	}
+	s.count++
+	s.bytes += size
+	return nil
+}
+
+// len returns the number of records in the spool
+func (s *recordSpool) len() int {
+	return s.count
+}
+
+// replay forwards the spooled records in order while connected returns true.
+// The records that are not forwarded stay in the spool.
+func (s *recordSpool) replay(connected func() bool, forward func(*service.Record)) error {
+	if s.count == 0 {
+		return nil
+	}
+	if s.store != nil {
+		err := s.store.Close()
+		s.store = nil
+		if err != nil {
+			return s.reset(err)
+		}
+	}
+	reader := NewStoreWithOptions(s.ctx, s.name, s.logger, s.opts)
+	if err := reader.Open(os.O_RDONLY); err != nil {
+		return s.reset(err)
+	}
+
+	// the records left once disconnected are moved to a new spool
+	var rest *recordSpool
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			reader.Close()
+			return s.reset(err)
+		}
+		if rest == nil && connected() {
+			forward(record)
+			continue
+		}
+		if rest == nil {
+			rest = newRecordSpool(s.ctx, s.logger, s.name+".rest", s.opts, s.maxBytes)
+		}
+		if err := rest.push(record); err != nil {
+			reader.Close()
+			rest.close()
+			return s.reset(err)
+		}
+	}
+	if err := reader.Close(); err != nil {
+		s.logger.CaptureError("spool: error closing spool", err)
+	}
+	if rest == nil {
+		return s.reset(nil)
+	}
+	if err := rest.store.Close(); err != nil {
+		rest.close()
+		return s.reset(err)
+	}
+	if err := os.Rename(rest.name, s.name); err != nil {
+		rest.close()
+		return s.reset(err)
+	}
+	s.count, s.bytes = rest.count, rest.bytes
+	return nil
+}
+
+// reset empties the spool, it returns err
+func (s *recordSpool) reset(err error) error {
+	if s.store != nil {
+		_ = s.store.Close()
+		s.store = nil
+	}
+	if err != nil {
+		s.logger.CaptureError("spool: dropping spooled records", err, "records", s.count)
+	}
+	s.count, s.bytes = 0, 0
+	if rmErr := os.Remove(s.name); rmErr != nil && !os.IsNotExist(rmErr) && err == nil {
+		err = rmErr
+	}
+	return err
+}
+
+// close drops the spooled records and removes the spool
+func (s *recordSpool) close() {
+	_ = s.reset(nil)
+}
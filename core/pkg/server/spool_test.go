@@ -0,0 +1,177 @@
+package server_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	"github.com/wandb/wandb/core/pkg/observability"
+	"github.com/wandb/wandb/core/pkg/server"
+	"github.com/wandb/wandb/core/pkg/service"
+)
+
+// startSpoolWriter starts a writer that spools the records while
+// connectivity is disconnected, it returns the input and forward channels
+func startSpoolWriter(
+	t *testing.T,
+	fileName string,
+	connectivity *server.Connectivity,
+	opts ...server.WriterOption,
+) (chan *service.Record, chan *service.Record, *server.Writer) {
+	fwdChan := make(chan *service.Record, server.BufferSize)
+	inChan := make(chan *service.Record, server.BufferSize)
+	writer := server.NewWriter(context.Background(), observability.NewNoOpLogger(),
+		append([]server.WriterOption{
+			server.WithWriterFwdChannel(fwdChan),
+			server.WithWriterSettings(&service.Settings{SyncFile: &wrapperspb.StringValue{Value: fileName}}),
+			server.WithWriterConnectivity(connectivity),
+		}, opts...)...,
+	)
+	go writer.Do(inChan)
+	return inChan, fwdChan, writer
+}
+
+func TestWriterSpool(t *testing.T) {
+	fileName := filepath.Join(t.TempDir(), "run.wandb")
+	connectivity := server.NewConnectivity()
+	inChan, fwdChan, writer := startSpoolWriter(t, fileName, connectivity)
+
+	records := makeOutputRecords(4)
+	inChan <- records[0]
+	first := <-fwdChan
+
+	// while disconnected the records are spooled instead of forwarded
+	connectivity.SetConnected(false)
+	for _, record := range records[1:] {
+		inChan <- record
+	}
+	assert.Eventually(t, func() bool { return writer.Stats().Spooled == 3 }, time.Second, time.Millisecond)
+	assert.Empty(t, fwdChan)
+	_, err := os.Stat(fileName + ".spool")
+	assert.NoError(t, err)
+
+	// they are forwarded in order once reconnected
+	connectivity.SetConnected(true)
+	forwarded := []*service.Record{first, <-fwdChan, <-fwdChan, <-fwdChan}
+	assert.Equal(t, []int64{1, 2, 3, 4}, recordNums(forwarded))
+	assert.Eventually(t, func() bool { return writer.Stats().Spooled == 0 }, time.Second, time.Millisecond)
+
+	close(inChan)
+	for range fwdChan {
+	}
+	_, err = os.Stat(fileName + ".spool")
+	assert.True(t, os.IsNotExist(err))
+}
+
+func TestWriterSpoolFull(t *testing.T) {
+	fileName := filepath.Join(t.TempDir(), "run.wandb")
+	connectivity := server.NewConnectivity()
+	connectivity.SetConnected(false)
+	// only a single record fits in the spool
+	inChan, fwdChan, _ := startSpoolWriter(t, fileName, connectivity,
+		server.WithWriterSpoolMaxBytes(20))
+
+	for _, record := range makeOutputRecords(3) {
+		inChan <- record
+	}
+	// the writer waits for the sender instead of dropping the records
+	forwarded := []*service.Record{<-fwdChan, <-fwdChan}
+	assert.Equal(t, []int64{1, 2}, recordNums(forwarded))
+
+	close(inChan)
+	for record := range fwdChan {
+		forwarded = append(forwarded, record)
+	}
+	assert.Equal(t, []int64{1, 2, 3}, recordNums(forwarded))
+}
+
+func TestWriterSpoolClose(t *testing.T) {
+	fileName := filepath.Join(t.TempDir(), "run.wandb")
+	connectivity := server.NewConnectivity()
+	connectivity.SetConnected(false)
+	inChan, fwdChan, _ := startSpoolWriter(t, fileName, connectivity)
+
+	// the spooled records are forwarded when the writer closes
+	for _, record := range makeOutputRecords(3) {
+		inChan <- record
+	}
+	close(inChan)
+	var forwarded []*service.Record
+	for record := range fwdChan {
+		forwarded = append(forwarded, record)
+	}
+	assert.Equal(t, []int64{1, 2, 3}, recordNums(forwarded))
+}
+
+func TestWriterSpoolAbort(t *testing.T) {
+	fileName := filepath.Join(t.TempDir(), "run.wandb")
+	connectivity := server.NewConnectivity()
+	connectivity.SetConnected(false)
+	inChan, fwdChan, writer := startSpoolWriter(t, fileName, connectivity)
+
+	// the spooled records are forwarded when the writer is aborted too
+	for _, record := range makeOutputRecords(3) {
+		inChan <- record
+	}
+	assert.Eventually(t, func() bool { return writer.Stats().Spooled == 3 }, time.Second, time.Millisecond)
+	writer.Abort()
+	var forwarded []*service.Record
+	for record := range fwdChan {
+		forwarded = append(forwarded, record)
+	}
+	assert.Equal(t, []int64{1, 2, 3}, recordNums(forwarded))
+	close(inChan)
+}
+
+func TestWriterSpoolDisabled(t *testing.T) {
+	fileName := filepath.Join(t.TempDir(), "run.wandb")
+	connectivity := server.NewConnectivity()
+	connectivity.SetConnected(false)
+	inChan, fwdChan, _ := startSpoolWriter(t, fileName, connectivity,
+		server.WithWriterSpoolMaxBytes(-1))
+
+	inChan <- makeOutputRecords(1)[0]
+	<-fwdChan
+	close(inChan)
+	for range fwdChan {
+	}
+	_, err := os.Stat(fileName + ".spool")
+	assert.True(t, os.IsNotExist(err))
+}
+
+func TestWriterPriorityChannel(t *testing.T) {
+	fileName := filepath.Join(t.TempDir(), "run.wandb")
+	connectivity := server.NewConnectivity()
+	connectivity.SetConnected(false)
+	priorityChan := make(chan *service.Record, server.BufferSize)
+	inChan, fwdChan, _ := startSpoolWriter(t, fileName, connectivity,
+		server.WithWriterPriorityChannel(priorityChan))
+
+	// the exit is neither queued behind the spooled records nor spooled
+	for _, record := range makeOutputRecords(2) {
+		inChan <- record
+	}
+	inChan <- &service.Record{RecordType: &service.Record_Exit{Exit: &service.RunExitRecord{}}}
+	inChan <- &service.Record{RecordType: &service.Record_Request{Request: &service.Request{
+		RequestType: &service.Request_Defer{Defer: &service.DeferRequest{}},
+	}}}
+	exit := <-priorityChan
+	assert.NotNil(t, exit.GetExit())
+	assert.Empty(t, fwdChan)
+
+	close(inChan)
+	for range priorityChan {
+	}
+	var forwarded []*service.Record
+	for record := range fwdChan {
+		forwarded = append(forwarded, record)
+	}
+	assert.Len(t, forwarded, 3)
+	assert.Equal(t, []int64{1, 2}, recordNums(forwarded[:2]))
+	assert.NotNil(t, forwarded[2].GetRequest().GetDefer())
+}
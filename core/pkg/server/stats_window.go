@@ -0,0 +1,161 @@
+package server
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/segmentio/encoding/json"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/wandb/wandb/core/internal/clients"
+	"github.com/wandb/wandb/core/pkg/service"
+)
+
+const (
+	// StatsWindowMean aggregates the system metrics of a window with their
+	// mean
+	StatsWindowMean = "mean"
+
+	// StatsWindowMax aggregates the system metrics of a window with their
+	// max
+	StatsWindowMax = "max"
+)
+
+// StatsWindow aggregates the system metrics sampled over a window into one
+// record, so that a long run stores and sends a record per window instead of
+// one per sample of each asset.
+type StatsWindow struct {
+	period      time.Duration
+	aggregation string
+
+	// metrics are the metrics of the window so far, by key
+	metrics map[string]*windowMetric
+
+	// timestamp is the time of the last sample of the window
+	timestamp *timestamppb.Timestamp
+
+	// start is when the first sample of the window was added
+	start time.Time
+
+	timer *time.Timer
+}
+
+type windowMetric struct {
+	total float64
+	max   float64
+	count int
+}
+
+// NewStatsWindow returns a window of the period that aggregates the metrics
+// with the mean or the max
+func NewStatsWindow(period time.Duration, aggregation string) (*StatsWindow, error) {
+	switch aggregation {
+	case "":
+		aggregation = StatsWindowMean
+	case StatsWindowMean, StatsWindowMax:
+	default:
+		return nil, fmt.Errorf("invalid stats window aggregation %q", aggregation)
+	}
+	return &StatsWindow{
+		period:      period,
+		aggregation: aggregation,
+		metrics:     make(map[string]*windowMetric),
+	}, nil
+}
+
+// newStatsWindow returns the window of the system metrics from the settings,
+// nil if the metrics are not aggregated
+func newStatsWindow(settings *service.Settings) (*StatsWindow, error) {
+	seconds := settings.GetXStatsWindowSeconds().GetValue()
+	if seconds <= 0 {
+		return nil, nil
+	}
+	return NewStatsWindow(
+		clients.SecondsToDuration(seconds),
+		settings.GetXStatsWindowAggregation().GetValue(),
+	)
+}
+
+// Add adds the metrics of a system stats record to the window. The values
+// that are not numbers are ignored.
+func (w *StatsWindow) Add(stats *service.StatsRecord, now time.Time) {
+	if len(w.metrics) == 0 {
+		w.start = now
+	}
+	for _, item := range stats.GetItem() {
+		var value float64
+		if err := json.Unmarshal([]byte(item.GetValueJson()), &value); err != nil {
+			continue
+		}
+		metric, ok := w.metrics[item.GetKey()]
+		if !ok {
+			metric = &windowMetric{max: value}
+			w.metrics[item.GetKey()] = metric
+		}
+		metric.total += value
+		metric.max = max(metric.max, value)
+		metric.count++
+	}
+	if stats.GetTimestamp() != nil {
+		w.timestamp = stats.GetTimestamp()
+	}
+}
+
+// Ready returns a channel that receives once the window is over, nil if it
+// is empty
+func (w *StatsWindow) Ready() <-chan time.Time {
+	if w == nil || len(w.metrics) == 0 {
+		return nil
+	}
+	delay := time.Until(w.start.Add(w.period))
+	if w.timer == nil {
+		w.timer = time.NewTimer(delay)
+	} else {
+		if !w.timer.Stop() {
+			select {
+			case <-w.timer.C:
+			default:
+			}
+		}
+		w.timer.Reset(delay)
+	}
+	return w.timer.C
+}
+
+// Flush returns the record of the metrics of the window, and starts a new
+// one. It returns nil if the window is empty.
+func (w *StatsWindow) Flush() *service.Record {
+	if w == nil || len(w.metrics) == 0 {
+		return nil
+	}
+	keys := make([]string, 0, len(w.metrics))
+	for key := range w.metrics {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	stats := &service.StatsRecord{
+		StatsType: service.StatsRecord_SYSTEM,
+		Timestamp: w.timestamp,
+	}
+	for _, key := range keys {
+		metric := w.metrics[key]
+		value := metric.total / float64(metric.count)
+		if w.aggregation == StatsWindowMax {
+			value = metric.max
+		}
+		encoded, err := json.Marshal(value)
+		if err != nil {
+			continue
+		}
+		stats.Item = append(stats.Item, &service.StatsItem{Key: key, ValueJson: string(encoded)})
+	}
+	clear(w.metrics)
+	w.timestamp = nil
+
+	return &service.Record{
+		RecordType: &service.Record_Stats{Stats: stats},
+		Control:    &service.Control{AlwaysSend: true},
+	}
+}
@@ -0,0 +1,162 @@
+package server
+
+import (
+	"fmt"
+
+	"github.com/segmentio/encoding/json"
+
+	"github.com/wandb/wandb/core/pkg/service"
+)
+
+// stopCondition is a condition over a history metric that stops the run
+// once it holds
+type stopCondition struct {
+	request *service.StopConditionRequest
+
+	// since is the step from which a threshold held, if it does
+	since *int64
+
+	// best is the best value of the metric of a plateau, reached at bestStep
+	best     *float64
+	bestStep int64
+}
+
+// handleStopCondition registers a stop condition, it replaces the condition
+// of the same name
+func (h *Handler) handleStopCondition(_ *service.Record, request *service.StopConditionRequest, response *service.Response) {
+	result := &service.StopConditionResponse{}
+	if err := validateStopCondition(request); err != nil {
+		result.Error = &service.ErrorInfo{Code: service.ErrorInfo_USAGE, Message: err.Error()}
+	} else {
+		h.addStopCondition(&stopCondition{request: request})
+	}
+	response.ResponseType = &service.Response_StopConditionResponse{StopConditionResponse: result}
+}
+
+func validateStopCondition(request *service.StopConditionRequest) error {
+	name := request.GetName()
+	switch {
+	case name == "":
+		return fmt.Errorf("handler: a stop condition needs a name")
+	case request.GetMetric() == "":
+		return fmt.Errorf("handler: the stop condition %s needs a metric", name)
+	case request.GetSteps() < 0:
+		return fmt.Errorf("handler: the stop condition %s has negative steps", name)
+	}
+	switch request.GetKind() {
+	case service.StopConditionRequest_THRESHOLD:
+		switch request.GetOp() {
+		case "<", "<=", ">", ">=":
+		default:
+			return fmt.Errorf("handler: the stop condition %s has an unknown op %q", name, request.GetOp())
+		}
+	case service.StopConditionRequest_PLATEAU:
+		switch request.GetGoal() {
+		case service.MetricRecord_GOAL_MINIMIZE, service.MetricRecord_GOAL_MAXIMIZE:
+		default:
+			return fmt.Errorf("handler: the stop condition %s needs a goal", name)
+		}
+		if request.GetMinDelta() < 0 {
+			return fmt.Errorf("handler: the stop condition %s has a negative min delta", name)
+		}
+	default:
+		return fmt.Errorf("handler: the stop condition %s has an unknown kind", name)
+	}
+	return nil
+}
+
+func (h *Handler) addStopCondition(condition *stopCondition) {
+	for i, other := range h.stopConditions {
+		if other.request.GetName() == condition.request.GetName() {
+			h.stopConditions[i] = condition
+			return
+		}
+	}
+	h.stopConditions = append(h.stopConditions, condition)
+}
+
+// evalStopConditions evaluates the stop conditions over a history row, the
+// run is stopped by the first that holds. A condition is not evaluated on a
+// row that doesn't log its metric.
+func (h *Handler) evalStopConditions(history *service.HistoryRecord) {
+	if len(h.stopConditions) == 0 || h.runStopped {
+		return
+	}
+	values := make(map[string]float64, len(history.GetItem()))
+	for _, item := range history.GetItem() {
+		var value float64
+		if err := json.Unmarshal([]byte(item.GetValueJson()), &value); err == nil {
+			values[item.GetKey()] = value
+		}
+	}
+
+	step := history.GetStep().GetNum()
+	for _, condition := range h.stopConditions {
+		value, ok := values[condition.request.GetMetric()]
+		if !ok {
+			continue
+		}
+		if reason, ok := condition.eval(step, value); ok {
+			h.logger.Info("handler: the run was stopped by a condition",
+				"name", condition.request.GetName(), "reason", reason)
+			h.runStopped = true
+			h.stopReason = reason
+			return
+		}
+	}
+}
+
+// eval updates the condition with the value of its metric at a step, it
+// returns why it holds if it does
+func (c *stopCondition) eval(step int64, value float64) (string, bool) {
+	request := c.request
+	switch request.GetKind() {
+	case service.StopConditionRequest_THRESHOLD:
+		if !compare(value, request.GetOp(), request.GetThreshold()) {
+			c.since = nil
+			return "", false
+		}
+		if c.since == nil {
+			c.since = &step
+		}
+		if step-*c.since < request.GetSteps() {
+			return "", false
+		}
+		return fmt.Sprintf("%s: %s %s %v for %d steps",
+			request.GetName(), request.GetMetric(), request.GetOp(), request.GetThreshold(),
+			step-*c.since), true
+	case service.StopConditionRequest_PLATEAU:
+		improvement := value
+		if c.best != nil {
+			improvement = value - *c.best
+		}
+		if request.GetGoal() == service.MetricRecord_GOAL_MINIMIZE {
+			improvement = -improvement
+		}
+		if c.best == nil || improvement > request.GetMinDelta() {
+			c.best = &value
+			c.bestStep = step
+			return "", false
+		}
+		if step-c.bestStep < request.GetSteps() {
+			return "", false
+		}
+		return fmt.Sprintf("%s: no improvement of %s in %d steps",
+			request.GetName(), request.GetMetric(), step-c.bestStep), true
+	}
+	return "", false
+}
+
+func compare(value float64, op string, threshold float64) bool {
+	switch op {
+	case "<":
+		return value < threshold
+	case "<=":
+		return value <= threshold
+	case ">":
+		return value > threshold
+	case ">=":
+		return value >= threshold
+	}
+	return false
+}
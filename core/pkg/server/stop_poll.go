@@ -0,0 +1,92 @@
+package server
+
+import (
+	"context"
+	"time"
+
+	"github.com/wandb/wandb/core/internal/clients"
+	"github.com/wandb/wandb/core/internal/gql"
+	"github.com/wandb/wandb/core/pkg/service"
+	"github.com/wandb/wandb/core/pkg/utils"
+)
+
+// defaultStopPollingInterval is how often the backend is asked whether the
+// run was stopped from the UI, as often as the client asks the handler
+const defaultStopPollingInterval = 15 * time.Second
+
+// stopPoller asks the backend whether the run was stopped from the UI
+type stopPoller struct {
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+// startStopPolling starts asking the backend whether the run was stopped from
+// the UI, the handler is told once it was
+func (s *Sender) startStopPolling() {
+	if s.stopPoller != nil || s.graphqlClient == nil || s.RunRecord == nil ||
+		s.settings.GetXSync().GetValue() {
+		return
+	}
+	interval := defaultStopPollingInterval
+	if setting := s.settings.GetXStopPollingIntervalSeconds(); setting.GetValue() > 0 {
+		interval = clients.SecondsToDuration(setting.GetValue())
+	}
+
+	ctx, cancel := context.WithCancel(s.backendCtx)
+	poller := &stopPoller{cancel: cancel, done: make(chan struct{})}
+	s.stopPoller = poller
+
+	project := s.RunRecord.Project
+	entity := s.RunRecord.Entity
+	runID := s.RunRecord.RunId
+	go func() {
+		defer close(poller.done)
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+			data, err := gql.RunStoppedStatus(ctx, s.graphqlClient,
+				utils.NilIfZero(project), utils.NilIfZero(entity), runID)
+			if err != nil {
+				if ctx.Err() == nil {
+					s.logger.Warn("sender: failed to get the stop status of the run", "error", err)
+				}
+				continue
+			}
+			if !runStopped(data) {
+				continue
+			}
+			s.forwardAsync(&service.Record{
+				RecordType: &service.Record_Request{Request: &service.Request{
+					RequestType: &service.Request_RunStopped{RunStopped: &service.RunStoppedRequest{}},
+				}},
+				Control: &service.Control{Local: true, AlwaysSend: true},
+			})
+			return
+		}
+	}()
+}
+
+// finishStopPolling stops asking the backend whether the run was stopped
+func (s *Sender) finishStopPolling() {
+	if s.stopPoller == nil {
+		return
+	}
+	s.stopPoller.cancel()
+	<-s.stopPoller.done
+	s.stopPoller = nil
+}
+
+// runStopped returns whether the backend reports the run as stopped
+func runStopped(data *gql.RunStoppedStatusResponse) bool {
+	project := data.GetProject()
+	if project == nil || project.GetRun() == nil {
+		return false
+	}
+	stopped := project.GetRun().GetStopped()
+	return stopped != nil && *stopped
+}
@@ -1,14 +1,22 @@
 package server
 
 import (
+	"bytes"
+	"compress/gzip"
 	"context"
 	"encoding/binary"
+	"errors"
 	"fmt"
 	"io"
+	"math"
 	"os"
+	"strings"
+	"sync"
 
 	"github.com/wandb/wandb/core/pkg/observability"
 
+	"github.com/klauspost/compress/snappy"
+	"github.com/klauspost/compress/zstd"
 	"github.com/wandb/wandb/core/pkg/leveldb"
 	"github.com/wandb/wandb/core/pkg/service"
 	"google.golang.org/protobuf/proto"
@@ -18,6 +26,18 @@ type HeaderOptions struct {
 	IDENT   [4]byte
 	Magic   uint16
 	Version byte
+	// Compression is the codec used for the record payloads, it is only
+	// encoded in headers of version headerVersionCompression or later.
+	Compression Compression
+	// Encryption is the cipher used for the record payloads, it is only
+	// encoded in headers of version headerVersionEncryption or later.
+	Encryption Encryption
+	// Schema is the encoded FileDescriptorSet of the records, it is only
+	// encoded in headers of version headerVersionSchema or later.
+	Schema []byte
+	// Metadata identifies the run of the store, it is only encoded in
+	// headers of version headerVersionMetadata or later.
+	Metadata *StoreMetadata
 }
 
 const (
@@ -25,6 +45,18 @@ const (
 	headerMagic = 0xBEE1
 	// headerVersion is the version of the header.
 	headerVersion = 0
+	// headerVersionCompression is the version of the header that carries
+	// the compression codec of the records.
+	headerVersionCompression = 1
+	// headerVersionEncryption is the version of the header that also
+	// carries the encryption cipher of the records.
+	headerVersionEncryption = 2
+	// headerVersionSchema is the version of the header that also carries
+	// the schema the records were written with.
+	headerVersionSchema = 3
+	// headerVersionMetadata is the version of the header that also carries
+	// the metadata of the run.
+	headerVersionMetadata = 4
 )
 
 // headerIdent returns the header identifier.
@@ -41,10 +73,67 @@ func NewHeader() *HeaderOptions {
 	}
 }
 
-// MarshalBinary encodes the header to binary format.
-func (o *HeaderOptions) MarshalBinary(w io.Writer) error {
+// SetCompression sets the compression codec of the records, upgrading the
+// header version if the records are compressed.
+func (o *HeaderOptions) SetCompression(compression Compression) {
+	o.Compression = compression
+	if compression != CompressionNone && o.Version < headerVersionCompression {
+		o.Version = headerVersionCompression
+	}
+}
+
+// SetEncryption sets the encryption cipher of the records, upgrading the
+// header version if the records are encrypted.
+func (o *HeaderOptions) SetEncryption(encryption Encryption) {
+	o.Encryption = encryption
+	if encryption != EncryptionNone && o.Version < headerVersionEncryption {
+		o.Version = headerVersionEncryption
+	}
+}
+
+// SetSchema sets the schema of the records, upgrading the header version.
+func (o *HeaderOptions) SetSchema(schema []byte) {
+	o.Schema = schema
+	if o.Version < headerVersionSchema {
+		o.Version = headerVersionSchema
+	}
+}
 
-	if err := binary.Write(w, binary.LittleEndian, o); err != nil {
+// SetMetadata sets the metadata of the run, upgrading the header version.
+func (o *HeaderOptions) SetMetadata(metadata *StoreMetadata) {
+	o.Metadata = metadata
+	o.Version = headerVersionMetadata
+}
+
+// headerPrefix is the part of the header common to all versions.
+type headerPrefix struct {
+	IDENT   [4]byte
+	Magic   uint16
+	Version byte
+}
+
+// MarshalBinary encodes the header to binary format. The header is written
+// with a single Write, after all its fields were read.
+func (o *HeaderOptions) MarshalBinary(w io.Writer) error {
+	var buf bytes.Buffer
+	prefix := headerPrefix{IDENT: o.IDENT, Magic: o.Magic, Version: o.Version}
+	_ = binary.Write(&buf, binary.LittleEndian, &prefix)
+	if o.Version >= headerVersionCompression {
+		buf.WriteByte(byte(o.Compression))
+	}
+	if o.Version >= headerVersionEncryption {
+		buf.WriteByte(byte(o.Encryption))
+	}
+	if o.Version >= headerVersionSchema {
+		_ = binary.Write(&buf, binary.LittleEndian, uint32(len(o.Schema)))
+		buf.Write(o.Schema)
+	}
+	if o.Version >= headerVersionMetadata {
+		metadata := marshalMetadata(o.Metadata)
+		_ = binary.Write(&buf, binary.LittleEndian, uint32(len(metadata)))
+		buf.Write(metadata)
+	}
+	if _, err := w.Write(buf.Bytes()); err != nil {
 		return fmt.Errorf("error writing binary data: %w", err)
 	}
 	return nil
@@ -52,15 +141,284 @@ func (o *HeaderOptions) MarshalBinary(w io.Writer) error {
 
 // UnmarshalBinary decodes binary data into the header.
 func (o *HeaderOptions) UnmarshalBinary(r io.Reader) error {
-	if err := binary.Read(r, binary.LittleEndian, o); err != nil {
+	prefix := headerPrefix{}
+	if err := binary.Read(r, binary.LittleEndian, &prefix); err != nil {
 		return fmt.Errorf("error reading binary data: %w", err)
 	}
+	o.IDENT, o.Magic, o.Version = prefix.IDENT, prefix.Magic, prefix.Version
+	o.Compression = CompressionNone
+	if o.Version >= headerVersionCompression {
+		if err := binary.Read(r, binary.LittleEndian, &o.Compression); err != nil {
+			return fmt.Errorf("error reading binary data: %w", err)
+		}
+	}
+	o.Encryption = EncryptionNone
+	if o.Version >= headerVersionEncryption {
+		if err := binary.Read(r, binary.LittleEndian, &o.Encryption); err != nil {
+			return fmt.Errorf("error reading binary data: %w", err)
+		}
+	}
+	o.Schema = nil
+	if o.Version >= headerVersionSchema {
+		var size uint32
+		if err := binary.Read(r, binary.LittleEndian, &size); err != nil {
+			return fmt.Errorf("error reading binary data: %w", err)
+		}
+		if size > maxSchemaSize {
+			return fmt.Errorf("schema of %d bytes is too large", size)
+		}
+		// headers with metadata have an empty schema if there is none
+		if size > 0 {
+			o.Schema = make([]byte, size)
+			if _, err := io.ReadFull(r, o.Schema); err != nil {
+				return fmt.Errorf("error reading binary data: %w", err)
+			}
+		}
+	}
+	o.Metadata = nil
+	if o.Version >= headerVersionMetadata {
+		var size uint32
+		if err := binary.Read(r, binary.LittleEndian, &size); err != nil {
+			return fmt.Errorf("error reading binary data: %w", err)
+		}
+		if size > maxMetadataSize {
+			return fmt.Errorf("metadata of %d bytes is too large", size)
+		}
+		data := make([]byte, size)
+		if _, err := io.ReadFull(r, data); err != nil {
+			return fmt.Errorf("error reading binary data: %w", err)
+		}
+		metadata, err := unmarshalMetadata(data)
+		if err != nil {
+			return err
+		}
+		o.Metadata = metadata
+	}
 	return nil
 }
 
 // Valid checks if the header is valid based on a reference header.
 func (o *HeaderOptions) Valid() bool {
-	return o.IDENT == headerIdent() && o.Magic == headerMagic && o.Version == headerVersion
+	return o.IDENT == headerIdent() &&
+		o.Magic == headerMagic &&
+		o.Version <= headerVersionMetadata &&
+		o.Compression.valid() &&
+		o.Encryption.valid()
+}
+
+// size returns the size of the encoded header.
+func (o *HeaderOptions) size() int64 {
+	size := int64(binary.Size(headerPrefix{}))
+	if o.Version >= headerVersionCompression {
+		size += int64(binary.Size(o.Compression))
+	}
+	if o.Version >= headerVersionEncryption {
+		size += int64(binary.Size(o.Encryption))
+	}
+	if o.Version >= headerVersionSchema {
+		size += int64(binary.Size(uint32(0)) + len(o.Schema))
+	}
+	if o.Version >= headerVersionMetadata {
+		size += int64(binary.Size(uint32(0)) + len(marshalMetadata(o.Metadata)))
+	}
+	return size
+}
+
+// Compression is the codec used to compress the records in a store
+type Compression byte
+
+const (
+	// CompressionNone stores the records uncompressed.
+	CompressionNone Compression = iota
+	// CompressionGzip compresses each record with gzip.
+	CompressionGzip
+	// CompressionZstd compresses each record with zstd.
+	CompressionZstd
+	// CompressionSnappy compresses each record with snappy, which is faster
+	// than the other codecs but compresses less.
+	CompressionSnappy
+)
+
+// zstdEncoder and zstdDecoder are shared by all stores, their EncodeAll and
+// DecodeAll methods are safe for concurrent use.
+var (
+	zstdEncoder = sync.OnceValues(func() (*zstd.Encoder, error) {
+		return zstd.NewWriter(nil)
+	})
+	zstdDecoder = sync.OnceValues(func() (*zstd.Decoder, error) {
+		return zstd.NewReader(nil, zstd.WithDecoderConcurrency(1))
+	})
+)
+
+// ParseCompression returns the codec with the given name, as used by the
+// _store_compression setting: none, gzip, zstd or snappy. An empty name means
+// no compression.
+func ParseCompression(name string) (Compression, error) {
+	switch strings.ToLower(name) {
+	case "", "none":
+		return CompressionNone, nil
+	case "gzip":
+		return CompressionGzip, nil
+	case "zstd":
+		return CompressionZstd, nil
+	case "snappy":
+		return CompressionSnappy, nil
+	default:
+		return CompressionNone, fmt.Errorf("unknown compression %q", name)
+	}
+}
+
+func (c Compression) valid() bool {
+	return c == CompressionNone || c == CompressionGzip || c == CompressionZstd ||
+		c == CompressionSnappy
+}
+
+// compress compresses a single record payload.
+func (c Compression) compress(data []byte) ([]byte, error) {
+	switch c {
+	case CompressionNone:
+		return data, nil
+	case CompressionGzip:
+		var buf bytes.Buffer
+		zw := gzip.NewWriter(&buf)
+		if _, err := zw.Write(data); err != nil {
+			return nil, err
+		}
+		if err := zw.Close(); err != nil {
+			return nil, err
+		}
+		return buf.Bytes(), nil
+	case CompressionZstd:
+		zw, err := zstdEncoder()
+		if err != nil {
+			return nil, err
+		}
+		return zw.EncodeAll(data, nil), nil
+	case CompressionSnappy:
+		return snappy.Encode(nil, data), nil
+	default:
+		return nil, fmt.Errorf("unknown compression %d", c)
+	}
+}
+
+// decompress decompresses a single record payload.
+func (c Compression) decompress(data []byte) ([]byte, error) {
+	switch c {
+	case CompressionNone:
+		return data, nil
+	case CompressionGzip:
+		zr, err := gzip.NewReader(bytes.NewReader(data))
+		if err != nil {
+			return nil, err
+		}
+		defer zr.Close()
+		return io.ReadAll(zr)
+	case CompressionZstd:
+		zr, err := zstdDecoder()
+		if err != nil {
+			return nil, err
+		}
+		return zr.DecodeAll(data, nil)
+	case CompressionSnappy:
+		return snappy.Decode(nil, data)
+	default:
+		return nil, fmt.Errorf("unknown compression %d", c)
+	}
+}
+
+// ErrCorruptRecord is matched by the errors returned when a record in the
+// store is corrupt: its chunk checksum does not match, it is truncated, or
+// its payload cannot be decoded.
+var ErrCorruptRecord = errors.New("store: corrupt record")
+
+// CorruptRecordError is the error returned by Read for a corrupt record, it
+// wraps the underlying cause and matches ErrCorruptRecord with errors.Is.
+type CorruptRecordError struct {
+	Err error
+}
+
+func (e *CorruptRecordError) Error() string {
+	return fmt.Sprintf("%v: %v", ErrCorruptRecord, e.Err)
+}
+
+func (e *CorruptRecordError) Unwrap() error {
+	return e.Err
+}
+
+func (e *CorruptRecordError) Is(target error) bool {
+	return target == ErrCorruptRecord
+}
+
+// isCorruption reports whether an error returned by the leveldb reader is
+// caused by corrupt data rather than by the underlying file.
+func isCorruption(err error) bool {
+	return errors.Is(err, leveldb.ErrChecksumMismatch) ||
+		errors.Is(err, leveldb.ErrInvalidChunk) ||
+		errors.Is(err, io.ErrUnexpectedEOF)
+}
+
+// storeBlockSize is the size of the blocks the underlying leveldb log is
+// divided into, it must match the block size used by the leveldb package.
+const storeBlockSize = 32 * 1024
+
+// StoreOptions are the options used when opening a store
+type StoreOptions struct {
+	// Resume appends to an existing store instead of truncating it
+	Resume bool
+
+	// Compression is the codec used to compress the records of a new store,
+	// a resumed or read store uses the codec recorded in its header
+	Compression Compression
+
+	// EncryptionKey is the AES key used to encrypt the records of a new
+	// store, which is encrypted if it is set, and to decrypt the records of
+	// an encrypted store
+	EncryptionKey []byte
+
+	// Index writes a sidecar index of the record offsets next to the store,
+	// which lets readers seek to a record and speeds up resuming
+	Index bool
+
+	// EmbedSchema embeds the schema of the records in the header of a new
+	// store, so that readers built with a different schema can migrate them
+	EmbedSchema bool
+
+	// Metadata is written in the header of a new store, which identifies its
+	// run to tools reading the header only
+	Metadata *StoreMetadata
+
+	// Mmap maps a store opened for reading into memory instead of reading it
+	// through the file, which is faster for large stores. It must only be set
+	// for stores that are not written to while they are read, as the records
+	// appended after the store was opened are not read.
+	Mmap bool
+}
+
+// newHeader returns the header of a new store created with the options
+func (opts StoreOptions) newHeader() *HeaderOptions {
+	header := NewHeader()
+	header.SetCompression(opts.Compression)
+	if opts.EncryptionKey != nil {
+		header.SetEncryption(EncryptionAESGCM)
+	}
+	if opts.EmbedSchema {
+		header.SetSchema(RecordSchema())
+	}
+	if opts.Metadata != nil {
+		header.SetMetadata(opts.Metadata)
+	}
+	return header
+}
+
+// RecordStore is the interface used by the writer to persist records. It is
+// implemented by Store for local files, and by the stores of the other
+// backends, see StoreBackend.
+type RecordStore interface {
+	Open(flag int) error
+	Write(msg *service.Record) error
+	Sync() error
+	Close() error
+	LastRecordNum() int64
 }
 
 // Store is the persistent store for a stream
@@ -82,13 +440,50 @@ type Store struct {
 
 	// logger is the logger for the store
 	logger *observability.CoreLogger
+
+	// opts are the options the store was created with
+	opts StoreOptions
+
+	// lastRecordNum is the highest record number found when resuming
+	lastRecordNum int64
+
+	// codec encodes the records as described by the store header
+	codec recordCodec
+
+	// header is the header of a store opened for reading
+	header HeaderOptions
+
+	// headerSize is the size of the store header, the log starts after it
+	headerSize int64
+
+	// index is the writer of the sidecar index if it is enabled
+	index *storeIndexWriter
+
+	// pending is the record returned by the next Read after a seek
+	pending *service.Record
+
+	// bytesWritten is the number of encoded record bytes written
+	bytesWritten int64
+
+	// migrator decodes the records of a store written with another schema,
+	// it is nil if the records use the current schema
+	migrator *recordMigrator
+
+	// mapped is the mapping of a store opened for reading with Mmap
+	mapped *mappedFile
 }
 
 // NewStore creates a new store
 func NewStore(ctx context.Context, fileName string, logger *observability.CoreLogger) *Store {
+	return NewStoreWithOptions(ctx, fileName, logger, StoreOptions{})
+}
+
+// NewStoreWithOptions creates a new store with the given options
+func NewStoreWithOptions(ctx context.Context, fileName string, logger *observability.CoreLogger, opts StoreOptions) *Store {
 	sr := &Store{ctx: ctx,
 		name:   fileName,
 		logger: logger,
+		opts:   opts,
 	}
 	return sr
 }
@@ -103,7 +498,6 @@ func (sr *Store) Open(flag int) error {
 			return err
 		}
 		sr.db = f
-		sr.reader = leveldb.NewReaderExt(f, leveldb.CRCAlgoIEEE)
 		header := NewHeader()
 		if err := header.UnmarshalBinary(sr.db); err != nil {
 			sr.logger.CaptureError("can't read header", err)
@@ -114,21 +508,47 @@ func (sr *Store) Open(flag int) error {
 			sr.logger.CaptureError("can't read header", err)
 			return err
 		}
-		return nil
+		if sr.opts.Mmap {
+			mapped, err := mapFile(f)
+			if err != nil {
+				sr.logger.CaptureWarn("store: can't map file, reading it instead",
+					"name", sr.name, "error", err)
+			} else {
+				sr.mapped = mapped
+			}
+		}
+		return sr.openReader(header)
+	case os.O_RDWR:
+		return sr.recover()
 	case os.O_WRONLY:
+		if sr.opts.Resume {
+			resumed, err := sr.resume()
+			if err != nil {
+				return err
+			}
+			if resumed {
+				return nil
+			}
+		}
 		f, err := os.Create(sr.name)
 		if err != nil {
 			sr.logger.CaptureError("can't open file", err)
 			return err
 		}
 		sr.db = f
-		sr.writer = leveldb.NewWriterExt(f, leveldb.CRCAlgoIEEE)
-		header := NewHeader()
+		header := sr.opts.newHeader()
+		if sr.codec, err = newRecordCodec(header, sr.opts.EncryptionKey); err != nil {
+			sr.logger.CaptureError("can't set up record codec", err)
+			return err
+		}
+		sr.headerSize = header.size()
 		if err := header.MarshalBinary(sr.db); err != nil {
 			sr.logger.CaptureError("can't write header", err)
 			return err
 		}
-		return nil
+		// created after the header so that record offsets are absolute
+		sr.writer = leveldb.NewWriterExt(f, leveldb.CRCAlgoIEEE)
+		return sr.openIndex(false)
 	default:
 		// TODO: generalize this?
 		err := fmt.Errorf("invalid flag %d", flag)
@@ -137,6 +557,298 @@ func (sr *Store) Open(flag int) error {
 	}
 }
 
+// resume opens an existing store for appending. It scans the existing
+// records to find the highest record number, truncates a partially written
+// record left by a crash and pads the file to the next block boundary so
+// that new records start on a fresh block. It returns false if there is no
+// valid store to resume from.
+func (sr *Store) resume() (bool, error) {
+	f, err := os.Open(sr.name)
+	if os.IsNotExist(err) {
+		return false, nil
+	} else if err != nil {
+		sr.logger.CaptureError("can't open file", err)
+		return false, err
+	}
+
+	header := NewHeader()
+	if err := header.UnmarshalBinary(f); err != nil || !header.Valid() {
+		_ = f.Close()
+		sr.logger.CaptureWarn("store: invalid header, creating a new store", "name", sr.name)
+		return false, nil
+	}
+	// an existing store that can't be decoded must not be overwritten
+	if sr.codec, err = newRecordCodec(header, sr.opts.EncryptionKey); err != nil {
+		_ = f.Close()
+		sr.logger.CaptureError("can't set up record codec", err)
+		return false, err
+	}
+	if header.Encryption == EncryptionNone && sr.opts.EncryptionKey != nil {
+		sr.logger.CaptureWarn("store: resuming a store that is not encrypted", "name", sr.name)
+	}
+
+	reader := leveldb.NewReaderExt(logSection(f, header.size()), leveldb.CRCAlgoIEEE)
+	// only the records after the last indexed one need to be scanned
+	if sr.opts.Index && sr.seekLastIndexed(reader, header.size()) {
+		sr.lastRecordNum = scanLastRecordNum(reader, sr.codec)
+	}
+	if sr.lastRecordNum == 0 {
+		// there is no index, or the last indexed record was lost in a crash
+		reader = leveldb.NewReaderExt(logSection(f, header.size()), leveldb.CRCAlgoIEEE)
+		sr.lastRecordNum = scanLastRecordNum(reader, sr.codec)
+	}
+	if err := f.Close(); err != nil {
+		sr.logger.CaptureError("can't close file", err)
+		return false, err
+	}
+
+	f, err = os.OpenFile(sr.name, os.O_RDWR|os.O_APPEND, 0644)
+	if err != nil {
+		sr.logger.CaptureError("can't open file", err)
+		return false, err
+	}
+	if err := sr.truncateTail(f, header.size()); err != nil {
+		_ = f.Close()
+		return false, err
+	}
+	info, err := f.Stat()
+	if err != nil {
+		_ = f.Close()
+		sr.logger.CaptureError("can't stat file", err)
+		return false, err
+	}
+	// the leveldb writer always starts on a fresh block, so zero-fill the
+	// rest of the last block, which the reader skips over
+	if rem := (info.Size() - header.size()) % storeBlockSize; rem != 0 {
+		if _, err := f.Write(make([]byte, storeBlockSize-rem)); err != nil {
+			_ = f.Close()
+			sr.logger.CaptureError("can't pad file", err)
+			return false, err
+		}
+	}
+	// the writer takes its base offset from the file position
+	if _, err := f.Seek(0, io.SeekEnd); err != nil {
+		_ = f.Close()
+		sr.logger.CaptureError("can't seek file", err)
+		return false, err
+	}
+	sr.db = f
+	sr.writer = leveldb.NewWriterExt(f, leveldb.CRCAlgoIEEE)
+	sr.headerSize = header.size()
+	if err := sr.openIndex(true); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// recover opens an existing store after a crash. It truncates the partially
+// written record at the end of the file, if any, and leaves the store open
+// for reading from the first record.
+func (sr *Store) recover() error {
+	f, err := os.OpenFile(sr.name, os.O_RDWR, 0644)
+	if err != nil {
+		sr.logger.CaptureError("can't open file", err)
+		return err
+	}
+	header := NewHeader()
+	if err := header.UnmarshalBinary(f); err != nil {
+		_ = f.Close()
+		sr.logger.CaptureError("can't read header", err)
+		return err
+	}
+	if !header.Valid() {
+		_ = f.Close()
+		err := fmt.Errorf("invalid header")
+		sr.logger.CaptureError("can't read header", err)
+		return err
+	}
+	if err := sr.truncateTail(f, header.size()); err != nil {
+		_ = f.Close()
+		return err
+	}
+	sr.db = f
+	return sr.openReader(header)
+}
+
+// logSection returns a reader of the log of a store file, which starts after
+// the header, so that the offsets of the log reader are relative to it
+func logSection(f io.ReaderAt, headerSize int64) *io.SectionReader {
+	return io.NewSectionReader(f, headerSize, math.MaxInt64-headerSize)
+}
+
+// openReader sets up reading the log after the header
+func (sr *Store) openReader(header *HeaderOptions) error {
+	codec, err := newRecordCodec(header, sr.opts.EncryptionKey)
+	if err != nil {
+		sr.logger.CaptureError("can't set up record codec", err)
+		return err
+	}
+	sr.migrator = nil
+	if header.Schema != nil {
+		if sr.migrator, err = newRecordMigrator(header.Schema); err != nil {
+			sr.logger.CaptureError("can't set up record migration", err)
+			return err
+		}
+	}
+	sr.codec = codec
+	sr.header = *header
+	sr.headerSize = header.size()
+	if sr.mapped != nil {
+		sr.reader = leveldb.NewReaderExt(logSection(sr.mapped, sr.headerSize), leveldb.CRCAlgoIEEE)
+	} else {
+		sr.reader = leveldb.NewReaderExt(logSection(sr.db, sr.headerSize), leveldb.CRCAlgoIEEE)
+	}
+	return nil
+}
+
+// Header returns the header of a store opened for reading
+func (sr *Store) Header() HeaderOptions {
+	return sr.header
+}
+
+// openIndex opens the sidecar index for writing if it is enabled
+func (sr *Store) openIndex(resume bool) error {
+	if !sr.opts.Index {
+		return nil
+	}
+	index, err := openStoreIndexWriter(sr.name, resume)
+	if err != nil {
+		sr.logger.CaptureError("can't open index", err)
+		return err
+	}
+	sr.index = index
+	return nil
+}
+
+// loadIndex returns the entries of the sidecar index that point into the log
+func (sr *Store) loadIndex(headerSize int64) ([]storeIndexEntry, error) {
+	info, err := os.Stat(sr.name)
+	if err != nil {
+		return nil, err
+	}
+	return loadStoreIndex(sr.name, info.Size()-headerSize)
+}
+
+// scanLastRecordNum returns the highest number of the records read until
+// the end of the log, skipping the corrupt ones
+func scanLastRecordNum(reader *leveldb.Reader, codec recordCodec) int64 {
+	var lastRecordNum int64
+	for {
+		r, err := reader.Next()
+		if err == io.EOF {
+			return lastRecordNum
+		}
+		if err != nil {
+			reader.Recover()
+			continue
+		}
+		buf, err := io.ReadAll(r)
+		if err != nil {
+			reader.Recover()
+			continue
+		}
+		if buf, err = codec.decode(buf); err != nil {
+			continue
+		}
+		msg := &service.Record{}
+		if err := proto.Unmarshal(buf, msg); err != nil {
+			continue
+		}
+		if msg.Num > lastRecordNum {
+			lastRecordNum = msg.Num
+		}
+	}
+}
+
+// seekLastIndexed moves the reader to the last indexed record, it returns
+// false if there is no usable index
+func (sr *Store) seekLastIndexed(reader *leveldb.Reader, headerSize int64) bool {
+	entries, err := sr.loadIndex(headerSize)
+	if err != nil {
+		sr.logger.CaptureWarn("store: ignoring index", "name", sr.name, "error", err)
+		return false
+	}
+	if len(entries) == 0 {
+		return false
+	}
+	if err := reader.SeekRecord(entries[len(entries)-1].Offset); err != nil {
+		sr.logger.CaptureWarn("store: ignoring index", "name", sr.name, "error", err)
+		return false
+	}
+	return true
+}
+
+// SeekRecord moves a store opened for reading so that the next Read returns
+// the first record whose number is at least num. It uses the sidecar index,
+// if there is one, to skip the records before the closest indexed record.
+func (sr *Store) SeekRecord(num int64) error {
+	if sr.reader == nil {
+		err := fmt.Errorf("store is not open for reading")
+		sr.logger.CaptureError("can't seek record", err)
+		return err
+	}
+	sr.pending = nil
+	sr.reader.Recover()
+	offset := int64(0)
+	entries, err := sr.loadIndex(sr.headerSize)
+	if err != nil {
+		sr.logger.CaptureWarn("store: ignoring index", "name", sr.name, "error", err)
+	} else if entry, ok := findStoreIndexEntry(entries, num); ok {
+		offset = entry.Offset
+	}
+	if err := sr.reader.SeekRecord(offset); err != nil {
+		sr.logger.CaptureError("can't seek record", err)
+		return err
+	}
+	for {
+		record, err := sr.Read()
+		if err != nil {
+			return err
+		}
+		if record.Num >= num {
+			sr.pending = record
+			return nil
+		}
+	}
+}
+
+// truncateTail truncates the file after the last complete and valid record,
+// dropping a record that was only partially written when the process was
+// killed. headerSize is the size of the store header at the start of the file.
+func (sr *Store) truncateTail(f *os.File, headerSize int64) error {
+	if _, err := f.Seek(headerSize, io.SeekStart); err != nil {
+		sr.logger.CaptureError("can't seek file", err)
+		return err
+	}
+	end, err := leveldb.LastValidOffset(f, leveldb.CRCAlgoIEEE)
+	if err != nil {
+		sr.logger.CaptureError("can't scan file", err)
+		return err
+	}
+	end += headerSize
+	info, err := f.Stat()
+	if err != nil {
+		sr.logger.CaptureError("can't stat file", err)
+		return err
+	}
+	if info.Size() <= end {
+		return nil
+	}
+	sr.logger.Info("store: truncating partially written records",
+		"name", sr.name, "size", info.Size(), "truncatedSize", end)
+	if err := f.Truncate(end); err != nil {
+		sr.logger.CaptureError("can't truncate file", err)
+		return err
+	}
+	return nil
+}
+
+// LastRecordNum returns the highest record number found in the store when it
+// was resumed, or 0 if the store was not resumed.
+func (sr *Store) LastRecordNum() int64 {
+	return sr.lastRecordNum
+}
+
 // Close closes the store
 func (sr *Store) Close() error {
 	if sr.writer != nil {
@@ -146,6 +858,20 @@ func (sr *Store) Close() error {
 		}
 	}
 
+	if sr.index != nil {
+		if err := sr.index.close(); err != nil {
+			sr.logger.CaptureError("can't close index", err)
+		}
+		sr.index = nil
+	}
+
+	if sr.mapped != nil {
+		if err := sr.mapped.Close(); err != nil {
+			sr.logger.CaptureError("can't unmap file", err)
+		}
+		sr.mapped = nil
+	}
+
 	err := sr.db.Close()
 	if err != nil {
 		sr.logger.CaptureError("can't close file", err)
@@ -160,9 +886,15 @@ func (sr *Store) Write(msg *service.Record) error {
 		sr.logger.CaptureError("can't write header", err)
 		return err
 	}
-	out, err := proto.Marshal(msg)
+	buf, err := marshalRecord(msg)
+	defer buf.release()
 	if err != nil {
-		sr.logger.CaptureError("can't write header", err)
+		sr.logger.CaptureError("can't marshal record", err)
+		return err
+	}
+	out, err := sr.codec.encode(buf.data)
+	if err != nil {
+		sr.logger.CaptureError("can't encode record", err)
 		return err
 	}
 
@@ -170,6 +902,51 @@ func (sr *Store) Write(msg *service.Record) error {
 		sr.logger.CaptureError("can't write header", err)
 		return err
 	}
+	sr.bytesWritten += int64(len(out))
+	if sr.index != nil {
+		offset, err := sr.writer.LastRecordOffset()
+		if err == nil {
+			err = sr.index.add(msg.Num, offset-sr.headerSize)
+		}
+		if err != nil {
+			sr.logger.CaptureError("can't index record", err)
+			return err
+		}
+	}
+	return nil
+}
+
+// BytesWritten returns the number of record bytes written since the store was
+// opened, after compression and encryption
+func (sr *Store) BytesWritten() int64 {
+	return sr.bytesWritten
+}
+
+// Sync flushes the buffered records to the underlying file and commits
+// its contents to stable storage
+func (sr *Store) Sync() error {
+	if sr.db == nil {
+		err := fmt.Errorf("db is closed")
+		sr.logger.CaptureError("can't sync file", err)
+		return err
+	}
+	if sr.writer != nil {
+		if err := sr.writer.Flush(); err != nil {
+			sr.logger.CaptureError("can't flush file", err)
+			return err
+		}
+	}
+	if err := sr.db.Sync(); err != nil {
+		sr.logger.CaptureError("can't sync file", err)
+		return err
+	}
+	// the index is synced after the log so that it never points past it
+	if sr.index != nil {
+		if err := sr.index.sync(); err != nil {
+			sr.logger.CaptureError("can't sync index", err)
+			return err
+		}
+	}
 	return nil
 }
 
@@ -186,6 +963,11 @@ func (sr *Store) Read() (*service.Record, error) {
 		return nil, err
 	}
 
+	if record := sr.pending; record != nil {
+		sr.pending = nil
+		return record, nil
+	}
+
 	reader, err := sr.reader.Next()
 	if err == io.EOF {
 		return nil, err
@@ -194,18 +976,36 @@ func (sr *Store) Read() (*service.Record, error) {
 	if err != nil {
 		sr.logger.CaptureError("can't read record", err)
 		sr.reader.Recover()
-		return nil, err
+		return nil, sr.readError(err)
 	}
 	buf, err := io.ReadAll(reader)
 	if err != nil {
 		sr.logger.CaptureError("can't read record", err)
 		sr.reader.Recover()
-		return nil, err
+		return nil, sr.readError(err)
+	}
+	if buf, err = sr.codec.decode(buf); err != nil {
+		sr.logger.CaptureError("can't decode record", err)
+		return nil, &CorruptRecordError{Err: err}
 	}
 	msg := &service.Record{}
-	if err = proto.Unmarshal(buf, msg); err != nil {
+	if sr.migrator != nil {
+		err = sr.migrator.unmarshal(buf, msg)
+	} else {
+		err = proto.Unmarshal(buf, msg)
+	}
+	if err != nil {
 		sr.logger.CaptureError("can't read record", err)
-		return nil, err
+		return nil, &CorruptRecordError{Err: err}
 	}
 	return msg, nil
 }
+
+// readError wraps the errors of the leveldb reader that are caused by corrupt
+// data in a CorruptRecordError
+func (sr *Store) readError(err error) error {
+	if isCorruption(err) {
+		return &CorruptRecordError{Err: err}
+	}
+	return err
+}
@@ -0,0 +1,337 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/wandb/wandb/core/pkg/leveldb"
+	"github.com/wandb/wandb/core/pkg/observability"
+	"github.com/wandb/wandb/core/pkg/service"
+)
+
+// StoreBackendKind is the kind of storage the writer persists the store to
+type StoreBackendKind int
+
+const (
+	// BackendFile writes the store to a local file.
+	BackendFile StoreBackendKind = iota
+	// BackendMemory keeps the store in memory, for tests.
+	BackendMemory
+	// BackendS3 uploads the store to an S3 bucket as a multipart upload.
+	BackendS3
+	// BackendGCS uploads the store to a GCS bucket as a multipart upload,
+	// through the XML API of GCS.
+	BackendGCS
+)
+
+// StoreBackend is where the writer persists the store. Stores that are not
+// written to a local file have no sidecar files, so they are not indexed,
+// segmented, sharded, mirrored or journaled, and they can't be read back to
+// send the records stored while offline.
+type StoreBackend struct {
+	Kind StoreBackendKind
+
+	// Bucket is the bucket of an object storage backend
+	Bucket string
+
+	// Prefix is prepended to the name of the store to get the key of its
+	// object in the bucket
+	Prefix string
+}
+
+// ParseStoreBackend returns the backend described by the _store_backend
+// setting: "file", "memory", or the URL of an object storage prefix such as
+// s3://bucket/prefix or gs://bucket/prefix. An empty value is BackendFile.
+func ParseStoreBackend(value string) (StoreBackend, error) {
+	switch strings.ToLower(value) {
+	case "", "file":
+		return StoreBackend{Kind: BackendFile}, nil
+	case "memory":
+		return StoreBackend{Kind: BackendMemory}, nil
+	}
+	u, err := url.Parse(value)
+	if err != nil {
+		return StoreBackend{}, fmt.Errorf("invalid store backend %q: %w", value, err)
+	}
+	backend := StoreBackend{Bucket: u.Host, Prefix: strings.Trim(u.Path, "/")}
+	switch u.Scheme {
+	case "s3":
+		backend.Kind = BackendS3
+	case "gs":
+		backend.Kind = BackendGCS
+	default:
+		return StoreBackend{}, fmt.Errorf("unknown store backend %q", value)
+	}
+	if backend.Bucket == "" {
+		return StoreBackend{}, fmt.Errorf("store backend %q has no bucket", value)
+	}
+	return backend, nil
+}
+
+// String returns the setting value of the backend
+func (b StoreBackend) String() string {
+	switch b.Kind {
+	case BackendMemory:
+		return "memory"
+	case BackendS3:
+		return (&url.URL{Scheme: "s3", Host: b.Bucket, Path: "/" + b.Prefix}).String()
+	case BackendGCS:
+		return (&url.URL{Scheme: "gs", Host: b.Bucket, Path: "/" + b.Prefix}).String()
+	default:
+		return "file"
+	}
+}
+
+// objectKey returns the key of the object of the store named fileName
+func (b StoreBackend) objectKey(fileName string) string {
+	return path.Join(b.Prefix, filepath.Base(fileName))
+}
+
+// newBackendStore returns the store named fileName on the backend
+func newBackendStore(
+	ctx context.Context,
+	fileName string,
+	backend StoreBackend,
+	logger *observability.CoreLogger,
+	opts StoreOptions,
+) RecordStore {
+	switch backend.Kind {
+	case BackendMemory:
+		return newStreamStore(memorySink{name: fileName}, logger, opts)
+	case BackendS3, BackendGCS:
+		return newStreamStore(newObjectSink(ctx, backend, backend.objectKey(fileName), logger), logger, opts)
+	default:
+		return NewStoreWithOptions(ctx, fileName, logger, opts)
+	}
+}
+
+// storeSink receives the encoded store of a backend other than a local file
+type storeSink interface {
+	io.Writer
+
+	// create starts a new store, discarding the existing one
+	create() error
+
+	// load returns the existing store to append to, which is empty if there
+	// is none
+	load() ([]byte, error)
+
+	// sync persists the bytes written so far, as far as the backend allows
+	sync() error
+
+	// Close persists all the bytes written
+	Close() error
+}
+
+// streamStore encodes the records in the store format to a sink, so that the
+// stores of all the backends can be read in the same way once downloaded
+type streamStore struct {
+	sink   storeSink
+	logger *observability.CoreLogger
+	opts   StoreOptions
+	writer *leveldb.Writer
+	codec  recordCodec
+
+	// lastRecordNum is the highest record number found when resuming
+	lastRecordNum int64
+
+	// bytesWritten is the number of encoded record bytes written
+	bytesWritten int64
+}
+
+func newStreamStore(sink storeSink, logger *observability.CoreLogger, opts StoreOptions) *streamStore {
+	return &streamStore{sink: sink, logger: logger, opts: opts}
+}
+
+// Open opens the store for writing, the stores of other backends than local
+// files can only be written
+func (ss *streamStore) Open(flag int) error {
+	if flag != os.O_WRONLY {
+		err := fmt.Errorf("invalid flag %d", flag)
+		ss.logger.CaptureError("can't open store", err)
+		return err
+	}
+	if ss.opts.Resume {
+		data, err := ss.sink.load()
+		if err != nil {
+			ss.logger.CaptureError("can't load store", err)
+			return err
+		}
+		if len(data) > 0 {
+			resumed, err := ss.resume(data)
+			if err != nil || resumed {
+				return err
+			}
+		}
+	}
+	if err := ss.sink.create(); err != nil {
+		ss.logger.CaptureError("can't create store", err)
+		return err
+	}
+	header := ss.opts.newHeader()
+	var err error
+	if ss.codec, err = newRecordCodec(header, ss.opts.EncryptionKey); err != nil {
+		ss.logger.CaptureError("can't set up record codec", err)
+		return err
+	}
+	if err := header.MarshalBinary(ss.sink); err != nil {
+		ss.logger.CaptureError("can't write header", err)
+		return err
+	}
+	ss.writer = leveldb.NewWriterExt(ss.sink, leveldb.CRCAlgoIEEE)
+	return nil
+}
+
+// resume appends to the existing store data, it returns false if the data is
+// not a valid store
+func (ss *streamStore) resume(data []byte) (bool, error) {
+	header := NewHeader()
+	if err := header.UnmarshalBinary(bytes.NewReader(data)); err != nil || !header.Valid() {
+		ss.logger.CaptureWarn("store: invalid header, creating a new store")
+		return false, nil
+	}
+	var err error
+	if ss.codec, err = newRecordCodec(header, ss.opts.EncryptionKey); err != nil {
+		ss.logger.CaptureError("can't set up record codec", err)
+		return false, err
+	}
+	reader := leveldb.NewReaderExt(logSection(bytes.NewReader(data), header.size()), leveldb.CRCAlgoIEEE)
+	ss.lastRecordNum = scanLastRecordNum(reader, ss.codec)
+	// the leveldb writer always starts on a fresh block
+	if rem := (int64(len(data)) - header.size()) % storeBlockSize; rem != 0 {
+		if _, err := ss.sink.Write(make([]byte, storeBlockSize-rem)); err != nil {
+			ss.logger.CaptureError("can't pad store", err)
+			return false, err
+		}
+	}
+	ss.writer = leveldb.NewWriterExt(ss.sink, leveldb.CRCAlgoIEEE)
+	return true, nil
+}
+
+func (ss *streamStore) Write(msg *service.Record) error {
+	writer, err := ss.writer.Next()
+	if err != nil {
+		ss.logger.CaptureError("can't write record", err)
+		return err
+	}
+	buf, err := marshalRecord(msg)
+	defer buf.release()
+	if err != nil {
+		ss.logger.CaptureError("can't marshal record", err)
+		return err
+	}
+	out, err := ss.codec.encode(buf.data)
+	if err != nil {
+		ss.logger.CaptureError("can't encode record", err)
+		return err
+	}
+	if _, err = writer.Write(out); err != nil {
+		ss.logger.CaptureError("can't write record", err)
+		return err
+	}
+	ss.bytesWritten += int64(len(out))
+	return nil
+}
+
+// Sync flushes the buffered records to the sink and persists them
+func (ss *streamStore) Sync() error {
+	if err := ss.writer.Flush(); err != nil {
+		ss.logger.CaptureError("can't flush store", err)
+		return err
+	}
+	if err := ss.sink.sync(); err != nil {
+		ss.logger.CaptureError("can't sync store", err)
+		return err
+	}
+	return nil
+}
+
+// Close flushes the buffered records and closes the sink
+func (ss *streamStore) Close() error {
+	if ss.writer != nil {
+		if err := ss.writer.Close(); err != nil {
+			ss.logger.CaptureError("can't close store", err)
+		}
+	}
+	err := ss.sink.Close()
+	if err != nil {
+		ss.logger.CaptureError("can't close store", err)
+	}
+	return err
+}
+
+// LastRecordNum returns the highest record number found in the store when it
+// was resumed, or 0 if the store was not resumed.
+func (ss *streamStore) LastRecordNum() int64 {
+	return ss.lastRecordNum
+}
+
+// BytesWritten returns the number of record bytes written since the store was
+// opened, after compression and encryption
+func (ss *streamStore) BytesWritten() int64 {
+	return ss.bytesWritten
+}
+
+// memoryStores are the stores of the memory backend by name
+var memoryStores = struct {
+	sync.Mutex
+	data map[string][]byte
+}{data: map[string][]byte{}}
+
+// memorySink writes a store of the memory backend
+type memorySink struct {
+	name string
+}
+
+func (s memorySink) create() error {
+	memoryStores.Lock()
+	defer memoryStores.Unlock()
+	memoryStores.data[s.name] = nil
+	return nil
+}
+
+func (s memorySink) load() ([]byte, error) {
+	return MemoryStoreData(s.name), nil
+}
+
+func (s memorySink) Write(p []byte) (int, error) {
+	memoryStores.Lock()
+	defer memoryStores.Unlock()
+	memoryStores.data[s.name] = append(memoryStores.data[s.name], p...)
+	return len(p), nil
+}
+
+func (s memorySink) sync() error {
+	return nil
+}
+
+func (s memorySink) Close() error {
+	return nil
+}
+
+// MemoryStoreData returns a copy of the store named fileName of the memory
+// backend, or nil if there is none
+func MemoryStoreData(fileName string) []byte {
+	memoryStores.Lock()
+	defer memoryStores.Unlock()
+	data, ok := memoryStores.data[fileName]
+	if !ok {
+		return nil
+	}
+	return append([]byte{}, data...)
+}
+
+// RemoveMemoryStore removes the store named fileName of the memory backend
+func RemoveMemoryStore(fileName string) {
+	memoryStores.Lock()
+	defer memoryStores.Unlock()
+	delete(memoryStores.data, fileName)
+}
@@ -0,0 +1,149 @@
+package server_test
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	"github.com/wandb/wandb/core/pkg/server"
+	"github.com/wandb/wandb/core/pkg/service"
+)
+
+func TestParseStoreBackend(t *testing.T) {
+	tests := []struct {
+		value   string
+		backend server.StoreBackend
+		wantErr bool
+	}{
+		{value: "", backend: server.StoreBackend{Kind: server.BackendFile}},
+		{value: "file", backend: server.StoreBackend{Kind: server.BackendFile}},
+		{value: "memory", backend: server.StoreBackend{Kind: server.BackendMemory}},
+		{value: "s3://bucket/runs/", backend: server.StoreBackend{Kind: server.BackendS3, Bucket: "bucket", Prefix: "runs"}},
+		{value: "gs://bucket", backend: server.StoreBackend{Kind: server.BackendGCS, Bucket: "bucket"}},
+		{value: "s3:///runs", wantErr: true},
+		{value: "ftp://bucket", wantErr: true},
+	}
+	for _, tt := range tests {
+		backend, err := server.ParseStoreBackend(tt.value)
+		if tt.wantErr {
+			assert.Error(t, err, tt.value)
+			continue
+		}
+		assert.NoError(t, err, tt.value)
+		assert.Equal(t, tt.backend, backend, tt.value)
+	}
+}
+
+// storeNums returns the numbers of the records of the encoded store data
+func storeNums(t *testing.T, data []byte) []int64 {
+	t.Helper()
+
+	fileName := filepath.Join(t.TempDir(), "downloaded.wandb")
+	assert.NoError(t, os.WriteFile(fileName, data, 0644))
+	var nums []int64
+	for _, record := range readStore(t, fileName) {
+		nums = append(nums, record.Num)
+	}
+	return nums
+}
+
+func TestWriterMemoryBackend(t *testing.T) {
+	fileName := filepath.Join(t.TempDir(), "run.wandb")
+	t.Cleanup(func() { server.RemoveMemoryStore(fileName) })
+	settings := &service.Settings{
+		SyncFile:      &wrapperspb.StringValue{Value: fileName},
+		XStoreBackend: &wrapperspb.StringValue{Value: "memory"},
+	}
+	runWriter(t, makeOutputRecords(3), server.WithWriterSettings(settings))
+	runWriter(t, makeOutputRecords(2), server.WithWriterSettings(settings), server.WithWriterResume())
+
+	assert.Equal(t, []int64{1, 2, 3, 4, 5}, storeNums(t, server.MemoryStoreData(fileName)))
+	_, err := os.Stat(fileName)
+	assert.True(t, os.IsNotExist(err))
+}
+
+// fakeObjectStorage serves the multipart upload requests of an S3 compatible
+// API and keeps the completed objects
+type fakeObjectStorage struct {
+	mu      sync.Mutex
+	parts   map[string]map[int][]byte
+	objects map[string][]byte
+	signed  bool
+}
+
+func newFakeObjectStorage() *fakeObjectStorage {
+	return &fakeObjectStorage{parts: map[string]map[int][]byte{}, objects: map[string][]byte{}}
+}
+
+func (f *fakeObjectStorage) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.signed = strings.HasPrefix(r.Header.Get("Authorization"), "AWS4-HMAC-SHA256 Credential=key/")
+	body, _ := io.ReadAll(r.Body)
+	query := r.URL.Query()
+	uploadID := query.Get("uploadId")
+	switch {
+	case r.Method == http.MethodPost && query.Has("uploads"):
+		uploadID = fmt.Sprint(len(f.parts) + 1)
+		f.parts[uploadID] = map[int][]byte{}
+		fmt.Fprintf(w, "<InitiateMultipartUploadResult><UploadId>%s</UploadId></InitiateMultipartUploadResult>", uploadID)
+	case r.Method == http.MethodPut:
+		var number int
+		fmt.Sscan(query.Get("partNumber"), &number)
+		f.parts[uploadID][number] = body
+		w.Header().Set("ETag", fmt.Sprintf(`"%d"`, number))
+	case r.Method == http.MethodPost:
+		complete := struct {
+			Parts []struct {
+				PartNumber int
+			} `xml:"Part"`
+		}{}
+		if err := xml.Unmarshal(body, &complete); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		sort.Slice(complete.Parts, func(i, j int) bool { return complete.Parts[i].PartNumber < complete.Parts[j].PartNumber })
+		var object []byte
+		for _, part := range complete.Parts {
+			object = append(object, f.parts[uploadID][part.PartNumber]...)
+		}
+		f.objects[r.URL.Path] = object
+	default:
+		http.Error(w, "unexpected request", http.StatusBadRequest)
+	}
+}
+
+func TestWriterObjectBackend(t *testing.T) {
+	storage := newFakeObjectStorage()
+	srv := httptest.NewServer(storage)
+	defer srv.Close()
+	t.Setenv("AWS_ENDPOINT_URL", srv.URL)
+	t.Setenv("AWS_ACCESS_KEY_ID", "key")
+	t.Setenv("AWS_SECRET_ACCESS_KEY", "secret")
+
+	fileName := filepath.Join(t.TempDir(), "run.wandb")
+	backend, err := server.ParseStoreBackend("s3://bucket/runs")
+	assert.NoError(t, err)
+	runWriter(t, makeOutputRecords(3),
+		server.WithWriterSettings(&service.Settings{SyncFile: &wrapperspb.StringValue{Value: fileName}}),
+		server.WithWriterStoreBackend(backend),
+	)
+
+	storage.mu.Lock()
+	defer storage.mu.Unlock()
+	assert.True(t, storage.signed)
+	object, ok := storage.objects["/bucket/runs/run.wandb"]
+	assert.True(t, ok)
+	assert.Equal(t, []int64{1, 2, 3}, storeNums(t, object))
+}
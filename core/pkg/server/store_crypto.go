@@ -0,0 +1,120 @@
+package server
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/wandb/wandb/core/pkg/service"
+)
+
+// Encryption is the cipher used to encrypt the records in a store
+type Encryption byte
+
+const (
+	// EncryptionNone stores the records in plain text.
+	EncryptionNone Encryption = iota
+	// EncryptionAESGCM encrypts each record with AES-GCM and a random nonce.
+	EncryptionAESGCM
+)
+
+func (e Encryption) valid() bool {
+	return e == EncryptionNone || e == EncryptionAESGCM
+}
+
+// StoreEncryptionKeyEnv is the environment variable the store encryption key
+// is read from if it is not set in the settings
+const StoreEncryptionKeyEnv = "WANDB_STORE_ENCRYPTION_KEY"
+
+// ParseEncryptionKey decodes a base64 encoded AES key of 16, 24 or 32 bytes
+func ParseEncryptionKey(encoded string) ([]byte, error) {
+	key, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		return nil, fmt.Errorf("invalid encryption key: %w", err)
+	}
+	switch len(key) {
+	case 16, 24, 32:
+		return key, nil
+	default:
+		return nil, fmt.Errorf("invalid encryption key: got %d bytes, want 16, 24 or 32", len(key))
+	}
+}
+
+// StoreEncryptionKey returns the store encryption key set by the
+// _store_encryption_key setting or the environment, or nil if the store is
+// not encrypted
+func StoreEncryptionKey(settings *service.Settings) ([]byte, error) {
+	encoded := settings.GetXStoreEncryptionKey().GetValue()
+	if encoded == "" {
+		encoded = os.Getenv(StoreEncryptionKeyEnv)
+	}
+	if encoded == "" {
+		return nil, nil
+	}
+	return ParseEncryptionKey(encoded)
+}
+
+// recordCodec encodes the record payloads of a store as described by its
+// header: records are compressed and then encrypted
+type recordCodec struct {
+	compression Compression
+	aead        cipher.AEAD
+}
+
+// newRecordCodec returns the codec of a store with the given header, key is
+// required if the store is encrypted
+func newRecordCodec(header *HeaderOptions, key []byte) (recordCodec, error) {
+	codec := recordCodec{compression: header.Compression}
+	switch header.Encryption {
+	case EncryptionNone:
+		return codec, nil
+	case EncryptionAESGCM:
+		if key == nil {
+			return codec, errors.New("store is encrypted, but no encryption key is set")
+		}
+		block, err := aes.NewCipher(key)
+		if err != nil {
+			return codec, err
+		}
+		if codec.aead, err = cipher.NewGCM(block); err != nil {
+			return codec, err
+		}
+		return codec, nil
+	default:
+		return codec, fmt.Errorf("unknown encryption %d", header.Encryption)
+	}
+}
+
+// encode compresses and encrypts a single record payload, the nonce is
+// prepended to the encrypted payload
+func (c recordCodec) encode(data []byte) ([]byte, error) {
+	data, err := c.compression.compress(data)
+	if err != nil || c.aead == nil {
+		return data, err
+	}
+	size := c.aead.NonceSize()
+	nonce := make([]byte, size, size+len(data)+c.aead.Overhead())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, err
+	}
+	return c.aead.Seal(nonce, nonce, data, nil), nil
+}
+
+// decode decrypts and decompresses a single record payload
+func (c recordCodec) decode(data []byte) ([]byte, error) {
+	if c.aead != nil {
+		size := c.aead.NonceSize()
+		if len(data) < size {
+			return nil, errors.New("encrypted record is too short")
+		}
+		var err error
+		if data, err = c.aead.Open(nil, data[:size], data[size:], nil); err != nil {
+			return nil, fmt.Errorf("can't decrypt record: %w", err)
+		}
+	}
+	return c.compression.decompress(data)
+}
@@ -0,0 +1,107 @@
+package server
+
+import (
+	"bufio"
+	"encoding/binary"
+	"errors"
+	"io"
+	"os"
+	"sort"
+)
+
+// storeIndexEntry maps a record number to the offset of the record in the
+// log, relative to the end of the store header
+type storeIndexEntry struct {
+	Num    int64
+	Offset int64
+}
+
+// storeIndexName returns the name of the sidecar index of a store
+func storeIndexName(fileName string) string {
+	return fileName + ".index"
+}
+
+// storeIndexWriter appends entries to the sidecar index of a store
+type storeIndexWriter struct {
+	file   *os.File
+	writer *bufio.Writer
+}
+
+// openStoreIndexWriter opens the index of a store for appending, an existing
+// index is truncated unless resume is set
+func openStoreIndexWriter(fileName string, resume bool) (*storeIndexWriter, error) {
+	flag := os.O_CREATE | os.O_WRONLY | os.O_APPEND
+	if !resume {
+		flag |= os.O_TRUNC
+	}
+	file, err := os.OpenFile(storeIndexName(fileName), flag, 0644)
+	if err != nil {
+		return nil, err
+	}
+	return &storeIndexWriter{file: file, writer: bufio.NewWriter(file)}, nil
+}
+
+func (iw *storeIndexWriter) add(num, offset int64) error {
+	return binary.Write(iw.writer, binary.LittleEndian, storeIndexEntry{Num: num, Offset: offset})
+}
+
+// sync commits the buffered entries to stable storage
+func (iw *storeIndexWriter) sync() error {
+	if err := iw.writer.Flush(); err != nil {
+		return err
+	}
+	return iw.file.Sync()
+}
+
+func (iw *storeIndexWriter) close() error {
+	if err := iw.writer.Flush(); err != nil {
+		_ = iw.file.Close()
+		return err
+	}
+	return iw.file.Close()
+}
+
+// loadStoreIndex reads the sidecar index of a store whose log is logSize
+// bytes long. The index may lag behind the log, and after a crash it may
+// contain entries of records that were truncated: entries past the end of
+// the log are dropped, and an entry replaces the earlier entries of records
+// with the same or a higher number, as the records were written again. It
+// returns no entries if the store has no index.
+func loadStoreIndex(fileName string, logSize int64) ([]storeIndexEntry, error) {
+	file, err := os.Open(storeIndexName(fileName))
+	if os.IsNotExist(err) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	reader := bufio.NewReader(file)
+	var entries []storeIndexEntry
+	for {
+		var entry storeIndexEntry
+		err := binary.Read(reader, binary.LittleEndian, &entry)
+		if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+			return entries, nil
+		} else if err != nil {
+			return nil, err
+		}
+		if entry.Offset < 0 || entry.Offset >= logSize {
+			continue
+		}
+		for len(entries) > 0 && entries[len(entries)-1].Num >= entry.Num {
+			entries = entries[:len(entries)-1]
+		}
+		entries = append(entries, entry)
+	}
+}
+
+// findStoreIndexEntry returns the entry of the highest record number that is
+// not greater than num
+func findStoreIndexEntry(entries []storeIndexEntry, num int64) (storeIndexEntry, bool) {
+	i := sort.Search(len(entries), func(i int) bool { return entries[i].Num > num })
+	if i == 0 {
+		return storeIndexEntry{}, false
+	}
+	return entries[i-1], true
+}
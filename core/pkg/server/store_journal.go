@@ -0,0 +1,133 @@
+package server
+
+import (
+	"encoding/binary"
+	"errors"
+	"hash/crc32"
+	"io"
+	"os"
+	"sync"
+)
+
+// The journal of a store records which record numbers the writer handed out,
+// so that a writer resuming the store after a crash does not hand them out
+// again. It is a single entry, rewritten in place:
+//
+//	magic (4 bytes) | durable (8 bytes) | reserved (8 bytes) | CRC-32 (4 bytes)
+//
+// durable is the number of the last record synced to the store, and reserved
+// the highest number that may have been assigned to a record. Numbers are
+// reserved in blocks, so that the journal is only written once per block.
+const (
+	journalMagic        = "WBJ1"
+	journalSize         = 24
+	journalReserveBlock = 1000
+)
+
+// storeJournalName returns the name of the journal of a store
+func storeJournalName(fileName string) string {
+	return fileName + ".journal"
+}
+
+// journalState is the content of a journal
+type journalState struct {
+	Durable  int64
+	Reserved int64
+}
+
+// marshal returns the encoding of the state
+func (s journalState) marshal() []byte {
+	data := make([]byte, 0, journalSize)
+	data = append(data, journalMagic...)
+	data = binary.LittleEndian.AppendUint64(data, uint64(s.Durable))
+	data = binary.LittleEndian.AppendUint64(data, uint64(s.Reserved))
+	return binary.LittleEndian.AppendUint32(data, crc32.ChecksumIEEE(data))
+}
+
+// errInvalidJournal is returned when a journal is torn or corrupt
+var errInvalidJournal = errors.New("invalid store journal")
+
+// loadJournal returns the state of the journal of a store, it returns
+// os.ErrNotExist if there is no journal
+func loadJournal(fileName string) (journalState, error) {
+	f, err := os.Open(storeJournalName(fileName))
+	if err != nil {
+		return journalState{}, err
+	}
+	defer f.Close()
+	data := make([]byte, journalSize)
+	if _, err := io.ReadFull(f, data); err != nil {
+		return journalState{}, errInvalidJournal
+	}
+	if string(data[:4]) != journalMagic ||
+		crc32.ChecksumIEEE(data[:20]) != binary.LittleEndian.Uint32(data[20:]) {
+		return journalState{}, errInvalidJournal
+	}
+	return journalState{
+		Durable:  int64(binary.LittleEndian.Uint64(data[4:12])),
+		Reserved: int64(binary.LittleEndian.Uint64(data[12:20])),
+	}, nil
+}
+
+// recordJournal writes the journal of a store. The record numbers are
+// reserved by the goroutine handling the records, and marked durable by the
+// goroutine writing the store.
+type recordJournal struct {
+	mu    sync.Mutex
+	file  *os.File
+	state journalState
+}
+
+// openRecordJournal creates the journal of a store, starting after the record
+// number last
+func openRecordJournal(fileName string, last int64) (*recordJournal, error) {
+	f, err := os.OpenFile(storeJournalName(fileName), os.O_RDWR|os.O_CREATE, 0644)
+	if err != nil {
+		return nil, err
+	}
+	j := &recordJournal{file: f, state: journalState{Durable: last, Reserved: last}}
+	if err := j.write(); err != nil {
+		_ = f.Close()
+		return nil, err
+	}
+	return j, nil
+}
+
+// write writes the state to the journal and syncs it, the caller holds mu
+func (j *recordJournal) write() error {
+	if _, err := j.file.WriteAt(j.state.marshal(), 0); err != nil {
+		return err
+	}
+	return j.file.Sync()
+}
+
+// reserve makes sure that the record number num is reserved before it is
+// assigned, reserving the next block of numbers if needed
+func (j *recordJournal) reserve(num int64) error {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	if num <= j.state.Reserved {
+		return nil
+	}
+	j.state.Reserved = num + journalReserveBlock - 1
+	return j.write()
+}
+
+// markDurable records that the records up to num were synced to the store.
+// It is only written to the journal when the next block is reserved, or
+// when the journal is closed.
+func (j *recordJournal) markDurable(num int64) {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	j.state.Durable = max(j.state.Durable, num)
+}
+
+// close writes the final state of the journal, in which the numbers up to
+// last were assigned, and closes it
+func (j *recordJournal) close(last int64) error {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	j.state.Reserved = last
+	err := j.write()
+	return errors.Join(err, j.file.Close())
+}
@@ -0,0 +1,62 @@
+package server
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"time"
+)
+
+// maxMetadataSize is the largest metadata block accepted in a store header
+const maxMetadataSize = 64 << 10
+
+// StoreMetadata identifies the run a store was written for. It is encoded as
+// JSON in the headers of version headerVersionMetadata or later, so that
+// tools can identify a store without decoding its records.
+type StoreMetadata struct {
+	RunID     string    `json:"run_id,omitempty"`
+	Entity    string    `json:"entity,omitempty"`
+	Project   string    `json:"project,omitempty"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// marshalMetadata returns the encoding of the metadata block, which is empty
+// if there is no metadata
+func marshalMetadata(metadata *StoreMetadata) []byte {
+	if metadata == nil {
+		return nil
+	}
+	// the metadata only has strings and a time, it can't fail to encode
+	data, _ := json.Marshal(metadata)
+	return data
+}
+
+// unmarshalMetadata decodes a metadata block, an empty block is no metadata
+func unmarshalMetadata(data []byte) (*StoreMetadata, error) {
+	if len(data) == 0 {
+		return nil, nil
+	}
+	metadata := &StoreMetadata{}
+	if err := json.Unmarshal(data, metadata); err != nil {
+		return nil, fmt.Errorf("invalid store metadata: %w", err)
+	}
+	return metadata, nil
+}
+
+// ReadHeaderOnly returns the header of the store file fileName without
+// reading its records, it returns an error if the header is not valid.
+func ReadHeaderOnly(fileName string) (*HeaderOptions, error) {
+	f, err := os.Open(fileName)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	header := NewHeader()
+	if err := header.UnmarshalBinary(f); err != nil {
+		return nil, err
+	}
+	if !header.Valid() {
+		return nil, fmt.Errorf("invalid header")
+	}
+	return header, nil
+}
@@ -0,0 +1,113 @@
+package server
+
+import (
+	"errors"
+	"fmt"
+	"path/filepath"
+
+	"github.com/wandb/wandb/core/pkg/observability"
+	"github.com/wandb/wandb/core/pkg/service"
+)
+
+// mirrorName returns the name of the mirror of a store file in dir
+func mirrorName(dir, fileName string) string {
+	return filepath.Join(dir, filepath.Base(fileName))
+}
+
+// mirroredStore writes the records to two stores, a primary and a mirror,
+// so that the records survive the failure of either. A store that fails is
+// not used anymore, the mirrored store only fails once both stores failed.
+type mirroredStore struct {
+	logger *observability.CoreLogger
+
+	// sinks are the primary store and its mirror
+	sinks [2]RecordStore
+
+	// failed is whether each of the sinks failed
+	failed [2]bool
+}
+
+// mirrorSinkNames name the sinks in the logs
+var mirrorSinkNames = [2]string{"primary", "mirror"}
+
+// newMirroredStore returns a store that writes to both primary and mirror
+func newMirroredStore(primary, mirror RecordStore, logger *observability.CoreLogger) *mirroredStore {
+	return &mirroredStore{
+		logger: logger,
+		sinks:  [2]RecordStore{primary, mirror},
+	}
+}
+
+// each calls fn for each sink that has not failed, and marks the sinks for
+// which fn fails as failed. It returns an error if no sink is left.
+func (ms *mirroredStore) each(op string, fn func(RecordStore) error) error {
+	var errs []error
+	for i, sink := range ms.sinks {
+		if ms.failed[i] {
+			continue
+		}
+		if err := fn(sink); err != nil {
+			ms.failed[i] = true
+			ms.logger.CaptureError(
+				fmt.Sprintf("mirrored store: %s store failed, continuing without it", mirrorSinkNames[i]),
+				err, "op", op,
+			)
+			errs = append(errs, err)
+		}
+	}
+	if ms.failed[0] && ms.failed[1] {
+		if len(errs) == 0 {
+			errs = append(errs, errors.New("all the stores failed"))
+		}
+		return fmt.Errorf("mirrored store: %s: %w", op, errors.Join(errs...))
+	}
+	return nil
+}
+
+func (ms *mirroredStore) Open(flag int) error {
+	return ms.each("open", func(sink RecordStore) error { return sink.Open(flag) })
+}
+
+func (ms *mirroredStore) Write(msg *service.Record) error {
+	return ms.each("write", func(sink RecordStore) error { return sink.Write(msg) })
+}
+
+func (ms *mirroredStore) Sync() error {
+	return ms.each("sync", func(sink RecordStore) error { return sink.Sync() })
+}
+
+// Close closes the sinks that did not fail
+func (ms *mirroredStore) Close() error {
+	var errs []error
+	for i, sink := range ms.sinks {
+		if ms.failed[i] {
+			continue
+		}
+		if err := sink.Close(); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// LastRecordNum returns the last record number of the primary store, or of
+// the mirror if the primary store failed
+func (ms *mirroredStore) LastRecordNum() int64 {
+	if ms.failed[0] {
+		return ms.sinks[1].LastRecordNum()
+	}
+	return ms.sinks[0].LastRecordNum()
+}
+
+// BytesWritten returns the bytes written by the primary store, or by the
+// mirror if the primary store failed
+func (ms *mirroredStore) BytesWritten() int64 {
+	sink := ms.sinks[0]
+	if ms.failed[0] {
+		sink = ms.sinks[1]
+	}
+	if sizer, ok := sink.(storeSizer); ok {
+		return sizer.BytesWritten()
+	}
+	return 0
+}
@@ -0,0 +1,74 @@
+package server
+
+import (
+	"errors"
+	"io"
+)
+
+// mmapChunkSize is the size of the chunks of a mapped store that are
+// prefetched ahead of the reader
+const mmapChunkSize = 4 << 20
+
+// errMmapUnsupported is returned when stores can't be mapped on the platform
+var errMmapUnsupported = errors.New("mmap is not supported on this platform")
+
+// mappedFile reads a store file that is mapped into memory, so that reading
+// a large store does not copy it through read calls. The chunk after the one
+// being read is prefetched so that the pages are in memory before they are
+// needed. The file is mapped at the size it had when it was opened, records
+// appended after that are not read.
+type mappedFile struct {
+	data []byte
+
+	// prefetched is the end of the prefetched part of the file
+	prefetched int
+
+	// prefetch asks the kernel to read a range of the mapping ahead
+	prefetch func([]byte)
+
+	// unmap releases the mapping
+	unmap func() error
+}
+
+// ReadAt implements io.ReaderAt
+func (m *mappedFile) ReadAt(p []byte, off int64) (int, error) {
+	if off < 0 {
+		return 0, errors.New("mapped file: negative offset")
+	}
+	if off >= int64(len(m.data)) {
+		return 0, io.EOF
+	}
+	m.prefetchTo(int(off) + len(p) + mmapChunkSize)
+	n := copy(p, m.data[off:])
+	if n < len(p) {
+		return n, io.EOF
+	}
+	return n, nil
+}
+
+// prefetchTo prefetches the chunks of the file up to end
+func (m *mappedFile) prefetchTo(end int) {
+	end = min(end, len(m.data))
+	for m.prefetched < end {
+		next := min(m.prefetched+mmapChunkSize, len(m.data))
+		if m.prefetch != nil {
+			m.prefetch(m.data[m.prefetched:next])
+		}
+		m.prefetched = next
+	}
+}
+
+// Size returns the size of the mapped file
+func (m *mappedFile) Size() int64 {
+	return int64(len(m.data))
+}
+
+// Close releases the mapping
+func (m *mappedFile) Close() error {
+	if m.unmap == nil {
+		return nil
+	}
+	err := m.unmap()
+	m.data, m.unmap = nil, nil
+	return err
+}
@@ -0,0 +1,39 @@
+//go:build linux
+
+package server
+
+import (
+	"fmt"
+	"os"
+	"syscall"
+)
+
+// mapFile maps a store file opened for reading into memory
+func mapFile(f *os.File) (*mappedFile, error) {
+	info, err := f.Stat()
+	if err != nil {
+		return nil, err
+	}
+	if info.Size() == 0 {
+		return &mappedFile{}, nil
+	}
+	if int64(int(info.Size())) != info.Size() {
+		return nil, fmt.Errorf("file of %d bytes is too large to map", info.Size())
+	}
+	data, err := syscall.Mmap(int(f.Fd()), 0, int(info.Size()), syscall.PROT_READ, syscall.MAP_SHARED)
+	if err != nil {
+		return nil, err
+	}
+	// the stores are read from start to end, so the kernel can read ahead
+	// aggressively and drop the pages that were read
+	_ = syscall.Madvise(data, syscall.MADV_SEQUENTIAL)
+	return &mappedFile{
+		data: data,
+		prefetch: func(chunk []byte) {
+			_ = syscall.Madvise(chunk, syscall.MADV_WILLNEED)
+		},
+		unmap: func() error {
+			return syscall.Munmap(data)
+		},
+	}, nil
+}
@@ -0,0 +1,10 @@
+//go:build !linux
+
+package server
+
+import "os"
+
+// mapFile returns errMmapUnsupported, the store is read from the file
+func mapFile(f *os.File) (*mappedFile, error) {
+	return nil, errMmapUnsupported
+}
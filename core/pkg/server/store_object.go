@@ -0,0 +1,340 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/wandb/wandb/core/pkg/observability"
+)
+
+// objectPartSize is the size of the parts of the multipart uploads of the
+// object storage backends. All the parts but the last must be at least 5MiB.
+const objectPartSize = 8 << 20
+
+// objectClient makes the requests of multipart uploads to an S3 compatible
+// API. S3 credentials are read from the AWS_ACCESS_KEY_ID,
+// AWS_SECRET_ACCESS_KEY and AWS_SESSION_TOKEN environment variables, and its
+// endpoint can be set with AWS_ENDPOINT_URL. GCS is accessed with HMAC keys
+// read from GCS_ACCESS_KEY_ID and GCS_SECRET_ACCESS_KEY, and its endpoint
+// can be set with STORAGE_EMULATOR_HOST.
+type objectClient struct {
+	client       *http.Client
+	endpoint     *url.URL
+	bucket       string
+	virtualHost  bool
+	region       string
+	accessKey    string
+	secretKey    string
+	sessionToken string
+}
+
+// newObjectClient returns the client of the bucket of an object storage
+// backend, configured from the environment
+func newObjectClient(backend StoreBackend) (*objectClient, error) {
+	c := &objectClient{client: &http.Client{Timeout: 5 * time.Minute}, bucket: backend.Bucket}
+	var endpoint string
+	switch backend.Kind {
+	case BackendS3:
+		c.accessKey = os.Getenv("AWS_ACCESS_KEY_ID")
+		c.secretKey = os.Getenv("AWS_SECRET_ACCESS_KEY")
+		c.sessionToken = os.Getenv("AWS_SESSION_TOKEN")
+		c.region = os.Getenv("AWS_REGION")
+		if c.region == "" {
+			c.region = os.Getenv("AWS_DEFAULT_REGION")
+		}
+		if c.region == "" {
+			c.region = "us-east-1"
+		}
+		endpoint = os.Getenv("AWS_ENDPOINT_URL")
+		if endpoint == "" {
+			endpoint = fmt.Sprintf("https://s3.%s.amazonaws.com", c.region)
+			c.virtualHost = true
+		}
+	case BackendGCS:
+		c.accessKey = os.Getenv("GCS_ACCESS_KEY_ID")
+		c.secretKey = os.Getenv("GCS_SECRET_ACCESS_KEY")
+		c.region = "auto"
+		endpoint = os.Getenv("STORAGE_EMULATOR_HOST")
+		if endpoint == "" {
+			endpoint = "https://storage.googleapis.com"
+		} else if !strings.Contains(endpoint, "://") {
+			endpoint = "http://" + endpoint
+		}
+	default:
+		return nil, fmt.Errorf("store backend %v is not an object storage", backend)
+	}
+	if c.accessKey == "" || c.secretKey == "" {
+		return nil, fmt.Errorf("no credentials for store backend %v", backend)
+	}
+	var err error
+	if c.endpoint, err = url.Parse(endpoint); err != nil {
+		return nil, fmt.Errorf("invalid endpoint for store backend %v: %w", backend, err)
+	}
+	return c, nil
+}
+
+// objectURL returns the URL of an object
+func (c *objectClient) objectURL(key string, query url.Values) *url.URL {
+	u := *c.endpoint
+	if c.virtualHost {
+		u.Host = c.bucket + "." + u.Host
+		u.Path = "/" + key
+	} else {
+		u.Path = strings.TrimSuffix(u.Path, "/") + "/" + c.bucket + "/" + key
+	}
+	u.RawQuery = canonicalQuery(query)
+	return &u
+}
+
+// do makes a signed request on an object and returns the response body, it
+// returns an error if the response is not successful
+func (c *objectClient) do(
+	ctx context.Context,
+	method string,
+	key string,
+	query url.Values,
+	body []byte,
+) (http.Header, []byte, error) {
+	u := c.objectURL(key, query)
+	req, err := http.NewRequestWithContext(ctx, method, u.String(), bytes.NewReader(body))
+	if err != nil {
+		return nil, nil, err
+	}
+	c.sign(req, body, time.Now())
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer resp.Body.Close()
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, nil, err
+	}
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return nil, nil, fmt.Errorf("%s %s: %s: %s", method, u.Redacted(), resp.Status, bytes.TrimSpace(data))
+	}
+	return resp.Header, data, nil
+}
+
+// sign signs a request with AWS Signature Version 4
+func (c *objectClient) sign(req *http.Request, body []byte, now time.Time) {
+	payloadHash := sha256Hex(body)
+	amzDate := now.UTC().Format("20060102T150405Z")
+	date := amzDate[:8]
+	req.Header.Set("x-amz-date", amzDate)
+	req.Header.Set("x-amz-content-sha256", payloadHash)
+	if c.sessionToken != "" {
+		req.Header.Set("x-amz-security-token", c.sessionToken)
+	}
+
+	headers := map[string]string{"host": req.URL.Host}
+	for name := range req.Header {
+		if name := strings.ToLower(name); strings.HasPrefix(name, "x-amz-") {
+			headers[name] = strings.TrimSpace(req.Header.Get(name))
+		}
+	}
+	names := make([]string, 0, len(headers))
+	for name := range headers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	var canonicalHeaders strings.Builder
+	for _, name := range names {
+		canonicalHeaders.WriteString(name + ":" + headers[name] + "\n")
+	}
+	signedHeaders := strings.Join(names, ";")
+
+	canonicalRequest := strings.Join([]string{
+		req.Method,
+		req.URL.EscapedPath(),
+		req.URL.RawQuery,
+		canonicalHeaders.String(),
+		signedHeaders,
+		payloadHash,
+	}, "\n")
+	scope := date + "/" + c.region + "/s3/aws4_request"
+	stringToSign := "AWS4-HMAC-SHA256\n" + amzDate + "\n" + scope + "\n" + sha256Hex([]byte(canonicalRequest))
+
+	key := []byte("AWS4" + c.secretKey)
+	for _, part := range []string{date, c.region, "s3", "aws4_request"} {
+		key = hmacSHA256(key, part)
+	}
+	signature := hex.EncodeToString(hmacSHA256(key, stringToSign))
+	req.Header.Set("Authorization", fmt.Sprintf(
+		"AWS4-HMAC-SHA256 Credential=%s/%s, SignedHeaders=%s, Signature=%s",
+		c.accessKey, scope, signedHeaders, signature,
+	))
+}
+
+// canonicalQuery encodes a query as required by AWS Signature Version 4,
+// sorted by key with spaces encoded as %20
+func canonicalQuery(query url.Values) string {
+	return strings.ReplaceAll(query.Encode(), "+", "%20")
+}
+
+func sha256Hex(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(data))
+	return mac.Sum(nil)
+}
+
+// completedPart is a part of a multipart upload, as listed when completing it
+type completedPart struct {
+	PartNumber int    `xml:"PartNumber"`
+	ETag       string `xml:"ETag"`
+}
+
+// objectSink uploads a store to object storage as the parts of a multipart
+// upload. The parts are uploaded as they fill up when the store is synced,
+// and the object is only created once the store is closed, so the records
+// written since the last full part are lost if the process crashes.
+type objectSink struct {
+	ctx     context.Context
+	backend StoreBackend
+	key     string
+	logger  *observability.CoreLogger
+	client  *objectClient
+
+	// uploadID is the ID of the multipart upload, it is empty until the
+	// upload is started
+	uploadID string
+
+	// parts are the parts uploaded so far
+	parts []completedPart
+
+	// buf are the bytes written that are not uploaded yet
+	buf []byte
+}
+
+func newObjectSink(
+	ctx context.Context,
+	backend StoreBackend,
+	key string,
+	logger *observability.CoreLogger,
+) *objectSink {
+	// the upload is completed when the store is closed, which may be after
+	// the context of the stream is cancelled
+	return &objectSink{ctx: context.WithoutCancel(ctx), backend: backend, key: key, logger: logger}
+}
+
+// create sets up the client, the upload is started with the first part
+func (s *objectSink) create() error {
+	client, err := newObjectClient(s.backend)
+	if err != nil {
+		return err
+	}
+	s.client = client
+	return nil
+}
+
+// load returns no data, as a multipart upload can't append to an existing
+// object: a resumed store is uploaded as a new object
+func (s *objectSink) load() ([]byte, error) {
+	s.logger.CaptureWarn("store: object storage backend can't resume a store, uploading a new one",
+		"backend", s.backend, "key", s.key)
+	return nil, nil
+}
+
+func (s *objectSink) Write(p []byte) (int, error) {
+	s.buf = append(s.buf, p...)
+	return len(p), nil
+}
+
+// sync uploads the full parts written so far
+func (s *objectSink) sync() error {
+	for len(s.buf) >= objectPartSize {
+		if err := s.uploadPart(s.buf[:objectPartSize]); err != nil {
+			return err
+		}
+		s.buf = s.buf[objectPartSize:]
+	}
+	return nil
+}
+
+// uploadPart uploads the next part, starting the upload if needed
+func (s *objectSink) uploadPart(data []byte) error {
+	if s.uploadID == "" {
+		_, body, err := s.client.do(s.ctx, http.MethodPost, s.key, url.Values{"uploads": {""}}, nil)
+		if err != nil {
+			return fmt.Errorf("store: starting upload: %w", err)
+		}
+		result := struct {
+			UploadID string `xml:"UploadId"`
+		}{}
+		if err := xml.Unmarshal(body, &result); err != nil || result.UploadID == "" {
+			return fmt.Errorf("store: starting upload: invalid response %q", body)
+		}
+		s.uploadID = result.UploadID
+	}
+	number := len(s.parts) + 1
+	query := url.Values{"partNumber": {fmt.Sprint(number)}, "uploadId": {s.uploadID}}
+	header, _, err := s.client.do(s.ctx, http.MethodPut, s.key, query, data)
+	if err != nil {
+		return fmt.Errorf("store: uploading part %d: %w", number, err)
+	}
+	s.parts = append(s.parts, completedPart{PartNumber: number, ETag: header.Get("ETag")})
+	return nil
+}
+
+// Close uploads the rest of the store as the last part and completes the
+// upload, which creates the object. The upload is aborted if it fails.
+func (s *objectSink) Close() error {
+	if s.client == nil {
+		return nil
+	}
+	err := s.sync()
+	if err == nil && (len(s.buf) > 0 || len(s.parts) == 0) {
+		err = s.uploadPart(s.buf)
+	}
+	if err == nil {
+		s.buf = nil
+		err = s.complete()
+	}
+	if err != nil && s.uploadID != "" {
+		query := url.Values{"uploadId": {s.uploadID}}
+		if _, _, abortErr := s.client.do(s.ctx, http.MethodDelete, s.key, query, nil); abortErr != nil {
+			err = errors.Join(err, fmt.Errorf("store: aborting upload: %w", abortErr))
+		}
+	}
+	s.client = nil
+	return err
+}
+
+// complete completes the upload from the uploaded parts
+func (s *objectSink) complete() error {
+	body, err := xml.Marshal(struct {
+		XMLName xml.Name        `xml:"CompleteMultipartUpload"`
+		Parts   []completedPart `xml:"Part"`
+	}{Parts: s.parts})
+	if err != nil {
+		return err
+	}
+	query := url.Values{"uploadId": {s.uploadID}}
+	_, resp, err := s.client.do(s.ctx, http.MethodPost, s.key, query, body)
+	if err != nil {
+		return fmt.Errorf("store: completing upload: %w", err)
+	}
+	// completing an upload can fail after the response status was sent
+	if bytes.Contains(resp, []byte("<Error>")) {
+		return fmt.Errorf("store: completing upload: %s", bytes.TrimSpace(resp))
+	}
+	return nil
+}
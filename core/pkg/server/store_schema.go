@@ -0,0 +1,106 @@
+package server
+
+import (
+	"bytes"
+	"fmt"
+	"sync"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/dynamicpb"
+
+	"github.com/wandb/wandb/core/pkg/service"
+)
+
+// maxSchemaSize is the largest schema accepted in a store header, so that a
+// corrupt header does not make readers allocate unbounded memory
+const maxSchemaSize = 16 << 20
+
+var (
+	recordSchemaOnce sync.Once
+	recordSchema     []byte
+)
+
+// RecordSchema returns the encoded FileDescriptorSet of the Record message
+// and all the files it depends on. It is embedded in the headers of stores
+// written with StoreOptions.EmbedSchema.
+func RecordSchema() []byte {
+	recordSchemaOnce.Do(func() {
+		set := &descriptorpb.FileDescriptorSet{}
+		seen := map[string]bool{}
+		var add func(file protoreflect.FileDescriptor)
+		add = func(file protoreflect.FileDescriptor) {
+			if seen[file.Path()] {
+				return
+			}
+			seen[file.Path()] = true
+			imports := file.Imports()
+			for i := 0; i < imports.Len(); i++ {
+				add(imports.Get(i).FileDescriptor)
+			}
+			set.File = append(set.File, protodesc.ToFileDescriptorProto(file))
+		}
+		add((&service.Record{}).ProtoReflect().Descriptor().ParentFile())
+
+		var err error
+		recordSchema, err = proto.MarshalOptions{Deterministic: true}.Marshal(set)
+		if err != nil {
+			panic(fmt.Sprintf("can't marshal record schema: %v", err))
+		}
+	})
+	return recordSchema
+}
+
+// recordMigrator decodes the records of a store written with a different
+// schema than the one this binary was built with. Records are decoded with
+// the embedded schema and converted by field name, so renumbered fields keep
+// their values and fields that no longer exist are dropped.
+type recordMigrator struct {
+	record protoreflect.MessageDescriptor
+}
+
+// newRecordMigrator returns the migrator of the records written with the
+// given schema, or nil if it is the current schema and no migration is needed
+func newRecordMigrator(schema []byte) (*recordMigrator, error) {
+	if bytes.Equal(schema, RecordSchema()) {
+		return nil, nil
+	}
+	set := &descriptorpb.FileDescriptorSet{}
+	if err := proto.Unmarshal(schema, set); err != nil {
+		return nil, fmt.Errorf("invalid schema: %w", err)
+	}
+	files, err := protodesc.NewFiles(set)
+	if err != nil {
+		return nil, fmt.Errorf("invalid schema: %w", err)
+	}
+	name := (&service.Record{}).ProtoReflect().Descriptor().FullName()
+	desc, err := files.FindDescriptorByName(name)
+	if err != nil {
+		return nil, fmt.Errorf("invalid schema: %w", err)
+	}
+	record, ok := desc.(protoreflect.MessageDescriptor)
+	if !ok {
+		return nil, fmt.Errorf("invalid schema: %s is not a message", name)
+	}
+	return &recordMigrator{record: record}, nil
+}
+
+// unmarshal decodes a record written with the schema of the migrator into a
+// record of the current schema
+func (m *recordMigrator) unmarshal(data []byte, record *service.Record) error {
+	written := dynamicpb.NewMessage(m.record)
+	if err := proto.Unmarshal(data, written); err != nil {
+		return err
+	}
+	js, err := protojson.Marshal(written)
+	if err != nil {
+		return fmt.Errorf("can't migrate record: %w", err)
+	}
+	if err := (protojson.UnmarshalOptions{DiscardUnknown: true}).Unmarshal(js, record); err != nil {
+		return fmt.Errorf("can't migrate record: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,407 @@
+package server
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"hash/fnv"
+	"io"
+	"os"
+	"strings"
+	"sync"
+	"sync/atomic"
+
+	"github.com/wandb/wandb/core/pkg/observability"
+	"github.com/wandb/wandb/core/pkg/service"
+)
+
+// ShardKey decides which shard of a sharded store a record is written to
+type ShardKey int
+
+const (
+	// ShardByType writes all the records of a type to the same shard.
+	ShardByType ShardKey = iota
+	// ShardByStep spreads the history records over the shards by step, and
+	// writes the other records to the first shard.
+	ShardByStep
+)
+
+// ParseShardKey returns the shard key with the given name, "type" or
+// "step". An empty name is ShardByType.
+func ParseShardKey(name string) (ShardKey, error) {
+	switch strings.ToLower(name) {
+	case "", "type":
+		return ShardByType, nil
+	case "step":
+		return ShardByStep, nil
+	default:
+		return ShardByType, fmt.Errorf("unknown shard key %q", name)
+	}
+}
+
+// String returns the name of the shard key
+func (k ShardKey) String() string {
+	if k == ShardByStep {
+		return "step"
+	}
+	return "type"
+}
+
+// shardQueueSize is the number of records that can wait to be written to
+// each shard
+const shardQueueSize = BufferSize
+
+// ShardManifest describes a store that the writer sharded over several
+// files. The writer saves it next to the first shard, named after it with a
+// .shards suffix.
+type ShardManifest struct {
+	// Shards is the number of shards
+	Shards int `json:"shards"`
+
+	// Key is the name of the shard key
+	Key string `json:"key"`
+}
+
+// shardManifestName returns the name of the shard manifest of a store
+func shardManifestName(fileName string) string {
+	return fileName + ".shards"
+}
+
+// shardName returns the name of a shard of the store whose first shard is
+// fileName
+func shardName(fileName string, shard int) string {
+	if shard == 0 {
+		return fileName
+	}
+	return fmt.Sprintf("%s.shard%d", fileName, shard)
+}
+
+// LoadShardManifest returns the shard manifest of the store whose first
+// shard is fileName, or nil if the store is not sharded
+func LoadShardManifest(fileName string) (*ShardManifest, error) {
+	data, err := os.ReadFile(shardManifestName(fileName))
+	if os.IsNotExist(err) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+	manifest := &ShardManifest{}
+	if err := json.Unmarshal(data, manifest); err != nil {
+		return nil, fmt.Errorf("invalid shard manifest: %w", err)
+	}
+	if manifest.Shards < 1 {
+		return nil, fmt.Errorf("invalid shard manifest: %d shards", manifest.Shards)
+	}
+	return manifest, nil
+}
+
+// save atomically writes the manifest of the store whose first shard is
+// fileName
+func (m *ShardManifest) save(fileName string) error {
+	data, err := json.Marshal(m)
+	if err != nil {
+		return err
+	}
+	name := shardManifestName(fileName)
+	tmp := name + ".tmp"
+	if err := os.WriteFile(tmp, data, 0644); err != nil {
+		return err
+	}
+	return os.Rename(tmp, name)
+}
+
+// paths returns the paths of the shards of the store whose first shard is
+// fileName
+func (m *ShardManifest) paths(fileName string) []string {
+	paths := make([]string, m.Shards)
+	for shard := range paths {
+		paths[shard] = shardName(fileName, shard)
+	}
+	return paths
+}
+
+// removeShards removes the shards of the store whose first shard is fileName,
+// other than the first one, and its manifest
+func removeShards(fileName string) error {
+	manifest, err := LoadShardManifest(fileName)
+	if err != nil || manifest == nil {
+		return err
+	}
+	var errs []error
+	for _, path := range manifest.paths(fileName)[1:] {
+		for _, name := range []string{path, storeIndexName(path)} {
+			if err := os.Remove(name); err != nil && !os.IsNotExist(err) {
+				errs = append(errs, err)
+			}
+		}
+	}
+	if err := os.Remove(shardManifestName(fileName)); err != nil && !os.IsNotExist(err) {
+		errs = append(errs, err)
+	}
+	return errors.Join(errs...)
+}
+
+// shardOp is an operation queued for a shard, it writes the record if it is
+// set and otherwise syncs the shard
+type shardOp struct {
+	record *service.Record
+	synced chan<- error
+}
+
+// storeShard is a shard of a sharded store, written by its own goroutine
+type storeShard struct {
+	store RecordStore
+	ops   chan shardOp
+
+	// mu guards err
+	mu sync.Mutex
+
+	// err is the error of a write since the last Write of the shard
+	err error
+}
+
+// shardedStore writes the records to several stores concurrently, so that
+// encoding and writing the records is not limited to a single goroutine. The
+// records keep the numbers assigned by the writer, readers merge the shards
+// in record number order.
+type shardedStore struct {
+	logger *observability.CoreLogger
+	key    ShardKey
+	shards []*storeShard
+	wg     sync.WaitGroup
+
+	// bytesWritten is the number of bytes written to all the shards
+	bytesWritten atomic.Int64
+}
+
+// newShardedStore returns a store that writes to the given stores
+func newShardedStore(stores []RecordStore, key ShardKey, logger *observability.CoreLogger) *shardedStore {
+	ss := &shardedStore{logger: logger, key: key}
+	for _, store := range stores {
+		ss.shards = append(ss.shards, &storeShard{store: store})
+	}
+	return ss
+}
+
+// Open opens all the shards and starts writing them
+func (ss *shardedStore) Open(flag int) error {
+	for i, shard := range ss.shards {
+		if err := shard.store.Open(flag); err != nil {
+			for _, opened := range ss.shards[:i] {
+				_ = opened.store.Close()
+			}
+			return fmt.Errorf("sharded store: opening shard %d: %w", i, err)
+		}
+	}
+	for _, shard := range ss.shards {
+		shard.ops = make(chan shardOp, shardQueueSize)
+		ss.wg.Add(1)
+		go ss.run(shard)
+	}
+	return nil
+}
+
+// run writes the records queued for a shard until its queue is closed
+func (ss *shardedStore) run(shard *storeShard) {
+	defer ss.wg.Done()
+	sizer, sized := shard.store.(storeSizer)
+	for op := range shard.ops {
+		if op.record == nil {
+			op.synced <- shard.store.Sync()
+			continue
+		}
+		var written int64
+		if sized {
+			written = sizer.BytesWritten()
+		}
+		if err := shard.store.Write(op.record); err != nil {
+			shard.mu.Lock()
+			shard.err = errors.Join(shard.err, err)
+			shard.mu.Unlock()
+			continue
+		}
+		if sized {
+			ss.bytesWritten.Add(sizer.BytesWritten() - written)
+		}
+	}
+}
+
+// shard returns the index of the shard a record is written to
+func (ss *shardedStore) shard(record *service.Record) int {
+	n := len(ss.shards)
+	if ss.key == ShardByStep {
+		if history := record.GetHistory(); history != nil {
+			step := history.GetStep().GetNum()
+			if step < 0 {
+				step = -step
+			}
+			return int(step % int64(n))
+		}
+		return 0
+	}
+	hash := fnv.New32a()
+	_, _ = hash.Write([]byte(recordTypeName(record)))
+	return int(hash.Sum32() % uint32(n))
+}
+
+// Write queues the record to be written to its shard. The records are
+// written asynchronously, so Write returns the errors of the earlier writes
+// to the shard that were not returned yet.
+func (ss *shardedStore) Write(record *service.Record) error {
+	shard := ss.shards[ss.shard(record)]
+	shard.ops <- shardOp{record: record}
+	shard.mu.Lock()
+	defer shard.mu.Unlock()
+	err := shard.err
+	shard.err = nil
+	return err
+}
+
+// Sync waits for the queued records to be written and syncs all the shards
+func (ss *shardedStore) Sync() error {
+	results := make([]chan error, len(ss.shards))
+	for i, shard := range ss.shards {
+		results[i] = make(chan error, 1)
+		shard.ops <- shardOp{synced: results[i]}
+	}
+	var errs []error
+	for i := range ss.shards {
+		if err := <-results[i]; err != nil {
+			errs = append(errs, fmt.Errorf("sharded store: syncing shard %d: %w", i, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// Close waits for the queued records to be written and closes all the shards
+func (ss *shardedStore) Close() error {
+	for _, shard := range ss.shards {
+		close(shard.ops)
+	}
+	ss.wg.Wait()
+	var errs []error
+	for i, shard := range ss.shards {
+		if shard.err != nil {
+			errs = append(errs, fmt.Errorf("sharded store: writing shard %d: %w", i, shard.err))
+		}
+		if err := shard.store.Close(); err != nil {
+			errs = append(errs, fmt.Errorf("sharded store: closing shard %d: %w", i, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// LastRecordNum returns the highest record number of the resumed shards
+func (ss *shardedStore) LastRecordNum() int64 {
+	var last int64
+	for _, shard := range ss.shards {
+		last = max(last, shard.store.LastRecordNum())
+	}
+	return last
+}
+
+// BytesWritten returns the bytes written to all the shards
+func (ss *shardedStore) BytesWritten() int64 {
+	return ss.bytesWritten.Load()
+}
+
+// shardMerger reads the records of all the shards of a store in record
+// number order
+type shardMerger struct {
+	stores []*Store
+
+	// heads are the next record of each shard, nil if it must be read
+	heads []*service.Record
+
+	// done is whether each shard was read to the end
+	done []bool
+}
+
+// openShardMerger opens the shards at paths for reading
+func openShardMerger(
+	reader *SegmentReader,
+	paths []string,
+) (*shardMerger, error) {
+	m := &shardMerger{
+		heads: make([]*service.Record, len(paths)),
+		done:  make([]bool, len(paths)),
+	}
+	for _, path := range paths {
+		store := NewStoreWithOptions(reader.ctx, path, reader.logger, reader.opts)
+		if err := store.Open(os.O_RDONLY); err != nil {
+			_ = m.Close()
+			return nil, err
+		}
+		m.stores = append(m.stores, store)
+	}
+	return m, nil
+}
+
+// Read returns the record with the lowest number among the next records of
+// the shards, it returns io.EOF once all the shards were read
+func (m *shardMerger) Read() (*service.Record, error) {
+	next := -1
+	for i, store := range m.stores {
+		if m.heads[i] == nil && !m.done[i] {
+			record, err := store.Read()
+			if err == io.EOF {
+				m.done[i] = true
+				continue
+			} else if err != nil {
+				return nil, err
+			}
+			m.heads[i] = record
+		}
+		if m.heads[i] != nil && (next < 0 || m.heads[i].Num < m.heads[next].Num) {
+			next = i
+		}
+	}
+	if next < 0 {
+		return nil, io.EOF
+	}
+	record := m.heads[next]
+	m.heads[next] = nil
+	return record, nil
+}
+
+// SeekRecord moves all the shards so that the next Read returns the first
+// record whose number is at least num
+func (m *shardMerger) SeekRecord(num int64) error {
+	found := false
+	for i, store := range m.stores {
+		m.heads[i] = nil
+		m.done[i] = false
+		err := store.SeekRecord(num)
+		if err == io.EOF {
+			m.done[i] = true
+			continue
+		} else if err != nil {
+			return err
+		}
+		found = true
+	}
+	if !found {
+		return io.EOF
+	}
+	return nil
+}
+
+// Header returns the header of the first shard
+func (m *shardMerger) Header() HeaderOptions {
+	if len(m.stores) == 0 {
+		return HeaderOptions{}
+	}
+	return m.stores[0].Header()
+}
+
+// Close closes all the shards
+func (m *shardMerger) Close() error {
+	var errs []error
+	for _, store := range m.stores {
+		if err := store.Close(); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	m.stores = nil
+	return errors.Join(errs...)
+}
@@ -0,0 +1,105 @@
+package server_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	"github.com/wandb/wandb/core/pkg/observability"
+	"github.com/wandb/wandb/core/pkg/server"
+	"github.com/wandb/wandb/core/pkg/service"
+)
+
+func historyRecordAt(step int64) *service.Record {
+	return &service.Record{RecordType: &service.Record_History{History: &service.HistoryRecord{
+		Step: &service.HistoryStep{Num: step},
+	}}}
+}
+
+func recordNums(records []*service.Record) []int64 {
+	var nums []int64
+	for _, record := range records {
+		nums = append(nums, record.Num)
+	}
+	return nums
+}
+
+func TestWriterShards(t *testing.T) {
+	fileName := filepath.Join(t.TempDir(), "run.wandb")
+	settings := &service.Settings{SyncFile: &wrapperspb.StringValue{Value: fileName}}
+	runWriter(t, compactRecords(),
+		server.WithWriterSettings(settings),
+		server.WithWriterShards(3, server.ShardByType),
+	)
+
+	manifest, err := server.LoadShardManifest(fileName)
+	assert.NoError(t, err)
+	assert.Equal(t, &server.ShardManifest{Shards: 3, Key: "type"}, manifest)
+	total := 0
+	for _, name := range []string{fileName, fileName + ".shard1", fileName + ".shard2"} {
+		records := readStore(t, name)
+		assert.Less(t, len(records), 10)
+		total += len(records)
+	}
+	assert.Equal(t, 10, total)
+
+	// the shards are merged in record order
+	assert.Equal(t, []int64{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}, recordNums(readSegments(t, fileName)))
+}
+
+func TestWriterShardsByStep(t *testing.T) {
+	fileName := filepath.Join(t.TempDir(), "run.wandb")
+	settings := &service.Settings{
+		SyncFile:       &wrapperspb.StringValue{Value: fileName},
+		XStoreShards:   &wrapperspb.Int64Value{Value: 2},
+		XStoreShardKey: &wrapperspb.StringValue{Value: "step"},
+	}
+	records := []*service.Record{{RecordType: &service.Record_Run{Run: &service.RunRecord{}}}}
+	for step := int64(0); step < 6; step++ {
+		records = append(records, historyRecordAt(step))
+	}
+	runWriter(t, records, server.WithWriterSettings(settings))
+
+	var steps []int64
+	for _, record := range readStore(t, fileName+".shard1") {
+		steps = append(steps, record.GetHistory().GetStep().GetNum())
+	}
+	assert.Equal(t, []int64{1, 3, 5}, steps)
+
+	reader := server.NewSegmentReader(context.Background(), fileName, observability.NewNoOpLogger())
+	assert.NoError(t, reader.Open())
+	defer reader.Close()
+	assert.NoError(t, reader.SeekRecord(4))
+	for num := int64(4); num <= 7; num++ {
+		record, err := reader.Read()
+		assert.NoError(t, err)
+		assert.Equal(t, num, record.Num)
+	}
+}
+
+func TestWriterShardsResume(t *testing.T) {
+	fileName := filepath.Join(t.TempDir(), "run.wandb")
+	settings := &service.Settings{SyncFile: &wrapperspb.StringValue{Value: fileName}}
+	runWriter(t, makeOutputRecords(4),
+		server.WithWriterSettings(settings),
+		server.WithWriterShards(2, server.ShardByType),
+	)
+	// the shards of the resumed store are kept
+	runWriter(t, compactRecords(),
+		server.WithWriterSettings(settings),
+		server.WithWriterShards(4, server.ShardByType),
+		server.WithWriterResume(),
+	)
+	_, err := os.Stat(fileName + ".shard2")
+	assert.True(t, os.IsNotExist(err))
+
+	nums := recordNums(readSegments(t, fileName))
+	assert.Len(t, nums, 14)
+	for i, num := range nums {
+		assert.Equal(t, int64(i+1), num)
+	}
+}
@@ -0,0 +1,80 @@
+package server
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// SyncPolicy decides when the writer syncs the store to stable storage, it
+// trades durability on a crash for throughput
+type SyncPolicy int
+
+const (
+	// SyncDefault syncs the store as configured by the batch size and flush
+	// interval options of the writer, and when the store is closed if either
+	// is set.
+	SyncDefault SyncPolicy = iota
+	// SyncPerRecord syncs the store after every record, no stored record is
+	// lost on a crash.
+	SyncPerRecord
+	// SyncPerBatch syncs the store once a batch of records was written.
+	SyncPerBatch
+	// SyncPerInterval syncs the store periodically if any records were
+	// written since the last sync.
+	SyncPerInterval
+	// SyncNever leaves syncing to the operating system, only an explicit
+	// flush syncs the store.
+	SyncNever
+)
+
+// defaultSyncBatchRecords is the batch size of SyncPerBatch if the writer has
+// no batch size
+const defaultSyncBatchRecords = 100
+
+// defaultSyncInterval is the interval of SyncPerInterval if the writer has no
+// flush interval
+const defaultSyncInterval = time.Second
+
+// ParseSyncPolicy returns the sync policy with the given name, one of
+// "record", "batch", "interval" or "never". An empty name is the default
+// policy.
+func ParseSyncPolicy(name string) (SyncPolicy, error) {
+	switch strings.ToLower(name) {
+	case "":
+		return SyncDefault, nil
+	case "record":
+		return SyncPerRecord, nil
+	case "batch":
+		return SyncPerBatch, nil
+	case "interval":
+		return SyncPerInterval, nil
+	case "never":
+		return SyncNever, nil
+	default:
+		return SyncDefault, fmt.Errorf("unknown sync policy %q", name)
+	}
+}
+
+// applySyncPolicy sets the batch size and flush interval of the writer that
+// implement its sync policy
+func (w *Writer) applySyncPolicy() {
+	switch w.syncPolicy {
+	case SyncPerRecord:
+		w.batchRecords, w.batchBytes = 1, 0
+		w.flushInterval = 0
+	case SyncPerBatch:
+		if w.batchRecords == 0 && w.batchBytes == 0 {
+			w.batchRecords = defaultSyncBatchRecords
+		}
+		w.flushInterval = 0
+	case SyncPerInterval:
+		if w.flushInterval == 0 {
+			w.flushInterval = defaultSyncInterval
+		}
+		w.batchRecords, w.batchBytes = 0, 0
+	case SyncNever:
+		w.batchRecords, w.batchBytes = 0, 0
+		w.flushInterval = 0
+	}
+}
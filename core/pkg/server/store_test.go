@@ -1,15 +1,26 @@
 package server_test
 
 import (
+	"bytes"
 	"context"
+	"encoding/base64"
 	"io"
 	"os"
+	"path/filepath"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/wandb/wandb/core/pkg/leveldb"
 	"github.com/wandb/wandb/core/pkg/observability"
 	"github.com/wandb/wandb/core/pkg/server"
 	"github.com/wandb/wandb/core/pkg/service"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/dynamicpb"
 )
 
 func TestValidHeader(t *testing.T) {
@@ -159,6 +170,124 @@ func TestCorruptFile(t *testing.T) {
 	assert.NoError(t, err)
 }
 
+func TestChecksumMismatch(t *testing.T) {
+	fileName := filepath.Join(t.TempDir(), "run.wandb")
+	logger := observability.NewNoOpLogger()
+	store := server.NewStore(context.Background(), fileName, logger)
+	assert.NoError(t, store.Open(os.O_WRONLY))
+	assert.NoError(t, store.Write(&service.Record{Num: 1, Uuid: "test-uuid"}))
+	assert.NoError(t, store.Close())
+
+	// flip the last byte of the record payload, after the store header
+	data, err := os.ReadFile(fileName)
+	assert.NoError(t, err)
+	data[len(data)-1] ^= 0xff
+	assert.NoError(t, os.WriteFile(fileName, data, 0644))
+
+	store2 := server.NewStore(context.Background(), fileName, logger)
+	assert.NoError(t, store2.Open(os.O_RDONLY))
+	defer store2.Close()
+
+	_, err = store2.Read()
+	assert.ErrorIs(t, err, server.ErrCorruptRecord)
+	assert.ErrorIs(t, err, leveldb.ErrChecksumMismatch)
+	var corruptErr *server.CorruptRecordError
+	assert.ErrorAs(t, err, &corruptErr)
+
+	// the reader skips the corrupt block
+	_, err = store2.Read()
+	assert.Equal(t, io.EOF, err)
+}
+
+func TestTruncatedRecord(t *testing.T) {
+	fileName := filepath.Join(t.TempDir(), "run.wandb")
+	logger := observability.NewNoOpLogger()
+	store := server.NewStore(context.Background(), fileName, logger)
+	assert.NoError(t, store.Open(os.O_WRONLY))
+	assert.NoError(t, store.Write(&service.Record{Num: 1, Uuid: "test-uuid"}))
+	assert.NoError(t, store.Close())
+
+	info, err := os.Stat(fileName)
+	assert.NoError(t, err)
+	assert.NoError(t, os.Truncate(fileName, info.Size()-2))
+
+	store2 := server.NewStore(context.Background(), fileName, logger)
+	assert.NoError(t, store2.Open(os.O_RDONLY))
+	defer store2.Close()
+
+	_, err = store2.Read()
+	assert.ErrorIs(t, err, server.ErrCorruptRecord)
+}
+
+func TestRecoverStore(t *testing.T) {
+	fileName := filepath.Join(t.TempDir(), "run.wandb")
+	logger := observability.NewNoOpLogger()
+	store := server.NewStore(context.Background(), fileName, logger)
+	assert.NoError(t, store.Open(os.O_WRONLY))
+	for i := 1; i <= 3; i++ {
+		assert.NoError(t, store.Write(&service.Record{Num: int64(i), Uuid: "test-uuid"}))
+	}
+	assert.NoError(t, store.Close())
+
+	// simulate a crash in the middle of writing the last record
+	info, err := os.Stat(fileName)
+	assert.NoError(t, err)
+	assert.NoError(t, os.Truncate(fileName, info.Size()-2))
+
+	store2 := server.NewStore(context.Background(), fileName, logger)
+	assert.NoError(t, store2.Open(os.O_RDWR))
+	for i := 1; i <= 2; i++ {
+		record, err := store2.Read()
+		assert.NoError(t, err)
+		assert.Equal(t, int64(i), record.Num)
+	}
+	_, err = store2.Read()
+	assert.Equal(t, io.EOF, err)
+	assert.NoError(t, store2.Close())
+
+	// the repaired file reads cleanly without recovery
+	store3 := server.NewStore(context.Background(), fileName, logger)
+	assert.NoError(t, store3.Open(os.O_RDONLY))
+	defer store3.Close()
+	for i := 1; i <= 2; i++ {
+		_, err := store3.Read()
+		assert.NoError(t, err)
+	}
+	_, err = store3.Read()
+	assert.Equal(t, io.EOF, err)
+}
+
+func TestResumeStoreAfterCrash(t *testing.T) {
+	fileName := filepath.Join(t.TempDir(), "run.wandb")
+	logger := observability.NewNoOpLogger()
+	store := server.NewStore(context.Background(), fileName, logger)
+	assert.NoError(t, store.Open(os.O_WRONLY))
+	assert.NoError(t, store.Write(&service.Record{Num: 1}))
+	assert.NoError(t, store.Write(&service.Record{Num: 2}))
+	assert.NoError(t, store.Close())
+
+	info, err := os.Stat(fileName)
+	assert.NoError(t, err)
+	assert.NoError(t, os.Truncate(fileName, info.Size()-1))
+
+	store2 := server.NewStoreWithOptions(context.Background(), fileName, logger, server.StoreOptions{Resume: true})
+	assert.NoError(t, store2.Open(os.O_WRONLY))
+	assert.Equal(t, int64(1), store2.LastRecordNum())
+	assert.NoError(t, store2.Write(&service.Record{Num: 2}))
+	assert.NoError(t, store2.Close())
+
+	store3 := server.NewStore(context.Background(), fileName, logger)
+	assert.NoError(t, store3.Open(os.O_RDONLY))
+	defer store3.Close()
+	for i := 1; i <= 2; i++ {
+		record, err := store3.Read()
+		assert.NoError(t, err)
+		assert.Equal(t, int64(i), record.Num)
+	}
+	_, err = store3.Read()
+	assert.Equal(t, io.EOF, err)
+}
+
 // Test to check the InvalidHeader scenario
 func TestStoreInvalidHeader(t *testing.T) {
 	tmpFile, err := os.CreateTemp("", "temp-invalid-header")
@@ -240,3 +369,532 @@ func TestReadFromClosedStore(t *testing.T) {
 	_, err = store.Read()
 	assert.Error(t, err, "can't read record")
 }
+
+// TestResumeStore tests that a resumed store continues after the existing records
+func TestResumeStore(t *testing.T) {
+	tmpFile, err := os.CreateTemp("", "temp-db")
+	assert.NoError(t, err)
+	defer os.Remove(tmpFile.Name())
+	tmpFile.Close()
+
+	logger := observability.NewNoOpLogger()
+	store := server.NewStore(context.Background(), tmpFile.Name(), logger)
+	err = store.Open(os.O_WRONLY)
+	assert.NoError(t, err)
+	for i := int64(1); i <= 3; i++ {
+		err = store.Write(&service.Record{Num: i})
+		assert.NoError(t, err)
+	}
+	err = store.Close()
+	assert.NoError(t, err)
+
+	store2 := server.NewStoreWithOptions(context.Background(), tmpFile.Name(), logger,
+		server.StoreOptions{Resume: true},
+	)
+	err = store2.Open(os.O_WRONLY)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(3), store2.LastRecordNum())
+	err = store2.Write(&service.Record{Num: 4})
+	assert.NoError(t, err)
+	err = store2.Close()
+	assert.NoError(t, err)
+
+	store3 := server.NewStore(context.Background(), tmpFile.Name(), logger)
+	err = store3.Open(os.O_RDONLY)
+	assert.NoError(t, err)
+	defer store3.Close()
+	for i := int64(1); i <= 4; i++ {
+		record, err := store3.Read()
+		assert.NoError(t, err)
+		assert.Equal(t, i, record.Num)
+	}
+	_, err = store3.Read()
+	assert.ErrorIs(t, err, io.EOF)
+}
+
+// TestResumeStoreInvalidHeader tests that resuming a store with an invalid
+// header falls back to a fresh store
+func TestResumeStoreInvalidHeader(t *testing.T) {
+	tmpFile, err := os.CreateTemp("", "temp-invalid-header")
+	assert.NoError(t, err)
+	defer os.Remove(tmpFile.Name())
+	tmpFile.Close()
+
+	err = os.WriteFile(tmpFile.Name(), []byte("Invalid"), 0644)
+	assert.NoError(t, err)
+
+	logger := observability.NewNoOpLogger()
+	store := server.NewStoreWithOptions(context.Background(), tmpFile.Name(), logger,
+		server.StoreOptions{Resume: true},
+	)
+	err = store.Open(os.O_WRONLY)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(0), store.LastRecordNum())
+	err = store.Write(&service.Record{Num: 1})
+	assert.NoError(t, err)
+	err = store.Close()
+	assert.NoError(t, err)
+
+	store2 := server.NewStore(context.Background(), tmpFile.Name(), logger)
+	err = store2.Open(os.O_RDONLY)
+	assert.NoError(t, err)
+	defer store2.Close()
+	record, err := store2.Read()
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), record.Num)
+}
+
+// TestSyncStore tests that syncing flushes the buffered records to the file
+func TestSyncStore(t *testing.T) {
+	tmpFile, err := os.CreateTemp("", "temp-db")
+	assert.NoError(t, err)
+	defer os.Remove(tmpFile.Name())
+	tmpFile.Close()
+
+	logger := observability.NewNoOpLogger()
+	store := server.NewStore(context.Background(), tmpFile.Name(), logger)
+	err = store.Open(os.O_WRONLY)
+	assert.NoError(t, err)
+	defer store.Close()
+
+	err = store.Write(&service.Record{Num: 1})
+	assert.NoError(t, err)
+	err = store.Sync()
+	assert.NoError(t, err)
+
+	store2 := server.NewStore(context.Background(), tmpFile.Name(), logger)
+	err = store2.Open(os.O_RDONLY)
+	assert.NoError(t, err)
+	defer store2.Close()
+	record, err := store2.Read()
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), record.Num)
+}
+
+func TestCompressedHeader(t *testing.T) {
+	header := server.NewHeader()
+	header.SetCompression(server.CompressionGzip)
+
+	var buf bytes.Buffer
+	err := header.MarshalBinary(&buf)
+	assert.NoError(t, err)
+
+	header2 := server.HeaderOptions{}
+	err = header2.UnmarshalBinary(&buf)
+	assert.NoError(t, err)
+	assert.True(t, header2.Valid())
+	assert.Equal(t, server.CompressionGzip, header2.Compression)
+}
+
+func TestUncompressedHeaderSize(t *testing.T) {
+	var buf bytes.Buffer
+	err := server.NewHeader().MarshalBinary(&buf)
+	assert.NoError(t, err)
+	assert.Equal(t, 7, buf.Len())
+}
+
+func TestReadWriteCompressedRecords(t *testing.T) {
+	for name, compression := range map[string]server.Compression{
+		"none":   server.CompressionNone,
+		"gzip":   server.CompressionGzip,
+		"zstd":   server.CompressionZstd,
+		"snappy": server.CompressionSnappy,
+	} {
+		compression := compression
+		t.Run(name, func(t *testing.T) {
+			fileName := filepath.Join(t.TempDir(), "run.wandb")
+			logger := observability.NewNoOpLogger()
+			store := server.NewStoreWithOptions(context.Background(), fileName, logger,
+				server.StoreOptions{Compression: compression},
+			)
+			err := store.Open(os.O_WRONLY)
+			assert.NoError(t, err)
+
+			// an empty, a short and a large compressible record
+			var records []*service.Record
+			for i, line := range []string{"", "some output", strings.Repeat("some output ", 10000)} {
+				record := &service.Record{
+					Num:        int64(i + 1),
+					RecordType: &service.Record_Output{Output: &service.OutputRecord{Line: line}},
+				}
+				records = append(records, record)
+				err = store.Write(record)
+				assert.NoError(t, err)
+			}
+			err = store.Close()
+			assert.NoError(t, err)
+
+			store2 := server.NewStore(context.Background(), fileName, logger)
+			err = store2.Open(os.O_RDONLY)
+			assert.NoError(t, err)
+			assert.Equal(t, compression, store2.Header().Compression)
+			for _, record := range records {
+				readRecord, err := store2.Read()
+				assert.NoError(t, err)
+				assert.True(t, proto.Equal(record, readRecord))
+			}
+			_, err = store2.Read()
+			assert.ErrorIs(t, err, io.EOF)
+			err = store2.Close()
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestParseCompression(t *testing.T) {
+	for name, want := range map[string]server.Compression{
+		"":       server.CompressionNone,
+		"none":   server.CompressionNone,
+		"gzip":   server.CompressionGzip,
+		"GZIP":   server.CompressionGzip,
+		"zstd":   server.CompressionZstd,
+		"snappy": server.CompressionSnappy,
+	} {
+		got, err := server.ParseCompression(name)
+		assert.NoError(t, err)
+		assert.Equal(t, want, got)
+	}
+	_, err := server.ParseCompression("lz4")
+	assert.Error(t, err)
+}
+
+// writeIndexedStore writes records numbered from first to last to an
+// indexed store
+func writeIndexedStore(t *testing.T, fileName string, first, last int64, resume bool) {
+	t.Helper()
+
+	store := server.NewStoreWithOptions(context.Background(), fileName, observability.NewNoOpLogger(),
+		server.StoreOptions{Index: true, Resume: resume},
+	)
+	assert.NoError(t, store.Open(os.O_WRONLY))
+	for num := first; num <= last; num++ {
+		// records large enough to span several blocks
+		record := &service.Record{
+			Num:        num,
+			RecordType: &service.Record_Output{Output: &service.OutputRecord{Line: strings.Repeat("x", 1000)}},
+		}
+		assert.NoError(t, store.Write(record))
+	}
+	assert.NoError(t, store.Close())
+}
+
+func TestSeekRecord(t *testing.T) {
+	fileName := filepath.Join(t.TempDir(), "run.wandb")
+	writeIndexedStore(t, fileName, 1, 100, false)
+	_, err := os.Stat(fileName + ".index")
+	assert.NoError(t, err)
+
+	store := server.NewStore(context.Background(), fileName, observability.NewNoOpLogger())
+	assert.NoError(t, store.Open(os.O_RDONLY))
+	defer store.Close()
+
+	for _, num := range []int64{50, 1, 100, 77} {
+		assert.NoError(t, store.SeekRecord(num))
+		record, err := store.Read()
+		assert.NoError(t, err)
+		assert.Equal(t, num, record.Num)
+	}
+	// reading continues after the record sought
+	record, err := store.Read()
+	assert.NoError(t, err)
+	assert.Equal(t, int64(78), record.Num)
+
+	assert.Equal(t, io.EOF, store.SeekRecord(101))
+}
+
+func TestSeekRecordWithoutIndex(t *testing.T) {
+	fileName := filepath.Join(t.TempDir(), "run.wandb")
+	writeIndexedStore(t, fileName, 1, 10, false)
+	assert.NoError(t, os.Remove(fileName+".index"))
+
+	store := server.NewStore(context.Background(), fileName, observability.NewNoOpLogger())
+	assert.NoError(t, store.Open(os.O_RDONLY))
+	defer store.Close()
+
+	assert.NoError(t, store.SeekRecord(5))
+	record, err := store.Read()
+	assert.NoError(t, err)
+	assert.Equal(t, int64(5), record.Num)
+}
+
+func TestStoreMmap(t *testing.T) {
+	fileName := filepath.Join(t.TempDir(), "run.wandb")
+	// more records than fit in a prefetched chunk
+	writeIndexedStore(t, fileName, 1, 6000, false)
+
+	store := server.NewStoreWithOptions(context.Background(), fileName, observability.NewNoOpLogger(),
+		server.StoreOptions{Mmap: true},
+	)
+	assert.NoError(t, store.Open(os.O_RDONLY))
+	defer store.Close()
+
+	for num := int64(1); num <= 6000; num++ {
+		record, err := store.Read()
+		assert.NoError(t, err)
+		assert.Equal(t, num, record.Num)
+	}
+	_, err := store.Read()
+	assert.Equal(t, io.EOF, err)
+
+	assert.NoError(t, store.SeekRecord(4321))
+	record, err := store.Read()
+	assert.NoError(t, err)
+	assert.Equal(t, int64(4321), record.Num)
+}
+
+func TestResumeIndexedStoreAfterCrash(t *testing.T) {
+	fileName := filepath.Join(t.TempDir(), "run.wandb")
+	writeIndexedStore(t, fileName, 1, 100, false)
+
+	// the last record is partially written, but indexed
+	info, err := os.Stat(fileName)
+	assert.NoError(t, err)
+	assert.NoError(t, os.Truncate(fileName, info.Size()-10))
+
+	store := server.NewStoreWithOptions(context.Background(), fileName, observability.NewNoOpLogger(),
+		server.StoreOptions{Index: true, Resume: true},
+	)
+	assert.NoError(t, store.Open(os.O_WRONLY))
+	assert.Equal(t, int64(99), store.LastRecordNum())
+	assert.NoError(t, store.Close())
+
+	writeIndexedStore(t, fileName, 100, 120, true)
+
+	reader := server.NewStore(context.Background(), fileName, observability.NewNoOpLogger())
+	assert.NoError(t, reader.Open(os.O_RDONLY))
+	defer reader.Close()
+	for _, num := range []int64{99, 100, 120} {
+		assert.NoError(t, reader.SeekRecord(num))
+		record, err := reader.Read()
+		assert.NoError(t, err)
+		assert.Equal(t, num, record.Num)
+	}
+}
+
+func TestEncryptedStore(t *testing.T) {
+	fileName := filepath.Join(t.TempDir(), "run.wandb")
+	logger := observability.NewNoOpLogger()
+	key := bytes.Repeat([]byte{1}, 32)
+	opts := server.StoreOptions{EncryptionKey: key, Compression: server.CompressionGzip}
+
+	store := server.NewStoreWithOptions(context.Background(), fileName, logger, opts)
+	assert.NoError(t, store.Open(os.O_WRONLY))
+	record := &service.Record{
+		Num:        1,
+		RecordType: &service.Record_Output{Output: &service.OutputRecord{Line: "secret line"}},
+	}
+	assert.NoError(t, store.Write(record))
+	assert.NoError(t, store.Close())
+
+	data, err := os.ReadFile(fileName)
+	assert.NoError(t, err)
+	assert.NotContains(t, string(data), "secret line")
+	header := server.NewHeader()
+	assert.NoError(t, header.UnmarshalBinary(bytes.NewReader(data)))
+	assert.True(t, header.Valid())
+	assert.Equal(t, server.EncryptionAESGCM, header.Encryption)
+	assert.Equal(t, server.CompressionGzip, header.Compression)
+
+	reader := server.NewStoreWithOptions(context.Background(), fileName, logger, opts)
+	assert.NoError(t, reader.Open(os.O_RDONLY))
+	readRecord, err := reader.Read()
+	assert.NoError(t, err)
+	assert.True(t, proto.Equal(record, readRecord))
+	assert.NoError(t, reader.Close())
+
+	// the key is required to read the store
+	noKey := server.NewStore(context.Background(), fileName, logger)
+	assert.Error(t, noKey.Open(os.O_RDONLY))
+
+	wrongKey := server.NewStoreWithOptions(context.Background(), fileName, logger,
+		server.StoreOptions{EncryptionKey: bytes.Repeat([]byte{2}, 32)},
+	)
+	assert.NoError(t, wrongKey.Open(os.O_RDONLY))
+	defer wrongKey.Close()
+	_, err = wrongKey.Read()
+	assert.ErrorIs(t, err, server.ErrCorruptRecord)
+}
+
+func TestResumeEncryptedStoreWithoutKey(t *testing.T) {
+	fileName := filepath.Join(t.TempDir(), "run.wandb")
+	logger := observability.NewNoOpLogger()
+	store := server.NewStoreWithOptions(context.Background(), fileName, logger,
+		server.StoreOptions{EncryptionKey: bytes.Repeat([]byte{1}, 16)},
+	)
+	assert.NoError(t, store.Open(os.O_WRONLY))
+	assert.NoError(t, store.Write(&service.Record{Num: 1}))
+	assert.NoError(t, store.Close())
+	info, err := os.Stat(fileName)
+	assert.NoError(t, err)
+
+	// the encrypted store is not replaced by a new one
+	resumed := server.NewStoreWithOptions(context.Background(), fileName, logger, server.StoreOptions{Resume: true})
+	assert.Error(t, resumed.Open(os.O_WRONLY))
+	info2, err := os.Stat(fileName)
+	assert.NoError(t, err)
+	assert.Equal(t, info.Size(), info2.Size())
+}
+
+func TestParseEncryptionKey(t *testing.T) {
+	key, err := server.ParseEncryptionKey(base64.StdEncoding.EncodeToString(make([]byte, 24)))
+	assert.NoError(t, err)
+	assert.Len(t, key, 24)
+
+	_, err = server.ParseEncryptionKey(base64.StdEncoding.EncodeToString(make([]byte, 10)))
+	assert.Error(t, err)
+	_, err = server.ParseEncryptionKey("not base64!")
+	assert.Error(t, err)
+}
+
+func TestEmbeddedSchemaStore(t *testing.T) {
+	fileName := filepath.Join(t.TempDir(), "run.wandb")
+	logger := observability.NewNoOpLogger()
+	opts := server.StoreOptions{EmbedSchema: true, Compression: server.CompressionGzip}
+
+	store := server.NewStoreWithOptions(context.Background(), fileName, logger, opts)
+	assert.NoError(t, store.Open(os.O_WRONLY))
+	record := &service.Record{
+		Num:        1,
+		RecordType: &service.Record_Output{Output: &service.OutputRecord{Line: "line"}},
+	}
+	assert.NoError(t, store.Write(record))
+	assert.NoError(t, store.Close())
+
+	reader := server.NewStore(context.Background(), fileName, logger)
+	assert.NoError(t, reader.Open(os.O_RDONLY))
+	defer reader.Close()
+	assert.Equal(t, server.CompressionGzip, reader.Header().Compression)
+	assert.Equal(t, server.RecordSchema(), reader.Header().Schema)
+	readRecord, err := reader.Read()
+	assert.NoError(t, err)
+	assert.True(t, proto.Equal(record, readRecord))
+}
+
+func TestStoreMetadata(t *testing.T) {
+	for _, embedSchema := range []bool{false, true} {
+		fileName := filepath.Join(t.TempDir(), "run.wandb")
+		logger := observability.NewNoOpLogger()
+		metadata := &server.StoreMetadata{
+			RunID:     "abc",
+			Entity:    "entity",
+			Project:   "project",
+			CreatedAt: time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC),
+		}
+		opts := server.StoreOptions{Metadata: metadata, EmbedSchema: embedSchema}
+
+		store := server.NewStoreWithOptions(context.Background(), fileName, logger, opts)
+		assert.NoError(t, store.Open(os.O_WRONLY))
+		record := &service.Record{
+			Num:        1,
+			RecordType: &service.Record_Output{Output: &service.OutputRecord{Line: "line"}},
+		}
+		assert.NoError(t, store.Write(record))
+		assert.NoError(t, store.Close())
+
+		header, err := server.ReadHeaderOnly(fileName)
+		assert.NoError(t, err)
+		assert.Equal(t, metadata, header.Metadata)
+		assert.Equal(t, embedSchema, header.Schema != nil)
+
+		records := readStore(t, fileName)
+		assert.Len(t, records, 1)
+		assert.True(t, proto.Equal(record, records[0]))
+	}
+}
+
+func TestReadHeaderOnlyInvalid(t *testing.T) {
+	fileName := filepath.Join(t.TempDir(), "run.wandb")
+	assert.NoError(t, os.WriteFile(fileName, []byte("not a store"), 0644))
+	_, err := server.ReadHeaderOnly(fileName)
+	assert.Error(t, err)
+}
+
+// renumberedSchema returns the record schema with the run field of the
+// Record message renumbered, as written by a different version
+func renumberedSchema(t *testing.T) (*descriptorpb.FileDescriptorSet, protoreflect.MessageDescriptor) {
+	t.Helper()
+
+	set := &descriptorpb.FileDescriptorSet{}
+	assert.NoError(t, proto.Unmarshal(server.RecordSchema(), set))
+	for _, file := range set.File {
+		for _, message := range file.MessageType {
+			if message.GetName() != "Record" {
+				continue
+			}
+			for _, field := range message.Field {
+				if field.GetName() == "run" {
+					field.Number = proto.Int32(1700)
+				}
+			}
+		}
+	}
+	files, err := protodesc.NewFiles(set)
+	assert.NoError(t, err)
+	desc, err := files.FindDescriptorByName("wandb_internal.Record")
+	assert.NoError(t, err)
+	return set, desc.(protoreflect.MessageDescriptor)
+}
+
+func TestMigrateRecords(t *testing.T) {
+	fileName := filepath.Join(t.TempDir(), "run.wandb")
+	set, desc := renumberedSchema(t)
+	schema, err := proto.Marshal(set)
+	assert.NoError(t, err)
+
+	// write a record with the renumbered schema
+	written := dynamicpb.NewMessage(desc)
+	run := dynamicpb.NewMessage(desc.Fields().ByName("run").Message())
+	run.Set(run.Descriptor().Fields().ByName("run_id"), protoreflect.ValueOfString("abc"))
+	written.Set(desc.Fields().ByName("run"), protoreflect.ValueOfMessage(run))
+	written.Set(desc.Fields().ByName("num"), protoreflect.ValueOfInt64(1))
+	data, err := proto.Marshal(written)
+	assert.NoError(t, err)
+
+	f, err := os.Create(fileName)
+	assert.NoError(t, err)
+	header := server.NewHeader()
+	header.SetSchema(schema)
+	assert.NoError(t, header.MarshalBinary(f))
+	writer := leveldb.NewWriterExt(f, leveldb.CRCAlgoIEEE)
+	w, err := writer.Next()
+	assert.NoError(t, err)
+	_, err = w.Write(data)
+	assert.NoError(t, err)
+	assert.NoError(t, writer.Close())
+	assert.NoError(t, f.Close())
+
+	// the record is read by field name with the current schema
+	store := server.NewStore(context.Background(), fileName, observability.NewNoOpLogger())
+	assert.NoError(t, store.Open(os.O_RDONLY))
+	defer store.Close()
+	record, err := store.Read()
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), record.Num)
+	assert.Equal(t, "abc", record.GetRun().GetRunId())
+}
+
+func BenchmarkStoreWrite(b *testing.B) {
+	store := server.NewStore(context.Background(), filepath.Join(b.TempDir(), "run.wandb"), observability.NewNoOpLogger())
+	if err := store.Open(os.O_WRONLY); err != nil {
+		b.Fatal(err)
+	}
+	defer store.Close()
+
+	history := &service.HistoryRecord{Step: &service.HistoryStep{Num: 1}}
+	for i := 0; i < 20; i++ {
+		history.Item = append(history.Item, &service.HistoryItem{
+			Key:       "metric_" + strings.Repeat("x", i),
+			ValueJson: "0.123456789",
+		})
+	}
+	record := &service.Record{RecordType: &service.Record_History{History: history}}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		record.Num = int64(i + 1)
+		if err := store.Write(record); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
@@ -3,6 +3,9 @@ package server
 import (
 	"context"
 	"sync"
+	"time"
+
+	"google.golang.org/protobuf/types/known/wrapperspb"
 
 	"github.com/wandb/wandb/core/internal/shared"
 	"github.com/wandb/wandb/core/internal/watcher"
@@ -34,6 +37,11 @@ type Stream struct {
 	// wg is the WaitGroup for the stream
 	wg sync.WaitGroup
 
+	// closeMu guards the input channels against the records sent to the
+	// stream by the process, like its preemption, while it is closed
+	closeMu sync.Mutex
+	closed  bool
+
 	// settings is the settings for the stream
 	settings *service.Settings
 
@@ -43,6 +51,9 @@ type Stream struct {
 	// writer is the writer for the stream
 	writer *Writer
 
+	// writerDone is closed once the writer returns, see Abort
+	writerDone chan struct{}
+
 	// sender is the sender for the stream
 	sender *Sender
 
@@ -57,6 +68,34 @@ type Stream struct {
 
 	// dispatcher is the dispatcher for the stream
 	dispatcher *Dispatcher
+
+	// capture records the records received by the handler to replay them,
+	// if _capture_path is set
+	capture *Capture
+}
+
+// maxBufferSize is the largest channel capacity that can be set in the
+// settings
+const maxBufferSize = 1 << 20
+
+// settingsBufferSize returns the channel capacity set by a setting, or def if
+// the setting is not set or out of range
+func settingsBufferSize(
+	logger *observability.CoreLogger,
+	name string,
+	setting *wrapperspb.Int32Value,
+	def int,
+) int {
+	if setting == nil {
+		return def
+	}
+	size := int(setting.GetValue())
+	if size < 1 || size > maxBufferSize {
+		logger.CaptureWarn("stream: ignoring buffer size setting",
+			"setting", name, "size", size, "max", maxBufferSize)
+		return def
+	}
+	return size
 }
 
 // NewStream creates a new stream with the given settings and responders.
@@ -71,43 +110,87 @@ func NewStream(ctx context.Context, settings *service.Settings, streamId string)
 		inChan:       make(chan *service.Record, BufferSize),
 		loopBackChan: make(chan *service.Record, BufferSize),
 		outChan:      make(chan *service.ServerResponse, BufferSize),
+		writerDone:   make(chan struct{}),
 	}
 
 	watcher := watcher.New(watcher.WithLogger(s.logger))
+	statsWindow, err := newStatsWindow(s.settings)
+	if err != nil {
+		s.logger.CaptureError("stream: the system metrics are not aggregated", err)
+	}
+	console, err := newConsole(s.settings)
+	if err != nil {
+		s.logger.CaptureError("stream: the console lines are not processed", err)
+	}
 	s.handler = NewHandler(s.ctx, s.logger,
 		WithHandlerSettings(s.settings),
-		WithHandlerFwdChannel(make(chan *service.Record, BufferSize)),
+		WithHandlerFwdChannel(make(chan *service.Record,
+			settingsBufferSize(s.logger, "_writer_buffer_size", s.settings.GetXWriterBufferSize(), BufferSize),
+		)),
 		WithHandlerOutChannel(make(chan *service.Result, BufferSize)),
 		WithHandlerSystemMonitor(monitor.NewSystemMonitor(s.logger, s.settings, s.loopBackChan)),
 		WithHandlerFileHandler(NewFilesHandler(watcher, s.logger, s.settings)),
 		WithHandlerTBHandler(NewTBHandler(watcher, s.logger, s.settings, s.loopBackChan)),
 		WithHandlerFilesInfoHandler(NewFilesInfoHandler()),
-		WithHandlerSummaryHandler(NewSummaryHandler(s.logger)),
+		WithHandlerSummaryHandler(NewSummaryHandler(s.logger, s.settings)),
 		WithHandlerMetricHandler(NewMetricHandler()),
+		WithHandlerStatsWindow(statsWindow),
+		WithHandlerConsole(console),
+		WithHandlerFlowControl(NewFlowControl(s.capacity)),
 		WithHandlerWatcher(watcher),
 	)
 
+	connectivity := NewConnectivity()
+	priorityChan := make(chan *service.Record, BufferSize)
 	s.writer = NewWriter(s.ctx, s.logger,
 		WithWriterSettings(s.settings),
-		WithWriterFwdChannel(make(chan *service.Record, BufferSize)),
+		WithWriterConnectivity(connectivity),
+		WithWriterPriorityChannel(priorityChan),
+		WithWriterFwdChannel(make(chan *service.Record,
+			settingsBufferSize(s.logger, "_sender_buffer_size", s.settings.GetXSenderBufferSize(), BufferSize),
+		)),
 	)
 
 	s.sender = NewSender(s.ctx, s.cancel, s.logger, s.settings,
 		WithSenderFwdChannel(s.loopBackChan),
+		WithSenderConnectivity(connectivity),
+		WithSenderPriorityChannel(priorityChan),
 		WithSenderOutChannel(make(chan *service.Result, BufferSize)),
 	)
 
 	s.dispatcher = NewDispatcher(s.logger)
 
+	if s.capture, err = newCapture(s.ctx, s.settings, s.logger); err != nil {
+		s.logger.CaptureError("stream: the records are not captured", err)
+	}
+
 	s.logger.Info("created new stream", "id", s.settings.RunId)
 	return s
 }
 
+// capacity returns the number of records the queues of the writer and the
+// sender can take, the credits of the clients are granted from it
+func (s *Stream) capacity() int {
+	return min(
+		cap(s.handler.fwdChan)-len(s.handler.fwdChan),
+		cap(s.writer.fwdChan)-len(s.writer.fwdChan),
+	)
+}
+
 // AddResponders adds the given responders to the stream's dispatcher.
 func (s *Stream) AddResponders(entries ...ResponderEntry) {
 	s.dispatcher.AddResponders(entries...)
 }
 
+// Detach removes the responder of a connection from a shared stream, unless
+// it is the last one. It returns false if the stream must be closed.
+func (s *Stream) Detach(id string) bool {
+	if !s.settings.GetXShared().GetValue() {
+		return false
+	}
+	return s.dispatcher.RemoveResponder(id)
+}
+
 // Start starts the stream's handler, writer, sender, and dispatcher.
 // We use Stream's wait group to ensure that all of these components are cleanly
 // finalized and closed when the stream is closed in Stream.Close().
@@ -121,8 +204,9 @@ func (s *Stream) Start() {
 		for _, ch := range []chan *service.Record{s.inChan, s.loopBackChan} {
 			wg.Add(1)
 			go func(ch chan *service.Record) {
+				loopback := ch == s.loopBackChan
 				for record := range ch {
-					fwdChan <- record
+					s.capture.Forward(record, loopback, fwdChan)
 				}
 				wg.Done()
 			}(ch)
@@ -143,6 +227,7 @@ func (s *Stream) Start() {
 	s.wg.Add(1)
 	go func() {
 		s.writer.Do(s.handler.fwdChan)
+		close(s.writerDone)
 		s.wg.Done()
 	}()
 
@@ -188,9 +273,42 @@ func (s *Stream) GetRun() *service.RunRecord {
 func (s *Stream) Close() {
 	// wait for the context to be canceled in the defer state machine in the sender
 	<-s.ctx.Done()
+	s.closeMu.Lock()
+	s.closed = true
 	close(s.loopBackChan)
 	close(s.inChan)
+	s.closeMu.Unlock()
 	s.wg.Wait()
+	s.capture.Close()
+}
+
+// Preempt marks the run of the stream as preempting, unless the stream is
+// closed
+func (s *Stream) Preempt() {
+	s.closeMu.Lock()
+	defer s.closeMu.Unlock()
+	if s.closed {
+		return
+	}
+	s.logger.Info("stream: the run is preempting", "id", s.settings.RunId)
+	s.inChan <- &service.Record{
+		RecordType: &service.Record_Preempting{Preempting: &service.RunPreemptingRecord{}},
+		Control:    &service.Control{AlwaysSend: true},
+	}
+}
+
+// Abort stops the writer of the stream before the process stops, so that
+// the records it accepted are flushed to the store. It waits for the writer
+// for at most timeout.
+func (s *Stream) Abort(timeout time.Duration) {
+	s.logger.Info("stream: aborting the writer", "id", s.settings.RunId)
+	s.writer.Abort()
+	select {
+	case <-s.writerDone:
+	case <-time.After(timeout):
+		s.logger.CaptureWarn("stream: the writer did not stop in time",
+			"id", s.settings.RunId, "timeout", timeout)
+	}
 }
 
 // Respond Handle internal responses like from the finish and close path
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"log/slog"
 	"sync"
+	"time"
 )
 
 // StreamMux is a multiplexer for streams.
@@ -57,6 +58,32 @@ func (sm *StreamMux) RemoveStream(streamId string) (*Stream, error) {
 	}
 }
 
+// PreemptAllStreams marks the runs of all the streams as preempting
+func (sm *StreamMux) PreemptAllStreams() {
+	sm.mutex.RLock()
+	defer sm.mutex.RUnlock()
+	for _, stream := range sm.mux {
+		stream.Preempt()
+	}
+}
+
+// AbortAllStreams aborts the writers of all the streams at once, and waits
+// for each for at most timeout
+func (sm *StreamMux) AbortAllStreams(timeout time.Duration) {
+	sm.mutex.RLock()
+	defer sm.mutex.RUnlock()
+
+	wg := sync.WaitGroup{}
+	for _, stream := range sm.mux {
+		wg.Add(1)
+		go func(stream *Stream) {
+			stream.Abort(timeout)
+			wg.Done()
+		}(stream)
+	}
+	wg.Wait()
+}
+
 // FinishAndCloseAllStreams closes all streams in the mux.
 func (sm *StreamMux) FinishAndCloseAllStreams(exitCode int32) {
 	sm.mutex.RLock()
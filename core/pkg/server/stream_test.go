@@ -0,0 +1,57 @@
+package server_test
+
+import (
+	"context"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	"github.com/wandb/wandb/core/pkg/backendtest"
+	"github.com/wandb/wandb/core/pkg/server"
+	"github.com/wandb/wandb/core/pkg/service"
+)
+
+func TestStreamGoOnline(t *testing.T) {
+	backend := backendtest.NewServer()
+	defer backend.Close()
+
+	dir := t.TempDir()
+	settings := makeBackendSettings(backend, dir)
+	settings.XOffline = &wrapperspb.BoolValue{Value: true}
+	settings.SyncFile = &wrapperspb.StringValue{Value: filepath.Join(dir, "run.wandb")}
+	settings.LogInternal = &wrapperspb.StringValue{Value: filepath.Join(dir, "debug-internal.log")}
+	settings.LogDir = &wrapperspb.StringValue{Value: dir}
+
+	stream := server.NewStream(context.Background(), settings, "run1")
+	stream.Start()
+
+	run := makeBackendRunRecord()
+	run.Control = nil
+	stream.HandleRecord(run)
+	stream.HandleRecord(&service.Record{RecordType: &service.Record_History{History: &service.HistoryRecord{
+		Item: []*service.HistoryItem{{Key: "loss", ValueJson: "0.5"}},
+	}}})
+	stream.HandleRecord(&service.Record{RecordType: &service.Record_Request{Request: &service.Request{
+		RequestType: &service.Request_GoOnline{GoOnline: &service.GoOnlineRequest{}},
+	}}})
+	stream.FinishAndClose(0)
+
+	// the run logged while offline is created and its history is streamed
+	upserts := backend.GraphQLRequests("UpsertBucket")
+	if assert.NotEmpty(t, upserts) {
+		assert.Equal(t, "testProject", upserts[0].Variables["project"])
+	}
+	var history []string
+	var complete bool
+	for _, data := range backend.FileStreamRequests() {
+		history = append(history, data.Files["wandb-history.jsonl"].Content...)
+		complete = complete || data.Complete != nil && *data.Complete
+	}
+	if assert.Len(t, history, 1) {
+		assert.True(t, strings.Contains(history[0], `"loss":0.5`), history[0])
+	}
+	assert.True(t, complete)
+}
@@ -1,6 +1,11 @@
 package server
 
 import (
+	"errors"
+	"time"
+
+	"github.com/segmentio/encoding/json"
+
 	"github.com/wandb/wandb/core/internal/debounce"
 	"github.com/wandb/wandb/core/pkg/observability"
 	"github.com/wandb/wandb/core/pkg/service"
@@ -14,16 +19,20 @@ type SummaryHandler struct {
 	// summaryDelta is the delta summary (keys updated since the last time we sent summary)
 	summaryDelta map[string]string
 
+	// summaryRemoved are the keys removed since the last time we sent summary
+	summaryRemoved map[string]struct{}
+
 	// summaryDebouncer is the debouncer for summary updates
 	summaryDebouncer *debounce.Debouncer
 }
 
-func NewSummaryHandler(logger *observability.CoreLogger) *SummaryHandler {
+func NewSummaryHandler(logger *observability.CoreLogger, settings *service.Settings) *SummaryHandler {
 	return &SummaryHandler{
 		consolidatedSummary: make(map[string]string),
 		summaryDelta:        make(map[string]string),
+		summaryRemoved:      make(map[string]struct{}),
 		summaryDebouncer: debounce.NewDebouncer(
-			summaryDebouncerRateLimit,
+			debounceLimit(settings, summaryDebouncerRateLimit),
 			summaryDebouncerBurstSize,
 			logger,
 		),
@@ -37,13 +46,120 @@ func (sh *SummaryHandler) Debounce(f func()) {
 	sh.summaryDebouncer.Debounce(f)
 }
 
+// Ready returns a channel that receives once the pending summary update can
+// be sent, nil if there is none
+func (sh *SummaryHandler) Ready() <-chan time.Time {
+	if sh == nil {
+		return nil
+	}
+	return sh.summaryDebouncer.Ready()
+}
+
 func (sh *SummaryHandler) Flush(f func()) {
+	if sh == nil {
+		return
+	}
 	sh.summaryDebouncer.Flush(f)
 }
 
 func (sh *SummaryHandler) updateSummaryDelta(summaryRecord *service.Record) {
 	for _, item := range summaryRecord.GetSummary().GetUpdate() {
 		sh.summaryDelta[item.GetKey()] = item.GetValueJson()
+		delete(sh.summaryRemoved, item.GetKey())
+	}
+	sh.summaryDebouncer.SetNeedsDebounce()
+}
+
+// applySummary reconciles the consolidated summary with a summary record
+// sent by the user. A nested key updates or removes a value inside the
+// object of its top level key, the delta has the top level keys only.
+func (sh *SummaryHandler) applySummary(summary *service.SummaryRecord) error {
+	var errs []error
+	for _, item := range summary.GetRemove() {
+		path := itemPath(item)
+		key := path[0]
+		if len(path) == 1 {
+			delete(sh.consolidatedSummary, key)
+			delete(sh.summaryDelta, key)
+			sh.summaryRemoved[key] = struct{}{}
+			continue
+		}
+		value, ok := sh.consolidatedSummary[key]
+		if !ok {
+			continue
+		}
+		if updated, removed := removeNestedJSON(json.RawMessage(value), path[1:]); removed {
+			sh.setSummaryValue(key, string(updated))
+		}
+	}
+	for _, item := range summary.GetUpdate() {
+		path := itemPath(item)
+		key := path[0]
+		if len(path) == 1 {
+			sh.setSummaryValue(key, item.GetValueJson())
+			continue
+		}
+		updated, err := setNestedJSON(
+			json.RawMessage(sh.consolidatedSummary[key]), path[1:], json.RawMessage(item.GetValueJson()),
+		)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		sh.setSummaryValue(key, string(updated))
 	}
 	sh.summaryDebouncer.SetNeedsDebounce()
+	return errors.Join(errs...)
+}
+
+// setSummaryValue sets the value of a top level key of the summary
+func (sh *SummaryHandler) setSummaryValue(key, valueJSON string) {
+	sh.consolidatedSummary[key] = valueJSON
+	sh.summaryDelta[key] = valueJSON
+	delete(sh.summaryRemoved, key)
+}
+
+// setNestedJSON returns the JSON object with the value at the path set. The
+// objects on the path are created, replacing values that are not objects.
+func setNestedJSON(object json.RawMessage, path []string, value json.RawMessage) (json.RawMessage, error) {
+	if len(path) == 0 {
+		return value, nil
+	}
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(object, &fields); err != nil || fields == nil {
+		fields = make(map[string]json.RawMessage)
+	}
+	field, err := setNestedJSON(fields[path[0]], path[1:], value)
+	if err != nil {
+		return nil, err
+	}
+	fields[path[0]] = field
+	return json.Marshal(fields)
+}
+
+// removeNestedJSON returns the JSON object without the value at the path,
+// and whether it had one
+func removeNestedJSON(object json.RawMessage, path []string) (json.RawMessage, bool) {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(object, &fields); err != nil || fields == nil {
+		return object, false
+	}
+	field, ok := fields[path[0]]
+	if !ok {
+		return object, false
+	}
+	if len(path) == 1 {
+		delete(fields, path[0])
+	} else {
+		updated, removed := removeNestedJSON(field, path[1:])
+		if !removed {
+			return object, false
+		}
+		fields[path[0]] = updated
+	}
+	encoded, err := json.Marshal(fields)
+	if err != nil {
+		return object, false
+	}
+	return encoded, true
 }
@@ -0,0 +1,384 @@
+package server
+
+import (
+	"crypto/md5"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+
+	"github.com/segmentio/encoding/json"
+
+	"github.com/wandb/wandb/core/pkg/artifacts"
+	"github.com/wandb/wandb/core/pkg/service"
+	"github.com/wandb/wandb/core/pkg/utils"
+)
+
+// defaultTableBufferRows is the number of rows of a table kept in memory
+// before they are spilled to disk, unless _table_buffer_rows is set
+const defaultTableBufferRows = 1000
+
+// tableMediaDir is the directory of the files of the tables, in the files
+// directory of the run
+var tableMediaDir = filepath.Join("media", "table")
+
+// tableArtifactName matches the characters that can't be in the name of the
+// artifact of a table
+var tableArtifactName = regexp.MustCompile(`[^a-zA-Z0-9_\-.]+`)
+
+// The types of the columns of a table
+const (
+	tableTypeAny     = "any"
+	tableTypeNumber  = "number"
+	tableTypeString  = "string"
+	tableTypeBoolean = "boolean"
+)
+
+// table is a table of the history whose rows are appended incrementally. The
+// rows are kept in memory up to a bound, then spilled to a file, so that the
+// whole table is never in memory.
+type table struct {
+	key     string
+	columns []string
+	dtypes  []string
+
+	// rows are the rows not yet spilled, after the spilled ones
+	rows []json.RawMessage
+
+	// spill is the file of the rows spilled, of size spillSize, they are
+	// separated by commas as in the data of the table
+	spill     *os.File
+	spillSize int64
+	spilled   int
+
+	// dirty is set once rows were appended since the table was committed
+	dirty bool
+
+	// warned is set once the client was warned of an invalid row
+	warned bool
+}
+
+// tableFile is the history value of a table, written to a file
+type tableFile struct {
+	Type   string `json:"_type"`
+	Sha256 string `json:"sha256"`
+	Size   int64  `json:"size"`
+	Path   string `json:"path"`
+	NCols  int    `json:"ncols"`
+	NRows  int    `json:"nrows"`
+}
+
+// handleTable appends the rows of a record to its table, and commits the
+// table if the record says so
+func (h *Handler) handleTable(record *service.Record, msg *service.TableRecord) {
+	t, err := h.tableOf(msg)
+	if err != nil {
+		h.logger.CaptureWarn("handler: dropping an invalid table record", "key", msg.GetKey(), "error", err)
+		h.internalMessages.Warning = append(h.internalMessages.Warning,
+			fmt.Sprintf("The rows of the table %s were not logged: %v.", msg.GetKey(), err))
+		return
+	}
+	for _, row := range msg.GetRowsJson() {
+		// the NaN and infinite numbers of the clients are not valid JSON
+		if !json.Valid([]byte(row)) {
+			row = replaceNonFinite(row, nullJSON, nullJSON, nullJSON)
+		}
+		if err := t.validateRow(row); err != nil {
+			if !t.warned {
+				t.warned = true
+				h.internalMessages.Warning = append(h.internalMessages.Warning,
+					fmt.Sprintf("Some rows of the table %s were not logged: %v.", t.key, err))
+			}
+			h.logger.Debug("handler: dropping an invalid table row", "key", t.key, "error", err)
+			continue
+		}
+		if err := t.append(row, h.tableBufferRows(), h.settings.GetTmpDir().GetValue()); err != nil {
+			h.logger.CaptureError("handler: failed to append a table row", err, "key", t.key)
+		}
+	}
+	if msg.GetCommit() {
+		h.withWriterHistory(record, func() { h.commitTable(t) })
+	}
+}
+
+func (h *Handler) tableBufferRows() int {
+	if setting := h.settings.GetXTableBufferRows(); setting != nil && setting.GetValue() > 0 {
+		return int(setting.GetValue())
+	}
+	return defaultTableBufferRows
+}
+
+// tableOf returns the table of a record, the first record of a table sets
+// its schema
+func (h *Handler) tableOf(msg *service.TableRecord) (*table, error) {
+	key := msg.GetKey()
+	if key == "" || !filepath.IsLocal(key) {
+		return nil, fmt.Errorf("the key is not valid")
+	}
+	if t, ok := h.tables[key]; ok {
+		if len(msg.GetColumns()) > 0 && !slices.Equal(msg.GetColumns(), t.columns) {
+			return nil, fmt.Errorf("the columns %v are not the columns %v of the table", msg.GetColumns(), t.columns)
+		}
+		if len(msg.GetDtypes()) > 0 && !slices.Equal(msg.GetDtypes(), t.dtypes) {
+			return nil, fmt.Errorf("the types %v are not the types %v of the table", msg.GetDtypes(), t.dtypes)
+		}
+		return t, nil
+	}
+
+	columns := msg.GetColumns()
+	if len(columns) == 0 {
+		return nil, fmt.Errorf("the first rows of a table must set its columns")
+	}
+	seen := make(map[string]bool, len(columns))
+	for _, column := range columns {
+		if seen[column] {
+			return nil, fmt.Errorf("the column %s is repeated", column)
+		}
+		seen[column] = true
+	}
+	dtypes := msg.GetDtypes()
+	if len(dtypes) == 0 {
+		dtypes = make([]string, len(columns))
+		for i := range dtypes {
+			dtypes[i] = tableTypeAny
+		}
+	}
+	if len(dtypes) != len(columns) {
+		return nil, fmt.Errorf("there are %d types for %d columns", len(dtypes), len(columns))
+	}
+	for _, dtype := range dtypes {
+		switch dtype {
+		case tableTypeAny, tableTypeNumber, tableTypeString, tableTypeBoolean:
+		default:
+			return nil, fmt.Errorf("the type %q is unknown", dtype)
+		}
+	}
+
+	if h.tables == nil {
+		h.tables = make(map[string]*table)
+	}
+	t := &table{key: key, columns: columns, dtypes: dtypes}
+	h.tables[key] = t
+	return t, nil
+}
+
+// validateRow checks that a row has a value of the type of each column, null
+// is a value of any type
+func (t *table) validateRow(row string) error {
+	var values []json.RawMessage
+	if err := json.Unmarshal([]byte(row), &values); err != nil {
+		return fmt.Errorf("a row is not a JSON array")
+	}
+	if len(values) != len(t.columns) {
+		return fmt.Errorf("a row has %d values for %d columns", len(values), len(t.columns))
+	}
+	for i, value := range values {
+		var decoded interface{}
+		if err := json.Unmarshal(value, &decoded); err != nil {
+			return err
+		}
+		ok := true
+		switch decoded.(type) {
+		case nil:
+		case float64:
+			ok = t.dtypes[i] == tableTypeAny || t.dtypes[i] == tableTypeNumber
+		case string:
+			ok = t.dtypes[i] == tableTypeAny || t.dtypes[i] == tableTypeString
+		case bool:
+			ok = t.dtypes[i] == tableTypeAny || t.dtypes[i] == tableTypeBoolean
+		default:
+			ok = t.dtypes[i] == tableTypeAny
+		}
+		if !ok {
+			return fmt.Errorf("the value %s of the column %s is not a %s", value, t.columns[i], t.dtypes[i])
+		}
+	}
+	return nil
+}
+
+// append appends a row to the table, the rows in memory are spilled to a
+// file of dir once there are more than limit
+func (t *table) append(row string, limit int, dir string) error {
+	t.rows = append(t.rows, json.RawMessage(row))
+	t.dirty = true
+	if len(t.rows) <= limit {
+		return nil
+	}
+
+	if t.spill == nil {
+		spill, err := os.CreateTemp(dir, "table-*.json")
+		if err != nil {
+			return err
+		}
+		t.spill = spill
+	}
+	n, err := t.writeRows(t.spill, t.spilled)
+	t.spillSize += n
+	if err != nil {
+		return err
+	}
+	t.spilled += len(t.rows)
+	t.rows = nil
+	return nil
+}
+
+// writeRows writes the rows in memory, the first of which is the row index
+// of the table, it returns the number of bytes written
+func (t *table) writeRows(w io.Writer, index int) (int64, error) {
+	var written int64
+	for i, row := range t.rows {
+		if index+i > 0 {
+			n, err := io.WriteString(w, ",")
+			written += int64(n)
+			if err != nil {
+				return written, err
+			}
+		}
+		n, err := w.Write(row)
+		written += int64(n)
+		if err != nil {
+			return written, err
+		}
+	}
+	return written, nil
+}
+
+// writeTo writes the table in the format of the tables of the history
+func (t *table) writeTo(w io.Writer) error {
+	columns, err := json.Marshal(t.columns)
+	if err != nil {
+		return err
+	}
+	if _, err := fmt.Fprintf(w, `{"_type":"table","columns":%s,"data":[`, columns); err != nil {
+		return err
+	}
+	if t.spill != nil {
+		if _, err := io.Copy(w, io.NewSectionReader(t.spill, 0, t.spillSize)); err != nil {
+			return err
+		}
+	}
+	if _, err := t.writeRows(w, t.spilled); err != nil {
+		return err
+	}
+	_, err = fmt.Fprintf(w, `],"ncols":%d,"nrows":%d}`, len(t.columns), t.spilled+len(t.rows))
+	return err
+}
+
+func (t *table) close() {
+	if t.spill == nil {
+		return
+	}
+	t.spill.Close()
+	os.Remove(t.spill.Name())
+	t.spill = nil
+}
+
+// commitTable writes a table to the media of the run, and logs it to the
+// history at the current step and to an artifact of the run
+func (h *Handler) commitTable(t *table) {
+	t.dirty = false
+	path, sha256Hex, md5B64, size, err := h.writeTable(t)
+	if err != nil {
+		h.logger.CaptureError("handler: failed to write a table", err, "key", t.key)
+		return
+	}
+	rel, err := filepath.Rel(h.settings.GetFilesDir().GetValue(), path)
+	if err != nil {
+		h.logger.CaptureError("handler: failed to write a table", err, "key", t.key)
+		return
+	}
+
+	h.handleFiles(&service.Record{RecordType: &service.Record_Files{Files: &service.FilesRecord{
+		Files: []*service.FilesItem{{Path: filepath.ToSlash(rel), Type: service.FilesItem_MEDIA}},
+	}}})
+
+	value, err := json.Marshal(tableFile{
+		Type:   "table-file",
+		Sha256: sha256Hex,
+		Size:   size,
+		Path:   filepath.ToSlash(rel),
+		NCols:  len(t.columns),
+		NRows:  t.spilled + len(t.rows),
+	})
+	if err != nil {
+		h.logger.CaptureError("handler: failed to log a table", err, "key", t.key)
+		return
+	}
+	h.handlePartialHistory(nil, &service.PartialHistoryRequest{
+		Item:   []*service.HistoryItem{{Key: t.key, ValueJson: string(value)}},
+		Action: &service.HistoryAction{Flush: false},
+	})
+
+	builder := artifacts.NewArtifactBuilder(&service.ArtifactRecord{
+		Entity:           h.settings.GetEntity().GetValue(),
+		Project:          h.settings.GetProject().GetValue(),
+		RunId:            h.settings.GetRunId().GetValue(),
+		Name:             tableArtifactName.ReplaceAllString(fmt.Sprintf("run-%s-%s", h.settings.GetRunId().GetValue(), t.key), ""),
+		Type:             "run_table",
+		Finalize:         true,
+		ClientId:         utils.GenerateAlphanumericSequence(128),
+		SequenceClientId: utils.GenerateAlphanumericSequence(128),
+	})
+	builder.AddEntry(filepath.ToSlash(t.key)+".table.json", path, md5B64, size)
+	h.sendRecord(&service.Record{RecordType: &service.Record_Artifact{Artifact: builder.GetArtifact()}})
+}
+
+// writeTable writes a table to the media of the run, the name of its file
+// has the digest of its content. It returns the path of the file with its
+// digests and size.
+func (h *Handler) writeTable(t *table) (string, string, string, int64, error) {
+	dir := filepath.Join(h.settings.GetFilesDir().GetValue(), tableMediaDir, filepath.Dir(t.key))
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return "", "", "", 0, err
+	}
+	f, err := os.CreateTemp(dir, ".table-*")
+	if err != nil {
+		return "", "", "", 0, err
+	}
+	defer os.Remove(f.Name())
+
+	sha256Hash, md5Hash := sha256.New(), md5.New()
+	err = t.writeTo(io.MultiWriter(f, sha256Hash, md5Hash))
+	if err == nil {
+		err = f.Sync()
+	}
+	info, statErr := f.Stat()
+	if err == nil {
+		err = statErr
+	}
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return "", "", "", 0, err
+	}
+
+	sha256Hex := utils.EncodeBytesAsHex(sha256Hash.Sum(nil))
+	step := int64(0)
+	if h.activeHistory != nil {
+		step = h.activeHistory.GetStep().GetNum()
+	} else if h.runRecord != nil {
+		step = h.runRecord.StartingStep
+	}
+	path := filepath.Join(dir, fmt.Sprintf("%s_%d_%s.table.json", filepath.Base(t.key), step, sha256Hex[:20]))
+	if err := os.Rename(f.Name(), path); err != nil {
+		return "", "", "", 0, err
+	}
+	return path, sha256Hex, base64.StdEncoding.EncodeToString(md5Hash.Sum(nil)), info.Size(), nil
+}
+
+// commitTables commits the tables with rows appended since they were last
+// committed, and closes them, once the run exits
+func (h *Handler) commitTables(record *service.Record) {
+	for _, t := range h.tables {
+		if t.dirty {
+			h.withWriterHistory(record, func() { h.commitTable(t) })
+		}
+		t.close()
+	}
+	h.tables = nil
+}
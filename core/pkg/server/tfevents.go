@@ -0,0 +1,270 @@
+package server
+
+import (
+	"bufio"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"io"
+	"math"
+
+	"google.golang.org/protobuf/encoding/protowire"
+)
+
+// The TensorBoard event files are TFRecord files of tensorflow.Event
+// messages. The messages are decoded field by field, as only the scalar
+// summaries are imported and the TensorFlow protos are not part of the core.
+const (
+	eventWallTimeField = 1
+	eventStepField     = 2
+	eventSummaryField  = 5
+
+	summaryValueField = 1
+
+	valueTagField    = 1
+	valueSimpleField = 2
+	valueTensorField = 8
+
+	tensorDtypeField   = 1
+	tensorContentField = 4
+	tensorFloatField   = 5
+	tensorDoubleField  = 6
+	tensorIntField     = 7
+	tensorInt64Field   = 10
+
+	dtypeFloat  = 1
+	dtypeDouble = 2
+	dtypeInt32  = 3
+	dtypeInt64  = 9
+)
+
+// maxTFRecordSize is the largest TFRecord accepted, so that a corrupt length
+// does not make the reader allocate unbounded memory
+const maxTFRecordSize = 256 << 20
+
+var tfrecordTable = crc32.MakeTable(crc32.Castagnoli)
+
+// maskedCRC is the masked CRC-32C of the TFRecord format
+func maskedCRC(data []byte) uint32 {
+	crc := crc32.Checksum(data, tfrecordTable)
+	return ((crc >> 15) | (crc << 17)) + 0xa282ead8
+}
+
+// tfrecordReader reads the records of a TFRecord file
+type tfrecordReader struct {
+	reader *bufio.Reader
+}
+
+func newTFRecordReader(r io.Reader) *tfrecordReader {
+	return &tfrecordReader{reader: bufio.NewReader(r)}
+}
+
+// next returns the next record, or io.EOF at the end of the file
+func (r *tfrecordReader) next() ([]byte, error) {
+	var header [12]byte
+	if _, err := io.ReadFull(r.reader, header[:]); err != nil {
+		if err == io.ErrUnexpectedEOF {
+			return nil, fmt.Errorf("tfrecord: truncated header")
+		}
+		return nil, err
+	}
+	if maskedCRC(header[:8]) != binary.LittleEndian.Uint32(header[8:]) {
+		return nil, errors.New("tfrecord: length checksum mismatch")
+	}
+	length := binary.LittleEndian.Uint64(header[:8])
+	if length > maxTFRecordSize {
+		return nil, fmt.Errorf("tfrecord: record of %d bytes is too large", length)
+	}
+	data := make([]byte, length+4)
+	if _, err := io.ReadFull(r.reader, data); err != nil {
+		return nil, fmt.Errorf("tfrecord: truncated record: %w", err)
+	}
+	data, crc := data[:length], binary.LittleEndian.Uint32(data[length:])
+	if maskedCRC(data) != crc {
+		return nil, errors.New("tfrecord: data checksum mismatch")
+	}
+	return data, nil
+}
+
+// tbScalar is a scalar summary value of an event
+type tbScalar struct {
+	tag   string
+	value float64
+}
+
+// tbEvent is the part of an event that is imported
+type tbEvent struct {
+	wallTime float64
+	step     int64
+	scalars  []tbScalar
+	// skipped is the number of summary values that are not scalars
+	skipped int
+}
+
+// parseTBEvent decodes an encoded tensorflow.Event
+func parseTBEvent(data []byte) (tbEvent, error) {
+	event := tbEvent{}
+	err := eachField(data, func(num protowire.Number, typ protowire.Type, value []byte) error {
+		switch {
+		case num == eventWallTimeField && typ == protowire.Fixed64Type:
+			bits, _ := protowire.ConsumeFixed64(value)
+			event.wallTime = math.Float64frombits(bits)
+		case num == eventStepField && typ == protowire.VarintType:
+			step, _ := protowire.ConsumeVarint(value)
+			event.step = int64(step)
+		case num == eventSummaryField && typ == protowire.BytesType:
+			return parseTBSummary(value, &event)
+		}
+		return nil
+	})
+	return event, err
+}
+
+func parseTBSummary(data []byte, event *tbEvent) error {
+	return eachField(data, func(num protowire.Number, typ protowire.Type, value []byte) error {
+		if num != summaryValueField || typ != protowire.BytesType {
+			return nil
+		}
+		scalar, ok, err := parseTBValue(value)
+		if err != nil {
+			return err
+		}
+		if ok {
+			event.scalars = append(event.scalars, scalar)
+		} else {
+			event.skipped++
+		}
+		return nil
+	})
+}
+
+// parseTBValue decodes a summary value, it reports whether the value is a
+// scalar
+func parseTBValue(data []byte) (tbScalar, bool, error) {
+	scalar := tbScalar{}
+	found := false
+	err := eachField(data, func(num protowire.Number, typ protowire.Type, value []byte) error {
+		switch {
+		case num == valueTagField && typ == protowire.BytesType:
+			scalar.tag = string(value)
+		case num == valueSimpleField && typ == protowire.Fixed32Type:
+			bits, _ := protowire.ConsumeFixed32(value)
+			scalar.value = float64(math.Float32frombits(bits))
+			found = true
+		case num == valueTensorField && typ == protowire.BytesType:
+			var err error
+			scalar.value, found, err = parseTBTensor(value)
+			return err
+		}
+		return nil
+	})
+	return scalar, found && scalar.tag != "", err
+}
+
+// parseTBTensor decodes a tensor, it reports whether the tensor holds a
+// single number
+func parseTBTensor(data []byte) (float64, bool, error) {
+	var dtype uint64
+	var content []byte
+	var values []float64
+	err := eachField(data, func(num protowire.Number, typ protowire.Type, value []byte) error {
+		switch num {
+		case tensorDtypeField:
+			if typ == protowire.VarintType {
+				dtype, _ = protowire.ConsumeVarint(value)
+			}
+		case tensorContentField:
+			if typ == protowire.BytesType {
+				content = value
+			}
+		case tensorFloatField:
+			return eachPacked(typ, protowire.Fixed32Type, value, func(v []byte) int {
+				bits, n := protowire.ConsumeFixed32(v)
+				values = append(values, float64(math.Float32frombits(bits)))
+				return n
+			})
+		case tensorDoubleField:
+			return eachPacked(typ, protowire.Fixed64Type, value, func(v []byte) int {
+				bits, n := protowire.ConsumeFixed64(v)
+				values = append(values, math.Float64frombits(bits))
+				return n
+			})
+		case tensorIntField:
+			return eachPacked(typ, protowire.VarintType, value, func(v []byte) int {
+				x, n := protowire.ConsumeVarint(v)
+				values = append(values, float64(int32(x)))
+				return n
+			})
+		case tensorInt64Field:
+			return eachPacked(typ, protowire.VarintType, value, func(v []byte) int {
+				x, n := protowire.ConsumeVarint(v)
+				values = append(values, float64(int64(x)))
+				return n
+			})
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, false, err
+	}
+	if len(values) == 1 {
+		return values[0], true, nil
+	}
+	switch {
+	case dtype == dtypeFloat && len(content) == 4:
+		return float64(math.Float32frombits(binary.LittleEndian.Uint32(content))), true, nil
+	case dtype == dtypeDouble && len(content) == 8:
+		return math.Float64frombits(binary.LittleEndian.Uint64(content)), true, nil
+	case dtype == dtypeInt32 && len(content) == 4:
+		return float64(int32(binary.LittleEndian.Uint32(content))), true, nil
+	case dtype == dtypeInt64 && len(content) == 8:
+		return float64(int64(binary.LittleEndian.Uint64(content))), true, nil
+	}
+	return 0, false, nil
+}
+
+// eachField calls fn with the number, type and value of each field of an
+// encoded message. The value of a varint or fixed field is its encoding.
+func eachField(data []byte, fn func(protowire.Number, protowire.Type, []byte) error) error {
+	for len(data) > 0 {
+		num, typ, n := protowire.ConsumeTag(data)
+		if n < 0 {
+			return protowire.ParseError(n)
+		}
+		data = data[n:]
+		size := protowire.ConsumeFieldValue(num, typ, data)
+		if size < 0 {
+			return protowire.ParseError(size)
+		}
+		value := data[:size]
+		if typ == protowire.BytesType {
+			value, _ = protowire.ConsumeBytes(value)
+		}
+		if err := fn(num, typ, value); err != nil {
+			return err
+		}
+		data = data[size:]
+	}
+	return nil
+}
+
+// eachPacked calls consume for each element of a repeated scalar field,
+// which is either packed or a single element of the element type
+func eachPacked(typ, elem protowire.Type, value []byte, consume func([]byte) int) error {
+	if typ == elem {
+		consume(value)
+		return nil
+	}
+	if typ != protowire.BytesType {
+		return nil
+	}
+	for len(value) > 0 {
+		n := consume(value)
+		if n < 0 {
+			return protowire.ParseError(n)
+		}
+		value = value[n:]
+	}
+	return nil
+}
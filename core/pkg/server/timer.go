@@ -25,6 +25,11 @@ func (t *Timer) Start(startTime *time.Time) {
 	t.isStarted = true
 }
 
+// Accumulate adds the runtime of a previous session of the run, when it is resumed
+func (t *Timer) Accumulate(runtime time.Duration) {
+	t.accumulated += runtime
+}
+
 func (t *Timer) Pause() {
 	if !t.isPaused {
 		elapsed := time.Since(t.resumeTime)
@@ -0,0 +1,95 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+
+	"github.com/wandb/wandb/core/pkg/observability"
+	"github.com/wandb/wandb/core/pkg/service"
+)
+
+// maxVerifyErrors is the number of errors kept in a verify report, so that a
+// badly corrupted store does not make the report unbounded
+const maxVerifyErrors = 100
+
+// VerifyStore walks the store whose first segment is fileName, across its
+// segments or shards, and reports the records it holds. Records whose
+// framing, checksum or encoding is invalid are counted as corrupt and
+// skipped. The report also lists the ranges of record numbers that are
+// missing, and counts the records whose number is not above the previous
+// one. Compacted stores have gaps where the superseded records were.
+//
+// An error is only returned if the store can't be read at all, e.g. if its
+// header is invalid.
+func VerifyStore(
+	ctx context.Context,
+	fileName string,
+	logger *observability.CoreLogger,
+	opts StoreOptions,
+) (*service.VerifyReport, error) {
+	reader := NewSegmentReaderWithOptions(ctx, fileName, logger, opts)
+	if err := reader.Open(); err != nil {
+		return nil, err
+	}
+	defer reader.Close()
+
+	report := &service.VerifyReport{}
+	counts := map[string]int64{}
+	var last int64
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		} else if errors.Is(err, ErrCorruptRecord) {
+			report.CorruptRecords++
+			addVerifyError(report, err.Error())
+			continue
+		} else if err != nil {
+			return nil, err
+		}
+
+		report.Records++
+		counts[recordTypeName(record)]++
+		switch {
+		case record.Num <= last:
+			report.OutOfOrder++
+			addVerifyError(report, fmt.Sprintf("record %d follows record %d", record.Num, last))
+			continue
+		case record.Num > last+1:
+			report.Gaps = append(report.Gaps, &service.VerifyReport_Gap{First: last + 1, Last: record.Num - 1})
+		}
+		last = record.Num
+	}
+
+	names := make([]string, 0, len(counts))
+	for name := range counts {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		report.RecordCounts = append(report.RecordCounts,
+			&service.VerifyReport_RecordCount{RecordType: name, Count: counts[name]},
+		)
+	}
+	return report, nil
+}
+
+// addVerifyError adds an error to the report, up to maxVerifyErrors
+func addVerifyError(report *service.VerifyReport, message string) {
+	if len(report.Errors) < maxVerifyErrors {
+		report.Errors = append(report.Errors, message)
+	}
+}
+
+// verifyError returns an error describing the problems found by a verify
+// report, or nil if the store has no corrupt or out of order records
+func verifyError(report *service.VerifyReport) error {
+	if report.GetCorruptRecords() == 0 && report.GetOutOfOrder() == 0 {
+		return nil
+	}
+	return fmt.Errorf("store verification failed: %d corrupt records, %d out of order records",
+		report.GetCorruptRecords(), report.GetOutOfOrder())
+}
@@ -0,0 +1,86 @@
+package server_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	"github.com/wandb/wandb/core/pkg/observability"
+	"github.com/wandb/wandb/core/pkg/server"
+	"github.com/wandb/wandb/core/pkg/service"
+)
+
+func TestVerifyStore(t *testing.T) {
+	fileName := filepath.Join(t.TempDir(), "run.wandb")
+	settings := &service.Settings{SyncFile: &wrapperspb.StringValue{Value: fileName}}
+	runWriter(t, compactRecords(), server.WithWriterSettings(settings))
+
+	report, err := server.VerifyStore(context.Background(), fileName,
+		observability.NewNoOpLogger(), server.StoreOptions{},
+	)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(10), report.GetRecords())
+	assert.Zero(t, report.GetCorruptRecords())
+	assert.Zero(t, report.GetOutOfOrder())
+	assert.Empty(t, report.GetGaps())
+
+	counts := map[string]int64{}
+	for _, count := range report.GetRecordCounts() {
+		counts[count.GetRecordType()] = count.GetCount()
+	}
+	assert.Equal(t, map[string]int64{"run": 1, "config": 2, "summary": 2, "output_raw": 4, "exit": 1}, counts)
+}
+
+func TestVerifyStoreCompacted(t *testing.T) {
+	fileName := filepath.Join(t.TempDir(), "run.wandb")
+	settings := &service.Settings{SyncFile: &wrapperspb.StringValue{Value: fileName}}
+	runWriter(t, compactRecords(), server.WithWriterSettings(settings))
+	_, err := server.CompactStore(context.Background(), fileName, "",
+		observability.NewNoOpLogger(), server.StoreOptions{},
+	)
+	assert.NoError(t, err)
+
+	report, err := server.VerifyStore(context.Background(), fileName,
+		observability.NewNoOpLogger(), server.StoreOptions{},
+	)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(7), report.GetRecords())
+	var gaps [][2]int64
+	for _, gap := range report.GetGaps() {
+		gaps = append(gaps, [2]int64{gap.GetFirst(), gap.GetLast()})
+	}
+	assert.Equal(t, [][2]int64{{2, 3}, {5, 5}}, gaps)
+}
+
+func TestVerifyStoreCorrupt(t *testing.T) {
+	fileName := filepath.Join(t.TempDir(), "run.wandb")
+	settings := &service.Settings{SyncFile: &wrapperspb.StringValue{Value: fileName}}
+	runWriter(t, makeOutputRecords(10), server.WithWriterSettings(settings))
+
+	data, err := os.ReadFile(fileName)
+	assert.NoError(t, err)
+	data[len(data)/2] ^= 0xff
+	assert.NoError(t, os.WriteFile(fileName, data, 0644))
+
+	report, err := server.VerifyStore(context.Background(), fileName,
+		observability.NewNoOpLogger(), server.StoreOptions{},
+	)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), report.GetCorruptRecords())
+	assert.NotEmpty(t, report.GetErrors())
+	assert.Less(t, report.GetRecords(), int64(10))
+}
+
+func TestVerifyStoreInvalidHeader(t *testing.T) {
+	fileName := filepath.Join(t.TempDir(), "run.wandb")
+	assert.NoError(t, os.WriteFile(fileName, []byte("not a store"), 0644))
+
+	_, err := server.VerifyStore(context.Background(), fileName,
+		observability.NewNoOpLogger(), server.StoreOptions{},
+	)
+	assert.Error(t, err)
+}
@@ -0,0 +1,151 @@
+package server
+
+import (
+	"sync"
+	"sync/atomic"
+
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	"github.com/wandb/wandb/core/pkg/observability"
+)
+
+const (
+	// defaultUploadWorkers is the default number of artifacts the sender
+	// uploads or downloads at the same time
+	defaultUploadWorkers = 2
+
+	// defaultMetadataWorkers is the default number of requests for file
+	// uploads and alerts the sender makes at the same time
+	defaultMetadataWorkers = 4
+
+	// maxPoolWorkers is the largest number of workers of a pool
+	maxPoolWorkers = 64
+)
+
+// WorkerPoolStats are the queue metrics of a worker pool of the sender
+type WorkerPoolStats struct {
+	// Workers is the number of workers of the pool
+	Workers int
+	// Queued is the number of tasks waiting for a worker
+	Queued int
+	// Active is the number of tasks being run
+	Active int64
+	// Completed is the number of tasks that are done
+	Completed int64
+}
+
+// workerPool runs the slow tasks of the sender outside of its loop, so that
+// they don't hold up the records behind them. The tasks of a pool are run
+// by a fixed number of workers, in no particular order.
+type workerPool struct {
+	name   string
+	logger *observability.CoreLogger
+
+	// tasks is the queue of the tasks waiting for a worker
+	tasks chan func()
+
+	// workers is the number of workers
+	workers int
+
+	// pending tracks the tasks that are submitted and not done
+	pending sync.WaitGroup
+
+	// running tracks the workers
+	running sync.WaitGroup
+
+	active    atomic.Int64
+	completed atomic.Int64
+}
+
+func newWorkerPool(
+	name string,
+	logger *observability.CoreLogger,
+	workers int,
+	queueSize int,
+) *workerPool {
+	p := &workerPool{
+		name:    name,
+		logger:  logger,
+		tasks:   make(chan func(), queueSize),
+		workers: workers,
+	}
+	p.running.Add(workers)
+	for i := 0; i < workers; i++ {
+		go p.work()
+	}
+	return p
+}
+
+// poolWorkers returns the number of workers set by a setting, or def if the
+// setting is not set or out of range
+func poolWorkers(
+	logger *observability.CoreLogger,
+	name string,
+	setting *wrapperspb.Int32Value,
+	def int,
+) int {
+	if setting == nil {
+		return def
+	}
+	workers := int(setting.GetValue())
+	if workers < 1 || workers > maxPoolWorkers {
+		logger.CaptureWarn("sender: ignoring workers setting",
+			"setting", name, "workers", workers, "max", maxPoolWorkers)
+		return def
+	}
+	return workers
+}
+
+func (p *workerPool) work() {
+	defer p.running.Done()
+	defer p.logger.Reraise()
+	for task := range p.tasks {
+		p.active.Add(1)
+		task()
+		p.active.Add(-1)
+		p.completed.Add(1)
+		p.pending.Done()
+	}
+}
+
+// submit queues a task, it blocks while the queue is full. A nil pool runs
+// the task right away.
+func (p *workerPool) submit(task func()) {
+	if p == nil {
+		task()
+		return
+	}
+	p.pending.Add(1)
+	p.tasks <- task
+}
+
+// wait blocks until the submitted tasks are done
+func (p *workerPool) wait() {
+	if p == nil {
+		return
+	}
+	p.pending.Wait()
+}
+
+// close waits for the submitted tasks and stops the workers
+func (p *workerPool) close() {
+	if p == nil {
+		return
+	}
+	close(p.tasks)
+	p.running.Wait()
+	p.logger.Debug("sender: worker pool closed", "pool", p.name, "completed", p.completed.Load())
+}
+
+// stats returns the queue metrics of the pool
+func (p *workerPool) stats() WorkerPoolStats {
+	if p == nil {
+		return WorkerPoolStats{}
+	}
+	return WorkerPoolStats{
+		Workers:   p.workers,
+		Queued:    len(p.tasks),
+		Active:    p.active.Load(),
+		Completed: p.completed.Load(),
+	}
+}
@@ -2,8 +2,16 @@ package server
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"io"
 	"os"
+	"sort"
 	"sync"
+	"sync/atomic"
+	"time"
+
+	"google.golang.org/protobuf/proto"
 
 	"github.com/wandb/wandb/core/pkg/observability"
 	"github.com/wandb/wandb/core/pkg/service"
@@ -23,6 +31,325 @@ func WithWriterSettings(settings *service.Settings) WriterOption {
 	}
 }
 
+// WithWriterResume makes the writer append to an existing store and continue
+// the record numbering from the last stored record.
+func WithWriterResume() WriterOption {
+	return func(w *Writer) {
+		w.resume = true
+	}
+}
+
+// WithWriterFlushInterval makes the writer periodically sync the store to
+// stable storage if any records were written since the last sync.
+func WithWriterFlushInterval(interval time.Duration) WriterOption {
+	return func(w *Writer) {
+		w.flushInterval = interval
+	}
+}
+
+// WithWriterMaxSegmentBytes makes the writer rotate the store to a new
+// segment file once the current one would grow beyond maxBytes. Segments are
+// named after the sync file with an increasing suffix (.1, .2, ...) and are
+// listed in a segment index next to it. It takes precedence over the
+// _store_max_segment_bytes setting.
+func WithWriterMaxSegmentBytes(maxBytes int64) WriterOption {
+	return func(w *Writer) {
+		w.maxSegmentBytes = maxBytes
+	}
+}
+
+// WithWriterCompression sets the codec used to compress the stored records,
+// it takes precedence over the _store_compression setting
+func WithWriterCompression(compression Compression) WriterOption {
+	return func(w *Writer) {
+		w.compression = compression
+	}
+}
+
+// WithWriterIndex makes the writer keep a sidecar index of the record offsets
+// next to each store segment, it is also enabled by the _store_index setting
+func WithWriterIndex() WriterOption {
+	return func(w *Writer) {
+		w.index = true
+	}
+}
+
+// WithWriterEmbedSchema makes the writer embed the schema of the records in
+// the header of each store segment, so that the store stays readable after
+// the schema changes. It is also enabled by the _store_embed_schema setting.
+func WithWriterEmbedSchema() WriterOption {
+	return func(w *Writer) {
+		w.embedSchema = true
+	}
+}
+
+// WithWriterMetadata makes the writer write the run ID, entity, project and
+// creation time of the run in the header of each store segment, which
+// identifies the store to tools reading the header only. It is also enabled
+// by the _store_metadata setting.
+func WithWriterMetadata() WriterOption {
+	return func(w *Writer) {
+		w.withMetadata = true
+	}
+}
+
+// WithWriterSnapshotInterval makes the writer store a snapshot record with
+// the merged config and summary and the history counters of the run every
+// interval records, so that readers can start from the last snapshot instead
+// of replaying the whole store. It takes precedence over the
+// _store_snapshot_interval setting.
+func WithWriterSnapshotInterval(interval int64) WriterOption {
+	return func(w *Writer) {
+		w.snapshotInterval = interval
+	}
+}
+
+// WithWriterBackpressureCallback sets a callback that is called with the
+// current depth of the store queue when it fills up past the high-water mark
+// and again when it drains below it.
+func WithWriterBackpressureCallback(callback func(depth int)) WriterOption {
+	return func(w *Writer) {
+		w.backpressureCallback = callback
+	}
+}
+
+// WithWriterDedup makes the writer drop records it has recently processed,
+// so that replayed records are not stored or sent twice.
+func WithWriterDedup() WriterOption {
+	return func(w *Writer) {
+		w.dedup = newRecordDeduper(defaultDedupWindow)
+	}
+}
+
+// WithWriterDedupWindow is like WithWriterDedup, but sets how many recently
+// processed records are remembered.
+func WithWriterDedupWindow(size int) WriterOption {
+	return func(w *Writer) {
+		w.dedup = newRecordDeduper(size)
+	}
+}
+
+// WithWriterRepeatDedup makes the writer not store the records of the given
+// types that are identical to the previous record of the same type. The
+// records are still sent. Types are named after the record_type fields of
+// the Record message, e.g. "config" or "telemetry".
+func WithWriterRepeatDedup(types ...string) WriterOption {
+	return func(w *Writer) {
+		w.repeatDedupTypes = types
+	}
+}
+
+// WithWriterConsoleRetention limits how much console output the writer
+// stores, the lines over the limits are only sent. It takes precedence over
+// the _store_console_max_lines, _store_console_max_bytes and
+// _store_console_window_seconds settings.
+func WithWriterConsoleRetention(retention ConsoleRetention) WriterOption {
+	return func(w *Writer) {
+		w.consoleRetention = retention
+	}
+}
+
+// WithWriterShards makes the writer shard the store over n files written
+// concurrently, choosing the shard of each record by key. A sharded store is
+// not split into segments. It takes precedence over the _store_shards and
+// _store_shard_key settings.
+func WithWriterShards(n int, key ShardKey) WriterOption {
+	return func(w *Writer) {
+		w.shards = n
+		w.shardKey = key
+	}
+}
+
+// WithWriterJournal makes the writer keep a journal of the record numbers it
+// assigned next to the store, so that resuming the store after a crash does
+// not reuse the numbers of records that were lost. It is also enabled by the
+// _store_journal setting.
+func WithWriterJournal() WriterOption {
+	return func(w *Writer) {
+		w.journaled = true
+	}
+}
+
+// WithWriterStoreQueueSize sets how many records can wait to be written to
+// the store before handling records blocks or, with WithWriterSpillDir,
+// spills to disk. It takes precedence over the _store_queue_size setting.
+func WithWriterStoreQueueSize(size int) WriterOption {
+	return func(w *Writer) {
+		w.storeQueueSize = size
+	}
+}
+
+// WithWriterSpillDir makes the writer spill the records that do not fit in
+// the store queue to a temporary file in dir instead of blocking until the
+// store catches up. Records are still stored in order.
+func WithWriterSpillDir(dir string) WriterOption {
+	return func(w *Writer) {
+		w.spillDir = dir
+	}
+}
+
+// WithWriterConnectivity makes the writer spool the records to forward while
+// the backend is unreachable, and forward them once it is reachable again.
+func WithWriterConnectivity(connectivity *Connectivity) WriterOption {
+	return func(w *Writer) {
+		w.connectivity = connectivity
+	}
+}
+
+// WithWriterPriorityChannel makes the writer forward the exit, the alerts and
+// the control requests to the sender on a separate channel, so that they are
+// not held up by the records queued before them.
+func WithWriterPriorityChannel(priority chan *service.Record) WriterOption {
+	return func(w *Writer) {
+		w.priorityChan = priority
+	}
+}
+
+// WithWriterSpoolMaxBytes limits the size of the records spooled while the
+// backend is unreachable, once it is reached the writer waits for the sender
+// again. A limit of zero or less disables spooling. It takes precedence over
+// the _spool_max_bytes setting.
+func WithWriterSpoolMaxBytes(maxBytes int64) WriterOption {
+	return func(w *Writer) {
+		w.spoolMaxBytes = maxBytes
+	}
+}
+
+// WithWriterPersistencePolicy sets the policy deciding which records are
+// stored, it takes precedence over the _store_exclude_types setting
+func WithWriterPersistencePolicy(policy *PersistencePolicy) WriterOption {
+	return func(w *Writer) {
+		w.policy = policy
+	}
+}
+
+// WithWriterStore sets the store used by the writer instead of creating one
+// for the sync file in the settings.
+func WithWriterStore(store RecordStore) WriterOption {
+	return func(w *Writer) {
+		w.store = store
+	}
+}
+
+// WithWriterBatchSize makes the writer sync the store once the given number
+// of records or bytes were written since the last sync, so that a batch of
+// records costs a single fsync. Zero disables the corresponding limit; use
+// WithWriterFlushInterval to also bound the time between syncs.
+func WithWriterBatchSize(records int, bytes int64) WriterOption {
+	return func(w *Writer) {
+		w.batchRecords = records
+		w.batchBytes = bytes
+	}
+}
+
+// WithWriterSyncPolicy sets when the store is synced to stable storage, it
+// takes precedence over the _store_sync_policy setting. Policies other than
+// SyncDefault override the batch size and flush interval that do not apply
+// to them.
+func WithWriterSyncPolicy(policy SyncPolicy) WriterOption {
+	return func(w *Writer) {
+		w.syncPolicy = policy
+	}
+}
+
+// WithWriterMirrorDir makes the writer also write the store to a file of
+// the same name in dir, e.g. on a different disk. Either copy keeps being
+// written if the other one fails. It takes precedence over the
+// _store_mirror_dir setting.
+func WithWriterMirrorDir(dir string) WriterOption {
+	return func(w *Writer) {
+		w.mirrorDir = dir
+	}
+}
+
+// WithWriterDrainTimeout bounds how long closing the writer waits for the
+// queued records to be stored. The records still queued after the timeout
+// are dropped and counted by type in a footer record, so that a slow or
+// stuck store does not block shutdown indefinitely. It takes precedence over
+// the _store_drain_timeout_seconds setting.
+func WithWriterDrainTimeout(timeout time.Duration) WriterOption {
+	return func(w *Writer) {
+		w.drainTimeout = timeout
+	}
+}
+
+// WithWriterStoreBackend sets where the writer persists the store, it takes
+// precedence over the _store_backend setting
+func WithWriterStoreBackend(backend StoreBackend) WriterOption {
+	return func(w *Writer) {
+		w.backend = backend
+	}
+}
+
+// WithWriterMetrics makes the writer log its metrics every interval and, if
+// forward is set, also forward them to the sender as a stats record. The
+// _writer_metrics_interval_seconds setting enables both.
+func WithWriterMetrics(interval time.Duration, forward bool) WriterOption {
+	return func(w *Writer) {
+		w.metricsInterval = interval
+		w.forwardMetrics = forward
+	}
+}
+
+// storeFlushResult is the reply to a store flush request
+type storeFlushResult struct {
+	// segment is the current store segment
+	segment int
+	// err is the error syncing the store
+	err error
+}
+
+// defaultStoreQueueSize is the default capacity of the store queue
+const defaultStoreQueueSize = BufferSize * 8
+
+// storeErrorReportInterval is the minimum time between two reports of store
+// errors to the client, so that a full disk does not flood it with warnings
+const storeErrorReportInterval = 30 * time.Second
+
+// storeHighWaterMark is the fraction of the store queue capacity above which
+// the writer reports backpressure
+const storeHighWaterMark = 0.8
+
+// WriterStats are the counters of the records stored by the writer
+type WriterStats struct {
+	// RecordsWritten is the number of records written to the store
+	RecordsWritten int64
+	// BytesWritten is the number of uncompressed record bytes written
+	BytesWritten int64
+	// PersistedBytes is the number of bytes written to the store files,
+	// after compression and encryption
+	PersistedBytes int64
+	// RecordsByType is the number of records written to the store by record
+	// type, named after the record_type fields of the Record message
+	RecordsByType map[string]int64
+	// QueueDepth is the number of records waiting to be stored
+	QueueDepth int
+	// QueueCapacity is the capacity of the store queue
+	QueueCapacity int
+	// Spilled is the number of records waiting to be stored in the spill file
+	Spilled int
+	// Spooled is the number of records waiting to be forwarded until the
+	// backend is reachable again
+	Spooled int
+	// Errors is the number of records that failed to be stored
+	Errors int64
+	// Dropped is the number of records dropped because they were not
+	// stored before the drain timeout
+	Dropped int64
+	// Repeated is the number of records that were not stored because they
+	// repeated the previous record of their type
+	Repeated int64
+	// ConsoleDropped is the number of console lines that were not stored
+	// because of the console retention
+	ConsoleDropped int64
+	// Syncs is the number of times the store was synced to disk
+	Syncs int64
+	// SyncTime is the total time spent syncing the store
+	SyncTime time.Duration
+	// MaxSyncLatency is the longest store sync
+	MaxSyncLatency time.Duration
+}
+
 // Writer is responsible for writing messages to the append-only log.
 // It receives messages from the handler, processes them,
 // if the message is to be persisted it writes them to the log.
@@ -40,15 +367,228 @@ type Writer struct {
 	// fwdChan is the channel for forwarding messages to the sender
 	fwdChan chan *service.Record
 
+	// priorityChan is the channel for forwarding the priority records to the
+	// sender, ahead of the records in fwdChan
+	priorityChan chan *service.Record
+
 	// storeChan is the channel for messages to be stored
 	storeChan chan *service.Record
 
 	// store is the store for the writer
-	store *Store
+	store RecordStore
+
+	// storeQueueSize is the capacity of the store channel
+	storeQueueSize int
+
+	// spillDir is the directory of the spill file, spilling is disabled if
+	// it is empty
+	spillDir string
+
+	// spill holds the records that did not fit in the store channel
+	spill *spillBuffer
+
+	// connectivity tracks whether the backend is reachable, the records are
+	// only spooled if it is set
+	connectivity *Connectivity
+
+	// spoolMaxBytes is the limit of the size of the spooled records, zero or
+	// less disables spooling
+	spoolMaxBytes int64
+
+	// spool holds the records to forward while the backend is unreachable,
+	// it is only used by the writer loop
+	spool *recordSpool
+
+	// spoolFull is whether the spool was full since it was last replayed
+	spoolFull bool
+
+	// spooled is the number of records in the spool
+	spooled atomic.Int64
 
 	// recordNum is the running count of stored records
 	recordNum int64
 
+	// resume is whether to append to an existing store
+	resume bool
+
+	// flushInterval is how often the store is synced, zero disables it
+	flushInterval time.Duration
+
+	// compression is the codec used to compress the stored records
+	compression Compression
+
+	// index is whether the stores keep a sidecar index of record offsets
+	index bool
+
+	// embedSchema is whether the stores embed the schema of the records
+	embedSchema bool
+
+	// withMetadata is whether the stores carry the metadata of the run
+	withMetadata bool
+
+	// metadata is written in the header of the stores if it is set
+	metadata *StoreMetadata
+
+	// encryptionKey is the key the stores are encrypted with, if set
+	encryptionKey []byte
+
+	// maxSegmentBytes is the size at which the store is rotated, zero
+	// disables rotation
+	maxSegmentBytes int64
+
+	// segment is the number of the current store segment
+	segment int
+
+	// segmentBytes is the approximate number of record bytes in the current
+	// segment
+	segmentBytes int64
+
+	// segmentIndex lists the store segments, it is only kept when the store
+	// is rotated
+	segmentIndex *SegmentIndex
+
+	// offline is whether records are only stored and not forwarded, unless
+	// they must always be sent
+	offline bool
+
+	// forwardedNum is the number of the last stored record that was
+	// forwarded to the sender in order
+	forwardedNum int64
+
+	// abortChan is closed by Abort to stop the writer before its input is
+	// closed
+	abortChan chan struct{}
+	abortOnce sync.Once
+
+	// storeFlushChan receives requests to write and sync all the records
+	// queued to the store, the result is sent on the request channel
+	storeFlushChan chan chan storeFlushResult
+
+	// storeDone is closed once the store is closed
+	storeDone chan struct{}
+
+	// batchRecords is the number of records written after which the store
+	// is synced, zero disables it
+	batchRecords int
+
+	// batchBytes is the number of bytes written after which the store is
+	// synced, zero disables it
+	batchBytes int64
+
+	// syncPolicy decides when the store is synced
+	syncPolicy SyncPolicy
+
+	// mirrorDir is the directory the store is mirrored to, mirroring is
+	// disabled if it is empty
+	mirrorDir string
+
+	// drainTimeout is how long closing waits for the queued records to be
+	// stored, zero waits until they are
+	drainTimeout time.Duration
+
+	// drainExpired is set once the drain timeout expired, the records that
+	// are still queued are dropped
+	drainExpired atomic.Bool
+
+	// dropped is the number of records dropped after the drain timeout
+	dropped atomic.Int64
+
+	// droppedByType counts the dropped records by type, it is only used by
+	// the store goroutine
+	droppedByType map[string]int64
+
+	// dedup drops recently processed records if set
+	dedup *recordDeduper
+
+	// repeatDedupTypes are the record types that are not stored when they
+	// repeat the previous record of their type
+	repeatDedupTypes []string
+
+	// repeatDedup skips storing repeated records if set
+	repeatDedup *repeatDeduper
+
+	// repeated is the number of repeated records that were not stored
+	repeated atomic.Int64
+
+	// journaled is whether the record numbers are journaled
+	journaled bool
+
+	// journal is the journal of the record numbers if it is enabled
+	journal *recordJournal
+
+	// lastStoredNum is the number of the last record written to the store,
+	// it is only used by the store goroutine
+	lastStoredNum int64
+
+	// shards is the number of shards of the store, it is not sharded if it
+	// is less than two
+	shards int
+
+	// shardKey decides the shard of the records of a sharded store
+	shardKey ShardKey
+
+	// backend is where the store is persisted
+	backend StoreBackend
+
+	// snapshotInterval is the number of records between snapshot records,
+	// zero disables snapshots
+	snapshotInterval int64
+
+	// snapshots consolidates the stored records if snapshots are enabled
+	snapshots *snapshotTracker
+
+	// consoleRetention limits the console output stored
+	consoleRetention ConsoleRetention
+
+	// console enforces the console retention if it is enabled
+	console *consoleLimiter
+
+	// consoleDropped is the number of console lines that were not stored
+	// because of the console retention
+	consoleDropped atomic.Int64
+
+	// policy decides which records are stored
+	policy *PersistencePolicy
+
+	// backpressureCallback is called when the store queue crosses the
+	// high-water mark
+	backpressureCallback func(depth int)
+
+	// aboveHighWater is whether the store queue is above the high-water mark
+	aboveHighWater atomic.Bool
+
+	// recordsWritten is the number of records written to the store
+	recordsWritten atomic.Int64
+
+	// bytesWritten is the number of record bytes written to the store
+	bytesWritten atomic.Int64
+
+	// storeErrors is the number of records that failed to be stored
+	storeErrors atomic.Int64
+
+	// storeErrorChan passes the store errors to report from the store
+	// goroutine to the writer loop, which forwards them to the sender
+	storeErrorChan chan error
+
+	// lastStoreErrorReport is when a store error was last reported, it is
+	// only used by the store goroutine
+	lastStoreErrorReport time.Time
+
+	// metrics are the counters of the stored records by type and of the
+	// store syncs
+	metrics writerMetrics
+
+	// metricsInterval is how often the metrics are reported, zero disables
+	// reporting
+	metricsInterval time.Duration
+
+	// forwardMetrics is whether the reported metrics are forwarded to the
+	// sender
+	forwardMetrics bool
+
+	// lastReport is the previous metrics report
+	lastReport metricsReport
+
 	// wg is the wait group for the writer
 	wg sync.WaitGroup
 }
@@ -56,66 +596,893 @@ type Writer struct {
 // NewWriter returns a new Writer
 func NewWriter(ctx context.Context, logger *observability.CoreLogger, opts ...WriterOption) *Writer {
 	w := &Writer{
-		ctx:    ctx,
-		logger: logger,
-		wg:     sync.WaitGroup{},
+		ctx:            ctx,
+		logger:         logger,
+		wg:             sync.WaitGroup{},
+		abortChan:      make(chan struct{}),
+		storeFlushChan: make(chan chan storeFlushResult),
+		storeDone:      make(chan struct{}),
+		storeErrorChan: make(chan error, 1),
 	}
 	for _, opt := range opts {
 		opt(w)
 	}
+	w.offline = w.settings.GetXOffline().GetValue()
+	if w.spoolMaxBytes == 0 {
+		if maxBytes := w.settings.GetXSpoolMaxBytes(); maxBytes != nil {
+			w.spoolMaxBytes = maxBytes.GetValue()
+		} else {
+			w.spoolMaxBytes = defaultSpoolMaxBytes
+		}
+	}
+	if w.storeQueueSize == 0 {
+		w.storeQueueSize = settingsBufferSize(w.logger, "_store_queue_size",
+			w.settings.GetXStoreQueueSize(), defaultStoreQueueSize)
+	}
+	if w.compression == CompressionNone && w.settings.GetXStoreCompression() != nil {
+		compression, err := ParseCompression(w.settings.GetXStoreCompression().GetValue())
+		if err != nil {
+			w.logger.CaptureWarn("writer: ignoring store compression setting", "error", err)
+		}
+		w.compression = compression
+	}
+	if w.policy == nil {
+		w.policy = w.settingsPolicy()
+	}
+	if !w.consoleRetention.enabled() {
+		w.consoleRetention = ConsoleRetention{
+			MaxLines: w.settings.GetXStoreConsoleMaxLines().GetValue(),
+			MaxBytes: w.settings.GetXStoreConsoleMaxBytes().GetValue(),
+			Window:   time.Duration(w.settings.GetXStoreConsoleWindowSeconds().GetValue() * float64(time.Second)),
+		}
+	}
+	if w.consoleRetention.enabled() {
+		w.console = newConsoleLimiter(w.consoleRetention)
+	}
+	if w.repeatDedupTypes == nil {
+		w.repeatDedupTypes = w.settings.GetXStoreDedupTypes().GetValue()
+	}
+	if len(w.repeatDedupTypes) > 0 {
+		dedup, err := newRepeatDeduper(w.repeatDedupTypes)
+		if err != nil {
+			w.logger.CaptureWarn("writer: ignoring store dedup types", "error", err)
+		} else {
+			w.repeatDedup = dedup
+		}
+	}
+	if w.settings.GetXStoreIndex().GetValue() {
+		w.index = true
+	}
+	if w.settings.GetXStoreEmbedSchema().GetValue() {
+		w.embedSchema = true
+	}
+	if w.settings.GetXStoreMetadata().GetValue() {
+		w.withMetadata = true
+	}
+	if w.withMetadata {
+		w.metadata = &StoreMetadata{
+			RunID:     w.settings.GetRunId().GetValue(),
+			Entity:    w.settings.GetEntity().GetValue(),
+			Project:   w.settings.GetProject().GetValue(),
+			CreatedAt: time.Now().UTC(),
+		}
+	}
+	if w.snapshotInterval == 0 {
+		w.snapshotInterval = w.settings.GetXStoreSnapshotInterval().GetValue()
+	}
+	if w.snapshotInterval > 0 {
+		w.snapshots = newSnapshotTracker()
+	}
+	if w.settings.GetXStoreJournal().GetValue() {
+		w.journaled = true
+	}
+	if w.maxSegmentBytes == 0 {
+		w.maxSegmentBytes = w.settings.GetXStoreMaxSegmentBytes().GetValue()
+	}
+	if w.shards == 0 && w.settings.GetXStoreShards().GetValue() > 1 {
+		w.shards = int(w.settings.GetXStoreShards().GetValue())
+		key, err := ParseShardKey(w.settings.GetXStoreShardKey().GetValue())
+		if err != nil {
+			w.logger.CaptureWarn("writer: ignoring store shard key setting", "error", err)
+		}
+		w.shardKey = key
+	}
+	if w.shards > 1 && w.maxSegmentBytes > 0 {
+		w.logger.CaptureWarn("writer: sharded stores are not split into segments",
+			"shards", w.shards, "max_segment_bytes", w.maxSegmentBytes)
+		w.maxSegmentBytes = 0
+	}
+	if w.backend.Kind == BackendFile && w.settings.GetXStoreBackend() != nil {
+		backend, err := ParseStoreBackend(w.settings.GetXStoreBackend().GetValue())
+		if err != nil {
+			w.logger.CaptureWarn("writer: ignoring store backend setting", "error", err)
+		}
+		w.backend = backend
+	}
+	if w.backend.Kind != BackendFile {
+		w.singleStore()
+	}
+	if w.syncPolicy == SyncDefault && w.settings.GetXStoreSyncPolicy() != nil {
+		policy, err := ParseSyncPolicy(w.settings.GetXStoreSyncPolicy().GetValue())
+		if err != nil {
+			w.logger.CaptureWarn("writer: ignoring store sync policy setting", "error", err)
+		}
+		w.syncPolicy = policy
+	}
+	w.applySyncPolicy()
+	if w.mirrorDir == "" && w.backend.Kind == BackendFile {
+		w.mirrorDir = w.settings.GetXStoreMirrorDir().GetValue()
+	}
+	if seconds := w.settings.GetXStoreDrainTimeoutSeconds().GetValue(); w.drainTimeout == 0 && seconds > 0 {
+		w.drainTimeout = time.Duration(seconds * float64(time.Second))
+	}
+	if seconds := w.settings.GetXWriterMetricsIntervalSeconds().GetValue(); w.metricsInterval == 0 && seconds > 0 {
+		w.metricsInterval = time.Duration(seconds * float64(time.Second))
+		w.forwardMetrics = true
+	}
+	if !w.settings.GetXSync().GetValue() {
+		// created up front so that Stats can be called concurrently with Do
+		w.storeChan = make(chan *service.Record, w.storeQueueSize)
+		if w.spillDir != "" {
+			spill, err := newSpillBuffer(w.spillDir)
+			if err != nil {
+				w.logger.CaptureError("writer: error creating spill file, spilling disabled", err)
+			} else {
+				w.spill = spill
+			}
+		}
+	}
 	return w
 }
 
+// singleStore disables the store features that need local sidecar files,
+// which the backends other than local files do not have
+func (w *Writer) singleStore() {
+	if w.maxSegmentBytes > 0 || w.shards > 1 || w.mirrorDir != "" || w.journaled || w.index {
+		w.logger.CaptureWarn("writer: store backend does not support segments, shards, mirrors, journals or indexes",
+			"backend", w.backend)
+	}
+	w.maxSegmentBytes = 0
+	w.shards = 0
+	w.mirrorDir = ""
+	w.journaled = false
+	w.index = false
+}
+
+// settingsPolicy returns the persistence policy configured in the settings,
+// or the default policy if the settings are invalid
+func (w *Writer) settingsPolicy() *PersistencePolicy {
+	policy, err := NewPersistencePolicy(w.settings.GetXStoreExcludeTypes().GetValue())
+	if err != nil {
+		w.logger.CaptureWarn("writer: ignoring store exclude types setting", "error", err)
+		policy, _ = NewPersistencePolicy(nil)
+	}
+	return policy
+}
+
 func (w *Writer) startStore() {
-	if w.settings.GetXSync().GetValue() {
+	if w.storeChan == nil {
 		// do not set up store if we are syncing an offline run
 		return
 	}
 
-	w.storeChan = make(chan *service.Record, BufferSize*8)
-
 	var err error
-	w.store = NewStore(w.ctx, w.settings.GetSyncFile().GetValue(), w.logger)
+	if w.encryptionKey, err = StoreEncryptionKey(w.settings); err != nil {
+		w.logger.CaptureFatalAndPanic("writer: invalid store encryption key", err)
+	}
+	if w.maxSegmentBytes > 0 || (w.resume && w.shards <= 1) {
+		w.loadSegmentIndex()
+	}
+	if w.store == nil && w.shards > 1 {
+		w.store = w.newShardedStore()
+	} else if w.store == nil {
+		w.store = w.newStore(w.segmentName(w.segment), w.resume)
+	}
 	err = w.store.Open(os.O_WRONLY)
 	if err != nil {
 		w.logger.CaptureFatalAndPanic("writer: error creating store", err)
 	}
+	w.recordNum = w.store.LastRecordNum()
+	if w.journaled {
+		w.openJournal()
+	}
+	if w.snapshots != nil && w.resume && w.backend.Kind == BackendFile {
+		w.restoreSnapshot()
+	}
+	if w.resume {
+		if info, err := os.Stat(w.segmentName(w.segment)); err == nil {
+			w.segmentBytes = info.Size()
+		}
+	}
+	if w.segmentIndex != nil {
+		if len(w.segmentIndex.Segments) == 0 {
+			w.segmentIndex.add(w.segmentName(0), w.recordNum+1)
+		}
+		w.saveSegmentIndex()
+	}
 
 	w.wg.Add(1)
 	go func() {
-		for record := range w.storeChan {
-			if err = w.store.Write(record); err != nil {
-				w.logger.Error("writer: error storing record", "error", err)
-			}
-		}
+		w.storeRecords()
 
-		if err = w.store.Close(); err != nil {
+		if w.flushInterval > 0 || w.batchRecords > 0 || w.batchBytes > 0 {
+			_ = w.syncStore()
+		}
+		if err := w.store.Close(); err != nil {
 			w.logger.CaptureError("writer: error closing store", err)
 		}
+		if w.journal != nil {
+			if err := w.journal.close(max(w.recordNum, w.lastStoredNum)); err != nil {
+				w.logger.CaptureError("writer: error closing journal", err)
+			}
+		}
+		if w.spill != nil {
+			if err := w.spill.close(); err != nil {
+				w.logger.CaptureError("writer: error closing spill file", err)
+			}
+		}
+		close(w.storeDone)
 		w.wg.Done()
 	}()
 }
 
+// restoreSnapshot loads the state of the resumed store, so that the next
+// snapshots account for the records stored before
+func (w *Writer) restoreSnapshot() {
+	snapshot, err := LoadStoreSnapshot(w.ctx, w.settings.GetSyncFile().GetValue(), w.logger,
+		StoreOptions{EncryptionKey: w.encryptionKey})
+	if err != nil {
+		w.logger.CaptureWarn("writer: can't load the state of the resumed store", "error", err)
+		return
+	}
+	w.snapshots.restore(snapshot)
+}
+
+// openJournal reconciles the record numbers of a resumed store with its
+// journal and opens the journal. Numbering continues after the highest
+// number that may have been assigned, even if the records were lost, so that
+// no number is assigned twice.
+func (w *Writer) openJournal() {
+	fileName := w.settings.GetSyncFile().GetValue()
+	if w.resume {
+		state, err := loadJournal(fileName)
+		switch {
+		case errors.Is(err, os.ErrNotExist):
+		case err != nil:
+			w.logger.CaptureWarn("writer: ignoring store journal", "error", err)
+		default:
+			if state.Durable > w.recordNum {
+				w.logger.CaptureWarn("writer: records synced before a crash were lost",
+					"first", w.recordNum+1, "last", state.Durable, "stream_id", w.settings.RunId)
+			}
+			if state.Reserved > w.recordNum {
+				w.logger.Info("writer: skipping record numbers reserved before resuming",
+					"first", w.recordNum+1, "last", state.Reserved, "stream_id", w.settings.RunId)
+				w.recordNum = state.Reserved
+			}
+		}
+	}
+	journal, err := openRecordJournal(fileName, w.recordNum)
+	if err != nil {
+		w.logger.CaptureError("writer: error opening journal, journaling disabled", err)
+		return
+	}
+	w.journal = journal
+}
+
+// storeRecords writes the records from the store channel to the store until
+// the channel is closed. The store is synced once a batch is full, or every
+// flush interval if any records were written since the last sync. Spilled
+// records are written once the records queued before them are.
+func (w *Writer) storeRecords() {
+	var tick <-chan time.Time
+	if w.flushInterval > 0 {
+		ticker := time.NewTicker(w.flushInterval)
+		defer ticker.Stop()
+		tick = ticker.C
+	}
+
+	batch := storeBatch{}
+	write := func(record *service.Record) {
+		if w.drainExpired.Load() {
+			w.dropRecord(record)
+			return
+		}
+		batch.add(w.writeStore(record))
+		if batch.full(w.batchRecords, w.batchBytes) {
+			_ = w.syncStore()
+			batch = storeBatch{}
+		}
+	}
+	for {
+		// while there are spilled records no new records are queued, so
+		// an empty queue means that the spilled records are next
+		if len(w.storeChan) == 0 {
+			if record := w.popSpilled(); record != nil {
+				write(record)
+				continue
+			}
+		}
+		select {
+		case record, ok := <-w.storeChan:
+			if !ok {
+				w.drainSpilled(write)
+				w.writeDroppedFooter()
+				return
+			}
+			w.checkBackpressure()
+			write(record)
+		case reply := <-w.storeFlushChan:
+			// write the records queued before the request
+			for queued := true; queued; {
+				select {
+				case record, ok := <-w.storeChan:
+					if queued = ok; ok {
+						write(record)
+					}
+				default:
+					queued = false
+				}
+			}
+			w.drainSpilled(write)
+			reply <- storeFlushResult{segment: w.segment, err: w.syncStore()}
+			batch = storeBatch{}
+		case <-tick:
+			if batch.records > 0 {
+				_ = w.syncStore()
+				batch = storeBatch{}
+			}
+		}
+	}
+}
+
+// storeBatch counts the records written to the store since the last sync
+type storeBatch struct {
+	records int
+	bytes   int64
+}
+
+func (b *storeBatch) add(bytes int64) {
+	b.records++
+	b.bytes += bytes
+}
+
+// full reports whether the batch reached either of the limits, a zero limit
+// is disabled
+func (b *storeBatch) full(maxRecords int, maxBytes int64) bool {
+	return (maxRecords > 0 && b.records >= maxRecords) ||
+		(maxBytes > 0 && b.bytes >= maxBytes)
+}
+
+// Flush writes all the records queued to the store and syncs it to disk. It
+// is safe to call concurrently with Do, and returns once the records queued
+// before the call are durable.
+func (w *Writer) Flush() error {
+	_, err := w.flushStore()
+	return err
+}
+
+// flushStore asks the store goroutine to write and sync the queued records,
+// and returns the current segment
+func (w *Writer) flushStore() (int, error) {
+	if w.storeChan == nil {
+		return 0, nil
+	}
+	reply := make(chan storeFlushResult, 1)
+	select {
+	case w.storeFlushChan <- reply:
+	case <-w.storeDone:
+		return 0, fmt.Errorf("writer: store is closed")
+	}
+	result := <-reply
+	return result.segment, result.err
+}
+
+// writeStore writes the record to the store, rotating the store first if
+// the record would make the current segment exceed the maximum size. A
+// record larger than the maximum size is written to a segment of its own.
+// It returns the number of bytes written.
+func (w *Writer) writeStore(record *service.Record) int64 {
+	size := int64(proto.Size(record))
+	if w.maxSegmentBytes > 0 && w.segmentBytes > 0 &&
+		w.segmentBytes+size > w.maxSegmentBytes {
+		if err := w.rotateStore(record.Num); err != nil {
+			w.logger.CaptureFatalAndPanic("writer: error rotating store", err)
+		}
+	}
+	sizer, sized := w.store.(storeSizer)
+	var persisted int64
+	if sized {
+		persisted = sizer.BytesWritten()
+	}
+	if err := w.store.Write(record); err != nil {
+		w.logger.Error("writer: error storing record", "error", err)
+		w.storeErrors.Add(1)
+		w.reportStoreError(err)
+		return 0
+	}
+	if sized {
+		w.metrics.persistedBytes.Add(sizer.BytesWritten() - persisted)
+	}
+	w.metrics.countRecord(record)
+	w.lastStoredNum = record.Num
+	if record.GetSnapshot() != nil && w.backend.Kind == BackendFile {
+		if err := saveSnapshotNum(w.settings.GetSyncFile().GetValue(), record.Num); err != nil {
+			w.logger.CaptureError("writer: error saving snapshot number", err)
+		}
+	}
+	w.segmentBytes += size
+	w.recordsWritten.Add(1)
+	w.bytesWritten.Add(size)
+	return size
+}
+
+// storeOptions returns the options of the store segments
+func (w *Writer) storeOptions(resume bool) StoreOptions {
+	return StoreOptions{
+		Resume:        resume,
+		Compression:   w.compression,
+		EncryptionKey: w.encryptionKey,
+		Index:         w.index,
+		EmbedSchema:   w.embedSchema,
+		Metadata:      w.metadata,
+	}
+}
+
+// loadSegmentIndex sets up the segment index, when resuming it continues
+// writing the last segment of the existing store. Segments on disk that the
+// index misses, because the writer stopped before saving it, are added so
+// that they are resumed rather than overwritten. A resumed store that was
+// never rotated keeps no index unless rotation is enabled.
+func (w *Writer) loadSegmentIndex() {
+	w.segmentIndex = &SegmentIndex{}
+	if !w.resume {
+		return
+	}
+	fileName := w.settings.GetSyncFile().GetValue()
+	index, err := LoadSegmentIndex(fileName)
+	if err != nil {
+		w.logger.CaptureWarn("writer: ignoring segment index", "error", err)
+		index = &SegmentIndex{}
+	}
+	probed := probeSegments(fileName)
+	for len(index.Segments) < len(probed.Segments) {
+		index.Segments = append(index.Segments, probed.Segments[len(index.Segments)])
+	}
+	w.segmentIndex = index
+	w.segment = len(index.Segments) - 1
+	if w.maxSegmentBytes == 0 && w.segment == 0 {
+		w.segmentIndex = nil
+	}
+}
+
+// saveSegmentIndex writes the segment index next to the store, and next to
+// its mirror if any
+func (w *Writer) saveSegmentIndex() {
+	if err := w.segmentIndex.save(w.settings.GetSyncFile().GetValue()); err != nil {
+		w.logger.CaptureError("writer: error saving segment index", err)
+	}
+	if w.mirrorDir == "" {
+		return
+	}
+	if err := w.segmentIndex.save(mirrorName(w.mirrorDir, w.settings.GetSyncFile().GetValue())); err != nil {
+		w.logger.CaptureError("writer: error saving mirror segment index", err)
+	}
+}
+
+// newStore returns the store of a segment, mirrored to the mirror directory
+// if it is set
+func (w *Writer) newStore(name string, resume bool) RecordStore {
+	if w.backend.Kind != BackendFile {
+		return newBackendStore(w.ctx, name, w.backend, w.logger, w.storeOptions(resume))
+	}
+	store := NewStoreWithOptions(w.ctx, name, w.logger, w.storeOptions(resume))
+	if w.mirrorDir == "" {
+		return store
+	}
+	mirror := NewStoreWithOptions(w.ctx, mirrorName(w.mirrorDir, name), w.logger, w.storeOptions(resume))
+	return newMirroredStore(store, mirror, w.logger)
+}
+
+// newShardedStore returns the store sharded over the shard files, when
+// resuming a sharded store it keeps the shards it was written with
+func (w *Writer) newShardedStore() RecordStore {
+	fileName := w.settings.GetSyncFile().GetValue()
+	manifest := &ShardManifest{Shards: w.shards, Key: w.shardKey.String()}
+	if w.resume {
+		existing, err := LoadShardManifest(fileName)
+		if err != nil {
+			w.logger.CaptureWarn("writer: ignoring shard manifest", "error", err)
+		} else if existing != nil {
+			manifest = existing
+		}
+	}
+	key, err := ParseShardKey(manifest.Key)
+	if err != nil {
+		w.logger.CaptureWarn("writer: ignoring shard manifest key", "error", err)
+	}
+	if err := manifest.save(fileName); err != nil {
+		w.logger.CaptureError("writer: error saving shard manifest", err)
+	}
+	if w.mirrorDir != "" {
+		if err := manifest.save(mirrorName(w.mirrorDir, fileName)); err != nil {
+			w.logger.CaptureError("writer: error saving mirror shard manifest", err)
+		}
+	}
+	stores := make([]RecordStore, manifest.Shards)
+	for shard, path := range manifest.paths(fileName) {
+		stores[shard] = w.newStore(path, w.resume)
+	}
+	return newShardedStore(stores, key, w.logger)
+}
+
+// rotateStore closes the current store segment and opens the next one,
+// starting with the given record
+func (w *Writer) rotateStore(firstRecord int64) error {
+	if err := w.store.Close(); err != nil {
+		w.logger.CaptureError("writer: error closing store", err)
+	}
+	w.segment++
+	name := w.segmentName(w.segment)
+	// an existing segment is appended to, never truncated
+	info, statErr := os.Stat(name)
+	w.store = w.newStore(name, statErr == nil)
+	if err := w.store.Open(os.O_WRONLY); err != nil {
+		return err
+	}
+	w.segmentBytes = 0
+	if statErr == nil {
+		w.segmentBytes = info.Size()
+	}
+	if w.segmentIndex != nil && w.segment >= len(w.segmentIndex.Segments) {
+		w.segmentIndex.add(name, firstRecord)
+		w.saveSegmentIndex()
+	}
+	w.logger.Info("writer: rotated store", "name", name, "stream_id", w.settings.RunId)
+	return nil
+}
+
+// queueStore queues the record to be written to the store. If spilling is
+// enabled and the queue is full, or records are already spilled, the record
+// is spilled so that it is stored after them.
+func (w *Writer) queueStore(record *service.Record) {
+	if w.spill == nil {
+		w.storeChan <- record
+		return
+	}
+	if w.spill.len() == 0 {
+		select {
+		case w.storeChan <- record:
+			return
+		default:
+		}
+	}
+	if err := w.spill.push(record); err != nil {
+		w.logger.CaptureError("writer: error spilling record", err)
+		w.storeChan <- record
+	}
+}
+
+// popSpilled returns the oldest spilled record, or nil if there is none
+func (w *Writer) popSpilled() *service.Record {
+	if w.spill == nil {
+		return nil
+	}
+	record, err := w.spill.pop()
+	if err != nil {
+		w.logger.CaptureError("writer: error reading spilled record", err)
+		w.storeErrors.Add(1)
+		return nil
+	}
+	return record
+}
+
+// drainSpilled writes all the spilled records to the store
+func (w *Writer) drainSpilled(write func(*service.Record)) {
+	for w.spill != nil && w.spill.len() > 0 {
+		if record := w.popSpilled(); record != nil {
+			write(record)
+		}
+	}
+}
+
+// checkBackpressure calls the backpressure callback when the store queue
+// crosses the high-water mark in either direction
+func (w *Writer) checkBackpressure() {
+	if w.backpressureCallback == nil {
+		return
+	}
+	depth := len(w.storeChan)
+	above := float64(depth) >= storeHighWaterMark*float64(cap(w.storeChan))
+	if w.aboveHighWater.CompareAndSwap(!above, above) {
+		w.backpressureCallback(depth)
+	}
+}
+
+// Stats returns the counters of the records stored by the writer, it is safe
+// to call while the writer is running.
+func (w *Writer) Stats() WriterStats {
+	return WriterStats{
+		RecordsWritten: w.recordsWritten.Load(),
+		BytesWritten:   w.bytesWritten.Load(),
+		PersistedBytes: w.metrics.persistedBytes.Load(),
+		RecordsByType:  w.metrics.byType(),
+		QueueDepth:     len(w.storeChan),
+		QueueCapacity:  cap(w.storeChan),
+		Spilled:        w.spilled(),
+		Spooled:        int(w.spooled.Load()),
+		Errors:         w.storeErrors.Load(),
+		Dropped:        w.dropped.Load(),
+		Repeated:       w.repeated.Load(),
+		ConsoleDropped: w.consoleDropped.Load(),
+		Syncs:          w.metrics.syncs.Load(),
+		SyncTime:       time.Duration(w.metrics.syncTime.Load()),
+		MaxSyncLatency: time.Duration(w.metrics.maxSyncTime.Load()),
+	}
+}
+
+// spilled returns the number of spilled records waiting to be stored
+func (w *Writer) spilled() int {
+	if w.spill == nil {
+		return 0
+	}
+	return w.spill.len()
+}
+
+// segmentName returns the file name of a store segment
+func (w *Writer) segmentName(segment int) string {
+	name := w.settings.GetSyncFile().GetValue()
+	if segment == 0 {
+		return name
+	}
+	return fmt.Sprintf("%s.%d", name, segment)
+}
+
+func (w *Writer) syncStore() error {
+	start := time.Now()
+	err := w.store.Sync()
+	w.metrics.observeSync(time.Since(start))
+	if err != nil {
+		w.logger.CaptureError("writer: error syncing store", err)
+		w.reportStoreError(err)
+		return err
+	}
+	if w.journal != nil {
+		w.journal.markDurable(w.lastStoredNum)
+	}
+	return nil
+}
+
 // do is the main loop of the writer to process incoming messages
 func (w *Writer) Do(inChan <-chan *service.Record) {
 	defer w.logger.Reraise()
 	w.logger.Info("writer: started", "stream_id", w.settings.RunId)
 
 	w.startStore()
+	w.startSpool()
+
+	var metricsTick <-chan time.Time
+	if w.metricsInterval > 0 {
+		ticker := time.NewTicker(w.metricsInterval)
+		defer ticker.Stop()
+		metricsTick = ticker.C
+		w.lastReport = metricsReport{time: time.Now()}
+	}
 
-	for record := range inChan {
-		w.handleRecord(record)
+loop:
+	for {
+		select {
+		case record, ok := <-inChan:
+			if !ok {
+				break loop
+			}
+			w.handleRecord(record)
+		case now := <-metricsTick:
+			w.reportMetrics(now)
+		case err := <-w.storeErrorChan:
+			w.sendStoreStatus(err)
+		case <-w.connectivity.Reconnected():
+			w.replaySpool(false)
+		case <-w.abortChan:
+			w.logger.Info("writer: aborted", "stream_id", w.settings.RunId)
+			// stop accepting new records, but keep draining the input so
+			// that upstream components do not block while shutting down
+			go func() {
+				for range inChan {
+				}
+			}()
+			break loop
+		}
 	}
+	// records that were already accepted are forwarded and flushed to the
+	// store
+	w.replaySpool(true)
+	w.closeSpool()
 	w.Close()
-	w.wg.Wait()
+	w.waitStore()
+}
+
+// reportStoreError passes a store error to the writer loop to be reported to
+// the client. Errors are reported at most once per storeErrorReportInterval,
+// and are dropped if the previous one was not forwarded yet.
+func (w *Writer) reportStoreError(err error) {
+	now := time.Now()
+	if !w.lastStoreErrorReport.IsZero() &&
+		now.Sub(w.lastStoreErrorReport) < storeErrorReportInterval {
+		return
+	}
+	select {
+	case w.storeErrorChan <- err:
+		w.lastStoreErrorReport = now
+	default:
+	}
+}
+
+// sendStoreStatus forwards a store error to the sender, which passes it back
+// to the handler to be surfaced to the client. It is not stored, and it is
+// forwarded when offline too.
+func (w *Writer) sendStoreStatus(err error) {
+	record := &service.Record{
+		RecordType: &service.Record_Request{Request: &service.Request{
+			RequestType: &service.Request_StoreStatus{StoreStatus: &service.StoreStatusRequest{
+				Error:  err.Error(),
+				Errors: w.storeErrors.Load(),
+			}},
+		}},
+		Control: &service.Control{Local: true, AlwaysSend: true},
+	}
+	w.sendRecord(record)
+}
+
+// waitStore waits for the store goroutine to store the queued records and
+// close the store. With a drain timeout it stops waiting after twice the
+// timeout, in case the store is stuck writing a record.
+func (w *Writer) waitStore() {
+	if w.drainTimeout == 0 || w.storeChan == nil {
+		w.wg.Wait()
+		return
+	}
+	select {
+	case <-w.storeDone:
+	case <-time.After(2 * w.drainTimeout):
+		w.logger.CaptureError("writer: timed out closing store",
+			fmt.Errorf("store not closed after %v", 2*w.drainTimeout),
+			"queued", len(w.storeChan), "stream_id", w.settings.RunId)
+	}
+}
+
+// dropRecord counts a record that is dropped because the drain timeout
+// expired
+func (w *Writer) dropRecord(record *service.Record) {
+	if w.droppedByType == nil {
+		w.droppedByType = make(map[string]int64)
+	}
+	w.droppedByType[recordTypeName(record)]++
+	w.dropped.Add(1)
+}
+
+// writeDroppedFooter reports the records dropped after the drain timeout and
+// stores a footer record that accounts for them
+func (w *Writer) writeDroppedFooter() {
+	if len(w.droppedByType) == 0 {
+		return
+	}
+	footer := &service.FooterRecord{}
+	names := make([]string, 0, len(w.droppedByType))
+	for name := range w.droppedByType {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		footer.DroppedRecords = append(footer.DroppedRecords, &service.FooterRecord_DroppedRecords{
+			RecordType: name,
+			Count:      w.droppedByType[name],
+		})
+	}
+	w.logger.CaptureWarn("writer: dropped records not stored before the drain timeout",
+		"dropped", w.dropped.Load(), "by_type", w.droppedByType, "stream_id", w.settings.RunId)
+
+	// the records were all queued, so no other record takes this number
+	w.writeStore(&service.Record{
+		Num:        w.recordNum + 1,
+		RecordType: &service.Record_Footer{Footer: footer},
+	})
+}
+
+// Abort stops the writer without waiting for its input to be closed, the
+// stream aborts it on a second preemption notice, before the process stops.
+// The writer stops accepting records, the records it already accepted,
+// spooled ones included, are still forwarded and stored before Do returns,
+// and the input keeps being drained so that upstream components do not
+// block. Cancelling the context of the writer does not stop it, as the
+// stream cancels it before closing the input on every exit. It is safe to
+// call concurrently with Do.
+func (w *Writer) Abort() {
+	w.abortOnce.Do(func() { close(w.abortChan) })
+}
+
+// resync forwards the stored records that were not forwarded yet by streaming
+// them from the store, and switches the writer to online mode. It is done
+// when the run goes online, after the request is forwarded so that the
+// sender can send the records.
+func (w *Writer) resync() {
+	if !w.offline {
+		return
+	}
+	w.offline = false
+	if w.storeChan == nil {
+		return
+	}
+
+	// wait for the queued records to be written so that they can be read
+	lastSegment, err := w.flushStore()
+	if err != nil {
+		w.logger.CaptureError("writer: error flushing store", err)
+	}
+
+	if w.backend.Kind != BackendFile {
+		w.logger.CaptureWarn("writer: can't resync records stored offline to the store backend",
+			"backend", w.backend, "stream_id", w.settings.RunId)
+		return
+	}
+	w.logger.Info("writer: resyncing stored records", "from", w.forwardedNum, "stream_id", w.settings.RunId)
+	for segment := 0; segment <= lastSegment; segment++ {
+		if err := w.resyncSegment(w.segmentName(segment)); err != nil {
+			w.logger.CaptureError("writer: error resyncing store", err)
+			return
+		}
+	}
+}
+
+// resyncSegment forwards the records of a store segment that were not
+// forwarded yet
+func (w *Writer) resyncSegment(name string) error {
+	store := NewStoreWithOptions(w.ctx, name, w.logger, w.storeOptions(false))
+	if err := store.Open(os.O_RDONLY); err != nil {
+		return err
+	}
+	defer store.Close()
+
+	for {
+		record, err := store.Read()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if record.Num <= w.forwardedNum {
+			continue
+		}
+		w.forwardedNum = record.Num
+		// records that must always be sent were forwarded when they arrived,
+		// but the run is sent again for the backend to create it, without
+		// answering the client twice
+		if record.GetControl().GetAlwaysSend() {
+			if record.GetRun() == nil {
+				continue
+			}
+			record.Control = nil
+		}
+		w.fwdChan <- record
+	}
 }
 
 // Close closes the writer and all its resources
 // which includes the store
 func (w *Writer) Close() {
+	// the sender drains the priority records once fwdChan is closed
+	if w.priorityChan != nil {
+		close(w.priorityChan)
+	}
 	close(w.fwdChan)
 	if w.storeChan != nil {
 		close(w.storeChan)
+		if w.drainTimeout > 0 {
+			time.AfterFunc(w.drainTimeout, func() {
+				w.drainExpired.Store(true)
+			})
+		}
 	}
 	w.logger.Info("writer: closed", "stream_id", w.settings.RunId)
 }
@@ -126,31 +1493,214 @@ func (w *Writer) Close() {
 // before they are sent to the server.
 func (w *Writer) handleRecord(record *service.Record) {
 	w.logger.Debug("write: got a message", "record", record, "stream_id", w.settings.RunId)
-	switch record.RecordType.(type) {
-	case *service.Record_Request:
-		w.sendRecord(record)
-	case nil:
+	if w.dedup != nil && w.dedup.isDuplicate(record) {
+		w.logger.Debug("writer: dropping duplicate record", "record", record, "stream_id", w.settings.RunId)
+		return
+	}
+	if record.RecordType == nil {
 		w.logger.Error("nil record type")
-	default:
-		w.sendRecord(record)
+		return
+	}
+	switch {
+	case w.policy.ShouldStore(record):
 		w.storeRecord(record)
+	case w.offline && isOfflineRequest(record):
+		// the handler answered the client, the stored request must not be
+		// answered again once it is sent
+		stored := proto.Clone(record).(*service.Record)
+		stored.Control = nil
+		w.storeRecord(stored)
+	}
+	// the records of a preempted run must be on disk before it is killed
+	if record.GetPreempting() != nil {
+		if err := w.Flush(); err != nil {
+			w.logger.Error("writer: failed to flush the store of the preempted run", "error", err)
+		}
+	}
+	w.sendRecord(record)
+	if record.GetRequest().GetGoOnline() != nil {
+		w.resync()
 	}
 }
 
+// isOfflineRequest returns whether the request is stored while offline, to be
+// sent when the run is synced
+func isOfflineRequest(record *service.Record) bool {
+	_, ok := record.GetRequest().GetRequestType().(*service.Request_LogArtifact)
+	return ok
+}
+
 // storeRecord stores the record in the append-only log
 func (w *Writer) storeRecord(record *service.Record) {
 	if record.GetControl().GetLocal() {
 		return
 	}
+	if w.repeatDedup != nil && w.repeatDedup.isRepeated(record) {
+		w.logger.Debug("writer: not storing repeated record", "record", record, "stream_id", w.settings.RunId)
+		w.repeated.Add(1)
+		return
+	}
+	if w.console != nil && !w.console.allow(record) {
+		if w.consoleDropped.Add(1) == 1 {
+			w.logger.CaptureWarn("writer: console output over the retention limits is not stored",
+				"max_lines", w.consoleRetention.MaxLines,
+				"max_bytes", w.consoleRetention.MaxBytes,
+				"window", w.consoleRetention.Window,
+				"stream_id", w.settings.RunId)
+		}
+		return
+	}
+	record.Num = w.nextRecordNum()
+	// tracked before it is queued, as the store goroutine then uses it
+	if w.snapshots != nil {
+		w.snapshots.apply(record)
+	}
+	w.queueStore(record)
+	if w.snapshots != nil && w.snapshots.sinceSnapshot >= w.snapshotInterval {
+		w.storeSnapshot()
+	}
+	w.checkBackpressure()
+}
+
+// nextRecordNum assigns the number of the next stored record
+func (w *Writer) nextRecordNum() int64 {
 	w.recordNum += 1
-	record.Num = w.recordNum
-	w.storeChan <- record
+	if w.journal != nil {
+		if err := w.journal.reserve(w.recordNum); err != nil {
+			w.logger.CaptureError("writer: error writing journal", err)
+		}
+	}
+	return w.recordNum
+}
+
+// storeSnapshot stores a snapshot record of the records stored so far, it is
+// not sent
+func (w *Writer) storeSnapshot() {
+	record := &service.Record{
+		RecordType: &service.Record_Snapshot{Snapshot: w.snapshots.snapshot()},
+	}
+	record.Num = w.nextRecordNum()
+	w.queueStore(record)
 }
 
 func (w *Writer) sendRecord(record *service.Record) {
 	// TODO: redo it so it only uses control
-	if w.settings.GetXOffline().GetValue() && !record.GetControl().GetAlwaysSend() {
+	if w.offline && !record.GetControl().GetAlwaysSend() {
+		return
+	}
+	if !w.offline && record.Num > w.forwardedNum {
+		w.forwardedNum = record.Num
+	}
+	// the priority records are not spooled either, they don't depend on the
+	// records before them
+	if w.priorityChan != nil && !w.offline && isPriorityRecord(record) {
+		w.priorityChan <- record
+		return
+	}
+	if w.spoolRecord(record) {
 		return
 	}
 	w.fwdChan <- record
 }
+
+// isPriorityRecord returns whether the record is forwarded to the sender
+// ahead of the queued records. The defer requests are not, the shutdown must
+// wait for the records before them.
+func isPriorityRecord(record *service.Record) bool {
+	switch x := record.GetRecordType().(type) {
+	case *service.Record_Exit, *service.Record_Alert:
+		return true
+	case *service.Record_Request:
+		switch x.Request.GetRequestType().(type) {
+		case *service.Request_NetworkStatus,
+			*service.Request_ServerInfo,
+			*service.Request_PollExit,
+			*service.Request_Cancel,
+			*service.Request_StoreStatus:
+			return true
+		}
+	}
+	return false
+}
+
+// startSpool sets up the spool of the records to forward while the backend
+// is unreachable. Records spooled by a previous writer were stored too, so
+// its spool is dropped.
+func (w *Writer) startSpool() {
+	if w.connectivity == nil || w.spoolMaxBytes <= 0 || w.offline ||
+		w.backend.Kind != BackendFile || w.settings.GetSyncFile().GetValue() == "" {
+		return
+	}
+	name := w.settings.GetSyncFile().GetValue() + ".spool"
+	if err := os.Remove(name); err != nil && !os.IsNotExist(err) {
+		w.logger.CaptureError("writer: error removing old spool, spooling disabled", err)
+		return
+	}
+	w.spool = newRecordSpool(w.ctx, w.logger, name,
+		StoreOptions{Compression: w.compression, EncryptionKey: w.encryptionKey},
+		w.spoolMaxBytes)
+}
+
+// spoolRecord spools the record if the backend is unreachable or records are
+// already spooled, so that the records are forwarded in order. It returns
+// false if the record must be forwarded right away, in which case the
+// spooled records were forwarded first.
+func (w *Writer) spoolRecord(record *service.Record) bool {
+	if w.spool == nil || (w.spool.len() == 0 && w.connectivity.Connected()) {
+		return false
+	}
+	err := w.spool.push(record)
+	if err == nil {
+		w.spooled.Store(int64(w.spool.len()))
+		return true
+	}
+	if errors.Is(err, errSpoolFull) {
+		if !w.spoolFull {
+			w.spoolFull = true
+			w.logger.CaptureWarn("writer: spool is full, waiting for the sender",
+				"max_bytes", w.spoolMaxBytes, "stream_id", w.settings.RunId)
+		}
+	} else {
+		w.logger.CaptureError("writer: error spooling record", err)
+	}
+	w.replaySpool(true)
+	return false
+}
+
+// replaySpool forwards the spooled records. Unless wait is set, it stops
+// once the backend is unreachable again and keeps the rest spooled.
+func (w *Writer) replaySpool(wait bool) {
+	if w.spool == nil || w.spool.len() == 0 {
+		return
+	}
+	w.logger.Info("writer: forwarding spooled records", "records", w.spool.len(),
+		"stream_id", w.settings.RunId)
+	connected := w.connectivity.Connected
+	if wait {
+		connected = func() bool { return true }
+	}
+	err := w.spool.replay(connected, func(record *service.Record) {
+		w.fwdChan <- record
+	})
+	if err != nil {
+		w.logger.CaptureError("writer: error forwarding spooled records", err)
+	}
+	w.spooled.Store(int64(w.spool.len()))
+	if w.spool.len() == 0 {
+		w.spoolFull = false
+	}
+}
+
+// closeSpool drops the records left in the spool and removes it
+func (w *Writer) closeSpool() {
+	if w.spool == nil {
+		return
+	}
+	if n := w.spool.len(); n > 0 {
+		w.logger.CaptureWarn("writer: dropping spooled records", "records", n,
+			"stream_id", w.settings.RunId)
+	}
+	w.spool.close()
+	w.spooled.Store(0)
+	w.spool = nil
+}
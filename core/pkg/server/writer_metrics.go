@@ -0,0 +1,148 @@
+package server
+
+import (
+	"encoding/json"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/wandb/wandb/core/pkg/service"
+)
+
+// writerMetricsPrefix is the prefix of the keys of the writer metrics in the
+// stats records forwarded to the sender
+const writerMetricsPrefix = "writer."
+
+// storeSizer is implemented by the stores that report how many bytes they
+// wrote to disk
+type storeSizer interface {
+	BytesWritten() int64
+}
+
+// writerMetrics are the counters of the store goroutine of the writer that
+// are not part of the basic record counters
+type writerMetrics struct {
+	mu sync.Mutex
+
+	// recordsByType is the number of records stored per record type
+	recordsByType map[string]int64
+
+	// persistedBytes is the number of bytes written to the store files
+	persistedBytes atomic.Int64
+
+	// syncs is the number of times the store was synced
+	syncs atomic.Int64
+
+	// syncTime is the total time spent syncing the store, in nanoseconds
+	syncTime atomic.Int64
+
+	// maxSyncTime is the longest store sync, in nanoseconds
+	maxSyncTime atomic.Int64
+}
+
+// countRecord counts a stored record by its type
+func (m *writerMetrics) countRecord(record *service.Record) {
+	name := recordTypeName(record)
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.recordsByType == nil {
+		m.recordsByType = make(map[string]int64)
+	}
+	m.recordsByType[name]++
+}
+
+// observeSync records the latency of a store sync
+func (m *writerMetrics) observeSync(latency time.Duration) {
+	m.syncs.Add(1)
+	m.syncTime.Add(int64(latency))
+	for {
+		longest := m.maxSyncTime.Load()
+		if int64(latency) <= longest || m.maxSyncTime.CompareAndSwap(longest, int64(latency)) {
+			return
+		}
+	}
+}
+
+// byType returns a copy of the record counts by type
+func (m *writerMetrics) byType() map[string]int64 {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	counts := make(map[string]int64, len(m.recordsByType))
+	for name, count := range m.recordsByType {
+		counts[name] = count
+	}
+	return counts
+}
+
+// metricsReport is the state of the previous metrics report, the rates are
+// computed over the time since then
+type metricsReport struct {
+	time  time.Time
+	stats WriterStats
+}
+
+// reportMetrics logs the writer metrics and, if enabled, forwards them to
+// the sender as a stats record
+func (w *Writer) reportMetrics(now time.Time) {
+	stats := w.Stats()
+	previous := w.lastReport
+	w.lastReport = metricsReport{time: now, stats: stats}
+
+	metrics := map[string]float64{
+		"records_written": float64(stats.RecordsWritten),
+		"bytes_written":   float64(stats.BytesWritten),
+		"persisted_bytes": float64(stats.PersistedBytes),
+		"queue_depth":     float64(stats.QueueDepth),
+		"spilled":         float64(stats.Spilled),
+		"errors":          float64(stats.Errors),
+	}
+	if stats.QueueCapacity > 0 {
+		metrics["queue_occupancy"] = float64(stats.QueueDepth) / float64(stats.QueueCapacity)
+	}
+	if elapsed := now.Sub(previous.time).Seconds(); !previous.time.IsZero() && elapsed > 0 {
+		metrics["records_per_second"] = float64(stats.RecordsWritten-previous.stats.RecordsWritten) / elapsed
+		metrics["bytes_per_second"] = float64(stats.BytesWritten-previous.stats.BytesWritten) / elapsed
+	}
+	if syncs := stats.Syncs - previous.stats.Syncs; syncs > 0 {
+		syncTime := stats.SyncTime - previous.stats.SyncTime
+		metrics["sync_latency_ms"] = float64(syncTime/time.Duration(syncs)) / float64(time.Millisecond)
+	}
+	metrics["max_sync_latency_ms"] = float64(stats.MaxSyncLatency) / float64(time.Millisecond)
+	for name, count := range stats.RecordsByType {
+		metrics["records."+name] = float64(count)
+	}
+
+	args := make([]any, 0, 2*len(metrics)+2)
+	for key, value := range metrics {
+		args = append(args, key, value)
+	}
+	w.logger.Info("writer: metrics", append(args, "stream_id", w.settings.RunId)...)
+
+	if w.forwardMetrics && !w.offline {
+		w.fwdChan <- makeWriterStatsRecord(metrics, timestamppb.New(now))
+	}
+}
+
+// makeWriterStatsRecord returns a stats record of the writer metrics
+func makeWriterStatsRecord(metrics map[string]float64, timestamp *timestamppb.Timestamp) *service.Record {
+	stats := &service.StatsRecord{
+		StatsType: service.StatsRecord_SYSTEM,
+		Timestamp: timestamp,
+	}
+	for key, value := range metrics {
+		data, err := json.Marshal(value)
+		if err != nil {
+			continue
+		}
+		stats.Item = append(stats.Item, &service.StatsItem{
+			Key:       writerMetricsPrefix + key,
+			ValueJson: string(data),
+		})
+	}
+	return &service.Record{
+		RecordType: &service.Record_Stats{Stats: stats},
+		Control:    &service.Control{AlwaysSend: true},
+	}
+}
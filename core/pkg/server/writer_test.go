@@ -0,0 +1,1222 @@
+package server_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	"github.com/wandb/wandb/core/pkg/observability"
+	"github.com/wandb/wandb/core/pkg/server"
+	"github.com/wandb/wandb/core/pkg/service"
+)
+
+// mockStore is an in-memory store that records the calls made to it
+type mockStore struct {
+	mu      sync.Mutex
+	records []*service.Record
+	syncs   int
+	closed  bool
+
+	// gate, if set, blocks writes until it is closed
+	gate chan struct{}
+	// writeErr, if set, is returned by writes
+	writeErr error
+}
+
+func (s *mockStore) Open(flag int) error { return nil }
+
+func (s *mockStore) Write(msg *service.Record) error {
+	if s.gate != nil {
+		<-s.gate
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.writeErr != nil {
+		return s.writeErr
+	}
+	s.records = append(s.records, msg)
+	return nil
+}
+
+func (s *mockStore) Sync() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.syncs++
+	return nil
+}
+
+func (s *mockStore) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.closed = true
+	return nil
+}
+
+func (s *mockStore) LastRecordNum() int64 { return 0 }
+
+func (s *mockStore) Syncs() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.syncs
+}
+
+// startWriter starts a writer and returns its input channel and a channel
+// that is closed once the writer is done
+func startWriter(opts ...server.WriterOption) (chan *service.Record, <-chan struct{}) {
+	inChan, done, _ := startWriterWithHandle(context.Background(), opts...)
+	return inChan, done
+}
+
+// startWriterWithHandle is like startWriter but runs the writer with the
+// given context and also returns the writer
+func startWriterWithHandle(
+	ctx context.Context,
+	opts ...server.WriterOption,
+) (chan *service.Record, <-chan struct{}, *server.Writer) {
+	fwdChan := make(chan *service.Record, server.BufferSize)
+	inChan := make(chan *service.Record, server.BufferSize)
+	writer := server.NewWriter(ctx, observability.NewNoOpLogger(),
+		append([]server.WriterOption{server.WithWriterFwdChannel(fwdChan)}, opts...)...,
+	)
+
+	done := make(chan struct{})
+	go func() {
+		writer.Do(inChan)
+		close(done)
+	}()
+	go func() {
+		for range fwdChan {
+		}
+	}()
+	return inChan, done, writer
+}
+
+// runWriter feeds the given records through a writer and waits for it to finish
+func runWriter(t *testing.T, records []*service.Record, opts ...server.WriterOption) {
+	t.Helper()
+
+	inChan, done := startWriter(opts...)
+	for _, record := range records {
+		inChan <- record
+	}
+	close(inChan)
+	<-done
+}
+
+// readStore returns all the records in the store file
+func readStore(t *testing.T, fileName string) []*service.Record {
+	t.Helper()
+
+	store := server.NewStore(context.Background(), fileName, observability.NewNoOpLogger())
+	err := store.Open(os.O_RDONLY)
+	assert.NoError(t, err)
+	defer store.Close()
+
+	var records []*service.Record
+	for {
+		record, err := store.Read()
+		if err == io.EOF {
+			return records
+		}
+		assert.NoError(t, err)
+		records = append(records, record)
+	}
+}
+
+func makeOutputRecords(n int) []*service.Record {
+	records := make([]*service.Record, n)
+	for i := range records {
+		records[i] = &service.Record{
+			RecordType: &service.Record_Output{Output: &service.OutputRecord{Line: "line"}},
+		}
+	}
+	return records
+}
+
+func TestWriterResume(t *testing.T) {
+	fileName := filepath.Join(t.TempDir(), "run.wandb")
+	settings := &service.Settings{SyncFile: &wrapperspb.StringValue{Value: fileName}}
+
+	runWriter(t, makeOutputRecords(3), server.WithWriterSettings(settings))
+	runWriter(t, makeOutputRecords(2), server.WithWriterSettings(settings), server.WithWriterResume())
+
+	records := readStore(t, fileName)
+	assert.Len(t, records, 5)
+	for i, record := range records {
+		assert.Equal(t, int64(i+1), record.Num)
+	}
+}
+
+func TestWriterJournal(t *testing.T) {
+	dir := t.TempDir()
+	fileName := filepath.Join(dir, "run.wandb")
+	settings := &service.Settings{
+		SyncFile:      &wrapperspb.StringValue{Value: fileName},
+		XStoreJournal: &wrapperspb.BoolValue{Value: true},
+	}
+	runWriter(t, makeOutputRecords(5), server.WithWriterSettings(settings))
+	_, err := os.Stat(fileName + ".journal")
+	assert.NoError(t, err)
+
+	// the last records are lost, as if the disk lost them in a crash
+	lostName := filepath.Join(dir, "lost.wandb")
+	runWriter(t, makeOutputRecords(2), server.WithWriterSettings(&service.Settings{
+		SyncFile: &wrapperspb.StringValue{Value: lostName},
+	}))
+	assert.NoError(t, os.Rename(lostName, fileName))
+
+	// the numbers of the lost records are not assigned again
+	runWriter(t, makeOutputRecords(2), server.WithWriterSettings(settings), server.WithWriterResume())
+	var nums []int64
+	for _, record := range readStore(t, fileName) {
+		nums = append(nums, record.Num)
+	}
+	assert.Equal(t, []int64{1, 2, 6, 7}, nums)
+}
+
+func TestWriterJournalInvalid(t *testing.T) {
+	fileName := filepath.Join(t.TempDir(), "run.wandb")
+	settings := &service.Settings{SyncFile: &wrapperspb.StringValue{Value: fileName}}
+	runWriter(t, makeOutputRecords(3), server.WithWriterSettings(settings), server.WithWriterJournal())
+	assert.NoError(t, os.WriteFile(fileName+".journal", []byte("torn"), 0644))
+
+	// a torn journal is ignored, numbering continues after the stored records
+	runWriter(t, makeOutputRecords(1),
+		server.WithWriterSettings(settings),
+		server.WithWriterJournal(),
+		server.WithWriterResume(),
+	)
+	records := readStore(t, fileName)
+	assert.Len(t, records, 4)
+	assert.Equal(t, int64(4), records[3].Num)
+}
+
+func TestWriterFlushInterval(t *testing.T) {
+	store := &mockStore{}
+	inChan, done := startWriter(
+		server.WithWriterSettings(&service.Settings{}),
+		server.WithWriterStore(store),
+		server.WithWriterFlushInterval(10*time.Millisecond),
+	)
+
+	for _, record := range makeOutputRecords(5) {
+		inChan <- record
+	}
+	assert.Eventually(t, func() bool { return store.Syncs() == 1 }, time.Second, time.Millisecond)
+
+	// no records were written, so the following ticks must not sync
+	time.Sleep(50 * time.Millisecond)
+	assert.Equal(t, 1, store.Syncs())
+
+	for _, record := range makeOutputRecords(5) {
+		inChan <- record
+	}
+	assert.Eventually(t, func() bool { return store.Syncs() == 2 }, time.Second, time.Millisecond)
+
+	// closing the writer forces a final sync
+	close(inChan)
+	<-done
+	assert.Equal(t, 3, store.Syncs())
+	assert.Len(t, store.records, 10)
+	assert.True(t, store.closed)
+}
+
+func TestWriterBatchSize(t *testing.T) {
+	store := &mockStore{}
+	runWriter(t, makeOutputRecords(10),
+		server.WithWriterSettings(&service.Settings{}),
+		server.WithWriterStore(store),
+		server.WithWriterBatchSize(4, 0),
+	)
+
+	// a sync after every 4 records and a final sync for the rest
+	assert.Equal(t, 3, store.Syncs())
+	assert.Len(t, store.records, 10)
+}
+
+func TestWriterSyncPolicy(t *testing.T) {
+	testCases := []struct {
+		name   string
+		policy string
+		syncs  int
+	}{
+		// a sync after every record and a final sync
+		{"record", "record", 11},
+		// a sync after every batch of 4 records and a final sync
+		{"batch", "batch", 3},
+		{"never", "never", 0},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			store := &mockStore{}
+			runWriter(t, makeOutputRecords(10),
+				server.WithWriterSettings(&service.Settings{
+					XStoreSyncPolicy: &wrapperspb.StringValue{Value: tc.policy},
+				}),
+				server.WithWriterStore(store),
+				server.WithWriterBatchSize(4, 0),
+			)
+			assert.Equal(t, tc.syncs, store.Syncs())
+			assert.Len(t, store.records, 10)
+		})
+	}
+}
+
+func TestWriterSyncPolicyNeverFlush(t *testing.T) {
+	store := &mockStore{}
+	inChan, done, writer := startWriterWithHandle(context.Background(),
+		server.WithWriterSettings(&service.Settings{}),
+		server.WithWriterStore(store),
+		server.WithWriterSyncPolicy(server.SyncNever),
+		server.WithWriterFlushInterval(time.Millisecond),
+	)
+	for _, record := range makeOutputRecords(3) {
+		inChan <- record
+	}
+	time.Sleep(10 * time.Millisecond)
+	assert.Equal(t, 0, store.Syncs())
+
+	// an explicit flush still syncs the store
+	assert.NoError(t, writer.Flush())
+	assert.Equal(t, 1, store.Syncs())
+	close(inChan)
+	<-done
+	assert.Equal(t, 1, store.Syncs())
+}
+
+func TestWriterMirrorDir(t *testing.T) {
+	fileName := filepath.Join(t.TempDir(), "run.wandb")
+	mirrorDir := t.TempDir()
+	settings := &service.Settings{
+		SyncFile:        &wrapperspb.StringValue{Value: fileName},
+		XStoreMirrorDir: &wrapperspb.StringValue{Value: mirrorDir},
+	}
+	runWriter(t, makeOutputRecords(3), server.WithWriterSettings(settings))
+
+	assert.Len(t, readStore(t, fileName), 3)
+	assert.Len(t, readStore(t, filepath.Join(mirrorDir, "run.wandb")), 3)
+}
+
+func TestWriterMirrorDirFailure(t *testing.T) {
+	dir := t.TempDir()
+	mirrorDir := t.TempDir()
+
+	// the records are still stored if the mirror can't be created
+	fileName := filepath.Join(dir, "run.wandb")
+	runWriter(t, makeOutputRecords(3),
+		server.WithWriterSettings(&service.Settings{SyncFile: &wrapperspb.StringValue{Value: fileName}}),
+		server.WithWriterMirrorDir(filepath.Join(dir, "missing")),
+	)
+	assert.Len(t, readStore(t, fileName), 3)
+
+	// and in the mirror if the primary store can't be created
+	fileName = filepath.Join(dir, "missing", "run.wandb")
+	writer := server.NewWriter(context.Background(), observability.NewNoOpLogger(),
+		server.WithWriterFwdChannel(make(chan *service.Record, server.BufferSize)),
+		server.WithWriterSettings(&service.Settings{SyncFile: &wrapperspb.StringValue{Value: fileName}}),
+		server.WithWriterMirrorDir(mirrorDir),
+	)
+	inChan := make(chan *service.Record, server.BufferSize)
+	for _, record := range makeOutputRecords(3) {
+		inChan <- record
+	}
+	close(inChan)
+	writer.Do(inChan)
+	assert.Len(t, readStore(t, filepath.Join(mirrorDir, "run.wandb")), 3)
+	assert.Equal(t, int64(3), writer.Stats().RecordsWritten)
+}
+
+func TestWriterDrainTimeout(t *testing.T) {
+	store := &mockStore{gate: make(chan struct{})}
+	inChan, done, writer := startWriterWithHandle(context.Background(),
+		server.WithWriterSettings(&service.Settings{}),
+		server.WithWriterStore(store),
+		server.WithWriterDrainTimeout(10*time.Millisecond),
+	)
+	inChan <- &service.Record{RecordType: &service.Record_Run{Run: &service.RunRecord{}}}
+	for _, record := range makeOutputRecords(4) {
+		inChan <- record
+	}
+	close(inChan)
+
+	// closing does not wait for the store that is stuck on the first record
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("writer did not close")
+	}
+	close(store.gate)
+	assert.Eventually(t, func() bool {
+		return writer.Stats().Dropped == 4
+	}, time.Second, time.Millisecond)
+
+	// the dropped records are accounted for in a footer
+	assert.Eventually(t, func() bool {
+		store.mu.Lock()
+		defer store.mu.Unlock()
+		return len(store.records) == 2
+	}, time.Second, time.Millisecond)
+	dropped := store.records[1].GetFooter().GetDroppedRecords()
+	assert.Len(t, dropped, 1)
+	assert.Equal(t, "output", dropped[0].GetRecordType())
+	assert.Equal(t, int64(4), dropped[0].GetCount())
+	assert.Equal(t, int64(6), store.records[1].Num)
+}
+
+func TestParseSyncPolicy(t *testing.T) {
+	for name, want := range map[string]server.SyncPolicy{
+		"":         server.SyncDefault,
+		"record":   server.SyncPerRecord,
+		"Batch":    server.SyncPerBatch,
+		"interval": server.SyncPerInterval,
+		"never":    server.SyncNever,
+	} {
+		policy, err := server.ParseSyncPolicy(name)
+		assert.NoError(t, err)
+		assert.Equal(t, want, policy)
+	}
+	_, err := server.ParseSyncPolicy("always")
+	assert.Error(t, err)
+}
+
+func TestWriterFlush(t *testing.T) {
+	store := &mockStore{}
+	inChan, done, writer := startWriterWithHandle(context.Background(),
+		server.WithWriterSettings(&service.Settings{}),
+		server.WithWriterStore(store),
+	)
+
+	for _, record := range makeOutputRecords(5) {
+		inChan <- record
+	}
+	// the records must be queued to the store before flushing
+	assert.Eventually(t, func() bool {
+		return writer.Stats().RecordsWritten+int64(writer.Stats().QueueDepth) == 5
+	}, time.Second, time.Millisecond)
+	assert.NoError(t, writer.Flush())
+	assert.Equal(t, 1, store.Syncs())
+	assert.Equal(t, int64(5), writer.Stats().RecordsWritten)
+
+	close(inChan)
+	<-done
+	assert.Error(t, writer.Flush())
+}
+
+func TestWriterNoFlushInterval(t *testing.T) {
+	store := &mockStore{}
+	inChan, done := startWriter(
+		server.WithWriterSettings(&service.Settings{}),
+		server.WithWriterStore(store),
+	)
+
+	for _, record := range makeOutputRecords(5) {
+		inChan <- record
+	}
+	close(inChan)
+	<-done
+	assert.Equal(t, 0, store.Syncs())
+	assert.Len(t, store.records, 5)
+}
+
+func TestWriterMaxSegmentBytes(t *testing.T) {
+	fileName := filepath.Join(t.TempDir(), "run.wandb")
+	settings := &service.Settings{SyncFile: &wrapperspb.StringValue{Value: fileName}}
+
+	records := makeOutputRecords(6)
+	// a record larger than the maximum segment size gets a segment of its own
+	records[3].GetOutput().Line = strings.Repeat("x", 200)
+	runWriter(t, records,
+		server.WithWriterSettings(settings),
+		server.WithWriterMaxSegmentBytes(100),
+	)
+
+	var stored []*service.Record
+	segments := []string{fileName}
+	for i := 1; ; i++ {
+		name := fmt.Sprintf("%s.%d", fileName, i)
+		if _, err := os.Stat(name); err != nil {
+			break
+		}
+		segments = append(segments, name)
+	}
+	assert.Greater(t, len(segments), 2)
+	for _, segment := range segments {
+		stored = append(stored, readStore(t, segment)...)
+	}
+
+	assert.Len(t, stored, 6)
+	for i, record := range stored {
+		assert.Equal(t, int64(i+1), record.Num)
+	}
+}
+
+func TestWriterCompressionResume(t *testing.T) {
+	fileName := filepath.Join(t.TempDir(), "run.wandb")
+	settings := &service.Settings{SyncFile: &wrapperspb.StringValue{Value: fileName}}
+
+	runWriter(t, makeOutputRecords(3),
+		server.WithWriterSettings(settings),
+		server.WithWriterCompression(server.CompressionGzip),
+	)
+	runWriter(t, makeOutputRecords(2),
+		server.WithWriterSettings(settings),
+		server.WithWriterResume(),
+	)
+
+	records := readStore(t, fileName)
+	assert.Len(t, records, 5)
+	for i, record := range records {
+		assert.Equal(t, int64(i+1), record.Num)
+		assert.Equal(t, "line", record.GetOutput().GetLine())
+	}
+}
+
+func TestWriterCompressionSetting(t *testing.T) {
+	for name, want := range map[string]server.Compression{
+		"gzip":   server.CompressionGzip,
+		"zstd":   server.CompressionZstd,
+		"snappy": server.CompressionSnappy,
+	} {
+		name, want := name, want
+		t.Run(name, func(t *testing.T) {
+			fileName := filepath.Join(t.TempDir(), "run.wandb")
+			settings := &service.Settings{
+				SyncFile:          &wrapperspb.StringValue{Value: fileName},
+				XStoreCompression: &wrapperspb.StringValue{Value: name},
+			}
+			runWriter(t, makeOutputRecords(3), server.WithWriterSettings(settings))
+
+			data, err := os.ReadFile(fileName)
+			assert.NoError(t, err)
+			header := server.HeaderOptions{}
+			assert.NoError(t, header.UnmarshalBinary(bytes.NewReader(data)))
+			assert.Equal(t, want, header.Compression)
+			assert.Len(t, readStore(t, fileName), 3)
+		})
+	}
+}
+
+func TestWriterIndexSetting(t *testing.T) {
+	fileName := filepath.Join(t.TempDir(), "run.wandb")
+	settings := &service.Settings{
+		SyncFile:    &wrapperspb.StringValue{Value: fileName},
+		XStoreIndex: &wrapperspb.BoolValue{Value: true},
+	}
+	runWriter(t, makeOutputRecords(10), server.WithWriterSettings(settings))
+
+	store := server.NewStore(context.Background(), fileName, observability.NewNoOpLogger())
+	assert.NoError(t, store.Open(os.O_RDONLY))
+	defer store.Close()
+	assert.NoError(t, store.SeekRecord(7))
+	record, err := store.Read()
+	assert.NoError(t, err)
+	assert.Equal(t, int64(7), record.Num)
+	_, err = os.Stat(fileName + ".index")
+	assert.NoError(t, err)
+}
+
+func TestWriterEncryptionKeyEnv(t *testing.T) {
+	key := base64.StdEncoding.EncodeToString(bytes.Repeat([]byte{7}, 32))
+	t.Setenv(server.StoreEncryptionKeyEnv, key)
+	fileName := filepath.Join(t.TempDir(), "run.wandb")
+	settings := &service.Settings{SyncFile: &wrapperspb.StringValue{Value: fileName}}
+	runWriter(t, makeOutputRecords(3), server.WithWriterSettings(settings))
+	runWriter(t, makeOutputRecords(2),
+		server.WithWriterSettings(settings),
+		server.WithWriterResume(),
+	)
+
+	keyBytes, err := server.StoreEncryptionKey(settings)
+	assert.NoError(t, err)
+	reader := server.NewSegmentReaderWithOptions(context.Background(), fileName, observability.NewNoOpLogger(),
+		server.StoreOptions{EncryptionKey: keyBytes},
+	)
+	assert.NoError(t, reader.Open())
+	defer reader.Close()
+	for i := 1; i <= 5; i++ {
+		record, err := reader.Read()
+		assert.NoError(t, err)
+		assert.Equal(t, int64(i), record.Num)
+	}
+	_, err = reader.Read()
+	assert.Equal(t, io.EOF, err)
+}
+
+func TestWriterStats(t *testing.T) {
+	store := &mockStore{gate: make(chan struct{})}
+	var mu sync.Mutex
+	var depths []int
+	inChan, done, writer := startWriterWithHandle(context.Background(),
+		server.WithWriterSettings(&service.Settings{}),
+		server.WithWriterStore(store),
+		server.WithWriterBackpressureCallback(func(depth int) {
+			mu.Lock()
+			defer mu.Unlock()
+			depths = append(depths, depth)
+		}),
+	)
+
+	// the store is blocked, so the records pile up in the queue
+	const numRecords = server.BufferSize * 8
+	for _, record := range makeOutputRecords(numRecords) {
+		inChan <- record
+	}
+	assert.Eventually(t, func() bool {
+		mu.Lock()
+		defer mu.Unlock()
+		return len(depths) == 1
+	}, time.Second, time.Millisecond)
+	assert.Greater(t, writer.Stats().QueueDepth, 0)
+
+	close(store.gate)
+	close(inChan)
+	<-done
+
+	assert.Len(t, depths, 2)
+	// the high-water mark is at 80% of the queue capacity
+	assert.Greater(t, depths[0], numRecords*8/10)
+	assert.LessOrEqual(t, depths[1], numRecords*8/10)
+
+	stats := writer.Stats()
+	assert.Equal(t, int64(numRecords), stats.RecordsWritten)
+	assert.Greater(t, stats.BytesWritten, int64(0))
+	assert.Equal(t, 0, stats.QueueDepth)
+	assert.Equal(t, int64(0), stats.Errors)
+}
+
+func TestWriterMetrics(t *testing.T) {
+	fileName := filepath.Join(t.TempDir(), "run.wandb")
+	settings := &service.Settings{SyncFile: &wrapperspb.StringValue{Value: fileName}}
+	inChan, done, writer := startWriterWithHandle(context.Background(),
+		server.WithWriterSettings(settings),
+	)
+
+	inChan <- &service.Record{RecordType: &service.Record_Run{Run: &service.RunRecord{}}}
+	for _, record := range makeOutputRecords(3) {
+		inChan <- record
+	}
+	assert.Eventually(t, func() bool {
+		return writer.Stats().RecordsWritten+int64(writer.Stats().QueueDepth) == 4
+	}, time.Second, time.Millisecond)
+	assert.NoError(t, writer.Flush())
+	close(inChan)
+	<-done
+
+	stats := writer.Stats()
+	assert.Equal(t, map[string]int64{"run": 1, "output": 3}, stats.RecordsByType)
+	assert.Greater(t, stats.PersistedBytes, int64(0))
+	assert.Greater(t, stats.QueueCapacity, 0)
+	assert.Equal(t, int64(1), stats.Syncs)
+	assert.GreaterOrEqual(t, stats.SyncTime, stats.MaxSyncLatency)
+}
+
+func TestWriterMetricsForwarded(t *testing.T) {
+	fwdChan := make(chan *service.Record, server.BufferSize)
+	inChan := make(chan *service.Record, server.BufferSize)
+	writer := server.NewWriter(context.Background(), observability.NewNoOpLogger(),
+		server.WithWriterFwdChannel(fwdChan),
+		server.WithWriterSettings(&service.Settings{
+			XWriterMetricsIntervalSeconds: &wrapperspb.DoubleValue{Value: 0.01},
+		}),
+		server.WithWriterStore(&mockStore{}),
+	)
+	go writer.Do(inChan)
+	defer close(inChan)
+
+	inChan <- makeOutputRecords(1)[0]
+	for record := range fwdChan {
+		stats := record.GetStats()
+		if stats == nil {
+			continue
+		}
+		keys := make(map[string]bool)
+		for _, item := range stats.Item {
+			keys[item.Key] = true
+		}
+		assert.True(t, keys["writer.records_written"])
+		assert.True(t, keys["writer.queue_depth"])
+		assert.True(t, record.GetControl().GetAlwaysSend())
+		return
+	}
+}
+
+func TestWriterStoreQueueSizeSettings(t *testing.T) {
+	tests := []struct {
+		size int32
+		want int
+	}{
+		{size: 5, want: 5},
+		{size: 0, want: server.BufferSize * 8},
+		{size: -1, want: server.BufferSize * 8},
+	}
+	for _, tt := range tests {
+		writer := server.NewWriter(context.Background(), observability.NewNoOpLogger(),
+			server.WithWriterSettings(&service.Settings{
+				XStoreQueueSize: &wrapperspb.Int32Value{Value: tt.size},
+			}),
+		)
+		assert.Equal(t, tt.want, writer.Stats().QueueCapacity, tt.size)
+	}
+}
+
+func TestWriterSpill(t *testing.T) {
+	store := &mockStore{gate: make(chan struct{})}
+	spillDir := t.TempDir()
+	inChan, done, writer := startWriterWithHandle(context.Background(),
+		server.WithWriterSettings(&service.Settings{}),
+		server.WithWriterStore(store),
+		server.WithWriterStoreQueueSize(4),
+		server.WithWriterSpillDir(spillDir),
+	)
+
+	// the store is blocked, the records that do not fit in the queue are
+	// spilled instead of blocking the writer
+	const numRecords = 50
+	for _, record := range makeOutputRecords(numRecords) {
+		inChan <- record
+	}
+	assert.Eventually(t, func() bool {
+		stats := writer.Stats()
+		// one record is blocked in the store
+		return stats.Spilled > 0 && stats.QueueDepth+stats.Spilled == numRecords-1
+	}, time.Second, time.Millisecond)
+
+	close(store.gate)
+	close(inChan)
+	<-done
+
+	assert.Len(t, store.records, numRecords)
+	for i, record := range store.records {
+		assert.Equal(t, int64(i+1), record.Num)
+		assert.Equal(t, "line", record.GetOutput().GetLine())
+	}
+	assert.Equal(t, 0, writer.Stats().Spilled)
+	entries, err := os.ReadDir(spillDir)
+	assert.NoError(t, err)
+	assert.Empty(t, entries)
+}
+
+func TestWriterExcludeTypes(t *testing.T) {
+	store := &mockStore{}
+	settings := &service.Settings{
+		XStoreExcludeTypes: &service.ListStringValue{Value: []string{"stats"}},
+	}
+	stats := &service.Record{RecordType: &service.Record_Stats{Stats: &service.StatsRecord{}}}
+	runWriter(t,
+		append(makeOutputRecords(2), stats),
+		server.WithWriterSettings(settings),
+		server.WithWriterStore(store),
+	)
+
+	assert.Len(t, store.records, 2)
+	for _, record := range store.records {
+		assert.NotNil(t, record.GetOutput())
+	}
+}
+
+func TestNewPersistencePolicy(t *testing.T) {
+	policy, err := server.NewPersistencePolicy([]string{"output_raw"})
+	assert.NoError(t, err)
+	assert.False(t, policy.ShouldStore(&service.Record{
+		RecordType: &service.Record_OutputRaw{OutputRaw: &service.OutputRawRecord{}},
+	}))
+	assert.False(t, policy.ShouldStore(&service.Record{
+		RecordType: &service.Record_Request{Request: &service.Request{}},
+	}))
+	assert.True(t, policy.ShouldStore(&service.Record{
+		RecordType: &service.Record_Output{Output: &service.OutputRecord{}},
+	}))
+
+	_, err = server.NewPersistencePolicy([]string{"not_a_record"})
+	assert.ErrorContains(t, err, "unknown record type")
+	_, err = server.NewPersistencePolicy([]string{"exit"})
+	assert.ErrorContains(t, err, "required")
+}
+
+func TestWriterStatsErrors(t *testing.T) {
+	store := &mockStore{writeErr: errors.New("disk full")}
+	inChan, done, writer := startWriterWithHandle(context.Background(),
+		server.WithWriterSettings(&service.Settings{}),
+		server.WithWriterStore(store),
+	)
+	for _, record := range makeOutputRecords(3) {
+		inChan <- record
+	}
+	close(inChan)
+	<-done
+
+	stats := writer.Stats()
+	assert.Equal(t, int64(0), stats.RecordsWritten)
+	assert.Equal(t, int64(3), stats.Errors)
+}
+
+func TestWriterStoreErrorStatus(t *testing.T) {
+	store := &mockStore{writeErr: errors.New("disk full")}
+	fwdChan := make(chan *service.Record, server.BufferSize)
+	inChan := make(chan *service.Record, server.BufferSize)
+	writer := server.NewWriter(context.Background(), observability.NewNoOpLogger(),
+		server.WithWriterFwdChannel(fwdChan),
+		server.WithWriterSettings(&service.Settings{XOffline: &wrapperspb.BoolValue{Value: true}}),
+		server.WithWriterStore(store),
+	)
+	go writer.Do(inChan)
+
+	// the status is forwarded even though the run is offline
+	for _, record := range makeOutputRecords(3) {
+		inChan <- record
+	}
+	var status *service.StoreStatusRequest
+	select {
+	case record := <-fwdChan:
+		status = record.GetRequest().GetStoreStatus()
+		assert.True(t, record.GetControl().GetLocal())
+	case <-time.After(time.Second):
+		t.Fatal("no store status forwarded")
+	}
+	assert.NotNil(t, status)
+	assert.Equal(t, "disk full", status.Error)
+	assert.Positive(t, status.Errors)
+
+	// later errors are not reported again right away
+	for _, record := range makeOutputRecords(3) {
+		inChan <- record
+	}
+	close(inChan)
+	for record := range fwdChan {
+		assert.Nil(t, record.GetRequest().GetStoreStatus())
+	}
+}
+
+func TestWriterAbort(t *testing.T) {
+	store := &mockStore{gate: make(chan struct{})}
+	inChan, done, writer := startWriterWithHandle(context.Background(),
+		server.WithWriterSettings(&service.Settings{}),
+		server.WithWriterStore(store),
+	)
+
+	// one record is blocked in the store and the rest wait in the queue
+	for _, record := range makeOutputRecords(10) {
+		inChan <- record
+	}
+	assert.Eventually(t, func() bool {
+		return writer.Stats().QueueDepth == 9
+	}, time.Second, time.Millisecond)
+
+	writer.Abort()
+	close(store.gate)
+	<-done
+
+	// records sent after aborting are not stored and do not block
+	for _, record := range makeOutputRecords(server.BufferSize * 2) {
+		inChan <- record
+	}
+	close(inChan)
+
+	assert.Len(t, store.records, 10)
+	assert.True(t, store.closed)
+}
+
+func TestWriterContextCancel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	store := &mockStore{}
+	inChan, done, _ := startWriterWithHandle(ctx,
+		server.WithWriterSettings(&service.Settings{}),
+		server.WithWriterStore(store),
+	)
+
+	// the stream cancels the context before closing the input, the records
+	// sent in between are still stored
+	for _, record := range makeOutputRecords(5) {
+		inChan <- record
+	}
+	cancel()
+	for _, record := range makeOutputRecords(5) {
+		inChan <- record
+	}
+	select {
+	case <-done:
+		t.Fatal("writer stopped before its input was closed")
+	default:
+	}
+	close(inChan)
+	<-done
+
+	assert.Len(t, store.records, 10)
+	assert.True(t, store.closed)
+}
+
+func TestWriterDedup(t *testing.T) {
+	store := &mockStore{}
+	record := &service.Record{
+		RecordType: &service.Record_Output{Output: &service.OutputRecord{Line: "line"}},
+		Uuid:       "uuid-1",
+	}
+	alwaysSend := &service.Record{
+		RecordType: &service.Record_Output{Output: &service.OutputRecord{Line: "exit"}},
+		Control:    &service.Control{AlwaysSend: true},
+	}
+	runWriter(t,
+		[]*service.Record{
+			record,
+			proto.Clone(record).(*service.Record),
+			alwaysSend,
+			proto.Clone(alwaysSend).(*service.Record),
+		},
+		server.WithWriterSettings(&service.Settings{}),
+		server.WithWriterStore(store),
+		server.WithWriterDedup(),
+	)
+
+	assert.Len(t, store.records, 3)
+	assert.Equal(t, "uuid-1", store.records[0].Uuid)
+	assert.Equal(t, "exit", store.records[1].GetOutput().GetLine())
+	assert.Equal(t, "exit", store.records[2].GetOutput().GetLine())
+}
+
+func TestWriterDedupWindow(t *testing.T) {
+	store := &mockStore{}
+	newRecord := func(line string) *service.Record {
+		return &service.Record{
+			RecordType: &service.Record_Output{Output: &service.OutputRecord{Line: line}},
+		}
+	}
+	runWriter(t,
+		[]*service.Record{
+			newRecord("a"),
+			newRecord("b"),
+			newRecord("a"), // dropped, still in the window
+			newRecord("c"), // evicts b
+			newRecord("b"), // stored, no longer in the window
+		},
+		server.WithWriterSettings(&service.Settings{}),
+		server.WithWriterStore(store),
+		server.WithWriterDedupWindow(2),
+	)
+
+	var lines []string
+	for _, record := range store.records {
+		lines = append(lines, record.GetOutput().GetLine())
+	}
+	assert.Equal(t, []string{"a", "b", "c", "b"}, lines)
+}
+
+func TestWriterDedupSameRecord(t *testing.T) {
+	store := &mockStore{}
+	record := &service.Record{
+		RecordType: &service.Record_Output{Output: &service.OutputRecord{Line: "line"}},
+	}
+	// the first pass assigns a record number to the record
+	runWriter(t,
+		[]*service.Record{record, record},
+		server.WithWriterSettings(&service.Settings{}),
+		server.WithWriterStore(store),
+		server.WithWriterDedup(),
+	)
+	assert.Len(t, store.records, 1)
+}
+
+func repeatDedupRecords() []*service.Record {
+	config := func(key string) *service.Record {
+		return &service.Record{RecordType: &service.Record_Config{Config: &service.ConfigRecord{
+			Update: []*service.ConfigItem{{Key: key, ValueJson: "1"}},
+		}}}
+	}
+	telemetry := &service.Record{RecordType: &service.Record_Telemetry{Telemetry: &service.TelemetryRecord{}}}
+	output := &service.Record{RecordType: &service.Record_Output{Output: &service.OutputRecord{Line: "line"}}}
+	return []*service.Record{
+		config("a"),
+		proto.Clone(telemetry).(*service.Record),
+		config("a"), // repeats the previous config
+		proto.Clone(output).(*service.Record),
+		proto.Clone(output).(*service.Record),    // not deduplicated
+		proto.Clone(telemetry).(*service.Record), // repeats the previous telemetry
+		config("b"),
+		config("a"),
+	}
+}
+
+func TestWriterRepeatDedup(t *testing.T) {
+	store := &mockStore{}
+	inChan, done, writer := startWriterWithHandle(context.Background(),
+		server.WithWriterSettings(&service.Settings{}),
+		server.WithWriterStore(store),
+		server.WithWriterRepeatDedup("config", "telemetry"),
+	)
+	for _, record := range repeatDedupRecords() {
+		inChan <- record
+	}
+	close(inChan)
+	<-done
+
+	var types []string
+	for _, record := range store.records {
+		types = append(types, string(record.ProtoReflect().WhichOneof(
+			record.ProtoReflect().Descriptor().Oneofs().ByName("record_type")).Name()))
+	}
+	assert.Equal(t, []string{"config", "telemetry", "output", "output", "config", "config"}, types)
+	assert.Equal(t, int64(2), writer.Stats().Repeated)
+}
+
+func TestWriterRepeatDedupSettings(t *testing.T) {
+	store := &mockStore{}
+	settings := &service.Settings{
+		XStoreDedupTypes: &service.ListStringValue{Value: []string{"config"}},
+	}
+	runWriter(t, repeatDedupRecords(),
+		server.WithWriterSettings(settings),
+		server.WithWriterStore(store),
+	)
+	assert.Len(t, store.records, 7)
+
+	// unknown types disable the deduplication
+	store = &mockStore{}
+	settings.XStoreDedupTypes.Value = []string{"config", "unknown"}
+	runWriter(t, repeatDedupRecords(),
+		server.WithWriterSettings(settings),
+		server.WithWriterStore(store),
+	)
+	assert.Len(t, store.records, 8)
+}
+
+func consoleRecord(line string, seconds int64) *service.Record {
+	return &service.Record{RecordType: &service.Record_OutputRaw{OutputRaw: &service.OutputRawRecord{
+		Line:      line,
+		Timestamp: &timestamppb.Timestamp{Seconds: seconds},
+	}}}
+}
+
+func storedLines(store *mockStore) []string {
+	var lines []string
+	for _, record := range store.records {
+		if output := record.GetOutputRaw(); output != nil {
+			lines = append(lines, output.GetLine())
+		}
+	}
+	return lines
+}
+
+func TestWriterConsoleRetention(t *testing.T) {
+	store := &mockStore{}
+	inChan, done, writer := startWriterWithHandle(context.Background(),
+		server.WithWriterSettings(&service.Settings{}),
+		server.WithWriterStore(store),
+		server.WithWriterConsoleRetention(server.ConsoleRetention{MaxLines: 2, MaxBytes: 10}),
+	)
+	for _, record := range []*service.Record{
+		consoleRecord("aaaa", 1),
+		{RecordType: &service.Record_History{History: &service.HistoryRecord{}}},
+		consoleRecord("bbbbbbbb", 2), // over the byte limit
+		consoleRecord("cc", 3),
+		consoleRecord("d", 4), // over the line limit
+	} {
+		inChan <- record
+	}
+	close(inChan)
+	<-done
+
+	assert.Equal(t, []string{"aaaa", "cc"}, storedLines(store))
+	assert.Len(t, store.records, 3)
+	assert.Equal(t, int64(2), writer.Stats().ConsoleDropped)
+}
+
+func TestWriterConsoleRetentionWindow(t *testing.T) {
+	store := &mockStore{}
+	settings := &service.Settings{
+		XStoreConsoleMaxLines:      &wrapperspb.Int64Value{Value: 2},
+		XStoreConsoleWindowSeconds: &wrapperspb.DoubleValue{Value: 10},
+	}
+	runWriter(t,
+		[]*service.Record{
+			consoleRecord("a", 0),
+			consoleRecord("b", 5),
+			consoleRecord("c", 8),  // two lines in the last 10 seconds
+			consoleRecord("d", 10), // a expired
+			consoleRecord("e", 14), // two lines in the last 10 seconds
+			consoleRecord("f", 16), // b expired, c not yet
+		},
+		server.WithWriterSettings(settings),
+		server.WithWriterStore(store),
+	)
+	assert.Equal(t, []string{"a", "b", "d", "f"}, storedLines(store))
+}
+
+func TestWriterResync(t *testing.T) {
+	fileName := filepath.Join(t.TempDir(), "run.wandb")
+	settings := &service.Settings{
+		SyncFile: &wrapperspb.StringValue{Value: fileName},
+		XOffline: &wrapperspb.BoolValue{Value: true},
+	}
+
+	fwdChan := make(chan *service.Record, server.BufferSize)
+	inChan := make(chan *service.Record, server.BufferSize)
+	writer := server.NewWriter(context.Background(), observability.NewNoOpLogger(),
+		server.WithWriterFwdChannel(fwdChan),
+		server.WithWriterSettings(settings),
+	)
+	go writer.Do(inChan)
+
+	// stored while offline, only the record that must always be sent is
+	// forwarded right away
+	records := makeOutputRecords(4)
+	records[1].Control = &service.Control{AlwaysSend: true}
+	for _, record := range records {
+		inChan <- record
+	}
+	forwarded := <-fwdChan
+	assert.Equal(t, int64(2), forwarded.Num)
+
+	inChan <- &service.Record{
+		RecordType: &service.Record_Request{Request: &service.Request{
+			RequestType: &service.Request_GoOnline{GoOnline: &service.GoOnlineRequest{}},
+		}},
+		Control: &service.Control{AlwaysSend: true},
+	}
+	for _, record := range makeOutputRecords(2) {
+		inChan <- record
+	}
+	close(inChan)
+
+	// the request goes first, then the records stored while offline
+	assert.NotNil(t, (<-fwdChan).GetRequest().GetGoOnline())
+	var nums []int64
+	for record := range fwdChan {
+		nums = append(nums, record.Num)
+	}
+	assert.Equal(t, []int64{1, 3, 4, 5, 6}, nums)
+}
+
+func BenchmarkWriterHistory(b *testing.B) {
+	fileName := filepath.Join(b.TempDir(), "run.wandb")
+	records := make([]*service.Record, b.N)
+	for i := range records {
+		history := &service.HistoryRecord{Step: &service.HistoryStep{Num: int64(i)}}
+		for j := 0; j < 20; j++ {
+			history.Item = append(history.Item, &service.HistoryItem{
+				Key:       fmt.Sprintf("metric_%d", j),
+				ValueJson: "0.123456789",
+			})
+		}
+		records[i] = &service.Record{RecordType: &service.Record_History{History: history}}
+	}
+	inChan, done := startWriter(server.WithWriterSettings(&service.Settings{
+		SyncFile: &wrapperspb.StringValue{Value: fileName},
+	}))
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for _, record := range records {
+		inChan <- record
+	}
+	close(inChan)
+	<-done
+}
+
+// makeRunRecords returns the records of a run with one record of each kind
+// sent to the backend
+func makeRunRecords() []*service.Record {
+	request := func(request *service.Request) *service.Record {
+		return &service.Record{
+			RecordType: &service.Record_Request{Request: request},
+			Control:    &service.Control{ConnectionId: "client"},
+		}
+	}
+	return []*service.Record{
+		{RecordType: &service.Record_Run{Run: &service.RunRecord{RunId: "run"}}},
+		request(&service.Request{RequestType: &service.Request_RunStart{RunStart: &service.RunStartRequest{}}}),
+		{RecordType: &service.Record_Telemetry{Telemetry: &service.TelemetryRecord{PythonVersion: "3.11"}}},
+		{RecordType: &service.Record_Config{Config: &service.ConfigRecord{
+			Update: []*service.ConfigItem{{Key: "lr", ValueJson: "0.1"}},
+		}}},
+		{RecordType: &service.Record_Alert{Alert: &service.AlertRecord{Title: "alert"}}},
+		{RecordType: &service.Record_Files{Files: &service.FilesRecord{
+			Files: []*service.FilesItem{{Path: "config.yaml"}},
+		}}},
+		{RecordType: &service.Record_History{History: &service.HistoryRecord{
+			Item: []*service.HistoryItem{{Key: "loss", ValueJson: "1"}},
+		}}},
+		{RecordType: &service.Record_Artifact{Artifact: &service.ArtifactRecord{Name: "dataset"}}},
+		request(&service.Request{RequestType: &service.Request_LogArtifact{LogArtifact: &service.LogArtifactRequest{
+			Artifact: &service.ArtifactRecord{Name: "model"}, HistoryStep: 1, StagingDir: "staging",
+		}}}),
+		{RecordType: &service.Record_UseArtifact{UseArtifact: &service.UseArtifactRecord{Name: "dataset"}}},
+		{RecordType: &service.Record_LinkArtifact{LinkArtifact: &service.LinkArtifactRecord{PortfolioName: "models"}}},
+		{RecordType: &service.Record_Summary{Summary: &service.SummaryRecord{
+			Update: []*service.SummaryItem{{Key: "loss", ValueJson: "1"}},
+		}}},
+		{RecordType: &service.Record_Exit{Exit: &service.RunExitRecord{}}, Control: &service.Control{AlwaysSend: true}},
+	}
+}
+
+// sentRecords returns the records as the sender gets them when a run is
+// synced, without their control and number
+func sentRecords(records []*service.Record) []*service.Record {
+	sent := make([]*service.Record, len(records))
+	for i, record := range records {
+		sent[i] = proto.Clone(record).(*service.Record)
+		sent[i].Control = nil
+		sent[i].Num = 0
+	}
+	return sent
+}
+
+func TestWriterOfflineSync(t *testing.T) {
+	// the records forwarded to the sender of an online run
+	fwdChan := make(chan *service.Record, server.BufferSize)
+	inChan := make(chan *service.Record, server.BufferSize)
+	writer := server.NewWriter(context.Background(), observability.NewNoOpLogger(),
+		server.WithWriterFwdChannel(fwdChan),
+		server.WithWriterSettings(&service.Settings{
+			SyncFile: &wrapperspb.StringValue{Value: filepath.Join(t.TempDir(), "online.wandb")},
+		}),
+	)
+	go writer.Do(inChan)
+	for _, record := range makeRunRecords() {
+		inChan <- record
+	}
+	close(inChan)
+	var online []*service.Record
+	for record := range fwdChan {
+		online = append(online, record)
+	}
+
+	// the records stored while offline, sent as the run is synced
+	fileName := filepath.Join(t.TempDir(), "run.wandb")
+	runWriter(t, makeRunRecords(), server.WithWriterSettings(&service.Settings{
+		SyncFile: &wrapperspb.StringValue{Value: fileName},
+		XOffline: &wrapperspb.BoolValue{Value: true},
+	}))
+	sender := &MockSender{}
+	syncService := server.NewSyncService(context.Background(),
+		server.WithSyncServiceSenderFunc(sender.Send),
+	)
+	syncService.Start()
+	for _, record := range readStore(t, fileName) {
+		switch record.GetRecordType().(type) {
+		case *service.Record_Header, *service.Record_Final, *service.Record_Footer:
+			continue
+		}
+		syncService.SyncRecord(record, nil)
+	}
+	syncService.Close()
+
+	online = sentRecords(online)
+	synced := sentRecords(sender.Records)
+	assert.Equal(t, len(online), len(synced))
+	for i := range online {
+		assert.True(t, proto.Equal(online[i], synced[i]), "record %d: %v != %v", i, online[i], synced[i])
+	}
+}
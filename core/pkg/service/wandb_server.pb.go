@@ -756,6 +756,10 @@ type ServerRequest struct {
 	//	*ServerRequest_InformDetach
 	//	*ServerRequest_InformTeardown
 	//	*ServerRequest_InformStart
+	//	*ServerRequest_CompactStore
+	//	*ServerRequest_ExportStore
+	//	*ServerRequest_ImportStore
+	//	*ServerRequest_VerifyStore
 	ServerRequestType isServerRequest_ServerRequestType `protobuf_oneof:"server_request_type"`
 }
 
@@ -854,6 +858,34 @@ func (x *ServerRequest) GetInformStart() *ServerInformStartRequest {
 	return nil
 }
 
+func (x *ServerRequest) GetCompactStore() *ServerCompactStoreRequest {
+	if x, ok := x.GetServerRequestType().(*ServerRequest_CompactStore); ok {
+		return x.CompactStore
+	}
+	return nil
+}
+
+func (x *ServerRequest) GetExportStore() *ServerExportStoreRequest {
+	if x, ok := x.GetServerRequestType().(*ServerRequest_ExportStore); ok {
+		return x.ExportStore
+	}
+	return nil
+}
+
+func (x *ServerRequest) GetImportStore() *ServerImportStoreRequest {
+	if x, ok := x.GetServerRequestType().(*ServerRequest_ImportStore); ok {
+		return x.ImportStore
+	}
+	return nil
+}
+
+func (x *ServerRequest) GetVerifyStore() *ServerVerifyStoreRequest {
+	if x, ok := x.GetServerRequestType().(*ServerRequest_VerifyStore); ok {
+		return x.VerifyStore
+	}
+	return nil
+}
+
 type isServerRequest_ServerRequestType interface {
 	isServerRequest_ServerRequestType()
 }
@@ -890,6 +922,22 @@ type ServerRequest_InformStart struct {
 	InformStart *ServerInformStartRequest `protobuf:"bytes,8,opt,name=inform_start,json=informStart,proto3,oneof"`
 }
 
+type ServerRequest_CompactStore struct {
+	CompactStore *ServerCompactStoreRequest `protobuf:"bytes,9,opt,name=compact_store,json=compactStore,proto3,oneof"`
+}
+
+type ServerRequest_ExportStore struct {
+	ExportStore *ServerExportStoreRequest `protobuf:"bytes,10,opt,name=export_store,json=exportStore,proto3,oneof"`
+}
+
+type ServerRequest_ImportStore struct {
+	ImportStore *ServerImportStoreRequest `protobuf:"bytes,11,opt,name=import_store,json=importStore,proto3,oneof"`
+}
+
+type ServerRequest_VerifyStore struct {
+	VerifyStore *ServerVerifyStoreRequest `protobuf:"bytes,12,opt,name=verify_store,json=verifyStore,proto3,oneof"`
+}
+
 func (*ServerRequest_RecordPublish) isServerRequest_ServerRequestType() {}
 
 func (*ServerRequest_RecordCommunicate) isServerRequest_ServerRequestType() {}
@@ -902,44 +950,641 @@ func (*ServerRequest_InformAttach) isServerRequest_ServerRequestType() {}
 
 func (*ServerRequest_InformDetach) isServerRequest_ServerRequestType() {}
 
-func (*ServerRequest_InformTeardown) isServerRequest_ServerRequestType() {}
+func (*ServerRequest_InformTeardown) isServerRequest_ServerRequestType() {}
+
+func (*ServerRequest_InformStart) isServerRequest_ServerRequestType() {}
+
+func (*ServerRequest_CompactStore) isServerRequest_ServerRequestType() {}
+
+func (*ServerRequest_ExportStore) isServerRequest_ServerRequestType() {}
+
+func (*ServerRequest_ImportStore) isServerRequest_ServerRequestType() {}
+
+func (*ServerRequest_VerifyStore) isServerRequest_ServerRequestType() {}
+
+type ServerResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to ServerResponseType:
+	//
+	//	*ServerResponse_ResultCommunicate
+	//	*ServerResponse_InformInitResponse
+	//	*ServerResponse_InformFinishResponse
+	//	*ServerResponse_InformAttachResponse
+	//	*ServerResponse_InformDetachResponse
+	//	*ServerResponse_InformTeardownResponse
+	//	*ServerResponse_InformStartResponse
+	//	*ServerResponse_CompactStoreResponse
+	//	*ServerResponse_ExportStoreResponse
+	//	*ServerResponse_ImportStoreResponse
+	//	*ServerResponse_VerifyStoreResponse
+	ServerResponseType isServerResponse_ServerResponseType `protobuf_oneof:"server_response_type"`
+}
+
+func (x *ServerResponse) Reset() {
+	*x = ServerResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_wandb_proto_wandb_server_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ServerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServerResponse) ProtoMessage() {}
+
+func (x *ServerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_wandb_proto_wandb_server_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServerResponse.ProtoReflect.Descriptor instead.
+func (*ServerResponse) Descriptor() ([]byte, []int) {
+	return file_wandb_proto_wandb_server_proto_rawDescGZIP(), []int{17}
+}
+
+func (m *ServerResponse) GetServerResponseType() isServerResponse_ServerResponseType {
+	if m != nil {
+		return m.ServerResponseType
+	}
+	return nil
+}
+
+func (x *ServerResponse) GetResultCommunicate() *Result {
+	if x, ok := x.GetServerResponseType().(*ServerResponse_ResultCommunicate); ok {
+		return x.ResultCommunicate
+	}
+	return nil
+}
+
+func (x *ServerResponse) GetInformInitResponse() *ServerInformInitResponse {
+	if x, ok := x.GetServerResponseType().(*ServerResponse_InformInitResponse); ok {
+		return x.InformInitResponse
+	}
+	return nil
+}
+
+func (x *ServerResponse) GetInformFinishResponse() *ServerInformFinishResponse {
+	if x, ok := x.GetServerResponseType().(*ServerResponse_InformFinishResponse); ok {
+		return x.InformFinishResponse
+	}
+	return nil
+}
+
+func (x *ServerResponse) GetInformAttachResponse() *ServerInformAttachResponse {
+	if x, ok := x.GetServerResponseType().(*ServerResponse_InformAttachResponse); ok {
+		return x.InformAttachResponse
+	}
+	return nil
+}
+
+func (x *ServerResponse) GetInformDetachResponse() *ServerInformDetachResponse {
+	if x, ok := x.GetServerResponseType().(*ServerResponse_InformDetachResponse); ok {
+		return x.InformDetachResponse
+	}
+	return nil
+}
+
+func (x *ServerResponse) GetInformTeardownResponse() *ServerInformTeardownResponse {
+	if x, ok := x.GetServerResponseType().(*ServerResponse_InformTeardownResponse); ok {
+		return x.InformTeardownResponse
+	}
+	return nil
+}
+
+func (x *ServerResponse) GetInformStartResponse() *ServerInformStartResponse {
+	if x, ok := x.GetServerResponseType().(*ServerResponse_InformStartResponse); ok {
+		return x.InformStartResponse
+	}
+	return nil
+}
+
+func (x *ServerResponse) GetCompactStoreResponse() *ServerCompactStoreResponse {
+	if x, ok := x.GetServerResponseType().(*ServerResponse_CompactStoreResponse); ok {
+		return x.CompactStoreResponse
+	}
+	return nil
+}
+
+func (x *ServerResponse) GetExportStoreResponse() *ServerExportStoreResponse {
+	if x, ok := x.GetServerResponseType().(*ServerResponse_ExportStoreResponse); ok {
+		return x.ExportStoreResponse
+	}
+	return nil
+}
+
+func (x *ServerResponse) GetImportStoreResponse() *ServerImportStoreResponse {
+	if x, ok := x.GetServerResponseType().(*ServerResponse_ImportStoreResponse); ok {
+		return x.ImportStoreResponse
+	}
+	return nil
+}
+
+func (x *ServerResponse) GetVerifyStoreResponse() *ServerVerifyStoreResponse {
+	if x, ok := x.GetServerResponseType().(*ServerResponse_VerifyStoreResponse); ok {
+		return x.VerifyStoreResponse
+	}
+	return nil
+}
+
+type isServerResponse_ServerResponseType interface {
+	isServerResponse_ServerResponseType()
+}
+
+type ServerResponse_ResultCommunicate struct {
+	ResultCommunicate *Result `protobuf:"bytes,2,opt,name=result_communicate,json=resultCommunicate,proto3,oneof"`
+}
+
+type ServerResponse_InformInitResponse struct {
+	InformInitResponse *ServerInformInitResponse `protobuf:"bytes,3,opt,name=inform_init_response,json=informInitResponse,proto3,oneof"`
+}
+
+type ServerResponse_InformFinishResponse struct {
+	InformFinishResponse *ServerInformFinishResponse `protobuf:"bytes,4,opt,name=inform_finish_response,json=informFinishResponse,proto3,oneof"`
+}
+
+type ServerResponse_InformAttachResponse struct {
+	InformAttachResponse *ServerInformAttachResponse `protobuf:"bytes,5,opt,name=inform_attach_response,json=informAttachResponse,proto3,oneof"`
+}
+
+type ServerResponse_InformDetachResponse struct {
+	InformDetachResponse *ServerInformDetachResponse `protobuf:"bytes,6,opt,name=inform_detach_response,json=informDetachResponse,proto3,oneof"`
+}
+
+type ServerResponse_InformTeardownResponse struct {
+	InformTeardownResponse *ServerInformTeardownResponse `protobuf:"bytes,7,opt,name=inform_teardown_response,json=informTeardownResponse,proto3,oneof"`
+}
+
+type ServerResponse_InformStartResponse struct {
+	InformStartResponse *ServerInformStartResponse `protobuf:"bytes,8,opt,name=inform_start_response,json=informStartResponse,proto3,oneof"`
+}
+
+type ServerResponse_CompactStoreResponse struct {
+	CompactStoreResponse *ServerCompactStoreResponse `protobuf:"bytes,9,opt,name=compact_store_response,json=compactStoreResponse,proto3,oneof"`
+}
+
+type ServerResponse_ExportStoreResponse struct {
+	ExportStoreResponse *ServerExportStoreResponse `protobuf:"bytes,10,opt,name=export_store_response,json=exportStoreResponse,proto3,oneof"`
+}
+
+type ServerResponse_ImportStoreResponse struct {
+	ImportStoreResponse *ServerImportStoreResponse `protobuf:"bytes,11,opt,name=import_store_response,json=importStoreResponse,proto3,oneof"`
+}
+
+type ServerResponse_VerifyStoreResponse struct {
+	VerifyStoreResponse *ServerVerifyStoreResponse `protobuf:"bytes,12,opt,name=verify_store_response,json=verifyStoreResponse,proto3,oneof"`
+}
+
+func (*ServerResponse_ResultCommunicate) isServerResponse_ServerResponseType() {}
+
+func (*ServerResponse_InformInitResponse) isServerResponse_ServerResponseType() {}
+
+func (*ServerResponse_InformFinishResponse) isServerResponse_ServerResponseType() {}
+
+func (*ServerResponse_InformAttachResponse) isServerResponse_ServerResponseType() {}
+
+func (*ServerResponse_InformDetachResponse) isServerResponse_ServerResponseType() {}
+
+func (*ServerResponse_InformTeardownResponse) isServerResponse_ServerResponseType() {}
+
+func (*ServerResponse_InformStartResponse) isServerResponse_ServerResponseType() {}
+
+func (*ServerResponse_CompactStoreResponse) isServerResponse_ServerResponseType() {}
+
+func (*ServerResponse_ExportStoreResponse) isServerResponse_ServerResponseType() {}
+
+func (*ServerResponse_ImportStoreResponse) isServerResponse_ServerResponseType() {}
+
+func (*ServerResponse_VerifyStoreResponse) isServerResponse_ServerResponseType() {}
+
+type ServerCompactStoreRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Path       string       `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	OutputPath string       `protobuf:"bytes,2,opt,name=output_path,json=outputPath,proto3" json:"output_path,omitempty"`
+	XInfo      *XRecordInfo `protobuf:"bytes,200,opt,name=_info,json=Info,proto3" json:"_info,omitempty"`
+}
+
+func (x *ServerCompactStoreRequest) Reset() {
+	*x = ServerCompactStoreRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_wandb_proto_wandb_server_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ServerCompactStoreRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServerCompactStoreRequest) ProtoMessage() {}
+
+func (x *ServerCompactStoreRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_wandb_proto_wandb_server_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServerCompactStoreRequest.ProtoReflect.Descriptor instead.
+func (*ServerCompactStoreRequest) Descriptor() ([]byte, []int) {
+	return file_wandb_proto_wandb_server_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *ServerCompactStoreRequest) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *ServerCompactStoreRequest) GetOutputPath() string {
+	if x != nil {
+		return x.OutputPath
+	}
+	return ""
+}
+
+func (x *ServerCompactStoreRequest) GetXInfo() *XRecordInfo {
+	if x != nil {
+		return x.XInfo
+	}
+	return nil
+}
+
+type ServerCompactStoreResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RecordsRead    int64        `protobuf:"varint,1,opt,name=records_read,json=recordsRead,proto3" json:"records_read,omitempty"`
+	RecordsWritten int64        `protobuf:"varint,2,opt,name=records_written,json=recordsWritten,proto3" json:"records_written,omitempty"`
+	Error          *ErrorInfo   `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	XInfo          *XRecordInfo `protobuf:"bytes,200,opt,name=_info,json=Info,proto3" json:"_info,omitempty"`
+}
+
+func (x *ServerCompactStoreResponse) Reset() {
+	*x = ServerCompactStoreResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_wandb_proto_wandb_server_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ServerCompactStoreResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServerCompactStoreResponse) ProtoMessage() {}
+
+func (x *ServerCompactStoreResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_wandb_proto_wandb_server_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServerCompactStoreResponse.ProtoReflect.Descriptor instead.
+func (*ServerCompactStoreResponse) Descriptor() ([]byte, []int) {
+	return file_wandb_proto_wandb_server_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *ServerCompactStoreResponse) GetRecordsRead() int64 {
+	if x != nil {
+		return x.RecordsRead
+	}
+	return 0
+}
+
+func (x *ServerCompactStoreResponse) GetRecordsWritten() int64 {
+	if x != nil {
+		return x.RecordsWritten
+	}
+	return 0
+}
+
+func (x *ServerCompactStoreResponse) GetError() *ErrorInfo {
+	if x != nil {
+		return x.Error
+	}
+	return nil
+}
+
+func (x *ServerCompactStoreResponse) GetXInfo() *XRecordInfo {
+	if x != nil {
+		return x.XInfo
+	}
+	return nil
+}
+
+type ServerExportStoreRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Path      string       `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	OutputDir string       `protobuf:"bytes,2,opt,name=output_dir,json=outputDir,proto3" json:"output_dir,omitempty"`
+	Format    string       `protobuf:"bytes,3,opt,name=format,proto3" json:"format,omitempty"`
+	XInfo     *XRecordInfo `protobuf:"bytes,200,opt,name=_info,json=Info,proto3" json:"_info,omitempty"`
+}
+
+func (x *ServerExportStoreRequest) Reset() {
+	*x = ServerExportStoreRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_wandb_proto_wandb_server_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ServerExportStoreRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServerExportStoreRequest) ProtoMessage() {}
+
+func (x *ServerExportStoreRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_wandb_proto_wandb_server_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServerExportStoreRequest.ProtoReflect.Descriptor instead.
+func (*ServerExportStoreRequest) Descriptor() ([]byte, []int) {
+	return file_wandb_proto_wandb_server_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *ServerExportStoreRequest) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *ServerExportStoreRequest) GetOutputDir() string {
+	if x != nil {
+		return x.OutputDir
+	}
+	return ""
+}
+
+func (x *ServerExportStoreRequest) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+func (x *ServerExportStoreRequest) GetXInfo() *XRecordInfo {
+	if x != nil {
+		return x.XInfo
+	}
+	return nil
+}
+
+type ServerExportStoreResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	HistoryRows int64        `protobuf:"varint,1,opt,name=history_rows,json=historyRows,proto3" json:"history_rows,omitempty"`
+	ConfigRows  int64        `protobuf:"varint,2,opt,name=config_rows,json=configRows,proto3" json:"config_rows,omitempty"`
+	SummaryRows int64        `protobuf:"varint,3,opt,name=summary_rows,json=summaryRows,proto3" json:"summary_rows,omitempty"`
+	ConsoleRows int64        `protobuf:"varint,4,opt,name=console_rows,json=consoleRows,proto3" json:"console_rows,omitempty"`
+	Error       *ErrorInfo   `protobuf:"bytes,5,opt,name=error,proto3" json:"error,omitempty"`
+	XInfo       *XRecordInfo `protobuf:"bytes,200,opt,name=_info,json=Info,proto3" json:"_info,omitempty"`
+}
+
+func (x *ServerExportStoreResponse) Reset() {
+	*x = ServerExportStoreResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_wandb_proto_wandb_server_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ServerExportStoreResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServerExportStoreResponse) ProtoMessage() {}
+
+func (x *ServerExportStoreResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_wandb_proto_wandb_server_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServerExportStoreResponse.ProtoReflect.Descriptor instead.
+func (*ServerExportStoreResponse) Descriptor() ([]byte, []int) {
+	return file_wandb_proto_wandb_server_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *ServerExportStoreResponse) GetHistoryRows() int64 {
+	if x != nil {
+		return x.HistoryRows
+	}
+	return 0
+}
+
+func (x *ServerExportStoreResponse) GetConfigRows() int64 {
+	if x != nil {
+		return x.ConfigRows
+	}
+	return 0
+}
+
+func (x *ServerExportStoreResponse) GetSummaryRows() int64 {
+	if x != nil {
+		return x.SummaryRows
+	}
+	return 0
+}
+
+func (x *ServerExportStoreResponse) GetConsoleRows() int64 {
+	if x != nil {
+		return x.ConsoleRows
+	}
+	return 0
+}
+
+func (x *ServerExportStoreResponse) GetError() *ErrorInfo {
+	if x != nil {
+		return x.Error
+	}
+	return nil
+}
+
+func (x *ServerExportStoreResponse) GetXInfo() *XRecordInfo {
+	if x != nil {
+		return x.XInfo
+	}
+	return nil
+}
+
+type ServerImportStoreRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Path       string       `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	OutputPath string       `protobuf:"bytes,2,opt,name=output_path,json=outputPath,proto3" json:"output_path,omitempty"`
+	Format     string       `protobuf:"bytes,3,opt,name=format,proto3" json:"format,omitempty"`
+	RunId      string       `protobuf:"bytes,4,opt,name=run_id,json=runId,proto3" json:"run_id,omitempty"`
+	Project    string       `protobuf:"bytes,5,opt,name=project,proto3" json:"project,omitempty"`
+	Entity     string       `protobuf:"bytes,6,opt,name=entity,proto3" json:"entity,omitempty"`
+	XInfo      *XRecordInfo `protobuf:"bytes,200,opt,name=_info,json=Info,proto3" json:"_info,omitempty"`
+}
+
+func (x *ServerImportStoreRequest) Reset() {
+	*x = ServerImportStoreRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_wandb_proto_wandb_server_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ServerImportStoreRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServerImportStoreRequest) ProtoMessage() {}
+
+func (x *ServerImportStoreRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_wandb_proto_wandb_server_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServerImportStoreRequest.ProtoReflect.Descriptor instead.
+func (*ServerImportStoreRequest) Descriptor() ([]byte, []int) {
+	return file_wandb_proto_wandb_server_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *ServerImportStoreRequest) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *ServerImportStoreRequest) GetOutputPath() string {
+	if x != nil {
+		return x.OutputPath
+	}
+	return ""
+}
+
+func (x *ServerImportStoreRequest) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+func (x *ServerImportStoreRequest) GetRunId() string {
+	if x != nil {
+		return x.RunId
+	}
+	return ""
+}
+
+func (x *ServerImportStoreRequest) GetProject() string {
+	if x != nil {
+		return x.Project
+	}
+	return ""
+}
+
+func (x *ServerImportStoreRequest) GetEntity() string {
+	if x != nil {
+		return x.Entity
+	}
+	return ""
+}
 
-func (*ServerRequest_InformStart) isServerRequest_ServerRequestType() {}
+func (x *ServerImportStoreRequest) GetXInfo() *XRecordInfo {
+	if x != nil {
+		return x.XInfo
+	}
+	return nil
+}
 
-type ServerResponse struct {
+type ServerImportStoreResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Types that are assignable to ServerResponseType:
-	//
-	//	*ServerResponse_ResultCommunicate
-	//	*ServerResponse_InformInitResponse
-	//	*ServerResponse_InformFinishResponse
-	//	*ServerResponse_InformAttachResponse
-	//	*ServerResponse_InformDetachResponse
-	//	*ServerResponse_InformTeardownResponse
-	//	*ServerResponse_InformStartResponse
-	ServerResponseType isServerResponse_ServerResponseType `protobuf_oneof:"server_response_type"`
+	RecordsWritten int64        `protobuf:"varint,1,opt,name=records_written,json=recordsWritten,proto3" json:"records_written,omitempty"`
+	HistoryRows    int64        `protobuf:"varint,2,opt,name=history_rows,json=historyRows,proto3" json:"history_rows,omitempty"`
+	Skipped        int64        `protobuf:"varint,3,opt,name=skipped,proto3" json:"skipped,omitempty"`
+	Error          *ErrorInfo   `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+	XInfo          *XRecordInfo `protobuf:"bytes,200,opt,name=_info,json=Info,proto3" json:"_info,omitempty"`
 }
 
-func (x *ServerResponse) Reset() {
-	*x = ServerResponse{}
+func (x *ServerImportStoreResponse) Reset() {
+	*x = ServerImportStoreResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_wandb_proto_wandb_server_proto_msgTypes[17]
+		mi := &file_wandb_proto_wandb_server_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ServerResponse) String() string {
+func (x *ServerImportStoreResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ServerResponse) ProtoMessage() {}
+func (*ServerImportStoreResponse) ProtoMessage() {}
 
-func (x *ServerResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_wandb_proto_wandb_server_proto_msgTypes[17]
+func (x *ServerImportStoreResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_wandb_proto_wandb_server_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -950,112 +1595,163 @@ func (x *ServerResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ServerResponse.ProtoReflect.Descriptor instead.
-func (*ServerResponse) Descriptor() ([]byte, []int) {
-	return file_wandb_proto_wandb_server_proto_rawDescGZIP(), []int{17}
+// Deprecated: Use ServerImportStoreResponse.ProtoReflect.Descriptor instead.
+func (*ServerImportStoreResponse) Descriptor() ([]byte, []int) {
+	return file_wandb_proto_wandb_server_proto_rawDescGZIP(), []int{23}
 }
 
-func (m *ServerResponse) GetServerResponseType() isServerResponse_ServerResponseType {
-	if m != nil {
-		return m.ServerResponseType
+func (x *ServerImportStoreResponse) GetRecordsWritten() int64 {
+	if x != nil {
+		return x.RecordsWritten
 	}
-	return nil
+	return 0
 }
 
-func (x *ServerResponse) GetResultCommunicate() *Result {
-	if x, ok := x.GetServerResponseType().(*ServerResponse_ResultCommunicate); ok {
-		return x.ResultCommunicate
+func (x *ServerImportStoreResponse) GetHistoryRows() int64 {
+	if x != nil {
+		return x.HistoryRows
 	}
-	return nil
+	return 0
 }
 
-func (x *ServerResponse) GetInformInitResponse() *ServerInformInitResponse {
-	if x, ok := x.GetServerResponseType().(*ServerResponse_InformInitResponse); ok {
-		return x.InformInitResponse
+func (x *ServerImportStoreResponse) GetSkipped() int64 {
+	if x != nil {
+		return x.Skipped
 	}
-	return nil
+	return 0
 }
 
-func (x *ServerResponse) GetInformFinishResponse() *ServerInformFinishResponse {
-	if x, ok := x.GetServerResponseType().(*ServerResponse_InformFinishResponse); ok {
-		return x.InformFinishResponse
+func (x *ServerImportStoreResponse) GetError() *ErrorInfo {
+	if x != nil {
+		return x.Error
 	}
 	return nil
 }
 
-func (x *ServerResponse) GetInformAttachResponse() *ServerInformAttachResponse {
-	if x, ok := x.GetServerResponseType().(*ServerResponse_InformAttachResponse); ok {
-		return x.InformAttachResponse
+func (x *ServerImportStoreResponse) GetXInfo() *XRecordInfo {
+	if x != nil {
+		return x.XInfo
 	}
 	return nil
 }
 
-func (x *ServerResponse) GetInformDetachResponse() *ServerInformDetachResponse {
-	if x, ok := x.GetServerResponseType().(*ServerResponse_InformDetachResponse); ok {
-		return x.InformDetachResponse
-	}
-	return nil
-}
+type ServerVerifyStoreRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-func (x *ServerResponse) GetInformTeardownResponse() *ServerInformTeardownResponse {
-	if x, ok := x.GetServerResponseType().(*ServerResponse_InformTeardownResponse); ok {
-		return x.InformTeardownResponse
-	}
-	return nil
+	Path  string       `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	XInfo *XRecordInfo `protobuf:"bytes,200,opt,name=_info,json=Info,proto3" json:"_info,omitempty"`
 }
 
-func (x *ServerResponse) GetInformStartResponse() *ServerInformStartResponse {
-	if x, ok := x.GetServerResponseType().(*ServerResponse_InformStartResponse); ok {
-		return x.InformStartResponse
+func (x *ServerVerifyStoreRequest) Reset() {
+	*x = ServerVerifyStoreRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_wandb_proto_wandb_server_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return nil
 }
 
-type isServerResponse_ServerResponseType interface {
-	isServerResponse_ServerResponseType()
+func (x *ServerVerifyStoreRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-type ServerResponse_ResultCommunicate struct {
-	ResultCommunicate *Result `protobuf:"bytes,2,opt,name=result_communicate,json=resultCommunicate,proto3,oneof"`
-}
+func (*ServerVerifyStoreRequest) ProtoMessage() {}
 
-type ServerResponse_InformInitResponse struct {
-	InformInitResponse *ServerInformInitResponse `protobuf:"bytes,3,opt,name=inform_init_response,json=informInitResponse,proto3,oneof"`
+func (x *ServerVerifyStoreRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_wandb_proto_wandb_server_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-type ServerResponse_InformFinishResponse struct {
-	InformFinishResponse *ServerInformFinishResponse `protobuf:"bytes,4,opt,name=inform_finish_response,json=informFinishResponse,proto3,oneof"`
+// Deprecated: Use ServerVerifyStoreRequest.ProtoReflect.Descriptor instead.
+func (*ServerVerifyStoreRequest) Descriptor() ([]byte, []int) {
+	return file_wandb_proto_wandb_server_proto_rawDescGZIP(), []int{24}
 }
 
-type ServerResponse_InformAttachResponse struct {
-	InformAttachResponse *ServerInformAttachResponse `protobuf:"bytes,5,opt,name=inform_attach_response,json=informAttachResponse,proto3,oneof"`
+func (x *ServerVerifyStoreRequest) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
 }
 
-type ServerResponse_InformDetachResponse struct {
-	InformDetachResponse *ServerInformDetachResponse `protobuf:"bytes,6,opt,name=inform_detach_response,json=informDetachResponse,proto3,oneof"`
+func (x *ServerVerifyStoreRequest) GetXInfo() *XRecordInfo {
+	if x != nil {
+		return x.XInfo
+	}
+	return nil
 }
 
-type ServerResponse_InformTeardownResponse struct {
-	InformTeardownResponse *ServerInformTeardownResponse `protobuf:"bytes,7,opt,name=inform_teardown_response,json=informTeardownResponse,proto3,oneof"`
+type ServerVerifyStoreResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Report *VerifyReport `protobuf:"bytes,1,opt,name=report,proto3" json:"report,omitempty"`
+	Error  *ErrorInfo    `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	XInfo  *XRecordInfo  `protobuf:"bytes,200,opt,name=_info,json=Info,proto3" json:"_info,omitempty"`
 }
 
-type ServerResponse_InformStartResponse struct {
-	InformStartResponse *ServerInformStartResponse `protobuf:"bytes,8,opt,name=inform_start_response,json=informStartResponse,proto3,oneof"`
+func (x *ServerVerifyStoreResponse) Reset() {
+	*x = ServerVerifyStoreResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_wandb_proto_wandb_server_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
 }
 
-func (*ServerResponse_ResultCommunicate) isServerResponse_ServerResponseType() {}
+func (x *ServerVerifyStoreResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
 
-func (*ServerResponse_InformInitResponse) isServerResponse_ServerResponseType() {}
+func (*ServerVerifyStoreResponse) ProtoMessage() {}
 
-func (*ServerResponse_InformFinishResponse) isServerResponse_ServerResponseType() {}
+func (x *ServerVerifyStoreResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_wandb_proto_wandb_server_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
 
-func (*ServerResponse_InformAttachResponse) isServerResponse_ServerResponseType() {}
+// Deprecated: Use ServerVerifyStoreResponse.ProtoReflect.Descriptor instead.
+func (*ServerVerifyStoreResponse) Descriptor() ([]byte, []int) {
+	return file_wandb_proto_wandb_server_proto_rawDescGZIP(), []int{25}
+}
 
-func (*ServerResponse_InformDetachResponse) isServerResponse_ServerResponseType() {}
+func (x *ServerVerifyStoreResponse) GetReport() *VerifyReport {
+	if x != nil {
+		return x.Report
+	}
+	return nil
+}
 
-func (*ServerResponse_InformTeardownResponse) isServerResponse_ServerResponseType() {}
+func (x *ServerVerifyStoreResponse) GetError() *ErrorInfo {
+	if x != nil {
+		return x.Error
+	}
+	return nil
+}
 
-func (*ServerResponse_InformStartResponse) isServerResponse_ServerResponseType() {}
+func (x *ServerVerifyStoreResponse) GetXInfo() *XRecordInfo {
+	if x != nil {
+		return x.XInfo
+	}
+	return nil
+}
 
 var File_wandb_proto_wandb_server_proto protoreflect.FileDescriptor
 
@@ -1138,7 +1834,7 @@ var file_wandb_proto_wandb_server_proto_rawDesc = []byte{
 	0x5f, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x04, 0x49, 0x6e, 0x66,
 	0x6f, 0x22, 0x1e, 0x0a, 0x1c, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x72,
 	0x6d, 0x54, 0x65, 0x61, 0x72, 0x64, 0x6f, 0x77, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x99, 0x05, 0x0a, 0x0d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x22, 0xd8, 0x07, 0x0a, 0x0d, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75,
 	0x65, 0x73, 0x74, 0x12, 0x3f, 0x0a, 0x0e, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x5f, 0x70, 0x75,
 	0x62, 0x6c, 0x69, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x77, 0x61,
 	0x6e, 0x64, 0x62, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2e, 0x52, 0x65, 0x63,
@@ -1178,53 +1874,192 @@ var file_wandb_proto_wandb_server_proto_rawDesc = []byte{
 	0x77, 0x61, 0x6e, 0x64, 0x62, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2e, 0x53,
 	0x65, 0x72, 0x76, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x53, 0x74, 0x61, 0x72, 0x74,
 	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x0b, 0x69, 0x6e, 0x66, 0x6f, 0x72,
-	0x6d, 0x53, 0x74, 0x61, 0x72, 0x74, 0x42, 0x15, 0x0a, 0x13, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72,
-	0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x22, 0xc6, 0x05,
-	0x0a, 0x0e, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x47, 0x0a, 0x12, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x75,
-	0x6e, 0x69, 0x63, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x77,
-	0x61, 0x6e, 0x64, 0x62, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2e, 0x52, 0x65,
-	0x73, 0x75, 0x6c, 0x74, 0x48, 0x00, 0x52, 0x11, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x43, 0x6f,
-	0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x63, 0x61, 0x74, 0x65, 0x12, 0x5c, 0x0a, 0x14, 0x69, 0x6e, 0x66,
-	0x6f, 0x72, 0x6d, 0x5f, 0x69, 0x6e, 0x69, 0x74, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x77, 0x61, 0x6e, 0x64, 0x62, 0x5f,
-	0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x49,
-	0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x49, 0x6e, 0x69, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x48, 0x00, 0x52, 0x12, 0x69, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x49, 0x6e, 0x69, 0x74, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x62, 0x0a, 0x16, 0x69, 0x6e, 0x66, 0x6f, 0x72,
-	0x6d, 0x5f, 0x66, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x77, 0x61, 0x6e, 0x64, 0x62, 0x5f,
-	0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x49,
-	0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x46, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x14, 0x69, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x46, 0x69, 0x6e,
-	0x69, 0x73, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x62, 0x0a, 0x16, 0x69,
-	0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x5f, 0x61, 0x74, 0x74, 0x61, 0x63, 0x68, 0x5f, 0x72, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x77, 0x61,
-	0x6e, 0x64, 0x62, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2e, 0x53, 0x65, 0x72,
-	0x76, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x14, 0x69, 0x6e, 0x66, 0x6f, 0x72,
-	0x6d, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x62, 0x0a, 0x16, 0x69, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x5f, 0x64, 0x65, 0x74, 0x61, 0x63, 0x68,
-	0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x2a, 0x2e, 0x77, 0x61, 0x6e, 0x64, 0x62, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c,
-	0x2e, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x44, 0x65, 0x74,
-	0x61, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x14, 0x69,
-	0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x44, 0x65, 0x74, 0x61, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x68, 0x0a, 0x18, 0x69, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x5f, 0x74, 0x65,
-	0x61, 0x72, 0x64, 0x6f, 0x77, 0x6e, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18,
-	0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x77, 0x61, 0x6e, 0x64, 0x62, 0x5f, 0x69, 0x6e,
-	0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x49, 0x6e, 0x66,
-	0x6f, 0x72, 0x6d, 0x54, 0x65, 0x61, 0x72, 0x64, 0x6f, 0x77, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x16, 0x69, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x54, 0x65, 0x61,
-	0x72, 0x64, 0x6f, 0x77, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5f, 0x0a,
-	0x15, 0x69, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x72, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x77,
+	0x6d, 0x53, 0x74, 0x61, 0x72, 0x74, 0x12, 0x50, 0x0a, 0x0d, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x63,
+	0x74, 0x5f, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e,
+	0x77, 0x61, 0x6e, 0x64, 0x62, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2e, 0x53,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x63, 0x74, 0x53, 0x74, 0x6f, 0x72,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x0c, 0x63, 0x6f, 0x6d, 0x70,
+	0x61, 0x63, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x12, 0x4d, 0x0a, 0x0c, 0x65, 0x78, 0x70, 0x6f,
+	0x72, 0x74, 0x5f, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28,
+	0x2e, 0x77, 0x61, 0x6e, 0x64, 0x62, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2e,
+	0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x53, 0x74, 0x6f, 0x72,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x0b, 0x65, 0x78, 0x70, 0x6f,
+	0x72, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x12, 0x4d, 0x0a, 0x0c, 0x69, 0x6d, 0x70, 0x6f, 0x72,
+	0x74, 0x5f, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e,
+	0x77, 0x61, 0x6e, 0x64, 0x62, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2e, 0x53,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x0b, 0x69, 0x6d, 0x70, 0x6f, 0x72,
+	0x74, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x12, 0x4d, 0x0a, 0x0c, 0x76, 0x65, 0x72, 0x69, 0x66, 0x79,
+	0x5f, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x77,
 	0x61, 0x6e, 0x64, 0x62, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2e, 0x53, 0x65,
-	0x72, 0x76, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x53, 0x74, 0x61, 0x72, 0x74, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x13, 0x69, 0x6e, 0x66, 0x6f, 0x72,
-	0x6d, 0x53, 0x74, 0x61, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x16,
-	0x0a, 0x14, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x72, 0x76, 0x65, 0x72, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x0b, 0x76, 0x65, 0x72, 0x69, 0x66, 0x79,
+	0x53, 0x74, 0x6f, 0x72, 0x65, 0x42, 0x15, 0x0a, 0x13, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f,
+	0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x22, 0xcd, 0x08, 0x0a,
+	0x0e, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x47, 0x0a, 0x12, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x75, 0x6e,
+	0x69, 0x63, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x77, 0x61,
+	0x6e, 0x64, 0x62, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2e, 0x52, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x48, 0x00, 0x52, 0x11, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x43, 0x6f, 0x6d,
+	0x6d, 0x75, 0x6e, 0x69, 0x63, 0x61, 0x74, 0x65, 0x12, 0x5c, 0x0a, 0x14, 0x69, 0x6e, 0x66, 0x6f,
+	0x72, 0x6d, 0x5f, 0x69, 0x6e, 0x69, 0x74, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x77, 0x61, 0x6e, 0x64, 0x62, 0x5f, 0x69,
+	0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x49, 0x6e,
+	0x66, 0x6f, 0x72, 0x6d, 0x49, 0x6e, 0x69, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x48, 0x00, 0x52, 0x12, 0x69, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x49, 0x6e, 0x69, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x62, 0x0a, 0x16, 0x69, 0x6e, 0x66, 0x6f, 0x72, 0x6d,
+	0x5f, 0x66, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x77, 0x61, 0x6e, 0x64, 0x62, 0x5f, 0x69,
+	0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x49, 0x6e,
+	0x66, 0x6f, 0x72, 0x6d, 0x46, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x48, 0x00, 0x52, 0x14, 0x69, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x46, 0x69, 0x6e, 0x69,
+	0x73, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x62, 0x0a, 0x16, 0x69, 0x6e,
+	0x66, 0x6f, 0x72, 0x6d, 0x5f, 0x61, 0x74, 0x74, 0x61, 0x63, 0x68, 0x5f, 0x72, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x77, 0x61, 0x6e,
+	0x64, 0x62, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2e, 0x53, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x14, 0x69, 0x6e, 0x66, 0x6f, 0x72, 0x6d,
+	0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x62,
+	0x0a, 0x16, 0x69, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x5f, 0x64, 0x65, 0x74, 0x61, 0x63, 0x68, 0x5f,
+	0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a,
+	0x2e, 0x77, 0x61, 0x6e, 0x64, 0x62, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2e,
+	0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x44, 0x65, 0x74, 0x61,
+	0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x14, 0x69, 0x6e,
+	0x66, 0x6f, 0x72, 0x6d, 0x44, 0x65, 0x74, 0x61, 0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x68, 0x0a, 0x18, 0x69, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x5f, 0x74, 0x65, 0x61,
+	0x72, 0x64, 0x6f, 0x77, 0x6e, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x77, 0x61, 0x6e, 0x64, 0x62, 0x5f, 0x69, 0x6e, 0x74,
+	0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f,
+	0x72, 0x6d, 0x54, 0x65, 0x61, 0x72, 0x64, 0x6f, 0x77, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x48, 0x00, 0x52, 0x16, 0x69, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x54, 0x65, 0x61, 0x72,
+	0x64, 0x6f, 0x77, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5f, 0x0a, 0x15,
+	0x69, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x72, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x77, 0x61,
+	0x6e, 0x64, 0x62, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2e, 0x53, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x72, 0x6d, 0x53, 0x74, 0x61, 0x72, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x13, 0x69, 0x6e, 0x66, 0x6f, 0x72, 0x6d,
+	0x53, 0x74, 0x61, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x62, 0x0a,
+	0x16, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x63, 0x74, 0x5f, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x5f, 0x72,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e,
+	0x77, 0x61, 0x6e, 0x64, 0x62, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2e, 0x53,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x63, 0x74, 0x53, 0x74, 0x6f, 0x72,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x14, 0x63, 0x6f, 0x6d,
+	0x70, 0x61, 0x63, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x5f, 0x0a, 0x15, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x73, 0x74, 0x6f, 0x72,
+	0x65, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x29, 0x2e, 0x77, 0x61, 0x6e, 0x64, 0x62, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61,
+	0x6c, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x53, 0x74,
+	0x6f, 0x72, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x13, 0x65,
+	0x78, 0x70, 0x6f, 0x72, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x5f, 0x0a, 0x15, 0x69, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x73, 0x74, 0x6f,
+	0x72, 0x65, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x29, 0x2e, 0x77, 0x61, 0x6e, 0x64, 0x62, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e,
+	0x61, 0x6c, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x53,
+	0x74, 0x6f, 0x72, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x13,
+	0x69, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x5f, 0x0a, 0x15, 0x76, 0x65, 0x72, 0x69, 0x66, 0x79, 0x5f, 0x73, 0x74,
+	0x6f, 0x72, 0x65, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x0c, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x29, 0x2e, 0x77, 0x61, 0x6e, 0x64, 0x62, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72,
+	0x6e, 0x61, 0x6c, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79,
+	0x53, 0x74, 0x6f, 0x72, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52,
+	0x13, 0x76, 0x65, 0x72, 0x69, 0x66, 0x79, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x42, 0x16, 0x0a, 0x14, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x72,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x22, 0x83, 0x01, 0x0a,
+	0x19, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x63, 0x74, 0x53, 0x74,
+	0x6f, 0x72, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61,
+	0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x1f,
+	0x0a, 0x0b, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x50, 0x61, 0x74, 0x68, 0x12,
+	0x31, 0x0a, 0x05, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0xc8, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1b, 0x2e, 0x77, 0x61, 0x6e, 0x64, 0x62, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c,
+	0x2e, 0x5f, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x04, 0x49, 0x6e,
+	0x66, 0x6f, 0x22, 0xcc, 0x01, 0x0a, 0x1a, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x43, 0x6f, 0x6d,
+	0x70, 0x61, 0x63, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x5f, 0x72, 0x65, 0x61,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73,
+	0x52, 0x65, 0x61, 0x64, 0x12, 0x27, 0x0a, 0x0f, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x5f,
+	0x77, 0x72, 0x69, 0x74, 0x74, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x72,
+	0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x57, 0x72, 0x69, 0x74, 0x74, 0x65, 0x6e, 0x12, 0x2f, 0x0a,
+	0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x77,
+	0x61, 0x6e, 0x64, 0x62, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2e, 0x45, 0x72,
+	0x72, 0x6f, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x31,
+	0x0a, 0x05, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0xc8, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b,
+	0x2e, 0x77, 0x61, 0x6e, 0x64, 0x62, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2e,
+	0x5f, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x04, 0x49, 0x6e, 0x66,
+	0x6f, 0x22, 0x98, 0x01, 0x0a, 0x18, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x45, 0x78, 0x70, 0x6f,
+	0x72, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12,
+	0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61,
+	0x74, 0x68, 0x12, 0x1d, 0x0a, 0x0a, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x5f, 0x64, 0x69, 0x72,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x44, 0x69,
+	0x72, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x31, 0x0a, 0x05, 0x5f, 0x69, 0x6e,
+	0x66, 0x6f, 0x18, 0xc8, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x77, 0x61, 0x6e, 0x64,
+	0x62, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2e, 0x5f, 0x52, 0x65, 0x63, 0x6f,
+	0x72, 0x64, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x04, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0x89, 0x02, 0x0a,
+	0x19, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x53, 0x74, 0x6f,
+	0x72, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x68, 0x69,
+	0x73, 0x74, 0x6f, 0x72, 0x79, 0x5f, 0x72, 0x6f, 0x77, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0b, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x6f, 0x77, 0x73, 0x12, 0x1f, 0x0a,
+	0x0b, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x5f, 0x72, 0x6f, 0x77, 0x73, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x6f, 0x77, 0x73, 0x12, 0x21,
+	0x0a, 0x0c, 0x73, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x5f, 0x72, 0x6f, 0x77, 0x73, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x73, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52, 0x6f, 0x77,
+	0x73, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6f, 0x6e, 0x73, 0x6f, 0x6c, 0x65, 0x5f, 0x72, 0x6f, 0x77,
+	0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x73, 0x6f, 0x6c, 0x65,
+	0x52, 0x6f, 0x77, 0x73, 0x12, 0x2f, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x77, 0x61, 0x6e, 0x64, 0x62, 0x5f, 0x69, 0x6e, 0x74, 0x65,
+	0x72, 0x6e, 0x61, 0x6c, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x05,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x31, 0x0a, 0x05, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0xc8,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x77, 0x61, 0x6e, 0x64, 0x62, 0x5f, 0x69, 0x6e,
+	0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2e, 0x5f, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x49, 0x6e,
+	0x66, 0x6f, 0x52, 0x04, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0xe3, 0x01, 0x0a, 0x18, 0x53, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x1f, 0x0a, 0x0b, 0x6f, 0x75, 0x74,
+	0x70, 0x75, 0x74, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x50, 0x61, 0x74, 0x68, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x6f,
+	0x72, 0x6d, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x66, 0x6f, 0x72, 0x6d,
+	0x61, 0x74, 0x12, 0x15, 0x0a, 0x06, 0x72, 0x75, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x72, 0x75, 0x6e, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x72, 0x6f,
+	0x6a, 0x65, 0x63, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x72, 0x6f, 0x6a,
+	0x65, 0x63, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x31, 0x0a, 0x05, 0x5f,
+	0x69, 0x6e, 0x66, 0x6f, 0x18, 0xc8, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x77, 0x61,
+	0x6e, 0x64, 0x62, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2e, 0x5f, 0x52, 0x65,
+	0x63, 0x6f, 0x72, 0x64, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x04, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0xe5,
+	0x01, 0x0a, 0x19, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x53,
+	0x74, 0x6f, 0x72, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x27, 0x0a, 0x0f,
+	0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x5f, 0x77, 0x72, 0x69, 0x74, 0x74, 0x65, 0x6e, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x57, 0x72,
+	0x69, 0x74, 0x74, 0x65, 0x6e, 0x12, 0x21, 0x0a, 0x0c, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79,
+	0x5f, 0x72, 0x6f, 0x77, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x68, 0x69, 0x73,
+	0x74, 0x6f, 0x72, 0x79, 0x52, 0x6f, 0x77, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x6b, 0x69, 0x70,
+	0x70, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x73, 0x6b, 0x69, 0x70, 0x70,
+	0x65, 0x64, 0x12, 0x2f, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x19, 0x2e, 0x77, 0x61, 0x6e, 0x64, 0x62, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e,
+	0x61, 0x6c, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x05, 0x65, 0x72,
+	0x72, 0x6f, 0x72, 0x12, 0x31, 0x0a, 0x05, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0xc8, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x77, 0x61, 0x6e, 0x64, 0x62, 0x5f, 0x69, 0x6e, 0x74, 0x65,
+	0x72, 0x6e, 0x61, 0x6c, 0x2e, 0x5f, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x49, 0x6e, 0x66, 0x6f,
+	0x52, 0x04, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0x61, 0x0a, 0x18, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x31, 0x0a, 0x05, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18,
+	0xc8, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x77, 0x61, 0x6e, 0x64, 0x62, 0x5f, 0x69,
+	0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2e, 0x5f, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x49,
+	0x6e, 0x66, 0x6f, 0x52, 0x04, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0xb5, 0x01, 0x0a, 0x19, 0x53, 0x65,
+	0x72, 0x76, 0x65, 0x72, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x34, 0x0a, 0x06, 0x72, 0x65, 0x70, 0x6f, 0x72,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x77, 0x61, 0x6e, 0x64, 0x62, 0x5f,
+	0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2e, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x52,
+	0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x06, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x2f, 0x0a,
+	0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x77,
+	0x61, 0x6e, 0x64, 0x62, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2e, 0x45, 0x72,
+	0x72, 0x6f, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x31,
+	0x0a, 0x05, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0xc8, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b,
+	0x2e, 0x77, 0x61, 0x6e, 0x64, 0x62, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2e,
+	0x5f, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x04, 0x49, 0x6e, 0x66,
+	0x6f, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1239,7 +2074,7 @@ func file_wandb_proto_wandb_server_proto_rawDescGZIP() []byte {
 	return file_wandb_proto_wandb_server_proto_rawDescData
 }
 
-var file_wandb_proto_wandb_server_proto_msgTypes = make([]protoimpl.MessageInfo, 18)
+var file_wandb_proto_wandb_server_proto_msgTypes = make([]protoimpl.MessageInfo, 26)
 var file_wandb_proto_wandb_server_proto_goTypes = []interface{}{
 	(*ServerShutdownRequest)(nil),        // 0: wandb_internal.ServerShutdownRequest
 	(*ServerShutdownResponse)(nil),       // 1: wandb_internal.ServerShutdownResponse
@@ -1259,44 +2094,75 @@ var file_wandb_proto_wandb_server_proto_goTypes = []interface{}{
 	(*ServerInformTeardownResponse)(nil), // 15: wandb_internal.ServerInformTeardownResponse
 	(*ServerRequest)(nil),                // 16: wandb_internal.ServerRequest
 	(*ServerResponse)(nil),               // 17: wandb_internal.ServerResponse
-	(*XRecordInfo)(nil),                  // 18: wandb_internal._RecordInfo
-	(*Settings)(nil),                     // 19: wandb_internal.Settings
-	(*Record)(nil),                       // 20: wandb_internal.Record
-	(*Result)(nil),                       // 21: wandb_internal.Result
+	(*ServerCompactStoreRequest)(nil),    // 18: wandb_internal.ServerCompactStoreRequest
+	(*ServerCompactStoreResponse)(nil),   // 19: wandb_internal.ServerCompactStoreResponse
+	(*ServerExportStoreRequest)(nil),     // 20: wandb_internal.ServerExportStoreRequest
+	(*ServerExportStoreResponse)(nil),    // 21: wandb_internal.ServerExportStoreResponse
+	(*ServerImportStoreRequest)(nil),     // 22: wandb_internal.ServerImportStoreRequest
+	(*ServerImportStoreResponse)(nil),    // 23: wandb_internal.ServerImportStoreResponse
+	(*ServerVerifyStoreRequest)(nil),     // 24: wandb_internal.ServerVerifyStoreRequest
+	(*ServerVerifyStoreResponse)(nil),    // 25: wandb_internal.ServerVerifyStoreResponse
+	(*XRecordInfo)(nil),                  // 26: wandb_internal._RecordInfo
+	(*Settings)(nil),                     // 27: wandb_internal.Settings
+	(*Record)(nil),                       // 28: wandb_internal.Record
+	(*Result)(nil),                       // 29: wandb_internal.Result
+	(*ErrorInfo)(nil),                    // 30: wandb_internal.ErrorInfo
+	(*VerifyReport)(nil),                 // 31: wandb_internal.VerifyReport
 }
 var file_wandb_proto_wandb_server_proto_depIdxs = []int32{
-	18, // 0: wandb_internal.ServerShutdownRequest._info:type_name -> wandb_internal._RecordInfo
-	18, // 1: wandb_internal.ServerStatusRequest._info:type_name -> wandb_internal._RecordInfo
-	19, // 2: wandb_internal.ServerInformInitRequest.settings:type_name -> wandb_internal.Settings
-	18, // 3: wandb_internal.ServerInformInitRequest._info:type_name -> wandb_internal._RecordInfo
-	19, // 4: wandb_internal.ServerInformStartRequest.settings:type_name -> wandb_internal.Settings
-	18, // 5: wandb_internal.ServerInformStartRequest._info:type_name -> wandb_internal._RecordInfo
-	18, // 6: wandb_internal.ServerInformFinishRequest._info:type_name -> wandb_internal._RecordInfo
-	18, // 7: wandb_internal.ServerInformAttachRequest._info:type_name -> wandb_internal._RecordInfo
-	19, // 8: wandb_internal.ServerInformAttachResponse.settings:type_name -> wandb_internal.Settings
-	18, // 9: wandb_internal.ServerInformAttachResponse._info:type_name -> wandb_internal._RecordInfo
-	18, // 10: wandb_internal.ServerInformDetachRequest._info:type_name -> wandb_internal._RecordInfo
-	18, // 11: wandb_internal.ServerInformTeardownRequest._info:type_name -> wandb_internal._RecordInfo
-	20, // 12: wandb_internal.ServerRequest.record_publish:type_name -> wandb_internal.Record
-	20, // 13: wandb_internal.ServerRequest.record_communicate:type_name -> wandb_internal.Record
+	26, // 0: wandb_internal.ServerShutdownRequest._info:type_name -> wandb_internal._RecordInfo
+	26, // 1: wandb_internal.ServerStatusRequest._info:type_name -> wandb_internal._RecordInfo
+	27, // 2: wandb_internal.ServerInformInitRequest.settings:type_name -> wandb_internal.Settings
+	26, // 3: wandb_internal.ServerInformInitRequest._info:type_name -> wandb_internal._RecordInfo
+	27, // 4: wandb_internal.ServerInformStartRequest.settings:type_name -> wandb_internal.Settings
+	26, // 5: wandb_internal.ServerInformStartRequest._info:type_name -> wandb_internal._RecordInfo
+	26, // 6: wandb_internal.ServerInformFinishRequest._info:type_name -> wandb_internal._RecordInfo
+	26, // 7: wandb_internal.ServerInformAttachRequest._info:type_name -> wandb_internal._RecordInfo
+	27, // 8: wandb_internal.ServerInformAttachResponse.settings:type_name -> wandb_internal.Settings
+	26, // 9: wandb_internal.ServerInformAttachResponse._info:type_name -> wandb_internal._RecordInfo
+	26, // 10: wandb_internal.ServerInformDetachRequest._info:type_name -> wandb_internal._RecordInfo
+	26, // 11: wandb_internal.ServerInformTeardownRequest._info:type_name -> wandb_internal._RecordInfo
+	28, // 12: wandb_internal.ServerRequest.record_publish:type_name -> wandb_internal.Record
+	28, // 13: wandb_internal.ServerRequest.record_communicate:type_name -> wandb_internal.Record
 	4,  // 14: wandb_internal.ServerRequest.inform_init:type_name -> wandb_internal.ServerInformInitRequest
 	8,  // 15: wandb_internal.ServerRequest.inform_finish:type_name -> wandb_internal.ServerInformFinishRequest
 	10, // 16: wandb_internal.ServerRequest.inform_attach:type_name -> wandb_internal.ServerInformAttachRequest
 	12, // 17: wandb_internal.ServerRequest.inform_detach:type_name -> wandb_internal.ServerInformDetachRequest
 	14, // 18: wandb_internal.ServerRequest.inform_teardown:type_name -> wandb_internal.ServerInformTeardownRequest
 	6,  // 19: wandb_internal.ServerRequest.inform_start:type_name -> wandb_internal.ServerInformStartRequest
-	21, // 20: wandb_internal.ServerResponse.result_communicate:type_name -> wandb_internal.Result
-	5,  // 21: wandb_internal.ServerResponse.inform_init_response:type_name -> wandb_internal.ServerInformInitResponse
-	9,  // 22: wandb_internal.ServerResponse.inform_finish_response:type_name -> wandb_internal.ServerInformFinishResponse
-	11, // 23: wandb_internal.ServerResponse.inform_attach_response:type_name -> wandb_internal.ServerInformAttachResponse
-	13, // 24: wandb_internal.ServerResponse.inform_detach_response:type_name -> wandb_internal.ServerInformDetachResponse
-	15, // 25: wandb_internal.ServerResponse.inform_teardown_response:type_name -> wandb_internal.ServerInformTeardownResponse
-	7,  // 26: wandb_internal.ServerResponse.inform_start_response:type_name -> wandb_internal.ServerInformStartResponse
-	27, // [27:27] is the sub-list for method output_type
-	27, // [27:27] is the sub-list for method input_type
-	27, // [27:27] is the sub-list for extension type_name
-	27, // [27:27] is the sub-list for extension extendee
-	0,  // [0:27] is the sub-list for field type_name
+	18, // 20: wandb_internal.ServerRequest.compact_store:type_name -> wandb_internal.ServerCompactStoreRequest
+	20, // 21: wandb_internal.ServerRequest.export_store:type_name -> wandb_internal.ServerExportStoreRequest
+	22, // 22: wandb_internal.ServerRequest.import_store:type_name -> wandb_internal.ServerImportStoreRequest
+	24, // 23: wandb_internal.ServerRequest.verify_store:type_name -> wandb_internal.ServerVerifyStoreRequest
+	29, // 24: wandb_internal.ServerResponse.result_communicate:type_name -> wandb_internal.Result
+	5,  // 25: wandb_internal.ServerResponse.inform_init_response:type_name -> wandb_internal.ServerInformInitResponse
+	9,  // 26: wandb_internal.ServerResponse.inform_finish_response:type_name -> wandb_internal.ServerInformFinishResponse
+	11, // 27: wandb_internal.ServerResponse.inform_attach_response:type_name -> wandb_internal.ServerInformAttachResponse
+	13, // 28: wandb_internal.ServerResponse.inform_detach_response:type_name -> wandb_internal.ServerInformDetachResponse
+	15, // 29: wandb_internal.ServerResponse.inform_teardown_response:type_name -> wandb_internal.ServerInformTeardownResponse
+	7,  // 30: wandb_internal.ServerResponse.inform_start_response:type_name -> wandb_internal.ServerInformStartResponse
+	19, // 31: wandb_internal.ServerResponse.compact_store_response:type_name -> wandb_internal.ServerCompactStoreResponse
+	21, // 32: wandb_internal.ServerResponse.export_store_response:type_name -> wandb_internal.ServerExportStoreResponse
+	23, // 33: wandb_internal.ServerResponse.import_store_response:type_name -> wandb_internal.ServerImportStoreResponse
+	25, // 34: wandb_internal.ServerResponse.verify_store_response:type_name -> wandb_internal.ServerVerifyStoreResponse
+	26, // 35: wandb_internal.ServerCompactStoreRequest._info:type_name -> wandb_internal._RecordInfo
+	30, // 36: wandb_internal.ServerCompactStoreResponse.error:type_name -> wandb_internal.ErrorInfo
+	26, // 37: wandb_internal.ServerCompactStoreResponse._info:type_name -> wandb_internal._RecordInfo
+	26, // 38: wandb_internal.ServerExportStoreRequest._info:type_name -> wandb_internal._RecordInfo
+	30, // 39: wandb_internal.ServerExportStoreResponse.error:type_name -> wandb_internal.ErrorInfo
+	26, // 40: wandb_internal.ServerExportStoreResponse._info:type_name -> wandb_internal._RecordInfo
+	26, // 41: wandb_internal.ServerImportStoreRequest._info:type_name -> wandb_internal._RecordInfo
+	30, // 42: wandb_internal.ServerImportStoreResponse.error:type_name -> wandb_internal.ErrorInfo
+	26, // 43: wandb_internal.ServerImportStoreResponse._info:type_name -> wandb_internal._RecordInfo
+	26, // 44: wandb_internal.ServerVerifyStoreRequest._info:type_name -> wandb_internal._RecordInfo
+	31, // 45: wandb_internal.ServerVerifyStoreResponse.report:type_name -> wandb_internal.VerifyReport
+	30, // 46: wandb_internal.ServerVerifyStoreResponse.error:type_name -> wandb_internal.ErrorInfo
+	26, // 47: wandb_internal.ServerVerifyStoreResponse._info:type_name -> wandb_internal._RecordInfo
+	48, // [48:48] is the sub-list for method output_type
+	48, // [48:48] is the sub-list for method input_type
+	48, // [48:48] is the sub-list for extension type_name
+	48, // [48:48] is the sub-list for extension extendee
+	0,  // [0:48] is the sub-list for field type_name
 }
 
 func init() { file_wandb_proto_wandb_server_proto_init() }
@@ -1524,6 +2390,102 @@ func file_wandb_proto_wandb_server_proto_init() {
 				return nil
 			}
 		}
+		file_wandb_proto_wandb_server_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ServerCompactStoreRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_wandb_proto_wandb_server_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ServerCompactStoreResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_wandb_proto_wandb_server_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ServerExportStoreRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_wandb_proto_wandb_server_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ServerExportStoreResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_wandb_proto_wandb_server_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ServerImportStoreRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_wandb_proto_wandb_server_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ServerImportStoreResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_wandb_proto_wandb_server_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ServerVerifyStoreRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_wandb_proto_wandb_server_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ServerVerifyStoreResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	file_wandb_proto_wandb_server_proto_msgTypes[16].OneofWrappers = []interface{}{
 		(*ServerRequest_RecordPublish)(nil),
@@ -1534,6 +2496,10 @@ func file_wandb_proto_wandb_server_proto_init() {
 		(*ServerRequest_InformDetach)(nil),
 		(*ServerRequest_InformTeardown)(nil),
 		(*ServerRequest_InformStart)(nil),
+		(*ServerRequest_CompactStore)(nil),
+		(*ServerRequest_ExportStore)(nil),
+		(*ServerRequest_ImportStore)(nil),
+		(*ServerRequest_VerifyStore)(nil),
 	}
 	file_wandb_proto_wandb_server_proto_msgTypes[17].OneofWrappers = []interface{}{
 		(*ServerResponse_ResultCommunicate)(nil),
@@ -1543,6 +2509,10 @@ func file_wandb_proto_wandb_server_proto_init() {
 		(*ServerResponse_InformDetachResponse)(nil),
 		(*ServerResponse_InformTeardownResponse)(nil),
 		(*ServerResponse_InformStartResponse)(nil),
+		(*ServerResponse_CompactStoreResponse)(nil),
+		(*ServerResponse_ExportStoreResponse)(nil),
+		(*ServerResponse_ImportStoreResponse)(nil),
+		(*ServerResponse_VerifyStoreResponse)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -1550,7 +2520,7 @@ func file_wandb_proto_wandb_server_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_wandb_proto_wandb_server_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   18,
+			NumMessages:   26,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
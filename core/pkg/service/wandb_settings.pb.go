@@ -410,6 +410,63 @@ type Settings struct {
 	XStatsBufferSize                 *wrapperspb.Int32Value   `protobuf:"bytes,161,opt,name=_stats_buffer_size,json=StatsBufferSize,proto3" json:"_stats_buffer_size,omitempty"`
 	XShared                          *wrapperspb.BoolValue    `protobuf:"bytes,162,opt,name=_shared,json=Shared,proto3" json:"_shared,omitempty"`
 	XProxies                         *MapStringKeyStringValue `protobuf:"bytes,200,opt,name=_proxies,json=Proxies,proto3" json:"_proxies,omitempty"`
+	XStoreCompression                *wrapperspb.StringValue  `protobuf:"bytes,163,opt,name=_store_compression,json=StoreCompression,proto3" json:"_store_compression,omitempty"`
+	XStoreExcludeTypes               *ListStringValue         `protobuf:"bytes,164,opt,name=_store_exclude_types,json=StoreExcludeTypes,proto3" json:"_store_exclude_types,omitempty"`
+	XStoreMaxSegmentBytes            *wrapperspb.Int64Value   `protobuf:"bytes,165,opt,name=_store_max_segment_bytes,json=StoreMaxSegmentBytes,proto3" json:"_store_max_segment_bytes,omitempty"`
+	XStoreIndex                      *wrapperspb.BoolValue    `protobuf:"bytes,166,opt,name=_store_index,json=StoreIndex,proto3" json:"_store_index,omitempty"`
+	XStoreEncryptionKey              *wrapperspb.StringValue  `protobuf:"bytes,167,opt,name=_store_encryption_key,json=StoreEncryptionKey,proto3" json:"_store_encryption_key,omitempty"`
+	XWriterMetricsIntervalSeconds    *wrapperspb.DoubleValue  `protobuf:"bytes,168,opt,name=_writer_metrics_interval_seconds,json=WriterMetricsIntervalSeconds,proto3" json:"_writer_metrics_interval_seconds,omitempty"`
+	XStoreSyncPolicy                 *wrapperspb.StringValue  `protobuf:"bytes,169,opt,name=_store_sync_policy,json=StoreSyncPolicy,proto3" json:"_store_sync_policy,omitempty"`
+	XStoreMirrorDir                  *wrapperspb.StringValue  `protobuf:"bytes,170,opt,name=_store_mirror_dir,json=StoreMirrorDir,proto3" json:"_store_mirror_dir,omitempty"`
+	XStoreDrainTimeoutSeconds        *wrapperspb.DoubleValue  `protobuf:"bytes,171,opt,name=_store_drain_timeout_seconds,json=StoreDrainTimeoutSeconds,proto3" json:"_store_drain_timeout_seconds,omitempty"`
+	XStoreEmbedSchema                *wrapperspb.BoolValue    `protobuf:"bytes,172,opt,name=_store_embed_schema,json=StoreEmbedSchema,proto3" json:"_store_embed_schema,omitempty"`
+	XStoreDedupTypes                 *ListStringValue         `protobuf:"bytes,173,opt,name=_store_dedup_types,json=StoreDedupTypes,proto3" json:"_store_dedup_types,omitempty"`
+	XStoreConsoleMaxLines            *wrapperspb.Int64Value   `protobuf:"bytes,174,opt,name=_store_console_max_lines,json=StoreConsoleMaxLines,proto3" json:"_store_console_max_lines,omitempty"`
+	XStoreConsoleMaxBytes            *wrapperspb.Int64Value   `protobuf:"bytes,175,opt,name=_store_console_max_bytes,json=StoreConsoleMaxBytes,proto3" json:"_store_console_max_bytes,omitempty"`
+	XStoreConsoleWindowSeconds       *wrapperspb.DoubleValue  `protobuf:"bytes,176,opt,name=_store_console_window_seconds,json=StoreConsoleWindowSeconds,proto3" json:"_store_console_window_seconds,omitempty"`
+	XStoreShards                     *wrapperspb.Int64Value   `protobuf:"bytes,177,opt,name=_store_shards,json=StoreShards,proto3" json:"_store_shards,omitempty"`
+	XStoreShardKey                   *wrapperspb.StringValue  `protobuf:"bytes,178,opt,name=_store_shard_key,json=StoreShardKey,proto3" json:"_store_shard_key,omitempty"`
+	XStoreJournal                    *wrapperspb.BoolValue    `protobuf:"bytes,179,opt,name=_store_journal,json=StoreJournal,proto3" json:"_store_journal,omitempty"`
+	XStoreBackend                    *wrapperspb.StringValue  `protobuf:"bytes,180,opt,name=_store_backend,json=StoreBackend,proto3" json:"_store_backend,omitempty"`
+	XWriterBufferSize                *wrapperspb.Int32Value   `protobuf:"bytes,181,opt,name=_writer_buffer_size,json=WriterBufferSize,proto3" json:"_writer_buffer_size,omitempty"`
+	XSenderBufferSize                *wrapperspb.Int32Value   `protobuf:"bytes,182,opt,name=_sender_buffer_size,json=SenderBufferSize,proto3" json:"_sender_buffer_size,omitempty"`
+	XStoreQueueSize                  *wrapperspb.Int32Value   `protobuf:"bytes,183,opt,name=_store_queue_size,json=StoreQueueSize,proto3" json:"_store_queue_size,omitempty"`
+	XStoreMetadata                   *wrapperspb.BoolValue    `protobuf:"bytes,184,opt,name=_store_metadata,json=StoreMetadata,proto3" json:"_store_metadata,omitempty"`
+	XStoreSnapshotInterval           *wrapperspb.Int64Value   `protobuf:"bytes,185,opt,name=_store_snapshot_interval,json=StoreSnapshotInterval,proto3" json:"_store_snapshot_interval,omitempty"`
+	XRetryJitter                     *wrapperspb.DoubleValue  `protobuf:"bytes,186,opt,name=_retry_jitter,json=RetryJitter,proto3" json:"_retry_jitter,omitempty"`
+	XRetryStatusCodes                *ListStringValue         `protobuf:"bytes,187,opt,name=_retry_status_codes,json=RetryStatusCodes,proto3" json:"_retry_status_codes,omitempty"`
+	XSpoolMaxBytes                   *wrapperspb.Int64Value   `protobuf:"bytes,188,opt,name=_spool_max_bytes,json=SpoolMaxBytes,proto3" json:"_spool_max_bytes,omitempty"`
+	XCircuitBreakerFailures          *wrapperspb.Int32Value   `protobuf:"bytes,189,opt,name=_circuit_breaker_failures,json=CircuitBreakerFailures,proto3" json:"_circuit_breaker_failures,omitempty"`
+	XCircuitBreakerCooldownSeconds   *wrapperspb.DoubleValue  `protobuf:"bytes,190,opt,name=_circuit_breaker_cooldown_seconds,json=CircuitBreakerCooldownSeconds,proto3" json:"_circuit_breaker_cooldown_seconds,omitempty"`
+	XFileStreamFlushIntervalSeconds  *wrapperspb.DoubleValue  `protobuf:"bytes,191,opt,name=_file_stream_flush_interval_seconds,json=FileStreamFlushIntervalSeconds,proto3" json:"_file_stream_flush_interval_seconds,omitempty"`
+	XFileStreamMaxBytes              *wrapperspb.Int64Value   `protobuf:"bytes,192,opt,name=_file_stream_max_bytes,json=FileStreamMaxBytes,proto3" json:"_file_stream_max_bytes,omitempty"`
+	XNoProxy                         *wrapperspb.StringValue  `protobuf:"bytes,193,opt,name=_no_proxy,json=NoProxy,proto3" json:"_no_proxy,omitempty"`
+	XCaBundlePath                    *wrapperspb.StringValue  `protobuf:"bytes,194,opt,name=_ca_bundle_path,json=CaBundlePath,proto3" json:"_ca_bundle_path,omitempty"`
+	XSenderUploadWorkers             *wrapperspb.Int32Value   `protobuf:"bytes,195,opt,name=_sender_upload_workers,json=SenderUploadWorkers,proto3" json:"_sender_upload_workers,omitempty"`
+	XSenderMetadataWorkers           *wrapperspb.Int32Value   `protobuf:"bytes,196,opt,name=_sender_metadata_workers,json=SenderMetadataWorkers,proto3" json:"_sender_metadata_workers,omitempty"`
+	XUploadBandwidthBytes            *wrapperspb.Int64Value   `protobuf:"bytes,197,opt,name=_upload_bandwidth_bytes,json=UploadBandwidthBytes,proto3" json:"_upload_bandwidth_bytes,omitempty"`
+	XBackendTransport                *wrapperspb.StringValue  `protobuf:"bytes,198,opt,name=_backend_transport,json=BackendTransport,proto3" json:"_backend_transport,omitempty"`
+	XExitFlushTimeoutSeconds         *wrapperspb.DoubleValue  `protobuf:"bytes,199,opt,name=_exit_flush_timeout_seconds,json=ExitFlushTimeoutSeconds,proto3" json:"_exit_flush_timeout_seconds,omitempty"`
+	XRequestCompression              *wrapperspb.StringValue  `protobuf:"bytes,201,opt,name=_request_compression,json=RequestCompression,proto3" json:"_request_compression,omitempty"`
+	XStopPollingIntervalSeconds      *wrapperspb.DoubleValue  `protobuf:"bytes,202,opt,name=_stop_polling_interval_seconds,json=StopPollingIntervalSeconds,proto3" json:"_stop_polling_interval_seconds,omitempty"`
+	XHistorySamplingEvery            *wrapperspb.Int32Value   `protobuf:"bytes,203,opt,name=_history_sampling_every,json=HistorySamplingEvery,proto3" json:"_history_sampling_every,omitempty"`
+	XHistorySamplingOverloadSeconds  *wrapperspb.DoubleValue  `protobuf:"bytes,204,opt,name=_history_sampling_overload_seconds,json=HistorySamplingOverloadSeconds,proto3" json:"_history_sampling_overload_seconds,omitempty"`
+	XFailoverBaseUrls                *ListStringValue         `protobuf:"bytes,205,opt,name=_failover_base_urls,json=FailoverBaseUrls,proto3" json:"_failover_base_urls,omitempty"`
+	XFailoverRecoverSeconds          *wrapperspb.DoubleValue  `protobuf:"bytes,206,opt,name=_failover_recover_seconds,json=FailoverRecoverSeconds,proto3" json:"_failover_recover_seconds,omitempty"`
+	XDebounceSeconds                 *wrapperspb.DoubleValue  `protobuf:"bytes,207,opt,name=_debounce_seconds,json=DebounceSeconds,proto3" json:"_debounce_seconds,omitempty"`
+	XStatsWindowSeconds              *wrapperspb.DoubleValue  `protobuf:"bytes,208,opt,name=_stats_window_seconds,json=StatsWindowSeconds,proto3" json:"_stats_window_seconds,omitempty"`
+	XStatsWindowAggregation          *wrapperspb.StringValue  `protobuf:"bytes,209,opt,name=_stats_window_aggregation,json=StatsWindowAggregation,proto3" json:"_stats_window_aggregation,omitempty"`
+	XHistoryInvalidValues            *wrapperspb.StringValue  `protobuf:"bytes,210,opt,name=_history_invalid_values,json=HistoryInvalidValues,proto3" json:"_history_invalid_values,omitempty"`
+	XConsoleAnsi                     *wrapperspb.StringValue  `protobuf:"bytes,211,opt,name=_console_ansi,json=ConsoleAnsi,proto3" json:"_console_ansi,omitempty"`
+	XConsoleMaxLineBytes             *wrapperspb.Int64Value   `protobuf:"bytes,212,opt,name=_console_max_line_bytes,json=ConsoleMaxLineBytes,proto3" json:"_console_max_line_bytes,omitempty"`
+	XConsoleReorderSeconds           *wrapperspb.DoubleValue  `protobuf:"bytes,213,opt,name=_console_reorder_seconds,json=ConsoleReorderSeconds,proto3" json:"_console_reorder_seconds,omitempty"`
+	XRank                            *wrapperspb.Int32Value   `protobuf:"bytes,214,opt,name=_rank,json=Rank,proto3" json:"_rank,omitempty"`
+	XHistoryStepPolicy               *wrapperspb.StringValue  `protobuf:"bytes,215,opt,name=_history_step_policy,json=HistoryStepPolicy,proto3" json:"_history_step_policy,omitempty"`
+	XHistoryMaxKeys                  *wrapperspb.Int32Value   `protobuf:"bytes,216,opt,name=_history_max_keys,json=HistoryMaxKeys,proto3" json:"_history_max_keys,omitempty"`
+	XHistoryKeyOverflow              *wrapperspb.StringValue  `protobuf:"bytes,217,opt,name=_history_key_overflow,json=HistoryKeyOverflow,proto3" json:"_history_key_overflow,omitempty"`
+	XTableBufferRows                 *wrapperspb.Int32Value   `protobuf:"bytes,218,opt,name=_table_buffer_rows,json=TableBufferRows,proto3" json:"_table_buffer_rows,omitempty"`
+	XCapturePath                     *wrapperspb.StringValue  `protobuf:"bytes,219,opt,name=_capture_path,json=CapturePath,proto3" json:"_capture_path,omitempty"`
+	XFlowControlCredits              *wrapperspb.Int32Value   `protobuf:"bytes,220,opt,name=_flow_control_credits,json=FlowControlCredits,proto3" json:"_flow_control_credits,omitempty"`
 }
 
 func (x *Settings) Reset() {
@@ -1578,6 +1635,405 @@ func (x *Settings) GetXProxies() *MapStringKeyStringValue {
 	return nil
 }
 
+func (x *Settings) GetXStoreCompression() *wrapperspb.StringValue {
+	if x != nil {
+		return x.XStoreCompression
+	}
+	return nil
+}
+
+func (x *Settings) GetXStoreExcludeTypes() *ListStringValue {
+	if x != nil {
+		return x.XStoreExcludeTypes
+	}
+	return nil
+}
+
+func (x *Settings) GetXStoreMaxSegmentBytes() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.XStoreMaxSegmentBytes
+	}
+	return nil
+}
+
+func (x *Settings) GetXStoreIndex() *wrapperspb.BoolValue {
+	if x != nil {
+		return x.XStoreIndex
+	}
+	return nil
+}
+
+func (x *Settings) GetXStoreEncryptionKey() *wrapperspb.StringValue {
+	if x != nil {
+		return x.XStoreEncryptionKey
+	}
+	return nil
+}
+
+func (x *Settings) GetXWriterMetricsIntervalSeconds() *wrapperspb.DoubleValue {
+	if x != nil {
+		return x.XWriterMetricsIntervalSeconds
+	}
+	return nil
+}
+
+func (x *Settings) GetXStoreSyncPolicy() *wrapperspb.StringValue {
+	if x != nil {
+		return x.XStoreSyncPolicy
+	}
+	return nil
+}
+
+func (x *Settings) GetXStoreMirrorDir() *wrapperspb.StringValue {
+	if x != nil {
+		return x.XStoreMirrorDir
+	}
+	return nil
+}
+
+func (x *Settings) GetXStoreDrainTimeoutSeconds() *wrapperspb.DoubleValue {
+	if x != nil {
+		return x.XStoreDrainTimeoutSeconds
+	}
+	return nil
+}
+
+func (x *Settings) GetXStoreEmbedSchema() *wrapperspb.BoolValue {
+	if x != nil {
+		return x.XStoreEmbedSchema
+	}
+	return nil
+}
+
+func (x *Settings) GetXStoreDedupTypes() *ListStringValue {
+	if x != nil {
+		return x.XStoreDedupTypes
+	}
+	return nil
+}
+
+func (x *Settings) GetXStoreConsoleMaxLines() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.XStoreConsoleMaxLines
+	}
+	return nil
+}
+
+func (x *Settings) GetXStoreConsoleMaxBytes() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.XStoreConsoleMaxBytes
+	}
+	return nil
+}
+
+func (x *Settings) GetXStoreConsoleWindowSeconds() *wrapperspb.DoubleValue {
+	if x != nil {
+		return x.XStoreConsoleWindowSeconds
+	}
+	return nil
+}
+
+func (x *Settings) GetXStoreShards() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.XStoreShards
+	}
+	return nil
+}
+
+func (x *Settings) GetXStoreShardKey() *wrapperspb.StringValue {
+	if x != nil {
+		return x.XStoreShardKey
+	}
+	return nil
+}
+
+func (x *Settings) GetXStoreJournal() *wrapperspb.BoolValue {
+	if x != nil {
+		return x.XStoreJournal
+	}
+	return nil
+}
+
+func (x *Settings) GetXStoreBackend() *wrapperspb.StringValue {
+	if x != nil {
+		return x.XStoreBackend
+	}
+	return nil
+}
+
+func (x *Settings) GetXWriterBufferSize() *wrapperspb.Int32Value {
+	if x != nil {
+		return x.XWriterBufferSize
+	}
+	return nil
+}
+
+func (x *Settings) GetXSenderBufferSize() *wrapperspb.Int32Value {
+	if x != nil {
+		return x.XSenderBufferSize
+	}
+	return nil
+}
+
+func (x *Settings) GetXStoreQueueSize() *wrapperspb.Int32Value {
+	if x != nil {
+		return x.XStoreQueueSize
+	}
+	return nil
+}
+
+func (x *Settings) GetXStoreMetadata() *wrapperspb.BoolValue {
+	if x != nil {
+		return x.XStoreMetadata
+	}
+	return nil
+}
+
+func (x *Settings) GetXStoreSnapshotInterval() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.XStoreSnapshotInterval
+	}
+	return nil
+}
+
+func (x *Settings) GetXRetryJitter() *wrapperspb.DoubleValue {
+	if x != nil {
+		return x.XRetryJitter
+	}
+	return nil
+}
+
+func (x *Settings) GetXRetryStatusCodes() *ListStringValue {
+	if x != nil {
+		return x.XRetryStatusCodes
+	}
+	return nil
+}
+
+func (x *Settings) GetXSpoolMaxBytes() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.XSpoolMaxBytes
+	}
+	return nil
+}
+
+func (x *Settings) GetXCircuitBreakerFailures() *wrapperspb.Int32Value {
+	if x != nil {
+		return x.XCircuitBreakerFailures
+	}
+	return nil
+}
+
+func (x *Settings) GetXCircuitBreakerCooldownSeconds() *wrapperspb.DoubleValue {
+	if x != nil {
+		return x.XCircuitBreakerCooldownSeconds
+	}
+	return nil
+}
+
+func (x *Settings) GetXFileStreamFlushIntervalSeconds() *wrapperspb.DoubleValue {
+	if x != nil {
+		return x.XFileStreamFlushIntervalSeconds
+	}
+	return nil
+}
+
+func (x *Settings) GetXFileStreamMaxBytes() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.XFileStreamMaxBytes
+	}
+	return nil
+}
+
+func (x *Settings) GetXNoProxy() *wrapperspb.StringValue {
+	if x != nil {
+		return x.XNoProxy
+	}
+	return nil
+}
+
+func (x *Settings) GetXCaBundlePath() *wrapperspb.StringValue {
+	if x != nil {
+		return x.XCaBundlePath
+	}
+	return nil
+}
+
+func (x *Settings) GetXSenderUploadWorkers() *wrapperspb.Int32Value {
+	if x != nil {
+		return x.XSenderUploadWorkers
+	}
+	return nil
+}
+
+func (x *Settings) GetXSenderMetadataWorkers() *wrapperspb.Int32Value {
+	if x != nil {
+		return x.XSenderMetadataWorkers
+	}
+	return nil
+}
+
+func (x *Settings) GetXUploadBandwidthBytes() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.XUploadBandwidthBytes
+	}
+	return nil
+}
+
+func (x *Settings) GetXBackendTransport() *wrapperspb.StringValue {
+	if x != nil {
+		return x.XBackendTransport
+	}
+	return nil
+}
+
+func (x *Settings) GetXExitFlushTimeoutSeconds() *wrapperspb.DoubleValue {
+	if x != nil {
+		return x.XExitFlushTimeoutSeconds
+	}
+	return nil
+}
+
+func (x *Settings) GetXRequestCompression() *wrapperspb.StringValue {
+	if x != nil {
+		return x.XRequestCompression
+	}
+	return nil
+}
+
+func (x *Settings) GetXStopPollingIntervalSeconds() *wrapperspb.DoubleValue {
+	if x != nil {
+		return x.XStopPollingIntervalSeconds
+	}
+	return nil
+}
+
+func (x *Settings) GetXHistorySamplingEvery() *wrapperspb.Int32Value {
+	if x != nil {
+		return x.XHistorySamplingEvery
+	}
+	return nil
+}
+
+func (x *Settings) GetXHistorySamplingOverloadSeconds() *wrapperspb.DoubleValue {
+	if x != nil {
+		return x.XHistorySamplingOverloadSeconds
+	}
+	return nil
+}
+
+func (x *Settings) GetXFailoverBaseUrls() *ListStringValue {
+	if x != nil {
+		return x.XFailoverBaseUrls
+	}
+	return nil
+}
+
+func (x *Settings) GetXFailoverRecoverSeconds() *wrapperspb.DoubleValue {
+	if x != nil {
+		return x.XFailoverRecoverSeconds
+	}
+	return nil
+}
+
+func (x *Settings) GetXDebounceSeconds() *wrapperspb.DoubleValue {
+	if x != nil {
+		return x.XDebounceSeconds
+	}
+	return nil
+}
+
+func (x *Settings) GetXStatsWindowSeconds() *wrapperspb.DoubleValue {
+	if x != nil {
+		return x.XStatsWindowSeconds
+	}
+	return nil
+}
+
+func (x *Settings) GetXStatsWindowAggregation() *wrapperspb.StringValue {
+	if x != nil {
+		return x.XStatsWindowAggregation
+	}
+	return nil
+}
+
+func (x *Settings) GetXHistoryInvalidValues() *wrapperspb.StringValue {
+	if x != nil {
+		return x.XHistoryInvalidValues
+	}
+	return nil
+}
+
+func (x *Settings) GetXConsoleAnsi() *wrapperspb.StringValue {
+	if x != nil {
+		return x.XConsoleAnsi
+	}
+	return nil
+}
+
+func (x *Settings) GetXConsoleMaxLineBytes() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.XConsoleMaxLineBytes
+	}
+	return nil
+}
+
+func (x *Settings) GetXConsoleReorderSeconds() *wrapperspb.DoubleValue {
+	if x != nil {
+		return x.XConsoleReorderSeconds
+	}
+	return nil
+}
+
+func (x *Settings) GetXRank() *wrapperspb.Int32Value {
+	if x != nil {
+		return x.XRank
+	}
+	return nil
+}
+
+func (x *Settings) GetXHistoryStepPolicy() *wrapperspb.StringValue {
+	if x != nil {
+		return x.XHistoryStepPolicy
+	}
+	return nil
+}
+
+func (x *Settings) GetXHistoryMaxKeys() *wrapperspb.Int32Value {
+	if x != nil {
+		return x.XHistoryMaxKeys
+	}
+	return nil
+}
+
+func (x *Settings) GetXHistoryKeyOverflow() *wrapperspb.StringValue {
+	if x != nil {
+		return x.XHistoryKeyOverflow
+	}
+	return nil
+}
+
+func (x *Settings) GetXTableBufferRows() *wrapperspb.Int32Value {
+	if x != nil {
+		return x.XTableBufferRows
+	}
+	return nil
+}
+
+func (x *Settings) GetXCapturePath() *wrapperspb.StringValue {
+	if x != nil {
+		return x.XCapturePath
+	}
+	return nil
+}
+
+func (x *Settings) GetXFlowControlCredits() *wrapperspb.Int32Value {
+	if x != nil {
+		return x.XFlowControlCredits
+	}
+	return nil
+}
+
 var File_wandb_proto_wandb_settings_proto protoreflect.FileDescriptor
 
 var file_wandb_proto_wandb_settings_proto_rawDesc = []byte{
@@ -1623,7 +2079,7 @@ var file_wandb_proto_wandb_settings_proto_rawDesc = []byte{
 	0x72, 0x6e, 0x61, 0x6c, 0x2e, 0x4d, 0x61, 0x70, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x4b, 0x65,
 	0x79, 0x4d, 0x61, 0x70, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x4b, 0x65, 0x79, 0x53, 0x74, 0x72,
 	0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x48, 0x00, 0x52, 0x07, 0x6d, 0x61, 0x70, 0x70,
-	0x69, 0x6e, 0x67, 0x42, 0x07, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0xa9, 0x55, 0x0a,
+	0x69, 0x6e, 0x67, 0x42, 0x07, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0xa2, 0x79, 0x0a,
 	0x08, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x34, 0x0a, 0x05, 0x5f, 0x61, 0x72,
 	0x67, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x77, 0x61, 0x6e, 0x64, 0x62,
 	0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x74,
@@ -2306,7 +2762,295 @@ var file_wandb_proto_wandb_settings_proto_rawDesc = []byte{
 	0xc8, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x77, 0x61, 0x6e, 0x64, 0x62, 0x5f, 0x69,
 	0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2e, 0x4d, 0x61, 0x70, 0x53, 0x74, 0x72, 0x69, 0x6e,
 	0x67, 0x4b, 0x65, 0x79, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52,
-	0x07, 0x50, 0x72, 0x6f, 0x78, 0x69, 0x65, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x07, 0x50, 0x72, 0x6f, 0x78, 0x69, 0x65, 0x73, 0x12, 0x4b, 0x0a, 0x12, 0x5f, 0x73, 0x74, 0x6f,
+	0x72, 0x65, 0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0xa3,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61,
+	0x6c, 0x75, 0x65, 0x52, 0x10, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x43, 0x6f, 0x6d, 0x70, 0x72, 0x65,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x51, 0x0a, 0x14, 0x5f, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x5f,
+	0x65, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x18, 0xa4, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x77, 0x61, 0x6e, 0x64, 0x62, 0x5f, 0x69, 0x6e, 0x74,
+	0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67,
+	0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x11, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x45, 0x78, 0x63, 0x6c,
+	0x75, 0x64, 0x65, 0x54, 0x79, 0x70, 0x65, 0x73, 0x12, 0x54, 0x0a, 0x18, 0x5f, 0x73, 0x74, 0x6f,
+	0x72, 0x65, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x62,
+	0x79, 0x74, 0x65, 0x73, 0x18, 0xa5, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x49, 0x6e,
+	0x74, 0x36, 0x34, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x14, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x4d,
+	0x61, 0x78, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x3d,
+	0x0a, 0x0c, 0x5f, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0xa6,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x56, 0x61, 0x6c, 0x75,
+	0x65, 0x52, 0x0a, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x50, 0x0a,
+	0x15, 0x5f, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x5f, 0x65, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0xa7, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x12, 0x53, 0x74, 0x6f,
+	0x72, 0x65, 0x45, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x4b, 0x65, 0x79, 0x12,
+	0x65, 0x0a, 0x20, 0x5f, 0x77, 0x72, 0x69, 0x74, 0x65, 0x72, 0x5f, 0x6d, 0x65, 0x74, 0x72, 0x69,
+	0x63, 0x73, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x5f, 0x73, 0x65, 0x63, 0x6f,
+	0x6e, 0x64, 0x73, 0x18, 0xa8, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x6f, 0x75,
+	0x62, 0x6c, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x1c, 0x57, 0x72, 0x69, 0x74, 0x65, 0x72,
+	0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x53,
+	0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x4a, 0x0a, 0x12, 0x5f, 0x73, 0x74, 0x6f, 0x72, 0x65,
+	0x5f, 0x73, 0x79, 0x6e, 0x63, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0xa9, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75,
+	0x65, 0x52, 0x0f, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x53, 0x79, 0x6e, 0x63, 0x50, 0x6f, 0x6c, 0x69,
+	0x63, 0x79, 0x12, 0x48, 0x0a, 0x11, 0x5f, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x5f, 0x6d, 0x69, 0x72,
+	0x72, 0x6f, 0x72, 0x5f, 0x64, 0x69, 0x72, 0x18, 0xaa, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0e, 0x53, 0x74,
+	0x6f, 0x72, 0x65, 0x4d, 0x69, 0x72, 0x72, 0x6f, 0x72, 0x44, 0x69, 0x72, 0x12, 0x5d, 0x0a, 0x1c,
+	0x5f, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x5f, 0x64, 0x72, 0x61, 0x69, 0x6e, 0x5f, 0x74, 0x69, 0x6d,
+	0x65, 0x6f, 0x75, 0x74, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0xab, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x56, 0x61, 0x6c, 0x75,
+	0x65, 0x52, 0x18, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x44, 0x72, 0x61, 0x69, 0x6e, 0x54, 0x69, 0x6d,
+	0x65, 0x6f, 0x75, 0x74, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x4a, 0x0a, 0x13, 0x5f,
+	0x73, 0x74, 0x6f, 0x72, 0x65, 0x5f, 0x65, 0x6d, 0x62, 0x65, 0x64, 0x5f, 0x73, 0x63, 0x68, 0x65,
+	0x6d, 0x61, 0x18, 0xac, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x42, 0x6f, 0x6f, 0x6c,
+	0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x10, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x45, 0x6d, 0x62, 0x65,
+	0x64, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x12, 0x4d, 0x0a, 0x12, 0x5f, 0x73, 0x74, 0x6f, 0x72,
+	0x65, 0x5f, 0x64, 0x65, 0x64, 0x75, 0x70, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x18, 0xad, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x77, 0x61, 0x6e, 0x64, 0x62, 0x5f, 0x69, 0x6e, 0x74,
+	0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67,
+	0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0f, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x44, 0x65, 0x64, 0x75,
+	0x70, 0x54, 0x79, 0x70, 0x65, 0x73, 0x12, 0x54, 0x0a, 0x18, 0x5f, 0x73, 0x74, 0x6f, 0x72, 0x65,
+	0x5f, 0x63, 0x6f, 0x6e, 0x73, 0x6f, 0x6c, 0x65, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x6c, 0x69, 0x6e,
+	0x65, 0x73, 0x18, 0xae, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x49, 0x6e, 0x74, 0x36,
+	0x34, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x14, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x43, 0x6f, 0x6e,
+	0x73, 0x6f, 0x6c, 0x65, 0x4d, 0x61, 0x78, 0x4c, 0x69, 0x6e, 0x65, 0x73, 0x12, 0x54, 0x0a, 0x18,
+	0x5f, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x73, 0x6f, 0x6c, 0x65, 0x5f, 0x6d,
+	0x61, 0x78, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0xaf, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x14, 0x53, 0x74,
+	0x6f, 0x72, 0x65, 0x43, 0x6f, 0x6e, 0x73, 0x6f, 0x6c, 0x65, 0x4d, 0x61, 0x78, 0x42, 0x79, 0x74,
+	0x65, 0x73, 0x12, 0x5f, 0x0a, 0x1d, 0x5f, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x5f, 0x63, 0x6f, 0x6e,
+	0x73, 0x6f, 0x6c, 0x65, 0x5f, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x5f, 0x73, 0x65, 0x63, 0x6f,
+	0x6e, 0x64, 0x73, 0x18, 0xb0, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x6f, 0x75,
+	0x62, 0x6c, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x19, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x43,
+	0x6f, 0x6e, 0x73, 0x6f, 0x6c, 0x65, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x53, 0x65, 0x63, 0x6f,
+	0x6e, 0x64, 0x73, 0x12, 0x40, 0x0a, 0x0d, 0x5f, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x5f, 0x73, 0x68,
+	0x61, 0x72, 0x64, 0x73, 0x18, 0xb1, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x49, 0x6e,
+	0x74, 0x36, 0x34, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0b, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x53,
+	0x68, 0x61, 0x72, 0x64, 0x73, 0x12, 0x46, 0x0a, 0x10, 0x5f, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x5f,
+	0x73, 0x68, 0x61, 0x72, 0x64, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0xb2, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0d,
+	0x53, 0x74, 0x6f, 0x72, 0x65, 0x53, 0x68, 0x61, 0x72, 0x64, 0x4b, 0x65, 0x79, 0x12, 0x41, 0x0a,
+	0x0e, 0x5f, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x5f, 0x6a, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6c, 0x18,
+	0xb3, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x56, 0x61, 0x6c,
+	0x75, 0x65, 0x52, 0x0c, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x4a, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6c,
+	0x12, 0x43, 0x0a, 0x0e, 0x5f, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x5f, 0x62, 0x61, 0x63, 0x6b, 0x65,
+	0x6e, 0x64, 0x18, 0xb4, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69,
+	0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0c, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x42, 0x61,
+	0x63, 0x6b, 0x65, 0x6e, 0x64, 0x12, 0x4b, 0x0a, 0x13, 0x5f, 0x77, 0x72, 0x69, 0x74, 0x65, 0x72,
+	0x5f, 0x62, 0x75, 0x66, 0x66, 0x65, 0x72, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0xb5, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x56, 0x61, 0x6c, 0x75, 0x65,
+	0x52, 0x10, 0x57, 0x72, 0x69, 0x74, 0x65, 0x72, 0x42, 0x75, 0x66, 0x66, 0x65, 0x72, 0x53, 0x69,
+	0x7a, 0x65, 0x12, 0x4b, 0x0a, 0x13, 0x5f, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x5f, 0x62, 0x75,
+	0x66, 0x66, 0x65, 0x72, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0xb6, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x10, 0x53,
+	0x65, 0x6e, 0x64, 0x65, 0x72, 0x42, 0x75, 0x66, 0x66, 0x65, 0x72, 0x53, 0x69, 0x7a, 0x65, 0x12,
+	0x47, 0x0a, 0x11, 0x5f, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x5f, 0x71, 0x75, 0x65, 0x75, 0x65, 0x5f,
+	0x73, 0x69, 0x7a, 0x65, 0x18, 0xb7, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x49, 0x6e,
+	0x74, 0x33, 0x32, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0e, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x51,
+	0x75, 0x65, 0x75, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x43, 0x0a, 0x0f, 0x5f, 0x73, 0x74, 0x6f,
+	0x72, 0x65, 0x5f, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0xb8, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0d,
+	0x53, 0x74, 0x6f, 0x72, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x55, 0x0a,
+	0x18, 0x5f, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x5f, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74,
+	0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0xb9, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x15, 0x53,
+	0x74, 0x6f, 0x72, 0x65, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x49, 0x6e, 0x74, 0x65,
+	0x72, 0x76, 0x61, 0x6c, 0x12, 0x41, 0x0a, 0x0d, 0x5f, 0x72, 0x65, 0x74, 0x72, 0x79, 0x5f, 0x6a,
+	0x69, 0x74, 0x74, 0x65, 0x72, 0x18, 0xba, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44,
+	0x6f, 0x75, 0x62, 0x6c, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0b, 0x52, 0x65, 0x74, 0x72,
+	0x79, 0x4a, 0x69, 0x74, 0x74, 0x65, 0x72, 0x12, 0x4f, 0x0a, 0x13, 0x5f, 0x72, 0x65, 0x74, 0x72,
+	0x79, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x73, 0x18, 0xbb,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x77, 0x61, 0x6e, 0x64, 0x62, 0x5f, 0x69, 0x6e,
+	0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e,
+	0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x10, 0x52, 0x65, 0x74, 0x72, 0x79, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x43, 0x6f, 0x64, 0x65, 0x73, 0x12, 0x45, 0x0a, 0x10, 0x5f, 0x73, 0x70, 0x6f,
+	0x6f, 0x6c, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0xbc, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x56, 0x61, 0x6c, 0x75, 0x65,
+	0x52, 0x0d, 0x53, 0x70, 0x6f, 0x6f, 0x6c, 0x4d, 0x61, 0x78, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12,
+	0x57, 0x0a, 0x19, 0x5f, 0x63, 0x69, 0x72, 0x63, 0x75, 0x69, 0x74, 0x5f, 0x62, 0x72, 0x65, 0x61,
+	0x6b, 0x65, 0x72, 0x5f, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x73, 0x18, 0xbd, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x56, 0x61, 0x6c, 0x75, 0x65,
+	0x52, 0x16, 0x43, 0x69, 0x72, 0x63, 0x75, 0x69, 0x74, 0x42, 0x72, 0x65, 0x61, 0x6b, 0x65, 0x72,
+	0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x73, 0x12, 0x67, 0x0a, 0x21, 0x5f, 0x63, 0x69, 0x72,
+	0x63, 0x75, 0x69, 0x74, 0x5f, 0x62, 0x72, 0x65, 0x61, 0x6b, 0x65, 0x72, 0x5f, 0x63, 0x6f, 0x6f,
+	0x6c, 0x64, 0x6f, 0x77, 0x6e, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0xbe, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x56, 0x61, 0x6c,
+	0x75, 0x65, 0x52, 0x1d, 0x43, 0x69, 0x72, 0x63, 0x75, 0x69, 0x74, 0x42, 0x72, 0x65, 0x61, 0x6b,
+	0x65, 0x72, 0x43, 0x6f, 0x6f, 0x6c, 0x64, 0x6f, 0x77, 0x6e, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64,
+	0x73, 0x12, 0x6a, 0x0a, 0x23, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x73, 0x74, 0x72, 0x65, 0x61,
+	0x6d, 0x5f, 0x66, 0x6c, 0x75, 0x73, 0x68, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c,
+	0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0xbf, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x1e, 0x46,
+	0x69, 0x6c, 0x65, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x46, 0x6c, 0x75, 0x73, 0x68, 0x49, 0x6e,
+	0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x50, 0x0a,
+	0x16, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x5f, 0x6d, 0x61,
+	0x78, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0xc0, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x12, 0x46, 0x69, 0x6c,
+	0x65, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x4d, 0x61, 0x78, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12,
+	0x39, 0x0a, 0x09, 0x5f, 0x6e, 0x6f, 0x5f, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x18, 0xc1, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75,
+	0x65, 0x52, 0x07, 0x4e, 0x6f, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x12, 0x44, 0x0a, 0x0f, 0x5f, 0x63,
+	0x61, 0x5f, 0x62, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0xc2, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c,
+	0x75, 0x65, 0x52, 0x0c, 0x43, 0x61, 0x42, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x50, 0x61, 0x74, 0x68,
+	0x12, 0x51, 0x0a, 0x16, 0x5f, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x5f, 0x75, 0x70, 0x6c, 0x6f,
+	0x61, 0x64, 0x5f, 0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x73, 0x18, 0xc3, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x13,
+	0x53, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x57, 0x6f, 0x72, 0x6b,
+	0x65, 0x72, 0x73, 0x12, 0x55, 0x0a, 0x18, 0x5f, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x5f, 0x6d,
+	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x73, 0x18,
+	0xc4, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x56, 0x61,
+	0x6c, 0x75, 0x65, 0x52, 0x15, 0x53, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x4d, 0x65, 0x74, 0x61, 0x64,
+	0x61, 0x74, 0x61, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x73, 0x12, 0x53, 0x0a, 0x17, 0x5f, 0x75,
+	0x70, 0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x62, 0x61, 0x6e, 0x64, 0x77, 0x69, 0x64, 0x74, 0x68, 0x5f,
+	0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0xc5, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x49,
+	0x6e, 0x74, 0x36, 0x34, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x14, 0x55, 0x70, 0x6c, 0x6f, 0x61,
+	0x64, 0x42, 0x61, 0x6e, 0x64, 0x77, 0x69, 0x64, 0x74, 0x68, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12,
+	0x4b, 0x0a, 0x12, 0x5f, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x5f, 0x74, 0x72, 0x61, 0x6e,
+	0x73, 0x70, 0x6f, 0x72, 0x74, 0x18, 0xc6, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53,
+	0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x10, 0x42, 0x61, 0x63, 0x6b,
+	0x65, 0x6e, 0x64, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x5b, 0x0a, 0x1b,
+	0x5f, 0x65, 0x78, 0x69, 0x74, 0x5f, 0x66, 0x6c, 0x75, 0x73, 0x68, 0x5f, 0x74, 0x69, 0x6d, 0x65,
+	0x6f, 0x75, 0x74, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0xc7, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65,
+	0x52, 0x17, 0x45, 0x78, 0x69, 0x74, 0x46, 0x6c, 0x75, 0x73, 0x68, 0x54, 0x69, 0x6d, 0x65, 0x6f,
+	0x75, 0x74, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x4f, 0x0a, 0x14, 0x5f, 0x72, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x18, 0xc9, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e,
+	0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x12, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x43,
+	0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x61, 0x0a, 0x1e, 0x5f, 0x73,
+	0x74, 0x6f, 0x70, 0x5f, 0x70, 0x6f, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x5f, 0x69, 0x6e, 0x74, 0x65,
+	0x72, 0x76, 0x61, 0x6c, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0xca, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x56, 0x61, 0x6c, 0x75,
+	0x65, 0x52, 0x1a, 0x53, 0x74, 0x6f, 0x70, 0x50, 0x6f, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x49, 0x6e,
+	0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x53, 0x0a,
+	0x17, 0x5f, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x5f, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x69,
+	0x6e, 0x67, 0x5f, 0x65, 0x76, 0x65, 0x72, 0x79, 0x18, 0xcb, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x14, 0x48, 0x69,
+	0x73, 0x74, 0x6f, 0x72, 0x79, 0x53, 0x61, 0x6d, 0x70, 0x6c, 0x69, 0x6e, 0x67, 0x45, 0x76, 0x65,
+	0x72, 0x79, 0x12, 0x69, 0x0a, 0x22, 0x5f, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x5f, 0x73,
+	0x61, 0x6d, 0x70, 0x6c, 0x69, 0x6e, 0x67, 0x5f, 0x6f, 0x76, 0x65, 0x72, 0x6c, 0x6f, 0x61, 0x64,
+	0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0xcc, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x1e, 0x48,
+	0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x53, 0x61, 0x6d, 0x70, 0x6c, 0x69, 0x6e, 0x67, 0x4f, 0x76,
+	0x65, 0x72, 0x6c, 0x6f, 0x61, 0x64, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x4f, 0x0a,
+	0x13, 0x5f, 0x66, 0x61, 0x69, 0x6c, 0x6f, 0x76, 0x65, 0x72, 0x5f, 0x62, 0x61, 0x73, 0x65, 0x5f,
+	0x75, 0x72, 0x6c, 0x73, 0x18, 0xcd, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x77, 0x61,
+	0x6e, 0x64, 0x62, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x10, 0x46, 0x61,
+	0x69, 0x6c, 0x6f, 0x76, 0x65, 0x72, 0x42, 0x61, 0x73, 0x65, 0x55, 0x72, 0x6c, 0x73, 0x12, 0x58,
+	0x0a, 0x19, 0x5f, 0x66, 0x61, 0x69, 0x6c, 0x6f, 0x76, 0x65, 0x72, 0x5f, 0x72, 0x65, 0x63, 0x6f,
+	0x76, 0x65, 0x72, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0xce, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65,
+	0x52, 0x16, 0x46, 0x61, 0x69, 0x6c, 0x6f, 0x76, 0x65, 0x72, 0x52, 0x65, 0x63, 0x6f, 0x76, 0x65,
+	0x72, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x49, 0x0a, 0x11, 0x5f, 0x64, 0x65, 0x62,
+	0x6f, 0x75, 0x6e, 0x63, 0x65, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0xcf, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x56, 0x61, 0x6c,
+	0x75, 0x65, 0x52, 0x0f, 0x44, 0x65, 0x62, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x53, 0x65, 0x63, 0x6f,
+	0x6e, 0x64, 0x73, 0x12, 0x50, 0x0a, 0x15, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x73, 0x5f, 0x77, 0x69,
+	0x6e, 0x64, 0x6f, 0x77, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0xd0, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x56, 0x61, 0x6c, 0x75,
+	0x65, 0x52, 0x12, 0x53, 0x74, 0x61, 0x74, 0x73, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x53, 0x65,
+	0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x58, 0x0a, 0x19, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x73, 0x5f,
+	0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x5f, 0x61, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0xd1, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69,
+	0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x16, 0x53, 0x74, 0x61, 0x74, 0x73, 0x57, 0x69,
+	0x6e, 0x64, 0x6f, 0x77, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x54, 0x0a, 0x17, 0x5f, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x5f, 0x69, 0x6e, 0x76, 0x61,
+	0x6c, 0x69, 0x64, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0xd2, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52,
+	0x14, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x49, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x56,
+	0x61, 0x6c, 0x75, 0x65, 0x73, 0x12, 0x41, 0x0a, 0x0d, 0x5f, 0x63, 0x6f, 0x6e, 0x73, 0x6f, 0x6c,
+	0x65, 0x5f, 0x61, 0x6e, 0x73, 0x69, 0x18, 0xd3, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0b, 0x43, 0x6f, 0x6e,
+	0x73, 0x6f, 0x6c, 0x65, 0x41, 0x6e, 0x73, 0x69, 0x12, 0x52, 0x0a, 0x17, 0x5f, 0x63, 0x6f, 0x6e,
+	0x73, 0x6f, 0x6c, 0x65, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x6c, 0x69, 0x6e, 0x65, 0x5f, 0x62, 0x79,
+	0x74, 0x65, 0x73, 0x18, 0xd4, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x49, 0x6e, 0x74,
+	0x36, 0x34, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x13, 0x43, 0x6f, 0x6e, 0x73, 0x6f, 0x6c, 0x65,
+	0x4d, 0x61, 0x78, 0x4c, 0x69, 0x6e, 0x65, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x56, 0x0a, 0x18,
+	0x5f, 0x63, 0x6f, 0x6e, 0x73, 0x6f, 0x6c, 0x65, 0x5f, 0x72, 0x65, 0x6f, 0x72, 0x64, 0x65, 0x72,
+	0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0xd5, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x15, 0x43,
+	0x6f, 0x6e, 0x73, 0x6f, 0x6c, 0x65, 0x52, 0x65, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x53, 0x65, 0x63,
+	0x6f, 0x6e, 0x64, 0x73, 0x12, 0x31, 0x0a, 0x05, 0x5f, 0x72, 0x61, 0x6e, 0x6b, 0x18, 0xd6, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x56, 0x61, 0x6c, 0x75,
+	0x65, 0x52, 0x04, 0x52, 0x61, 0x6e, 0x6b, 0x12, 0x4e, 0x0a, 0x14, 0x5f, 0x68, 0x69, 0x73, 0x74,
+	0x6f, 0x72, 0x79, 0x5f, 0x73, 0x74, 0x65, 0x70, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18,
+	0xd7, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56,
+	0x61, 0x6c, 0x75, 0x65, 0x52, 0x11, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x53, 0x74, 0x65,
+	0x70, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x47, 0x0a, 0x11, 0x5f, 0x68, 0x69, 0x73, 0x74,
+	0x6f, 0x72, 0x79, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x6b, 0x65, 0x79, 0x73, 0x18, 0xd8, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x56, 0x61, 0x6c, 0x75, 0x65,
+	0x52, 0x0e, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x4d, 0x61, 0x78, 0x4b, 0x65, 0x79, 0x73,
+	0x12, 0x50, 0x0a, 0x15, 0x5f, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x5f, 0x6b, 0x65, 0x79,
+	0x5f, 0x6f, 0x76, 0x65, 0x72, 0x66, 0x6c, 0x6f, 0x77, 0x18, 0xd9, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x12,
+	0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x4b, 0x65, 0x79, 0x4f, 0x76, 0x65, 0x72, 0x66, 0x6c,
+	0x6f, 0x77, 0x12, 0x49, 0x0a, 0x12, 0x5f, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x62, 0x75, 0x66,
+	0x66, 0x65, 0x72, 0x5f, 0x72, 0x6f, 0x77, 0x73, 0x18, 0xda, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0f, 0x54, 0x61,
+	0x62, 0x6c, 0x65, 0x42, 0x75, 0x66, 0x66, 0x65, 0x72, 0x52, 0x6f, 0x77, 0x73, 0x12, 0x41, 0x0a,
+	0x0d, 0x5f, 0x63, 0x61, 0x70, 0x74, 0x75, 0x72, 0x65, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0xdb,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61,
+	0x6c, 0x75, 0x65, 0x52, 0x0b, 0x43, 0x61, 0x70, 0x74, 0x75, 0x72, 0x65, 0x50, 0x61, 0x74, 0x68,
+	0x12, 0x4f, 0x0a, 0x15, 0x5f, 0x66, 0x6c, 0x6f, 0x77, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f,
+	0x6c, 0x5f, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x73, 0x18, 0xdc, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x12, 0x46,
+	0x6c, 0x6f, 0x77, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x43, 0x72, 0x65, 0x64, 0x69, 0x74,
+	0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -2334,6 +3078,7 @@ var file_wandb_proto_wandb_settings_proto_goTypes = []interface{}{
 	(*wrapperspb.Int32Value)(nil),               // 8: google.protobuf.Int32Value
 	(*wrapperspb.StringValue)(nil),              // 9: google.protobuf.StringValue
 	(*wrapperspb.DoubleValue)(nil),              // 10: google.protobuf.DoubleValue
+	(*wrapperspb.Int64Value)(nil),               // 11: google.protobuf.Int64Value
 }
 var file_wandb_proto_wandb_settings_proto_depIdxs = []int32{
 	5,   // 0: wandb_internal.MapStringKeyStringValue.value:type_name -> wandb_internal.MapStringKeyStringValue.ValueEntry
@@ -2502,12 +3247,69 @@ var file_wandb_proto_wandb_settings_proto_depIdxs = []int32{
 	8,   // 163: wandb_internal.Settings._stats_buffer_size:type_name -> google.protobuf.Int32Value
 	7,   // 164: wandb_internal.Settings._shared:type_name -> google.protobuf.BoolValue
 	1,   // 165: wandb_internal.Settings._proxies:type_name -> wandb_internal.MapStringKeyStringValue
-	1,   // 166: wandb_internal.MapStringKeyMapStringKeyStringValue.ValueEntry.value:type_name -> wandb_internal.MapStringKeyStringValue
-	167, // [167:167] is the sub-list for method output_type
-	167, // [167:167] is the sub-list for method input_type
-	167, // [167:167] is the sub-list for extension type_name
-	167, // [167:167] is the sub-list for extension extendee
-	0,   // [0:167] is the sub-list for field type_name
+	9,   // 166: wandb_internal.Settings._store_compression:type_name -> google.protobuf.StringValue
+	0,   // 167: wandb_internal.Settings._store_exclude_types:type_name -> wandb_internal.ListStringValue
+	11,  // 168: wandb_internal.Settings._store_max_segment_bytes:type_name -> google.protobuf.Int64Value
+	7,   // 169: wandb_internal.Settings._store_index:type_name -> google.protobuf.BoolValue
+	9,   // 170: wandb_internal.Settings._store_encryption_key:type_name -> google.protobuf.StringValue
+	10,  // 171: wandb_internal.Settings._writer_metrics_interval_seconds:type_name -> google.protobuf.DoubleValue
+	9,   // 172: wandb_internal.Settings._store_sync_policy:type_name -> google.protobuf.StringValue
+	9,   // 173: wandb_internal.Settings._store_mirror_dir:type_name -> google.protobuf.StringValue
+	10,  // 174: wandb_internal.Settings._store_drain_timeout_seconds:type_name -> google.protobuf.DoubleValue
+	7,   // 175: wandb_internal.Settings._store_embed_schema:type_name -> google.protobuf.BoolValue
+	0,   // 176: wandb_internal.Settings._store_dedup_types:type_name -> wandb_internal.ListStringValue
+	11,  // 177: wandb_internal.Settings._store_console_max_lines:type_name -> google.protobuf.Int64Value
+	11,  // 178: wandb_internal.Settings._store_console_max_bytes:type_name -> google.protobuf.Int64Value
+	10,  // 179: wandb_internal.Settings._store_console_window_seconds:type_name -> google.protobuf.DoubleValue
+	11,  // 180: wandb_internal.Settings._store_shards:type_name -> google.protobuf.Int64Value
+	9,   // 181: wandb_internal.Settings._store_shard_key:type_name -> google.protobuf.StringValue
+	7,   // 182: wandb_internal.Settings._store_journal:type_name -> google.protobuf.BoolValue
+	9,   // 183: wandb_internal.Settings._store_backend:type_name -> google.protobuf.StringValue
+	8,   // 184: wandb_internal.Settings._writer_buffer_size:type_name -> google.protobuf.Int32Value
+	8,   // 185: wandb_internal.Settings._sender_buffer_size:type_name -> google.protobuf.Int32Value
+	8,   // 186: wandb_internal.Settings._store_queue_size:type_name -> google.protobuf.Int32Value
+	7,   // 187: wandb_internal.Settings._store_metadata:type_name -> google.protobuf.BoolValue
+	11,  // 188: wandb_internal.Settings._store_snapshot_interval:type_name -> google.protobuf.Int64Value
+	10,  // 189: wandb_internal.Settings._retry_jitter:type_name -> google.protobuf.DoubleValue
+	0,   // 190: wandb_internal.Settings._retry_status_codes:type_name -> wandb_internal.ListStringValue
+	11,  // 191: wandb_internal.Settings._spool_max_bytes:type_name -> google.protobuf.Int64Value
+	8,   // 192: wandb_internal.Settings._circuit_breaker_failures:type_name -> google.protobuf.Int32Value
+	10,  // 193: wandb_internal.Settings._circuit_breaker_cooldown_seconds:type_name -> google.protobuf.DoubleValue
+	10,  // 194: wandb_internal.Settings._file_stream_flush_interval_seconds:type_name -> google.protobuf.DoubleValue
+	11,  // 195: wandb_internal.Settings._file_stream_max_bytes:type_name -> google.protobuf.Int64Value
+	9,   // 196: wandb_internal.Settings._no_proxy:type_name -> google.protobuf.StringValue
+	9,   // 197: wandb_internal.Settings._ca_bundle_path:type_name -> google.protobuf.StringValue
+	8,   // 198: wandb_internal.Settings._sender_upload_workers:type_name -> google.protobuf.Int32Value
+	8,   // 199: wandb_internal.Settings._sender_metadata_workers:type_name -> google.protobuf.Int32Value
+	11,  // 200: wandb_internal.Settings._upload_bandwidth_bytes:type_name -> google.protobuf.Int64Value
+	9,   // 201: wandb_internal.Settings._backend_transport:type_name -> google.protobuf.StringValue
+	10,  // 202: wandb_internal.Settings._exit_flush_timeout_seconds:type_name -> google.protobuf.DoubleValue
+	9,   // 203: wandb_internal.Settings._request_compression:type_name -> google.protobuf.StringValue
+	10,  // 204: wandb_internal.Settings._stop_polling_interval_seconds:type_name -> google.protobuf.DoubleValue
+	8,   // 205: wandb_internal.Settings._history_sampling_every:type_name -> google.protobuf.Int32Value
+	10,  // 206: wandb_internal.Settings._history_sampling_overload_seconds:type_name -> google.protobuf.DoubleValue
+	0,   // 207: wandb_internal.Settings._failover_base_urls:type_name -> wandb_internal.ListStringValue
+	10,  // 208: wandb_internal.Settings._failover_recover_seconds:type_name -> google.protobuf.DoubleValue
+	10,  // 209: wandb_internal.Settings._debounce_seconds:type_name -> google.protobuf.DoubleValue
+	10,  // 210: wandb_internal.Settings._stats_window_seconds:type_name -> google.protobuf.DoubleValue
+	9,   // 211: wandb_internal.Settings._stats_window_aggregation:type_name -> google.protobuf.StringValue
+	9,   // 212: wandb_internal.Settings._history_invalid_values:type_name -> google.protobuf.StringValue
+	9,   // 213: wandb_internal.Settings._console_ansi:type_name -> google.protobuf.StringValue
+	11,  // 214: wandb_internal.Settings._console_max_line_bytes:type_name -> google.protobuf.Int64Value
+	10,  // 215: wandb_internal.Settings._console_reorder_seconds:type_name -> google.protobuf.DoubleValue
+	8,   // 216: wandb_internal.Settings._rank:type_name -> google.protobuf.Int32Value
+	9,   // 217: wandb_internal.Settings._history_step_policy:type_name -> google.protobuf.StringValue
+	8,   // 218: wandb_internal.Settings._history_max_keys:type_name -> google.protobuf.Int32Value
+	9,   // 219: wandb_internal.Settings._history_key_overflow:type_name -> google.protobuf.StringValue
+	8,   // 220: wandb_internal.Settings._table_buffer_rows:type_name -> google.protobuf.Int32Value
+	9,   // 221: wandb_internal.Settings._capture_path:type_name -> google.protobuf.StringValue
+	8,   // 222: wandb_internal.Settings._flow_control_credits:type_name -> google.protobuf.Int32Value
+	1,   // 223: wandb_internal.MapStringKeyMapStringKeyStringValue.ValueEntry.value:type_name -> wandb_internal.MapStringKeyStringValue
+	224, // [224:224] is the sub-list for method output_type
+	224, // [224:224] is the sub-list for method input_type
+	224, // [224:224] is the sub-list for extension type_name
+	224, // [224:224] is the sub-list for extension extendee
+	0,   // [0:224] is the sub-list for field type_name
 }
 
 func init() { file_wandb_proto_wandb_settings_proto_init() }
@@ -0,0 +1,216 @@
+// Package store reads the .wandb files written by the internal writer of a
+// run, so that tools can parse them without depending on the internals of the
+// server package.
+package store
+
+import (
+	"context"
+	"errors"
+	"io"
+
+	"github.com/wandb/wandb/core/pkg/observability"
+	"github.com/wandb/wandb/core/pkg/server"
+	"github.com/wandb/wandb/core/pkg/service"
+)
+
+// ErrStop can be returned by the function passed to Iterate, or to one of the
+// typed iterators, to stop the iteration without an error.
+var ErrStop = errors.New("store: stop iteration")
+
+// ErrCorruptRecord is returned, wrapped, when a record of the file is corrupt.
+var ErrCorruptRecord = server.ErrCorruptRecord
+
+// Header describes how the records of a file are encoded
+type Header struct {
+	// Version is the version of the file format
+	Version byte
+
+	// Compressed reports whether the records are compressed
+	Compressed bool
+
+	// Encrypted reports whether the records are encrypted
+	Encrypted bool
+
+	// Schema reports whether the file embeds the schema of its records,
+	// records written with another schema are migrated when read
+	Schema bool
+
+	// Metadata identifies the run of the file, it is nil if the writer did
+	// not record it
+	Metadata *Metadata
+}
+
+// Metadata identifies the run a file was written for
+type Metadata = server.StoreMetadata
+
+// newHeader returns the description of a store header
+func newHeader(header server.HeaderOptions) Header {
+	return Header{
+		Version:    header.Version,
+		Compressed: header.Compression != server.CompressionNone,
+		Encrypted:  header.Encryption != server.EncryptionNone,
+		Schema:     header.Schema != nil,
+		Metadata:   header.Metadata,
+	}
+}
+
+// ReadHeader returns the header of the .wandb file at path, without reading
+// its records
+func ReadHeader(path string) (Header, error) {
+	header, err := server.ReadHeaderOnly(path)
+	if err != nil {
+		return Header{}, err
+	}
+	return newHeader(*header), nil
+}
+
+type options struct {
+	encryptionKey []byte
+	logger        *observability.CoreLogger
+	mmap          bool
+}
+
+// Option configures a Reader
+type Option func(*options)
+
+// WithEncryptionKey sets the key used to decrypt the records of an encrypted
+// file. The key can be decoded with server.ParseEncryptionKey.
+func WithEncryptionKey(key []byte) Option {
+	return func(o *options) {
+		o.encryptionKey = key
+	}
+}
+
+// WithLogger sets the logger errors are reported to, nothing is logged by
+// default
+func WithLogger(logger *observability.CoreLogger) Option {
+	return func(o *options) {
+		o.logger = logger
+	}
+}
+
+// WithMmap maps the file into memory instead of reading it, which is faster
+// for large files. It must not be used for a file that is still being
+// written, as the records appended after it was opened are not read.
+func WithMmap() Option {
+	return func(o *options) {
+		o.mmap = true
+	}
+}
+
+// Reader reads the records of a .wandb file in order. If the writer split the
+// file into segments, the records of all segments are read.
+type Reader struct {
+	reader *server.SegmentReader
+}
+
+// Open opens the .wandb file at path for reading
+func Open(path string, opts ...Option) (*Reader, error) {
+	o := options{logger: observability.NewNoOpLogger()}
+	for _, opt := range opts {
+		opt(&o)
+	}
+	reader := server.NewSegmentReaderWithOptions(
+		context.Background(),
+		path,
+		o.logger,
+		server.StoreOptions{EncryptionKey: o.encryptionKey, Mmap: o.mmap},
+	)
+	if err := reader.Open(); err != nil {
+		return nil, err
+	}
+	return &Reader{reader: reader}, nil
+}
+
+// Header returns the header of the file, or of the segment being read
+func (r *Reader) Header() Header {
+	return newHeader(r.reader.Header())
+}
+
+// Next returns the next record, it returns io.EOF after the last record
+func (r *Reader) Next() (*service.Record, error) {
+	return r.reader.Read()
+}
+
+// SeekRecord moves the reader so that Next returns the first record whose
+// number is at least num. It returns io.EOF if there is no such record.
+func (r *Reader) SeekRecord(num int64) error {
+	return r.reader.SeekRecord(num)
+}
+
+// Iterate calls fn for each of the remaining records until the end of the
+// file or until fn returns an error. It returns the error of fn, unless it is
+// ErrStop.
+func (r *Reader) Iterate(fn func(*service.Record) error) error {
+	for {
+		record, err := r.Next()
+		if err == io.EOF {
+			return nil
+		} else if err != nil {
+			return err
+		}
+		if err := fn(record); err == ErrStop {
+			return nil
+		} else if err != nil {
+			return err
+		}
+	}
+}
+
+// Run returns the first run record of the remaining records, or nil if there
+// is none.
+func (r *Reader) Run() (*service.RunRecord, error) {
+	var run *service.RunRecord
+	err := r.Iterate(func(record *service.Record) error {
+		if run = record.GetRun(); run != nil {
+			return ErrStop
+		}
+		return nil
+	})
+	return run, err
+}
+
+// History calls fn for each of the remaining history records
+func (r *Reader) History(fn func(num int64, history *service.HistoryRecord) error) error {
+	return r.Iterate(func(record *service.Record) error {
+		if history := record.GetHistory(); history != nil {
+			return fn(record.Num, history)
+		}
+		return nil
+	})
+}
+
+// Summary calls fn for each of the remaining summary records
+func (r *Reader) Summary(fn func(num int64, summary *service.SummaryRecord) error) error {
+	return r.Iterate(func(record *service.Record) error {
+		if summary := record.GetSummary(); summary != nil {
+			return fn(record.Num, summary)
+		}
+		return nil
+	})
+}
+
+// Config calls fn for each of the remaining config records
+func (r *Reader) Config(fn func(num int64, config *service.ConfigRecord) error) error {
+	return r.Iterate(func(record *service.Record) error {
+		if config := record.GetConfig(); config != nil {
+			return fn(record.Num, config)
+		}
+		return nil
+	})
+}
+
+// Output calls fn for each of the remaining raw console output records
+func (r *Reader) Output(fn func(num int64, output *service.OutputRawRecord) error) error {
+	return r.Iterate(func(record *service.Record) error {
+		if output := record.GetOutputRaw(); output != nil {
+			return fn(record.Num, output)
+		}
+		return nil
+	})
+}
+
+// Close closes the file
+func (r *Reader) Close() error {
+	return r.reader.Close()
+}
@@ -0,0 +1,172 @@
+package store_test
+
+import (
+	"context"
+	"io"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	"github.com/wandb/wandb/core/pkg/observability"
+	"github.com/wandb/wandb/core/pkg/server"
+	"github.com/wandb/wandb/core/pkg/service"
+	"github.com/wandb/wandb/core/pkg/store"
+)
+
+// writeFile writes the records to a .wandb file with the writer
+func writeFile(records []*service.Record, opts ...server.WriterOption) {
+	fwdChan := make(chan *service.Record, server.BufferSize)
+	inChan := make(chan *service.Record, server.BufferSize)
+	writer := server.NewWriter(context.Background(), observability.NewNoOpLogger(),
+		append([]server.WriterOption{server.WithWriterFwdChannel(fwdChan)}, opts...)...,
+	)
+	go func() {
+		for range fwdChan {
+		}
+	}()
+	for _, record := range records {
+		inChan <- record
+	}
+	close(inChan)
+	writer.Do(inChan)
+}
+
+func makeRecords() []*service.Record {
+	return []*service.Record{
+		{RecordType: &service.Record_Run{Run: &service.RunRecord{RunId: "abc"}}},
+		{RecordType: &service.Record_History{History: &service.HistoryRecord{}}},
+		{RecordType: &service.Record_OutputRaw{OutputRaw: &service.OutputRawRecord{Line: "line"}}},
+		{RecordType: &service.Record_History{History: &service.HistoryRecord{}}},
+		{RecordType: &service.Record_Summary{Summary: &service.SummaryRecord{}}},
+	}
+}
+
+func TestReaderIterate(t *testing.T) {
+	fileName := filepath.Join(t.TempDir(), "run.wandb")
+	settings := &service.Settings{SyncFile: &wrapperspb.StringValue{Value: fileName}}
+	writeFile(makeRecords(), server.WithWriterSettings(settings))
+
+	reader, err := store.Open(fileName)
+	assert.NoError(t, err)
+	defer reader.Close()
+	assert.False(t, reader.Header().Encrypted)
+
+	var nums []int64
+	assert.NoError(t, reader.Iterate(func(record *service.Record) error {
+		nums = append(nums, record.Num)
+		return nil
+	}))
+	assert.Equal(t, []int64{1, 2, 3, 4, 5}, nums)
+
+	_, err = reader.Next()
+	assert.Equal(t, io.EOF, err)
+}
+
+func TestReaderTypedAccessors(t *testing.T) {
+	fileName := filepath.Join(t.TempDir(), "run.wandb")
+	settings := &service.Settings{SyncFile: &wrapperspb.StringValue{Value: fileName}}
+	writeFile(makeRecords(), server.WithWriterSettings(settings))
+
+	reader, err := store.Open(fileName)
+	assert.NoError(t, err)
+	defer reader.Close()
+
+	run, err := reader.Run()
+	assert.NoError(t, err)
+	assert.Equal(t, "abc", run.GetRunId())
+
+	var nums []int64
+	assert.NoError(t, reader.History(func(num int64, _ *service.HistoryRecord) error {
+		nums = append(nums, num)
+		return nil
+	}))
+	assert.Equal(t, []int64{2, 4}, nums)
+}
+
+func TestReaderSeek(t *testing.T) {
+	fileName := filepath.Join(t.TempDir(), "run.wandb")
+	settings := &service.Settings{
+		SyncFile:              &wrapperspb.StringValue{Value: fileName},
+		XStoreMaxSegmentBytes: &wrapperspb.Int64Value{Value: 40},
+		XStoreIndex:           &wrapperspb.BoolValue{Value: true},
+	}
+	writeFile(makeRecords(), server.WithWriterSettings(settings))
+
+	reader, err := store.Open(fileName)
+	assert.NoError(t, err)
+	defer reader.Close()
+
+	for _, num := range []int64{4, 2, 5} {
+		assert.NoError(t, reader.SeekRecord(num))
+		record, err := reader.Next()
+		assert.NoError(t, err)
+		assert.Equal(t, num, record.Num)
+	}
+	assert.Equal(t, io.EOF, reader.SeekRecord(6))
+}
+
+func TestReaderMmap(t *testing.T) {
+	fileName := filepath.Join(t.TempDir(), "run.wandb")
+	settings := &service.Settings{SyncFile: &wrapperspb.StringValue{Value: fileName}}
+	writeFile(makeRecords(), server.WithWriterSettings(settings))
+
+	reader, err := store.Open(fileName, store.WithMmap())
+	assert.NoError(t, err)
+	defer reader.Close()
+
+	var count int
+	assert.NoError(t, reader.Iterate(func(record *service.Record) error {
+		count++
+		return nil
+	}))
+	assert.Equal(t, 5, count)
+}
+
+func TestReadHeader(t *testing.T) {
+	fileName := filepath.Join(t.TempDir(), "run.wandb")
+	settings := &service.Settings{
+		SyncFile: &wrapperspb.StringValue{Value: fileName},
+		RunId:    &wrapperspb.StringValue{Value: "abc"},
+		Entity:   &wrapperspb.StringValue{Value: "entity"},
+		Project:  &wrapperspb.StringValue{Value: "project"},
+	}
+	writeFile(makeRecords(), server.WithWriterSettings(settings), server.WithWriterMetadata())
+
+	header, err := store.ReadHeader(fileName)
+	assert.NoError(t, err)
+	assert.Equal(t, "abc", header.Metadata.RunID)
+	assert.Equal(t, "entity", header.Metadata.Entity)
+	assert.Equal(t, "project", header.Metadata.Project)
+	assert.False(t, header.Metadata.CreatedAt.IsZero())
+
+	reader, err := store.Open(fileName)
+	assert.NoError(t, err)
+	defer reader.Close()
+	assert.Equal(t, header, reader.Header())
+}
+
+func TestReaderEncrypted(t *testing.T) {
+	fileName := filepath.Join(t.TempDir(), "run.wandb")
+	key := "MDEyMzQ1Njc4OWFiY2RlZg=="
+	settings := &service.Settings{
+		SyncFile:            &wrapperspb.StringValue{Value: fileName},
+		XStoreEncryptionKey: &wrapperspb.StringValue{Value: key},
+	}
+	writeFile(makeRecords(), server.WithWriterSettings(settings))
+
+	_, err := store.Open(fileName)
+	assert.Error(t, err)
+
+	decoded, err := server.ParseEncryptionKey(key)
+	assert.NoError(t, err)
+	reader, err := store.Open(fileName, store.WithEncryptionKey(decoded))
+	assert.NoError(t, err)
+	defer reader.Close()
+	assert.True(t, reader.Header().Encrypted)
+
+	record, err := reader.Next()
+	assert.NoError(t, err)
+	assert.Equal(t, "abc", record.GetRun().GetRunId())
+}
@@ -14,6 +14,15 @@ func NilIfZero[T comparable](x T) *T {
 	return &x
 }
 
+// ZeroIfNil returns the value x points to, or the zero value if x is nil
+func ZeroIfNil[T any](x *T) T {
+	if x == nil {
+		var zero T
+		return zero
+	}
+	return *x
+}
+
 func GenerateAlphanumericSequence(length int) string {
 	var result string
 	for i := 0; i < length; i++ {
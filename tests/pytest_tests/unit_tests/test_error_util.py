@@ -1,5 +1,13 @@
 import pytest
-from wandb.errors import Error
+from wandb.errors import (
+    AuthenticationError,
+    CommError,
+    Error,
+    ProjectNotFoundError,
+    QuotaExceededError,
+    ServerError,
+    UsageError,
+)
 from wandb.errors.util import ProtobufErrorHandler
 from wandb.proto import wandb_internal_pb2 as pb
 
@@ -9,6 +17,10 @@ from wandb.proto import wandb_internal_pb2 as pb
     [
         (pb.ErrorInfo(), type(None)),
         (pb.ErrorInfo(code=-2), Error),
+        (pb.ErrorInfo(code=pb.ErrorInfo.AUTHENTICATION), AuthenticationError),
+        (pb.ErrorInfo(code=pb.ErrorInfo.QUOTA_EXCEEDED), QuotaExceededError),
+        (pb.ErrorInfo(code=pb.ErrorInfo.PROJECT_NOT_FOUND), ProjectNotFoundError),
+        (pb.ErrorInfo(code=pb.ErrorInfo.SERVER), ServerError),
     ],
 )
 def test_protobuf_error_handler(error, expected):
@@ -16,6 +28,20 @@ def test_protobuf_error_handler(error, expected):
     assert isinstance(exc, expected)
 
 
+@pytest.mark.parametrize(
+    "exc, parent",
+    [
+        (QuotaExceededError("quota"), CommError),
+        (ServerError("server"), CommError),
+        (ProjectNotFoundError("project"), UsageError),
+    ],
+)
+def test_protobuf_error_handler_roundtrip(exc, parent):
+    error = ProtobufErrorHandler.from_exception(exc)
+    assert isinstance(ProtobufErrorHandler.to_exception(error), type(exc))
+    assert isinstance(exc, parent)
+
+
 def test_protobuf_error_handler_exception():
     with pytest.raises(ValueError):
         ProtobufErrorHandler.from_exception(Exception(""))  # type: ignore
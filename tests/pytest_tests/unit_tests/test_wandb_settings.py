@@ -900,6 +900,32 @@ def test_settings_static():
     assert static_settings.base_url == "https://api.wandb.ai"
 
 
+def test_settings_static_core_settings():
+    from wandb.sdk.internal.settings_static import SettingsStatic
+
+    settings = Settings()
+    settings.update(
+        {
+            "_spool_max_bytes": "4294967296",
+            "_store_exclude_types": "output_raw,stats",
+            "_store_index": "true",
+            "_retry_jitter": 0.5,
+            "_history_max_keys": 100,
+        },
+        source=Source.SETTINGS,
+    )
+    proto = settings.to_proto()
+    # sizes over 32 bits are Int64Value
+    assert proto._spool_max_bytes.value == 4294967296
+    static_settings = SettingsStatic(proto)
+    assert static_settings._spool_max_bytes == 4294967296
+    assert static_settings._store_exclude_types == ["output_raw", "stats"]
+    assert static_settings._store_index is True
+    assert static_settings._retry_jitter == 0.5
+    assert static_settings._history_max_keys == 100
+    assert static_settings._store_shards is None
+
+
 # --------------------------
 # test run settings
 # --------------------------
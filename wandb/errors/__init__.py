@@ -2,8 +2,11 @@ __all__ = [
     "Error",
     "CommError",
     "AuthenticationError",
+    "QuotaExceededError",
+    "ServerError",
     "UsageError",
     "UnsupportedError",
+    "ProjectNotFoundError",
 ]
 
 from typing import Optional
@@ -33,9 +36,21 @@ class AuthenticationError(CommError):
     """Raised when authentication fails."""
 
 
+class QuotaExceededError(CommError):
+    """Raised when the storage or usage quota of the account is exceeded."""
+
+
+class ServerError(CommError):
+    """Raised when the W&B server fails to handle a request."""
+
+
 class UsageError(Error):
     """Raised when an invalid usage of the SDK API is detected."""
 
 
 class UnsupportedError(UsageError):
     """Raised when trying to use a feature that is not supported."""
+
+
+class ProjectNotFoundError(UsageError):
+    """Raised when the entity or project of a run does not exist."""
@@ -2,7 +2,16 @@ from typing import Optional
 
 from wandb.proto import wandb_internal_pb2 as pb
 
-from . import AuthenticationError, CommError, Error, UnsupportedError, UsageError
+from . import (
+    AuthenticationError,
+    CommError,
+    Error,
+    ProjectNotFoundError,
+    QuotaExceededError,
+    ServerError,
+    UnsupportedError,
+    UsageError,
+)
 
 to_exception_map = {
     pb.ErrorInfo.UNKNOWN: Error,
@@ -10,6 +19,9 @@ to_exception_map = {
     pb.ErrorInfo.AUTHENTICATION: AuthenticationError,
     pb.ErrorInfo.USAGE: UsageError,
     pb.ErrorInfo.UNSUPPORTED: UnsupportedError,
+    pb.ErrorInfo.QUOTA_EXCEEDED: QuotaExceededError,
+    pb.ErrorInfo.PROJECT_NOT_FOUND: ProjectNotFoundError,
+    pb.ErrorInfo.SERVER: ServerError,
 }
 
 from_exception_map = {v: k for k, v in to_exception_map.items()}
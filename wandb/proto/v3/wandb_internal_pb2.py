@@ -17,7 +17,7 @@ from wandb.proto import wandb_base_pb2 as wandb_dot_proto_dot_wandb__base__pb2
 from wandb.proto import wandb_telemetry_pb2 as wandb_dot_proto_dot_wandb__telemetry__pb2
 
 
-DESCRIPTOR = _descriptor_pool.Default().AddSerializedFile(b'\n wandb/proto/wandb_internal.proto\x12\x0ewandb_internal\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1cwandb/proto/wandb_base.proto\x1a!wandb/proto/wandb_telemetry.proto\"\x9c\t\n\x06Record\x12\x0b\n\x03num\x18\x01 \x01(\x03\x12\x30\n\x07history\x18\x02 \x01(\x0b\x32\x1d.wandb_internal.HistoryRecordH\x00\x12\x30\n\x07summary\x18\x03 \x01(\x0b\x32\x1d.wandb_internal.SummaryRecordH\x00\x12.\n\x06output\x18\x04 \x01(\x0b\x32\x1c.wandb_internal.OutputRecordH\x00\x12.\n\x06\x63onfig\x18\x05 \x01(\x0b\x32\x1c.wandb_internal.ConfigRecordH\x00\x12,\n\x05\x66iles\x18\x06 \x01(\x0b\x32\x1b.wandb_internal.FilesRecordH\x00\x12,\n\x05stats\x18\x07 \x01(\x0b\x32\x1b.wandb_internal.StatsRecordH\x00\x12\x32\n\x08\x61rtifact\x18\x08 \x01(\x0b\x32\x1e.wandb_internal.ArtifactRecordH\x00\x12,\n\x08tbrecord\x18\t \x01(\x0b\x32\x18.wandb_internal.TBRecordH\x00\x12,\n\x05\x61lert\x18\n \x01(\x0b\x32\x1b.wandb_internal.AlertRecordH\x00\x12\x34\n\ttelemetry\x18\x0b \x01(\x0b\x32\x1f.wandb_internal.TelemetryRecordH\x00\x12.\n\x06metric\x18\x0c \x01(\x0b\x32\x1c.wandb_internal.MetricRecordH\x00\x12\x35\n\noutput_raw\x18\r \x01(\x0b\x32\x1f.wandb_internal.OutputRawRecordH\x00\x12(\n\x03run\x18\x11 \x01(\x0b\x32\x19.wandb_internal.RunRecordH\x00\x12-\n\x04\x65xit\x18\x12 \x01(\x0b\x32\x1d.wandb_internal.RunExitRecordH\x00\x12,\n\x05\x66inal\x18\x14 \x01(\x0b\x32\x1b.wandb_internal.FinalRecordH\x00\x12.\n\x06header\x18\x15 \x01(\x0b\x32\x1c.wandb_internal.HeaderRecordH\x00\x12.\n\x06\x66ooter\x18\x16 \x01(\x0b\x32\x1c.wandb_internal.FooterRecordH\x00\x12\x39\n\npreempting\x18\x17 \x01(\x0b\x32#.wandb_internal.RunPreemptingRecordH\x00\x12;\n\rlink_artifact\x18\x18 \x01(\x0b\x32\".wandb_internal.LinkArtifactRecordH\x00\x12\x39\n\x0cuse_artifact\x18\x19 \x01(\x0b\x32!.wandb_internal.UseArtifactRecordH\x00\x12*\n\x07request\x18\x64 \x01(\x0b\x32\x17.wandb_internal.RequestH\x00\x12(\n\x07\x63ontrol\x18\x10 \x01(\x0b\x32\x17.wandb_internal.Control\x12\x0c\n\x04uuid\x18\x13 \x01(\t\x12+\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1b.wandb_internal._RecordInfoB\r\n\x0brecord_type\"\xa8\x01\n\x07\x43ontrol\x12\x10\n\x08req_resp\x18\x01 \x01(\x08\x12\r\n\x05local\x18\x02 \x01(\x08\x12\x10\n\x08relay_id\x18\x03 \x01(\t\x12\x14\n\x0cmailbox_slot\x18\x04 \x01(\t\x12\x13\n\x0b\x61lways_send\x18\x05 \x01(\x08\x12\x14\n\x0c\x66low_control\x18\x06 \x01(\x08\x12\x12\n\nend_offset\x18\x07 \x01(\x03\x12\x15\n\rconnection_id\x18\x08 \x01(\t\"\xf3\x03\n\x06Result\x12\x35\n\nrun_result\x18\x11 \x01(\x0b\x32\x1f.wandb_internal.RunUpdateResultH\x00\x12\x34\n\x0b\x65xit_result\x18\x12 \x01(\x0b\x32\x1d.wandb_internal.RunExitResultH\x00\x12\x33\n\nlog_result\x18\x14 \x01(\x0b\x32\x1d.wandb_internal.HistoryResultH\x00\x12\x37\n\x0esummary_result\x18\x15 \x01(\x0b\x32\x1d.wandb_internal.SummaryResultH\x00\x12\x35\n\routput_result\x18\x16 \x01(\x0b\x32\x1c.wandb_internal.OutputResultH\x00\x12\x35\n\rconfig_result\x18\x17 \x01(\x0b\x32\x1c.wandb_internal.ConfigResultH\x00\x12,\n\x08response\x18\x64 \x01(\x0b\x32\x18.wandb_internal.ResponseH\x00\x12(\n\x07\x63ontrol\x18\x10 \x01(\x0b\x32\x17.wandb_internal.Control\x12\x0c\n\x04uuid\x18\x18 \x01(\t\x12+\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1b.wandb_internal._ResultInfoB\r\n\x0bresult_type\":\n\x0b\x46inalRecord\x12+\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1b.wandb_internal._RecordInfo\"b\n\x0bVersionInfo\x12\x10\n\x08producer\x18\x01 \x01(\t\x12\x14\n\x0cmin_consumer\x18\x02 \x01(\t\x12+\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1b.wandb_internal._RecordInfo\"n\n\x0cHeaderRecord\x12\x31\n\x0cversion_info\x18\x01 \x01(\x0b\x32\x1b.wandb_internal.VersionInfo\x12+\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1b.wandb_internal._RecordInfo\";\n\x0c\x46ooterRecord\x12+\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1b.wandb_internal._RecordInfo\"\xce\x04\n\tRunRecord\x12\x0e\n\x06run_id\x18\x01 \x01(\t\x12\x0e\n\x06\x65ntity\x18\x02 \x01(\t\x12\x0f\n\x07project\x18\x03 \x01(\t\x12,\n\x06\x63onfig\x18\x04 \x01(\x0b\x32\x1c.wandb_internal.ConfigRecord\x12.\n\x07summary\x18\x05 \x01(\x0b\x32\x1d.wandb_internal.SummaryRecord\x12\x11\n\trun_group\x18\x06 \x01(\t\x12\x10\n\x08job_type\x18\x07 \x01(\t\x12\x14\n\x0c\x64isplay_name\x18\x08 \x01(\t\x12\r\n\x05notes\x18\t \x01(\t\x12\x0c\n\x04tags\x18\n \x03(\t\x12\x30\n\x08settings\x18\x0b \x01(\x0b\x32\x1e.wandb_internal.SettingsRecord\x12\x10\n\x08sweep_id\x18\x0c \x01(\t\x12\x0c\n\x04host\x18\r \x01(\t\x12\x15\n\rstarting_step\x18\x0e \x01(\x03\x12\x12\n\nstorage_id\x18\x10 \x01(\t\x12.\n\nstart_time\x18\x11 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12\x0f\n\x07resumed\x18\x12 \x01(\x08\x12\x32\n\ttelemetry\x18\x13 \x01(\x0b\x32\x1f.wandb_internal.TelemetryRecord\x12\x0f\n\x07runtime\x18\x14 \x01(\x05\x12*\n\x03git\x18\x15 \x01(\x0b\x32\x1d.wandb_internal.GitRepoRecord\x12+\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1b.wandb_internal._RecordInfo\";\n\rGitRepoRecord\x12\x1a\n\nremote_url\x18\x01 \x01(\tR\x06remote\x12\x0e\n\x06\x63ommit\x18\x02 \x01(\t\"c\n\x0fRunUpdateResult\x12&\n\x03run\x18\x01 \x01(\x0b\x32\x19.wandb_internal.RunRecord\x12(\n\x05\x65rror\x18\x02 \x01(\x0b\x32\x19.wandb_internal.ErrorInfo\"\xac\x01\n\tErrorInfo\x12\x0f\n\x07message\x18\x01 \x01(\t\x12\x31\n\x04\x63ode\x18\x02 \x01(\x0e\x32#.wandb_internal.ErrorInfo.ErrorCode\"[\n\tErrorCode\x12\x0b\n\x07UNKNOWN\x10\x00\x12\x11\n\rCOMMUNICATION\x10\x01\x12\x12\n\x0e\x41UTHENTICATION\x10\x02\x12\t\n\x05USAGE\x10\x03\x12\x0f\n\x0bUNSUPPORTED\x10\x04\"`\n\rRunExitRecord\x12\x11\n\texit_code\x18\x01 \x01(\x05\x12\x0f\n\x07runtime\x18\x02 \x01(\x05\x12+\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1b.wandb_internal._RecordInfo\"\x0f\n\rRunExitResult\"B\n\x13RunPreemptingRecord\x12+\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1b.wandb_internal._RecordInfo\"\x15\n\x13RunPreemptingResult\"i\n\x0eSettingsRecord\x12*\n\x04item\x18\x01 \x03(\x0b\x32\x1c.wandb_internal.SettingsItem\x12+\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1b.wandb_internal._RecordInfo\"/\n\x0cSettingsItem\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\x12\n\nvalue_json\x18\x10 \x01(\t\"\x1a\n\x0bHistoryStep\x12\x0b\n\x03num\x18\x01 \x01(\x03\"\x92\x01\n\rHistoryRecord\x12)\n\x04item\x18\x01 \x03(\x0b\x32\x1b.wandb_internal.HistoryItem\x12)\n\x04step\x18\x02 \x01(\x0b\x32\x1b.wandb_internal.HistoryStep\x12+\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1b.wandb_internal._RecordInfo\"B\n\x0bHistoryItem\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\x12\n\nnested_key\x18\x02 \x03(\t\x12\x12\n\nvalue_json\x18\x10 \x01(\t\"\x0f\n\rHistoryResult\"\xdc\x01\n\x0cOutputRecord\x12<\n\x0boutput_type\x18\x01 \x01(\x0e\x32\'.wandb_internal.OutputRecord.OutputType\x12-\n\ttimestamp\x18\x02 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12\x0c\n\x04line\x18\x03 \x01(\t\x12+\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1b.wandb_internal._RecordInfo\"$\n\nOutputType\x12\n\n\x06STDERR\x10\x00\x12\n\n\x06STDOUT\x10\x01\"\x0e\n\x0cOutputResult\"\xe2\x01\n\x0fOutputRawRecord\x12?\n\x0boutput_type\x18\x01 \x01(\x0e\x32*.wandb_internal.OutputRawRecord.OutputType\x12-\n\ttimestamp\x18\x02 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12\x0c\n\x04line\x18\x03 \x01(\t\x12+\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1b.wandb_internal._RecordInfo\"$\n\nOutputType\x12\n\n\x06STDERR\x10\x00\x12\n\n\x06STDOUT\x10\x01\"\x11\n\x0fOutputRawResult\"\x98\x03\n\x0cMetricRecord\x12\x0c\n\x04name\x18\x01 \x01(\t\x12\x11\n\tglob_name\x18\x02 \x01(\t\x12\x13\n\x0bstep_metric\x18\x04 \x01(\t\x12\x19\n\x11step_metric_index\x18\x05 \x01(\x05\x12.\n\x07options\x18\x06 \x01(\x0b\x32\x1d.wandb_internal.MetricOptions\x12.\n\x07summary\x18\x07 \x01(\x0b\x32\x1d.wandb_internal.MetricSummary\x12\x35\n\x04goal\x18\x08 \x01(\x0e\x32\'.wandb_internal.MetricRecord.MetricGoal\x12/\n\x08_control\x18\t \x01(\x0b\x32\x1d.wandb_internal.MetricControl\x12+\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1b.wandb_internal._RecordInfo\"B\n\nMetricGoal\x12\x0e\n\nGOAL_UNSET\x10\x00\x12\x11\n\rGOAL_MINIMIZE\x10\x01\x12\x11\n\rGOAL_MAXIMIZE\x10\x02\"\x0e\n\x0cMetricResult\"C\n\rMetricOptions\x12\x11\n\tstep_sync\x18\x01 \x01(\x08\x12\x0e\n\x06hidden\x18\x02 \x01(\x08\x12\x0f\n\x07\x64\x65\x66ined\x18\x03 \x01(\x08\"\"\n\rMetricControl\x12\x11\n\toverwrite\x18\x01 \x01(\x08\"o\n\rMetricSummary\x12\x0b\n\x03min\x18\x01 \x01(\x08\x12\x0b\n\x03max\x18\x02 \x01(\x08\x12\x0c\n\x04mean\x18\x03 \x01(\x08\x12\x0c\n\x04\x62\x65st\x18\x04 \x01(\x08\x12\x0c\n\x04last\x18\x05 \x01(\x08\x12\x0c\n\x04none\x18\x06 \x01(\x08\x12\x0c\n\x04\x63opy\x18\x07 \x01(\x08\"\x93\x01\n\x0c\x43onfigRecord\x12*\n\x06update\x18\x01 \x03(\x0b\x32\x1a.wandb_internal.ConfigItem\x12*\n\x06remove\x18\x02 \x03(\x0b\x32\x1a.wandb_internal.ConfigItem\x12+\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1b.wandb_internal._RecordInfo\"A\n\nConfigItem\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\x12\n\nnested_key\x18\x02 \x03(\t\x12\x12\n\nvalue_json\x18\x10 \x01(\t\"\x0e\n\x0c\x43onfigResult\"\x96\x01\n\rSummaryRecord\x12+\n\x06update\x18\x01 \x03(\x0b\x32\x1b.wandb_internal.SummaryItem\x12+\n\x06remove\x18\x02 \x03(\x0b\x32\x1b.wandb_internal.SummaryItem\x12+\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1b.wandb_internal._RecordInfo\"B\n\x0bSummaryItem\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\x12\n\nnested_key\x18\x02 \x03(\t\x12\x12\n\nvalue_json\x18\x10 \x01(\t\"\x0f\n\rSummaryResult\"d\n\x0b\x46ilesRecord\x12(\n\x05\x66iles\x18\x01 \x03(\x0b\x32\x19.wandb_internal.FilesItem\x12+\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1b.wandb_internal._RecordInfo\"\xfd\x01\n\tFilesItem\x12\x0c\n\x04path\x18\x01 \x01(\t\x12\x34\n\x06policy\x18\x02 \x01(\x0e\x32$.wandb_internal.FilesItem.PolicyType\x12\x30\n\x04type\x18\x03 \x01(\x0e\x32\".wandb_internal.FilesItem.FileType\x12\x15\n\rexternal_path\x18\x10 \x01(\t\"(\n\nPolicyType\x12\x07\n\x03NOW\x10\x00\x12\x07\n\x03\x45ND\x10\x01\x12\x08\n\x04LIVE\x10\x02\"9\n\x08\x46ileType\x12\t\n\x05OTHER\x10\x00\x12\t\n\x05WANDB\x10\x01\x12\t\n\x05MEDIA\x10\x02\x12\x0c\n\x08\x41RTIFACT\x10\x03\"\r\n\x0b\x46ilesResult\"\xe6\x01\n\x0bStatsRecord\x12\x39\n\nstats_type\x18\x01 \x01(\x0e\x32%.wandb_internal.StatsRecord.StatsType\x12-\n\ttimestamp\x18\x02 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12\'\n\x04item\x18\x03 \x03(\x0b\x32\x19.wandb_internal.StatsItem\x12+\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1b.wandb_internal._RecordInfo\"\x17\n\tStatsType\x12\n\n\x06SYSTEM\x10\x00\",\n\tStatsItem\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\x12\n\nvalue_json\x18\x10 \x01(\t\"\xd9\x03\n\x0e\x41rtifactRecord\x12\x0e\n\x06run_id\x18\x01 \x01(\t\x12\x0f\n\x07project\x18\x02 \x01(\t\x12\x0e\n\x06\x65ntity\x18\x03 \x01(\t\x12\x0c\n\x04type\x18\x04 \x01(\t\x12\x0c\n\x04name\x18\x05 \x01(\t\x12\x0e\n\x06\x64igest\x18\x06 \x01(\t\x12\x13\n\x0b\x64\x65scription\x18\x07 \x01(\t\x12\x10\n\x08metadata\x18\x08 \x01(\t\x12\x14\n\x0cuser_created\x18\t \x01(\x08\x12\x18\n\x10use_after_commit\x18\n \x01(\x08\x12\x0f\n\x07\x61liases\x18\x0b \x03(\t\x12\x32\n\x08manifest\x18\x0c \x01(\x0b\x32 .wandb_internal.ArtifactManifest\x12\x16\n\x0e\x64istributed_id\x18\r \x01(\t\x12\x10\n\x08\x66inalize\x18\x0e \x01(\x08\x12\x11\n\tclient_id\x18\x0f \x01(\t\x12\x1a\n\x12sequence_client_id\x18\x10 \x01(\t\x12\x0f\n\x07\x62\x61se_id\x18\x11 \x01(\t\x12\x1c\n\x14ttl_duration_seconds\x18\x12 \x01(\x03\x12\x19\n\x11incremental_beta1\x18\x64 \x01(\x08\x12+\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1b.wandb_internal._RecordInfo\"\xbc\x01\n\x10\x41rtifactManifest\x12\x0f\n\x07version\x18\x01 \x01(\x05\x12\x16\n\x0estorage_policy\x18\x02 \x01(\t\x12\x46\n\x15storage_policy_config\x18\x03 \x03(\x0b\x32\'.wandb_internal.StoragePolicyConfigItem\x12\x37\n\x08\x63ontents\x18\x04 \x03(\x0b\x32%.wandb_internal.ArtifactManifestEntry\"\xbb\x01\n\x15\x41rtifactManifestEntry\x12\x0c\n\x04path\x18\x01 \x01(\t\x12\x0e\n\x06\x64igest\x18\x02 \x01(\t\x12\x0b\n\x03ref\x18\x03 \x01(\t\x12\x0c\n\x04size\x18\x04 \x01(\x03\x12\x10\n\x08mimetype\x18\x05 \x01(\t\x12\x12\n\nlocal_path\x18\x06 \x01(\t\x12\x19\n\x11\x62irth_artifact_id\x18\x07 \x01(\t\x12(\n\x05\x65xtra\x18\x10 \x03(\x0b\x32\x19.wandb_internal.ExtraItem\",\n\tExtraItem\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\x12\n\nvalue_json\x18\x02 \x01(\t\":\n\x17StoragePolicyConfigItem\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\x12\n\nvalue_json\x18\x02 \x01(\t\"\x10\n\x0e\x41rtifactResult\"\x14\n\x12LinkArtifactResult\"\xcf\x01\n\x12LinkArtifactRecord\x12\x11\n\tclient_id\x18\x01 \x01(\t\x12\x11\n\tserver_id\x18\x02 \x01(\t\x12\x16\n\x0eportfolio_name\x18\x03 \x01(\t\x12\x18\n\x10portfolio_entity\x18\x04 \x01(\t\x12\x19\n\x11portfolio_project\x18\x05 \x01(\t\x12\x19\n\x11portfolio_aliases\x18\x06 \x03(\t\x12+\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1b.wandb_internal._RecordInfo\"h\n\x08TBRecord\x12\x0f\n\x07log_dir\x18\x01 \x01(\t\x12\x0c\n\x04save\x18\x02 \x01(\x08\x12\x10\n\x08root_dir\x18\x03 \x01(\t\x12+\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1b.wandb_internal._RecordInfo\"\n\n\x08TBResult\"}\n\x0b\x41lertRecord\x12\r\n\x05title\x18\x01 \x01(\t\x12\x0c\n\x04text\x18\x02 \x01(\t\x12\r\n\x05level\x18\x03 \x01(\t\x12\x15\n\rwait_duration\x18\x04 \x01(\x03\x12+\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1b.wandb_internal._RecordInfo\"\r\n\x0b\x41lertResult\"\xc9\x0f\n\x07Request\x12\x38\n\x0bstop_status\x18\x01 \x01(\x0b\x32!.wandb_internal.StopStatusRequestH\x00\x12>\n\x0enetwork_status\x18\x02 \x01(\x0b\x32$.wandb_internal.NetworkStatusRequestH\x00\x12-\n\x05\x64\x65\x66\x65r\x18\x03 \x01(\x0b\x32\x1c.wandb_internal.DeferRequestH\x00\x12\x38\n\x0bget_summary\x18\x04 \x01(\x0b\x32!.wandb_internal.GetSummaryRequestH\x00\x12-\n\x05login\x18\x05 \x01(\x0b\x32\x1c.wandb_internal.LoginRequestH\x00\x12-\n\x05pause\x18\x06 \x01(\x0b\x32\x1c.wandb_internal.PauseRequestH\x00\x12/\n\x06resume\x18\x07 \x01(\x0b\x32\x1d.wandb_internal.ResumeRequestH\x00\x12\x34\n\tpoll_exit\x18\x08 \x01(\x0b\x32\x1f.wandb_internal.PollExitRequestH\x00\x12@\n\x0fsampled_history\x18\t \x01(\x0b\x32%.wandb_internal.SampledHistoryRequestH\x00\x12@\n\x0fpartial_history\x18\n \x01(\x0b\x32%.wandb_internal.PartialHistoryRequestH\x00\x12\x34\n\trun_start\x18\x0b \x01(\x0b\x32\x1f.wandb_internal.RunStartRequestH\x00\x12<\n\rcheck_version\x18\x0c \x01(\x0b\x32#.wandb_internal.CheckVersionRequestH\x00\x12:\n\x0clog_artifact\x18\r \x01(\x0b\x32\".wandb_internal.LogArtifactRequestH\x00\x12\x44\n\x11\x64ownload_artifact\x18\x0e \x01(\x0b\x32\'.wandb_internal.DownloadArtifactRequestH\x00\x12\x35\n\tkeepalive\x18\x11 \x01(\x0b\x32 .wandb_internal.KeepaliveRequestH\x00\x12\x36\n\nrun_status\x18\x14 \x01(\x0b\x32 .wandb_internal.RunStatusRequestH\x00\x12/\n\x06\x63\x61ncel\x18\x15 \x01(\x0b\x32\x1d.wandb_internal.CancelRequestH\x00\x12\x33\n\x08metadata\x18\x16 \x01(\x0b\x32\x1f.wandb_internal.MetadataRequestH\x00\x12\x44\n\x11internal_messages\x18\x17 \x01(\x0b\x32\'.wandb_internal.InternalMessagesRequestH\x00\x12@\n\x0fpython_packages\x18\x18 \x01(\x0b\x32%.wandb_internal.PythonPackagesRequestH\x00\x12\x33\n\x08shutdown\x18@ \x01(\x0b\x32\x1f.wandb_internal.ShutdownRequestH\x00\x12/\n\x06\x61ttach\x18\x41 \x01(\x0b\x32\x1d.wandb_internal.AttachRequestH\x00\x12/\n\x06status\x18\x42 \x01(\x0b\x32\x1d.wandb_internal.StatusRequestH\x00\x12\x38\n\x0bserver_info\x18\x43 \x01(\x0b\x32!.wandb_internal.ServerInfoRequestH\x00\x12\x38\n\x0bsender_mark\x18\x44 \x01(\x0b\x32!.wandb_internal.SenderMarkRequestH\x00\x12\x38\n\x0bsender_read\x18\x45 \x01(\x0b\x32!.wandb_internal.SenderReadRequestH\x00\x12<\n\rstatus_report\x18\x46 \x01(\x0b\x32#.wandb_internal.StatusReportRequestH\x00\x12>\n\x0esummary_record\x18G \x01(\x0b\x32$.wandb_internal.SummaryRecordRequestH\x00\x12\x42\n\x10telemetry_record\x18H \x01(\x0b\x32&.wandb_internal.TelemetryRecordRequestH\x00\x12\x32\n\x08job_info\x18I \x01(\x0b\x32\x1e.wandb_internal.JobInfoRequestH\x00\x12\x45\n\x12get_system_metrics\x18J \x01(\x0b\x32\'.wandb_internal.GetSystemMetricsRequestH\x00\x12\x45\n\x12\x66ile_transfer_info\x18K \x01(\x0b\x32\'.wandb_internal.FileTransferInfoRequestH\x00\x12+\n\x04sync\x18L \x01(\x0b\x32\x1b.wandb_internal.SyncRequestH\x00\x12\x39\n\x0btest_inject\x18\xe8\x07 \x01(\x0b\x32!.wandb_internal.TestInjectRequestH\x00\x42\x0e\n\x0crequest_type\"\xe2\x0b\n\x08Response\x12?\n\x12keepalive_response\x18\x12 \x01(\x0b\x32!.wandb_internal.KeepaliveResponseH\x00\x12\x42\n\x14stop_status_response\x18\x13 \x01(\x0b\x32\".wandb_internal.StopStatusResponseH\x00\x12H\n\x17network_status_response\x18\x14 \x01(\x0b\x32%.wandb_internal.NetworkStatusResponseH\x00\x12\x37\n\x0elogin_response\x18\x18 \x01(\x0b\x32\x1d.wandb_internal.LoginResponseH\x00\x12\x42\n\x14get_summary_response\x18\x19 \x01(\x0b\x32\".wandb_internal.GetSummaryResponseH\x00\x12>\n\x12poll_exit_response\x18\x1a \x01(\x0b\x32 .wandb_internal.PollExitResponseH\x00\x12J\n\x18sampled_history_response\x18\x1b \x01(\x0b\x32&.wandb_internal.SampledHistoryResponseH\x00\x12>\n\x12run_start_response\x18\x1c \x01(\x0b\x32 .wandb_internal.RunStartResponseH\x00\x12\x46\n\x16\x63heck_version_response\x18\x1d \x01(\x0b\x32$.wandb_internal.CheckVersionResponseH\x00\x12\x44\n\x15log_artifact_response\x18\x1e \x01(\x0b\x32#.wandb_internal.LogArtifactResponseH\x00\x12N\n\x1a\x64ownload_artifact_response\x18\x1f \x01(\x0b\x32(.wandb_internal.DownloadArtifactResponseH\x00\x12@\n\x13run_status_response\x18# \x01(\x0b\x32!.wandb_internal.RunStatusResponseH\x00\x12\x39\n\x0f\x63\x61ncel_response\x18$ \x01(\x0b\x32\x1e.wandb_internal.CancelResponseH\x00\x12N\n\x1ainternal_messages_response\x18% \x01(\x0b\x32(.wandb_internal.InternalMessagesResponseH\x00\x12=\n\x11shutdown_response\x18@ \x01(\x0b\x32 .wandb_internal.ShutdownResponseH\x00\x12\x39\n\x0f\x61ttach_response\x18\x41 \x01(\x0b\x32\x1e.wandb_internal.AttachResponseH\x00\x12\x39\n\x0fstatus_response\x18\x42 \x01(\x0b\x32\x1e.wandb_internal.StatusResponseH\x00\x12\x42\n\x14server_info_response\x18\x43 \x01(\x0b\x32\".wandb_internal.ServerInfoResponseH\x00\x12<\n\x11job_info_response\x18\x44 \x01(\x0b\x32\x1f.wandb_internal.JobInfoResponseH\x00\x12O\n\x1bget_system_metrics_response\x18\x45 \x01(\x0b\x32(.wandb_internal.GetSystemMetricsResponseH\x00\x12\x35\n\rsync_response\x18\x46 \x01(\x0b\x32\x1c.wandb_internal.SyncResponseH\x00\x12\x43\n\x14test_inject_response\x18\xe8\x07 \x01(\x0b\x32\".wandb_internal.TestInjectResponseH\x00\x42\x0f\n\rresponse_type\"\xc0\x02\n\x0c\x44\x65\x66\x65rRequest\x12\x36\n\x05state\x18\x01 \x01(\x0e\x32\'.wandb_internal.DeferRequest.DeferState\"\xf7\x01\n\nDeferState\x12\t\n\x05\x42\x45GIN\x10\x00\x12\r\n\tFLUSH_RUN\x10\x01\x12\x0f\n\x0b\x46LUSH_STATS\x10\x02\x12\x19\n\x15\x46LUSH_PARTIAL_HISTORY\x10\x03\x12\x0c\n\x08\x46LUSH_TB\x10\x04\x12\r\n\tFLUSH_SUM\x10\x05\x12\x13\n\x0f\x46LUSH_DEBOUNCER\x10\x06\x12\x10\n\x0c\x46LUSH_OUTPUT\x10\x07\x12\r\n\tFLUSH_JOB\x10\x08\x12\r\n\tFLUSH_DIR\x10\t\x12\x0c\n\x08\x46LUSH_FP\x10\n\x12\x0b\n\x07JOIN_FP\x10\x0b\x12\x0c\n\x08\x46LUSH_FS\x10\x0c\x12\x0f\n\x0b\x46LUSH_FINAL\x10\r\x12\x07\n\x03\x45ND\x10\x0e\"<\n\x0cPauseRequest\x12,\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1c.wandb_internal._RequestInfo\"\x0f\n\rPauseResponse\"=\n\rResumeRequest\x12,\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1c.wandb_internal._RequestInfo\"\x10\n\x0eResumeResponse\"M\n\x0cLoginRequest\x12\x0f\n\x07\x61pi_key\x18\x01 \x01(\t\x12,\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1c.wandb_internal._RequestInfo\"&\n\rLoginResponse\x12\x15\n\ractive_entity\x18\x01 \x01(\t\"A\n\x11GetSummaryRequest\x12,\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1c.wandb_internal._RequestInfo\"?\n\x12GetSummaryResponse\x12)\n\x04item\x18\x01 \x03(\x0b\x32\x1b.wandb_internal.SummaryItem\"G\n\x17GetSystemMetricsRequest\x12,\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1c.wandb_internal._RequestInfo\"R\n\x12SystemMetricSample\x12-\n\ttimestamp\x18\x01 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12\r\n\x05value\x18\x02 \x01(\x02\"I\n\x13SystemMetricsBuffer\x12\x32\n\x06record\x18\x01 \x03(\x0b\x32\".wandb_internal.SystemMetricSample\"\xca\x01\n\x18GetSystemMetricsResponse\x12S\n\x0esystem_metrics\x18\x01 \x03(\x0b\x32;.wandb_internal.GetSystemMetricsResponse.SystemMetricsEntry\x1aY\n\x12SystemMetricsEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\x32\n\x05value\x18\x02 \x01(\x0b\x32#.wandb_internal.SystemMetricsBuffer:\x02\x38\x01\"=\n\rStatusRequest\x12,\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1c.wandb_internal._RequestInfo\")\n\x0eStatusResponse\x12\x17\n\x0frun_should_stop\x18\x01 \x01(\x08\"A\n\x11StopStatusRequest\x12,\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1c.wandb_internal._RequestInfo\"-\n\x12StopStatusResponse\x12\x17\n\x0frun_should_stop\x18\x01 \x01(\x08\"D\n\x14NetworkStatusRequest\x12,\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1c.wandb_internal._RequestInfo\"P\n\x15NetworkStatusResponse\x12\x37\n\x11network_responses\x18\x01 \x03(\x0b\x32\x1c.wandb_internal.HttpResponse\"D\n\x0cHttpResponse\x12\x18\n\x10http_status_code\x18\x01 \x01(\x05\x12\x1a\n\x12http_response_text\x18\x02 \x01(\t\"G\n\x17InternalMessagesRequest\x12,\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1c.wandb_internal._RequestInfo\"N\n\x18InternalMessagesResponse\x12\x32\n\x08messages\x18\x01 \x01(\x0b\x32 .wandb_internal.InternalMessages\"#\n\x10InternalMessages\x12\x0f\n\x07warning\x18\x01 \x03(\t\"?\n\x0fPollExitRequest\x12,\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1c.wandb_internal._RequestInfo\"\xbc\x01\n\x10PollExitResponse\x12\x0c\n\x04\x64one\x18\x01 \x01(\x08\x12\x32\n\x0b\x65xit_result\x18\x02 \x01(\x0b\x32\x1d.wandb_internal.RunExitResult\x12\x35\n\x0cpusher_stats\x18\x03 \x01(\x0b\x32\x1f.wandb_internal.FilePusherStats\x12/\n\x0b\x66ile_counts\x18\x04 \x01(\x0b\x32\x1a.wandb_internal.FileCounts\"@\n\rSyncOverwrite\x12\x0e\n\x06run_id\x18\x01 \x01(\t\x12\x0e\n\x06\x65ntity\x18\x02 \x01(\t\x12\x0f\n\x07project\x18\x03 \x01(\t\"\x1e\n\x08SyncSkip\x12\x12\n\noutput_raw\x18\x01 \x01(\x08\"\x13\n\x11SenderMarkRequest\"\x93\x01\n\x0bSyncRequest\x12\x14\n\x0cstart_offset\x18\x01 \x01(\x03\x12\x14\n\x0c\x66inal_offset\x18\x02 \x01(\x03\x12\x30\n\toverwrite\x18\x03 \x01(\x0b\x32\x1d.wandb_internal.SyncOverwrite\x12&\n\x04skip\x18\x04 \x01(\x0b\x32\x18.wandb_internal.SyncSkip\"E\n\x0cSyncResponse\x12\x0b\n\x03url\x18\x01 \x01(\t\x12(\n\x05\x65rror\x18\x02 \x01(\x0b\x32\x19.wandb_internal.ErrorInfo\"?\n\x11SenderReadRequest\x12\x14\n\x0cstart_offset\x18\x01 \x01(\x03\x12\x14\n\x0c\x66inal_offset\x18\x02 \x01(\x03\"m\n\x13StatusReportRequest\x12\x12\n\nrecord_num\x18\x01 \x01(\x03\x12\x13\n\x0bsent_offset\x18\x02 \x01(\x03\x12-\n\tsync_time\x18\x03 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\"F\n\x14SummaryRecordRequest\x12.\n\x07summary\x18\x01 \x01(\x0b\x32\x1d.wandb_internal.SummaryRecord\"L\n\x16TelemetryRecordRequest\x12\x32\n\ttelemetry\x18\x01 \x01(\x0b\x32\x1f.wandb_internal.TelemetryRecord\"A\n\x11ServerInfoRequest\x12,\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1c.wandb_internal._RequestInfo\"|\n\x12ServerInfoResponse\x12-\n\nlocal_info\x18\x01 \x01(\x0b\x32\x19.wandb_internal.LocalInfo\x12\x37\n\x0fserver_messages\x18\x02 \x01(\x0b\x32\x1e.wandb_internal.ServerMessages\"=\n\x0eServerMessages\x12+\n\x04item\x18\x01 \x03(\x0b\x32\x1d.wandb_internal.ServerMessage\"e\n\rServerMessage\x12\x12\n\nplain_text\x18\x01 \x01(\t\x12\x10\n\x08utf_text\x18\x02 \x01(\t\x12\x11\n\thtml_text\x18\x03 \x01(\t\x12\x0c\n\x04type\x18\x04 \x01(\t\x12\r\n\x05level\x18\x05 \x01(\x05\"c\n\nFileCounts\x12\x13\n\x0bwandb_count\x18\x01 \x01(\x05\x12\x13\n\x0bmedia_count\x18\x02 \x01(\x05\x12\x16\n\x0e\x61rtifact_count\x18\x03 \x01(\x05\x12\x13\n\x0bother_count\x18\x04 \x01(\x05\"U\n\x0f\x46ilePusherStats\x12\x16\n\x0euploaded_bytes\x18\x01 \x01(\x03\x12\x13\n\x0btotal_bytes\x18\x02 \x01(\x03\x12\x15\n\rdeduped_bytes\x18\x03 \x01(\x03\"\x1e\n\rFilesUploaded\x12\r\n\x05\x66iles\x18\x01 \x03(\t\"\xf4\x01\n\x17\x46ileTransferInfoRequest\x12\x42\n\x04type\x18\x01 \x01(\x0e\x32\x34.wandb_internal.FileTransferInfoRequest.TransferType\x12\x0c\n\x04path\x18\x02 \x01(\t\x12\x0b\n\x03url\x18\x03 \x01(\t\x12\x0c\n\x04size\x18\x04 \x01(\x03\x12\x11\n\tprocessed\x18\x05 \x01(\x03\x12/\n\x0b\x66ile_counts\x18\x06 \x01(\x0b\x32\x1a.wandb_internal.FileCounts\"(\n\x0cTransferType\x12\n\n\x06Upload\x10\x00\x12\x0c\n\x08\x44ownload\x10\x01\"1\n\tLocalInfo\x12\x0f\n\x07version\x18\x01 \x01(\t\x12\x13\n\x0bout_of_date\x18\x02 \x01(\x08\"?\n\x0fShutdownRequest\x12,\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1c.wandb_internal._RequestInfo\"\x12\n\x10ShutdownResponse\"P\n\rAttachRequest\x12\x11\n\tattach_id\x18\x14 \x01(\t\x12,\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1c.wandb_internal._RequestInfo\"b\n\x0e\x41ttachResponse\x12&\n\x03run\x18\x01 \x01(\x0b\x32\x19.wandb_internal.RunRecord\x12(\n\x05\x65rror\x18\x02 \x01(\x0b\x32\x19.wandb_internal.ErrorInfo\"\xd5\x02\n\x11TestInjectRequest\x12\x13\n\x0bhandler_exc\x18\x01 \x01(\x08\x12\x14\n\x0chandler_exit\x18\x02 \x01(\x08\x12\x15\n\rhandler_abort\x18\x03 \x01(\x08\x12\x12\n\nsender_exc\x18\x04 \x01(\x08\x12\x13\n\x0bsender_exit\x18\x05 \x01(\x08\x12\x14\n\x0csender_abort\x18\x06 \x01(\x08\x12\x0f\n\x07req_exc\x18\x07 \x01(\x08\x12\x10\n\x08req_exit\x18\x08 \x01(\x08\x12\x11\n\treq_abort\x18\t \x01(\x08\x12\x10\n\x08resp_exc\x18\n \x01(\x08\x12\x11\n\tresp_exit\x18\x0b \x01(\x08\x12\x12\n\nresp_abort\x18\x0c \x01(\x08\x12\x10\n\x08msg_drop\x18\r \x01(\x08\x12\x10\n\x08msg_hang\x18\x0e \x01(\x08\x12,\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1c.wandb_internal._RequestInfo\"\x14\n\x12TestInjectResponse\"\x1e\n\rHistoryAction\x12\r\n\x05\x66lush\x18\x01 \x01(\x08\"\xca\x01\n\x15PartialHistoryRequest\x12)\n\x04item\x18\x01 \x03(\x0b\x32\x1b.wandb_internal.HistoryItem\x12)\n\x04step\x18\x02 \x01(\x0b\x32\x1b.wandb_internal.HistoryStep\x12-\n\x06\x61\x63tion\x18\x03 \x01(\x0b\x32\x1d.wandb_internal.HistoryAction\x12,\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1c.wandb_internal._RequestInfo\"\x18\n\x16PartialHistoryResponse\"E\n\x15SampledHistoryRequest\x12,\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1c.wandb_internal._RequestInfo\"_\n\x12SampledHistoryItem\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\x12\n\nnested_key\x18\x02 \x03(\t\x12\x14\n\x0cvalues_float\x18\x03 \x03(\x02\x12\x12\n\nvalues_int\x18\x04 \x03(\x03\"J\n\x16SampledHistoryResponse\x12\x30\n\x04item\x18\x01 \x03(\x0b\x32\".wandb_internal.SampledHistoryItem\"@\n\x10RunStatusRequest\x12,\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1c.wandb_internal._RequestInfo\"x\n\x11RunStatusResponse\x12\x18\n\x10sync_items_total\x18\x01 \x01(\x03\x12\x1a\n\x12sync_items_pending\x18\x02 \x01(\x03\x12-\n\tsync_time\x18\x03 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\"g\n\x0fRunStartRequest\x12&\n\x03run\x18\x01 \x01(\x0b\x32\x19.wandb_internal.RunRecord\x12,\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1c.wandb_internal._RequestInfo\"\x12\n\x10RunStartResponse\"\\\n\x13\x43heckVersionRequest\x12\x17\n\x0f\x63urrent_version\x18\x01 \x01(\t\x12,\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1c.wandb_internal._RequestInfo\"]\n\x14\x43heckVersionResponse\x12\x17\n\x0fupgrade_message\x18\x01 \x01(\t\x12\x14\n\x0cyank_message\x18\x02 \x01(\t\x12\x16\n\x0e\x64\x65lete_message\x18\x03 \x01(\t\">\n\x0eJobInfoRequest\x12,\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1c.wandb_internal._RequestInfo\"6\n\x0fJobInfoResponse\x12\x12\n\nsequenceId\x18\x01 \x01(\t\x12\x0f\n\x07version\x18\x02 \x01(\t\"\x9f\x01\n\x12LogArtifactRequest\x12\x30\n\x08\x61rtifact\x18\x01 \x01(\x0b\x32\x1e.wandb_internal.ArtifactRecord\x12\x14\n\x0chistory_step\x18\x02 \x01(\x03\x12\x13\n\x0bstaging_dir\x18\x03 \x01(\t\x12,\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1c.wandb_internal._RequestInfo\"A\n\x13LogArtifactResponse\x12\x13\n\x0b\x61rtifact_id\x18\x01 \x01(\t\x12\x15\n\rerror_message\x18\x02 \x01(\t\"\x95\x01\n\x17\x44ownloadArtifactRequest\x12\x13\n\x0b\x61rtifact_id\x18\x01 \x01(\t\x12\x15\n\rdownload_root\x18\x02 \x01(\t\x12 \n\x18\x61llow_missing_references\x18\x04 \x01(\x08\x12,\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1c.wandb_internal._RequestInfo\"1\n\x18\x44ownloadArtifactResponse\x12\x15\n\rerror_message\x18\x01 \x01(\t\"@\n\x10KeepaliveRequest\x12,\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1c.wandb_internal._RequestInfo\"\x13\n\x11KeepaliveResponse\"F\n\x0c\x41rtifactInfo\x12\x10\n\x08\x61rtifact\x18\x01 \x01(\t\x12\x12\n\nentrypoint\x18\x02 \x03(\t\x12\x10\n\x08notebook\x18\x03 \x01(\x08\")\n\x07GitInfo\x12\x0e\n\x06remote\x18\x01 \x01(\t\x12\x0e\n\x06\x63ommit\x18\x02 \x01(\t\"\\\n\tGitSource\x12)\n\x08git_info\x18\x01 \x01(\x0b\x32\x17.wandb_internal.GitInfo\x12\x12\n\nentrypoint\x18\x02 \x03(\t\x12\x10\n\x08notebook\x18\x03 \x01(\x08\"\x1c\n\x0bImageSource\x12\r\n\x05image\x18\x01 \x01(\t\"\x8c\x01\n\x06Source\x12&\n\x03git\x18\x01 \x01(\x0b\x32\x19.wandb_internal.GitSource\x12.\n\x08\x61rtifact\x18\x02 \x01(\x0b\x32\x1c.wandb_internal.ArtifactInfo\x12*\n\x05image\x18\x03 \x01(\x0b\x32\x1b.wandb_internal.ImageSource\"k\n\tJobSource\x12\x10\n\x08_version\x18\x01 \x01(\t\x12\x13\n\x0bsource_type\x18\x02 \x01(\t\x12&\n\x06source\x18\x03 \x01(\x0b\x32\x16.wandb_internal.Source\x12\x0f\n\x07runtime\x18\x04 \x01(\t\"V\n\x12PartialJobArtifact\x12\x10\n\x08job_name\x18\x01 \x01(\t\x12.\n\x0bsource_info\x18\x02 \x01(\x0b\x32\x19.wandb_internal.JobSource\"\x9d\x01\n\x11UseArtifactRecord\x12\n\n\x02id\x18\x01 \x01(\t\x12\x0c\n\x04type\x18\x02 \x01(\t\x12\x0c\n\x04name\x18\x03 \x01(\t\x12\x33\n\x07partial\x18\x04 \x01(\x0b\x32\".wandb_internal.PartialJobArtifact\x12+\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1b.wandb_internal._RecordInfo\"\x13\n\x11UseArtifactResult\"R\n\rCancelRequest\x12\x13\n\x0b\x63\x61ncel_slot\x18\x01 \x01(\t\x12,\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1c.wandb_internal._RequestInfo\"\x10\n\x0e\x43\x61ncelResponse\"\'\n\x08\x44iskInfo\x12\r\n\x05total\x18\x01 \x01(\x04\x12\x0c\n\x04used\x18\x02 \x01(\x04\"\x1b\n\nMemoryInfo\x12\r\n\x05total\x18\x01 \x01(\x04\"/\n\x07\x43puInfo\x12\r\n\x05\x63ount\x18\x01 \x01(\r\x12\x15\n\rcount_logical\x18\x02 \x01(\r\">\n\x0cGpuAppleInfo\x12\x0f\n\x07gpuType\x18\x01 \x01(\t\x12\x0e\n\x06vendor\x18\x02 \x01(\t\x12\r\n\x05\x63ores\x18\x03 \x01(\r\"3\n\rGpuNvidiaInfo\x12\x0c\n\x04name\x18\x01 \x01(\t\x12\x14\n\x0cmemory_total\x18\x02 \x01(\x04\"\x89\x02\n\nGpuAmdInfo\x12\n\n\x02id\x18\x01 \x01(\t\x12\x11\n\tunique_id\x18\x02 \x01(\t\x12\x15\n\rvbios_version\x18\x03 \x01(\t\x12\x19\n\x11performance_level\x18\x04 \x01(\t\x12\x15\n\rgpu_overdrive\x18\x05 \x01(\t\x12\x1c\n\x14gpu_memory_overdrive\x18\x06 \x01(\t\x12\x11\n\tmax_power\x18\x07 \x01(\t\x12\x0e\n\x06series\x18\x08 \x01(\t\x12\r\n\x05model\x18\t \x01(\t\x12\x0e\n\x06vendor\x18\n \x01(\t\x12\x0b\n\x03sku\x18\x0b \x01(\t\x12\x12\n\nsclk_range\x18\x0c \x01(\t\x12\x12\n\nmclk_range\x18\r \x01(\t\"\x96\x08\n\x0fMetadataRequest\x12\n\n\x02os\x18\x01 \x01(\t\x12\x0e\n\x06python\x18\x02 \x01(\t\x12/\n\x0bheartbeatAt\x18\x03 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12-\n\tstartedAt\x18\x04 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12\x0e\n\x06\x64ocker\x18\x05 \x01(\t\x12\x0c\n\x04\x63uda\x18\x06 \x01(\t\x12\x0c\n\x04\x61rgs\x18\x07 \x03(\t\x12\r\n\x05state\x18\x08 \x01(\t\x12\x0f\n\x07program\x18\t \x01(\t\x12\x1b\n\tcode_path\x18\n \x01(\tR\x08\x63odePath\x12*\n\x03git\x18\x0b \x01(\x0b\x32\x1d.wandb_internal.GitRepoRecord\x12\r\n\x05\x65mail\x18\x0c \x01(\t\x12\x0c\n\x04root\x18\r \x01(\t\x12\x0c\n\x04host\x18\x0e \x01(\t\x12\x10\n\x08username\x18\x0f \x01(\t\x12\x12\n\nexecutable\x18\x10 \x01(\t\x12&\n\x0f\x63ode_path_local\x18\x11 \x01(\tR\rcodePathLocal\x12\r\n\x05\x63olab\x18\x12 \x01(\t\x12\x1c\n\tcpu_count\x18\x13 \x01(\rR\tcpu_count\x12,\n\x11\x63pu_count_logical\x18\x14 \x01(\rR\x11\x63pu_count_logical\x12\x15\n\x08gpu_type\x18\x15 \x01(\tR\x03gpu\x12\x1c\n\tgpu_count\x18\x16 \x01(\rR\tgpu_count\x12\x37\n\x04\x64isk\x18\x17 \x03(\x0b\x32).wandb_internal.MetadataRequest.DiskEntry\x12*\n\x06memory\x18\x18 \x01(\x0b\x32\x1a.wandb_internal.MemoryInfo\x12$\n\x03\x63pu\x18\x19 \x01(\x0b\x32\x17.wandb_internal.CpuInfo\x12\x39\n\tgpu_apple\x18\x1a \x01(\x0b\x32\x1c.wandb_internal.GpuAppleInfoR\x08gpuapple\x12=\n\ngpu_nvidia\x18\x1b \x03(\x0b\x32\x1d.wandb_internal.GpuNvidiaInfoR\ngpu_nvidia\x12\x34\n\x07gpu_amd\x18\x1c \x03(\x0b\x32\x1a.wandb_internal.GpuAmdInfoR\x07gpu_amd\x12\x39\n\x05slurm\x18\x1d \x03(\x0b\x32*.wandb_internal.MetadataRequest.SlurmEntry\x1a\x45\n\tDiskEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\'\n\x05value\x18\x02 \x01(\x0b\x32\x18.wandb_internal.DiskInfo:\x02\x38\x01\x1a,\n\nSlurmEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\t:\x02\x38\x01\"\x8d\x01\n\x15PythonPackagesRequest\x12\x44\n\x07package\x18\x01 \x03(\x0b\x32\x33.wandb_internal.PythonPackagesRequest.PythonPackage\x1a.\n\rPythonPackage\x12\x0c\n\x04name\x18\x01 \x01(\t\x12\x0f\n\x07version\x18\x02 \x01(\tb\x06proto3')
+DESCRIPTOR = _descriptor_pool.Default().AddSerializedFile(b'\n wandb/proto/wandb_internal.proto\x12\x0ewandb_internal\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1cwandb/proto/wandb_base.proto\x1a!wandb/proto/wandb_telemetry.proto\"\xb8\n\n\x06Record\x12\x0b\n\x03num\x18\x01 \x01(\x03\x12\x30\n\x07history\x18\x02 \x01(\x0b\x32\x1d.wandb_internal.HistoryRecordH\x00\x12\x30\n\x07summary\x18\x03 \x01(\x0b\x32\x1d.wandb_internal.SummaryRecordH\x00\x12.\n\x06output\x18\x04 \x01(\x0b\x32\x1c.wandb_internal.OutputRecordH\x00\x12.\n\x06\x63onfig\x18\x05 \x01(\x0b\x32\x1c.wandb_internal.ConfigRecordH\x00\x12,\n\x05\x66iles\x18\x06 \x01(\x0b\x32\x1b.wandb_internal.FilesRecordH\x00\x12,\n\x05stats\x18\x07 \x01(\x0b\x32\x1b.wandb_internal.StatsRecordH\x00\x12\x32\n\x08\x61rtifact\x18\x08 \x01(\x0b\x32\x1e.wandb_internal.ArtifactRecordH\x00\x12,\n\x08tbrecord\x18\t \x01(\x0b\x32\x18.wandb_internal.TBRecordH\x00\x12,\n\x05\x61lert\x18\n \x01(\x0b\x32\x1b.wandb_internal.AlertRecordH\x00\x12\x34\n\ttelemetry\x18\x0b \x01(\x0b\x32\x1f.wandb_internal.TelemetryRecordH\x00\x12.\n\x06metric\x18\x0c \x01(\x0b\x32\x1c.wandb_internal.MetricRecordH\x00\x12\x35\n\noutput_raw\x18\r \x01(\x0b\x32\x1f.wandb_internal.OutputRawRecordH\x00\x12(\n\x03run\x18\x11 \x01(\x0b\x32\x19.wandb_internal.RunRecordH\x00\x12-\n\x04\x65xit\x18\x12 \x01(\x0b\x32\x1d.wandb_internal.RunExitRecordH\x00\x12,\n\x05\x66inal\x18\x14 \x01(\x0b\x32\x1b.wandb_internal.FinalRecordH\x00\x12.\n\x06header\x18\x15 \x01(\x0b\x32\x1c.wandb_internal.HeaderRecordH\x00\x12.\n\x06\x66ooter\x18\x16 \x01(\x0b\x32\x1c.wandb_internal.FooterRecordH\x00\x12\x39\n\npreempting\x18\x17 \x01(\x0b\x32#.wandb_internal.RunPreemptingRecordH\x00\x12;\n\rlink_artifact\x18\x18 \x01(\x0b\x32\".wandb_internal.LinkArtifactRecordH\x00\x12\x39\n\x0cuse_artifact\x18\x19 \x01(\x0b\x32!.wandb_internal.UseArtifactRecordH\x00\x12*\n\x07request\x18\x64 \x01(\x0b\x32\x17.wandb_internal.RequestH\x00\x12\x32\n\x08snapshot\x18\x1a \x01(\x0b\x32\x1e.wandb_internal.SnapshotRecordH\x00\x12\x38\n\x0b\x65nvironment\x18\x1b \x01(\x0b\x32!.wandb_internal.EnvironmentRecordH\x00\x12,\n\x05table\x18\x1c \x01(\x0b\x32\x1b.wandb_internal.TableRecordH\x00\x12(\n\x07\x63ontrol\x18\x10 \x01(\x0b\x32\x17.wandb_internal.Control\x12\x0c\n\x04uuid\x18\x13 \x01(\t\x12+\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1b.wandb_internal._RecordInfoB\r\n\x0brecord_type\"\xe0\x01\n\x07\x43ontrol\x12\x10\n\x08req_resp\x18\x01 \x01(\x08\x12\r\n\x05local\x18\x02 \x01(\x08\x12\x10\n\x08relay_id\x18\x03 \x01(\t\x12\x14\n\x0cmailbox_slot\x18\x04 \x01(\t\x12\x13\n\x0b\x61lways_send\x18\x05 \x01(\x08\x12\x14\n\x0c\x66low_control\x18\x06 \x01(\x08\x12\x12\n\nend_offset\x18\x07 \x01(\x03\x12\x15\n\rconnection_id\x18\x08 \x01(\t\x12\x0c\n\x04rank\x18\t \x01(\t\x12\x16\n\x0e\x63\x61pture_micros\x18\n \x01(\x03\x12\x10\n\x08loopback\x18\x0b \x01(\x08\"\xf3\x03\n\x06Result\x12\x35\n\nrun_result\x18\x11 \x01(\x0b\x32\x1f.wandb_internal.RunUpdateResultH\x00\x12\x34\n\x0b\x65xit_result\x18\x12 \x01(\x0b\x32\x1d.wandb_internal.RunExitResultH\x00\x12\x33\n\nlog_result\x18\x14 \x01(\x0b\x32\x1d.wandb_internal.HistoryResultH\x00\x12\x37\n\x0esummary_result\x18\x15 \x01(\x0b\x32\x1d.wandb_internal.SummaryResultH\x00\x12\x35\n\routput_result\x18\x16 \x01(\x0b\x32\x1c.wandb_internal.OutputResultH\x00\x12\x35\n\rconfig_result\x18\x17 \x01(\x0b\x32\x1c.wandb_internal.ConfigResultH\x00\x12,\n\x08response\x18\x64 \x01(\x0b\x32\x18.wandb_internal.ResponseH\x00\x12(\n\x07\x63ontrol\x18\x10 \x01(\x0b\x32\x17.wandb_internal.Control\x12\x0c\n\x04uuid\x18\x18 \x01(\t\x12+\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1b.wandb_internal._ResultInfoB\r\n\x0bresult_type\":\n\x0b\x46inalRecord\x12+\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1b.wandb_internal._RecordInfo\"b\n\x0bVersionInfo\x12\x10\n\x08producer\x18\x01 \x01(\t\x12\x14\n\x0cmin_consumer\x18\x02 \x01(\t\x12+\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1b.wandb_internal._RecordInfo\"n\n\x0cHeaderRecord\x12\x31\n\x0cversion_info\x18\x01 \x01(\x0b\x32\x1b.wandb_internal.VersionInfo\x12+\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1b.wandb_internal._RecordInfo\"\xb7\x01\n\x0c\x46ooterRecord\x12+\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1b.wandb_internal._RecordInfo\x12\x44\n\x0f\x64ropped_records\x18\x01 \x03(\x0b\x32+.wandb_internal.FooterRecord.DroppedRecords\x1a\x34\n\x0e\x44roppedRecords\x12\x13\n\x0brecord_type\x18\x01 \x01(\t\x12\r\n\x05\x63ount\x18\x02 \x01(\x03\"\xce\x04\n\tRunRecord\x12\x0e\n\x06run_id\x18\x01 \x01(\t\x12\x0e\n\x06\x65ntity\x18\x02 \x01(\t\x12\x0f\n\x07project\x18\x03 \x01(\t\x12,\n\x06\x63onfig\x18\x04 \x01(\x0b\x32\x1c.wandb_internal.ConfigRecord\x12.\n\x07summary\x18\x05 \x01(\x0b\x32\x1d.wandb_internal.SummaryRecord\x12\x11\n\trun_group\x18\x06 \x01(\t\x12\x10\n\x08job_type\x18\x07 \x01(\t\x12\x14\n\x0c\x64isplay_name\x18\x08 \x01(\t\x12\r\n\x05notes\x18\t \x01(\t\x12\x0c\n\x04tags\x18\n \x03(\t\x12\x30\n\x08settings\x18\x0b \x01(\x0b\x32\x1e.wandb_internal.SettingsRecord\x12\x10\n\x08sweep_id\x18\x0c \x01(\t\x12\x0c\n\x04host\x18\r \x01(\t\x12\x15\n\rstarting_step\x18\x0e \x01(\x03\x12\x12\n\nstorage_id\x18\x10 \x01(\t\x12.\n\nstart_time\x18\x11 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12\x0f\n\x07resumed\x18\x12 \x01(\x08\x12\x32\n\ttelemetry\x18\x13 \x01(\x0b\x32\x1f.wandb_internal.TelemetryRecord\x12\x0f\n\x07runtime\x18\x14 \x01(\x05\x12*\n\x03git\x18\x15 \x01(\x0b\x32\x1d.wandb_internal.GitRepoRecord\x12+\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1b.wandb_internal._RecordInfo\";\n\rGitRepoRecord\x12\x1a\n\nremote_url\x18\x01 \x01(\tR\x06remote\x12\x0e\n\x06\x63ommit\x18\x02 \x01(\t\"c\n\x0fRunUpdateResult\x12&\n\x03run\x18\x01 \x01(\x0b\x32\x19.wandb_internal.RunRecord\x12(\n\x05\x65rror\x18\x02 \x01(\x0b\x32\x19.wandb_internal.ErrorInfo\"\xe4\x01\n\tErrorInfo\x12\x0f\n\x07message\x18\x01 \x01(\t\x12\x31\n\x04\x63ode\x18\x02 \x01(\x0e\x32#.wandb_internal.ErrorInfo.ErrorCode\"\x92\x01\n\tErrorCode\x12\x0b\n\x07UNKNOWN\x10\x00\x12\x11\n\rCOMMUNICATION\x10\x01\x12\x12\n\x0e\x41UTHENTICATION\x10\x02\x12\t\n\x05USAGE\x10\x03\x12\x0f\n\x0bUNSUPPORTED\x10\x04\x12\x12\n\x0eQUOTA_EXCEEDED\x10\x05\x12\x15\n\x11PROJECT_NOT_FOUND\x10\x06\x12\n\n\x06SERVER\x10\x07\"`\n\rRunExitRecord\x12\x11\n\texit_code\x18\x01 \x01(\x05\x12\x0f\n\x07runtime\x18\x02 \x01(\x05\x12+\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1b.wandb_internal._RecordInfo\"\x8d\x01\n\rRunExitResult\x12\x14\n\x0cpartial_sync\x18\x01 \x01(\x08\x12\x14\n\x0cunsent_files\x18\x02 \x03(\t\x12\x18\n\x10unsent_artifacts\x18\x03 \x03(\t\x12#\n\x1bunsent_file_stream_requests\x18\x04 \x01(\x05\x12\x11\n\tsync_file\x18\x05 \x01(\t\"B\n\x13RunPreemptingRecord\x12+\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1b.wandb_internal._RecordInfo\"\x15\n\x13RunPreemptingResult\"i\n\x0eSettingsRecord\x12*\n\x04item\x18\x01 \x03(\x0b\x32\x1c.wandb_internal.SettingsItem\x12+\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1b.wandb_internal._RecordInfo\"/\n\x0cSettingsItem\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\x12\n\nvalue_json\x18\x10 \x01(\t\"\x1a\n\x0bHistoryStep\x12\x0b\n\x03num\x18\x01 \x01(\x03\"\x92\x01\n\rHistoryRecord\x12)\n\x04item\x18\x01 \x03(\x0b\x32\x1b.wandb_internal.HistoryItem\x12)\n\x04step\x18\x02 \x01(\x0b\x32\x1b.wandb_internal.HistoryStep\x12+\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1b.wandb_internal._RecordInfo\"B\n\x0bHistoryItem\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\x12\n\nnested_key\x18\x02 \x03(\t\x12\x12\n\nvalue_json\x18\x10 \x01(\t\"\x0f\n\rHistoryResult\"\xdc\x01\n\x0cOutputRecord\x12<\n\x0boutput_type\x18\x01 \x01(\x0e\x32\'.wandb_internal.OutputRecord.OutputType\x12-\n\ttimestamp\x18\x02 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12\x0c\n\x04line\x18\x03 \x01(\t\x12+\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1b.wandb_internal._RecordInfo\"$\n\nOutputType\x12\n\n\x06STDERR\x10\x00\x12\n\n\x06STDOUT\x10\x01\"\x0e\n\x0cOutputResult\"\xe2\x01\n\x0fOutputRawRecord\x12?\n\x0boutput_type\x18\x01 \x01(\x0e\x32*.wandb_internal.OutputRawRecord.OutputType\x12-\n\ttimestamp\x18\x02 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12\x0c\n\x04line\x18\x03 \x01(\t\x12+\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1b.wandb_internal._RecordInfo\"$\n\nOutputType\x12\n\n\x06STDERR\x10\x00\x12\n\n\x06STDOUT\x10\x01\"\x11\n\x0fOutputRawResult\"\x98\x03\n\x0cMetricRecord\x12\x0c\n\x04name\x18\x01 \x01(\t\x12\x11\n\tglob_name\x18\x02 \x01(\t\x12\x13\n\x0bstep_metric\x18\x04 \x01(\t\x12\x19\n\x11step_metric_index\x18\x05 \x01(\x05\x12.\n\x07options\x18\x06 \x01(\x0b\x32\x1d.wandb_internal.MetricOptions\x12.\n\x07summary\x18\x07 \x01(\x0b\x32\x1d.wandb_internal.MetricSummary\x12\x35\n\x04goal\x18\x08 \x01(\x0e\x32\'.wandb_internal.MetricRecord.MetricGoal\x12/\n\x08_control\x18\t \x01(\x0b\x32\x1d.wandb_internal.MetricControl\x12+\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1b.wandb_internal._RecordInfo\"B\n\nMetricGoal\x12\x0e\n\nGOAL_UNSET\x10\x00\x12\x11\n\rGOAL_MINIMIZE\x10\x01\x12\x11\n\rGOAL_MAXIMIZE\x10\x02\"\x0e\n\x0cMetricResult\"C\n\rMetricOptions\x12\x11\n\tstep_sync\x18\x01 \x01(\x08\x12\x0e\n\x06hidden\x18\x02 \x01(\x08\x12\x0f\n\x07\x64\x65\x66ined\x18\x03 \x01(\x08\"\"\n\rMetricControl\x12\x11\n\toverwrite\x18\x01 \x01(\x08\"o\n\rMetricSummary\x12\x0b\n\x03min\x18\x01 \x01(\x08\x12\x0b\n\x03max\x18\x02 \x01(\x08\x12\x0c\n\x04mean\x18\x03 \x01(\x08\x12\x0c\n\x04\x62\x65st\x18\x04 \x01(\x08\x12\x0c\n\x04last\x18\x05 \x01(\x08\x12\x0c\n\x04none\x18\x06 \x01(\x08\x12\x0c\n\x04\x63opy\x18\x07 \x01(\x08\"\x93\x01\n\x0c\x43onfigRecord\x12*\n\x06update\x18\x01 \x03(\x0b\x32\x1a.wandb_internal.ConfigItem\x12*\n\x06remove\x18\x02 \x03(\x0b\x32\x1a.wandb_internal.ConfigItem\x12+\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1b.wandb_internal._RecordInfo\"A\n\nConfigItem\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\x12\n\nnested_key\x18\x02 \x03(\t\x12\x12\n\nvalue_json\x18\x10 \x01(\t\"\x0e\n\x0c\x43onfigResult\"\x96\x01\n\rSummaryRecord\x12+\n\x06update\x18\x01 \x03(\x0b\x32\x1b.wandb_internal.SummaryItem\x12+\n\x06remove\x18\x02 \x03(\x0b\x32\x1b.wandb_internal.SummaryItem\x12+\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1b.wandb_internal._RecordInfo\"B\n\x0bSummaryItem\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\x12\n\nnested_key\x18\x02 \x03(\t\x12\x12\n\nvalue_json\x18\x10 \x01(\t\"\x0f\n\rSummaryResult\"d\n\x0b\x46ilesRecord\x12(\n\x05\x66iles\x18\x01 \x03(\x0b\x32\x19.wandb_internal.FilesItem\x12+\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1b.wandb_internal._RecordInfo\"\xfd\x01\n\tFilesItem\x12\x0c\n\x04path\x18\x01 \x01(\t\x12\x34\n\x06policy\x18\x02 \x01(\x0e\x32$.wandb_internal.FilesItem.PolicyType\x12\x30\n\x04type\x18\x03 \x01(\x0e\x32\".wandb_internal.FilesItem.FileType\x12\x15\n\rexternal_path\x18\x10 \x01(\t\"(\n\nPolicyType\x12\x07\n\x03NOW\x10\x00\x12\x07\n\x03\x45ND\x10\x01\x12\x08\n\x04LIVE\x10\x02\"9\n\x08\x46ileType\x12\t\n\x05OTHER\x10\x00\x12\t\n\x05WANDB\x10\x01\x12\t\n\x05MEDIA\x10\x02\x12\x0c\n\x08\x41RTIFACT\x10\x03\"\r\n\x0b\x46ilesResult\"\xe6\x01\n\x0bStatsRecord\x12\x39\n\nstats_type\x18\x01 \x01(\x0e\x32%.wandb_internal.StatsRecord.StatsType\x12-\n\ttimestamp\x18\x02 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12\'\n\x04item\x18\x03 \x03(\x0b\x32\x19.wandb_internal.StatsItem\x12+\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1b.wandb_internal._RecordInfo\"\x17\n\tStatsType\x12\n\n\x06SYSTEM\x10\x00\",\n\tStatsItem\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\x12\n\nvalue_json\x18\x10 \x01(\t\"\xd9\x03\n\x0e\x41rtifactRecord\x12\x0e\n\x06run_id\x18\x01 \x01(\t\x12\x0f\n\x07project\x18\x02 \x01(\t\x12\x0e\n\x06\x65ntity\x18\x03 \x01(\t\x12\x0c\n\x04type\x18\x04 \x01(\t\x12\x0c\n\x04name\x18\x05 \x01(\t\x12\x0e\n\x06\x64igest\x18\x06 \x01(\t\x12\x13\n\x0b\x64\x65scription\x18\x07 \x01(\t\x12\x10\n\x08metadata\x18\x08 \x01(\t\x12\x14\n\x0cuser_created\x18\t \x01(\x08\x12\x18\n\x10use_after_commit\x18\n \x01(\x08\x12\x0f\n\x07\x61liases\x18\x0b \x03(\t\x12\x32\n\x08manifest\x18\x0c \x01(\x0b\x32 .wandb_internal.ArtifactManifest\x12\x16\n\x0e\x64istributed_id\x18\r \x01(\t\x12\x10\n\x08\x66inalize\x18\x0e \x01(\x08\x12\x11\n\tclient_id\x18\x0f \x01(\t\x12\x1a\n\x12sequence_client_id\x18\x10 \x01(\t\x12\x0f\n\x07\x62\x61se_id\x18\x11 \x01(\t\x12\x1c\n\x14ttl_duration_seconds\x18\x12 \x01(\x03\x12\x19\n\x11incremental_beta1\x18\x64 \x01(\x08\x12+\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1b.wandb_internal._RecordInfo\"\xbc\x01\n\x10\x41rtifactManifest\x12\x0f\n\x07version\x18\x01 \x01(\x05\x12\x16\n\x0estorage_policy\x18\x02 \x01(\t\x12\x46\n\x15storage_policy_config\x18\x03 \x03(\x0b\x32\'.wandb_internal.StoragePolicyConfigItem\x12\x37\n\x08\x63ontents\x18\x04 \x03(\x0b\x32%.wandb_internal.ArtifactManifestEntry\"\xbb\x01\n\x15\x41rtifactManifestEntry\x12\x0c\n\x04path\x18\x01 \x01(\t\x12\x0e\n\x06\x64igest\x18\x02 \x01(\t\x12\x0b\n\x03ref\x18\x03 \x01(\t\x12\x0c\n\x04size\x18\x04 \x01(\x03\x12\x10\n\x08mimetype\x18\x05 \x01(\t\x12\x12\n\nlocal_path\x18\x06 \x01(\t\x12\x19\n\x11\x62irth_artifact_id\x18\x07 \x01(\t\x12(\n\x05\x65xtra\x18\x10 \x03(\x0b\x32\x19.wandb_internal.ExtraItem\",\n\tExtraItem\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\x12\n\nvalue_json\x18\x02 \x01(\t\":\n\x17StoragePolicyConfigItem\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\x12\n\nvalue_json\x18\x02 \x01(\t\"\x10\n\x0e\x41rtifactResult\"\x14\n\x12LinkArtifactResult\"\xcf\x01\n\x12LinkArtifactRecord\x12\x11\n\tclient_id\x18\x01 \x01(\t\x12\x11\n\tserver_id\x18\x02 \x01(\t\x12\x16\n\x0eportfolio_name\x18\x03 \x01(\t\x12\x18\n\x10portfolio_entity\x18\x04 \x01(\t\x12\x19\n\x11portfolio_project\x18\x05 \x01(\t\x12\x19\n\x11portfolio_aliases\x18\x06 \x03(\t\x12+\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1b.wandb_internal._RecordInfo\"h\n\x08TBRecord\x12\x0f\n\x07log_dir\x18\x01 \x01(\t\x12\x0c\n\x04save\x18\x02 \x01(\x08\x12\x10\n\x08root_dir\x18\x03 \x01(\t\x12+\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1b.wandb_internal._RecordInfo\"\n\n\x08TBResult\"}\n\x0b\x41lertRecord\x12\r\n\x05title\x18\x01 \x01(\t\x12\x0c\n\x04text\x18\x02 \x01(\t\x12\r\n\x05level\x18\x03 \x01(\t\x12\x15\n\rwait_duration\x18\x04 \x01(\x03\x12+\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1b.wandb_internal._RecordInfo\"\r\n\x0b\x41lertResult\"\xdc\x13\n\x07Request\x12\x38\n\x0bstop_status\x18\x01 \x01(\x0b\x32!.wandb_internal.StopStatusRequestH\x00\x12>\n\x0enetwork_status\x18\x02 \x01(\x0b\x32$.wandb_internal.NetworkStatusRequestH\x00\x12-\n\x05\x64\x65\x66\x65r\x18\x03 \x01(\x0b\x32\x1c.wandb_internal.DeferRequestH\x00\x12\x38\n\x0bget_summary\x18\x04 \x01(\x0b\x32!.wandb_internal.GetSummaryRequestH\x00\x12-\n\x05login\x18\x05 \x01(\x0b\x32\x1c.wandb_internal.LoginRequestH\x00\x12-\n\x05pause\x18\x06 \x01(\x0b\x32\x1c.wandb_internal.PauseRequestH\x00\x12/\n\x06resume\x18\x07 \x01(\x0b\x32\x1d.wandb_internal.ResumeRequestH\x00\x12\x34\n\tpoll_exit\x18\x08 \x01(\x0b\x32\x1f.wandb_internal.PollExitRequestH\x00\x12@\n\x0fsampled_history\x18\t \x01(\x0b\x32%.wandb_internal.SampledHistoryRequestH\x00\x12@\n\x0fpartial_history\x18\n \x01(\x0b\x32%.wandb_internal.PartialHistoryRequestH\x00\x12\x34\n\trun_start\x18\x0b \x01(\x0b\x32\x1f.wandb_internal.RunStartRequestH\x00\x12<\n\rcheck_version\x18\x0c \x01(\x0b\x32#.wandb_internal.CheckVersionRequestH\x00\x12:\n\x0clog_artifact\x18\r \x01(\x0b\x32\".wandb_internal.LogArtifactRequestH\x00\x12\x44\n\x11\x64ownload_artifact\x18\x0e \x01(\x0b\x32\'.wandb_internal.DownloadArtifactRequestH\x00\x12\x35\n\tkeepalive\x18\x11 \x01(\x0b\x32 .wandb_internal.KeepaliveRequestH\x00\x12\x36\n\nrun_status\x18\x14 \x01(\x0b\x32 .wandb_internal.RunStatusRequestH\x00\x12/\n\x06\x63\x61ncel\x18\x15 \x01(\x0b\x32\x1d.wandb_internal.CancelRequestH\x00\x12\x33\n\x08metadata\x18\x16 \x01(\x0b\x32\x1f.wandb_internal.MetadataRequestH\x00\x12\x44\n\x11internal_messages\x18\x17 \x01(\x0b\x32\'.wandb_internal.InternalMessagesRequestH\x00\x12@\n\x0fpython_packages\x18\x18 \x01(\x0b\x32%.wandb_internal.PythonPackagesRequestH\x00\x12\x33\n\x08shutdown\x18@ \x01(\x0b\x32\x1f.wandb_internal.ShutdownRequestH\x00\x12/\n\x06\x61ttach\x18\x41 \x01(\x0b\x32\x1d.wandb_internal.AttachRequestH\x00\x12/\n\x06status\x18\x42 \x01(\x0b\x32\x1d.wandb_internal.StatusRequestH\x00\x12\x38\n\x0bserver_info\x18\x43 \x01(\x0b\x32!.wandb_internal.ServerInfoRequestH\x00\x12\x38\n\x0bsender_mark\x18\x44 \x01(\x0b\x32!.wandb_internal.SenderMarkRequestH\x00\x12\x38\n\x0bsender_read\x18\x45 \x01(\x0b\x32!.wandb_internal.SenderReadRequestH\x00\x12<\n\rstatus_report\x18\x46 \x01(\x0b\x32#.wandb_internal.StatusReportRequestH\x00\x12>\n\x0esummary_record\x18G \x01(\x0b\x32$.wandb_internal.SummaryRecordRequestH\x00\x12\x42\n\x10telemetry_record\x18H \x01(\x0b\x32&.wandb_internal.TelemetryRecordRequestH\x00\x12\x32\n\x08job_info\x18I \x01(\x0b\x32\x1e.wandb_internal.JobInfoRequestH\x00\x12\x45\n\x12get_system_metrics\x18J \x01(\x0b\x32\'.wandb_internal.GetSystemMetricsRequestH\x00\x12\x45\n\x12\x66ile_transfer_info\x18K \x01(\x0b\x32\'.wandb_internal.FileTransferInfoRequestH\x00\x12+\n\x04sync\x18L \x01(\x0b\x32\x1b.wandb_internal.SyncRequestH\x00\x12\x39\n\x0btest_inject\x18\xe8\x07 \x01(\x0b\x32!.wandb_internal.TestInjectRequestH\x00\x12:\n\x0cstore_status\x18M \x01(\x0b\x32\".wandb_internal.StoreStatusRequestH\x00\x12@\n\x0f\x63ircuit_breaker\x18N \x01(\x0b\x32%.wandb_internal.CircuitBreakerRequestH\x00\x12\x38\n\x0brun_stopped\x18O \x01(\x0b\x32!.wandb_internal.RunStoppedRequestH\x00\x12\x32\n\x08run_move\x18P \x01(\x0b\x32\x1e.wandb_internal.RunMoveRequestH\x00\x12\x35\n\tpreflight\x18Q \x01(\x0b\x32 .wandb_internal.PreflightRequestH\x00\x12>\n\x0e\x64\x65rived_metric\x18R \x01(\x0b\x32$.wandb_internal.DerivedMetricRequestH\x00\x12>\n\x0estop_condition\x18S \x01(\x0b\x32$.wandb_internal.StopConditionRequestH\x00\x12\x38\n\x0b\x66low_credit\x18T \x01(\x0b\x32!.wandb_internal.FlowCreditRequestH\x00\x12\x34\n\tgo_online\x18U \x01(\x0b\x32\x1f.wandb_internal.GoOnlineRequestH\x00\x42\x0e\n\x0crequest_type\"\xb9\x0e\n\x08Response\x12?\n\x12keepalive_response\x18\x12 \x01(\x0b\x32!.wandb_internal.KeepaliveResponseH\x00\x12\x42\n\x14stop_status_response\x18\x13 \x01(\x0b\x32\".wandb_internal.StopStatusResponseH\x00\x12H\n\x17network_status_response\x18\x14 \x01(\x0b\x32%.wandb_internal.NetworkStatusResponseH\x00\x12\x37\n\x0elogin_response\x18\x18 \x01(\x0b\x32\x1d.wandb_internal.LoginResponseH\x00\x12\x42\n\x14get_summary_response\x18\x19 \x01(\x0b\x32\".wandb_internal.GetSummaryResponseH\x00\x12>\n\x12poll_exit_response\x18\x1a \x01(\x0b\x32 .wandb_internal.PollExitResponseH\x00\x12J\n\x18sampled_history_response\x18\x1b \x01(\x0b\x32&.wandb_internal.SampledHistoryResponseH\x00\x12>\n\x12run_start_response\x18\x1c \x01(\x0b\x32 .wandb_internal.RunStartResponseH\x00\x12\x46\n\x16\x63heck_version_response\x18\x1d \x01(\x0b\x32$.wandb_internal.CheckVersionResponseH\x00\x12\x44\n\x15log_artifact_response\x18\x1e \x01(\x0b\x32#.wandb_internal.LogArtifactResponseH\x00\x12N\n\x1a\x64ownload_artifact_response\x18\x1f \x01(\x0b\x32(.wandb_internal.DownloadArtifactResponseH\x00\x12@\n\x13run_status_response\x18# \x01(\x0b\x32!.wandb_internal.RunStatusResponseH\x00\x12\x39\n\x0f\x63\x61ncel_response\x18$ \x01(\x0b\x32\x1e.wandb_internal.CancelResponseH\x00\x12N\n\x1ainternal_messages_response\x18% \x01(\x0b\x32(.wandb_internal.InternalMessagesResponseH\x00\x12=\n\x11shutdown_response\x18@ \x01(\x0b\x32 .wandb_internal.ShutdownResponseH\x00\x12\x39\n\x0f\x61ttach_response\x18\x41 \x01(\x0b\x32\x1e.wandb_internal.AttachResponseH\x00\x12\x39\n\x0fstatus_response\x18\x42 \x01(\x0b\x32\x1e.wandb_internal.StatusResponseH\x00\x12\x42\n\x14server_info_response\x18\x43 \x01(\x0b\x32\".wandb_internal.ServerInfoResponseH\x00\x12<\n\x11job_info_response\x18\x44 \x01(\x0b\x32\x1f.wandb_internal.JobInfoResponseH\x00\x12O\n\x1bget_system_metrics_response\x18\x45 \x01(\x0b\x32(.wandb_internal.GetSystemMetricsResponseH\x00\x12\x35\n\rsync_response\x18\x46 \x01(\x0b\x32\x1c.wandb_internal.SyncResponseH\x00\x12\x43\n\x14test_inject_response\x18\xe8\x07 \x01(\x0b\x32\".wandb_internal.TestInjectResponseH\x00\x12<\n\x11run_move_response\x18G \x01(\x0b\x32\x1f.wandb_internal.RunMoveResponseH\x00\x12?\n\x12preflight_response\x18H \x01(\x0b\x32!.wandb_internal.PreflightResponseH\x00\x12H\n\x17\x64\x65rived_metric_response\x18I \x01(\x0b\x32%.wandb_internal.DerivedMetricResponseH\x00\x12H\n\x17stop_condition_response\x18J \x01(\x0b\x32%.wandb_internal.StopConditionResponseH\x00\x12\x42\n\x14\x66low_credit_response\x18K \x01(\x0b\x32\".wandb_internal.FlowCreditResponseH\x00\x42\x0f\n\rresponse_type\"\xc0\x02\n\x0c\x44\x65\x66\x65rRequest\x12\x36\n\x05state\x18\x01 \x01(\x0e\x32\'.wandb_internal.DeferRequest.DeferState\"\xf7\x01\n\nDeferState\x12\t\n\x05\x42\x45GIN\x10\x00\x12\r\n\tFLUSH_RUN\x10\x01\x12\x0f\n\x0b\x46LUSH_STATS\x10\x02\x12\x19\n\x15\x46LUSH_PARTIAL_HISTORY\x10\x03\x12\x0c\n\x08\x46LUSH_TB\x10\x04\x12\r\n\tFLUSH_SUM\x10\x05\x12\x13\n\x0f\x46LUSH_DEBOUNCER\x10\x06\x12\x10\n\x0c\x46LUSH_OUTPUT\x10\x07\x12\r\n\tFLUSH_JOB\x10\x08\x12\r\n\tFLUSH_DIR\x10\t\x12\x0c\n\x08\x46LUSH_FP\x10\n\x12\x0b\n\x07JOIN_FP\x10\x0b\x12\x0c\n\x08\x46LUSH_FS\x10\x0c\x12\x0f\n\x0b\x46LUSH_FINAL\x10\r\x12\x07\n\x03\x45ND\x10\x0e\"<\n\x0cPauseRequest\x12,\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1c.wandb_internal._RequestInfo\"\x0f\n\rPauseResponse\"=\n\rResumeRequest\x12,\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1c.wandb_internal._RequestInfo\"\x10\n\x0eResumeResponse\"M\n\x0cLoginRequest\x12\x0f\n\x07\x61pi_key\x18\x01 \x01(\t\x12,\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1c.wandb_internal._RequestInfo\"&\n\rLoginResponse\x12\x15\n\ractive_entity\x18\x01 \x01(\t\"A\n\x11GetSummaryRequest\x12,\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1c.wandb_internal._RequestInfo\"?\n\x12GetSummaryResponse\x12)\n\x04item\x18\x01 \x03(\x0b\x32\x1b.wandb_internal.SummaryItem\"G\n\x17GetSystemMetricsRequest\x12,\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1c.wandb_internal._RequestInfo\"R\n\x12SystemMetricSample\x12-\n\ttimestamp\x18\x01 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12\r\n\x05value\x18\x02 \x01(\x02\"I\n\x13SystemMetricsBuffer\x12\x32\n\x06record\x18\x01 \x03(\x0b\x32\".wandb_internal.SystemMetricSample\"\xca\x01\n\x18GetSystemMetricsResponse\x12S\n\x0esystem_metrics\x18\x01 \x03(\x0b\x32;.wandb_internal.GetSystemMetricsResponse.SystemMetricsEntry\x1aY\n\x12SystemMetricsEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\x32\n\x05value\x18\x02 \x01(\x0b\x32#.wandb_internal.SystemMetricsBuffer:\x02\x38\x01\"=\n\rStatusRequest\x12,\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1c.wandb_internal._RequestInfo\")\n\x0eStatusResponse\x12\x17\n\x0frun_should_stop\x18\x01 \x01(\x08\"A\n\x11StopStatusRequest\x12,\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1c.wandb_internal._RequestInfo\"Z\n\x12StopStatusResponse\x12\x17\n\x0frun_should_stop\x18\x01 \x01(\x08\x12\x16\n\x0erun_preempting\x18\x02 \x01(\x08\x12\x13\n\x0bstop_reason\x18\x03 \x01(\t\"D\n\x14NetworkStatusRequest\x12,\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1c.wandb_internal._RequestInfo\"P\n\x15NetworkStatusResponse\x12\x37\n\x11network_responses\x18\x01 \x03(\x0b\x32\x1c.wandb_internal.HttpResponse\"D\n\x0cHttpResponse\x12\x18\n\x10http_status_code\x18\x01 \x01(\x05\x12\x1a\n\x12http_response_text\x18\x02 \x01(\t\"G\n\x17InternalMessagesRequest\x12,\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1c.wandb_internal._RequestInfo\"N\n\x18InternalMessagesResponse\x12\x32\n\x08messages\x18\x01 \x01(\x0b\x32 .wandb_internal.InternalMessages\"g\n\x10InternalMessages\x12\x0f\n\x07warning\x18\x01 \x03(\t\x12\x42\n\x15invalid_history_value\x18\x02 \x03(\x0b\x32#.wandb_internal.InvalidHistoryValue\"?\n\x0fPollExitRequest\x12,\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1c.wandb_internal._RequestInfo\"\xbc\x01\n\x10PollExitResponse\x12\x0c\n\x04\x64one\x18\x01 \x01(\x08\x12\x32\n\x0b\x65xit_result\x18\x02 \x01(\x0b\x32\x1d.wandb_internal.RunExitResult\x12\x35\n\x0cpusher_stats\x18\x03 \x01(\x0b\x32\x1f.wandb_internal.FilePusherStats\x12/\n\x0b\x66ile_counts\x18\x04 \x01(\x0b\x32\x1a.wandb_internal.FileCounts\"@\n\rSyncOverwrite\x12\x0e\n\x06run_id\x18\x01 \x01(\t\x12\x0e\n\x06\x65ntity\x18\x02 \x01(\t\x12\x0f\n\x07project\x18\x03 \x01(\t\"\x1e\n\x08SyncSkip\x12\x12\n\noutput_raw\x18\x01 \x01(\x08\"\x13\n\x11SenderMarkRequest\"\xb4\x01\n\x0bSyncRequest\x12\x14\n\x0cstart_offset\x18\x01 \x01(\x03\x12\x14\n\x0c\x66inal_offset\x18\x02 \x01(\x03\x12\x30\n\toverwrite\x18\x03 \x01(\x0b\x32\x1d.wandb_internal.SyncOverwrite\x12&\n\x04skip\x18\x04 \x01(\x0b\x32\x18.wandb_internal.SyncSkip\x12\x0f\n\x07\x63ompact\x18\x05 \x01(\x08\x12\x0e\n\x06verify\x18\x06 \x01(\x08\"z\n\x0cSyncResponse\x12\x0b\n\x03url\x18\x01 \x01(\t\x12(\n\x05\x65rror\x18\x02 \x01(\x0b\x32\x19.wandb_internal.ErrorInfo\x12\x33\n\rverify_report\x18\x03 \x01(\x0b\x32\x1c.wandb_internal.VerifyReport\"?\n\x11SenderReadRequest\x12\x14\n\x0cstart_offset\x18\x01 \x01(\x03\x12\x14\n\x0c\x66inal_offset\x18\x02 \x01(\x03\"m\n\x13StatusReportRequest\x12\x12\n\nrecord_num\x18\x01 \x01(\x03\x12\x13\n\x0bsent_offset\x18\x02 \x01(\x03\x12-\n\tsync_time\x18\x03 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\"F\n\x14SummaryRecordRequest\x12.\n\x07summary\x18\x01 \x01(\x0b\x32\x1d.wandb_internal.SummaryRecord\"L\n\x16TelemetryRecordRequest\x12\x32\n\ttelemetry\x18\x01 \x01(\x0b\x32\x1f.wandb_internal.TelemetryRecord\"A\n\x11ServerInfoRequest\x12,\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1c.wandb_internal._RequestInfo\"|\n\x12ServerInfoResponse\x12-\n\nlocal_info\x18\x01 \x01(\x0b\x32\x19.wandb_internal.LocalInfo\x12\x37\n\x0fserver_messages\x18\x02 \x01(\x0b\x32\x1e.wandb_internal.ServerMessages\"=\n\x0eServerMessages\x12+\n\x04item\x18\x01 \x03(\x0b\x32\x1d.wandb_internal.ServerMessage\"e\n\rServerMessage\x12\x12\n\nplain_text\x18\x01 \x01(\t\x12\x10\n\x08utf_text\x18\x02 \x01(\t\x12\x11\n\thtml_text\x18\x03 \x01(\t\x12\x0c\n\x04type\x18\x04 \x01(\t\x12\r\n\x05level\x18\x05 \x01(\x05\"c\n\nFileCounts\x12\x13\n\x0bwandb_count\x18\x01 \x01(\x05\x12\x13\n\x0bmedia_count\x18\x02 \x01(\x05\x12\x16\n\x0e\x61rtifact_count\x18\x03 \x01(\x05\x12\x13\n\x0bother_count\x18\x04 \x01(\x05\"U\n\x0f\x46ilePusherStats\x12\x16\n\x0euploaded_bytes\x18\x01 \x01(\x03\x12\x13\n\x0btotal_bytes\x18\x02 \x01(\x03\x12\x15\n\rdeduped_bytes\x18\x03 \x01(\x03\"\x1e\n\rFilesUploaded\x12\r\n\x05\x66iles\x18\x01 \x03(\t\"\xf4\x01\n\x17\x46ileTransferInfoRequest\x12\x42\n\x04type\x18\x01 \x01(\x0e\x32\x34.wandb_internal.FileTransferInfoRequest.TransferType\x12\x0c\n\x04path\x18\x02 \x01(\t\x12\x0b\n\x03url\x18\x03 \x01(\t\x12\x0c\n\x04size\x18\x04 \x01(\x03\x12\x11\n\tprocessed\x18\x05 \x01(\x03\x12/\n\x0b\x66ile_counts\x18\x06 \x01(\x0b\x32\x1a.wandb_internal.FileCounts\"(\n\x0cTransferType\x12\n\n\x06Upload\x10\x00\x12\x0c\n\x08\x44ownload\x10\x01\"1\n\tLocalInfo\x12\x0f\n\x07version\x18\x01 \x01(\t\x12\x13\n\x0bout_of_date\x18\x02 \x01(\x08\"?\n\x0fShutdownRequest\x12,\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1c.wandb_internal._RequestInfo\"\x12\n\x10ShutdownResponse\"P\n\rAttachRequest\x12\x11\n\tattach_id\x18\x14 \x01(\t\x12,\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1c.wandb_internal._RequestInfo\"b\n\x0e\x41ttachResponse\x12&\n\x03run\x18\x01 \x01(\x0b\x32\x19.wandb_internal.RunRecord\x12(\n\x05\x65rror\x18\x02 \x01(\x0b\x32\x19.wandb_internal.ErrorInfo\"\xd5\x02\n\x11TestInjectRequest\x12\x13\n\x0bhandler_exc\x18\x01 \x01(\x08\x12\x14\n\x0chandler_exit\x18\x02 \x01(\x08\x12\x15\n\rhandler_abort\x18\x03 \x01(\x08\x12\x12\n\nsender_exc\x18\x04 \x01(\x08\x12\x13\n\x0bsender_exit\x18\x05 \x01(\x08\x12\x14\n\x0csender_abort\x18\x06 \x01(\x08\x12\x0f\n\x07req_exc\x18\x07 \x01(\x08\x12\x10\n\x08req_exit\x18\x08 \x01(\x08\x12\x11\n\treq_abort\x18\t \x01(\x08\x12\x10\n\x08resp_exc\x18\n \x01(\x08\x12\x11\n\tresp_exit\x18\x0b \x01(\x08\x12\x12\n\nresp_abort\x18\x0c \x01(\x08\x12\x10\n\x08msg_drop\x18\r \x01(\x08\x12\x10\n\x08msg_hang\x18\x0e \x01(\x08\x12,\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1c.wandb_internal._RequestInfo\"\x14\n\x12TestInjectResponse\"\x1e\n\rHistoryAction\x12\r\n\x05\x66lush\x18\x01 \x01(\x08\"\xca\x01\n\x15PartialHistoryRequest\x12)\n\x04item\x18\x01 \x03(\x0b\x32\x1b.wandb_internal.HistoryItem\x12)\n\x04step\x18\x02 \x01(\x0b\x32\x1b.wandb_internal.HistoryStep\x12-\n\x06\x61\x63tion\x18\x03 \x01(\x0b\x32\x1d.wandb_internal.HistoryAction\x12,\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1c.wandb_internal._RequestInfo\"\x18\n\x16PartialHistoryResponse\"E\n\x15SampledHistoryRequest\x12,\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1c.wandb_internal._RequestInfo\"_\n\x12SampledHistoryItem\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\x12\n\nnested_key\x18\x02 \x03(\t\x12\x14\n\x0cvalues_float\x18\x03 \x03(\x02\x12\x12\n\nvalues_int\x18\x04 \x03(\x03\"J\n\x16SampledHistoryResponse\x12\x30\n\x04item\x18\x01 \x03(\x0b\x32\".wandb_internal.SampledHistoryItem\"@\n\x10RunStatusRequest\x12,\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1c.wandb_internal._RequestInfo\"x\n\x11RunStatusResponse\x12\x18\n\x10sync_items_total\x18\x01 \x01(\x03\x12\x1a\n\x12sync_items_pending\x18\x02 \x01(\x03\x12-\n\tsync_time\x18\x03 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\"g\n\x0fRunStartRequest\x12&\n\x03run\x18\x01 \x01(\x0b\x32\x19.wandb_internal.RunRecord\x12,\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1c.wandb_internal._RequestInfo\"\x12\n\x10RunStartResponse\"\\\n\x13\x43heckVersionRequest\x12\x17\n\x0f\x63urrent_version\x18\x01 \x01(\t\x12,\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1c.wandb_internal._RequestInfo\"]\n\x14\x43heckVersionResponse\x12\x17\n\x0fupgrade_message\x18\x01 \x01(\t\x12\x14\n\x0cyank_message\x18\x02 \x01(\t\x12\x16\n\x0e\x64\x65lete_message\x18\x03 \x01(\t\">\n\x0eJobInfoRequest\x12,\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1c.wandb_internal._RequestInfo\"6\n\x0fJobInfoResponse\x12\x12\n\nsequenceId\x18\x01 \x01(\t\x12\x0f\n\x07version\x18\x02 \x01(\t\"\x9f\x01\n\x12LogArtifactRequest\x12\x30\n\x08\x61rtifact\x18\x01 \x01(\x0b\x32\x1e.wandb_internal.ArtifactRecord\x12\x14\n\x0chistory_step\x18\x02 \x01(\x03\x12\x13\n\x0bstaging_dir\x18\x03 \x01(\t\x12,\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1c.wandb_internal._RequestInfo\"A\n\x13LogArtifactResponse\x12\x13\n\x0b\x61rtifact_id\x18\x01 \x01(\t\x12\x15\n\rerror_message\x18\x02 \x01(\t\"\x95\x01\n\x17\x44ownloadArtifactRequest\x12\x13\n\x0b\x61rtifact_id\x18\x01 \x01(\t\x12\x15\n\rdownload_root\x18\x02 \x01(\t\x12 \n\x18\x61llow_missing_references\x18\x04 \x01(\x08\x12,\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1c.wandb_internal._RequestInfo\"1\n\x18\x44ownloadArtifactResponse\x12\x15\n\rerror_message\x18\x01 \x01(\t\"@\n\x10KeepaliveRequest\x12,\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1c.wandb_internal._RequestInfo\"\x13\n\x11KeepaliveResponse\"F\n\x0c\x41rtifactInfo\x12\x10\n\x08\x61rtifact\x18\x01 \x01(\t\x12\x12\n\nentrypoint\x18\x02 \x03(\t\x12\x10\n\x08notebook\x18\x03 \x01(\x08\")\n\x07GitInfo\x12\x0e\n\x06remote\x18\x01 \x01(\t\x12\x0e\n\x06\x63ommit\x18\x02 \x01(\t\"\\\n\tGitSource\x12)\n\x08git_info\x18\x01 \x01(\x0b\x32\x17.wandb_internal.GitInfo\x12\x12\n\nentrypoint\x18\x02 \x03(\t\x12\x10\n\x08notebook\x18\x03 \x01(\x08\"\x1c\n\x0bImageSource\x12\r\n\x05image\x18\x01 \x01(\t\"\x8c\x01\n\x06Source\x12&\n\x03git\x18\x01 \x01(\x0b\x32\x19.wandb_internal.GitSource\x12.\n\x08\x61rtifact\x18\x02 \x01(\x0b\x32\x1c.wandb_internal.ArtifactInfo\x12*\n\x05image\x18\x03 \x01(\x0b\x32\x1b.wandb_internal.ImageSource\"k\n\tJobSource\x12\x10\n\x08_version\x18\x01 \x01(\t\x12\x13\n\x0bsource_type\x18\x02 \x01(\t\x12&\n\x06source\x18\x03 \x01(\x0b\x32\x16.wandb_internal.Source\x12\x0f\n\x07runtime\x18\x04 \x01(\t\"V\n\x12PartialJobArtifact\x12\x10\n\x08job_name\x18\x01 \x01(\t\x12.\n\x0bsource_info\x18\x02 \x01(\x0b\x32\x19.wandb_internal.JobSource\"\x9d\x01\n\x11UseArtifactRecord\x12\n\n\x02id\x18\x01 \x01(\t\x12\x0c\n\x04type\x18\x02 \x01(\t\x12\x0c\n\x04name\x18\x03 \x01(\t\x12\x33\n\x07partial\x18\x04 \x01(\x0b\x32\".wandb_internal.PartialJobArtifact\x12+\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1b.wandb_internal._RecordInfo\"\x13\n\x11UseArtifactResult\"R\n\rCancelRequest\x12\x13\n\x0b\x63\x61ncel_slot\x18\x01 \x01(\t\x12,\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1c.wandb_internal._RequestInfo\"\x10\n\x0e\x43\x61ncelResponse\"\'\n\x08\x44iskInfo\x12\r\n\x05total\x18\x01 \x01(\x04\x12\x0c\n\x04used\x18\x02 \x01(\x04\"\x1b\n\nMemoryInfo\x12\r\n\x05total\x18\x01 \x01(\x04\"/\n\x07\x43puInfo\x12\r\n\x05\x63ount\x18\x01 \x01(\r\x12\x15\n\rcount_logical\x18\x02 \x01(\r\">\n\x0cGpuAppleInfo\x12\x0f\n\x07gpuType\x18\x01 \x01(\t\x12\x0e\n\x06vendor\x18\x02 \x01(\t\x12\r\n\x05\x63ores\x18\x03 \x01(\r\"3\n\rGpuNvidiaInfo\x12\x0c\n\x04name\x18\x01 \x01(\t\x12\x14\n\x0cmemory_total\x18\x02 \x01(\x04\"\x89\x02\n\nGpuAmdInfo\x12\n\n\x02id\x18\x01 \x01(\t\x12\x11\n\tunique_id\x18\x02 \x01(\t\x12\x15\n\rvbios_version\x18\x03 \x01(\t\x12\x19\n\x11performance_level\x18\x04 \x01(\t\x12\x15\n\rgpu_overdrive\x18\x05 \x01(\t\x12\x1c\n\x14gpu_memory_overdrive\x18\x06 \x01(\t\x12\x11\n\tmax_power\x18\x07 \x01(\t\x12\x0e\n\x06series\x18\x08 \x01(\t\x12\r\n\x05model\x18\t \x01(\t\x12\x0e\n\x06vendor\x18\n \x01(\t\x12\x0b\n\x03sku\x18\x0b \x01(\t\x12\x12\n\nsclk_range\x18\x0c \x01(\t\x12\x12\n\nmclk_range\x18\r \x01(\t\"\xce\x08\n\x0fMetadataRequest\x12\n\n\x02os\x18\x01 \x01(\t\x12\x0e\n\x06python\x18\x02 \x01(\t\x12/\n\x0bheartbeatAt\x18\x03 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12-\n\tstartedAt\x18\x04 \x01(\x0b\x32\x1a.google.protobuf.Timestamp\x12\x0e\n\x06\x64ocker\x18\x05 \x01(\t\x12\x0c\n\x04\x63uda\x18\x06 \x01(\t\x12\x0c\n\x04\x61rgs\x18\x07 \x03(\t\x12\r\n\x05state\x18\x08 \x01(\t\x12\x0f\n\x07program\x18\t \x01(\t\x12\x1b\n\tcode_path\x18\n \x01(\tR\x08\x63odePath\x12*\n\x03git\x18\x0b \x01(\x0b\x32\x1d.wandb_internal.GitRepoRecord\x12\r\n\x05\x65mail\x18\x0c \x01(\t\x12\x0c\n\x04root\x18\r \x01(\t\x12\x0c\n\x04host\x18\x0e \x01(\t\x12\x10\n\x08username\x18\x0f \x01(\t\x12\x12\n\nexecutable\x18\x10 \x01(\t\x12&\n\x0f\x63ode_path_local\x18\x11 \x01(\tR\rcodePathLocal\x12\r\n\x05\x63olab\x18\x12 \x01(\t\x12\x1c\n\tcpu_count\x18\x13 \x01(\rR\tcpu_count\x12,\n\x11\x63pu_count_logical\x18\x14 \x01(\rR\x11\x63pu_count_logical\x12\x15\n\x08gpu_type\x18\x15 \x01(\tR\x03gpu\x12\x1c\n\tgpu_count\x18\x16 \x01(\rR\tgpu_count\x12\x37\n\x04\x64isk\x18\x17 \x03(\x0b\x32).wandb_internal.MetadataRequest.DiskEntry\x12*\n\x06memory\x18\x18 \x01(\x0b\x32\x1a.wandb_internal.MemoryInfo\x12$\n\x03\x63pu\x18\x19 \x01(\x0b\x32\x17.wandb_internal.CpuInfo\x12\x39\n\tgpu_apple\x18\x1a \x01(\x0b\x32\x1c.wandb_internal.GpuAppleInfoR\x08gpuapple\x12=\n\ngpu_nvidia\x18\x1b \x03(\x0b\x32\x1d.wandb_internal.GpuNvidiaInfoR\ngpu_nvidia\x12\x34\n\x07gpu_amd\x18\x1c \x03(\x0b\x32\x1a.wandb_internal.GpuAmdInfoR\x07gpu_amd\x12\x39\n\x05slurm\x18\x1d \x03(\x0b\x32*.wandb_internal.MetadataRequest.SlurmEntry\x12\x36\n\x0b\x65nvironment\x18\x1e \x01(\x0b\x32!.wandb_internal.EnvironmentRecord\x1a\x45\n\tDiskEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\'\n\x05value\x18\x02 \x01(\x0b\x32\x18.wandb_internal.DiskInfo:\x02\x38\x01\x1a,\n\nSlurmEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\t:\x02\x38\x01\"\x8d\x01\n\x15PythonPackagesRequest\x12\x44\n\x07package\x18\x01 \x03(\x0b\x32\x33.wandb_internal.PythonPackagesRequest.PythonPackage\x1a.\n\rPythonPackage\x12\x0c\n\x04name\x18\x01 \x01(\t\x12\x0f\n\x07version\x18\x02 \x01(\t\"\xa6\x02\n\x0cVerifyReport\x12\x0f\n\x07records\x18\x01 \x01(\x03\x12\x17\n\x0f\x63orrupt_records\x18\x02 \x01(\x03\x12\x14\n\x0cout_of_order\x18\x03 \x01(\x03\x12?\n\rrecord_counts\x18\x04 \x03(\x0b\x32(.wandb_internal.VerifyReport.RecordCount\x12.\n\x04gaps\x18\x05 \x03(\x0b\x32 .wandb_internal.VerifyReport.Gap\x12\x0e\n\x06\x65rrors\x18\x06 \x03(\t\x1a\x31\n\x0bRecordCount\x12\x13\n\x0brecord_type\x18\x01 \x01(\t\x12\r\n\x05\x63ount\x18\x02 \x01(\x03\x1a\"\n\x03Gap\x12\r\n\x05\x66irst\x18\x01 \x01(\x03\x12\x0c\n\x04last\x18\x02 \x01(\x03\"\xd1\x01\n\x0eSnapshotRecord\x12\x10\n\x08last_num\x18\x01 \x01(\x03\x12,\n\x06\x63onfig\x18\x02 \x01(\x0b\x32\x1c.wandb_internal.ConfigRecord\x12.\n\x07summary\x18\x03 \x01(\x0b\x32\x1d.wandb_internal.SummaryRecord\x12\x0c\n\x04step\x18\x04 \x01(\x03\x12\x14\n\x0chistory_rows\x18\x05 \x01(\x03\x12+\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1b.wandb_internal._RecordInfo\"3\n\x12StoreStatusRequest\x12\r\n\x05\x65rror\x18\x01 \x01(\t\x12\x0e\n\x06\x65rrors\x18\x02 \x01(\x03\"R\n\x15\x43ircuitBreakerRequest\x12\x10\n\x08\x66\x61ilures\x18\x01 \x01(\x05\x12\x18\n\x10\x63ooldown_seconds\x18\x02 \x01(\x01\x12\r\n\x05\x65rror\x18\x03 \x01(\t\"\x13\n\x11RunStoppedRequest\"}\n\x0eRunMoveRequest\x12\x0e\n\x06\x65ntity\x18\x01 \x01(\t\x12\x0f\n\x07project\x18\x02 \x01(\t\x12\x0c\n\x04\x66ork\x18\x03 \x01(\x08\x12\x0e\n\x06run_id\x18\x04 \x01(\t\x12,\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1c.wandb_internal._RequestInfo\"c\n\x0fRunMoveResponse\x12&\n\x03run\x18\x01 \x01(\x0b\x32\x19.wandb_internal.RunRecord\x12(\n\x05\x65rror\x18\x02 \x01(\x0b\x32\x19.wandb_internal.ErrorInfo\"@\n\x10PreflightRequest\x12,\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1c.wandb_internal._RequestInfo\"s\n\x0ePreflightCheck\x12\r\n\x05stage\x18\x01 \x01(\t\x12\x0f\n\x07\x61\x64\x64ress\x18\x02 \x01(\t\x12\n\n\x02ok\x18\x03 \x01(\x08\x12\r\n\x05\x65rror\x18\x04 \x01(\t\x12\x0c\n\x04hint\x18\x05 \x01(\t\x12\x18\n\x10\x64uration_seconds\x18\x06 \x01(\x01\"O\n\x11PreflightResponse\x12\n\n\x02ok\x18\x01 \x01(\x08\x12.\n\x06\x63hecks\x18\x02 \x03(\x0b\x32\x1e.wandb_internal.PreflightCheck\"f\n\x14\x44\x65rivedMetricRequest\x12\x0c\n\x04name\x18\x01 \x01(\t\x12\x12\n\nexpression\x18\x02 \x01(\t\x12,\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1c.wandb_internal._RequestInfo\"A\n\x15\x44\x65rivedMetricResponse\x12(\n\x05\x65rror\x18\x01 \x01(\x0b\x32\x19.wandb_internal.ErrorInfo\"S\n\x13InvalidHistoryValue\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\x12\n\nvalue_json\x18\x02 \x01(\t\x12\x0c\n\x04step\x18\x03 \x01(\x03\x12\r\n\x05\x63ount\x18\x04 \x01(\x03\"\x9c\x03\n\x11\x45nvironmentRecord\x12\x12\n\ngit_commit\x18\x01 \x01(\t\x12\x12\n\ngit_branch\x18\x02 \x01(\t\x12\x11\n\tgit_dirty\x18\x03 \x01(\x08\x12\x17\n\x0fgit_diff_sha256\x18\x04 \x01(\t\x12\x16\n\x0epython_version\x18\x05 \x01(\t\x12\x12\n\ngo_version\x18\x06 \x01(\t\x12\x14\n\x0c\x63uda_version\x18\x07 \x01(\t\x12\x1d\n\x15nvidia_driver_version\x18\x08 \x01(\t\x12\x17\n\x0f\x63ontainer_image\x18\t \x01(\t\x12\x1e\n\x16\x63ontainer_image_digest\x18\n \x01(\t\x12=\n\x06launch\x18\x0b \x03(\x0b\x32-.wandb_internal.EnvironmentRecord.LaunchEntry\x12+\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1b.wandb_internal._RecordInfo\x1a-\n\x0bLaunchEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\t:\x02\x38\x01\"\xb7\x02\n\x14StopConditionRequest\x12\x0c\n\x04name\x18\x01 \x01(\t\x12\x0e\n\x06metric\x18\x02 \x01(\t\x12\x37\n\x04kind\x18\x03 \x01(\x0e\x32).wandb_internal.StopConditionRequest.Kind\x12\n\n\x02op\x18\x04 \x01(\t\x12\x11\n\tthreshold\x18\x05 \x01(\x01\x12\r\n\x05steps\x18\x06 \x01(\x03\x12\x35\n\x04goal\x18\x07 \x01(\x0e\x32\'.wandb_internal.MetricRecord.MetricGoal\x12\x11\n\tmin_delta\x18\x08 \x01(\x01\x12,\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1c.wandb_internal._RequestInfo\"\"\n\x04Kind\x12\r\n\tTHRESHOLD\x10\x00\x12\x0b\n\x07PLATEAU\x10\x01\"A\n\x15StopConditionResponse\x12(\n\x05\x65rror\x18\x01 \x01(\x0b\x32\x19.wandb_internal.ErrorInfo\"\x8b\x01\n\x0bTableRecord\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\x0f\n\x07\x63olumns\x18\x02 \x03(\t\x12\x0e\n\x06\x64types\x18\x03 \x03(\t\x12\x11\n\trows_json\x18\x04 \x03(\t\x12\x0e\n\x06\x63ommit\x18\x05 \x01(\x08\x12+\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1b.wandb_internal._RecordInfo\"O\n\x11\x46lowCreditRequest\x12\x0c\n\x04want\x18\x01 \x01(\x05\x12,\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1c.wandb_internal._RequestInfo\"%\n\x12\x46lowCreditResponse\x12\x0f\n\x07\x63redits\x18\x01 \x01(\x05\"?\n\x0fGoOnlineRequest\x12,\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1c.wandb_internal._RequestInfob\x06proto3')
 
 
 
@@ -28,6 +28,7 @@ _FINALRECORD = DESCRIPTOR.message_types_by_name['FinalRecord']
 _VERSIONINFO = DESCRIPTOR.message_types_by_name['VersionInfo']
 _HEADERRECORD = DESCRIPTOR.message_types_by_name['HeaderRecord']
 _FOOTERRECORD = DESCRIPTOR.message_types_by_name['FooterRecord']
+_FOOTERRECORD_DROPPEDRECORDS = _FOOTERRECORD.nested_types_by_name['DroppedRecords']
 _RUNRECORD = DESCRIPTOR.message_types_by_name['RunRecord']
 _GITREPORECORD = DESCRIPTOR.message_types_by_name['GitRepoRecord']
 _RUNUPDATERESULT = DESCRIPTOR.message_types_by_name['RunUpdateResult']
@@ -168,6 +169,29 @@ _METADATAREQUEST_DISKENTRY = _METADATAREQUEST.nested_types_by_name['DiskEntry']
 _METADATAREQUEST_SLURMENTRY = _METADATAREQUEST.nested_types_by_name['SlurmEntry']
 _PYTHONPACKAGESREQUEST = DESCRIPTOR.message_types_by_name['PythonPackagesRequest']
 _PYTHONPACKAGESREQUEST_PYTHONPACKAGE = _PYTHONPACKAGESREQUEST.nested_types_by_name['PythonPackage']
+_VERIFYREPORT = DESCRIPTOR.message_types_by_name['VerifyReport']
+_VERIFYREPORT_RECORDCOUNT = _VERIFYREPORT.nested_types_by_name['RecordCount']
+_VERIFYREPORT_GAP = _VERIFYREPORT.nested_types_by_name['Gap']
+_SNAPSHOTRECORD = DESCRIPTOR.message_types_by_name['SnapshotRecord']
+_STORESTATUSREQUEST = DESCRIPTOR.message_types_by_name['StoreStatusRequest']
+_CIRCUITBREAKERREQUEST = DESCRIPTOR.message_types_by_name['CircuitBreakerRequest']
+_RUNSTOPPEDREQUEST = DESCRIPTOR.message_types_by_name['RunStoppedRequest']
+_RUNMOVEREQUEST = DESCRIPTOR.message_types_by_name['RunMoveRequest']
+_RUNMOVERESPONSE = DESCRIPTOR.message_types_by_name['RunMoveResponse']
+_PREFLIGHTREQUEST = DESCRIPTOR.message_types_by_name['PreflightRequest']
+_PREFLIGHTCHECK = DESCRIPTOR.message_types_by_name['PreflightCheck']
+_PREFLIGHTRESPONSE = DESCRIPTOR.message_types_by_name['PreflightResponse']
+_DERIVEDMETRICREQUEST = DESCRIPTOR.message_types_by_name['DerivedMetricRequest']
+_DERIVEDMETRICRESPONSE = DESCRIPTOR.message_types_by_name['DerivedMetricResponse']
+_INVALIDHISTORYVALUE = DESCRIPTOR.message_types_by_name['InvalidHistoryValue']
+_ENVIRONMENTRECORD = DESCRIPTOR.message_types_by_name['EnvironmentRecord']
+_ENVIRONMENTRECORD_LAUNCHENTRY = _ENVIRONMENTRECORD.nested_types_by_name['LaunchEntry']
+_STOPCONDITIONREQUEST = DESCRIPTOR.message_types_by_name['StopConditionRequest']
+_STOPCONDITIONRESPONSE = DESCRIPTOR.message_types_by_name['StopConditionResponse']
+_TABLERECORD = DESCRIPTOR.message_types_by_name['TableRecord']
+_FLOWCREDITREQUEST = DESCRIPTOR.message_types_by_name['FlowCreditRequest']
+_FLOWCREDITRESPONSE = DESCRIPTOR.message_types_by_name['FlowCreditResponse']
+_GOONLINEREQUEST = DESCRIPTOR.message_types_by_name['GoOnlineRequest']
 _ERRORINFO_ERRORCODE = _ERRORINFO.enum_types_by_name['ErrorCode']
 _OUTPUTRECORD_OUTPUTTYPE = _OUTPUTRECORD.enum_types_by_name['OutputType']
 _OUTPUTRAWRECORD_OUTPUTTYPE = _OUTPUTRAWRECORD.enum_types_by_name['OutputType']
@@ -177,6 +201,7 @@ _FILESITEM_FILETYPE = _FILESITEM.enum_types_by_name['FileType']
 _STATSRECORD_STATSTYPE = _STATSRECORD.enum_types_by_name['StatsType']
 _DEFERREQUEST_DEFERSTATE = _DEFERREQUEST.enum_types_by_name['DeferState']
 _FILETRANSFERINFOREQUEST_TRANSFERTYPE = _FILETRANSFERINFOREQUEST.enum_types_by_name['TransferType']
+_STOPCONDITIONREQUEST_KIND = _STOPCONDITIONREQUEST.enum_types_by_name['Kind']
 Record = _reflection.GeneratedProtocolMessageType('Record', (_message.Message,), {
   'DESCRIPTOR' : _RECORD,
   '__module__' : 'wandb.proto.wandb_internal_pb2'
@@ -220,11 +245,19 @@ HeaderRecord = _reflection.GeneratedProtocolMessageType('HeaderRecord', (_messag
 _sym_db.RegisterMessage(HeaderRecord)
 
 FooterRecord = _reflection.GeneratedProtocolMessageType('FooterRecord', (_message.Message,), {
+
+  'DroppedRecords' : _reflection.GeneratedProtocolMessageType('DroppedRecords', (_message.Message,), {
+    'DESCRIPTOR' : _FOOTERRECORD_DROPPEDRECORDS,
+    '__module__' : 'wandb.proto.wandb_internal_pb2'
+    # @@protoc_insertion_point(class_scope:wandb_internal.FooterRecord.DroppedRecords)
+    })
+  ,
   'DESCRIPTOR' : _FOOTERRECORD,
   '__module__' : 'wandb.proto.wandb_internal_pb2'
   # @@protoc_insertion_point(class_scope:wandb_internal.FooterRecord)
   })
 _sym_db.RegisterMessage(FooterRecord)
+_sym_db.RegisterMessage(FooterRecord.DroppedRecords)
 
 RunRecord = _reflection.GeneratedProtocolMessageType('RunRecord', (_message.Message,), {
   'DESCRIPTOR' : _RUNRECORD,
@@ -1210,6 +1243,170 @@ PythonPackagesRequest = _reflection.GeneratedProtocolMessageType('PythonPackages
 _sym_db.RegisterMessage(PythonPackagesRequest)
 _sym_db.RegisterMessage(PythonPackagesRequest.PythonPackage)
 
+VerifyReport = _reflection.GeneratedProtocolMessageType('VerifyReport', (_message.Message,), {
+
+  'RecordCount' : _reflection.GeneratedProtocolMessageType('RecordCount', (_message.Message,), {
+    'DESCRIPTOR' : _VERIFYREPORT_RECORDCOUNT,
+    '__module__' : 'wandb.proto.wandb_internal_pb2'
+    # @@protoc_insertion_point(class_scope:wandb_internal.VerifyReport.RecordCount)
+    })
+  ,
+
+  'Gap' : _reflection.GeneratedProtocolMessageType('Gap', (_message.Message,), {
+    'DESCRIPTOR' : _VERIFYREPORT_GAP,
+    '__module__' : 'wandb.proto.wandb_internal_pb2'
+    # @@protoc_insertion_point(class_scope:wandb_internal.VerifyReport.Gap)
+    })
+  ,
+  'DESCRIPTOR' : _VERIFYREPORT,
+  '__module__' : 'wandb.proto.wandb_internal_pb2'
+  # @@protoc_insertion_point(class_scope:wandb_internal.VerifyReport)
+  })
+_sym_db.RegisterMessage(VerifyReport)
+_sym_db.RegisterMessage(VerifyReport.RecordCount)
+_sym_db.RegisterMessage(VerifyReport.Gap)
+
+SnapshotRecord = _reflection.GeneratedProtocolMessageType('SnapshotRecord', (_message.Message,), {
+  'DESCRIPTOR' : _SNAPSHOTRECORD,
+  '__module__' : 'wandb.proto.wandb_internal_pb2'
+  # @@protoc_insertion_point(class_scope:wandb_internal.SnapshotRecord)
+  })
+_sym_db.RegisterMessage(SnapshotRecord)
+
+StoreStatusRequest = _reflection.GeneratedProtocolMessageType('StoreStatusRequest', (_message.Message,), {
+  'DESCRIPTOR' : _STORESTATUSREQUEST,
+  '__module__' : 'wandb.proto.wandb_internal_pb2'
+  # @@protoc_insertion_point(class_scope:wandb_internal.StoreStatusRequest)
+  })
+_sym_db.RegisterMessage(StoreStatusRequest)
+
+CircuitBreakerRequest = _reflection.GeneratedProtocolMessageType('CircuitBreakerRequest', (_message.Message,), {
+  'DESCRIPTOR' : _CIRCUITBREAKERREQUEST,
+  '__module__' : 'wandb.proto.wandb_internal_pb2'
+  # @@protoc_insertion_point(class_scope:wandb_internal.CircuitBreakerRequest)
+  })
+_sym_db.RegisterMessage(CircuitBreakerRequest)
+
+RunStoppedRequest = _reflection.GeneratedProtocolMessageType('RunStoppedRequest', (_message.Message,), {
+  'DESCRIPTOR' : _RUNSTOPPEDREQUEST,
+  '__module__' : 'wandb.proto.wandb_internal_pb2'
+  # @@protoc_insertion_point(class_scope:wandb_internal.RunStoppedRequest)
+  })
+_sym_db.RegisterMessage(RunStoppedRequest)
+
+RunMoveRequest = _reflection.GeneratedProtocolMessageType('RunMoveRequest', (_message.Message,), {
+  'DESCRIPTOR' : _RUNMOVEREQUEST,
+  '__module__' : 'wandb.proto.wandb_internal_pb2'
+  # @@protoc_insertion_point(class_scope:wandb_internal.RunMoveRequest)
+  })
+_sym_db.RegisterMessage(RunMoveRequest)
+
+RunMoveResponse = _reflection.GeneratedProtocolMessageType('RunMoveResponse', (_message.Message,), {
+  'DESCRIPTOR' : _RUNMOVERESPONSE,
+  '__module__' : 'wandb.proto.wandb_internal_pb2'
+  # @@protoc_insertion_point(class_scope:wandb_internal.RunMoveResponse)
+  })
+_sym_db.RegisterMessage(RunMoveResponse)
+
+PreflightRequest = _reflection.GeneratedProtocolMessageType('PreflightRequest', (_message.Message,), {
+  'DESCRIPTOR' : _PREFLIGHTREQUEST,
+  '__module__' : 'wandb.proto.wandb_internal_pb2'
+  # @@protoc_insertion_point(class_scope:wandb_internal.PreflightRequest)
+  })
+_sym_db.RegisterMessage(PreflightRequest)
+
+PreflightCheck = _reflection.GeneratedProtocolMessageType('PreflightCheck', (_message.Message,), {
+  'DESCRIPTOR' : _PREFLIGHTCHECK,
+  '__module__' : 'wandb.proto.wandb_internal_pb2'
+  # @@protoc_insertion_point(class_scope:wandb_internal.PreflightCheck)
+  })
+_sym_db.RegisterMessage(PreflightCheck)
+
+PreflightResponse = _reflection.GeneratedProtocolMessageType('PreflightResponse', (_message.Message,), {
+  'DESCRIPTOR' : _PREFLIGHTRESPONSE,
+  '__module__' : 'wandb.proto.wandb_internal_pb2'
+  # @@protoc_insertion_point(class_scope:wandb_internal.PreflightResponse)
+  })
+_sym_db.RegisterMessage(PreflightResponse)
+
+DerivedMetricRequest = _reflection.GeneratedProtocolMessageType('DerivedMetricRequest', (_message.Message,), {
+  'DESCRIPTOR' : _DERIVEDMETRICREQUEST,
+  '__module__' : 'wandb.proto.wandb_internal_pb2'
+  # @@protoc_insertion_point(class_scope:wandb_internal.DerivedMetricRequest)
+  })
+_sym_db.RegisterMessage(DerivedMetricRequest)
+
+DerivedMetricResponse = _reflection.GeneratedProtocolMessageType('DerivedMetricResponse', (_message.Message,), {
+  'DESCRIPTOR' : _DERIVEDMETRICRESPONSE,
+  '__module__' : 'wandb.proto.wandb_internal_pb2'
+  # @@protoc_insertion_point(class_scope:wandb_internal.DerivedMetricResponse)
+  })
+_sym_db.RegisterMessage(DerivedMetricResponse)
+
+InvalidHistoryValue = _reflection.GeneratedProtocolMessageType('InvalidHistoryValue', (_message.Message,), {
+  'DESCRIPTOR' : _INVALIDHISTORYVALUE,
+  '__module__' : 'wandb.proto.wandb_internal_pb2'
+  # @@protoc_insertion_point(class_scope:wandb_internal.InvalidHistoryValue)
+  })
+_sym_db.RegisterMessage(InvalidHistoryValue)
+
+EnvironmentRecord = _reflection.GeneratedProtocolMessageType('EnvironmentRecord', (_message.Message,), {
+
+  'LaunchEntry' : _reflection.GeneratedProtocolMessageType('LaunchEntry', (_message.Message,), {
+    'DESCRIPTOR' : _ENVIRONMENTRECORD_LAUNCHENTRY,
+    '__module__' : 'wandb.proto.wandb_internal_pb2'
+    # @@protoc_insertion_point(class_scope:wandb_internal.EnvironmentRecord.LaunchEntry)
+    })
+  ,
+  'DESCRIPTOR' : _ENVIRONMENTRECORD,
+  '__module__' : 'wandb.proto.wandb_internal_pb2'
+  # @@protoc_insertion_point(class_scope:wandb_internal.EnvironmentRecord)
+  })
+_sym_db.RegisterMessage(EnvironmentRecord)
+_sym_db.RegisterMessage(EnvironmentRecord.LaunchEntry)
+
+StopConditionRequest = _reflection.GeneratedProtocolMessageType('StopConditionRequest', (_message.Message,), {
+  'DESCRIPTOR' : _STOPCONDITIONREQUEST,
+  '__module__' : 'wandb.proto.wandb_internal_pb2'
+  # @@protoc_insertion_point(class_scope:wandb_internal.StopConditionRequest)
+  })
+_sym_db.RegisterMessage(StopConditionRequest)
+
+StopConditionResponse = _reflection.GeneratedProtocolMessageType('StopConditionResponse', (_message.Message,), {
+  'DESCRIPTOR' : _STOPCONDITIONRESPONSE,
+  '__module__' : 'wandb.proto.wandb_internal_pb2'
+  # @@protoc_insertion_point(class_scope:wandb_internal.StopConditionResponse)
+  })
+_sym_db.RegisterMessage(StopConditionResponse)
+
+TableRecord = _reflection.GeneratedProtocolMessageType('TableRecord', (_message.Message,), {
+  'DESCRIPTOR' : _TABLERECORD,
+  '__module__' : 'wandb.proto.wandb_internal_pb2'
+  # @@protoc_insertion_point(class_scope:wandb_internal.TableRecord)
+  })
+_sym_db.RegisterMessage(TableRecord)
+
+FlowCreditRequest = _reflection.GeneratedProtocolMessageType('FlowCreditRequest', (_message.Message,), {
+  'DESCRIPTOR' : _FLOWCREDITREQUEST,
+  '__module__' : 'wandb.proto.wandb_internal_pb2'
+  # @@protoc_insertion_point(class_scope:wandb_internal.FlowCreditRequest)
+  })
+_sym_db.RegisterMessage(FlowCreditRequest)
+
+FlowCreditResponse = _reflection.GeneratedProtocolMessageType('FlowCreditResponse', (_message.Message,), {
+  'DESCRIPTOR' : _FLOWCREDITRESPONSE,
+  '__module__' : 'wandb.proto.wandb_internal_pb2'
+  # @@protoc_insertion_point(class_scope:wandb_internal.FlowCreditResponse)
+  })
+_sym_db.RegisterMessage(FlowCreditResponse)
+
+GoOnlineRequest = _reflection.GeneratedProtocolMessageType('GoOnlineRequest', (_message.Message,), {
+  'DESCRIPTOR' : _GOONLINEREQUEST,
+  '__module__' : 'wandb.proto.wandb_internal_pb2'
+  # @@protoc_insertion_point(class_scope:wandb_internal.GoOnlineRequest)
+  })
+_sym_db.RegisterMessage(GoOnlineRequest)
+
 if _descriptor._USE_C_DESCRIPTORS == False:
 
   DESCRIPTOR._options = None
@@ -1219,316 +1416,368 @@ if _descriptor._USE_C_DESCRIPTORS == False:
   _METADATAREQUEST_DISKENTRY._serialized_options = b'8\001'
   _METADATAREQUEST_SLURMENTRY._options = None
   _METADATAREQUEST_SLURMENTRY._serialized_options = b'8\001'
+  _ENVIRONMENTRECORD_LAUNCHENTRY._options = None
+  _ENVIRONMENTRECORD_LAUNCHENTRY._serialized_options = b'8\001'
   _RECORD._serialized_start=151
-  _RECORD._serialized_end=1331
-  _CONTROL._serialized_start=1334
-  _CONTROL._serialized_end=1502
-  _RESULT._serialized_start=1505
-  _RESULT._serialized_end=2004
-  _FINALRECORD._serialized_start=2006
-  _FINALRECORD._serialized_end=2064
-  _VERSIONINFO._serialized_start=2066
-  _VERSIONINFO._serialized_end=2164
-  _HEADERRECORD._serialized_start=2166
-  _HEADERRECORD._serialized_end=2276
-  _FOOTERRECORD._serialized_start=2278
-  _FOOTERRECORD._serialized_end=2337
-  _RUNRECORD._serialized_start=2340
-  _RUNRECORD._serialized_end=2930
-  _GITREPORECORD._serialized_start=2932
-  _GITREPORECORD._serialized_end=2991
-  _RUNUPDATERESULT._serialized_start=2993
-  _RUNUPDATERESULT._serialized_end=3092
-  _ERRORINFO._serialized_start=3095
-  _ERRORINFO._serialized_end=3267
-  _ERRORINFO_ERRORCODE._serialized_start=3176
-  _ERRORINFO_ERRORCODE._serialized_end=3267
-  _RUNEXITRECORD._serialized_start=3269
-  _RUNEXITRECORD._serialized_end=3365
-  _RUNEXITRESULT._serialized_start=3367
-  _RUNEXITRESULT._serialized_end=3382
-  _RUNPREEMPTINGRECORD._serialized_start=3384
-  _RUNPREEMPTINGRECORD._serialized_end=3450
-  _RUNPREEMPTINGRESULT._serialized_start=3452
-  _RUNPREEMPTINGRESULT._serialized_end=3473
-  _SETTINGSRECORD._serialized_start=3475
-  _SETTINGSRECORD._serialized_end=3580
-  _SETTINGSITEM._serialized_start=3582
-  _SETTINGSITEM._serialized_end=3629
-  _HISTORYSTEP._serialized_start=3631
-  _HISTORYSTEP._serialized_end=3657
-  _HISTORYRECORD._serialized_start=3660
-  _HISTORYRECORD._serialized_end=3806
-  _HISTORYITEM._serialized_start=3808
-  _HISTORYITEM._serialized_end=3874
-  _HISTORYRESULT._serialized_start=3876
-  _HISTORYRESULT._serialized_end=3891
-  _OUTPUTRECORD._serialized_start=3894
-  _OUTPUTRECORD._serialized_end=4114
-  _OUTPUTRECORD_OUTPUTTYPE._serialized_start=4078
-  _OUTPUTRECORD_OUTPUTTYPE._serialized_end=4114
-  _OUTPUTRESULT._serialized_start=4116
-  _OUTPUTRESULT._serialized_end=4130
-  _OUTPUTRAWRECORD._serialized_start=4133
-  _OUTPUTRAWRECORD._serialized_end=4359
-  _OUTPUTRAWRECORD_OUTPUTTYPE._serialized_start=4078
-  _OUTPUTRAWRECORD_OUTPUTTYPE._serialized_end=4114
-  _OUTPUTRAWRESULT._serialized_start=4361
-  _OUTPUTRAWRESULT._serialized_end=4378
-  _METRICRECORD._serialized_start=4381
-  _METRICRECORD._serialized_end=4789
-  _METRICRECORD_METRICGOAL._serialized_start=4723
-  _METRICRECORD_METRICGOAL._serialized_end=4789
-  _METRICRESULT._serialized_start=4791
-  _METRICRESULT._serialized_end=4805
-  _METRICOPTIONS._serialized_start=4807
-  _METRICOPTIONS._serialized_end=4874
-  _METRICCONTROL._serialized_start=4876
-  _METRICCONTROL._serialized_end=4910
-  _METRICSUMMARY._serialized_start=4912
-  _METRICSUMMARY._serialized_end=5023
-  _CONFIGRECORD._serialized_start=5026
-  _CONFIGRECORD._serialized_end=5173
-  _CONFIGITEM._serialized_start=5175
-  _CONFIGITEM._serialized_end=5240
-  _CONFIGRESULT._serialized_start=5242
-  _CONFIGRESULT._serialized_end=5256
-  _SUMMARYRECORD._serialized_start=5259
-  _SUMMARYRECORD._serialized_end=5409
-  _SUMMARYITEM._serialized_start=5411
-  _SUMMARYITEM._serialized_end=5477
-  _SUMMARYRESULT._serialized_start=5479
-  _SUMMARYRESULT._serialized_end=5494
-  _FILESRECORD._serialized_start=5496
-  _FILESRECORD._serialized_end=5596
-  _FILESITEM._serialized_start=5599
-  _FILESITEM._serialized_end=5852
-  _FILESITEM_POLICYTYPE._serialized_start=5753
-  _FILESITEM_POLICYTYPE._serialized_end=5793
-  _FILESITEM_FILETYPE._serialized_start=5795
-  _FILESITEM_FILETYPE._serialized_end=5852
-  _FILESRESULT._serialized_start=5854
-  _FILESRESULT._serialized_end=5867
-  _STATSRECORD._serialized_start=5870
-  _STATSRECORD._serialized_end=6100
-  _STATSRECORD_STATSTYPE._serialized_start=6077
-  _STATSRECORD_STATSTYPE._serialized_end=6100
-  _STATSITEM._serialized_start=6102
-  _STATSITEM._serialized_end=6146
-  _ARTIFACTRECORD._serialized_start=6149
-  _ARTIFACTRECORD._serialized_end=6622
-  _ARTIFACTMANIFEST._serialized_start=6625
-  _ARTIFACTMANIFEST._serialized_end=6813
-  _ARTIFACTMANIFESTENTRY._serialized_start=6816
-  _ARTIFACTMANIFESTENTRY._serialized_end=7003
-  _EXTRAITEM._serialized_start=7005
-  _EXTRAITEM._serialized_end=7049
-  _STORAGEPOLICYCONFIGITEM._serialized_start=7051
-  _STORAGEPOLICYCONFIGITEM._serialized_end=7109
-  _ARTIFACTRESULT._serialized_start=7111
-  _ARTIFACTRESULT._serialized_end=7127
-  _LINKARTIFACTRESULT._serialized_start=7129
-  _LINKARTIFACTRESULT._serialized_end=7149
-  _LINKARTIFACTRECORD._serialized_start=7152
-  _LINKARTIFACTRECORD._serialized_end=7359
-  _TBRECORD._serialized_start=7361
-  _TBRECORD._serialized_end=7465
-  _TBRESULT._serialized_start=7467
-  _TBRESULT._serialized_end=7477
-  _ALERTRECORD._serialized_start=7479
-  _ALERTRECORD._serialized_end=7604
-  _ALERTRESULT._serialized_start=7606
-  _ALERTRESULT._serialized_end=7619
-  _REQUEST._serialized_start=7622
-  _REQUEST._serialized_end=9615
-  _RESPONSE._serialized_start=9618
-  _RESPONSE._serialized_end=11124
-  _DEFERREQUEST._serialized_start=11127
-  _DEFERREQUEST._serialized_end=11447
-  _DEFERREQUEST_DEFERSTATE._serialized_start=11200
-  _DEFERREQUEST_DEFERSTATE._serialized_end=11447
-  _PAUSEREQUEST._serialized_start=11449
-  _PAUSEREQUEST._serialized_end=11509
-  _PAUSERESPONSE._serialized_start=11511
-  _PAUSERESPONSE._serialized_end=11526
-  _RESUMEREQUEST._serialized_start=11528
-  _RESUMEREQUEST._serialized_end=11589
-  _RESUMERESPONSE._serialized_start=11591
-  _RESUMERESPONSE._serialized_end=11607
-  _LOGINREQUEST._serialized_start=11609
-  _LOGINREQUEST._serialized_end=11686
-  _LOGINRESPONSE._serialized_start=11688
-  _LOGINRESPONSE._serialized_end=11726
-  _GETSUMMARYREQUEST._serialized_start=11728
-  _GETSUMMARYREQUEST._serialized_end=11793
-  _GETSUMMARYRESPONSE._serialized_start=11795
-  _GETSUMMARYRESPONSE._serialized_end=11858
-  _GETSYSTEMMETRICSREQUEST._serialized_start=11860
-  _GETSYSTEMMETRICSREQUEST._serialized_end=11931
-  _SYSTEMMETRICSAMPLE._serialized_start=11933
-  _SYSTEMMETRICSAMPLE._serialized_end=12015
-  _SYSTEMMETRICSBUFFER._serialized_start=12017
-  _SYSTEMMETRICSBUFFER._serialized_end=12090
-  _GETSYSTEMMETRICSRESPONSE._serialized_start=12093
-  _GETSYSTEMMETRICSRESPONSE._serialized_end=12295
-  _GETSYSTEMMETRICSRESPONSE_SYSTEMMETRICSENTRY._serialized_start=12206
-  _GETSYSTEMMETRICSRESPONSE_SYSTEMMETRICSENTRY._serialized_end=12295
-  _STATUSREQUEST._serialized_start=12297
-  _STATUSREQUEST._serialized_end=12358
-  _STATUSRESPONSE._serialized_start=12360
-  _STATUSRESPONSE._serialized_end=12401
-  _STOPSTATUSREQUEST._serialized_start=12403
-  _STOPSTATUSREQUEST._serialized_end=12468
-  _STOPSTATUSRESPONSE._serialized_start=12470
-  _STOPSTATUSRESPONSE._serialized_end=12515
-  _NETWORKSTATUSREQUEST._serialized_start=12517
-  _NETWORKSTATUSREQUEST._serialized_end=12585
-  _NETWORKSTATUSRESPONSE._serialized_start=12587
-  _NETWORKSTATUSRESPONSE._serialized_end=12667
-  _HTTPRESPONSE._serialized_start=12669
-  _HTTPRESPONSE._serialized_end=12737
-  _INTERNALMESSAGESREQUEST._serialized_start=12739
-  _INTERNALMESSAGESREQUEST._serialized_end=12810
-  _INTERNALMESSAGESRESPONSE._serialized_start=12812
-  _INTERNALMESSAGESRESPONSE._serialized_end=12890
-  _INTERNALMESSAGES._serialized_start=12892
-  _INTERNALMESSAGES._serialized_end=12927
-  _POLLEXITREQUEST._serialized_start=12929
-  _POLLEXITREQUEST._serialized_end=12992
-  _POLLEXITRESPONSE._serialized_start=12995
-  _POLLEXITRESPONSE._serialized_end=13183
-  _SYNCOVERWRITE._serialized_start=13185
-  _SYNCOVERWRITE._serialized_end=13249
-  _SYNCSKIP._serialized_start=13251
-  _SYNCSKIP._serialized_end=13281
-  _SENDERMARKREQUEST._serialized_start=13283
-  _SENDERMARKREQUEST._serialized_end=13302
-  _SYNCREQUEST._serialized_start=13305
-  _SYNCREQUEST._serialized_end=13452
-  _SYNCRESPONSE._serialized_start=13454
-  _SYNCRESPONSE._serialized_end=13523
-  _SENDERREADREQUEST._serialized_start=13525
-  _SENDERREADREQUEST._serialized_end=13588
-  _STATUSREPORTREQUEST._serialized_start=13590
-  _STATUSREPORTREQUEST._serialized_end=13699
-  _SUMMARYRECORDREQUEST._serialized_start=13701
-  _SUMMARYRECORDREQUEST._serialized_end=13771
-  _TELEMETRYRECORDREQUEST._serialized_start=13773
-  _TELEMETRYRECORDREQUEST._serialized_end=13849
-  _SERVERINFOREQUEST._serialized_start=13851
-  _SERVERINFOREQUEST._serialized_end=13916
-  _SERVERINFORESPONSE._serialized_start=13918
-  _SERVERINFORESPONSE._serialized_end=14042
-  _SERVERMESSAGES._serialized_start=14044
-  _SERVERMESSAGES._serialized_end=14105
-  _SERVERMESSAGE._serialized_start=14107
-  _SERVERMESSAGE._serialized_end=14208
-  _FILECOUNTS._serialized_start=14210
-  _FILECOUNTS._serialized_end=14309
-  _FILEPUSHERSTATS._serialized_start=14311
-  _FILEPUSHERSTATS._serialized_end=14396
-  _FILESUPLOADED._serialized_start=14398
-  _FILESUPLOADED._serialized_end=14428
-  _FILETRANSFERINFOREQUEST._serialized_start=14431
-  _FILETRANSFERINFOREQUEST._serialized_end=14675
-  _FILETRANSFERINFOREQUEST_TRANSFERTYPE._serialized_start=14635
-  _FILETRANSFERINFOREQUEST_TRANSFERTYPE._serialized_end=14675
-  _LOCALINFO._serialized_start=14677
-  _LOCALINFO._serialized_end=14726
-  _SHUTDOWNREQUEST._serialized_start=14728
-  _SHUTDOWNREQUEST._serialized_end=14791
-  _SHUTDOWNRESPONSE._serialized_start=14793
-  _SHUTDOWNRESPONSE._serialized_end=14811
-  _ATTACHREQUEST._serialized_start=14813
-  _ATTACHREQUEST._serialized_end=14893
-  _ATTACHRESPONSE._serialized_start=14895
-  _ATTACHRESPONSE._serialized_end=14993
-  _TESTINJECTREQUEST._serialized_start=14996
-  _TESTINJECTREQUEST._serialized_end=15337
-  _TESTINJECTRESPONSE._serialized_start=15339
-  _TESTINJECTRESPONSE._serialized_end=15359
-  _HISTORYACTION._serialized_start=15361
-  _HISTORYACTION._serialized_end=15391
-  _PARTIALHISTORYREQUEST._serialized_start=15394
-  _PARTIALHISTORYREQUEST._serialized_end=15596
-  _PARTIALHISTORYRESPONSE._serialized_start=15598
-  _PARTIALHISTORYRESPONSE._serialized_end=15622
-  _SAMPLEDHISTORYREQUEST._serialized_start=15624
-  _SAMPLEDHISTORYREQUEST._serialized_end=15693
-  _SAMPLEDHISTORYITEM._serialized_start=15695
-  _SAMPLEDHISTORYITEM._serialized_end=15790
-  _SAMPLEDHISTORYRESPONSE._serialized_start=15792
-  _SAMPLEDHISTORYRESPONSE._serialized_end=15866
-  _RUNSTATUSREQUEST._serialized_start=15868
-  _RUNSTATUSREQUEST._serialized_end=15932
-  _RUNSTATUSRESPONSE._serialized_start=15934
-  _RUNSTATUSRESPONSE._serialized_end=16054
-  _RUNSTARTREQUEST._serialized_start=16056
-  _RUNSTARTREQUEST._serialized_end=16159
-  _RUNSTARTRESPONSE._serialized_start=16161
-  _RUNSTARTRESPONSE._serialized_end=16179
-  _CHECKVERSIONREQUEST._serialized_start=16181
-  _CHECKVERSIONREQUEST._serialized_end=16273
-  _CHECKVERSIONRESPONSE._serialized_start=16275
-  _CHECKVERSIONRESPONSE._serialized_end=16368
-  _JOBINFOREQUEST._serialized_start=16370
-  _JOBINFOREQUEST._serialized_end=16432
-  _JOBINFORESPONSE._serialized_start=16434
-  _JOBINFORESPONSE._serialized_end=16488
-  _LOGARTIFACTREQUEST._serialized_start=16491
-  _LOGARTIFACTREQUEST._serialized_end=16650
-  _LOGARTIFACTRESPONSE._serialized_start=16652
-  _LOGARTIFACTRESPONSE._serialized_end=16717
-  _DOWNLOADARTIFACTREQUEST._serialized_start=16720
-  _DOWNLOADARTIFACTREQUEST._serialized_end=16869
-  _DOWNLOADARTIFACTRESPONSE._serialized_start=16871
-  _DOWNLOADARTIFACTRESPONSE._serialized_end=16920
-  _KEEPALIVEREQUEST._serialized_start=16922
-  _KEEPALIVEREQUEST._serialized_end=16986
-  _KEEPALIVERESPONSE._serialized_start=16988
-  _KEEPALIVERESPONSE._serialized_end=17007
-  _ARTIFACTINFO._serialized_start=17009
-  _ARTIFACTINFO._serialized_end=17079
-  _GITINFO._serialized_start=17081
-  _GITINFO._serialized_end=17122
-  _GITSOURCE._serialized_start=17124
-  _GITSOURCE._serialized_end=17216
-  _IMAGESOURCE._serialized_start=17218
-  _IMAGESOURCE._serialized_end=17246
-  _SOURCE._serialized_start=17249
-  _SOURCE._serialized_end=17389
-  _JOBSOURCE._serialized_start=17391
-  _JOBSOURCE._serialized_end=17498
-  _PARTIALJOBARTIFACT._serialized_start=17500
-  _PARTIALJOBARTIFACT._serialized_end=17586
-  _USEARTIFACTRECORD._serialized_start=17589
-  _USEARTIFACTRECORD._serialized_end=17746
-  _USEARTIFACTRESULT._serialized_start=17748
-  _USEARTIFACTRESULT._serialized_end=17767
-  _CANCELREQUEST._serialized_start=17769
-  _CANCELREQUEST._serialized_end=17851
-  _CANCELRESPONSE._serialized_start=17853
-  _CANCELRESPONSE._serialized_end=17869
-  _DISKINFO._serialized_start=17871
-  _DISKINFO._serialized_end=17910
-  _MEMORYINFO._serialized_start=17912
-  _MEMORYINFO._serialized_end=17939
-  _CPUINFO._serialized_start=17941
-  _CPUINFO._serialized_end=17988
-  _GPUAPPLEINFO._serialized_start=17990
-  _GPUAPPLEINFO._serialized_end=18052
-  _GPUNVIDIAINFO._serialized_start=18054
-  _GPUNVIDIAINFO._serialized_end=18105
-  _GPUAMDINFO._serialized_start=18108
-  _GPUAMDINFO._serialized_end=18373
-  _METADATAREQUEST._serialized_start=18376
-  _METADATAREQUEST._serialized_end=19422
-  _METADATAREQUEST_DISKENTRY._serialized_start=19307
-  _METADATAREQUEST_DISKENTRY._serialized_end=19376
-  _METADATAREQUEST_SLURMENTRY._serialized_start=19378
-  _METADATAREQUEST_SLURMENTRY._serialized_end=19422
-  _PYTHONPACKAGESREQUEST._serialized_start=19425
-  _PYTHONPACKAGESREQUEST._serialized_end=19566
-  _PYTHONPACKAGESREQUEST_PYTHONPACKAGE._serialized_start=19520
-  _PYTHONPACKAGESREQUEST_PYTHONPACKAGE._serialized_end=19566
+  _RECORD._serialized_end=1487
+  _CONTROL._serialized_start=1490
+  _CONTROL._serialized_end=1714
+  _RESULT._serialized_start=1717
+  _RESULT._serialized_end=2216
+  _FINALRECORD._serialized_start=2218
+  _FINALRECORD._serialized_end=2276
+  _VERSIONINFO._serialized_start=2278
+  _VERSIONINFO._serialized_end=2376
+  _HEADERRECORD._serialized_start=2378
+  _HEADERRECORD._serialized_end=2488
+  _FOOTERRECORD._serialized_start=2491
+  _FOOTERRECORD._serialized_end=2674
+  _FOOTERRECORD_DROPPEDRECORDS._serialized_start=2622
+  _FOOTERRECORD_DROPPEDRECORDS._serialized_end=2674
+  _RUNRECORD._serialized_start=2677
+  _RUNRECORD._serialized_end=3267
+  _GITREPORECORD._serialized_start=3269
+  _GITREPORECORD._serialized_end=3328
+  _RUNUPDATERESULT._serialized_start=3330
+  _RUNUPDATERESULT._serialized_end=3429
+  _ERRORINFO._serialized_start=3432
+  _ERRORINFO._serialized_end=3660
+  _ERRORINFO_ERRORCODE._serialized_start=3514
+  _ERRORINFO_ERRORCODE._serialized_end=3660
+  _RUNEXITRECORD._serialized_start=3662
+  _RUNEXITRECORD._serialized_end=3758
+  _RUNEXITRESULT._serialized_start=3761
+  _RUNEXITRESULT._serialized_end=3902
+  _RUNPREEMPTINGRECORD._serialized_start=3904
+  _RUNPREEMPTINGRECORD._serialized_end=3970
+  _RUNPREEMPTINGRESULT._serialized_start=3972
+  _RUNPREEMPTINGRESULT._serialized_end=3993
+  _SETTINGSRECORD._serialized_start=3995
+  _SETTINGSRECORD._serialized_end=4100
+  _SETTINGSITEM._serialized_start=4102
+  _SETTINGSITEM._serialized_end=4149
+  _HISTORYSTEP._serialized_start=4151
+  _HISTORYSTEP._serialized_end=4177
+  _HISTORYRECORD._serialized_start=4180
+  _HISTORYRECORD._serialized_end=4326
+  _HISTORYITEM._serialized_start=4328
+  _HISTORYITEM._serialized_end=4394
+  _HISTORYRESULT._serialized_start=4396
+  _HISTORYRESULT._serialized_end=4411
+  _OUTPUTRECORD._serialized_start=4414
+  _OUTPUTRECORD._serialized_end=4634
+  _OUTPUTRECORD_OUTPUTTYPE._serialized_start=4598
+  _OUTPUTRECORD_OUTPUTTYPE._serialized_end=4634
+  _OUTPUTRESULT._serialized_start=4636
+  _OUTPUTRESULT._serialized_end=4650
+  _OUTPUTRAWRECORD._serialized_start=4653
+  _OUTPUTRAWRECORD._serialized_end=4879
+  _OUTPUTRAWRECORD_OUTPUTTYPE._serialized_start=4598
+  _OUTPUTRAWRECORD_OUTPUTTYPE._serialized_end=4634
+  _OUTPUTRAWRESULT._serialized_start=4881
+  _OUTPUTRAWRESULT._serialized_end=4898
+  _METRICRECORD._serialized_start=4901
+  _METRICRECORD._serialized_end=5309
+  _METRICRECORD_METRICGOAL._serialized_start=5243
+  _METRICRECORD_METRICGOAL._serialized_end=5309
+  _METRICRESULT._serialized_start=5311
+  _METRICRESULT._serialized_end=5325
+  _METRICOPTIONS._serialized_start=5327
+  _METRICOPTIONS._serialized_end=5394
+  _METRICCONTROL._serialized_start=5396
+  _METRICCONTROL._serialized_end=5430
+  _METRICSUMMARY._serialized_start=5432
+  _METRICSUMMARY._serialized_end=5543
+  _CONFIGRECORD._serialized_start=5546
+  _CONFIGRECORD._serialized_end=5693
+  _CONFIGITEM._serialized_start=5695
+  _CONFIGITEM._serialized_end=5760
+  _CONFIGRESULT._serialized_start=5762
+  _CONFIGRESULT._serialized_end=5776
+  _SUMMARYRECORD._serialized_start=5779
+  _SUMMARYRECORD._serialized_end=5929
+  _SUMMARYITEM._serialized_start=5931
+  _SUMMARYITEM._serialized_end=5997
+  _SUMMARYRESULT._serialized_start=5999
+  _SUMMARYRESULT._serialized_end=6014
+  _FILESRECORD._serialized_start=6016
+  _FILESRECORD._serialized_end=6116
+  _FILESITEM._serialized_start=6119
+  _FILESITEM._serialized_end=6372
+  _FILESITEM_POLICYTYPE._serialized_start=6273
+  _FILESITEM_POLICYTYPE._serialized_end=6313
+  _FILESITEM_FILETYPE._serialized_start=6315
+  _FILESITEM_FILETYPE._serialized_end=6372
+  _FILESRESULT._serialized_start=6374
+  _FILESRESULT._serialized_end=6387
+  _STATSRECORD._serialized_start=6390
+  _STATSRECORD._serialized_end=6620
+  _STATSRECORD_STATSTYPE._serialized_start=6597
+  _STATSRECORD_STATSTYPE._serialized_end=6620
+  _STATSITEM._serialized_start=6622
+  _STATSITEM._serialized_end=6666
+  _ARTIFACTRECORD._serialized_start=6669
+  _ARTIFACTRECORD._serialized_end=7142
+  _ARTIFACTMANIFEST._serialized_start=7145
+  _ARTIFACTMANIFEST._serialized_end=7333
+  _ARTIFACTMANIFESTENTRY._serialized_start=7336
+  _ARTIFACTMANIFESTENTRY._serialized_end=7523
+  _EXTRAITEM._serialized_start=7525
+  _EXTRAITEM._serialized_end=7569
+  _STORAGEPOLICYCONFIGITEM._serialized_start=7571
+  _STORAGEPOLICYCONFIGITEM._serialized_end=7629
+  _ARTIFACTRESULT._serialized_start=7631
+  _ARTIFACTRESULT._serialized_end=7647
+  _LINKARTIFACTRESULT._serialized_start=7649
+  _LINKARTIFACTRESULT._serialized_end=7669
+  _LINKARTIFACTRECORD._serialized_start=7672
+  _LINKARTIFACTRECORD._serialized_end=7879
+  _TBRECORD._serialized_start=7881
+  _TBRECORD._serialized_end=7985
+  _TBRESULT._serialized_start=7987
+  _TBRESULT._serialized_end=7997
+  _ALERTRECORD._serialized_start=7999
+  _ALERTRECORD._serialized_end=8124
+  _ALERTRESULT._serialized_start=8126
+  _ALERTRESULT._serialized_end=8139
+  _REQUEST._serialized_start=8142
+  _REQUEST._serialized_end=10666
+  _RESPONSE._serialized_start=10669
+  _RESPONSE._serialized_end=12518
+  _DEFERREQUEST._serialized_start=12521
+  _DEFERREQUEST._serialized_end=12841
+  _DEFERREQUEST_DEFERSTATE._serialized_start=12594
+  _DEFERREQUEST_DEFERSTATE._serialized_end=12841
+  _PAUSEREQUEST._serialized_start=12843
+  _PAUSEREQUEST._serialized_end=12903
+  _PAUSERESPONSE._serialized_start=12905
+  _PAUSERESPONSE._serialized_end=12920
+  _RESUMEREQUEST._serialized_start=12922
+  _RESUMEREQUEST._serialized_end=12983
+  _RESUMERESPONSE._serialized_start=12985
+  _RESUMERESPONSE._serialized_end=13001
+  _LOGINREQUEST._serialized_start=13003
+  _LOGINREQUEST._serialized_end=13080
+  _LOGINRESPONSE._serialized_start=13082
+  _LOGINRESPONSE._serialized_end=13120
+  _GETSUMMARYREQUEST._serialized_start=13122
+  _GETSUMMARYREQUEST._serialized_end=13187
+  _GETSUMMARYRESPONSE._serialized_start=13189
+  _GETSUMMARYRESPONSE._serialized_end=13252
+  _GETSYSTEMMETRICSREQUEST._serialized_start=13254
+  _GETSYSTEMMETRICSREQUEST._serialized_end=13325
+  _SYSTEMMETRICSAMPLE._serialized_start=13327
+  _SYSTEMMETRICSAMPLE._serialized_end=13409
+  _SYSTEMMETRICSBUFFER._serialized_start=13411
+  _SYSTEMMETRICSBUFFER._serialized_end=13484
+  _GETSYSTEMMETRICSRESPONSE._serialized_start=13487
+  _GETSYSTEMMETRICSRESPONSE._serialized_end=13689
+  _GETSYSTEMMETRICSRESPONSE_SYSTEMMETRICSENTRY._serialized_start=13600
+  _GETSYSTEMMETRICSRESPONSE_SYSTEMMETRICSENTRY._serialized_end=13689
+  _STATUSREQUEST._serialized_start=13691
+  _STATUSREQUEST._serialized_end=13752
+  _STATUSRESPONSE._serialized_start=13754
+  _STATUSRESPONSE._serialized_end=13795
+  _STOPSTATUSREQUEST._serialized_start=13797
+  _STOPSTATUSREQUEST._serialized_end=13862
+  _STOPSTATUSRESPONSE._serialized_start=13864
+  _STOPSTATUSRESPONSE._serialized_end=13954
+  _NETWORKSTATUSREQUEST._serialized_start=13956
+  _NETWORKSTATUSREQUEST._serialized_end=14024
+  _NETWORKSTATUSRESPONSE._serialized_start=14026
+  _NETWORKSTATUSRESPONSE._serialized_end=14106
+  _HTTPRESPONSE._serialized_start=14108
+  _HTTPRESPONSE._serialized_end=14176
+  _INTERNALMESSAGESREQUEST._serialized_start=14178
+  _INTERNALMESSAGESREQUEST._serialized_end=14249
+  _INTERNALMESSAGESRESPONSE._serialized_start=14251
+  _INTERNALMESSAGESRESPONSE._serialized_end=14329
+  _INTERNALMESSAGES._serialized_start=14331
+  _INTERNALMESSAGES._serialized_end=14434
+  _POLLEXITREQUEST._serialized_start=14436
+  _POLLEXITREQUEST._serialized_end=14499
+  _POLLEXITRESPONSE._serialized_start=14502
+  _POLLEXITRESPONSE._serialized_end=14690
+  _SYNCOVERWRITE._serialized_start=14692
+  _SYNCOVERWRITE._serialized_end=14756
+  _SYNCSKIP._serialized_start=14758
+  _SYNCSKIP._serialized_end=14788
+  _SENDERMARKREQUEST._serialized_start=14790
+  _SENDERMARKREQUEST._serialized_end=14809
+  _SYNCREQUEST._serialized_start=14812
+  _SYNCREQUEST._serialized_end=14992
+  _SYNCRESPONSE._serialized_start=14994
+  _SYNCRESPONSE._serialized_end=15116
+  _SENDERREADREQUEST._serialized_start=15118
+  _SENDERREADREQUEST._serialized_end=15181
+  _STATUSREPORTREQUEST._serialized_start=15183
+  _STATUSREPORTREQUEST._serialized_end=15292
+  _SUMMARYRECORDREQUEST._serialized_start=15294
+  _SUMMARYRECORDREQUEST._serialized_end=15364
+  _TELEMETRYRECORDREQUEST._serialized_start=15366
+  _TELEMETRYRECORDREQUEST._serialized_end=15442
+  _SERVERINFOREQUEST._serialized_start=15444
+  _SERVERINFOREQUEST._serialized_end=15509
+  _SERVERINFORESPONSE._serialized_start=15511
+  _SERVERINFORESPONSE._serialized_end=15635
+  _SERVERMESSAGES._serialized_start=15637
+  _SERVERMESSAGES._serialized_end=15698
+  _SERVERMESSAGE._serialized_start=15700
+  _SERVERMESSAGE._serialized_end=15801
+  _FILECOUNTS._serialized_start=15803
+  _FILECOUNTS._serialized_end=15902
+  _FILEPUSHERSTATS._serialized_start=15904
+  _FILEPUSHERSTATS._serialized_end=15989
+  _FILESUPLOADED._serialized_start=15991
+  _FILESUPLOADED._serialized_end=16021
+  _FILETRANSFERINFOREQUEST._serialized_start=16024
+  _FILETRANSFERINFOREQUEST._serialized_end=16268
+  _FILETRANSFERINFOREQUEST_TRANSFERTYPE._serialized_start=16228
+  _FILETRANSFERINFOREQUEST_TRANSFERTYPE._serialized_end=16268
+  _LOCALINFO._serialized_start=16270
+  _LOCALINFO._serialized_end=16319
+  _SHUTDOWNREQUEST._serialized_start=16321
+  _SHUTDOWNREQUEST._serialized_end=16384
+  _SHUTDOWNRESPONSE._serialized_start=16386
+  _SHUTDOWNRESPONSE._serialized_end=16404
+  _ATTACHREQUEST._serialized_start=16406
+  _ATTACHREQUEST._serialized_end=16486
+  _ATTACHRESPONSE._serialized_start=16488
+  _ATTACHRESPONSE._serialized_end=16586
+  _TESTINJECTREQUEST._serialized_start=16589
+  _TESTINJECTREQUEST._serialized_end=16930
+  _TESTINJECTRESPONSE._serialized_start=16932
+  _TESTINJECTRESPONSE._serialized_end=16952
+  _HISTORYACTION._serialized_start=16954
+  _HISTORYACTION._serialized_end=16984
+  _PARTIALHISTORYREQUEST._serialized_start=16987
+  _PARTIALHISTORYREQUEST._serialized_end=17189
+  _PARTIALHISTORYRESPONSE._serialized_start=17191
+  _PARTIALHISTORYRESPONSE._serialized_end=17215
+  _SAMPLEDHISTORYREQUEST._serialized_start=17217
+  _SAMPLEDHISTORYREQUEST._serialized_end=17286
+  _SAMPLEDHISTORYITEM._serialized_start=17288
+  _SAMPLEDHISTORYITEM._serialized_end=17383
+  _SAMPLEDHISTORYRESPONSE._serialized_start=17385
+  _SAMPLEDHISTORYRESPONSE._serialized_end=17459
+  _RUNSTATUSREQUEST._serialized_start=17461
+  _RUNSTATUSREQUEST._serialized_end=17525
+  _RUNSTATUSRESPONSE._serialized_start=17527
+  _RUNSTATUSRESPONSE._serialized_end=17647
+  _RUNSTARTREQUEST._serialized_start=17649
+  _RUNSTARTREQUEST._serialized_end=17752
+  _RUNSTARTRESPONSE._serialized_start=17754
+  _RUNSTARTRESPONSE._serialized_end=17772
+  _CHECKVERSIONREQUEST._serialized_start=17774
+  _CHECKVERSIONREQUEST._serialized_end=17866
+  _CHECKVERSIONRESPONSE._serialized_start=17868
+  _CHECKVERSIONRESPONSE._serialized_end=17961
+  _JOBINFOREQUEST._serialized_start=17963
+  _JOBINFOREQUEST._serialized_end=18025
+  _JOBINFORESPONSE._serialized_start=18027
+  _JOBINFORESPONSE._serialized_end=18081
+  _LOGARTIFACTREQUEST._serialized_start=18084
+  _LOGARTIFACTREQUEST._serialized_end=18243
+  _LOGARTIFACTRESPONSE._serialized_start=18245
+  _LOGARTIFACTRESPONSE._serialized_end=18310
+  _DOWNLOADARTIFACTREQUEST._serialized_start=18313
+  _DOWNLOADARTIFACTREQUEST._serialized_end=18462
+  _DOWNLOADARTIFACTRESPONSE._serialized_start=18464
+  _DOWNLOADARTIFACTRESPONSE._serialized_end=18513
+  _KEEPALIVEREQUEST._serialized_start=18515
+  _KEEPALIVEREQUEST._serialized_end=18579
+  _KEEPALIVERESPONSE._serialized_start=18581
+  _KEEPALIVERESPONSE._serialized_end=18600
+  _ARTIFACTINFO._serialized_start=18602
+  _ARTIFACTINFO._serialized_end=18672
+  _GITINFO._serialized_start=18674
+  _GITINFO._serialized_end=18715
+  _GITSOURCE._serialized_start=18717
+  _GITSOURCE._serialized_end=18809
+  _IMAGESOURCE._serialized_start=18811
+  _IMAGESOURCE._serialized_end=18839
+  _SOURCE._serialized_start=18842
+  _SOURCE._serialized_end=18982
+  _JOBSOURCE._serialized_start=18984
+  _JOBSOURCE._serialized_end=19091
+  _PARTIALJOBARTIFACT._serialized_start=19093
+  _PARTIALJOBARTIFACT._serialized_end=19179
+  _USEARTIFACTRECORD._serialized_start=19182
+  _USEARTIFACTRECORD._serialized_end=19339
+  _USEARTIFACTRESULT._serialized_start=19341
+  _USEARTIFACTRESULT._serialized_end=19360
+  _CANCELREQUEST._serialized_start=19362
+  _CANCELREQUEST._serialized_end=19444
+  _CANCELRESPONSE._serialized_start=19446
+  _CANCELRESPONSE._serialized_end=19462
+  _DISKINFO._serialized_start=19464
+  _DISKINFO._serialized_end=19503
+  _MEMORYINFO._serialized_start=19505
+  _MEMORYINFO._serialized_end=19532
+  _CPUINFO._serialized_start=19534
+  _CPUINFO._serialized_end=19581
+  _GPUAPPLEINFO._serialized_start=19583
+  _GPUAPPLEINFO._serialized_end=19645
+  _GPUNVIDIAINFO._serialized_start=19647
+  _GPUNVIDIAINFO._serialized_end=19698
+  _GPUAMDINFO._serialized_start=19701
+  _GPUAMDINFO._serialized_end=19966
+  _METADATAREQUEST._serialized_start=19969
+  _METADATAREQUEST._serialized_end=21071
+  _METADATAREQUEST_DISKENTRY._serialized_start=20956
+  _METADATAREQUEST_DISKENTRY._serialized_end=21025
+  _METADATAREQUEST_SLURMENTRY._serialized_start=21027
+  _METADATAREQUEST_SLURMENTRY._serialized_end=21071
+  _PYTHONPACKAGESREQUEST._serialized_start=21074
+  _PYTHONPACKAGESREQUEST._serialized_end=21215
+  _PYTHONPACKAGESREQUEST_PYTHONPACKAGE._serialized_start=21169
+  _PYTHONPACKAGESREQUEST_PYTHONPACKAGE._serialized_end=21215
+  _VERIFYREPORT._serialized_start=21218
+  _VERIFYREPORT._serialized_end=21512
+  _VERIFYREPORT_RECORDCOUNT._serialized_start=21427
+  _VERIFYREPORT_RECORDCOUNT._serialized_end=21476
+  _VERIFYREPORT_GAP._serialized_start=21478
+  _VERIFYREPORT_GAP._serialized_end=21512
+  _SNAPSHOTRECORD._serialized_start=21515
+  _SNAPSHOTRECORD._serialized_end=21724
+  _STORESTATUSREQUEST._serialized_start=21726
+  _STORESTATUSREQUEST._serialized_end=21777
+  _CIRCUITBREAKERREQUEST._serialized_start=21779
+  _CIRCUITBREAKERREQUEST._serialized_end=21861
+  _RUNSTOPPEDREQUEST._serialized_start=21863
+  _RUNSTOPPEDREQUEST._serialized_end=21882
+  _RUNMOVEREQUEST._serialized_start=21884
+  _RUNMOVEREQUEST._serialized_end=22009
+  _RUNMOVERESPONSE._serialized_start=22011
+  _RUNMOVERESPONSE._serialized_end=22110
+  _PREFLIGHTREQUEST._serialized_start=22112
+  _PREFLIGHTREQUEST._serialized_end=22176
+  _PREFLIGHTCHECK._serialized_start=22178
+  _PREFLIGHTCHECK._serialized_end=22293
+  _PREFLIGHTRESPONSE._serialized_start=22295
+  _PREFLIGHTRESPONSE._serialized_end=22374
+  _DERIVEDMETRICREQUEST._serialized_start=22376
+  _DERIVEDMETRICREQUEST._serialized_end=22478
+  _DERIVEDMETRICRESPONSE._serialized_start=22480
+  _DERIVEDMETRICRESPONSE._serialized_end=22545
+  _INVALIDHISTORYVALUE._serialized_start=22547
+  _INVALIDHISTORYVALUE._serialized_end=22630
+  _ENVIRONMENTRECORD._serialized_start=22633
+  _ENVIRONMENTRECORD._serialized_end=23045
+  _ENVIRONMENTRECORD_LAUNCHENTRY._serialized_start=23000
+  _ENVIRONMENTRECORD_LAUNCHENTRY._serialized_end=23045
+  _STOPCONDITIONREQUEST._serialized_start=23048
+  _STOPCONDITIONREQUEST._serialized_end=23359
+  _STOPCONDITIONREQUEST_KIND._serialized_start=23325
+  _STOPCONDITIONREQUEST_KIND._serialized_end=23359
+  _STOPCONDITIONRESPONSE._serialized_start=23361
+  _STOPCONDITIONRESPONSE._serialized_end=23426
+  _TABLERECORD._serialized_start=23429
+  _TABLERECORD._serialized_end=23568
+  _FLOWCREDITREQUEST._serialized_start=23570
+  _FLOWCREDITREQUEST._serialized_end=23649
+  _FLOWCREDITRESPONSE._serialized_start=23651
+  _FLOWCREDITRESPONSE._serialized_end=23688
+  _GOONLINEREQUEST._serialized_start=23690
+  _GOONLINEREQUEST._serialized_end=23753
 # @@protoc_insertion_point(module_scope)
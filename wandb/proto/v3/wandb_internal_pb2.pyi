@@ -54,6 +54,9 @@ class Record(google.protobuf.message.Message):
     LINK_ARTIFACT_FIELD_NUMBER: builtins.int
     USE_ARTIFACT_FIELD_NUMBER: builtins.int
     REQUEST_FIELD_NUMBER: builtins.int
+    SNAPSHOT_FIELD_NUMBER: builtins.int
+    ENVIRONMENT_FIELD_NUMBER: builtins.int
+    TABLE_FIELD_NUMBER: builtins.int
     CONTROL_FIELD_NUMBER: builtins.int
     UUID_FIELD_NUMBER: builtins.int
     _INFO_FIELD_NUMBER: builtins.int
@@ -104,6 +107,12 @@ class Record(google.protobuf.message.Message):
     def request(self) -> global___Request:
         """request field does not belong here longterm"""
     @property
+    def snapshot(self) -> global___SnapshotRecord: ...
+    @property
+    def environment(self) -> global___EnvironmentRecord: ...
+    @property
+    def table(self) -> global___TableRecord: ...
+    @property
     def control(self) -> global___Control: ...
     uuid: builtins.str
     @property
@@ -133,13 +142,16 @@ class Record(google.protobuf.message.Message):
         link_artifact: global___LinkArtifactRecord | None = ...,
         use_artifact: global___UseArtifactRecord | None = ...,
         request: global___Request | None = ...,
+        snapshot: global___SnapshotRecord | None = ...,
+        environment: global___EnvironmentRecord | None = ...,
+        table: global___TableRecord | None = ...,
         control: global___Control | None = ...,
         uuid: builtins.str = ...,
         _info: wandb.proto.wandb_base_pb2._RecordInfo | None = ...,
     ) -> None: ...
-    def HasField(self, field_name: typing_extensions.Literal["_info", b"_info", "alert", b"alert", "artifact", b"artifact", "config", b"config", "control", b"control", "exit", b"exit", "files", b"files", "final", b"final", "footer", b"footer", "header", b"header", "history", b"history", "link_artifact", b"link_artifact", "metric", b"metric", "output", b"output", "output_raw", b"output_raw", "preempting", b"preempting", "record_type", b"record_type", "request", b"request", "run", b"run", "stats", b"stats", "summary", b"summary", "tbrecord", b"tbrecord", "telemetry", b"telemetry", "use_artifact", b"use_artifact"]) -> builtins.bool: ...
-    def ClearField(self, field_name: typing_extensions.Literal["_info", b"_info", "alert", b"alert", "artifact", b"artifact", "config", b"config", "control", b"control", "exit", b"exit", "files", b"files", "final", b"final", "footer", b"footer", "header", b"header", "history", b"history", "link_artifact", b"link_artifact", "metric", b"metric", "num", b"num", "output", b"output", "output_raw", b"output_raw", "preempting", b"preempting", "record_type", b"record_type", "request", b"request", "run", b"run", "stats", b"stats", "summary", b"summary", "tbrecord", b"tbrecord", "telemetry", b"telemetry", "use_artifact", b"use_artifact", "uuid", b"uuid"]) -> None: ...
-    def WhichOneof(self, oneof_group: typing_extensions.Literal["record_type", b"record_type"]) -> typing_extensions.Literal["history", "summary", "output", "config", "files", "stats", "artifact", "tbrecord", "alert", "telemetry", "metric", "output_raw", "run", "exit", "final", "header", "footer", "preempting", "link_artifact", "use_artifact", "request"] | None: ...
+    def HasField(self, field_name: typing_extensions.Literal["_info", b"_info", "alert", b"alert", "artifact", b"artifact", "config", b"config", "control", b"control", "environment", b"environment", "exit", b"exit", "files", b"files", "final", b"final", "footer", b"footer", "header", b"header", "history", b"history", "link_artifact", b"link_artifact", "metric", b"metric", "output", b"output", "output_raw", b"output_raw", "preempting", b"preempting", "record_type", b"record_type", "request", b"request", "run", b"run", "snapshot", b"snapshot", "stats", b"stats", "summary", b"summary", "table", b"table", "tbrecord", b"tbrecord", "telemetry", b"telemetry", "use_artifact", b"use_artifact"]) -> builtins.bool: ...
+    def ClearField(self, field_name: typing_extensions.Literal["_info", b"_info", "alert", b"alert", "artifact", b"artifact", "config", b"config", "control", b"control", "environment", b"environment", "exit", b"exit", "files", b"files", "final", b"final", "footer", b"footer", "header", b"header", "history", b"history", "link_artifact", b"link_artifact", "metric", b"metric", "num", b"num", "output", b"output", "output_raw", b"output_raw", "preempting", b"preempting", "record_type", b"record_type", "request", b"request", "run", b"run", "snapshot", b"snapshot", "stats", b"stats", "summary", b"summary", "table", b"table", "tbrecord", b"tbrecord", "telemetry", b"telemetry", "use_artifact", b"use_artifact", "uuid", b"uuid"]) -> None: ...
+    def WhichOneof(self, oneof_group: typing_extensions.Literal["record_type", b"record_type"]) -> typing_extensions.Literal["history", "summary", "output", "config", "files", "stats", "artifact", "tbrecord", "alert", "telemetry", "metric", "output_raw", "run", "exit", "final", "header", "footer", "preempting", "link_artifact", "use_artifact", "request", "snapshot", "environment", "table"] | None: ...
 
 global___Record = Record
 
@@ -154,6 +166,9 @@ class Control(google.protobuf.message.Message):
     FLOW_CONTROL_FIELD_NUMBER: builtins.int
     END_OFFSET_FIELD_NUMBER: builtins.int
     CONNECTION_ID_FIELD_NUMBER: builtins.int
+    RANK_FIELD_NUMBER: builtins.int
+    CAPTURE_MICROS_FIELD_NUMBER: builtins.int
+    LOOPBACK_FIELD_NUMBER: builtins.int
     req_resp: builtins.bool
     """record is expecting a result"""
     local: builtins.bool
@@ -170,6 +185,12 @@ class Control(google.protobuf.message.Message):
     """end of message offset of this written message"""
     connection_id: builtins.str
     """connection id"""
+    rank: builtins.str
+    """rank of the writer of a shared run"""
+    capture_micros: builtins.int
+    """time the record was captured, in microseconds"""
+    loopback: builtins.bool
+    """record was sent by the stream to itself"""
     def __init__(
         self,
         *,
@@ -181,8 +202,11 @@ class Control(google.protobuf.message.Message):
         flow_control: builtins.bool = ...,
         end_offset: builtins.int = ...,
         connection_id: builtins.str = ...,
+        rank: builtins.str = ...,
+        capture_micros: builtins.int = ...,
+        loopback: builtins.bool = ...,
     ) -> None: ...
-    def ClearField(self, field_name: typing_extensions.Literal["always_send", b"always_send", "connection_id", b"connection_id", "end_offset", b"end_offset", "flow_control", b"flow_control", "local", b"local", "mailbox_slot", b"mailbox_slot", "relay_id", b"relay_id", "req_resp", b"req_resp"]) -> None: ...
+    def ClearField(self, field_name: typing_extensions.Literal["always_send", b"always_send", "capture_micros", b"capture_micros", "connection_id", b"connection_id", "end_offset", b"end_offset", "flow_control", b"flow_control", "local", b"local", "loopback", b"loopback", "mailbox_slot", b"mailbox_slot", "rank", b"rank", "relay_id", b"relay_id", "req_resp", b"req_resp"]) -> None: ...
 
 global___Control = Control
 
@@ -322,16 +346,39 @@ class FooterRecord(google.protobuf.message.Message):
 
     DESCRIPTOR: google.protobuf.descriptor.Descriptor
 
+    class DroppedRecords(google.protobuf.message.Message):
+        """records the writer dropped because it could not store them in time
+        when the run was closed, by record type
+        """
+
+        DESCRIPTOR: google.protobuf.descriptor.Descriptor
+
+        RECORD_TYPE_FIELD_NUMBER: builtins.int
+        COUNT_FIELD_NUMBER: builtins.int
+        record_type: builtins.str
+        count: builtins.int
+        def __init__(
+            self,
+            *,
+            record_type: builtins.str = ...,
+            count: builtins.int = ...,
+        ) -> None: ...
+        def ClearField(self, field_name: typing_extensions.Literal["count", b"count", "record_type", b"record_type"]) -> None: ...
+
     _INFO_FIELD_NUMBER: builtins.int
+    DROPPED_RECORDS_FIELD_NUMBER: builtins.int
     @property
     def _info(self) -> wandb.proto.wandb_base_pb2._RecordInfo: ...
+    @property
+    def dropped_records(self) -> google.protobuf.internal.containers.RepeatedCompositeFieldContainer[global___FooterRecord.DroppedRecords]: ...
     def __init__(
         self,
         *,
         _info: wandb.proto.wandb_base_pb2._RecordInfo | None = ...,
+        dropped_records: collections.abc.Iterable[global___FooterRecord.DroppedRecords] | None = ...,
     ) -> None: ...
     def HasField(self, field_name: typing_extensions.Literal["_info", b"_info"]) -> builtins.bool: ...
-    def ClearField(self, field_name: typing_extensions.Literal["_info", b"_info"]) -> None: ...
+    def ClearField(self, field_name: typing_extensions.Literal["_info", b"_info", "dropped_records", b"dropped_records"]) -> None: ...
 
 global___FooterRecord = FooterRecord
 
@@ -473,6 +520,9 @@ class ErrorInfo(google.protobuf.message.Message):
         AUTHENTICATION: ErrorInfo._ErrorCode.ValueType  # 2
         USAGE: ErrorInfo._ErrorCode.ValueType  # 3
         UNSUPPORTED: ErrorInfo._ErrorCode.ValueType  # 4
+        QUOTA_EXCEEDED: ErrorInfo._ErrorCode.ValueType  # 5
+        PROJECT_NOT_FOUND: ErrorInfo._ErrorCode.ValueType  # 6
+        SERVER: ErrorInfo._ErrorCode.ValueType  # 7
 
     class ErrorCode(_ErrorCode, metaclass=_ErrorCodeEnumTypeWrapper): ...
     UNKNOWN: ErrorInfo.ErrorCode.ValueType  # 0
@@ -480,6 +530,9 @@ class ErrorInfo(google.protobuf.message.Message):
     AUTHENTICATION: ErrorInfo.ErrorCode.ValueType  # 2
     USAGE: ErrorInfo.ErrorCode.ValueType  # 3
     UNSUPPORTED: ErrorInfo.ErrorCode.ValueType  # 4
+    QUOTA_EXCEEDED: ErrorInfo.ErrorCode.ValueType  # 5
+    PROJECT_NOT_FOUND: ErrorInfo.ErrorCode.ValueType  # 6
+    SERVER: ErrorInfo.ErrorCode.ValueType  # 7
 
     MESSAGE_FIELD_NUMBER: builtins.int
     CODE_FIELD_NUMBER: builtins.int
@@ -524,9 +577,32 @@ global___RunExitRecord = RunExitRecord
 class RunExitResult(google.protobuf.message.Message):
     DESCRIPTOR: google.protobuf.descriptor.Descriptor
 
+    PARTIAL_SYNC_FIELD_NUMBER: builtins.int
+    UNSENT_FILES_FIELD_NUMBER: builtins.int
+    UNSENT_ARTIFACTS_FIELD_NUMBER: builtins.int
+    UNSENT_FILE_STREAM_REQUESTS_FIELD_NUMBER: builtins.int
+    SYNC_FILE_FIELD_NUMBER: builtins.int
+    partial_sync: builtins.bool
+    """partial_sync is set if the final flush timed out before all the data
+    was sent, the data left is listed below
+    """
+    @property
+    def unsent_files(self) -> google.protobuf.internal.containers.RepeatedScalarFieldContainer[builtins.str]: ...
+    @property
+    def unsent_artifacts(self) -> google.protobuf.internal.containers.RepeatedScalarFieldContainer[builtins.str]: ...
+    unsent_file_stream_requests: builtins.int
+    sync_file: builtins.str
+    """sync_file is the transaction log to sync the data left from"""
     def __init__(
         self,
+        *,
+        partial_sync: builtins.bool = ...,
+        unsent_files: collections.abc.Iterable[builtins.str] | None = ...,
+        unsent_artifacts: collections.abc.Iterable[builtins.str] | None = ...,
+        unsent_file_stream_requests: builtins.int = ...,
+        sync_file: builtins.str = ...,
     ) -> None: ...
+    def ClearField(self, field_name: typing_extensions.Literal["partial_sync", b"partial_sync", "sync_file", b"sync_file", "unsent_artifacts", b"unsent_artifacts", "unsent_file_stream_requests", b"unsent_file_stream_requests", "unsent_files", b"unsent_files"]) -> None: ...
 
 global___RunExitResult = RunExitResult
 
@@ -1537,6 +1613,15 @@ class Request(google.protobuf.message.Message):
     FILE_TRANSFER_INFO_FIELD_NUMBER: builtins.int
     SYNC_FIELD_NUMBER: builtins.int
     TEST_INJECT_FIELD_NUMBER: builtins.int
+    STORE_STATUS_FIELD_NUMBER: builtins.int
+    CIRCUIT_BREAKER_FIELD_NUMBER: builtins.int
+    RUN_STOPPED_FIELD_NUMBER: builtins.int
+    RUN_MOVE_FIELD_NUMBER: builtins.int
+    PREFLIGHT_FIELD_NUMBER: builtins.int
+    DERIVED_METRIC_FIELD_NUMBER: builtins.int
+    STOP_CONDITION_FIELD_NUMBER: builtins.int
+    FLOW_CREDIT_FIELD_NUMBER: builtins.int
+    GO_ONLINE_FIELD_NUMBER: builtins.int
     @property
     def stop_status(self) -> global___StopStatusRequest: ...
     @property
@@ -1605,6 +1690,24 @@ class Request(google.protobuf.message.Message):
     def sync(self) -> global___SyncRequest: ...
     @property
     def test_inject(self) -> global___TestInjectRequest: ...
+    @property
+    def store_status(self) -> global___StoreStatusRequest: ...
+    @property
+    def circuit_breaker(self) -> global___CircuitBreakerRequest: ...
+    @property
+    def run_stopped(self) -> global___RunStoppedRequest: ...
+    @property
+    def run_move(self) -> global___RunMoveRequest: ...
+    @property
+    def preflight(self) -> global___PreflightRequest: ...
+    @property
+    def derived_metric(self) -> global___DerivedMetricRequest: ...
+    @property
+    def stop_condition(self) -> global___StopConditionRequest: ...
+    @property
+    def flow_credit(self) -> global___FlowCreditRequest: ...
+    @property
+    def go_online(self) -> global___GoOnlineRequest: ...
     def __init__(
         self,
         *,
@@ -1642,10 +1745,19 @@ class Request(google.protobuf.message.Message):
         file_transfer_info: global___FileTransferInfoRequest | None = ...,
         sync: global___SyncRequest | None = ...,
         test_inject: global___TestInjectRequest | None = ...,
-    ) -> None: ...
-    def HasField(self, field_name: typing_extensions.Literal["attach", b"attach", "cancel", b"cancel", "check_version", b"check_version", "defer", b"defer", "download_artifact", b"download_artifact", "file_transfer_info", b"file_transfer_info", "get_summary", b"get_summary", "get_system_metrics", b"get_system_metrics", "internal_messages", b"internal_messages", "job_info", b"job_info", "keepalive", b"keepalive", "log_artifact", b"log_artifact", "login", b"login", "metadata", b"metadata", "network_status", b"network_status", "partial_history", b"partial_history", "pause", b"pause", "poll_exit", b"poll_exit", "python_packages", b"python_packages", "request_type", b"request_type", "resume", b"resume", "run_start", b"run_start", "run_status", b"run_status", "sampled_history", b"sampled_history", "sender_mark", b"sender_mark", "sender_read", b"sender_read", "server_info", b"server_info", "shutdown", b"shutdown", "status", b"status", "status_report", b"status_report", "stop_status", b"stop_status", "summary_record", b"summary_record", "sync", b"sync", "telemetry_record", b"telemetry_record", "test_inject", b"test_inject"]) -> builtins.bool: ...
-    def ClearField(self, field_name: typing_extensions.Literal["attach", b"attach", "cancel", b"cancel", "check_version", b"check_version", "defer", b"defer", "download_artifact", b"download_artifact", "file_transfer_info", b"file_transfer_info", "get_summary", b"get_summary", "get_system_metrics", b"get_system_metrics", "internal_messages", b"internal_messages", "job_info", b"job_info", "keepalive", b"keepalive", "log_artifact", b"log_artifact", "login", b"login", "metadata", b"metadata", "network_status", b"network_status", "partial_history", b"partial_history", "pause", b"pause", "poll_exit", b"poll_exit", "python_packages", b"python_packages", "request_type", b"request_type", "resume", b"resume", "run_start", b"run_start", "run_status", b"run_status", "sampled_history", b"sampled_history", "sender_mark", b"sender_mark", "sender_read", b"sender_read", "server_info", b"server_info", "shutdown", b"shutdown", "status", b"status", "status_report", b"status_report", "stop_status", b"stop_status", "summary_record", b"summary_record", "sync", b"sync", "telemetry_record", b"telemetry_record", "test_inject", b"test_inject"]) -> None: ...
-    def WhichOneof(self, oneof_group: typing_extensions.Literal["request_type", b"request_type"]) -> typing_extensions.Literal["stop_status", "network_status", "defer", "get_summary", "login", "pause", "resume", "poll_exit", "sampled_history", "partial_history", "run_start", "check_version", "log_artifact", "download_artifact", "keepalive", "run_status", "cancel", "metadata", "internal_messages", "python_packages", "shutdown", "attach", "status", "server_info", "sender_mark", "sender_read", "status_report", "summary_record", "telemetry_record", "job_info", "get_system_metrics", "file_transfer_info", "sync", "test_inject"] | None: ...
+        store_status: global___StoreStatusRequest | None = ...,
+        circuit_breaker: global___CircuitBreakerRequest | None = ...,
+        run_stopped: global___RunStoppedRequest | None = ...,
+        run_move: global___RunMoveRequest | None = ...,
+        preflight: global___PreflightRequest | None = ...,
+        derived_metric: global___DerivedMetricRequest | None = ...,
+        stop_condition: global___StopConditionRequest | None = ...,
+        flow_credit: global___FlowCreditRequest | None = ...,
+        go_online: global___GoOnlineRequest | None = ...,
+    ) -> None: ...
+    def HasField(self, field_name: typing_extensions.Literal["attach", b"attach", "cancel", b"cancel", "check_version", b"check_version", "circuit_breaker", b"circuit_breaker", "defer", b"defer", "derived_metric", b"derived_metric", "download_artifact", b"download_artifact", "file_transfer_info", b"file_transfer_info", "flow_credit", b"flow_credit", "get_summary", b"get_summary", "get_system_metrics", b"get_system_metrics", "go_online", b"go_online", "internal_messages", b"internal_messages", "job_info", b"job_info", "keepalive", b"keepalive", "log_artifact", b"log_artifact", "login", b"login", "metadata", b"metadata", "network_status", b"network_status", "partial_history", b"partial_history", "pause", b"pause", "poll_exit", b"poll_exit", "preflight", b"preflight", "python_packages", b"python_packages", "request_type", b"request_type", "resume", b"resume", "run_move", b"run_move", "run_start", b"run_start", "run_status", b"run_status", "run_stopped", b"run_stopped", "sampled_history", b"sampled_history", "sender_mark", b"sender_mark", "sender_read", b"sender_read", "server_info", b"server_info", "shutdown", b"shutdown", "status", b"status", "status_report", b"status_report", "stop_condition", b"stop_condition", "stop_status", b"stop_status", "store_status", b"store_status", "summary_record", b"summary_record", "sync", b"sync", "telemetry_record", b"telemetry_record", "test_inject", b"test_inject"]) -> builtins.bool: ...
+    def ClearField(self, field_name: typing_extensions.Literal["attach", b"attach", "cancel", b"cancel", "check_version", b"check_version", "circuit_breaker", b"circuit_breaker", "defer", b"defer", "derived_metric", b"derived_metric", "download_artifact", b"download_artifact", "file_transfer_info", b"file_transfer_info", "flow_credit", b"flow_credit", "get_summary", b"get_summary", "get_system_metrics", b"get_system_metrics", "go_online", b"go_online", "internal_messages", b"internal_messages", "job_info", b"job_info", "keepalive", b"keepalive", "log_artifact", b"log_artifact", "login", b"login", "metadata", b"metadata", "network_status", b"network_status", "partial_history", b"partial_history", "pause", b"pause", "poll_exit", b"poll_exit", "preflight", b"preflight", "python_packages", b"python_packages", "request_type", b"request_type", "resume", b"resume", "run_move", b"run_move", "run_start", b"run_start", "run_status", b"run_status", "run_stopped", b"run_stopped", "sampled_history", b"sampled_history", "sender_mark", b"sender_mark", "sender_read", b"sender_read", "server_info", b"server_info", "shutdown", b"shutdown", "status", b"status", "status_report", b"status_report", "stop_condition", b"stop_condition", "stop_status", b"stop_status", "store_status", b"store_status", "summary_record", b"summary_record", "sync", b"sync", "telemetry_record", b"telemetry_record", "test_inject", b"test_inject"]) -> None: ...
+    def WhichOneof(self, oneof_group: typing_extensions.Literal["request_type", b"request_type"]) -> typing_extensions.Literal["stop_status", "network_status", "defer", "get_summary", "login", "pause", "resume", "poll_exit", "sampled_history", "partial_history", "run_start", "check_version", "log_artifact", "download_artifact", "keepalive", "run_status", "cancel", "metadata", "internal_messages", "python_packages", "shutdown", "attach", "status", "server_info", "sender_mark", "sender_read", "status_report", "summary_record", "telemetry_record", "job_info", "get_system_metrics", "file_transfer_info", "sync", "test_inject", "store_status", "circuit_breaker", "run_stopped", "run_move", "preflight", "derived_metric", "stop_condition", "flow_credit", "go_online"] | None: ...
 
 global___Request = Request
 
@@ -1678,6 +1790,11 @@ class Response(google.protobuf.message.Message):
     GET_SYSTEM_METRICS_RESPONSE_FIELD_NUMBER: builtins.int
     SYNC_RESPONSE_FIELD_NUMBER: builtins.int
     TEST_INJECT_RESPONSE_FIELD_NUMBER: builtins.int
+    RUN_MOVE_RESPONSE_FIELD_NUMBER: builtins.int
+    PREFLIGHT_RESPONSE_FIELD_NUMBER: builtins.int
+    DERIVED_METRIC_RESPONSE_FIELD_NUMBER: builtins.int
+    STOP_CONDITION_RESPONSE_FIELD_NUMBER: builtins.int
+    FLOW_CREDIT_RESPONSE_FIELD_NUMBER: builtins.int
     @property
     def keepalive_response(self) -> global___KeepaliveResponse: ...
     @property
@@ -1722,6 +1839,16 @@ class Response(google.protobuf.message.Message):
     def sync_response(self) -> global___SyncResponse: ...
     @property
     def test_inject_response(self) -> global___TestInjectResponse: ...
+    @property
+    def run_move_response(self) -> global___RunMoveResponse: ...
+    @property
+    def preflight_response(self) -> global___PreflightResponse: ...
+    @property
+    def derived_metric_response(self) -> global___DerivedMetricResponse: ...
+    @property
+    def stop_condition_response(self) -> global___StopConditionResponse: ...
+    @property
+    def flow_credit_response(self) -> global___FlowCreditResponse: ...
     def __init__(
         self,
         *,
@@ -1747,10 +1874,15 @@ class Response(google.protobuf.message.Message):
         get_system_metrics_response: global___GetSystemMetricsResponse | None = ...,
         sync_response: global___SyncResponse | None = ...,
         test_inject_response: global___TestInjectResponse | None = ...,
+        run_move_response: global___RunMoveResponse | None = ...,
+        preflight_response: global___PreflightResponse | None = ...,
+        derived_metric_response: global___DerivedMetricResponse | None = ...,
+        stop_condition_response: global___StopConditionResponse | None = ...,
+        flow_credit_response: global___FlowCreditResponse | None = ...,
     ) -> None: ...
-    def HasField(self, field_name: typing_extensions.Literal["attach_response", b"attach_response", "cancel_response", b"cancel_response", "check_version_response", b"check_version_response", "download_artifact_response", b"download_artifact_response", "get_summary_response", b"get_summary_response", "get_system_metrics_response", b"get_system_metrics_response", "internal_messages_response", b"internal_messages_response", "job_info_response", b"job_info_response", "keepalive_response", b"keepalive_response", "log_artifact_response", b"log_artifact_response", "login_response", b"login_response", "network_status_response", b"network_status_response", "poll_exit_response", b"poll_exit_response", "response_type", b"response_type", "run_start_response", b"run_start_response", "run_status_response", b"run_status_response", "sampled_history_response", b"sampled_history_response", "server_info_response", b"server_info_response", "shutdown_response", b"shutdown_response", "status_response", b"status_response", "stop_status_response", b"stop_status_response", "sync_response", b"sync_response", "test_inject_response", b"test_inject_response"]) -> builtins.bool: ...
-    def ClearField(self, field_name: typing_extensions.Literal["attach_response", b"attach_response", "cancel_response", b"cancel_response", "check_version_response", b"check_version_response", "download_artifact_response", b"download_artifact_response", "get_summary_response", b"get_summary_response", "get_system_metrics_response", b"get_system_metrics_response", "internal_messages_response", b"internal_messages_response", "job_info_response", b"job_info_response", "keepalive_response", b"keepalive_response", "log_artifact_response", b"log_artifact_response", "login_response", b"login_response", "network_status_response", b"network_status_response", "poll_exit_response", b"poll_exit_response", "response_type", b"response_type", "run_start_response", b"run_start_response", "run_status_response", b"run_status_response", "sampled_history_response", b"sampled_history_response", "server_info_response", b"server_info_response", "shutdown_response", b"shutdown_response", "status_response", b"status_response", "stop_status_response", b"stop_status_response", "sync_response", b"sync_response", "test_inject_response", b"test_inject_response"]) -> None: ...
-    def WhichOneof(self, oneof_group: typing_extensions.Literal["response_type", b"response_type"]) -> typing_extensions.Literal["keepalive_response", "stop_status_response", "network_status_response", "login_response", "get_summary_response", "poll_exit_response", "sampled_history_response", "run_start_response", "check_version_response", "log_artifact_response", "download_artifact_response", "run_status_response", "cancel_response", "internal_messages_response", "shutdown_response", "attach_response", "status_response", "server_info_response", "job_info_response", "get_system_metrics_response", "sync_response", "test_inject_response"] | None: ...
+    def HasField(self, field_name: typing_extensions.Literal["attach_response", b"attach_response", "cancel_response", b"cancel_response", "check_version_response", b"check_version_response", "derived_metric_response", b"derived_metric_response", "download_artifact_response", b"download_artifact_response", "flow_credit_response", b"flow_credit_response", "get_summary_response", b"get_summary_response", "get_system_metrics_response", b"get_system_metrics_response", "internal_messages_response", b"internal_messages_response", "job_info_response", b"job_info_response", "keepalive_response", b"keepalive_response", "log_artifact_response", b"log_artifact_response", "login_response", b"login_response", "network_status_response", b"network_status_response", "poll_exit_response", b"poll_exit_response", "preflight_response", b"preflight_response", "response_type", b"response_type", "run_move_response", b"run_move_response", "run_start_response", b"run_start_response", "run_status_response", b"run_status_response", "sampled_history_response", b"sampled_history_response", "server_info_response", b"server_info_response", "shutdown_response", b"shutdown_response", "status_response", b"status_response", "stop_condition_response", b"stop_condition_response", "stop_status_response", b"stop_status_response", "sync_response", b"sync_response", "test_inject_response", b"test_inject_response"]) -> builtins.bool: ...
+    def ClearField(self, field_name: typing_extensions.Literal["attach_response", b"attach_response", "cancel_response", b"cancel_response", "check_version_response", b"check_version_response", "derived_metric_response", b"derived_metric_response", "download_artifact_response", b"download_artifact_response", "flow_credit_response", b"flow_credit_response", "get_summary_response", b"get_summary_response", "get_system_metrics_response", b"get_system_metrics_response", "internal_messages_response", b"internal_messages_response", "job_info_response", b"job_info_response", "keepalive_response", b"keepalive_response", "log_artifact_response", b"log_artifact_response", "login_response", b"login_response", "network_status_response", b"network_status_response", "poll_exit_response", b"poll_exit_response", "preflight_response", b"preflight_response", "response_type", b"response_type", "run_move_response", b"run_move_response", "run_start_response", b"run_start_response", "run_status_response", b"run_status_response", "sampled_history_response", b"sampled_history_response", "server_info_response", b"server_info_response", "shutdown_response", b"shutdown_response", "status_response", b"status_response", "stop_condition_response", b"stop_condition_response", "stop_status_response", b"stop_status_response", "sync_response", b"sync_response", "test_inject_response", b"test_inject_response"]) -> None: ...
+    def WhichOneof(self, oneof_group: typing_extensions.Literal["response_type", b"response_type"]) -> typing_extensions.Literal["keepalive_response", "stop_status_response", "network_status_response", "login_response", "get_summary_response", "poll_exit_response", "sampled_history_response", "run_start_response", "check_version_response", "log_artifact_response", "download_artifact_response", "run_status_response", "cancel_response", "internal_messages_response", "shutdown_response", "attach_response", "status_response", "server_info_response", "job_info_response", "get_system_metrics_response", "sync_response", "test_inject_response", "run_move_response", "preflight_response", "derived_metric_response", "stop_condition_response", "flow_credit_response"] | None: ...
 
 global___Response = Response
 
@@ -2082,13 +2214,20 @@ class StopStatusResponse(google.protobuf.message.Message):
     DESCRIPTOR: google.protobuf.descriptor.Descriptor
 
     RUN_SHOULD_STOP_FIELD_NUMBER: builtins.int
+    RUN_PREEMPTING_FIELD_NUMBER: builtins.int
+    STOP_REASON_FIELD_NUMBER: builtins.int
     run_should_stop: builtins.bool
+    run_preempting: builtins.bool
+    stop_reason: builtins.str
+    """stop_reason is the stop condition that stopped the run, if one did"""
     def __init__(
         self,
         *,
         run_should_stop: builtins.bool = ...,
+        run_preempting: builtins.bool = ...,
+        stop_reason: builtins.str = ...,
     ) -> None: ...
-    def ClearField(self, field_name: typing_extensions.Literal["run_should_stop", b"run_should_stop"]) -> None: ...
+    def ClearField(self, field_name: typing_extensions.Literal["run_preempting", b"run_preempting", "run_should_stop", b"run_should_stop", "stop_reason", b"stop_reason"]) -> None: ...
 
 global___StopStatusResponse = StopStatusResponse
 
@@ -2180,14 +2319,18 @@ class InternalMessages(google.protobuf.message.Message):
     DESCRIPTOR: google.protobuf.descriptor.Descriptor
 
     WARNING_FIELD_NUMBER: builtins.int
+    INVALID_HISTORY_VALUE_FIELD_NUMBER: builtins.int
     @property
     def warning(self) -> google.protobuf.internal.containers.RepeatedScalarFieldContainer[builtins.str]: ...
+    @property
+    def invalid_history_value(self) -> google.protobuf.internal.containers.RepeatedCompositeFieldContainer[global___InvalidHistoryValue]: ...
     def __init__(
         self,
         *,
         warning: collections.abc.Iterable[builtins.str] | None = ...,
+        invalid_history_value: collections.abc.Iterable[global___InvalidHistoryValue] | None = ...,
     ) -> None: ...
-    def ClearField(self, field_name: typing_extensions.Literal["warning", b"warning"]) -> None: ...
+    def ClearField(self, field_name: typing_extensions.Literal["invalid_history_value", b"invalid_history_value", "warning", b"warning"]) -> None: ...
 
 global___InternalMessages = InternalMessages
 
@@ -2292,12 +2435,16 @@ class SyncRequest(google.protobuf.message.Message):
     FINAL_OFFSET_FIELD_NUMBER: builtins.int
     OVERWRITE_FIELD_NUMBER: builtins.int
     SKIP_FIELD_NUMBER: builtins.int
+    COMPACT_FIELD_NUMBER: builtins.int
+    VERIFY_FIELD_NUMBER: builtins.int
     start_offset: builtins.int
     final_offset: builtins.int
     @property
     def overwrite(self) -> global___SyncOverwrite: ...
     @property
     def skip(self) -> global___SyncSkip: ...
+    compact: builtins.bool
+    verify: builtins.bool
     def __init__(
         self,
         *,
@@ -2305,9 +2452,11 @@ class SyncRequest(google.protobuf.message.Message):
         final_offset: builtins.int = ...,
         overwrite: global___SyncOverwrite | None = ...,
         skip: global___SyncSkip | None = ...,
+        compact: builtins.bool = ...,
+        verify: builtins.bool = ...,
     ) -> None: ...
     def HasField(self, field_name: typing_extensions.Literal["overwrite", b"overwrite", "skip", b"skip"]) -> builtins.bool: ...
-    def ClearField(self, field_name: typing_extensions.Literal["final_offset", b"final_offset", "overwrite", b"overwrite", "skip", b"skip", "start_offset", b"start_offset"]) -> None: ...
+    def ClearField(self, field_name: typing_extensions.Literal["compact", b"compact", "final_offset", b"final_offset", "overwrite", b"overwrite", "skip", b"skip", "start_offset", b"start_offset", "verify", b"verify"]) -> None: ...
 
 global___SyncRequest = SyncRequest
 
@@ -2316,17 +2465,21 @@ class SyncResponse(google.protobuf.message.Message):
 
     URL_FIELD_NUMBER: builtins.int
     ERROR_FIELD_NUMBER: builtins.int
+    VERIFY_REPORT_FIELD_NUMBER: builtins.int
     url: builtins.str
     @property
     def error(self) -> global___ErrorInfo: ...
+    @property
+    def verify_report(self) -> global___VerifyReport: ...
     def __init__(
         self,
         *,
         url: builtins.str = ...,
         error: global___ErrorInfo | None = ...,
+        verify_report: global___VerifyReport | None = ...,
     ) -> None: ...
-    def HasField(self, field_name: typing_extensions.Literal["error", b"error"]) -> builtins.bool: ...
-    def ClearField(self, field_name: typing_extensions.Literal["error", b"error", "url", b"url"]) -> None: ...
+    def HasField(self, field_name: typing_extensions.Literal["error", b"error", "verify_report", b"verify_report"]) -> builtins.bool: ...
+    def ClearField(self, field_name: typing_extensions.Literal["error", b"error", "url", b"url", "verify_report", b"verify_report"]) -> None: ...
 
 global___SyncResponse = SyncResponse
 
@@ -3574,6 +3727,7 @@ class MetadataRequest(google.protobuf.message.Message):
     GPU_NVIDIA_FIELD_NUMBER: builtins.int
     GPU_AMD_FIELD_NUMBER: builtins.int
     SLURM_FIELD_NUMBER: builtins.int
+    ENVIRONMENT_FIELD_NUMBER: builtins.int
     os: builtins.str
     python: builtins.str
     @property
@@ -3614,6 +3768,8 @@ class MetadataRequest(google.protobuf.message.Message):
     def gpu_amd(self) -> google.protobuf.internal.containers.RepeatedCompositeFieldContainer[global___GpuAmdInfo]: ...
     @property
     def slurm(self) -> google.protobuf.internal.containers.ScalarMap[builtins.str, builtins.str]: ...
+    @property
+    def environment(self) -> global___EnvironmentRecord: ...
     def __init__(
         self,
         *,
@@ -3646,9 +3802,10 @@ class MetadataRequest(google.protobuf.message.Message):
         gpu_nvidia: collections.abc.Iterable[global___GpuNvidiaInfo] | None = ...,
         gpu_amd: collections.abc.Iterable[global___GpuAmdInfo] | None = ...,
         slurm: collections.abc.Mapping[builtins.str, builtins.str] | None = ...,
+        environment: global___EnvironmentRecord | None = ...,
     ) -> None: ...
-    def HasField(self, field_name: typing_extensions.Literal["cpu", b"cpu", "git", b"git", "gpu_apple", b"gpu_apple", "heartbeatAt", b"heartbeatAt", "memory", b"memory", "startedAt", b"startedAt"]) -> builtins.bool: ...
-    def ClearField(self, field_name: typing_extensions.Literal["args", b"args", "code_path", b"code_path", "code_path_local", b"code_path_local", "colab", b"colab", "cpu", b"cpu", "cpu_count", b"cpu_count", "cpu_count_logical", b"cpu_count_logical", "cuda", b"cuda", "disk", b"disk", "docker", b"docker", "email", b"email", "executable", b"executable", "git", b"git", "gpu_amd", b"gpu_amd", "gpu_apple", b"gpu_apple", "gpu_count", b"gpu_count", "gpu_nvidia", b"gpu_nvidia", "gpu_type", b"gpu_type", "heartbeatAt", b"heartbeatAt", "host", b"host", "memory", b"memory", "os", b"os", "program", b"program", "python", b"python", "root", b"root", "slurm", b"slurm", "startedAt", b"startedAt", "state", b"state", "username", b"username"]) -> None: ...
+    def HasField(self, field_name: typing_extensions.Literal["cpu", b"cpu", "environment", b"environment", "git", b"git", "gpu_apple", b"gpu_apple", "heartbeatAt", b"heartbeatAt", "memory", b"memory", "startedAt", b"startedAt"]) -> builtins.bool: ...
+    def ClearField(self, field_name: typing_extensions.Literal["args", b"args", "code_path", b"code_path", "code_path_local", b"code_path_local", "colab", b"colab", "cpu", b"cpu", "cpu_count", b"cpu_count", "cpu_count_logical", b"cpu_count_logical", "cuda", b"cuda", "disk", b"disk", "docker", b"docker", "email", b"email", "environment", b"environment", "executable", b"executable", "git", b"git", "gpu_amd", b"gpu_amd", "gpu_apple", b"gpu_apple", "gpu_count", b"gpu_count", "gpu_nvidia", b"gpu_nvidia", "gpu_type", b"gpu_type", "heartbeatAt", b"heartbeatAt", "host", b"host", "memory", b"memory", "os", b"os", "program", b"program", "python", b"python", "root", b"root", "slurm", b"slurm", "startedAt", b"startedAt", "state", b"state", "username", b"username"]) -> None: ...
 
 global___MetadataRequest = MetadataRequest
 
@@ -3681,3 +3838,650 @@ class PythonPackagesRequest(google.protobuf.message.Message):
     def ClearField(self, field_name: typing_extensions.Literal["package", b"package"]) -> None: ...
 
 global___PythonPackagesRequest = PythonPackagesRequest
+
+class VerifyReport(google.protobuf.message.Message):
+    """
+    VerifyReport: result of walking a .wandb file and checking its records
+    """
+
+    DESCRIPTOR: google.protobuf.descriptor.Descriptor
+
+    class RecordCount(google.protobuf.message.Message):
+        DESCRIPTOR: google.protobuf.descriptor.Descriptor
+
+        RECORD_TYPE_FIELD_NUMBER: builtins.int
+        COUNT_FIELD_NUMBER: builtins.int
+        record_type: builtins.str
+        count: builtins.int
+        def __init__(
+            self,
+            *,
+            record_type: builtins.str = ...,
+            count: builtins.int = ...,
+        ) -> None: ...
+        def ClearField(self, field_name: typing_extensions.Literal["count", b"count", "record_type", b"record_type"]) -> None: ...
+
+    class Gap(google.protobuf.message.Message):
+        DESCRIPTOR: google.protobuf.descriptor.Descriptor
+
+        FIRST_FIELD_NUMBER: builtins.int
+        LAST_FIELD_NUMBER: builtins.int
+        first: builtins.int
+        last: builtins.int
+        def __init__(
+            self,
+            *,
+            first: builtins.int = ...,
+            last: builtins.int = ...,
+        ) -> None: ...
+        def ClearField(self, field_name: typing_extensions.Literal["first", b"first", "last", b"last"]) -> None: ...
+
+    RECORDS_FIELD_NUMBER: builtins.int
+    CORRUPT_RECORDS_FIELD_NUMBER: builtins.int
+    OUT_OF_ORDER_FIELD_NUMBER: builtins.int
+    RECORD_COUNTS_FIELD_NUMBER: builtins.int
+    GAPS_FIELD_NUMBER: builtins.int
+    ERRORS_FIELD_NUMBER: builtins.int
+    records: builtins.int
+    corrupt_records: builtins.int
+    out_of_order: builtins.int
+    @property
+    def record_counts(self) -> google.protobuf.internal.containers.RepeatedCompositeFieldContainer[global___VerifyReport.RecordCount]: ...
+    @property
+    def gaps(self) -> google.protobuf.internal.containers.RepeatedCompositeFieldContainer[global___VerifyReport.Gap]: ...
+    @property
+    def errors(self) -> google.protobuf.internal.containers.RepeatedScalarFieldContainer[builtins.str]: ...
+    def __init__(
+        self,
+        *,
+        records: builtins.int = ...,
+        corrupt_records: builtins.int = ...,
+        out_of_order: builtins.int = ...,
+        record_counts: collections.abc.Iterable[global___VerifyReport.RecordCount] | None = ...,
+        gaps: collections.abc.Iterable[global___VerifyReport.Gap] | None = ...,
+        errors: collections.abc.Iterable[builtins.str] | None = ...,
+    ) -> None: ...
+    def ClearField(self, field_name: typing_extensions.Literal["corrupt_records", b"corrupt_records", "errors", b"errors", "gaps", b"gaps", "out_of_order", b"out_of_order", "record_counts", b"record_counts", "records", b"records"]) -> None: ...
+
+global___VerifyReport = VerifyReport
+
+class SnapshotRecord(google.protobuf.message.Message):
+    """
+    SnapshotRecord: state of the run consolidated by the writer, so that
+    readers can start from it instead of replaying the records before
+    """
+
+    DESCRIPTOR: google.protobuf.descriptor.Descriptor
+
+    LAST_NUM_FIELD_NUMBER: builtins.int
+    CONFIG_FIELD_NUMBER: builtins.int
+    SUMMARY_FIELD_NUMBER: builtins.int
+    STEP_FIELD_NUMBER: builtins.int
+    HISTORY_ROWS_FIELD_NUMBER: builtins.int
+    _INFO_FIELD_NUMBER: builtins.int
+    last_num: builtins.int
+    """number of the last record the snapshot accounts for"""
+    @property
+    def config(self) -> global___ConfigRecord:
+        """merged config and summary records"""
+    @property
+    def summary(self) -> global___SummaryRecord: ...
+    step: builtins.int
+    """step of the last history record and number of history records"""
+    history_rows: builtins.int
+    @property
+    def _info(self) -> wandb.proto.wandb_base_pb2._RecordInfo: ...
+    def __init__(
+        self,
+        *,
+        last_num: builtins.int = ...,
+        config: global___ConfigRecord | None = ...,
+        summary: global___SummaryRecord | None = ...,
+        step: builtins.int = ...,
+        history_rows: builtins.int = ...,
+        _info: wandb.proto.wandb_base_pb2._RecordInfo | None = ...,
+    ) -> None: ...
+    def HasField(self, field_name: typing_extensions.Literal["_info", b"_info", "config", b"config", "summary", b"summary"]) -> builtins.bool: ...
+    def ClearField(self, field_name: typing_extensions.Literal["_info", b"_info", "config", b"config", "history_rows", b"history_rows", "last_num", b"last_num", "step", b"step", "summary", b"summary"]) -> None: ...
+
+global___SnapshotRecord = SnapshotRecord
+
+class StoreStatusRequest(google.protobuf.message.Message):
+    """
+    StoreStatusRequest: reports that records failed to be written to the
+    transaction log, it is not persisted
+    """
+
+    DESCRIPTOR: google.protobuf.descriptor.Descriptor
+
+    ERROR_FIELD_NUMBER: builtins.int
+    ERRORS_FIELD_NUMBER: builtins.int
+    error: builtins.str
+    errors: builtins.int
+    def __init__(
+        self,
+        *,
+        error: builtins.str = ...,
+        errors: builtins.int = ...,
+    ) -> None: ...
+    def ClearField(self, field_name: typing_extensions.Literal["error", b"error", "errors", b"errors"]) -> None: ...
+
+global___StoreStatusRequest = StoreStatusRequest
+
+class CircuitBreakerRequest(google.protobuf.message.Message):
+    """
+    CircuitBreakerRequest: reports that requests to the backend are paused
+    after consecutive failures, it is not persisted
+    """
+
+    DESCRIPTOR: google.protobuf.descriptor.Descriptor
+
+    FAILURES_FIELD_NUMBER: builtins.int
+    COOLDOWN_SECONDS_FIELD_NUMBER: builtins.int
+    ERROR_FIELD_NUMBER: builtins.int
+    failures: builtins.int
+    cooldown_seconds: builtins.float
+    error: builtins.str
+    def __init__(
+        self,
+        *,
+        failures: builtins.int = ...,
+        cooldown_seconds: builtins.float = ...,
+        error: builtins.str = ...,
+    ) -> None: ...
+    def ClearField(self, field_name: typing_extensions.Literal["cooldown_seconds", b"cooldown_seconds", "error", b"error", "failures", b"failures"]) -> None: ...
+
+global___CircuitBreakerRequest = CircuitBreakerRequest
+
+class RunStoppedRequest(google.protobuf.message.Message):
+    """
+    RunStoppedRequest: reports that the run was stopped from the UI, it is not
+    persisted
+    """
+
+    DESCRIPTOR: google.protobuf.descriptor.Descriptor
+
+    def __init__(
+        self,
+    ) -> None: ...
+
+global___RunStoppedRequest = RunStoppedRequest
+
+class RunMoveRequest(google.protobuf.message.Message):
+    """
+    RunMoveRequest: moves the run to another project or entity, or forks it
+    into a new run there, while it is logging
+    """
+
+    DESCRIPTOR: google.protobuf.descriptor.Descriptor
+
+    ENTITY_FIELD_NUMBER: builtins.int
+    PROJECT_FIELD_NUMBER: builtins.int
+    FORK_FIELD_NUMBER: builtins.int
+    RUN_ID_FIELD_NUMBER: builtins.int
+    _INFO_FIELD_NUMBER: builtins.int
+    entity: builtins.str
+    """the empty entity and project are the ones of the run"""
+    project: builtins.str
+    fork: builtins.bool
+    """fork keeps the run where it is, and continues the logging in a new run"""
+    run_id: builtins.str
+    """run_id is the id of the fork, a new one is generated if it is empty"""
+    @property
+    def _info(self) -> wandb.proto.wandb_base_pb2._RequestInfo: ...
+    def __init__(
+        self,
+        *,
+        entity: builtins.str = ...,
+        project: builtins.str = ...,
+        fork: builtins.bool = ...,
+        run_id: builtins.str = ...,
+        _info: wandb.proto.wandb_base_pb2._RequestInfo | None = ...,
+    ) -> None: ...
+    def HasField(self, field_name: typing_extensions.Literal["_info", b"_info"]) -> builtins.bool: ...
+    def ClearField(self, field_name: typing_extensions.Literal["_info", b"_info", "entity", b"entity", "fork", b"fork", "project", b"project", "run_id", b"run_id"]) -> None: ...
+
+global___RunMoveRequest = RunMoveRequest
+
+class RunMoveResponse(google.protobuf.message.Message):
+    DESCRIPTOR: google.protobuf.descriptor.Descriptor
+
+    RUN_FIELD_NUMBER: builtins.int
+    ERROR_FIELD_NUMBER: builtins.int
+    @property
+    def run(self) -> global___RunRecord: ...
+    @property
+    def error(self) -> global___ErrorInfo: ...
+    def __init__(
+        self,
+        *,
+        run: global___RunRecord | None = ...,
+        error: global___ErrorInfo | None = ...,
+    ) -> None: ...
+    def HasField(self, field_name: typing_extensions.Literal["error", b"error", "run", b"run"]) -> builtins.bool: ...
+    def ClearField(self, field_name: typing_extensions.Literal["error", b"error", "run", b"run"]) -> None: ...
+
+global___RunMoveResponse = RunMoveResponse
+
+class PreflightRequest(google.protobuf.message.Message):
+    """
+    PreflightRequest: asks for the checks of the connection to the backend made
+    as the stream started, from the name resolution to the API key
+    """
+
+    DESCRIPTOR: google.protobuf.descriptor.Descriptor
+
+    _INFO_FIELD_NUMBER: builtins.int
+    @property
+    def _info(self) -> wandb.proto.wandb_base_pb2._RequestInfo: ...
+    def __init__(
+        self,
+        *,
+        _info: wandb.proto.wandb_base_pb2._RequestInfo | None = ...,
+    ) -> None: ...
+    def HasField(self, field_name: typing_extensions.Literal["_info", b"_info"]) -> builtins.bool: ...
+    def ClearField(self, field_name: typing_extensions.Literal["_info", b"_info"]) -> None: ...
+
+global___PreflightRequest = PreflightRequest
+
+class PreflightCheck(google.protobuf.message.Message):
+    DESCRIPTOR: google.protobuf.descriptor.Descriptor
+
+    STAGE_FIELD_NUMBER: builtins.int
+    ADDRESS_FIELD_NUMBER: builtins.int
+    OK_FIELD_NUMBER: builtins.int
+    ERROR_FIELD_NUMBER: builtins.int
+    HINT_FIELD_NUMBER: builtins.int
+    DURATION_SECONDS_FIELD_NUMBER: builtins.int
+    stage: builtins.str
+    """stage is one of dns, tcp, tls and auth"""
+    address: builtins.str
+    ok: builtins.bool
+    error: builtins.str
+    hint: builtins.str
+    """hint tells what to look at if the stage failed"""
+    duration_seconds: builtins.float
+    def __init__(
+        self,
+        *,
+        stage: builtins.str = ...,
+        address: builtins.str = ...,
+        ok: builtins.bool = ...,
+        error: builtins.str = ...,
+        hint: builtins.str = ...,
+        duration_seconds: builtins.float = ...,
+    ) -> None: ...
+    def ClearField(self, field_name: typing_extensions.Literal["address", b"address", "duration_seconds", b"duration_seconds", "error", b"error", "hint", b"hint", "ok", b"ok", "stage", b"stage"]) -> None: ...
+
+global___PreflightCheck = PreflightCheck
+
+class PreflightResponse(google.protobuf.message.Message):
+    DESCRIPTOR: google.protobuf.descriptor.Descriptor
+
+    OK_FIELD_NUMBER: builtins.int
+    CHECKS_FIELD_NUMBER: builtins.int
+    ok: builtins.bool
+    @property
+    def checks(self) -> google.protobuf.internal.containers.RepeatedCompositeFieldContainer[global___PreflightCheck]:
+        """checks are the stages checked in order, up to the first that failed"""
+    def __init__(
+        self,
+        *,
+        ok: builtins.bool = ...,
+        checks: collections.abc.Iterable[global___PreflightCheck] | None = ...,
+    ) -> None: ...
+    def ClearField(self, field_name: typing_extensions.Literal["checks", b"checks", "ok", b"ok"]) -> None: ...
+
+global___PreflightResponse = PreflightResponse
+
+class DerivedMetricRequest(google.protobuf.message.Message):
+    """
+    DerivedMetricRequest: declares a metric computed from the keys of each
+    history row, e.g. tokens_per_sec = tokens / step_time
+    """
+
+    DESCRIPTOR: google.protobuf.descriptor.Descriptor
+
+    NAME_FIELD_NUMBER: builtins.int
+    EXPRESSION_FIELD_NUMBER: builtins.int
+    _INFO_FIELD_NUMBER: builtins.int
+    name: builtins.str
+    expression: builtins.str
+    """expression is arithmetic over the keys of the row, a key that is not a
+    plain name is quoted with backquotes, e.g. `train/tokens` / step_time
+    """
+    @property
+    def _info(self) -> wandb.proto.wandb_base_pb2._RequestInfo: ...
+    def __init__(
+        self,
+        *,
+        name: builtins.str = ...,
+        expression: builtins.str = ...,
+        _info: wandb.proto.wandb_base_pb2._RequestInfo | None = ...,
+    ) -> None: ...
+    def HasField(self, field_name: typing_extensions.Literal["_info", b"_info"]) -> builtins.bool: ...
+    def ClearField(self, field_name: typing_extensions.Literal["_info", b"_info", "expression", b"expression", "name", b"name"]) -> None: ...
+
+global___DerivedMetricRequest = DerivedMetricRequest
+
+class DerivedMetricResponse(google.protobuf.message.Message):
+    DESCRIPTOR: google.protobuf.descriptor.Descriptor
+
+    ERROR_FIELD_NUMBER: builtins.int
+    @property
+    def error(self) -> global___ErrorInfo: ...
+    def __init__(
+        self,
+        *,
+        error: global___ErrorInfo | None = ...,
+    ) -> None: ...
+    def HasField(self, field_name: typing_extensions.Literal["error", b"error"]) -> builtins.bool: ...
+    def ClearField(self, field_name: typing_extensions.Literal["error", b"error"]) -> None: ...
+
+global___DerivedMetricResponse = DerivedMetricResponse
+
+class InvalidHistoryValue(google.protobuf.message.Message):
+    """InvalidHistoryValue is a value of the history that is not valid JSON, like
+    NaN or Infinity, rejected under the error policy of _history_invalid_values
+    """
+
+    DESCRIPTOR: google.protobuf.descriptor.Descriptor
+
+    KEY_FIELD_NUMBER: builtins.int
+    VALUE_JSON_FIELD_NUMBER: builtins.int
+    STEP_FIELD_NUMBER: builtins.int
+    COUNT_FIELD_NUMBER: builtins.int
+    key: builtins.str
+    value_json: builtins.str
+    step: builtins.int
+    """step is the step of the first value rejected"""
+    count: builtins.int
+    """count is how many values of the key were rejected since the last report"""
+    def __init__(
+        self,
+        *,
+        key: builtins.str = ...,
+        value_json: builtins.str = ...,
+        step: builtins.int = ...,
+        count: builtins.int = ...,
+    ) -> None: ...
+    def ClearField(self, field_name: typing_extensions.Literal["count", b"count", "key", b"key", "step", b"step", "value_json", b"value_json"]) -> None: ...
+
+global___InvalidHistoryValue = InvalidHistoryValue
+
+class EnvironmentRecord(google.protobuf.message.Message):
+    """
+    EnvironmentRecord: fingerprint of the environment the run was started in
+    """
+
+    DESCRIPTOR: google.protobuf.descriptor.Descriptor
+
+    class LaunchEntry(google.protobuf.message.Message):
+        DESCRIPTOR: google.protobuf.descriptor.Descriptor
+
+        KEY_FIELD_NUMBER: builtins.int
+        VALUE_FIELD_NUMBER: builtins.int
+        key: builtins.str
+        value: builtins.str
+        def __init__(
+            self,
+            *,
+            key: builtins.str = ...,
+            value: builtins.str = ...,
+        ) -> None: ...
+        def ClearField(self, field_name: typing_extensions.Literal["key", b"key", "value", b"value"]) -> None: ...
+
+    GIT_COMMIT_FIELD_NUMBER: builtins.int
+    GIT_BRANCH_FIELD_NUMBER: builtins.int
+    GIT_DIRTY_FIELD_NUMBER: builtins.int
+    GIT_DIFF_SHA256_FIELD_NUMBER: builtins.int
+    PYTHON_VERSION_FIELD_NUMBER: builtins.int
+    GO_VERSION_FIELD_NUMBER: builtins.int
+    CUDA_VERSION_FIELD_NUMBER: builtins.int
+    NVIDIA_DRIVER_VERSION_FIELD_NUMBER: builtins.int
+    CONTAINER_IMAGE_FIELD_NUMBER: builtins.int
+    CONTAINER_IMAGE_DIGEST_FIELD_NUMBER: builtins.int
+    LAUNCH_FIELD_NUMBER: builtins.int
+    _INFO_FIELD_NUMBER: builtins.int
+    git_commit: builtins.str
+    git_branch: builtins.str
+    git_dirty: builtins.bool
+    git_diff_sha256: builtins.str
+    """SHA-256 of the diff of the working tree against HEAD, empty if clean"""
+    python_version: builtins.str
+    go_version: builtins.str
+    """version of the Go runtime of wandb-core"""
+    cuda_version: builtins.str
+    """version of the CUDA driver, e.g. 12.2"""
+    nvidia_driver_version: builtins.str
+    container_image: builtins.str
+    container_image_digest: builtins.str
+    @property
+    def launch(self) -> google.protobuf.internal.containers.ScalarMap[builtins.str, builtins.str]:
+        """variables of the environment that tell how the run was launched"""
+    @property
+    def _info(self) -> wandb.proto.wandb_base_pb2._RecordInfo: ...
+    def __init__(
+        self,
+        *,
+        git_commit: builtins.str = ...,
+        git_branch: builtins.str = ...,
+        git_dirty: builtins.bool = ...,
+        git_diff_sha256: builtins.str = ...,
+        python_version: builtins.str = ...,
+        go_version: builtins.str = ...,
+        cuda_version: builtins.str = ...,
+        nvidia_driver_version: builtins.str = ...,
+        container_image: builtins.str = ...,
+        container_image_digest: builtins.str = ...,
+        launch: collections.abc.Mapping[builtins.str, builtins.str] | None = ...,
+        _info: wandb.proto.wandb_base_pb2._RecordInfo | None = ...,
+    ) -> None: ...
+    def HasField(self, field_name: typing_extensions.Literal["_info", b"_info"]) -> builtins.bool: ...
+    def ClearField(self, field_name: typing_extensions.Literal["_info", b"_info", "container_image", b"container_image", "container_image_digest", b"container_image_digest", "cuda_version", b"cuda_version", "git_branch", b"git_branch", "git_commit", b"git_commit", "git_diff_sha256", b"git_diff_sha256", "git_dirty", b"git_dirty", "go_version", b"go_version", "launch", b"launch", "nvidia_driver_version", b"nvidia_driver_version", "python_version", b"python_version"]) -> None: ...
+
+global___EnvironmentRecord = EnvironmentRecord
+
+class StopConditionRequest(google.protobuf.message.Message):
+    """
+    StopConditionRequest: declares a condition over a history metric that
+    stops the run once it holds, e.g. loss > 10 for 50 steps, or no
+    improvement of loss in 1000 steps
+    """
+
+    DESCRIPTOR: google.protobuf.descriptor.Descriptor
+
+    class _Kind:
+        ValueType = typing.NewType("ValueType", builtins.int)
+        V: typing_extensions.TypeAlias = ValueType
+
+    class _KindEnumTypeWrapper(google.protobuf.internal.enum_type_wrapper._EnumTypeWrapper[StopConditionRequest._Kind.ValueType], builtins.type):  # noqa: F821
+        DESCRIPTOR: google.protobuf.descriptor.EnumDescriptor
+        THRESHOLD: StopConditionRequest._Kind.ValueType  # 0
+        """THRESHOLD holds once the metric compared to threshold by op held for
+        steps steps
+        """
+
+        PLATEAU: StopConditionRequest._Kind.ValueType  # 1
+        """PLATEAU holds once the metric did not improve toward goal by more than
+        min_delta for steps steps
+        """
+
+
+    class Kind(_Kind, metaclass=_KindEnumTypeWrapper): ...
+    THRESHOLD: StopConditionRequest.Kind.ValueType  # 0
+    """THRESHOLD holds once the metric compared to threshold by op held for
+    steps steps
+    """
+
+    PLATEAU: StopConditionRequest.Kind.ValueType  # 1
+    """PLATEAU holds once the metric did not improve toward goal by more than
+    min_delta for steps steps
+    """
+
+
+    NAME_FIELD_NUMBER: builtins.int
+    METRIC_FIELD_NUMBER: builtins.int
+    KIND_FIELD_NUMBER: builtins.int
+    OP_FIELD_NUMBER: builtins.int
+    THRESHOLD_FIELD_NUMBER: builtins.int
+    STEPS_FIELD_NUMBER: builtins.int
+    GOAL_FIELD_NUMBER: builtins.int
+    MIN_DELTA_FIELD_NUMBER: builtins.int
+    _INFO_FIELD_NUMBER: builtins.int
+    name: builtins.str
+    """name identifies the condition, a condition with the same name replaces it"""
+    metric: builtins.str
+    kind: global___StopConditionRequest.Kind.ValueType
+    op: builtins.str
+    """op is one of <, <=, > and >="""
+    threshold: builtins.float
+    steps: builtins.int
+    goal: global___MetricRecord.MetricGoal.ValueType
+    min_delta: builtins.float
+    @property
+    def _info(self) -> wandb.proto.wandb_base_pb2._RequestInfo: ...
+    def __init__(
+        self,
+        *,
+        name: builtins.str = ...,
+        metric: builtins.str = ...,
+        kind: global___StopConditionRequest.Kind.ValueType = ...,
+        op: builtins.str = ...,
+        threshold: builtins.float = ...,
+        steps: builtins.int = ...,
+        goal: global___MetricRecord.MetricGoal.ValueType = ...,
+        min_delta: builtins.float = ...,
+        _info: wandb.proto.wandb_base_pb2._RequestInfo | None = ...,
+    ) -> None: ...
+    def HasField(self, field_name: typing_extensions.Literal["_info", b"_info"]) -> builtins.bool: ...
+    def ClearField(self, field_name: typing_extensions.Literal["_info", b"_info", "goal", b"goal", "kind", b"kind", "metric", b"metric", "min_delta", b"min_delta", "name", b"name", "op", b"op", "steps", b"steps", "threshold", b"threshold"]) -> None: ...
+
+global___StopConditionRequest = StopConditionRequest
+
+class StopConditionResponse(google.protobuf.message.Message):
+    DESCRIPTOR: google.protobuf.descriptor.Descriptor
+
+    ERROR_FIELD_NUMBER: builtins.int
+    @property
+    def error(self) -> global___ErrorInfo: ...
+    def __init__(
+        self,
+        *,
+        error: global___ErrorInfo | None = ...,
+    ) -> None: ...
+    def HasField(self, field_name: typing_extensions.Literal["error", b"error"]) -> builtins.bool: ...
+    def ClearField(self, field_name: typing_extensions.Literal["error", b"error"]) -> None: ...
+
+global___StopConditionResponse = StopConditionResponse
+
+class TableRecord(google.protobuf.message.Message):
+    """
+    TableRecord: appends rows to a table, logged to the history and to an
+    artifact of the run once committed
+    """
+
+    DESCRIPTOR: google.protobuf.descriptor.Descriptor
+
+    KEY_FIELD_NUMBER: builtins.int
+    COLUMNS_FIELD_NUMBER: builtins.int
+    DTYPES_FIELD_NUMBER: builtins.int
+    ROWS_JSON_FIELD_NUMBER: builtins.int
+    COMMIT_FIELD_NUMBER: builtins.int
+    _INFO_FIELD_NUMBER: builtins.int
+    key: builtins.str
+    """key is the history key of the table"""
+    @property
+    def columns(self) -> google.protobuf.internal.containers.RepeatedScalarFieldContainer[builtins.str]:
+        """columns are the names of the columns, set by the first record of the
+        table, a later record that sets them must set the same
+        """
+    @property
+    def dtypes(self) -> google.protobuf.internal.containers.RepeatedScalarFieldContainer[builtins.str]:
+        """dtypes are the types of the columns, any, number, string or boolean,
+        any by default
+        """
+    @property
+    def rows_json(self) -> google.protobuf.internal.containers.RepeatedScalarFieldContainer[builtins.str]:
+        """rows_json are the rows appended, each a JSON array of a value per column"""
+    commit: builtins.bool
+    """commit logs the table with all of its rows at the current step, the
+    rows appended later make the next version of the table
+    """
+    @property
+    def _info(self) -> wandb.proto.wandb_base_pb2._RecordInfo: ...
+    def __init__(
+        self,
+        *,
+        key: builtins.str = ...,
+        columns: collections.abc.Iterable[builtins.str] | None = ...,
+        dtypes: collections.abc.Iterable[builtins.str] | None = ...,
+        rows_json: collections.abc.Iterable[builtins.str] | None = ...,
+        commit: builtins.bool = ...,
+        _info: wandb.proto.wandb_base_pb2._RecordInfo | None = ...,
+    ) -> None: ...
+    def HasField(self, field_name: typing_extensions.Literal["_info", b"_info"]) -> builtins.bool: ...
+    def ClearField(self, field_name: typing_extensions.Literal["_info", b"_info", "columns", b"columns", "commit", b"commit", "dtypes", b"dtypes", "key", b"key", "rows_json", b"rows_json"]) -> None: ...
+
+global___TableRecord = TableRecord
+
+class FlowCreditRequest(google.protobuf.message.Message):
+    """
+    FlowCreditRequest: asks for the credits to send records, granted from the
+    capacity left downstream of the handler. A client with flow control sends
+    a record per credit, and waits for the response once it has none left.
+    """
+
+    DESCRIPTOR: google.protobuf.descriptor.Descriptor
+
+    WANT_FIELD_NUMBER: builtins.int
+    _INFO_FIELD_NUMBER: builtins.int
+    want: builtins.int
+    """want is the number of credits asked for"""
+    @property
+    def _info(self) -> wandb.proto.wandb_base_pb2._RequestInfo: ...
+    def __init__(
+        self,
+        *,
+        want: builtins.int = ...,
+        _info: wandb.proto.wandb_base_pb2._RequestInfo | None = ...,
+    ) -> None: ...
+    def HasField(self, field_name: typing_extensions.Literal["_info", b"_info"]) -> builtins.bool: ...
+    def ClearField(self, field_name: typing_extensions.Literal["_info", b"_info", "want", b"want"]) -> None: ...
+
+global___FlowCreditRequest = FlowCreditRequest
+
+class FlowCreditResponse(google.protobuf.message.Message):
+    DESCRIPTOR: google.protobuf.descriptor.Descriptor
+
+    CREDITS_FIELD_NUMBER: builtins.int
+    credits: builtins.int
+    def __init__(
+        self,
+        *,
+        credits: builtins.int = ...,
+    ) -> None: ...
+    def ClearField(self, field_name: typing_extensions.Literal["credits", b"credits"]) -> None: ...
+
+global___FlowCreditResponse = FlowCreditResponse
+
+class GoOnlineRequest(google.protobuf.message.Message):
+    """
+    GoOnlineRequest: brings a run started offline online, the records stored
+    while it was offline are then sent to the backend in order
+    """
+
+    DESCRIPTOR: google.protobuf.descriptor.Descriptor
+
+    _INFO_FIELD_NUMBER: builtins.int
+    @property
+    def _info(self) -> wandb.proto.wandb_base_pb2._RequestInfo: ...
+    def __init__(
+        self,
+        *,
+        _info: wandb.proto.wandb_base_pb2._RequestInfo | None = ...,
+    ) -> None: ...
+    def HasField(self, field_name: typing_extensions.Literal["_info", b"_info"]) -> builtins.bool: ...
+    def ClearField(self, field_name: typing_extensions.Literal["_info", b"_info"]) -> None: ...
+
+global___GoOnlineRequest = GoOnlineRequest
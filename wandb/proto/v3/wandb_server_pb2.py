@@ -17,7 +17,7 @@ from wandb.proto import wandb_internal_pb2 as wandb_dot_proto_dot_wandb__interna
 from wandb.proto import wandb_settings_pb2 as wandb_dot_proto_dot_wandb__settings__pb2
 
 
-DESCRIPTOR = _descriptor_pool.Default().AddSerializedFile(b'\n\x1ewandb/proto/wandb_server.proto\x12\x0ewandb_internal\x1a\x1cwandb/proto/wandb_base.proto\x1a wandb/proto/wandb_internal.proto\x1a wandb/proto/wandb_settings.proto\"D\n\x15ServerShutdownRequest\x12+\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1b.wandb_internal._RecordInfo\"\x18\n\x16ServerShutdownResponse\"B\n\x13ServerStatusRequest\x12+\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1b.wandb_internal._RecordInfo\"\x16\n\x14ServerStatusResponse\"r\n\x17ServerInformInitRequest\x12*\n\x08settings\x18\x01 \x01(\x0b\x32\x18.wandb_internal.Settings\x12+\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1b.wandb_internal._RecordInfo\"\x1a\n\x18ServerInformInitResponse\"s\n\x18ServerInformStartRequest\x12*\n\x08settings\x18\x01 \x01(\x0b\x32\x18.wandb_internal.Settings\x12+\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1b.wandb_internal._RecordInfo\"\x1b\n\x19ServerInformStartResponse\"H\n\x19ServerInformFinishRequest\x12+\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1b.wandb_internal._RecordInfo\"\x1c\n\x1aServerInformFinishResponse\"H\n\x19ServerInformAttachRequest\x12+\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1b.wandb_internal._RecordInfo\"u\n\x1aServerInformAttachResponse\x12*\n\x08settings\x18\x01 \x01(\x0b\x32\x18.wandb_internal.Settings\x12+\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1b.wandb_internal._RecordInfo\"H\n\x19ServerInformDetachRequest\x12+\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1b.wandb_internal._RecordInfo\"\x1c\n\x1aServerInformDetachResponse\"]\n\x1bServerInformTeardownRequest\x12\x11\n\texit_code\x18\x01 \x01(\x05\x12+\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1b.wandb_internal._RecordInfo\"\x1e\n\x1cServerInformTeardownResponse\"\xa4\x04\n\rServerRequest\x12\x30\n\x0erecord_publish\x18\x01 \x01(\x0b\x32\x16.wandb_internal.RecordH\x00\x12\x34\n\x12record_communicate\x18\x02 \x01(\x0b\x32\x16.wandb_internal.RecordH\x00\x12>\n\x0binform_init\x18\x03 \x01(\x0b\x32\'.wandb_internal.ServerInformInitRequestH\x00\x12\x42\n\rinform_finish\x18\x04 \x01(\x0b\x32).wandb_internal.ServerInformFinishRequestH\x00\x12\x42\n\rinform_attach\x18\x05 \x01(\x0b\x32).wandb_internal.ServerInformAttachRequestH\x00\x12\x42\n\rinform_detach\x18\x06 \x01(\x0b\x32).wandb_internal.ServerInformDetachRequestH\x00\x12\x46\n\x0finform_teardown\x18\x07 \x01(\x0b\x32+.wandb_internal.ServerInformTeardownRequestH\x00\x12@\n\x0cinform_start\x18\x08 \x01(\x0b\x32(.wandb_internal.ServerInformStartRequestH\x00\x42\x15\n\x13server_request_type\"\xb0\x04\n\x0eServerResponse\x12\x34\n\x12result_communicate\x18\x02 \x01(\x0b\x32\x16.wandb_internal.ResultH\x00\x12H\n\x14inform_init_response\x18\x03 \x01(\x0b\x32(.wandb_internal.ServerInformInitResponseH\x00\x12L\n\x16inform_finish_response\x18\x04 \x01(\x0b\x32*.wandb_internal.ServerInformFinishResponseH\x00\x12L\n\x16inform_attach_response\x18\x05 \x01(\x0b\x32*.wandb_internal.ServerInformAttachResponseH\x00\x12L\n\x16inform_detach_response\x18\x06 \x01(\x0b\x32*.wandb_internal.ServerInformDetachResponseH\x00\x12P\n\x18inform_teardown_response\x18\x07 \x01(\x0b\x32,.wandb_internal.ServerInformTeardownResponseH\x00\x12J\n\x15inform_start_response\x18\x08 \x01(\x0b\x32).wandb_internal.ServerInformStartResponseH\x00\x42\x16\n\x14server_response_typeb\x06proto3')
+DESCRIPTOR = _descriptor_pool.Default().AddSerializedFile(b'\n\x1ewandb/proto/wandb_server.proto\x12\x0ewandb_internal\x1a\x1cwandb/proto/wandb_base.proto\x1a wandb/proto/wandb_internal.proto\x1a wandb/proto/wandb_settings.proto\"D\n\x15ServerShutdownRequest\x12+\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1b.wandb_internal._RecordInfo\"\x18\n\x16ServerShutdownResponse\"B\n\x13ServerStatusRequest\x12+\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1b.wandb_internal._RecordInfo\"\x16\n\x14ServerStatusResponse\"r\n\x17ServerInformInitRequest\x12*\n\x08settings\x18\x01 \x01(\x0b\x32\x18.wandb_internal.Settings\x12+\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1b.wandb_internal._RecordInfo\"\x1a\n\x18ServerInformInitResponse\"s\n\x18ServerInformStartRequest\x12*\n\x08settings\x18\x01 \x01(\x0b\x32\x18.wandb_internal.Settings\x12+\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1b.wandb_internal._RecordInfo\"\x1b\n\x19ServerInformStartResponse\"H\n\x19ServerInformFinishRequest\x12+\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1b.wandb_internal._RecordInfo\"\x1c\n\x1aServerInformFinishResponse\"H\n\x19ServerInformAttachRequest\x12+\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1b.wandb_internal._RecordInfo\"u\n\x1aServerInformAttachResponse\x12*\n\x08settings\x18\x01 \x01(\x0b\x32\x18.wandb_internal.Settings\x12+\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1b.wandb_internal._RecordInfo\"H\n\x19ServerInformDetachRequest\x12+\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1b.wandb_internal._RecordInfo\"\x1c\n\x1aServerInformDetachResponse\"]\n\x1bServerInformTeardownRequest\x12\x11\n\texit_code\x18\x01 \x01(\x05\x12+\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1b.wandb_internal._RecordInfo\"\x1e\n\x1cServerInformTeardownResponse\"\xae\x06\n\rServerRequest\x12\x30\n\x0erecord_publish\x18\x01 \x01(\x0b\x32\x16.wandb_internal.RecordH\x00\x12\x34\n\x12record_communicate\x18\x02 \x01(\x0b\x32\x16.wandb_internal.RecordH\x00\x12>\n\x0binform_init\x18\x03 \x01(\x0b\x32\'.wandb_internal.ServerInformInitRequestH\x00\x12\x42\n\rinform_finish\x18\x04 \x01(\x0b\x32).wandb_internal.ServerInformFinishRequestH\x00\x12\x42\n\rinform_attach\x18\x05 \x01(\x0b\x32).wandb_internal.ServerInformAttachRequestH\x00\x12\x42\n\rinform_detach\x18\x06 \x01(\x0b\x32).wandb_internal.ServerInformDetachRequestH\x00\x12\x46\n\x0finform_teardown\x18\x07 \x01(\x0b\x32+.wandb_internal.ServerInformTeardownRequestH\x00\x12@\n\x0cinform_start\x18\x08 \x01(\x0b\x32(.wandb_internal.ServerInformStartRequestH\x00\x12\x42\n\rcompact_store\x18\t \x01(\x0b\x32).wandb_internal.ServerCompactStoreRequestH\x00\x12@\n\x0c\x65xport_store\x18\n \x01(\x0b\x32(.wandb_internal.ServerExportStoreRequestH\x00\x12@\n\x0cimport_store\x18\x0b \x01(\x0b\x32(.wandb_internal.ServerImportStoreRequestH\x00\x12@\n\x0cverify_store\x18\x0c \x01(\x0b\x32(.wandb_internal.ServerVerifyStoreRequestH\x00\x42\x15\n\x13server_request_type\"\xe2\x06\n\x0eServerResponse\x12\x34\n\x12result_communicate\x18\x02 \x01(\x0b\x32\x16.wandb_internal.ResultH\x00\x12H\n\x14inform_init_response\x18\x03 \x01(\x0b\x32(.wandb_internal.ServerInformInitResponseH\x00\x12L\n\x16inform_finish_response\x18\x04 \x01(\x0b\x32*.wandb_internal.ServerInformFinishResponseH\x00\x12L\n\x16inform_attach_response\x18\x05 \x01(\x0b\x32*.wandb_internal.ServerInformAttachResponseH\x00\x12L\n\x16inform_detach_response\x18\x06 \x01(\x0b\x32*.wandb_internal.ServerInformDetachResponseH\x00\x12P\n\x18inform_teardown_response\x18\x07 \x01(\x0b\x32,.wandb_internal.ServerInformTeardownResponseH\x00\x12J\n\x15inform_start_response\x18\x08 \x01(\x0b\x32).wandb_internal.ServerInformStartResponseH\x00\x12L\n\x16\x63ompact_store_response\x18\t \x01(\x0b\x32*.wandb_internal.ServerCompactStoreResponseH\x00\x12J\n\x15\x65xport_store_response\x18\n \x01(\x0b\x32).wandb_internal.ServerExportStoreResponseH\x00\x12J\n\x15import_store_response\x18\x0b \x01(\x0b\x32).wandb_internal.ServerImportStoreResponseH\x00\x12J\n\x15verify_store_response\x18\x0c \x01(\x0b\x32).wandb_internal.ServerVerifyStoreResponseH\x00\x42\x16\n\x14server_response_type\"k\n\x19ServerCompactStoreRequest\x12\x0c\n\x04path\x18\x01 \x01(\t\x12\x13\n\x0boutput_path\x18\x02 \x01(\t\x12+\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1b.wandb_internal._RecordInfo\"\xa2\x01\n\x1aServerCompactStoreResponse\x12\x14\n\x0crecords_read\x18\x01 \x01(\x03\x12\x17\n\x0frecords_written\x18\x02 \x01(\x03\x12(\n\x05\x65rror\x18\x03 \x01(\x0b\x32\x19.wandb_internal.ErrorInfo\x12+\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1b.wandb_internal._RecordInfo\"y\n\x18ServerExportStoreRequest\x12\x0c\n\x04path\x18\x01 \x01(\t\x12\x12\n\noutput_dir\x18\x02 \x01(\t\x12\x0e\n\x06\x66ormat\x18\x03 \x01(\t\x12+\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1b.wandb_internal._RecordInfo\"\xc9\x01\n\x19ServerExportStoreResponse\x12\x14\n\x0chistory_rows\x18\x01 \x01(\x03\x12\x13\n\x0b\x63onfig_rows\x18\x02 \x01(\x03\x12\x14\n\x0csummary_rows\x18\x03 \x01(\x03\x12\x14\n\x0c\x63onsole_rows\x18\x04 \x01(\x03\x12(\n\x05\x65rror\x18\x05 \x01(\x0b\x32\x19.wandb_internal.ErrorInfo\x12+\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1b.wandb_internal._RecordInfo\"\xab\x01\n\x18ServerImportStoreRequest\x12\x0c\n\x04path\x18\x01 \x01(\t\x12\x13\n\x0boutput_path\x18\x02 \x01(\t\x12\x0e\n\x06\x66ormat\x18\x03 \x01(\t\x12\x0e\n\x06run_id\x18\x04 \x01(\t\x12\x0f\n\x07project\x18\x05 \x01(\t\x12\x0e\n\x06\x65ntity\x18\x06 \x01(\t\x12+\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1b.wandb_internal._RecordInfo\"\xb2\x01\n\x19ServerImportStoreResponse\x12\x17\n\x0frecords_written\x18\x01 \x01(\x03\x12\x14\n\x0chistory_rows\x18\x02 \x01(\x03\x12\x0f\n\x07skipped\x18\x03 \x01(\x03\x12(\n\x05\x65rror\x18\x04 \x01(\x0b\x32\x19.wandb_internal.ErrorInfo\x12+\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1b.wandb_internal._RecordInfo\"U\n\x18ServerVerifyStoreRequest\x12\x0c\n\x04path\x18\x01 \x01(\t\x12+\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1b.wandb_internal._RecordInfo\"\xa0\x01\n\x19ServerVerifyStoreResponse\x12,\n\x06report\x18\x01 \x01(\x0b\x32\x1c.wandb_internal.VerifyReport\x12(\n\x05\x65rror\x18\x02 \x01(\x0b\x32\x19.wandb_internal.ErrorInfo\x12+\n\x05_info\x18\xc8\x01 \x01(\x0b\x32\x1b.wandb_internal._RecordInfob\x06proto3')
 
 
 
@@ -39,6 +39,14 @@ _SERVERINFORMTEARDOWNREQUEST = DESCRIPTOR.message_types_by_name['ServerInformTea
 _SERVERINFORMTEARDOWNRESPONSE = DESCRIPTOR.message_types_by_name['ServerInformTeardownResponse']
 _SERVERREQUEST = DESCRIPTOR.message_types_by_name['ServerRequest']
 _SERVERRESPONSE = DESCRIPTOR.message_types_by_name['ServerResponse']
+_SERVERCOMPACTSTOREREQUEST = DESCRIPTOR.message_types_by_name['ServerCompactStoreRequest']
+_SERVERCOMPACTSTORERESPONSE = DESCRIPTOR.message_types_by_name['ServerCompactStoreResponse']
+_SERVEREXPORTSTOREREQUEST = DESCRIPTOR.message_types_by_name['ServerExportStoreRequest']
+_SERVEREXPORTSTORERESPONSE = DESCRIPTOR.message_types_by_name['ServerExportStoreResponse']
+_SERVERIMPORTSTOREREQUEST = DESCRIPTOR.message_types_by_name['ServerImportStoreRequest']
+_SERVERIMPORTSTORERESPONSE = DESCRIPTOR.message_types_by_name['ServerImportStoreResponse']
+_SERVERVERIFYSTOREREQUEST = DESCRIPTOR.message_types_by_name['ServerVerifyStoreRequest']
+_SERVERVERIFYSTORERESPONSE = DESCRIPTOR.message_types_by_name['ServerVerifyStoreResponse']
 ServerShutdownRequest = _reflection.GeneratedProtocolMessageType('ServerShutdownRequest', (_message.Message,), {
   'DESCRIPTOR' : _SERVERSHUTDOWNREQUEST,
   '__module__' : 'wandb.proto.wandb_server_pb2'
@@ -165,6 +173,62 @@ ServerResponse = _reflection.GeneratedProtocolMessageType('ServerResponse', (_me
   })
 _sym_db.RegisterMessage(ServerResponse)
 
+ServerCompactStoreRequest = _reflection.GeneratedProtocolMessageType('ServerCompactStoreRequest', (_message.Message,), {
+  'DESCRIPTOR' : _SERVERCOMPACTSTOREREQUEST,
+  '__module__' : 'wandb.proto.wandb_server_pb2'
+  # @@protoc_insertion_point(class_scope:wandb_internal.ServerCompactStoreRequest)
+  })
+_sym_db.RegisterMessage(ServerCompactStoreRequest)
+
+ServerCompactStoreResponse = _reflection.GeneratedProtocolMessageType('ServerCompactStoreResponse', (_message.Message,), {
+  'DESCRIPTOR' : _SERVERCOMPACTSTORERESPONSE,
+  '__module__' : 'wandb.proto.wandb_server_pb2'
+  # @@protoc_insertion_point(class_scope:wandb_internal.ServerCompactStoreResponse)
+  })
+_sym_db.RegisterMessage(ServerCompactStoreResponse)
+
+ServerExportStoreRequest = _reflection.GeneratedProtocolMessageType('ServerExportStoreRequest', (_message.Message,), {
+  'DESCRIPTOR' : _SERVEREXPORTSTOREREQUEST,
+  '__module__' : 'wandb.proto.wandb_server_pb2'
+  # @@protoc_insertion_point(class_scope:wandb_internal.ServerExportStoreRequest)
+  })
+_sym_db.RegisterMessage(ServerExportStoreRequest)
+
+ServerExportStoreResponse = _reflection.GeneratedProtocolMessageType('ServerExportStoreResponse', (_message.Message,), {
+  'DESCRIPTOR' : _SERVEREXPORTSTORERESPONSE,
+  '__module__' : 'wandb.proto.wandb_server_pb2'
+  # @@protoc_insertion_point(class_scope:wandb_internal.ServerExportStoreResponse)
+  })
+_sym_db.RegisterMessage(ServerExportStoreResponse)
+
+ServerImportStoreRequest = _reflection.GeneratedProtocolMessageType('ServerImportStoreRequest', (_message.Message,), {
+  'DESCRIPTOR' : _SERVERIMPORTSTOREREQUEST,
+  '__module__' : 'wandb.proto.wandb_server_pb2'
+  # @@protoc_insertion_point(class_scope:wandb_internal.ServerImportStoreRequest)
+  })
+_sym_db.RegisterMessage(ServerImportStoreRequest)
+
+ServerImportStoreResponse = _reflection.GeneratedProtocolMessageType('ServerImportStoreResponse', (_message.Message,), {
+  'DESCRIPTOR' : _SERVERIMPORTSTORERESPONSE,
+  '__module__' : 'wandb.proto.wandb_server_pb2'
+  # @@protoc_insertion_point(class_scope:wandb_internal.ServerImportStoreResponse)
+  })
+_sym_db.RegisterMessage(ServerImportStoreResponse)
+
+ServerVerifyStoreRequest = _reflection.GeneratedProtocolMessageType('ServerVerifyStoreRequest', (_message.Message,), {
+  'DESCRIPTOR' : _SERVERVERIFYSTOREREQUEST,
+  '__module__' : 'wandb.proto.wandb_server_pb2'
+  # @@protoc_insertion_point(class_scope:wandb_internal.ServerVerifyStoreRequest)
+  })
+_sym_db.RegisterMessage(ServerVerifyStoreRequest)
+
+ServerVerifyStoreResponse = _reflection.GeneratedProtocolMessageType('ServerVerifyStoreResponse', (_message.Message,), {
+  'DESCRIPTOR' : _SERVERVERIFYSTORERESPONSE,
+  '__module__' : 'wandb.proto.wandb_server_pb2'
+  # @@protoc_insertion_point(class_scope:wandb_internal.ServerVerifyStoreResponse)
+  })
+_sym_db.RegisterMessage(ServerVerifyStoreResponse)
+
 if _descriptor._USE_C_DESCRIPTORS == False:
 
   DESCRIPTOR._options = None
@@ -201,7 +265,23 @@ if _descriptor._USE_C_DESCRIPTORS == False:
   _SERVERINFORMTEARDOWNRESPONSE._serialized_start=1122
   _SERVERINFORMTEARDOWNRESPONSE._serialized_end=1152
   _SERVERREQUEST._serialized_start=1155
-  _SERVERREQUEST._serialized_end=1703
-  _SERVERRESPONSE._serialized_start=1706
-  _SERVERRESPONSE._serialized_end=2266
+  _SERVERREQUEST._serialized_end=1969
+  _SERVERRESPONSE._serialized_start=1972
+  _SERVERRESPONSE._serialized_end=2838
+  _SERVERCOMPACTSTOREREQUEST._serialized_start=2840
+  _SERVERCOMPACTSTOREREQUEST._serialized_end=2947
+  _SERVERCOMPACTSTORERESPONSE._serialized_start=2950
+  _SERVERCOMPACTSTORERESPONSE._serialized_end=3112
+  _SERVEREXPORTSTOREREQUEST._serialized_start=3114
+  _SERVEREXPORTSTOREREQUEST._serialized_end=3235
+  _SERVEREXPORTSTORERESPONSE._serialized_start=3238
+  _SERVEREXPORTSTORERESPONSE._serialized_end=3439
+  _SERVERIMPORTSTOREREQUEST._serialized_start=3442
+  _SERVERIMPORTSTOREREQUEST._serialized_end=3613
+  _SERVERIMPORTSTORERESPONSE._serialized_start=3616
+  _SERVERIMPORTSTORERESPONSE._serialized_end=3794
+  _SERVERVERIFYSTOREREQUEST._serialized_start=3796
+  _SERVERVERIFYSTOREREQUEST._serialized_end=3881
+  _SERVERVERIFYSTORERESPONSE._serialized_start=3884
+  _SERVERVERIFYSTORERESPONSE._serialized_end=4044
 # @@protoc_insertion_point(module_scope)
@@ -271,6 +271,10 @@ class ServerRequest(google.protobuf.message.Message):
     INFORM_DETACH_FIELD_NUMBER: builtins.int
     INFORM_TEARDOWN_FIELD_NUMBER: builtins.int
     INFORM_START_FIELD_NUMBER: builtins.int
+    COMPACT_STORE_FIELD_NUMBER: builtins.int
+    EXPORT_STORE_FIELD_NUMBER: builtins.int
+    IMPORT_STORE_FIELD_NUMBER: builtins.int
+    VERIFY_STORE_FIELD_NUMBER: builtins.int
     @property
     def record_publish(self) -> wandb.proto.wandb_internal_pb2.Record: ...
     @property
@@ -287,6 +291,14 @@ class ServerRequest(google.protobuf.message.Message):
     def inform_teardown(self) -> global___ServerInformTeardownRequest: ...
     @property
     def inform_start(self) -> global___ServerInformStartRequest: ...
+    @property
+    def compact_store(self) -> global___ServerCompactStoreRequest: ...
+    @property
+    def export_store(self) -> global___ServerExportStoreRequest: ...
+    @property
+    def import_store(self) -> global___ServerImportStoreRequest: ...
+    @property
+    def verify_store(self) -> global___ServerVerifyStoreRequest: ...
     def __init__(
         self,
         *,
@@ -298,10 +310,14 @@ class ServerRequest(google.protobuf.message.Message):
         inform_detach: global___ServerInformDetachRequest | None = ...,
         inform_teardown: global___ServerInformTeardownRequest | None = ...,
         inform_start: global___ServerInformStartRequest | None = ...,
+        compact_store: global___ServerCompactStoreRequest | None = ...,
+        export_store: global___ServerExportStoreRequest | None = ...,
+        import_store: global___ServerImportStoreRequest | None = ...,
+        verify_store: global___ServerVerifyStoreRequest | None = ...,
     ) -> None: ...
-    def HasField(self, field_name: typing_extensions.Literal["inform_attach", b"inform_attach", "inform_detach", b"inform_detach", "inform_finish", b"inform_finish", "inform_init", b"inform_init", "inform_start", b"inform_start", "inform_teardown", b"inform_teardown", "record_communicate", b"record_communicate", "record_publish", b"record_publish", "server_request_type", b"server_request_type"]) -> builtins.bool: ...
-    def ClearField(self, field_name: typing_extensions.Literal["inform_attach", b"inform_attach", "inform_detach", b"inform_detach", "inform_finish", b"inform_finish", "inform_init", b"inform_init", "inform_start", b"inform_start", "inform_teardown", b"inform_teardown", "record_communicate", b"record_communicate", "record_publish", b"record_publish", "server_request_type", b"server_request_type"]) -> None: ...
-    def WhichOneof(self, oneof_group: typing_extensions.Literal["server_request_type", b"server_request_type"]) -> typing_extensions.Literal["record_publish", "record_communicate", "inform_init", "inform_finish", "inform_attach", "inform_detach", "inform_teardown", "inform_start"] | None: ...
+    def HasField(self, field_name: typing_extensions.Literal["compact_store", b"compact_store", "export_store", b"export_store", "import_store", b"import_store", "inform_attach", b"inform_attach", "inform_detach", b"inform_detach", "inform_finish", b"inform_finish", "inform_init", b"inform_init", "inform_start", b"inform_start", "inform_teardown", b"inform_teardown", "record_communicate", b"record_communicate", "record_publish", b"record_publish", "server_request_type", b"server_request_type", "verify_store", b"verify_store"]) -> builtins.bool: ...
+    def ClearField(self, field_name: typing_extensions.Literal["compact_store", b"compact_store", "export_store", b"export_store", "import_store", b"import_store", "inform_attach", b"inform_attach", "inform_detach", b"inform_detach", "inform_finish", b"inform_finish", "inform_init", b"inform_init", "inform_start", b"inform_start", "inform_teardown", b"inform_teardown", "record_communicate", b"record_communicate", "record_publish", b"record_publish", "server_request_type", b"server_request_type", "verify_store", b"verify_store"]) -> None: ...
+    def WhichOneof(self, oneof_group: typing_extensions.Literal["server_request_type", b"server_request_type"]) -> typing_extensions.Literal["record_publish", "record_communicate", "inform_init", "inform_finish", "inform_attach", "inform_detach", "inform_teardown", "inform_start", "compact_store", "export_store", "import_store", "verify_store"] | None: ...
 
 global___ServerRequest = ServerRequest
 
@@ -316,6 +332,10 @@ class ServerResponse(google.protobuf.message.Message):
     INFORM_DETACH_RESPONSE_FIELD_NUMBER: builtins.int
     INFORM_TEARDOWN_RESPONSE_FIELD_NUMBER: builtins.int
     INFORM_START_RESPONSE_FIELD_NUMBER: builtins.int
+    COMPACT_STORE_RESPONSE_FIELD_NUMBER: builtins.int
+    EXPORT_STORE_RESPONSE_FIELD_NUMBER: builtins.int
+    IMPORT_STORE_RESPONSE_FIELD_NUMBER: builtins.int
+    VERIFY_STORE_RESPONSE_FIELD_NUMBER: builtins.int
     @property
     def result_communicate(self) -> wandb.proto.wandb_internal_pb2.Result: ...
     @property
@@ -330,6 +350,14 @@ class ServerResponse(google.protobuf.message.Message):
     def inform_teardown_response(self) -> global___ServerInformTeardownResponse: ...
     @property
     def inform_start_response(self) -> global___ServerInformStartResponse: ...
+    @property
+    def compact_store_response(self) -> global___ServerCompactStoreResponse: ...
+    @property
+    def export_store_response(self) -> global___ServerExportStoreResponse: ...
+    @property
+    def import_store_response(self) -> global___ServerImportStoreResponse: ...
+    @property
+    def verify_store_response(self) -> global___ServerVerifyStoreResponse: ...
     def __init__(
         self,
         *,
@@ -340,9 +368,250 @@ class ServerResponse(google.protobuf.message.Message):
         inform_detach_response: global___ServerInformDetachResponse | None = ...,
         inform_teardown_response: global___ServerInformTeardownResponse | None = ...,
         inform_start_response: global___ServerInformStartResponse | None = ...,
+        compact_store_response: global___ServerCompactStoreResponse | None = ...,
+        export_store_response: global___ServerExportStoreResponse | None = ...,
+        import_store_response: global___ServerImportStoreResponse | None = ...,
+        verify_store_response: global___ServerVerifyStoreResponse | None = ...,
     ) -> None: ...
-    def HasField(self, field_name: typing_extensions.Literal["inform_attach_response", b"inform_attach_response", "inform_detach_response", b"inform_detach_response", "inform_finish_response", b"inform_finish_response", "inform_init_response", b"inform_init_response", "inform_start_response", b"inform_start_response", "inform_teardown_response", b"inform_teardown_response", "result_communicate", b"result_communicate", "server_response_type", b"server_response_type"]) -> builtins.bool: ...
-    def ClearField(self, field_name: typing_extensions.Literal["inform_attach_response", b"inform_attach_response", "inform_detach_response", b"inform_detach_response", "inform_finish_response", b"inform_finish_response", "inform_init_response", b"inform_init_response", "inform_start_response", b"inform_start_response", "inform_teardown_response", b"inform_teardown_response", "result_communicate", b"result_communicate", "server_response_type", b"server_response_type"]) -> None: ...
-    def WhichOneof(self, oneof_group: typing_extensions.Literal["server_response_type", b"server_response_type"]) -> typing_extensions.Literal["result_communicate", "inform_init_response", "inform_finish_response", "inform_attach_response", "inform_detach_response", "inform_teardown_response", "inform_start_response"] | None: ...
+    def HasField(self, field_name: typing_extensions.Literal["compact_store_response", b"compact_store_response", "export_store_response", b"export_store_response", "import_store_response", b"import_store_response", "inform_attach_response", b"inform_attach_response", "inform_detach_response", b"inform_detach_response", "inform_finish_response", b"inform_finish_response", "inform_init_response", b"inform_init_response", "inform_start_response", b"inform_start_response", "inform_teardown_response", b"inform_teardown_response", "result_communicate", b"result_communicate", "server_response_type", b"server_response_type", "verify_store_response", b"verify_store_response"]) -> builtins.bool: ...
+    def ClearField(self, field_name: typing_extensions.Literal["compact_store_response", b"compact_store_response", "export_store_response", b"export_store_response", "import_store_response", b"import_store_response", "inform_attach_response", b"inform_attach_response", "inform_detach_response", b"inform_detach_response", "inform_finish_response", b"inform_finish_response", "inform_init_response", b"inform_init_response", "inform_start_response", b"inform_start_response", "inform_teardown_response", b"inform_teardown_response", "result_communicate", b"result_communicate", "server_response_type", b"server_response_type", "verify_store_response", b"verify_store_response"]) -> None: ...
+    def WhichOneof(self, oneof_group: typing_extensions.Literal["server_response_type", b"server_response_type"]) -> typing_extensions.Literal["result_communicate", "inform_init_response", "inform_finish_response", "inform_attach_response", "inform_detach_response", "inform_teardown_response", "inform_start_response", "compact_store_response", "export_store_response", "import_store_response", "verify_store_response"] | None: ...
 
 global___ServerResponse = ServerResponse
+
+@typing_extensions.final
+class ServerCompactStoreRequest(google.protobuf.message.Message):
+    """
+    ServerCompactStoreRequest: rewrite a .wandb file without superseded records
+    """
+
+    DESCRIPTOR: google.protobuf.descriptor.Descriptor
+
+    PATH_FIELD_NUMBER: builtins.int
+    OUTPUT_PATH_FIELD_NUMBER: builtins.int
+    _INFO_FIELD_NUMBER: builtins.int
+    path: builtins.str
+    output_path: builtins.str
+    @property
+    def _info(self) -> wandb.proto.wandb_base_pb2._RecordInfo: ...
+    def __init__(
+        self,
+        *,
+        path: builtins.str = ...,
+        output_path: builtins.str = ...,
+        _info: wandb.proto.wandb_base_pb2._RecordInfo | None = ...,
+    ) -> None: ...
+    def HasField(self, field_name: typing_extensions.Literal["_info", b"_info"]) -> builtins.bool: ...
+    def ClearField(self, field_name: typing_extensions.Literal["_info", b"_info", "output_path", b"output_path", "path", b"path"]) -> None: ...
+
+global___ServerCompactStoreRequest = ServerCompactStoreRequest
+
+@typing_extensions.final
+class ServerCompactStoreResponse(google.protobuf.message.Message):
+    DESCRIPTOR: google.protobuf.descriptor.Descriptor
+
+    RECORDS_READ_FIELD_NUMBER: builtins.int
+    RECORDS_WRITTEN_FIELD_NUMBER: builtins.int
+    ERROR_FIELD_NUMBER: builtins.int
+    _INFO_FIELD_NUMBER: builtins.int
+    records_read: builtins.int
+    records_written: builtins.int
+    @property
+    def error(self) -> wandb.proto.wandb_internal_pb2.ErrorInfo: ...
+    @property
+    def _info(self) -> wandb.proto.wandb_base_pb2._RecordInfo: ...
+    def __init__(
+        self,
+        *,
+        records_read: builtins.int = ...,
+        records_written: builtins.int = ...,
+        error: wandb.proto.wandb_internal_pb2.ErrorInfo | None = ...,
+        _info: wandb.proto.wandb_base_pb2._RecordInfo | None = ...,
+    ) -> None: ...
+    def HasField(self, field_name: typing_extensions.Literal["_info", b"_info", "error", b"error"]) -> builtins.bool: ...
+    def ClearField(self, field_name: typing_extensions.Literal["_info", b"_info", "error", b"error", "records_read", b"records_read", "records_written", b"records_written"]) -> None: ...
+
+global___ServerCompactStoreResponse = ServerCompactStoreResponse
+
+@typing_extensions.final
+class ServerExportStoreRequest(google.protobuf.message.Message):
+    """
+    ServerExportStoreRequest: write the records of a .wandb file as JSONL
+    """
+
+    DESCRIPTOR: google.protobuf.descriptor.Descriptor
+
+    PATH_FIELD_NUMBER: builtins.int
+    OUTPUT_DIR_FIELD_NUMBER: builtins.int
+    FORMAT_FIELD_NUMBER: builtins.int
+    _INFO_FIELD_NUMBER: builtins.int
+    path: builtins.str
+    output_dir: builtins.str
+    format: builtins.str
+    @property
+    def _info(self) -> wandb.proto.wandb_base_pb2._RecordInfo: ...
+    def __init__(
+        self,
+        *,
+        path: builtins.str = ...,
+        output_dir: builtins.str = ...,
+        format: builtins.str = ...,
+        _info: wandb.proto.wandb_base_pb2._RecordInfo | None = ...,
+    ) -> None: ...
+    def HasField(self, field_name: typing_extensions.Literal["_info", b"_info"]) -> builtins.bool: ...
+    def ClearField(self, field_name: typing_extensions.Literal["_info", b"_info", "format", b"format", "output_dir", b"output_dir", "path", b"path"]) -> None: ...
+
+global___ServerExportStoreRequest = ServerExportStoreRequest
+
+@typing_extensions.final
+class ServerExportStoreResponse(google.protobuf.message.Message):
+    DESCRIPTOR: google.protobuf.descriptor.Descriptor
+
+    HISTORY_ROWS_FIELD_NUMBER: builtins.int
+    CONFIG_ROWS_FIELD_NUMBER: builtins.int
+    SUMMARY_ROWS_FIELD_NUMBER: builtins.int
+    CONSOLE_ROWS_FIELD_NUMBER: builtins.int
+    ERROR_FIELD_NUMBER: builtins.int
+    _INFO_FIELD_NUMBER: builtins.int
+    history_rows: builtins.int
+    config_rows: builtins.int
+    summary_rows: builtins.int
+    console_rows: builtins.int
+    @property
+    def error(self) -> wandb.proto.wandb_internal_pb2.ErrorInfo: ...
+    @property
+    def _info(self) -> wandb.proto.wandb_base_pb2._RecordInfo: ...
+    def __init__(
+        self,
+        *,
+        history_rows: builtins.int = ...,
+        config_rows: builtins.int = ...,
+        summary_rows: builtins.int = ...,
+        console_rows: builtins.int = ...,
+        error: wandb.proto.wandb_internal_pb2.ErrorInfo | None = ...,
+        _info: wandb.proto.wandb_base_pb2._RecordInfo | None = ...,
+    ) -> None: ...
+    def HasField(self, field_name: typing_extensions.Literal["_info", b"_info", "error", b"error"]) -> builtins.bool: ...
+    def ClearField(self, field_name: typing_extensions.Literal["_info", b"_info", "config_rows", b"config_rows", "console_rows", b"console_rows", "error", b"error", "history_rows", b"history_rows", "summary_rows", b"summary_rows"]) -> None: ...
+
+global___ServerExportStoreResponse = ServerExportStoreResponse
+
+@typing_extensions.final
+class ServerImportStoreRequest(google.protobuf.message.Message):
+    """
+    ServerImportStoreRequest: write a .wandb file with the metrics of
+    TensorBoard event files or a CSV file
+    """
+
+    DESCRIPTOR: google.protobuf.descriptor.Descriptor
+
+    PATH_FIELD_NUMBER: builtins.int
+    OUTPUT_PATH_FIELD_NUMBER: builtins.int
+    FORMAT_FIELD_NUMBER: builtins.int
+    RUN_ID_FIELD_NUMBER: builtins.int
+    PROJECT_FIELD_NUMBER: builtins.int
+    ENTITY_FIELD_NUMBER: builtins.int
+    _INFO_FIELD_NUMBER: builtins.int
+    path: builtins.str
+    output_path: builtins.str
+    format: builtins.str
+    run_id: builtins.str
+    project: builtins.str
+    entity: builtins.str
+    @property
+    def _info(self) -> wandb.proto.wandb_base_pb2._RecordInfo: ...
+    def __init__(
+        self,
+        *,
+        path: builtins.str = ...,
+        output_path: builtins.str = ...,
+        format: builtins.str = ...,
+        run_id: builtins.str = ...,
+        project: builtins.str = ...,
+        entity: builtins.str = ...,
+        _info: wandb.proto.wandb_base_pb2._RecordInfo | None = ...,
+    ) -> None: ...
+    def HasField(self, field_name: typing_extensions.Literal["_info", b"_info"]) -> builtins.bool: ...
+    def ClearField(self, field_name: typing_extensions.Literal["_info", b"_info", "entity", b"entity", "format", b"format", "output_path", b"output_path", "path", b"path", "project", b"project", "run_id", b"run_id"]) -> None: ...
+
+global___ServerImportStoreRequest = ServerImportStoreRequest
+
+@typing_extensions.final
+class ServerImportStoreResponse(google.protobuf.message.Message):
+    DESCRIPTOR: google.protobuf.descriptor.Descriptor
+
+    RECORDS_WRITTEN_FIELD_NUMBER: builtins.int
+    HISTORY_ROWS_FIELD_NUMBER: builtins.int
+    SKIPPED_FIELD_NUMBER: builtins.int
+    ERROR_FIELD_NUMBER: builtins.int
+    _INFO_FIELD_NUMBER: builtins.int
+    records_written: builtins.int
+    history_rows: builtins.int
+    skipped: builtins.int
+    @property
+    def error(self) -> wandb.proto.wandb_internal_pb2.ErrorInfo: ...
+    @property
+    def _info(self) -> wandb.proto.wandb_base_pb2._RecordInfo: ...
+    def __init__(
+        self,
+        *,
+        records_written: builtins.int = ...,
+        history_rows: builtins.int = ...,
+        skipped: builtins.int = ...,
+        error: wandb.proto.wandb_internal_pb2.ErrorInfo | None = ...,
+        _info: wandb.proto.wandb_base_pb2._RecordInfo | None = ...,
+    ) -> None: ...
+    def HasField(self, field_name: typing_extensions.Literal["_info", b"_info", "error", b"error"]) -> builtins.bool: ...
+    def ClearField(self, field_name: typing_extensions.Literal["_info", b"_info", "error", b"error", "history_rows", b"history_rows", "records_written", b"records_written", "skipped", b"skipped"]) -> None: ...
+
+global___ServerImportStoreResponse = ServerImportStoreResponse
+
+@typing_extensions.final
+class ServerVerifyStoreRequest(google.protobuf.message.Message):
+    """
+    ServerVerifyStoreRequest: check the framing, checksums and record numbers
+    of a .wandb file
+    """
+
+    DESCRIPTOR: google.protobuf.descriptor.Descriptor
+
+    PATH_FIELD_NUMBER: builtins.int
+    _INFO_FIELD_NUMBER: builtins.int
+    path: builtins.str
+    @property
+    def _info(self) -> wandb.proto.wandb_base_pb2._RecordInfo: ...
+    def __init__(
+        self,
+        *,
+        path: builtins.str = ...,
+        _info: wandb.proto.wandb_base_pb2._RecordInfo | None = ...,
+    ) -> None: ...
+    def HasField(self, field_name: typing_extensions.Literal["_info", b"_info"]) -> builtins.bool: ...
+    def ClearField(self, field_name: typing_extensions.Literal["_info", b"_info", "path", b"path"]) -> None: ...
+
+global___ServerVerifyStoreRequest = ServerVerifyStoreRequest
+
+@typing_extensions.final
+class ServerVerifyStoreResponse(google.protobuf.message.Message):
+    DESCRIPTOR: google.protobuf.descriptor.Descriptor
+
+    REPORT_FIELD_NUMBER: builtins.int
+    ERROR_FIELD_NUMBER: builtins.int
+    _INFO_FIELD_NUMBER: builtins.int
+    @property
+    def report(self) -> wandb.proto.wandb_internal_pb2.VerifyReport: ...
+    @property
+    def error(self) -> wandb.proto.wandb_internal_pb2.ErrorInfo: ...
+    @property
+    def _info(self) -> wandb.proto.wandb_base_pb2._RecordInfo: ...
+    def __init__(
+        self,
+        *,
+        report: wandb.proto.wandb_internal_pb2.VerifyReport | None = ...,
+        error: wandb.proto.wandb_internal_pb2.ErrorInfo | None = ...,
+        _info: wandb.proto.wandb_base_pb2._RecordInfo | None = ...,
+    ) -> None: ...
+    def HasField(self, field_name: typing_extensions.Literal["_info", b"_info", "error", b"error", "report", b"report"]) -> builtins.bool: ...
+    def ClearField(self, field_name: typing_extensions.Literal["_info", b"_info", "error", b"error", "report", b"report"]) -> None: ...
+
+global___ServerVerifyStoreResponse = ServerVerifyStoreResponse
@@ -287,6 +287,63 @@ class Settings(google.protobuf.message.Message):
     _STATS_BUFFER_SIZE_FIELD_NUMBER: builtins.int
     _SHARED_FIELD_NUMBER: builtins.int
     _PROXIES_FIELD_NUMBER: builtins.int
+    _STORE_COMPRESSION_FIELD_NUMBER: builtins.int
+    _STORE_EXCLUDE_TYPES_FIELD_NUMBER: builtins.int
+    _STORE_MAX_SEGMENT_BYTES_FIELD_NUMBER: builtins.int
+    _STORE_INDEX_FIELD_NUMBER: builtins.int
+    _STORE_ENCRYPTION_KEY_FIELD_NUMBER: builtins.int
+    _WRITER_METRICS_INTERVAL_SECONDS_FIELD_NUMBER: builtins.int
+    _STORE_SYNC_POLICY_FIELD_NUMBER: builtins.int
+    _STORE_MIRROR_DIR_FIELD_NUMBER: builtins.int
+    _STORE_DRAIN_TIMEOUT_SECONDS_FIELD_NUMBER: builtins.int
+    _STORE_EMBED_SCHEMA_FIELD_NUMBER: builtins.int
+    _STORE_DEDUP_TYPES_FIELD_NUMBER: builtins.int
+    _STORE_CONSOLE_MAX_LINES_FIELD_NUMBER: builtins.int
+    _STORE_CONSOLE_MAX_BYTES_FIELD_NUMBER: builtins.int
+    _STORE_CONSOLE_WINDOW_SECONDS_FIELD_NUMBER: builtins.int
+    _STORE_SHARDS_FIELD_NUMBER: builtins.int
+    _STORE_SHARD_KEY_FIELD_NUMBER: builtins.int
+    _STORE_JOURNAL_FIELD_NUMBER: builtins.int
+    _STORE_BACKEND_FIELD_NUMBER: builtins.int
+    _WRITER_BUFFER_SIZE_FIELD_NUMBER: builtins.int
+    _SENDER_BUFFER_SIZE_FIELD_NUMBER: builtins.int
+    _STORE_QUEUE_SIZE_FIELD_NUMBER: builtins.int
+    _STORE_METADATA_FIELD_NUMBER: builtins.int
+    _STORE_SNAPSHOT_INTERVAL_FIELD_NUMBER: builtins.int
+    _RETRY_JITTER_FIELD_NUMBER: builtins.int
+    _RETRY_STATUS_CODES_FIELD_NUMBER: builtins.int
+    _SPOOL_MAX_BYTES_FIELD_NUMBER: builtins.int
+    _CIRCUIT_BREAKER_FAILURES_FIELD_NUMBER: builtins.int
+    _CIRCUIT_BREAKER_COOLDOWN_SECONDS_FIELD_NUMBER: builtins.int
+    _FILE_STREAM_FLUSH_INTERVAL_SECONDS_FIELD_NUMBER: builtins.int
+    _FILE_STREAM_MAX_BYTES_FIELD_NUMBER: builtins.int
+    _NO_PROXY_FIELD_NUMBER: builtins.int
+    _CA_BUNDLE_PATH_FIELD_NUMBER: builtins.int
+    _SENDER_UPLOAD_WORKERS_FIELD_NUMBER: builtins.int
+    _SENDER_METADATA_WORKERS_FIELD_NUMBER: builtins.int
+    _UPLOAD_BANDWIDTH_BYTES_FIELD_NUMBER: builtins.int
+    _BACKEND_TRANSPORT_FIELD_NUMBER: builtins.int
+    _EXIT_FLUSH_TIMEOUT_SECONDS_FIELD_NUMBER: builtins.int
+    _REQUEST_COMPRESSION_FIELD_NUMBER: builtins.int
+    _STOP_POLLING_INTERVAL_SECONDS_FIELD_NUMBER: builtins.int
+    _HISTORY_SAMPLING_EVERY_FIELD_NUMBER: builtins.int
+    _HISTORY_SAMPLING_OVERLOAD_SECONDS_FIELD_NUMBER: builtins.int
+    _FAILOVER_BASE_URLS_FIELD_NUMBER: builtins.int
+    _FAILOVER_RECOVER_SECONDS_FIELD_NUMBER: builtins.int
+    _DEBOUNCE_SECONDS_FIELD_NUMBER: builtins.int
+    _STATS_WINDOW_SECONDS_FIELD_NUMBER: builtins.int
+    _STATS_WINDOW_AGGREGATION_FIELD_NUMBER: builtins.int
+    _HISTORY_INVALID_VALUES_FIELD_NUMBER: builtins.int
+    _CONSOLE_ANSI_FIELD_NUMBER: builtins.int
+    _CONSOLE_MAX_LINE_BYTES_FIELD_NUMBER: builtins.int
+    _CONSOLE_REORDER_SECONDS_FIELD_NUMBER: builtins.int
+    _RANK_FIELD_NUMBER: builtins.int
+    _HISTORY_STEP_POLICY_FIELD_NUMBER: builtins.int
+    _HISTORY_MAX_KEYS_FIELD_NUMBER: builtins.int
+    _HISTORY_KEY_OVERFLOW_FIELD_NUMBER: builtins.int
+    _TABLE_BUFFER_ROWS_FIELD_NUMBER: builtins.int
+    _CAPTURE_PATH_FIELD_NUMBER: builtins.int
+    _FLOW_CONTROL_CREDITS_FIELD_NUMBER: builtins.int
     @property
     def _args(self) -> global___ListStringValue: ...
     @property
@@ -611,6 +668,120 @@ class Settings(google.protobuf.message.Message):
     def _shared(self) -> google.protobuf.wrappers_pb2.BoolValue: ...
     @property
     def _proxies(self) -> global___MapStringKeyStringValue: ...
+    @property
+    def _store_compression(self) -> google.protobuf.wrappers_pb2.StringValue: ...
+    @property
+    def _store_exclude_types(self) -> global___ListStringValue: ...
+    @property
+    def _store_max_segment_bytes(self) -> google.protobuf.wrappers_pb2.Int64Value: ...
+    @property
+    def _store_index(self) -> google.protobuf.wrappers_pb2.BoolValue: ...
+    @property
+    def _store_encryption_key(self) -> google.protobuf.wrappers_pb2.StringValue: ...
+    @property
+    def _writer_metrics_interval_seconds(self) -> google.protobuf.wrappers_pb2.DoubleValue: ...
+    @property
+    def _store_sync_policy(self) -> google.protobuf.wrappers_pb2.StringValue: ...
+    @property
+    def _store_mirror_dir(self) -> google.protobuf.wrappers_pb2.StringValue: ...
+    @property
+    def _store_drain_timeout_seconds(self) -> google.protobuf.wrappers_pb2.DoubleValue: ...
+    @property
+    def _store_embed_schema(self) -> google.protobuf.wrappers_pb2.BoolValue: ...
+    @property
+    def _store_dedup_types(self) -> global___ListStringValue: ...
+    @property
+    def _store_console_max_lines(self) -> google.protobuf.wrappers_pb2.Int64Value: ...
+    @property
+    def _store_console_max_bytes(self) -> google.protobuf.wrappers_pb2.Int64Value: ...
+    @property
+    def _store_console_window_seconds(self) -> google.protobuf.wrappers_pb2.DoubleValue: ...
+    @property
+    def _store_shards(self) -> google.protobuf.wrappers_pb2.Int64Value: ...
+    @property
+    def _store_shard_key(self) -> google.protobuf.wrappers_pb2.StringValue: ...
+    @property
+    def _store_journal(self) -> google.protobuf.wrappers_pb2.BoolValue: ...
+    @property
+    def _store_backend(self) -> google.protobuf.wrappers_pb2.StringValue: ...
+    @property
+    def _writer_buffer_size(self) -> google.protobuf.wrappers_pb2.Int32Value: ...
+    @property
+    def _sender_buffer_size(self) -> google.protobuf.wrappers_pb2.Int32Value: ...
+    @property
+    def _store_queue_size(self) -> google.protobuf.wrappers_pb2.Int32Value: ...
+    @property
+    def _store_metadata(self) -> google.protobuf.wrappers_pb2.BoolValue: ...
+    @property
+    def _store_snapshot_interval(self) -> google.protobuf.wrappers_pb2.Int64Value: ...
+    @property
+    def _retry_jitter(self) -> google.protobuf.wrappers_pb2.DoubleValue: ...
+    @property
+    def _retry_status_codes(self) -> global___ListStringValue: ...
+    @property
+    def _spool_max_bytes(self) -> google.protobuf.wrappers_pb2.Int64Value: ...
+    @property
+    def _circuit_breaker_failures(self) -> google.protobuf.wrappers_pb2.Int32Value: ...
+    @property
+    def _circuit_breaker_cooldown_seconds(self) -> google.protobuf.wrappers_pb2.DoubleValue: ...
+    @property
+    def _file_stream_flush_interval_seconds(self) -> google.protobuf.wrappers_pb2.DoubleValue: ...
+    @property
+    def _file_stream_max_bytes(self) -> google.protobuf.wrappers_pb2.Int64Value: ...
+    @property
+    def _no_proxy(self) -> google.protobuf.wrappers_pb2.StringValue: ...
+    @property
+    def _ca_bundle_path(self) -> google.protobuf.wrappers_pb2.StringValue: ...
+    @property
+    def _sender_upload_workers(self) -> google.protobuf.wrappers_pb2.Int32Value: ...
+    @property
+    def _sender_metadata_workers(self) -> google.protobuf.wrappers_pb2.Int32Value: ...
+    @property
+    def _upload_bandwidth_bytes(self) -> google.protobuf.wrappers_pb2.Int64Value: ...
+    @property
+    def _backend_transport(self) -> google.protobuf.wrappers_pb2.StringValue: ...
+    @property
+    def _exit_flush_timeout_seconds(self) -> google.protobuf.wrappers_pb2.DoubleValue: ...
+    @property
+    def _request_compression(self) -> google.protobuf.wrappers_pb2.StringValue: ...
+    @property
+    def _stop_polling_interval_seconds(self) -> google.protobuf.wrappers_pb2.DoubleValue: ...
+    @property
+    def _history_sampling_every(self) -> google.protobuf.wrappers_pb2.Int32Value: ...
+    @property
+    def _history_sampling_overload_seconds(self) -> google.protobuf.wrappers_pb2.DoubleValue: ...
+    @property
+    def _failover_base_urls(self) -> global___ListStringValue: ...
+    @property
+    def _failover_recover_seconds(self) -> google.protobuf.wrappers_pb2.DoubleValue: ...
+    @property
+    def _debounce_seconds(self) -> google.protobuf.wrappers_pb2.DoubleValue: ...
+    @property
+    def _stats_window_seconds(self) -> google.protobuf.wrappers_pb2.DoubleValue: ...
+    @property
+    def _stats_window_aggregation(self) -> google.protobuf.wrappers_pb2.StringValue: ...
+    @property
+    def _history_invalid_values(self) -> google.protobuf.wrappers_pb2.StringValue: ...
+    @property
+    def _console_ansi(self) -> google.protobuf.wrappers_pb2.StringValue: ...
+    @property
+    def _console_max_line_bytes(self) -> google.protobuf.wrappers_pb2.Int64Value: ...
+    @property
+    def _console_reorder_seconds(self) -> google.protobuf.wrappers_pb2.DoubleValue: ...
+    @property
+    def _rank(self) -> google.protobuf.wrappers_pb2.Int32Value: ...
+    @property
+    def _history_step_policy(self) -> google.protobuf.wrappers_pb2.StringValue: ...
+    @property
+    def _history_max_keys(self) -> google.protobuf.wrappers_pb2.Int32Value: ...
+    @property
+    def _history_key_overflow(self) -> google.protobuf.wrappers_pb2.StringValue: ...
+    @property
+    def _table_buffer_rows(self) -> google.protobuf.wrappers_pb2.Int32Value: ...
+    @property
+    def _capture_path(self) -> google.protobuf.wrappers_pb2.StringValue: ...
+    @property
+    def _flow_control_credits(self) -> google.protobuf.wrappers_pb2.Int32Value: ...
     def __init__(
         self,
         *,
@@ -776,8 +947,65 @@ class Settings(google.protobuf.message.Message):
         _stats_buffer_size: google.protobuf.wrappers_pb2.Int32Value | None = ...,
         _shared: google.protobuf.wrappers_pb2.BoolValue | None = ...,
         _proxies: global___MapStringKeyStringValue | None = ...,
+        _store_compression: google.protobuf.wrappers_pb2.StringValue | None = ...,
+        _store_exclude_types: global___ListStringValue | None = ...,
+        _store_max_segment_bytes: google.protobuf.wrappers_pb2.Int64Value | None = ...,
+        _store_index: google.protobuf.wrappers_pb2.BoolValue | None = ...,
+        _store_encryption_key: google.protobuf.wrappers_pb2.StringValue | None = ...,
+        _writer_metrics_interval_seconds: google.protobuf.wrappers_pb2.DoubleValue | None = ...,
+        _store_sync_policy: google.protobuf.wrappers_pb2.StringValue | None = ...,
+        _store_mirror_dir: google.protobuf.wrappers_pb2.StringValue | None = ...,
+        _store_drain_timeout_seconds: google.protobuf.wrappers_pb2.DoubleValue | None = ...,
+        _store_embed_schema: google.protobuf.wrappers_pb2.BoolValue | None = ...,
+        _store_dedup_types: global___ListStringValue | None = ...,
+        _store_console_max_lines: google.protobuf.wrappers_pb2.Int64Value | None = ...,
+        _store_console_max_bytes: google.protobuf.wrappers_pb2.Int64Value | None = ...,
+        _store_console_window_seconds: google.protobuf.wrappers_pb2.DoubleValue | None = ...,
+        _store_shards: google.protobuf.wrappers_pb2.Int64Value | None = ...,
+        _store_shard_key: google.protobuf.wrappers_pb2.StringValue | None = ...,
+        _store_journal: google.protobuf.wrappers_pb2.BoolValue | None = ...,
+        _store_backend: google.protobuf.wrappers_pb2.StringValue | None = ...,
+        _writer_buffer_size: google.protobuf.wrappers_pb2.Int32Value | None = ...,
+        _sender_buffer_size: google.protobuf.wrappers_pb2.Int32Value | None = ...,
+        _store_queue_size: google.protobuf.wrappers_pb2.Int32Value | None = ...,
+        _store_metadata: google.protobuf.wrappers_pb2.BoolValue | None = ...,
+        _store_snapshot_interval: google.protobuf.wrappers_pb2.Int64Value | None = ...,
+        _retry_jitter: google.protobuf.wrappers_pb2.DoubleValue | None = ...,
+        _retry_status_codes: global___ListStringValue | None = ...,
+        _spool_max_bytes: google.protobuf.wrappers_pb2.Int64Value | None = ...,
+        _circuit_breaker_failures: google.protobuf.wrappers_pb2.Int32Value | None = ...,
+        _circuit_breaker_cooldown_seconds: google.protobuf.wrappers_pb2.DoubleValue | None = ...,
+        _file_stream_flush_interval_seconds: google.protobuf.wrappers_pb2.DoubleValue | None = ...,
+        _file_stream_max_bytes: google.protobuf.wrappers_pb2.Int64Value | None = ...,
+        _no_proxy: google.protobuf.wrappers_pb2.StringValue | None = ...,
+        _ca_bundle_path: google.protobuf.wrappers_pb2.StringValue | None = ...,
+        _sender_upload_workers: google.protobuf.wrappers_pb2.Int32Value | None = ...,
+        _sender_metadata_workers: google.protobuf.wrappers_pb2.Int32Value | None = ...,
+        _upload_bandwidth_bytes: google.protobuf.wrappers_pb2.Int64Value | None = ...,
+        _backend_transport: google.protobuf.wrappers_pb2.StringValue | None = ...,
+        _exit_flush_timeout_seconds: google.protobuf.wrappers_pb2.DoubleValue | None = ...,
+        _request_compression: google.protobuf.wrappers_pb2.StringValue | None = ...,
+        _stop_polling_interval_seconds: google.protobuf.wrappers_pb2.DoubleValue | None = ...,
+        _history_sampling_every: google.protobuf.wrappers_pb2.Int32Value | None = ...,
+        _history_sampling_overload_seconds: google.protobuf.wrappers_pb2.DoubleValue | None = ...,
+        _failover_base_urls: global___ListStringValue | None = ...,
+        _failover_recover_seconds: google.protobuf.wrappers_pb2.DoubleValue | None = ...,
+        _debounce_seconds: google.protobuf.wrappers_pb2.DoubleValue | None = ...,
+        _stats_window_seconds: google.protobuf.wrappers_pb2.DoubleValue | None = ...,
+        _stats_window_aggregation: google.protobuf.wrappers_pb2.StringValue | None = ...,
+        _history_invalid_values: google.protobuf.wrappers_pb2.StringValue | None = ...,
+        _console_ansi: google.protobuf.wrappers_pb2.StringValue | None = ...,
+        _console_max_line_bytes: google.protobuf.wrappers_pb2.Int64Value | None = ...,
+        _console_reorder_seconds: google.protobuf.wrappers_pb2.DoubleValue | None = ...,
+        _rank: google.protobuf.wrappers_pb2.Int32Value | None = ...,
+        _history_step_policy: google.protobuf.wrappers_pb2.StringValue | None = ...,
+        _history_max_keys: google.protobuf.wrappers_pb2.Int32Value | None = ...,
+        _history_key_overflow: google.protobuf.wrappers_pb2.StringValue | None = ...,
+        _table_buffer_rows: google.protobuf.wrappers_pb2.Int32Value | None = ...,
+        _capture_path: google.protobuf.wrappers_pb2.StringValue | None = ...,
+        _flow_control_credits: google.protobuf.wrappers_pb2.Int32Value | None = ...,
     ) -> None: ...
-    def HasField(self, field_name: typing_extensions.Literal["_args", b"_args", "_async_upload_concurrency_limit", b"_async_upload_concurrency_limit", "_aws_lambda", b"_aws_lambda", "_cli_only_mode", b"_cli_only_mode", "_colab", b"_colab", "_cuda", b"_cuda", "_disable_machine_info", b"_disable_machine_info", "_disable_meta", b"_disable_meta", "_disable_service", b"_disable_service", "_disable_setproctitle", b"_disable_setproctitle", "_disable_stats", b"_disable_stats", "_disable_viewer", b"_disable_viewer", "_except_exit", b"_except_exit", "_executable", b"_executable", "_extra_http_headers", b"_extra_http_headers", "_file_stream_retry_max", b"_file_stream_retry_max", "_file_stream_retry_wait_max_seconds", b"_file_stream_retry_wait_max_seconds", "_file_stream_retry_wait_min_seconds", b"_file_stream_retry_wait_min_seconds", "_file_stream_timeout_seconds", b"_file_stream_timeout_seconds", "_file_transfer_retry_max", b"_file_transfer_retry_max", "_file_transfer_retry_wait_max_seconds", b"_file_transfer_retry_wait_max_seconds", "_file_transfer_retry_wait_min_seconds", b"_file_transfer_retry_wait_min_seconds", "_file_transfer_timeout_seconds", b"_file_transfer_timeout_seconds", "_flow_control_custom", b"_flow_control_custom", "_flow_control_disabled", b"_flow_control_disabled", "_graphql_retry_max", b"_graphql_retry_max", "_graphql_retry_wait_max_seconds", b"_graphql_retry_wait_max_seconds", "_graphql_retry_wait_min_seconds", b"_graphql_retry_wait_min_seconds", "_graphql_timeout_seconds", b"_graphql_timeout_seconds", "_internal_check_process", b"_internal_check_process", "_internal_queue_timeout", b"_internal_queue_timeout", "_ipython", b"_ipython", "_jupyter", b"_jupyter", "_jupyter_name", b"_jupyter_name", "_jupyter_path", b"_jupyter_path", "_jupyter_root", b"_jupyter_root", "_kaggle", b"_kaggle", "_live_policy_rate_limit", b"_live_policy_rate_limit", "_live_policy_wait_time", b"_live_policy_wait_time", "_log_level", b"_log_level", "_network_buffer", b"_network_buffer", "_noop", b"_noop", "_notebook", b"_notebook", "_offline", b"_offline", "_os", b"_os", "_platform", b"_platform", "_proxies", b"_proxies", "_python", b"_python", "_require_core", b"_require_core", "_runqueue_item_id", b"_runqueue_item_id", "_save_requirements", b"_save_requirements", "_service_transport", b"_service_transport", "_service_wait", b"_service_wait", "_shared", b"_shared", "_start_datetime", b"_start_datetime", "_start_time", b"_start_time", "_stats_buffer_size", b"_stats_buffer_size", "_stats_disk_paths", b"_stats_disk_paths", "_stats_join_assets", b"_stats_join_assets", "_stats_neuron_monitor_config_path", b"_stats_neuron_monitor_config_path", "_stats_open_metrics_endpoints", b"_stats_open_metrics_endpoints", "_stats_open_metrics_filters", b"_stats_open_metrics_filters", "_stats_pid", b"_stats_pid", "_stats_sample_rate_seconds", b"_stats_sample_rate_seconds", "_stats_samples_to_average", b"_stats_samples_to_average", "_sync", b"_sync", "_tmp_code_dir", b"_tmp_code_dir", "_tracelog", b"_tracelog", "_unsaved_keys", b"_unsaved_keys", "_windows", b"_windows", "allow_val_change", b"allow_val_change", "anonymous", b"anonymous", "api_key", b"api_key", "azure_account_url_to_access_key", b"azure_account_url_to_access_key", "base_url", b"base_url", "code_dir", b"code_dir", "colab_url", b"colab_url", "config_paths", b"config_paths", "console", b"console", "deployment", b"deployment", "disable_code", b"disable_code", "disable_git", b"disable_git", "disable_hints", b"disable_hints", "disable_job_creation", b"disable_job_creation", "disabled", b"disabled", "docker", b"docker", "email", b"email", "entity", b"entity", "files_dir", b"files_dir", "force", b"force", "git_commit", b"git_commit", "git_remote", b"git_remote", "git_remote_url", b"git_remote_url", "git_root", b"git_root", "heartbeat_seconds", b"heartbeat_seconds", "host", b"host", "ignore_globs", b"ignore_globs", "init_timeout", b"init_timeout", "is_local", b"is_local", "job_name", b"job_name", "job_source", b"job_source", "label_disable", b"label_disable", "launch", b"launch", "launch_config_path", b"launch_config_path", "log_dir", b"log_dir", "log_internal", b"log_internal", "log_symlink_internal", b"log_symlink_internal", "log_symlink_user", b"log_symlink_user", "log_user", b"log_user", "login_timeout", b"login_timeout", "mode", b"mode", "notebook_name", b"notebook_name", "problem", b"problem", "program", b"program", "program_abspath", b"program_abspath", "program_relpath", b"program_relpath", "project", b"project", "project_url", b"project_url", "quiet", b"quiet", "reinit", b"reinit", "relogin", b"relogin", "resume", b"resume", "resume_fname", b"resume_fname", "resumed", b"resumed", "root_dir", b"root_dir", "run_group", b"run_group", "run_id", b"run_id", "run_job_type", b"run_job_type", "run_mode", b"run_mode", "run_name", b"run_name", "run_notes", b"run_notes", "run_tags", b"run_tags", "run_url", b"run_url", "sagemaker_disable", b"sagemaker_disable", "save_code", b"save_code", "settings_system", b"settings_system", "settings_workspace", b"settings_workspace", "show_colors", b"show_colors", "show_emoji", b"show_emoji", "show_errors", b"show_errors", "show_info", b"show_info", "show_warnings", b"show_warnings", "silent", b"silent", "start_method", b"start_method", "strict", b"strict", "summary_errors", b"summary_errors", "summary_timeout", b"summary_timeout", "summary_warnings", b"summary_warnings", "sweep_id", b"sweep_id", "sweep_param_path", b"sweep_param_path", "sweep_url", b"sweep_url", "symlink", b"symlink", "sync_dir", b"sync_dir", "sync_file", b"sync_file", "sync_symlink_latest", b"sync_symlink_latest", "system_sample", b"system_sample", "system_sample_seconds", b"system_sample_seconds", "table_raise_on_max_row_limit_exceeded", b"table_raise_on_max_row_limit_exceeded", "timespec", b"timespec", "tmp_dir", b"tmp_dir", "username", b"username", "wandb_dir", b"wandb_dir"]) -> builtins.bool: ...
-    def ClearField(self, field_name: typing_extensions.Literal["_args", b"_args", "_async_upload_concurrency_limit", b"_async_upload_concurrency_limit", "_aws_lambda", b"_aws_lambda", "_cli_only_mode", b"_cli_only_mode", "_colab", b"_colab", "_cuda", b"_cuda", "_disable_machine_info", b"_disable_machine_info", "_disable_meta", b"_disable_meta", "_disable_service", b"_disable_service", "_disable_setproctitle", b"_disable_setproctitle", "_disable_stats", b"_disable_stats", "_disable_viewer", b"_disable_viewer", "_except_exit", b"_except_exit", "_executable", b"_executable", "_extra_http_headers", b"_extra_http_headers", "_file_stream_retry_max", b"_file_stream_retry_max", "_file_stream_retry_wait_max_seconds", b"_file_stream_retry_wait_max_seconds", "_file_stream_retry_wait_min_seconds", b"_file_stream_retry_wait_min_seconds", "_file_stream_timeout_seconds", b"_file_stream_timeout_seconds", "_file_transfer_retry_max", b"_file_transfer_retry_max", "_file_transfer_retry_wait_max_seconds", b"_file_transfer_retry_wait_max_seconds", "_file_transfer_retry_wait_min_seconds", b"_file_transfer_retry_wait_min_seconds", "_file_transfer_timeout_seconds", b"_file_transfer_timeout_seconds", "_flow_control_custom", b"_flow_control_custom", "_flow_control_disabled", b"_flow_control_disabled", "_graphql_retry_max", b"_graphql_retry_max", "_graphql_retry_wait_max_seconds", b"_graphql_retry_wait_max_seconds", "_graphql_retry_wait_min_seconds", b"_graphql_retry_wait_min_seconds", "_graphql_timeout_seconds", b"_graphql_timeout_seconds", "_internal_check_process", b"_internal_check_process", "_internal_queue_timeout", b"_internal_queue_timeout", "_ipython", b"_ipython", "_jupyter", b"_jupyter", "_jupyter_name", b"_jupyter_name", "_jupyter_path", b"_jupyter_path", "_jupyter_root", b"_jupyter_root", "_kaggle", b"_kaggle", "_live_policy_rate_limit", b"_live_policy_rate_limit", "_live_policy_wait_time", b"_live_policy_wait_time", "_log_level", b"_log_level", "_network_buffer", b"_network_buffer", "_noop", b"_noop", "_notebook", b"_notebook", "_offline", b"_offline", "_os", b"_os", "_platform", b"_platform", "_proxies", b"_proxies", "_python", b"_python", "_require_core", b"_require_core", "_runqueue_item_id", b"_runqueue_item_id", "_save_requirements", b"_save_requirements", "_service_transport", b"_service_transport", "_service_wait", b"_service_wait", "_shared", b"_shared", "_start_datetime", b"_start_datetime", "_start_time", b"_start_time", "_stats_buffer_size", b"_stats_buffer_size", "_stats_disk_paths", b"_stats_disk_paths", "_stats_join_assets", b"_stats_join_assets", "_stats_neuron_monitor_config_path", b"_stats_neuron_monitor_config_path", "_stats_open_metrics_endpoints", b"_stats_open_metrics_endpoints", "_stats_open_metrics_filters", b"_stats_open_metrics_filters", "_stats_pid", b"_stats_pid", "_stats_sample_rate_seconds", b"_stats_sample_rate_seconds", "_stats_samples_to_average", b"_stats_samples_to_average", "_sync", b"_sync", "_tmp_code_dir", b"_tmp_code_dir", "_tracelog", b"_tracelog", "_unsaved_keys", b"_unsaved_keys", "_windows", b"_windows", "allow_val_change", b"allow_val_change", "anonymous", b"anonymous", "api_key", b"api_key", "azure_account_url_to_access_key", b"azure_account_url_to_access_key", "base_url", b"base_url", "code_dir", b"code_dir", "colab_url", b"colab_url", "config_paths", b"config_paths", "console", b"console", "deployment", b"deployment", "disable_code", b"disable_code", "disable_git", b"disable_git", "disable_hints", b"disable_hints", "disable_job_creation", b"disable_job_creation", "disabled", b"disabled", "docker", b"docker", "email", b"email", "entity", b"entity", "files_dir", b"files_dir", "force", b"force", "git_commit", b"git_commit", "git_remote", b"git_remote", "git_remote_url", b"git_remote_url", "git_root", b"git_root", "heartbeat_seconds", b"heartbeat_seconds", "host", b"host", "ignore_globs", b"ignore_globs", "init_timeout", b"init_timeout", "is_local", b"is_local", "job_name", b"job_name", "job_source", b"job_source", "label_disable", b"label_disable", "launch", b"launch", "launch_config_path", b"launch_config_path", "log_dir", b"log_dir", "log_internal", b"log_internal", "log_symlink_internal", b"log_symlink_internal", "log_symlink_user", b"log_symlink_user", "log_user", b"log_user", "login_timeout", b"login_timeout", "mode", b"mode", "notebook_name", b"notebook_name", "problem", b"problem", "program", b"program", "program_abspath", b"program_abspath", "program_relpath", b"program_relpath", "project", b"project", "project_url", b"project_url", "quiet", b"quiet", "reinit", b"reinit", "relogin", b"relogin", "resume", b"resume", "resume_fname", b"resume_fname", "resumed", b"resumed", "root_dir", b"root_dir", "run_group", b"run_group", "run_id", b"run_id", "run_job_type", b"run_job_type", "run_mode", b"run_mode", "run_name", b"run_name", "run_notes", b"run_notes", "run_tags", b"run_tags", "run_url", b"run_url", "sagemaker_disable", b"sagemaker_disable", "save_code", b"save_code", "settings_system", b"settings_system", "settings_workspace", b"settings_workspace", "show_colors", b"show_colors", "show_emoji", b"show_emoji", "show_errors", b"show_errors", "show_info", b"show_info", "show_warnings", b"show_warnings", "silent", b"silent", "start_method", b"start_method", "strict", b"strict", "summary_errors", b"summary_errors", "summary_timeout", b"summary_timeout", "summary_warnings", b"summary_warnings", "sweep_id", b"sweep_id", "sweep_param_path", b"sweep_param_path", "sweep_url", b"sweep_url", "symlink", b"symlink", "sync_dir", b"sync_dir", "sync_file", b"sync_file", "sync_symlink_latest", b"sync_symlink_latest", "system_sample", b"system_sample", "system_sample_seconds", b"system_sample_seconds", "table_raise_on_max_row_limit_exceeded", b"table_raise_on_max_row_limit_exceeded", "timespec", b"timespec", "tmp_dir", b"tmp_dir", "username", b"username", "wandb_dir", b"wandb_dir"]) -> None: ...
+    def HasField(self, field_name: typing_extensions.Literal["_args", b"_args", "_async_upload_concurrency_limit", b"_async_upload_concurrency_limit", "_aws_lambda", b"_aws_lambda", "_backend_transport", b"_backend_transport", "_ca_bundle_path", b"_ca_bundle_path", "_capture_path", b"_capture_path", "_circuit_breaker_cooldown_seconds", b"_circuit_breaker_cooldown_seconds", "_circuit_breaker_failures", b"_circuit_breaker_failures", "_cli_only_mode", b"_cli_only_mode", "_colab", b"_colab", "_console_ansi", b"_console_ansi", "_console_max_line_bytes", b"_console_max_line_bytes", "_console_reorder_seconds", b"_console_reorder_seconds", "_cuda", b"_cuda", "_debounce_seconds", b"_debounce_seconds", "_disable_machine_info", b"_disable_machine_info", "_disable_meta", b"_disable_meta", "_disable_service", b"_disable_service", "_disable_setproctitle", b"_disable_setproctitle", "_disable_stats", b"_disable_stats", "_disable_viewer", b"_disable_viewer", "_except_exit", b"_except_exit", "_executable", b"_executable", "_exit_flush_timeout_seconds", b"_exit_flush_timeout_seconds", "_extra_http_headers", b"_extra_http_headers", "_failover_base_urls", b"_failover_base_urls", "_failover_recover_seconds", b"_failover_recover_seconds", "_file_stream_flush_interval_seconds", b"_file_stream_flush_interval_seconds", "_file_stream_max_bytes", b"_file_stream_max_bytes", "_file_stream_retry_max", b"_file_stream_retry_max", "_file_stream_retry_wait_max_seconds", b"_file_stream_retry_wait_max_seconds", "_file_stream_retry_wait_min_seconds", b"_file_stream_retry_wait_min_seconds", "_file_stream_timeout_seconds", b"_file_stream_timeout_seconds", "_file_transfer_retry_max", b"_file_transfer_retry_max", "_file_transfer_retry_wait_max_seconds", b"_file_transfer_retry_wait_max_seconds", "_file_transfer_retry_wait_min_seconds", b"_file_transfer_retry_wait_min_seconds", "_file_transfer_timeout_seconds", b"_file_transfer_timeout_seconds", "_flow_control_credits", b"_flow_control_credits", "_flow_control_custom", b"_flow_control_custom", "_flow_control_disabled", b"_flow_control_disabled", "_graphql_retry_max", b"_graphql_retry_max", "_graphql_retry_wait_max_seconds", b"_graphql_retry_wait_max_seconds", "_graphql_retry_wait_min_seconds", b"_graphql_retry_wait_min_seconds", "_graphql_timeout_seconds", b"_graphql_timeout_seconds", "_history_invalid_values", b"_history_invalid_values", "_history_key_overflow", b"_history_key_overflow", "_history_max_keys", b"_history_max_keys", "_history_sampling_every", b"_history_sampling_every", "_history_sampling_overload_seconds", b"_history_sampling_overload_seconds", "_history_step_policy", b"_history_step_policy", "_internal_check_process", b"_internal_check_process", "_internal_queue_timeout", b"_internal_queue_timeout", "_ipython", b"_ipython", "_jupyter", b"_jupyter", "_jupyter_name", b"_jupyter_name", "_jupyter_path", b"_jupyter_path", "_jupyter_root", b"_jupyter_root", "_kaggle", b"_kaggle", "_live_policy_rate_limit", b"_live_policy_rate_limit", "_live_policy_wait_time", b"_live_policy_wait_time", "_log_level", b"_log_level", "_network_buffer", b"_network_buffer", "_no_proxy", b"_no_proxy", "_noop", b"_noop", "_notebook", b"_notebook", "_offline", b"_offline", "_os", b"_os", "_platform", b"_platform", "_proxies", b"_proxies", "_python", b"_python", "_rank", b"_rank", "_request_compression", b"_request_compression", "_require_core", b"_require_core", "_retry_jitter", b"_retry_jitter", "_retry_status_codes", b"_retry_status_codes", "_runqueue_item_id", b"_runqueue_item_id", "_save_requirements", b"_save_requirements", "_sender_buffer_size", b"_sender_buffer_size", "_sender_metadata_workers", b"_sender_metadata_workers", "_sender_upload_workers", b"_sender_upload_workers", "_service_transport", b"_service_transport", "_service_wait", b"_service_wait", "_shared", b"_shared", "_spool_max_bytes", b"_spool_max_bytes", "_start_datetime", b"_start_datetime", "_start_time", b"_start_time", "_stats_buffer_size", b"_stats_buffer_size", "_stats_disk_paths", b"_stats_disk_paths", "_stats_join_assets", b"_stats_join_assets", "_stats_neuron_monitor_config_path", b"_stats_neuron_monitor_config_path", "_stats_open_metrics_endpoints", b"_stats_open_metrics_endpoints", "_stats_open_metrics_filters", b"_stats_open_metrics_filters", "_stats_pid", b"_stats_pid", "_stats_sample_rate_seconds", b"_stats_sample_rate_seconds", "_stats_samples_to_average", b"_stats_samples_to_average", "_stats_window_aggregation", b"_stats_window_aggregation", "_stats_window_seconds", b"_stats_window_seconds", "_stop_polling_interval_seconds", b"_stop_polling_interval_seconds", "_store_backend", b"_store_backend", "_store_compression", b"_store_compression", "_store_console_max_bytes", b"_store_console_max_bytes", "_store_console_max_lines", b"_store_console_max_lines", "_store_console_window_seconds", b"_store_console_window_seconds", "_store_dedup_types", b"_store_dedup_types", "_store_drain_timeout_seconds", b"_store_drain_timeout_seconds", "_store_embed_schema", b"_store_embed_schema", "_store_encryption_key", b"_store_encryption_key", "_store_exclude_types", b"_store_exclude_types", "_store_index", b"_store_index", "_store_journal", b"_store_journal", "_store_max_segment_bytes", b"_store_max_segment_bytes", "_store_metadata", b"_store_metadata", "_store_mirror_dir", b"_store_mirror_dir", "_store_queue_size", b"_store_queue_size", "_store_shard_key", b"_store_shard_key", "_store_shards", b"_store_shards", "_store_snapshot_interval", b"_store_snapshot_interval", "_store_sync_policy", b"_store_sync_policy", "_sync", b"_sync", "_table_buffer_rows", b"_table_buffer_rows", "_tmp_code_dir", b"_tmp_code_dir", "_tracelog", b"_tracelog", "_unsaved_keys", b"_unsaved_keys", "_upload_bandwidth_bytes", b"_upload_bandwidth_bytes", "_windows", b"_windows", "_writer_buffer_size", b"_writer_buffer_size", "_writer_metrics_interval_seconds", b"_writer_metrics_interval_seconds", "allow_val_change", b"allow_val_change", "anonymous", b"anonymous", "api_key", b"api_key", "azure_account_url_to_access_key", b"azure_account_url_to_access_key", "base_url", b"base_url", "code_dir", b"code_dir", "colab_url", b"colab_url", "config_paths", b"config_paths", "console", b"console", "deployment", b"deployment", "disable_code", b"disable_code", "disable_git", b"disable_git", "disable_hints", b"disable_hints", "disable_job_creation", b"disable_job_creation", "disabled", b"disabled", "docker", b"docker", "email", b"email", "entity", b"entity", "files_dir", b"files_dir", "force", b"force", "git_commit", b"git_commit", "git_remote", b"git_remote", "git_remote_url", b"git_remote_url", "git_root", b"git_root", "heartbeat_seconds", b"heartbeat_seconds", "host", b"host", "ignore_globs", b"ignore_globs", "init_timeout", b"init_timeout", "is_local", b"is_local", "job_name", b"job_name", "job_source", b"job_source", "label_disable", b"label_disable", "launch", b"launch", "launch_config_path", b"launch_config_path", "log_dir", b"log_dir", "log_internal", b"log_internal", "log_symlink_internal", b"log_symlink_internal", "log_symlink_user", b"log_symlink_user", "log_user", b"log_user", "login_timeout", b"login_timeout", "mode", b"mode", "notebook_name", b"notebook_name", "problem", b"problem", "program", b"program", "program_abspath", b"program_abspath", "program_relpath", b"program_relpath", "project", b"project", "project_url", b"project_url", "quiet", b"quiet", "reinit", b"reinit", "relogin", b"relogin", "resume", b"resume", "resume_fname", b"resume_fname", "resumed", b"resumed", "root_dir", b"root_dir", "run_group", b"run_group", "run_id", b"run_id", "run_job_type", b"run_job_type", "run_mode", b"run_mode", "run_name", b"run_name", "run_notes", b"run_notes", "run_tags", b"run_tags", "run_url", b"run_url", "sagemaker_disable", b"sagemaker_disable", "save_code", b"save_code", "settings_system", b"settings_system", "settings_workspace", b"settings_workspace", "show_colors", b"show_colors", "show_emoji", b"show_emoji", "show_errors", b"show_errors", "show_info", b"show_info", "show_warnings", b"show_warnings", "silent", b"silent", "start_method", b"start_method", "strict", b"strict", "summary_errors", b"summary_errors", "summary_timeout", b"summary_timeout", "summary_warnings", b"summary_warnings", "sweep_id", b"sweep_id", "sweep_param_path", b"sweep_param_path", "sweep_url", b"sweep_url", "symlink", b"symlink", "sync_dir", b"sync_dir", "sync_file", b"sync_file", "sync_symlink_latest", b"sync_symlink_latest", "system_sample", b"system_sample", "system_sample_seconds", b"system_sample_seconds", "table_raise_on_max_row_limit_exceeded", b"table_raise_on_max_row_limit_exceeded", "timespec", b"timespec", "tmp_dir", b"tmp_dir", "username", b"username", "wandb_dir", b"wandb_dir"]) -> builtins.bool: ...
+    def ClearField(self, field_name: typing_extensions.Literal["_args", b"_args", "_async_upload_concurrency_limit", b"_async_upload_concurrency_limit", "_aws_lambda", b"_aws_lambda", "_backend_transport", b"_backend_transport", "_ca_bundle_path", b"_ca_bundle_path", "_capture_path", b"_capture_path", "_circuit_breaker_cooldown_seconds", b"_circuit_breaker_cooldown_seconds", "_circuit_breaker_failures", b"_circuit_breaker_failures", "_cli_only_mode", b"_cli_only_mode", "_colab", b"_colab", "_console_ansi", b"_console_ansi", "_console_max_line_bytes", b"_console_max_line_bytes", "_console_reorder_seconds", b"_console_reorder_seconds", "_cuda", b"_cuda", "_debounce_seconds", b"_debounce_seconds", "_disable_machine_info", b"_disable_machine_info", "_disable_meta", b"_disable_meta", "_disable_service", b"_disable_service", "_disable_setproctitle", b"_disable_setproctitle", "_disable_stats", b"_disable_stats", "_disable_viewer", b"_disable_viewer", "_except_exit", b"_except_exit", "_executable", b"_executable", "_exit_flush_timeout_seconds", b"_exit_flush_timeout_seconds", "_extra_http_headers", b"_extra_http_headers", "_failover_base_urls", b"_failover_base_urls", "_failover_recover_seconds", b"_failover_recover_seconds", "_file_stream_flush_interval_seconds", b"_file_stream_flush_interval_seconds", "_file_stream_max_bytes", b"_file_stream_max_bytes", "_file_stream_retry_max", b"_file_stream_retry_max", "_file_stream_retry_wait_max_seconds", b"_file_stream_retry_wait_max_seconds", "_file_stream_retry_wait_min_seconds", b"_file_stream_retry_wait_min_seconds", "_file_stream_timeout_seconds", b"_file_stream_timeout_seconds", "_file_transfer_retry_max", b"_file_transfer_retry_max", "_file_transfer_retry_wait_max_seconds", b"_file_transfer_retry_wait_max_seconds", "_file_transfer_retry_wait_min_seconds", b"_file_transfer_retry_wait_min_seconds", "_file_transfer_timeout_seconds", b"_file_transfer_timeout_seconds", "_flow_control_credits", b"_flow_control_credits", "_flow_control_custom", b"_flow_control_custom", "_flow_control_disabled", b"_flow_control_disabled", "_graphql_retry_max", b"_graphql_retry_max", "_graphql_retry_wait_max_seconds", b"_graphql_retry_wait_max_seconds", "_graphql_retry_wait_min_seconds", b"_graphql_retry_wait_min_seconds", "_graphql_timeout_seconds", b"_graphql_timeout_seconds", "_history_invalid_values", b"_history_invalid_values", "_history_key_overflow", b"_history_key_overflow", "_history_max_keys", b"_history_max_keys", "_history_sampling_every", b"_history_sampling_every", "_history_sampling_overload_seconds", b"_history_sampling_overload_seconds", "_history_step_policy", b"_history_step_policy", "_internal_check_process", b"_internal_check_process", "_internal_queue_timeout", b"_internal_queue_timeout", "_ipython", b"_ipython", "_jupyter", b"_jupyter", "_jupyter_name", b"_jupyter_name", "_jupyter_path", b"_jupyter_path", "_jupyter_root", b"_jupyter_root", "_kaggle", b"_kaggle", "_live_policy_rate_limit", b"_live_policy_rate_limit", "_live_policy_wait_time", b"_live_policy_wait_time", "_log_level", b"_log_level", "_network_buffer", b"_network_buffer", "_no_proxy", b"_no_proxy", "_noop", b"_noop", "_notebook", b"_notebook", "_offline", b"_offline", "_os", b"_os", "_platform", b"_platform", "_proxies", b"_proxies", "_python", b"_python", "_rank", b"_rank", "_request_compression", b"_request_compression", "_require_core", b"_require_core", "_retry_jitter", b"_retry_jitter", "_retry_status_codes", b"_retry_status_codes", "_runqueue_item_id", b"_runqueue_item_id", "_save_requirements", b"_save_requirements", "_sender_buffer_size", b"_sender_buffer_size", "_sender_metadata_workers", b"_sender_metadata_workers", "_sender_upload_workers", b"_sender_upload_workers", "_service_transport", b"_service_transport", "_service_wait", b"_service_wait", "_shared", b"_shared", "_spool_max_bytes", b"_spool_max_bytes", "_start_datetime", b"_start_datetime", "_start_time", b"_start_time", "_stats_buffer_size", b"_stats_buffer_size", "_stats_disk_paths", b"_stats_disk_paths", "_stats_join_assets", b"_stats_join_assets", "_stats_neuron_monitor_config_path", b"_stats_neuron_monitor_config_path", "_stats_open_metrics_endpoints", b"_stats_open_metrics_endpoints", "_stats_open_metrics_filters", b"_stats_open_metrics_filters", "_stats_pid", b"_stats_pid", "_stats_sample_rate_seconds", b"_stats_sample_rate_seconds", "_stats_samples_to_average", b"_stats_samples_to_average", "_stats_window_aggregation", b"_stats_window_aggregation", "_stats_window_seconds", b"_stats_window_seconds", "_stop_polling_interval_seconds", b"_stop_polling_interval_seconds", "_store_backend", b"_store_backend", "_store_compression", b"_store_compression", "_store_console_max_bytes", b"_store_console_max_bytes", "_store_console_max_lines", b"_store_console_max_lines", "_store_console_window_seconds", b"_store_console_window_seconds", "_store_dedup_types", b"_store_dedup_types", "_store_drain_timeout_seconds", b"_store_drain_timeout_seconds", "_store_embed_schema", b"_store_embed_schema", "_store_encryption_key", b"_store_encryption_key", "_store_exclude_types", b"_store_exclude_types", "_store_index", b"_store_index", "_store_journal", b"_store_journal", "_store_max_segment_bytes", b"_store_max_segment_bytes", "_store_metadata", b"_store_metadata", "_store_mirror_dir", b"_store_mirror_dir", "_store_queue_size", b"_store_queue_size", "_store_shard_key", b"_store_shard_key", "_store_shards", b"_store_shards", "_store_snapshot_interval", b"_store_snapshot_interval", "_store_sync_policy", b"_store_sync_policy", "_sync", b"_sync", "_table_buffer_rows", b"_table_buffer_rows", "_tmp_code_dir", b"_tmp_code_dir", "_tracelog", b"_tracelog", "_unsaved_keys", b"_unsaved_keys", "_upload_bandwidth_bytes", b"_upload_bandwidth_bytes", "_windows", b"_windows", "_writer_buffer_size", b"_writer_buffer_size", "_writer_metrics_interval_seconds", b"_writer_metrics_interval_seconds", "allow_val_change", b"allow_val_change", "anonymous", b"anonymous", "api_key", b"api_key", "azure_account_url_to_access_key", b"azure_account_url_to_access_key", "base_url", b"base_url", "code_dir", b"code_dir", "colab_url", b"colab_url", "config_paths", b"config_paths", "console", b"console", "deployment", b"deployment", "disable_code", b"disable_code", "disable_git", b"disable_git", "disable_hints", b"disable_hints", "disable_job_creation", b"disable_job_creation", "disabled", b"disabled", "docker", b"docker", "email", b"email", "entity", b"entity", "files_dir", b"files_dir", "force", b"force", "git_commit", b"git_commit", "git_remote", b"git_remote", "git_remote_url", b"git_remote_url", "git_root", b"git_root", "heartbeat_seconds", b"heartbeat_seconds", "host", b"host", "ignore_globs", b"ignore_globs", "init_timeout", b"init_timeout", "is_local", b"is_local", "job_name", b"job_name", "job_source", b"job_source", "label_disable", b"label_disable", "launch", b"launch", "launch_config_path", b"launch_config_path", "log_dir", b"log_dir", "log_internal", b"log_internal", "log_symlink_internal", b"log_symlink_internal", "log_symlink_user", b"log_symlink_user", "log_user", b"log_user", "login_timeout", b"login_timeout", "mode", b"mode", "notebook_name", b"notebook_name", "problem", b"problem", "program", b"program", "program_abspath", b"program_abspath", "program_relpath", b"program_relpath", "project", b"project", "project_url", b"project_url", "quiet", b"quiet", "reinit", b"reinit", "relogin", b"relogin", "resume", b"resume", "resume_fname", b"resume_fname", "resumed", b"resumed", "root_dir", b"root_dir", "run_group", b"run_group", "run_id", b"run_id", "run_job_type", b"run_job_type", "run_mode", b"run_mode", "run_name", b"run_name", "run_notes", b"run_notes", "run_tags", b"run_tags", "run_url", b"run_url", "sagemaker_disable", b"sagemaker_disable", "save_code", b"save_code", "settings_system", b"settings_system", "settings_workspace", b"settings_workspace", "show_colors", b"show_colors", "show_emoji", b"show_emoji", "show_errors", b"show_errors", "show_info", b"show_info", "show_warnings", b"show_warnings", "silent", b"silent", "start_method", b"start_method", "strict", b"strict", "summary_errors", b"summary_errors", "summary_timeout", b"summary_timeout", "summary_warnings", b"summary_warnings", "sweep_id", b"sweep_id", "sweep_param_path", b"sweep_param_path", "sweep_url", b"sweep_url", "symlink", b"symlink", "sync_dir", b"sync_dir", "sync_file", b"sync_file", "sync_symlink_latest", b"sync_symlink_latest", "system_sample", b"system_sample", "system_sample_seconds", b"system_sample_seconds", "table_raise_on_max_row_limit_exceeded", b"table_raise_on_max_row_limit_exceeded", "timespec", b"timespec", "tmp_dir", b"tmp_dir", "username", b"username", "wandb_dir", b"wandb_dir"]) -> None: ...
 
 global___Settings = Settings
@@ -14,9 +14,18 @@ _Setting = Literal[
     "_args",
     "_aws_lambda",
     "_async_upload_concurrency_limit",
+    "_backend_transport",
+    "_ca_bundle_path",
+    "_capture_path",
+    "_circuit_breaker_cooldown_seconds",
+    "_circuit_breaker_failures",
     "_cli_only_mode",
     "_colab",
+    "_console_ansi",
+    "_console_max_line_bytes",
+    "_console_reorder_seconds",
     "_cuda",
+    "_debounce_seconds",
     "_disable_meta",
     "_disable_service",
     "_disable_setproctitle",
@@ -25,7 +34,12 @@ _Setting = Literal[
     "_disable_machine_info",
     "_except_exit",
     "_executable",
+    "_exit_flush_timeout_seconds",
     "_extra_http_headers",
+    "_failover_base_urls",
+    "_failover_recover_seconds",
+    "_file_stream_flush_interval_seconds",
+    "_file_stream_max_bytes",
     "_file_stream_retry_max",
     "_file_stream_retry_wait_min_seconds",
     "_file_stream_retry_wait_max_seconds",
@@ -34,12 +48,19 @@ _Setting = Literal[
     "_file_transfer_retry_wait_min_seconds",
     "_file_transfer_retry_wait_max_seconds",
     "_file_transfer_timeout_seconds",
+    "_flow_control_credits",
     "_flow_control_custom",
     "_flow_control_disabled",
     "_graphql_retry_max",
     "_graphql_retry_wait_min_seconds",
     "_graphql_retry_wait_max_seconds",
     "_graphql_timeout_seconds",
+    "_history_invalid_values",
+    "_history_key_overflow",
+    "_history_max_keys",
+    "_history_sampling_every",
+    "_history_sampling_overload_seconds",
+    "_history_step_policy",
     "_internal_check_process",
     "_internal_queue_timeout",
     "_ipython",
@@ -52,9 +73,18 @@ _Setting = Literal[
     "_live_policy_wait_time",
     "_log_level",
     "_network_buffer",
+    "_no_proxy",
     "_noop",
     "_notebook",
     "_offline",
+    "_rank",
+    "_request_compression",
+    "_retry_jitter",
+    "_retry_status_codes",
+    "_sender_buffer_size",
+    "_sender_metadata_workers",
+    "_sender_upload_workers",
+    "_spool_max_bytes",
     "_sync",
     "_os",
     "_platform",
@@ -77,10 +107,37 @@ _Setting = Literal[
     "_stats_open_metrics_filters",
     "_stats_disk_paths",
     "_stats_buffer_size",
+    "_stats_window_aggregation",
+    "_stats_window_seconds",
+    "_stop_polling_interval_seconds",
+    "_store_backend",
+    "_store_compression",
+    "_store_console_max_bytes",
+    "_store_console_max_lines",
+    "_store_console_window_seconds",
+    "_store_dedup_types",
+    "_store_drain_timeout_seconds",
+    "_store_embed_schema",
+    "_store_encryption_key",
+    "_store_exclude_types",
+    "_store_index",
+    "_store_journal",
+    "_store_max_segment_bytes",
+    "_store_metadata",
+    "_store_mirror_dir",
+    "_store_queue_size",
+    "_store_shard_key",
+    "_store_shards",
+    "_store_snapshot_interval",
+    "_store_sync_policy",
+    "_table_buffer_rows",
     "_tmp_code_dir",
     "_tracelog",
     "_unsaved_keys",
+    "_upload_bandwidth_bytes",
     "_windows",
+    "_writer_buffer_size",
+    "_writer_metrics_interval_seconds",
     "allow_val_change",
     "anonymous",
     "api_key",
@@ -191,6 +248,8 @@ SETTINGS_TOPOLOGICALLY_SORTED: Final[Tuple[_Setting, ...]] = (
     "run_id",
     "start_method",
     "_aws_lambda",
+    "_ca_bundle_path",
+    "_capture_path",
     "_colab",
     "_disable_machine_info",
     "_disable_meta",
@@ -207,6 +266,7 @@ SETTINGS_TOPOLOGICALLY_SORTED: Final[Tuple[_Setting, ...]] = (
     "_offline",
     "_shared",
     "_stats_neuron_monitor_config_path",
+    "_store_mirror_dir",
     "run_mode",
     "_start_datetime",
     "timespec",
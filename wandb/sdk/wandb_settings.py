@@ -34,7 +34,13 @@ from typing import (
 )
 from urllib.parse import quote, unquote, urlencode, urlparse, urlsplit
 
-from google.protobuf.wrappers_pb2 import BoolValue, DoubleValue, Int32Value, StringValue
+from google.protobuf.wrappers_pb2 import (
+    BoolValue,
+    DoubleValue,
+    Int32Value,
+    Int64Value,
+    StringValue,
+)
 
 import wandb
 import wandb.env
@@ -134,7 +140,9 @@ def _datetime_as_str(val: Union[datetime, str]) -> str:
 
 def _redact_dict(
     d: Dict[str, Any],
-    unsafe_keys: Union[Set[str], FrozenSet[str]] = frozenset({"api_key"}),
+    unsafe_keys: Union[Set[str], FrozenSet[str]] = frozenset(
+        {"api_key", "_store_encryption_key"}
+    ),
     redact_str: str = "***REDACTED***",
 ) -> Dict[str, Any]:
     """Redact a dict of unsafe values specified by their key."""
@@ -290,10 +298,19 @@ class SettingsData:
     _args: Sequence[str]
     _aws_lambda: bool
     _async_upload_concurrency_limit: int
+    _backend_transport: str  # HTTP transport used to talk to the backend
+    _ca_bundle_path: str  # CA certificates to trust for the backend
+    _capture_path: str  # file the stream records are captured to
+    _circuit_breaker_cooldown_seconds: float  # pause of the requests after the failures
+    _circuit_breaker_failures: int  # failures before requests to the backend pause
     _cli_only_mode: bool  # Avoid running any code specific for runs
     _colab: bool
+    _console_ansi: str  # how ANSI escape codes in the console are handled
+    _console_max_line_bytes: int  # max bytes of a console line
+    _console_reorder_seconds: float  # window the console lines are reordered in
     # _config_dict: Config
     _cuda: str
+    _debounce_seconds: float  # interval between the debounced run updates
     _disable_meta: bool  # Do not collect system metadata
     _disable_service: (
         bool
@@ -304,7 +321,12 @@ class SettingsData:
     _disable_machine_info: bool  # Disable automatic machine info collection
     _except_exit: bool
     _executable: str
+    _exit_flush_timeout_seconds: float  # time to flush the uploads on exit
     _extra_http_headers: Mapping[str, str]
+    _failover_base_urls: Sequence[str]  # backend urls used during outages
+    _failover_recover_seconds: float  # time before the primary url is tried again
+    _file_stream_flush_interval_seconds: float  # interval between file stream requests
+    _file_stream_max_bytes: int  # max bytes of a file stream request
     # file stream retry client configuration
     _file_stream_retry_max: int  # max number of retries
     _file_stream_retry_wait_min_seconds: float  # min wait time between retries
@@ -315,6 +337,7 @@ class SettingsData:
     _file_transfer_retry_wait_min_seconds: float
     _file_transfer_retry_wait_max_seconds: float
     _file_transfer_timeout_seconds: float
+    _flow_control_credits: int  # records sent ahead of the writer
     _flow_control_custom: bool
     _flow_control_disabled: bool
     # graphql retry client configuration
@@ -322,6 +345,12 @@ class SettingsData:
     _graphql_retry_wait_min_seconds: float
     _graphql_retry_wait_max_seconds: float
     _graphql_timeout_seconds: float
+    _history_invalid_values: str  # how NaN and infinite history values are handled
+    _history_key_overflow: str  # what happens to the keys over the max
+    _history_max_keys: int  # max distinct history keys
+    _history_sampling_every: int  # keep one history row in this many
+    _history_sampling_overload_seconds: float  # backlog that starts the sampling
+    _history_step_policy: str  # how out of order history steps are handled
     _internal_check_process: float
     _internal_queue_timeout: float
     _ipython: bool
@@ -334,9 +363,18 @@ class SettingsData:
     _live_policy_wait_time: int
     _log_level: int
     _network_buffer: int
+    _no_proxy: str  # hosts that are not proxied, comma separated
     _noop: bool
     _notebook: bool
     _offline: bool
+    _rank: int  # rank of the process writing to a shared run
+    _request_compression: str  # compression of the request bodies
+    _retry_jitter: float  # jitter of the retry backoff, between 0 and 1
+    _retry_status_codes: Sequence[str]  # HTTP status codes that are retried
+    _sender_buffer_size: int  # buffer between the writer and the sender
+    _sender_metadata_workers: int  # concurrent metadata requests
+    _sender_upload_workers: int  # concurrent artifact uploads
+    _spool_max_bytes: int  # max bytes spooled while the backend is unreachable
     _sync: bool
     _os: str
     _platform: str
@@ -368,10 +406,37 @@ class SettingsData:
     _stats_buffer_size: (
         int
     )  # number of consolidated samples to buffer before flushing, available in run obj
+    _stats_window_aggregation: str  # how the system metrics are aggregated
+    _stats_window_seconds: float  # window the system metrics are aggregated over
+    _stop_polling_interval_seconds: float  # interval between run stop checks
+    _store_backend: str  # where the transaction log is stored
+    _store_compression: str  # compression of the records in the transaction log
+    _store_console_max_bytes: int  # max console bytes stored per window
+    _store_console_max_lines: int  # max console lines stored per window
+    _store_console_window_seconds: float  # window of the console retention limits
+    _store_dedup_types: Sequence[str]  # record types whose repeats are not stored
+    _store_drain_timeout_seconds: float  # time allowed to drain the store on exit
+    _store_embed_schema: bool  # embed the record schema in the transaction log
+    _store_encryption_key: str  # base64 key to encrypt the transaction log with
+    _store_exclude_types: Sequence[str]  # record types that are not stored
+    _store_index: bool  # keep an index of the transaction log segments
+    _store_journal: bool  # keep a journal of the reserved record numbers
+    _store_max_segment_bytes: int  # size of a transaction log segment before rotation
+    _store_metadata: bool  # store a metadata record at the start of the log
+    _store_mirror_dir: str  # directory with a mirror of the transaction log
+    _store_queue_size: int  # records queued for the store goroutine
+    _store_shard_key: str  # how records are assigned to the shards
+    _store_shards: int  # number of transaction log shards
+    _store_snapshot_interval: int  # records between snapshots of the run state
+    _store_sync_policy: str  # when the transaction log is synced to disk
+    _table_buffer_rows: int  # rows buffered before a table is committed
     _tmp_code_dir: str
     _tracelog: str
     _unsaved_keys: Sequence[str]
+    _upload_bandwidth_bytes: int  # upload bandwidth cap, in bytes per second
     _windows: bool
+    _writer_buffer_size: int  # buffer between the handler and the writer
+    _writer_metrics_interval_seconds: float  # interval between writer metrics reports
     allow_val_change: bool
     anonymous: str
     api_key: str
@@ -619,10 +684,17 @@ class Settings(SettingsData):
                 "hook": lambda _: is_aws_lambda(),
                 "auto_hook": True,
             },
+            _ca_bundle_path={"hook": lambda x: self._path_convert(x)},
+            _capture_path={"hook": lambda x: self._path_convert(x)},
+            _circuit_breaker_cooldown_seconds={"preprocessor": float},
+            _circuit_breaker_failures={"preprocessor": int},
             _colab={
                 "hook": lambda _: "google.colab" in sys.modules,
                 "auto_hook": True,
             },
+            _console_max_line_bytes={"preprocessor": int},
+            _console_reorder_seconds={"preprocessor": float},
+            _debounce_seconds={"preprocessor": float},
             _disable_machine_info={
                 "value": False,
                 "preprocessor": _str_as_bool,
@@ -644,7 +716,12 @@ class Settings(SettingsData):
                 "hook": lambda x: self._disable_machine_info or x,
             },
             _disable_viewer={"preprocessor": _str_as_bool},
+            _exit_flush_timeout_seconds={"preprocessor": float},
             _extra_http_headers={"preprocessor": _str_as_json},
+            _failover_base_urls={"preprocessor": _str_as_tuple},
+            _failover_recover_seconds={"preprocessor": float},
+            _file_stream_flush_interval_seconds={"preprocessor": float},
+            _file_stream_max_bytes={"preprocessor": int},
             # Retry filestream requests for 2 hours before dropping chunk (how do we recover?)
             # retry_count = seconds_in_2_hours / max_retry_time + num_retries_until_max_60_sec
             #             = 7200 / 60 + ceil(log2(60/2))
@@ -658,6 +735,7 @@ class Settings(SettingsData):
             _file_transfer_retry_wait_min_seconds={"value": 2, "preprocessor": float},
             _file_transfer_retry_wait_max_seconds={"value": 60, "preprocessor": float},
             _file_transfer_timeout_seconds={"value": 0, "preprocessor": float},
+            _flow_control_credits={"preprocessor": int},
             _flow_control_disabled={
                 "hook": lambda _: self._network_buffer == 0,
                 "auto_hook": True,
@@ -670,6 +748,9 @@ class Settings(SettingsData):
             _graphql_retry_wait_min_seconds={"value": 2, "preprocessor": float},
             _graphql_retry_wait_max_seconds={"value": 60, "preprocessor": float},
             _graphql_timeout_seconds={"value": 30.0, "preprocessor": float},
+            _history_max_keys={"preprocessor": int},
+            _history_sampling_every={"preprocessor": int},
+            _history_sampling_overload_seconds={"preprocessor": float},
             _internal_check_process={"value": 8, "preprocessor": float},
             _internal_queue_timeout={"value": 2, "preprocessor": float},
             _ipython={
@@ -703,8 +784,14 @@ class Settings(SettingsData):
             _proxies={
                 "preprocessor": _str_as_json,
             },
+            _rank={"preprocessor": int},
             _require_core={"value": False, "preprocessor": _str_as_bool},
+            _retry_jitter={"preprocessor": float},
+            _retry_status_codes={"preprocessor": _str_as_tuple},
             _save_requirements={"value": True, "preprocessor": _str_as_bool},
+            _sender_buffer_size={"preprocessor": int},
+            _sender_metadata_workers={"preprocessor": int},
+            _sender_upload_workers={"preprocessor": int},
             _service_wait={
                 "value": 30,
                 "preprocessor": float,
@@ -714,6 +801,7 @@ class Settings(SettingsData):
                 "hook": lambda _: self.mode == "shared",
                 "auto_hook": True,
             },
+            _spool_max_bytes={"preprocessor": int},
             _start_datetime={"preprocessor": _datetime_as_str},
             _stats_sample_rate_seconds={
                 "value": 2.0,
@@ -745,15 +833,36 @@ class Settings(SettingsData):
                 "value": 0,
                 "preprocessor": int,
             },
+            _stats_window_seconds={"preprocessor": float},
+            _stop_polling_interval_seconds={"preprocessor": float},
+            _store_console_max_bytes={"preprocessor": int},
+            _store_console_max_lines={"preprocessor": int},
+            _store_console_window_seconds={"preprocessor": float},
+            _store_dedup_types={"preprocessor": _str_as_tuple},
+            _store_drain_timeout_seconds={"preprocessor": float},
+            _store_embed_schema={"preprocessor": _str_as_bool},
+            _store_exclude_types={"preprocessor": _str_as_tuple},
+            _store_index={"preprocessor": _str_as_bool},
+            _store_journal={"preprocessor": _str_as_bool},
+            _store_max_segment_bytes={"preprocessor": int},
+            _store_metadata={"preprocessor": _str_as_bool},
+            _store_mirror_dir={"hook": lambda x: self._path_convert(x)},
+            _store_queue_size={"preprocessor": int},
+            _store_shards={"preprocessor": int},
+            _store_snapshot_interval={"preprocessor": int},
             _sync={"value": False},
+            _table_buffer_rows={"preprocessor": int},
             _tmp_code_dir={
                 "value": "code",
                 "hook": lambda x: self._path_convert(self.tmp_dir, x),
             },
+            _upload_bandwidth_bytes={"preprocessor": int},
             _windows={
                 "hook": lambda _: platform.system() == "Windows",
                 "auto_hook": True,
             },
+            _writer_buffer_size={"preprocessor": int},
+            _writer_metrics_interval_seconds={"preprocessor": float},
             anonymous={"validator": self._validate_anonymous},
             api_key={"validator": self._validate_api_key},
             base_url={
@@ -1556,7 +1665,12 @@ class Settings(SettingsData):
             if isinstance(v, bool):
                 getattr(settings, k).CopyFrom(BoolValue(value=v))
             elif isinstance(v, int):
-                getattr(settings, k).CopyFrom(Int32Value(value=v))
+                setting = getattr(settings, k)
+                # sizes and counts that may not fit in 32 bits are Int64Value
+                if setting.DESCRIPTOR == Int64Value.DESCRIPTOR:
+                    setting.CopyFrom(Int64Value(value=v))
+                else:
+                    setting.CopyFrom(Int32Value(value=v))
             elif isinstance(v, float):
                 getattr(settings, k).CopyFrom(DoubleValue(value=v))
             elif isinstance(v, str):